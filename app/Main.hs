@@ -1,12 +1,16 @@
+{-# LANGUAGE NamedFieldPuns #-}
+
 module Main where
 
+import Data.Maybe (fromMaybe)
 import Lib
 import Options.Applicative
 import Util
 
 data Opts = Opts
   { schemaVersion :: String,
-    language :: String
+    language :: String,
+    schema :: Maybe String
   }
 
 myopts :: Parser Opts
@@ -22,6 +26,13 @@ myopts =
           <> metavar "Go"
           <> help "Target language"
       )
+    <*> optional
+      ( strOption
+          ( long "schema"
+              <> metavar "PATH or URL"
+              <> help "Reference schema of EDItEUR to generate from, which defaults to the one fetched by `make schema`"
+          )
+      )
 
 main :: IO ()
 main = run =<< execParser opts
@@ -35,8 +46,8 @@ main = run =<< execParser opts
         )
 
 run :: Opts -> IO ()
-run Opts {schemaVersion = "v2", language = "go"} = render Go V2
-run Opts {schemaVersion = "v3", language = "go"} = render Go V3
-run Opts {schemaVersion = "v2", language = "typescript"} = render TypeScript V2
+run Opts {schemaVersion = "v2", language = "go", schema} = render Go V2 $ fromMaybe (defaultSchema V2) schema
+run Opts {schemaVersion = "v3", language = "go", schema} = render Go V3 $ fromMaybe (defaultSchema V3) schema
+run Opts {schemaVersion = "v2", language = "typescript", schema} = render TypeScript V2 $ fromMaybe (defaultSchema V2) schema
 run Opts {schemaVersion = "v3", language = "typescript"} = unimplemented ["v3 for typescript hasn't supportted yet"]
 run Opts {schemaVersion = v, language = l} = unreachable ["unintentional options has been passed, got", v, l]
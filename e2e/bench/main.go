@@ -0,0 +1,145 @@
+// Command bench measures decoding of a representative message of 10,000 products,
+// which repeats products of fixtures/20201200.onix, and prints results as go test -bench does
+// along with heap which the decoded message retains with and without interning.
+//
+//	go run ./e2e/bench [-products 10000]
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"runtime"
+	"testing"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+func main() {
+	products := flag.Int("products", 10000, "number of products of the message")
+	flag.Parse()
+	src, err := ioutil.ReadFile("fixtures/20201200.onix")
+	if err != nil {
+		log.Fatal(err)
+	}
+	message, err := repeat(src, *products)
+	if err != nil {
+		log.Fatal(err)
+	}
+	benchmarks := []struct {
+		name string
+		fn   func() error
+	}{
+		{"GeneratedV2", func() error {
+			decoder := xml.NewDecoder(bytes.NewReader(message))
+			decoder.CharsetReader = onix.CharsetReader
+			var msg v2.ONIXMessage
+			return decoder.Decode(&msg)
+		}},
+		{"Decode", func() error {
+			_, err := onix.Decode(bytes.NewReader(message))
+			return err
+		}},
+		{"DecodeParallel", func() error {
+			for p := range onix.DecodeParallel(bytes.NewReader(message), 4) {
+				if p.Err != nil {
+					return p.Err
+				}
+			}
+			return nil
+		}},
+	}
+	for _, bench := range benchmarks {
+		if err := bench.fn(); err != nil {
+			log.Fatalf("%s: %s", bench.name, err)
+		}
+		result := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(message)))
+			for i := 0; i < b.N; i++ {
+				bench.fn()
+			}
+		})
+		fmt.Printf("Benchmark%s/%d\t%s\t%s\n", bench.name, *products, result, result.MemString())
+	}
+	for _, interned := range []bool{false, true} {
+		var opts []onix.DecodeOption
+		if interned {
+			opts = append(opts, onix.WithInternPool(onix.NewInternPool()))
+		}
+		heap, err := retained(func() (interface{}, error) {
+			return onix.Decode(bytes.NewReader(message), opts...)
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Retained/%d/interned=%t\t%d B\n", *products, interned, heap)
+	}
+}
+
+// retained returns bytes of heap which the value decode returns holds.
+func retained(decode func() (interface{}, error)) (uint64, error) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	v, err := decode()
+	if err != nil {
+		return 0, err
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(v)
+	return after.HeapAlloc - before.HeapAlloc, nil
+}
+
+// repeat returns message of src whose products are repeated until there are n of them.
+func repeat(src []byte, n int) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(src))
+	// Offsets have to be of src as it is, whichever charset it declares.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	var products [][]byte
+	var offset, start int64
+	depth := 0
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && t.Name.Local == "product" {
+				start = offset
+			}
+		case xml.EndElement:
+			if depth == 2 && t.Name.Local == "product" {
+				products = append(products, src[start:decoder.InputOffset()])
+			}
+			depth--
+		}
+		offset = decoder.InputOffset()
+	}
+	if len(products) == 0 {
+		return nil, fmt.Errorf("fixture has no products")
+	}
+	head := bytes.Index(src, products[0])
+	tail := bytes.LastIndex(src, []byte("</ONIXmessage>"))
+	var b bytes.Buffer
+	b.Write(src[:head])
+	for i := 0; i < n; i++ {
+		b.Write(products[i%len(products)])
+		b.WriteString("\n  ")
+	}
+	b.Write(src[tail:])
+	return b.Bytes(), nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"reflect"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/accessibility"
+)
+
+// accessibilityCases are products in short tags whose ProductFormFeature composites of type 09 tell accessibility,
+// and the summaries, codes of statements in order of sections, and issues which they are evaluated into.
+var accessibilityCases = []struct {
+	release string
+	product string
+	summary string
+	codes   []string
+	issues  []accessibility.Issue
+}{
+	{
+		"2.1",
+		`<product><b012>DG</b012>` +
+			`<productformfeature><b334>09</b334><b335>10</b335></productformfeature>` +
+			`<productformfeature><b334>09</b334><b335>00</b335><b336> Fully accessible. </b336></productformfeature>` +
+			`<productformfeature><b334>09</b334><b335>04</b335></productformfeature>` +
+			`</product>`,
+		"Fully accessible.",
+		[]string{"04", "10"},
+		nil,
+	},
+	{
+		"2.1",
+		`<product><b012>DG</b012><productformfeature><b334>09</b334><b335>11</b335></productformfeature></product>`,
+		"",
+		[]string{"11"},
+		[]accessibility.Issue{accessibility.MissingConformance, accessibility.MissingSummary},
+	},
+	{
+		"2.1",
+		`<product><b012>BC</b012><productformfeature><b334>09</b334><b335>04</b335></productformfeature></product>`,
+		"",
+		nil,
+		nil,
+	},
+	{
+		"3.0",
+		`<product><descriptivedetail><b012>ED</b012>` +
+			`<productformfeature><b334>09</b334><b335>00</b335><b336>Fully accessible.</b336></productformfeature>` +
+			`<productformfeature><b334>09</b334><b335>76</b335></productformfeature>` +
+			`<productformfeature><b334>09</b334><b335>12</b335></productformfeature>` +
+			`<productformfeature><b334>01</b334><b335>BLK</b335></productformfeature>` +
+			`</descriptivedetail></product>`,
+		"Fully accessible.",
+		[]string{"12", "76"},
+		nil,
+	},
+	{
+		"3.0",
+		`<product><descriptivedetail><b012>EA</b012></descriptivedetail></product>`,
+		"",
+		nil,
+		[]accessibility.Issue{accessibility.MissingDetails, accessibility.MissingConformance, accessibility.MissingSummary},
+	},
+	{
+		"3.0",
+		`<product><a001>no-detail</a001></product>`,
+		"",
+		nil,
+		nil,
+	},
+}
+
+func checkAccessibility(r *report) {
+	for _, c := range accessibilityCases {
+		var report accessibility.Report
+		switch c.release {
+		case "2.1":
+			var p v2.Product
+			if err := xml.Unmarshal([]byte(c.product), &p); err != nil {
+				r.errorf("%s: %s", c.product, err)
+				continue
+			}
+			report = accessibility.Evaluate(p)
+		case "3.0":
+			var p v3.Product
+			if err := xml.Unmarshal([]byte(c.product), &p); err != nil {
+				r.errorf("%s: %s", c.product, err)
+				continue
+			}
+			report = accessibility.EvaluateV3(p)
+		}
+		var codes []string
+		for _, s := range report.Statements {
+			codes = append(codes, s.Code)
+		}
+		if report.Summary != c.summary || !reflect.DeepEqual(codes, c.codes) || !reflect.DeepEqual(report.Issues, c.issues) {
+			r.errorf("%s: %q, %v, %v, want %q, %v, %v", c.product, report.Summary, codes, report.Issues, c.summary, c.codes, c.issues)
+		}
+	}
+
+	// The product of the fixture of 3.0 is printed, which the act does not apply to.
+	fixture, err := ioutil.ReadFile("fixtures/3.0.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	var msg v3.ONIXMessage
+	if err := xml.Unmarshal(fixture, &msg); err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	for _, report := range accessibility.EvaluateMessageV3(&msg) {
+		if report.Digital || !report.Compliant() || report.RecordReference == "" {
+			r.errorf("fixture: %+v, want the printed product of its RecordReference", report)
+		}
+	}
+}
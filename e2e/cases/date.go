@@ -0,0 +1,75 @@
+package main
+
+import (
+	"time"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// dateCases are dates of formats of List 55, and the beginning and precision of the periods which they stand for.
+// Zero time of a case which is not free text means that the date is rejected.
+var dateCases = []struct {
+	date      string
+	format    string
+	want      time.Time
+	precision v3.DatePrecision
+}{
+	{"20210415", "00", day(2021, time.April, 15), v3.DatePrecisionDay},
+	{"202104", "01", day(2021, time.April, 1), v3.DatePrecisionMonth},
+	// ISO week 1 is the week which has January 4th, and 2020 has 53 weeks.
+	{"202101", "02", day(2021, time.January, 4), v3.DatePrecisionWeek},
+	{"202001", "02", day(2019, time.December, 30), v3.DatePrecisionWeek},
+	{"202053", "02", day(2020, time.December, 28), v3.DatePrecisionWeek},
+	{"202054", "02", time.Time{}, v3.DatePrecisionText},
+	{"202100", "02", time.Time{}, v3.DatePrecisionText},
+	{"20212", "03", day(2021, time.April, 1), v3.DatePrecisionQuarter},
+	{"20215", "03", time.Time{}, v3.DatePrecisionText},
+	// Seasons begin in March, June, September and December.
+	{"20211", "04", day(2021, time.March, 1), v3.DatePrecisionSeason},
+	{"20214", "04", day(2021, time.December, 1), v3.DatePrecisionSeason},
+	{"2021", "05", day(2021, time.January, 1), v3.DatePrecisionYear},
+	// Range of dates is taken as its beginning.
+	{"2021041520210430", "06", day(2021, time.April, 15), v3.DatePrecisionDay},
+	{"202104202106", "07", day(2021, time.April, 1), v3.DatePrecisionMonth},
+	{"202110202112", "08", day(2021, time.March, 8), v3.DatePrecisionWeek},
+	{"2021320214", "09", day(2021, time.July, 1), v3.DatePrecisionQuarter},
+	{"2021220223", "10", day(2021, time.June, 1), v3.DatePrecisionSeason},
+	{"20212022", "11", day(2021, time.January, 1), v3.DatePrecisionYear},
+	{"20210415", "06", time.Time{}, v3.DatePrecisionText},
+	{"20210415T0930", "13", time.Date(2021, time.April, 15, 9, 30, 0, 0, time.UTC), v3.DatePrecisionMinute},
+	{"20210415T093015", "14", time.Date(2021, time.April, 15, 9, 30, 15, 0, time.UTC), v3.DatePrecisionSecond},
+	// Format of empty string is inferred from length of date.
+	{"202104", "", day(2021, time.April, 1), v3.DatePrecisionMonth},
+	{"20210415", "", day(2021, time.April, 15), v3.DatePrecisionDay},
+	{"20210", "", time.Time{}, v3.DatePrecisionText},
+	{"Spring 2021", "12", time.Time{}, v3.DatePrecisionText},
+	{"2021", "00", time.Time{}, v3.DatePrecisionText},
+	{"20210415", "99", time.Time{}, v3.DatePrecisionText},
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func checkDate(r *report) {
+	for _, c := range dateCases {
+		rejected := c.want.IsZero() && c.format != "12"
+		d3, err3 := v3.ParseDate(c.date, c.format)
+		d2, err2 := v2.ParseDate(c.date, c.format)
+		if (err3 != nil) != rejected || (err2 != nil) != rejected {
+			r.errorf("ParseDate(%q, %q) returns errors of %v and %v", c.date, c.format, err3, err2)
+			continue
+		}
+		if rejected {
+			continue
+		}
+		if !d3.Time.Equal(c.want) || d3.Precision != c.precision || d3.Text != c.date {
+			r.errorf("v3.ParseDate(%q, %q) = %s of %d, want %s of %d", c.date, c.format, d3.Time, d3.Precision, c.want, c.precision)
+		}
+		// Both releases parse dates of List 55 alike.
+		if !d2.Time.Equal(d3.Time) || uint8(d2.Precision) != uint8(d3.Precision) {
+			r.errorf("v2.ParseDate(%q, %q) = %s of %d, want %s of %d", c.date, c.format, d2.Time, d2.Precision, d3.Time, d3.Precision)
+		}
+	}
+}
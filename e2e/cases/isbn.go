@@ -0,0 +1,58 @@
+package main
+
+import "github.com/kogai/onix-codegen/go/isbn"
+
+func checkISBN(r *report) {
+	for _, c := range []struct {
+		isbn string
+		want error
+	}{
+		{"978-0-306-40615-7", nil},
+		{"9780306406157", nil},
+		{"9780306406158", isbn.ErrChecksum},
+		{"9770306406157", isbn.ErrPrefix},
+		{"978030640615", isbn.ErrLength},
+		{"978030640615X", isbn.ErrCharacter},
+	} {
+		if err := isbn.Validate13(c.isbn); err != c.want {
+			r.errorf("Validate13(%q) = %v, want %v", c.isbn, err, c.want)
+		}
+	}
+	for _, c := range []struct {
+		isbn string
+		want error
+	}{
+		{"0-306-40615-2", nil},
+		{"080442957x", nil},
+		{"0306406153", isbn.ErrChecksum},
+		{"03064061", isbn.ErrLength},
+		{"03064X6152", isbn.ErrCharacter},
+	} {
+		if err := isbn.Validate10(c.isbn); err != c.want {
+			r.errorf("Validate10(%q) = %v, want %v", c.isbn, err, c.want)
+		}
+	}
+
+	conversions := []struct {
+		name    string
+		convert func(string) (string, error)
+		isbn    string
+		want    string
+		err     error
+	}{
+		{"Convert10To13", isbn.Convert10To13, "0-306-40615-2", "9780306406157", nil},
+		{"Convert10To13", isbn.Convert10To13, "080442957X", "9780804429573", nil},
+		{"Convert13To10", isbn.Convert13To10, "978-0-306-40615-7", "0306406152", nil},
+		{"Convert13To10", isbn.Convert13To10, "9780804429573", "080442957X", nil},
+		{"Convert13To10", isbn.Convert13To10, "9791234567896", "", isbn.ErrPrefix},
+		{"Hyphenate", isbn.Hyphenate, "9780306406157", "978-0-306-40615-7", nil},
+		{"Hyphenate", isbn.Hyphenate, "0306406152", "0-306-40615-2", nil},
+		{"Hyphenate", isbn.Hyphenate, "9780306406158", "", isbn.ErrChecksum},
+	}
+	for _, c := range conversions {
+		got, err := c.convert(c.isbn)
+		if got != c.want || err != c.err {
+			r.errorf("%s(%q) = %q, %v, want %q, %v", c.name, c.isbn, got, err, c.want, c.err)
+		}
+	}
+}
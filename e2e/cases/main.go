@@ -0,0 +1,50 @@
+// Command cases checks hand-written logic of the packages against tables of cases and the fixture of 3.0,
+// and fails unless each of them holds.
+//
+//	go run ./e2e/cases
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// check checks a package, and reports cases which do not hold to r.
+type check struct {
+	name string
+	run  func(r *report)
+}
+
+var checks = []check{
+	{"isbn", checkISBN},
+	{"date", checkDate},
+	{"texts", checkTexts},
+	{"parallel", checkParallel},
+	{"rights", checkRights},
+	{"accessibility", checkAccessibility},
+	{"store", checkStore},
+	{"opt", checkOpt},
+}
+
+// report collects cases which do not hold.
+type report struct {
+	name   string
+	failed bool
+}
+
+func (r *report) errorf(format string, args ...interface{}) {
+	log.Printf("%s: %s", r.name, fmt.Sprintf(format, args...))
+	r.failed = true
+}
+
+func main() {
+	failed := false
+	for _, c := range checks {
+		r := &report{name: c.name}
+		c.run(r)
+		failed = failed || r.failed
+	}
+	if failed {
+		log.Fatal("cases do not hold")
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/xml"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+func checkOpt(r *report) {
+	var p v3.Product
+	if err := xml.Unmarshal([]byte(`<product><publishingdetail><b394>04</b394></publishingdetail></product>`), &p); err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	if status, ok := p.PublishingDetail.GetPublishingStatus(); !ok || status.Code() != "04" {
+		r.errorf("GetPublishingStatus() = %q, %t, want 04 of PublishingStatus", status.Code(), ok)
+	}
+	def := v3.PublishingStatus{Body: "Unknown"}
+	if status := p.PublishingDetail.PublishingStatusOrElse(def); status.Code() != "04" {
+		r.errorf("PublishingStatusOrElse(def) = %q, want 04 of PublishingStatus", status.Code())
+	}
+	if _, ok := p.PublishingDetail.GetLatestReprintNumber(); ok {
+		r.errorf("GetLatestReprintNumber() returns the element which is not set")
+	}
+
+	// Accessors of nil composites return the zero value or def rather than panic.
+	p.PublishingDetail = nil
+	if _, ok := p.PublishingDetail.GetPublishingStatus(); ok {
+		r.errorf("GetPublishingStatus() of nil PublishingDetail returns the element")
+	}
+	if status := p.PublishingDetail.PublishingStatusOrElse(def); status != def {
+		r.errorf("PublishingStatusOrElse(def) of nil PublishingDetail = %+v, want def", status)
+	}
+	var old v2.Product
+	if id := old.ReligiousText.ReligiousTextIDOrElse(v2.ReligiousTextID{Body: "Bible"}); id.Body != "Bible" {
+		r.errorf("ReligiousTextIDOrElse(def) of nil ReligiousText = %+v, want def", id)
+	}
+}
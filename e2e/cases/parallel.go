@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// parallelProducts is number of products of the message, which is large enough for workers to finish out of order.
+const parallelProducts = 200
+
+var (
+	productElement  = regexp.MustCompile(`(?s)<product>.*</product>`)
+	recordReference = regexp.MustCompile(`<a001>[^<]*</a001>`)
+)
+
+func checkParallel(r *report) {
+	fixture, err := ioutil.ReadFile("fixtures/3.0.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	product := productElement.Find(fixture)
+	if product == nil {
+		r.errorf("fixture has no product")
+		return
+	}
+	// Products of the message are numbered by their RecordReferences.
+	var products bytes.Buffer
+	for i := 1; i <= parallelProducts; i++ {
+		products.Write(recordReference.ReplaceAll(product, []byte(fmt.Sprintf("<a001>%d</a001>", i))))
+	}
+	message := productElement.ReplaceAll(fixture, products.Bytes())
+
+	received := 0
+	for p := range onix.DecodeParallel(bytes.NewReader(message), 8) {
+		if p.Err != nil {
+			r.errorf("DecodeParallel: %s", p.Err)
+			return
+		}
+		received++
+		if got := p.Product.V3.RecordReference.Body; got != strconv.Itoa(received) {
+			r.errorf("DecodeParallel sends product %s as the %dth", got, received)
+		}
+	}
+	if received != parallelProducts {
+		r.errorf("DecodeParallel sends %d products, want %d", received, parallelProducts)
+	}
+
+	// Cancellation stops reading between products, and the products before it are still sent in order.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received = 0
+	var last error
+	for p := range onix.DecodeParallelContext(ctx, bytes.NewReader(message), 8) {
+		if last != nil {
+			r.errorf("DecodeParallelContext sends a product after the error %s", last)
+		}
+		if p.Err != nil {
+			last = p.Err
+			continue
+		}
+		received++
+		if got := p.Product.V3.RecordReference.Body; got != strconv.Itoa(received) {
+			r.errorf("DecodeParallelContext sends product %s as the %dth", got, received)
+		}
+		if received == 10 {
+			cancel()
+		}
+	}
+	if !errors.Is(last, context.Canceled) || received >= parallelProducts {
+		r.errorf("DecodeParallelContext stops after %d products with %v, want %s", received, last, context.Canceled)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/rights"
+)
+
+// market is a country which is asked to rights of a product, and whether and how the product can be sold there.
+type market struct {
+	country string
+	forSale bool
+	rights  rights.RightsType
+}
+
+// rightsCases are composites of sales rights of products in short tags, and the markets which they decide.
+var rightsCases = []struct {
+	release string
+	product string
+	markets []market
+}{
+	{
+		"2.1",
+		`<product><salesrights><b089>01</b089><b090>GB US</b090></salesrights><salesrights><b089>03</b089><b388>ROW</b388></salesrights></product>`,
+		[]market{{"GB", true, rights.ForSaleExclusive}, {"us", true, rights.ForSaleExclusive}, {"FR", false, rights.NotForSale}},
+	},
+	{
+		"2.1",
+		`<product><salesrights><b089>02</b089><b388>WORLD</b388></salesrights><notforsale><b090>CA</b090></notforsale></product>`,
+		[]market{{"CA", false, rights.NotForSale}, {"FR", true, rights.ForSaleNonExclusive}},
+	},
+	{
+		"2.1",
+		`<product><salesrights><b089>01</b089><b388>ECZ</b388><b090>GB</b090></salesrights></product>`,
+		[]market{{"DE", true, rights.ForSaleExclusive}, {"GB-SCT", true, rights.ForSaleExclusive}, {"CH", false, rights.Unknown}},
+	},
+	{
+		"3.0",
+		`<product><publishingdetail>` +
+			`<salesrights><b089>01</b089><territory><x450>WORLD</x450><x451>US CA</x451></territory></salesrights>` +
+			`<salesrights><b089>03</b089><territory><x449>US CA</x449></territory></salesrights>` +
+			`</publishingdetail></product>`,
+		[]market{{"GB", true, rights.ForSaleExclusive}, {"US", false, rights.NotForSale}, {"CA", false, rights.NotForSale}},
+	},
+	{
+		"3.0",
+		`<product><publishingdetail><salesrights><b089>01</b089><territory><x449>GB</x449><x452>GB-NIR</x452></territory></salesrights></publishingdetail></product>`,
+		[]market{{"GB-SCT", true, rights.ForSaleExclusive}, {"GB-NIR", false, rights.Unknown}, {"FR", false, rights.Unknown}},
+	},
+	{
+		"3.0",
+		`<product><publishingdetail>` +
+			`<salesrights><b089>02</b089><territory><x450>ROW</x450></territory></salesrights>` +
+			`<salesrights><b089>01</b089><territory><x449>JP</x449></territory></salesrights>` +
+			`</publishingdetail></product>`,
+		[]market{{"JP", true, rights.ForSaleExclusive}, {"FR", true, rights.ForSaleNonExclusive}},
+	},
+}
+
+func checkRights(r *report) {
+	for _, c := range rightsCases {
+		var statements rights.Rights
+		switch c.release {
+		case "2.1":
+			var p v2.Product
+			if err := xml.Unmarshal([]byte(c.product), &p); err != nil {
+				r.errorf("%s: %s", c.product, err)
+				continue
+			}
+			statements = rights.FromProduct(p)
+		case "3.0":
+			var p v3.Product
+			if err := xml.Unmarshal([]byte(c.product), &p); err != nil {
+				r.errorf("%s: %s", c.product, err)
+				continue
+			}
+			statements = rights.FromProductV3(p)
+		}
+		checkMarkets(r, c.product, statements, c.markets)
+	}
+
+	fixture, err := ioutil.ReadFile("fixtures/3.0.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	var msg v3.ONIXMessage
+	if err := xml.Unmarshal(fixture, &msg); err != nil {
+		r.errorf("fixtures/3.0.onix: %s", err)
+		return
+	}
+	// The product of the fixture is for sale in the whole world.
+	checkMarkets(r, "fixtures/3.0.onix", rights.FromProductV3(msg.Products[0]), []market{{"GB", true, rights.ForSaleExclusive}, {"JP", true, rights.ForSaleExclusive}})
+}
+
+func checkMarkets(r *report, product string, statements rights.Rights, markets []market) {
+	for _, m := range markets {
+		forSale, t := statements.CanSellIn(m.country)
+		if forSale != m.forSale || t != m.rights {
+			r.errorf("%s: CanSellIn(%q) = %t, %s, want %t, %s", product, m.country, forSale, t, m.forSale, m.rights)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/kogai/onix-codegen/go/onix"
+	"github.com/kogai/onix-codegen/go/store"
+)
+
+// Both fixtures hold the product of the same RecordReference and ISBN, whose 3.0 record replaces the 2.1 one.
+const (
+	sharedReference = "062124983"
+	sharedISBN      = "978-1-68050-636-5"
+	// deletedReference is the product of the fixture of 2.1 which a delete notification removes.
+	deletedReference = "050569283"
+)
+
+var deletedAt = time.Date(2020, time.December, 12, 0, 0, 0, 0, time.UTC)
+
+func checkStore(r *report) {
+	v2Message, err := onix.Read("fixtures/20201200.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	v3Message, err := onix.Read("fixtures/3.0.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	fixture, err := ioutil.ReadFile("fixtures/20201200.onix")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	// The fixture of 2.1 is delivered once again, where the product of deletedReference is notified of deletion.
+	notification := regexp.MustCompile(`(<a001>` + deletedReference + `</a001>\s*<a002>)02(</a002>)`)
+	if notification.Find(fixture) == nil {
+		r.errorf("fixture has no product of %s", deletedReference)
+		return
+	}
+	deletion, err := onix.Decode(bytes.NewReader(notification.ReplaceAll(fixture, []byte("${1}05${2}"))))
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+
+	dir, err := ioutil.TempDir("", "onix-store-")
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "catalog.jsonl")
+	file, err := store.Open(path)
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+
+	processor := func(s store.ProductStore) store.Processor {
+		return store.Processor{Store: s, Tombstones: true, Now: func() time.Time { return deletedAt }}
+	}
+	for _, s := range []*store.FileStore{store.NewMemoryStore(), file} {
+		if err := store.Apply(s, v2Message); err != nil {
+			r.errorf("Apply(%s): %s", v2Message.Version, err)
+			return
+		}
+		report, err := processor(s).Process(deletion)
+		if err != nil {
+			r.errorf("Process: %s", err)
+			return
+		}
+		if !reflect.DeepEqual(report.Deleted, []string{deletedReference}) || len(report.Put) != 4 {
+			r.errorf("Process reports %+v, want %s deleted and the others put", report, deletedReference)
+		}
+		// The deleted product is unknown once it has been deleted.
+		if report, _ := processor(s).Process(deletion); !reflect.DeepEqual(report.UnknownDeletes, []string{deletedReference}) {
+			r.errorf("Process reports %+v, want %s of unknown delete", report, deletedReference)
+		}
+		if err := store.Apply(s, v3Message); err != nil {
+			r.errorf("Apply(%s): %s", v3Message.Version, err)
+			return
+		}
+		checkCatalog(r, s, v3Message)
+	}
+
+	// Replay of the log restores the catalog, and compaction keeps it while dropping replaced and deleted records.
+	if err := file.Close(); err != nil {
+		r.errorf("Close: %s", err)
+		return
+	}
+	file, err = store.Open(path)
+	if err != nil {
+		r.errorf("Open: %s", err)
+		return
+	}
+	checkCatalog(r, file, v3Message)
+	before := size(path)
+	if err := file.Compact(); err != nil {
+		r.errorf("Compact: %s", err)
+		return
+	}
+	if after := size(path); after >= before {
+		r.errorf("Compact leaves %d bytes of log, want less than %d", after, before)
+	}
+	checkCatalog(r, file, v3Message)
+	// Records which are appended after compaction are found by their offsets of the compacted log.
+	if err := store.Apply(file, v3Message); err != nil {
+		r.errorf("Apply after Compact: %s", err)
+	}
+	checkCatalog(r, file, v3Message)
+	file.Close()
+
+	// The last line which has been left without newline is terminated on Open, and the next record is appended on its own line.
+	log, err := ioutil.ReadFile(path)
+	if err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	if err := ioutil.WriteFile(path, bytes.TrimRight(log, "\n"), 0644); err != nil {
+		r.errorf("%s", err)
+		return
+	}
+	for i := 0; i < 2; i++ {
+		file, err = store.Open(path)
+		if err != nil {
+			r.errorf("Open of log without newline: %s", err)
+			return
+		}
+		if err := file.ApplyDelete(sharedReference); err != nil {
+			r.errorf("ApplyDelete: %s", err)
+		}
+		if err := store.Apply(file, v3Message); err != nil {
+			r.errorf("Apply: %s", err)
+		}
+		checkCatalog(r, file, v3Message)
+		file.Close()
+	}
+}
+
+// checkCatalog checks s which holds the products of the fixture of 2.1 but the deleted one, whose shared product has been replaced by 3.0.
+func checkCatalog(r *report, s *store.FileStore, v3Message *onix.Message) {
+	if s.Len() != 4 {
+		r.errorf("store holds %d products, want 4", s.Len())
+	}
+	p, ok, err := s.Get(sharedReference)
+	if err != nil || !ok || p.Version != onix.V3 || !p.V3.Equal(&v3Message.V3.Products[0]) {
+		r.errorf("Get(%q) = %s, %t, %v, want the product of 3.0", sharedReference, p.Version, ok, err)
+	}
+	products, err := s.FindByISBN(sharedISBN)
+	if err != nil || len(products) != 1 || products[0].Version != onix.V3 {
+		r.errorf("FindByISBN(%q) returns %d products, %v, want the product of 3.0", sharedISBN, len(products), err)
+	}
+	if _, ok, _ := s.Get(deletedReference); ok {
+		r.errorf("Get(%q) returns the product which has been deleted", deletedReference)
+	}
+	if at, ok, _ := s.Tombstoned(deletedReference); !ok || !at.Equal(deletedAt) {
+		r.errorf("Tombstoned(%q) = %s, %t, want %s", deletedReference, at, ok, deletedAt)
+	}
+}
+
+func size(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
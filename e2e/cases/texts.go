@@ -0,0 +1,50 @@
+package main
+
+import "github.com/kogai/onix-codegen/go/texts"
+
+func checkTexts(r *report) {
+	for _, c := range []struct {
+		html string
+		want string
+	}{
+		{`<p>Hello <b>world</b></p>`, `<p>Hello <b>world</b></p>`},
+		{`<P>Upper <EM>case</EM></P>`, `<p>Upper <em>case</em></p>`},
+		{`<p>Hello<script>alert(1)</script></p>`, `<p>Hello</p>`},
+		{`<div><span class="x">text</span></div>`, `text`},
+		{`<a href="https://example.com/" onclick="steal()">link</a>`, `<a href="https://example.com/">link</a>`},
+		{`<a href="javascript:alert(1)">link</a>`, `<a>link</a>`},
+		{`<p>unclosed <b>bold`, `<p>unclosed <b>bold</b></p>`},
+		{`<ul><li>one<li>two</ul>`, `<ul><li>one</li><li>two</li></ul>`},
+		{`<p>a<br>b</p>`, `<p>a<br>b</p>`},
+		{`Fish &amp; chips &eacute;`, `Fish &amp; chips é`},
+		{`1 < 2`, `1 &lt; 2`},
+	} {
+		if got := texts.Sanitize(c.html); got != c.want {
+			r.errorf("Sanitize(%q) = %q, want %q", c.html, got, c.want)
+		}
+	}
+	for _, c := range []struct {
+		html string
+		want string
+	}{
+		{`<p>First  paragraph</p><p>Second<br/>line</p>`, "First paragraph\nSecond\nline"},
+		{`<style>p {}</style>Text &amp; more`, "Text & more"},
+	} {
+		if got := texts.PlainText(c.html); got != c.want {
+			r.errorf("PlainText(%q) = %q, want %q", c.html, got, c.want)
+		}
+	}
+	for _, c := range []struct {
+		text string
+		n    int
+		want string
+	}{
+		{"short", 10, "short"},
+		{"The quick brown fox jumps", 12, "The quick…"},
+		{"", 0, ""},
+	} {
+		if got := texts.Truncate(c.text, c.n); got != c.want {
+			r.errorf("Truncate(%q, %d) = %q, want %q", c.text, c.n, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Command dialect writes messages of both releases in both dialects, and fails unless the streaming Reader of each release
+// reads the same header and products out of them as onix.Decode does.
+//
+//	go run ./e2e/dialect
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// productV3 is product of 3.0 which has only the required elements of its identity and supply.
+const productV3 = `<product>
+  <a001>dialect</a001>
+  <a002>03</a002>
+  <productidentifier><b221>15</b221><b244>9784000000002</b244></productidentifier>
+  <productsupply>
+    <marketpublishingdetail><j407>04</j407></marketpublishingdetail>
+    <supplydetail><supplier><j292>01</j292><j137>Kogai</j137></supplier><j396>20</j396></supplydetail>
+  </productsupply>
+</product>`
+
+func main() {
+	file, err := os.Open("fixtures/20201200.onix")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+	messageV2, err := onix.Decode(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	messageV3, err := buildV3()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	failed := false
+	for _, dialect := range []struct {
+		name    string
+		dialect onix.Dialect
+	}{
+		{"short tags", onix.ShortTag},
+		{"reference tags", onix.ReferenceTag},
+	} {
+		for _, message := range []*onix.Message{messageV2, messageV3} {
+			var buf bytes.Buffer
+			if err := onix.Encode(&buf, message, onix.WithDialect(dialect.dialect)); err != nil {
+				log.Fatalf("%s in %s: %s", message.Version, dialect.name, err)
+			}
+			var check func([]byte) error
+			if message.Version == onix.V2 {
+				check = func(b []byte) error { return checkV2(b, message.V2) }
+			} else {
+				check = func(b []byte) error { return checkV3(b, message.V3) }
+			}
+			if err := check(buf.Bytes()); err != nil {
+				log.Printf("%s in %s: %s", message.Version, dialect.name, err)
+				failed = true
+			}
+		}
+	}
+	if failed {
+		log.Fatal("messages have not been read as they have been decoded")
+	}
+}
+
+func buildV3() (*onix.Message, error) {
+	var product v3.Product
+	if err := xml.Unmarshal([]byte(productV3), &product); err != nil {
+		return nil, err
+	}
+	message, err := v3.NewMessageBuilder().
+		Sender("Kogai").
+		SentDate(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)).
+		AddProduct(product).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return &onix.Message{Version: onix.V3, V3: message}, nil
+}
+
+func checkV2(b []byte, expected *v2.ONIXMessage) error {
+	reader := v2.NewReader(bytes.NewReader(b))
+	defer reader.Close()
+	header, err := reader.Header()
+	if err != nil {
+		return err
+	}
+	if err := equalHeaders(header, *expected.Header); err != nil {
+		return err
+	}
+	count := 0
+	for {
+		product, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if count >= len(expected.Products) || !expected.Products[count].Equal(product) {
+			return fmt.Errorf("product %d has been read differently from the decoded one", count+1)
+		}
+		count++
+	}
+	if count != len(expected.Products) {
+		return fmt.Errorf("%d products have been read, expected %d", count, len(expected.Products))
+	}
+	return nil
+}
+
+func checkV3(b []byte, expected *v3.ONIXMessage) error {
+	reader := v3.NewReader(bytes.NewReader(b))
+	defer reader.Close()
+	header, err := reader.Header()
+	if err != nil {
+		return err
+	}
+	if err := equalHeaders(header, expected.Header); err != nil {
+		return err
+	}
+	count := 0
+	for {
+		product, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if count >= len(expected.Products) || !expected.Products[count].Equal(product) {
+			return fmt.Errorf("product %d has been read differently from the decoded one", count+1)
+		}
+		count++
+	}
+	if count != len(expected.Products) {
+		return fmt.Errorf("%d products have been read, expected %d", count, len(expected.Products))
+	}
+	return nil
+}
+
+// equalHeaders compares headers by their short tags, since Equal is generated for products only.
+func equalHeaders(header, expected interface{}) error {
+	got, err := xml.Marshal(header)
+	if err != nil {
+		return err
+	}
+	want, err := xml.Marshal(expected)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("header has been read differently, got %s", got)
+	}
+	return nil
+}
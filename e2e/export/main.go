@@ -0,0 +1,59 @@
+// Command export writes products of the message of source into dist as JSON-LD of schema.org or records of Dublin Core,
+// which snapshot tests compare with the fixtures.
+//
+//	go run ./e2e/export [-format dublincore] fixtures/20201200.onix out.jsonld
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"io/ioutil"
+	"log"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/export/dublincore"
+	"github.com/kogai/onix-codegen/go/export/schemaorg"
+)
+
+func main() {
+	format := flag.String("format", "schemaorg", "format which products are exported into, which is schemaorg or dublincore")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		log.Fatal("usage: export [-format dublincore] source dist")
+	}
+	source, dist := flag.Arg(0), flag.Arg(1)
+
+	msg, err := v2.Read(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var buf bytes.Buffer
+	switch *format {
+	case "schemaorg":
+		books := make([]schemaorg.Book, 0, len(msg.Products))
+		for _, p := range msg.Products {
+			books = append(books, schemaorg.FromProduct(p))
+		}
+		b, err := json.MarshalIndent(books, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		buf.Write(b)
+	case "dublincore":
+		for _, p := range msg.Products {
+			b, err := xml.MarshalIndent(dublincore.FromProduct(p), "", "  ")
+			if err != nil {
+				log.Fatal(err)
+			}
+			buf.Write(b)
+			buf.WriteByte('\n')
+		}
+	default:
+		log.Fatalf("unexpected format has been passed, got [%s]", *format)
+	}
+	if err := ioutil.WriteFile(dist, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,17 +1,36 @@
+// Command helper writes the message of source into dist as JSON, which snapshot tests compare with the fixtures.
+//
+//	go run ./e2e/go [-release 3.0] fixtures/20201200.onix out.json
 package main
 
 import (
 	"encoding/json"
+	"flag"
 	"io/ioutil"
 	"log"
-	"os"
 
-	onix "github.com/kogai/onix-codegen/generated/go/v2"
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
 )
 
 func main() {
-	dist := os.Args[1]
-	data, err := onix.Read("fixtures/20201200.onix")
+	release := flag.String("release", "2.1", "release of the message of source, which is 2.1 or 3.0")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		log.Fatal("usage: helper [-release 3.0] source dist")
+	}
+	source, dist := flag.Arg(0), flag.Arg(1)
+
+	var data interface{}
+	var err error
+	switch *release {
+	case "2.1":
+		data, err = v2.Read(source)
+	case "3.0":
+		data, err = v3.Read(source)
+	default:
+		log.Fatalf("unexpected release has been passed, got [%s]", *release)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
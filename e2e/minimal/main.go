@@ -0,0 +1,111 @@
+// Command minimal writes minimal records of both releases whose optional elements have been set to zero values,
+// and fails unless the messages conform to the generated models without any empty element or attribute, which is not valid ONIX.
+//
+//	go run ./e2e/minimal
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"log"
+	"regexp"
+	"time"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// emptyElement matches elements without content, such as <b044></b044> or <b044/>.
+var emptyElement = regexp.MustCompile(`<([A-Za-z0-9]+)(\s[^>]*)?(/>|></([A-Za-z0-9]+)>)`)
+
+// emptyAttribute matches attributes without value, such as sourcename="".
+var emptyAttribute = regexp.MustCompile(`\s[A-Za-z0-9]+=""`)
+
+var sentAt = time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+func main() {
+	failed := false
+	for _, release := range []struct {
+		version onix.Version
+		encode  func(*bytes.Buffer) error
+	}{
+		{onix.V2, encodeV2},
+		{onix.V3, encodeV3},
+	} {
+		var buf bytes.Buffer
+		if err := release.encode(&buf); err != nil {
+			log.Fatalf("%s: %s", release.version, err)
+		}
+		for _, err := range onix.ValidateStructure(bytes.NewReader(buf.Bytes()), release.version) {
+			log.Printf("%s: %s", release.version, err)
+			failed = true
+		}
+		for _, m := range emptyElement.FindAllSubmatch(buf.Bytes(), -1) {
+			if len(m[4]) == 0 || bytes.Equal(m[1], m[4]) {
+				log.Printf("%s: empty element has been written, got [%s]", release.version, m[0])
+				failed = true
+			}
+		}
+		for _, m := range emptyAttribute.FindAll(buf.Bytes(), -1) {
+			log.Printf("%s: empty attribute has been written, got [%s]", release.version, bytes.TrimSpace(m))
+			failed = true
+		}
+	}
+	if failed {
+		log.Fatal("minimal records are not valid")
+	}
+}
+
+func encodeV2(buf *bytes.Buffer) error {
+	product := v2.NewProductBuilder("minimal").
+		ISBN13("9784000000002").
+		Form("BB").
+		Title("Minimal", "").
+		Publisher("Kogai").
+		PublicationDate(sentAt)
+	message, err := v2.NewMessageBuilder().
+		Sender("Kogai").
+		Contact("", "").
+		SentDate(sentAt).
+		AddProduct(product).
+		Build()
+	if err != nil {
+		return err
+	}
+	message.Products[0].YearFirstPublished = &v2.PlainText{}
+	message.Products[0].EditionStatement = &v2.PlainText{Body: " "}
+	return v2.Encode(buf, message)
+}
+
+// minimalProductV3 is product of 3.0 which has only the required elements of its identity and supply.
+const minimalProductV3 = `<product>
+  <a001>minimal</a001>
+  <a002>03</a002>
+  <productidentifier><b221>15</b221><b244>9784000000002</b244></productidentifier>
+  <productsupply>
+    <marketpublishingdetail><j407>04</j407></marketpublishingdetail>
+    <supplydetail><supplier><j292>01</j292><j137>Kogai</j137></supplier><j396>20</j396></supplydetail>
+  </productsupply>
+</product>`
+
+func encodeV3(buf *bytes.Buffer) error {
+	var product v3.Product
+	if err := xml.Unmarshal([]byte(minimalProductV3), &product); err != nil {
+		return err
+	}
+	empty := v3.DtDotNonEmptyString("")
+	product.ProductSupplys[0].MarketPublishingDetail.PromotionContact = new(v3.Flow)
+	product.ProductSupplys[0].MarketPublishingDetail.Sourcename = &empty
+	product.ProductSupplys[0].SupplyDetails[0].Supplier.TelephoneNumbers = []v3.PlainText{{}}
+	message, err := v3.NewMessageBuilder().
+		Sender("Kogai").
+		Contact("", "").
+		SentDate(sentAt).
+		AddProduct(product).
+		Build()
+	if err != nil {
+		return err
+	}
+	return v3.Encode(buf, message)
+}
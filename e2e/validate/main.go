@@ -0,0 +1,130 @@
+// Command validate checks that the fixture of 3.0 is valid, and fails unless Validate reports each of its required elements
+// once the element has been removed from the fixture, and unless ValidateStructure reports an element out of order.
+// It also fails unless Supplier of the fixture is written back in the order of the schema.
+//
+//	go run ./e2e/validate
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"strings"
+
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// requiredElements are required elements of the fixture by their short tags, and paths which Validate reports once they are removed.
+var requiredElements = []struct {
+	tag  string
+	path string
+}{
+	{"a001", "/ONIXMessage/Product[1]/RecordReference"},
+	{"a002", "/ONIXMessage/Product[1]/NotificationType"},
+	{"productidentifier", "/ONIXMessage/Product[1]/ProductIdentifier"},
+	{"x314", "/ONIXMessage/Product[1]/DescriptiveDetail/ProductComposition"},
+	{"b012", "/ONIXMessage/Product[1]/DescriptiveDetail/ProductForm"},
+	{"titledetail", "/ONIXMessage/Product[1]/DescriptiveDetail/TitleDetail"},
+	{"b089", "/ONIXMessage/Product[1]/PublishingDetail/SalesRights[1]/SalesRightsType"},
+	{"j292", "/ONIXMessage/Product[1]/ProductSupply[1]/SupplyDetail[1]/Supplier/SupplierRole"},
+	{"j396", "/ONIXMessage/Product[1]/ProductSupply[1]/SupplyDetail[1]/ProductAvailability"},
+}
+
+func main() {
+	fixture, err := ioutil.ReadFile("fixtures/3.0.onix")
+	if err != nil {
+		log.Fatal(err)
+	}
+	errs, err := validate(fixture)
+	if err != nil {
+		log.Fatal(err)
+	}
+	failed := false
+	for _, e := range errs {
+		log.Printf("fixture: %s", e)
+		failed = true
+	}
+
+	for _, r := range requiredElements {
+		// Elements of the fixture are not nested in the ones of the same tag, so that the first closing tag closes the element.
+		element := regexp.MustCompile(`(?s)\s*<` + r.tag + `>.*?</` + r.tag + `>`)
+		if element.Find(fixture) == nil {
+			log.Fatalf("fixture has no %s", r.tag)
+		}
+		errs, err := validate(element.ReplaceAll(fixture, nil))
+		if err != nil {
+			log.Fatalf("without %s: %s", r.tag, err)
+		}
+		if !reported(errs, r.path) {
+			log.Printf("without %s: %s has not been reported, got %v", r.tag, r.path, errs)
+			failed = true
+		}
+	}
+	if failed {
+		log.Fatal("required elements of 3.0 have not been validated")
+	}
+
+	for _, e := range onix.ValidateStructure(bytes.NewReader(fixture), onix.V3) {
+		log.Printf("structure of fixture: %s", e)
+		failed = true
+	}
+	// NotificationType follows RecordReference in the schema.
+	swapped := regexp.MustCompile(`(<a001>.*?</a001>)(\s*)(<a002>.*?</a002>)`)
+	if swapped.Find(fixture) == nil {
+		log.Fatal("fixture has no a001 followed by a002")
+	}
+	misordered := onix.ValidateStructure(bytes.NewReader(swapped.ReplaceAll(fixture, []byte("$3$2$1"))), onix.V3)
+	if len(misordered) != 1 || misordered[0].Path != "/ONIXMessage/Product[1]/RecordReference" {
+		log.Printf("swapped a001 and a002: RecordReference out of order has not been reported, got %v", misordered)
+		failed = true
+	}
+	if failed {
+		log.Fatal("structure of 3.0 has not been validated")
+	}
+
+	// SupplierRole precedes SupplierIdentifier and SupplierName in the schema.
+	supplier := regexp.MustCompile(`(?s)<supplier>.*?</supplier>`).Find(fixture)
+	if supplier == nil {
+		log.Fatal("fixture has no supplier")
+	}
+	written, err := roundTrip(supplier)
+	if err != nil {
+		log.Fatalf("supplier: %s", err)
+	}
+	if want := regexp.MustCompile(`>\s+<`).ReplaceAll(supplier, []byte("><")); !bytes.Equal(written, want) {
+		log.Fatalf("supplier has been written as %s, want %s", written, want)
+	}
+}
+
+// roundTrip decodes element as Supplier and encodes it again by the same tag.
+func roundTrip(element []byte) ([]byte, error) {
+	var supplier v3.Supplier
+	if err := xml.Unmarshal(element, &supplier); err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := xml.NewEncoder(&b).EncodeElement(supplier, xml.StartElement{Name: xml.Name{Local: "supplier"}}); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func validate(message []byte) ([]v3.ValidationError, error) {
+	var msg v3.ONIXMessage
+	if err := xml.NewDecoder(bytes.NewReader(message)).Decode(&msg); err != nil {
+		return nil, err
+	}
+	return v3.Validate(&msg), nil
+}
+
+func reported(errs []v3.ValidationError, path string) bool {
+	for _, e := range errs {
+		if e.Path == path || strings.HasPrefix(e.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package onix
+
+import "strconv"
+
+// ISBN13 returns ISBN-13 of product.
+func (p Product) ISBN13() (string, bool) {
+	return p.identifier("15")
+}
+
+// GTIN13 returns GTIN-13, formerly known as EAN-13, of product.
+// It falls back on EAN13 element which is replaced by ProductIdentifier composite.
+func (p Product) GTIN13() (string, bool) {
+	if id, ok := p.identifier("03"); ok {
+		return id, true
+	}
+	if p.EAN13 != nil {
+		return p.EAN13.Body, true
+	}
+	return "", false
+}
+
+// ProprietaryID returns proprietary identifier of product whose IDTypeName is idTypeName.
+func (p Product) ProprietaryID(idTypeName string) (string, bool) {
+	for _, id := range p.ProductIdentifiers {
+		if id.ProductIDType.Code() == "01" && id.IDTypeName != nil && id.IDTypeName.Body == idTypeName {
+			return id.IDValue.Body, true
+		}
+	}
+	return "", false
+}
+
+// MainAuthor returns name of author who has least sequence number among contributors of product.
+func (p Product) MainAuthor() (string, bool) {
+	var author *Contributor
+	for i, c := range p.Contributors {
+		if !c.IsAuthor() {
+			continue
+		}
+		if author == nil || sequenceOf(c) < sequenceOf(*author) {
+			author = &p.Contributors[i]
+		}
+	}
+	if author == nil {
+		return "", false
+	}
+	return author.DisplayName()
+}
+
+// descriptionTypes are codes of List 33 of texts which describe product, in order of precedence.
+var descriptionTypes = []string{"01", "03", "02"}
+
+// Description returns main, long or short description of product, which may be marked up in HTML.
+// It falls back on MainDescription element which is replaced by OtherText composite.
+func (p Product) Description() (string, bool) {
+	for _, t := range descriptionTypes {
+		for _, text := range p.OtherTexts {
+			if text.Text != nil && text.TextTypeCode.Code() == t {
+				return string(*text.Text), true
+			}
+		}
+	}
+	if p.MainDescription != nil {
+		return string(*p.MainDescription), true
+	}
+	return "", false
+}
+
+// Publisher returns name of publisher of product.
+// It falls back on PublisherName element which is replaced by Publisher composite.
+func (p Product) Publisher() (string, bool) {
+	for _, publisher := range p.Publishers {
+		if publisher.Role() != PublishingRolePublisher {
+			continue
+		}
+		if publisher.PublisherName != nil {
+			return publisher.PublisherName.Body, true
+		}
+	}
+	if p.PublisherName != nil {
+		return p.PublisherName.Body, true
+	}
+	return "", false
+}
+
+func (p Product) identifier(idType string) (string, bool) {
+	for _, id := range p.ProductIdentifiers {
+		if id.ProductIDType.Code() == idType {
+			return id.IDValue.Body, true
+		}
+	}
+	return "", false
+}
+
+// sequenceOf returns sequence number of c, where contributor without it falls behind the others.
+func sequenceOf(c Contributor) uint64 {
+	if c.SequenceNumber == nil {
+		return ^uint64(0)
+	}
+	n, err := strconv.ParseUint(c.SequenceNumber.Body, 10, 64)
+	if err != nil {
+		return ^uint64(0)
+	}
+	return n
+}
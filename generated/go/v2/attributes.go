@@ -0,0 +1,87 @@
+package onix
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+)
+
+// Attributes are attributes of provenance which any element of ONIX for Books may carry.
+// Composites and codes declare them as their own fields, and PlainText embeds them.
+type Attributes struct {
+	Datestamp  *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename     `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+}
+
+// PlainText is an element of text other than XHTML such as TitleText, which keeps its attributes along with the text.
+type PlainText struct {
+	Body string `xml:",chardata" json:"body,omitempty"`
+	Attributes
+}
+
+func (t PlainText) String() string {
+	return t.Body
+}
+
+// MarshalXML is marshaler which writes no element for blank text, since empty element is not valid ONIX,
+// so that optional element which has been set to empty text is omitted as if it is not set.
+func (t PlainText) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(t.Body) == "" {
+		return nil
+	}
+	type text PlainText
+	return e.EncodeElement(text(t), start)
+}
+
+// MarshalJSON is marshaler which emits text alone unless attributes are set.
+func (t PlainText) MarshalJSON() ([]byte, error) {
+	type attributed PlainText
+	if t.Attributes != (Attributes{}) {
+		return json.Marshal(attributed(t))
+	}
+	return json.Marshal(t.Body)
+}
+
+// UnmarshalJSON is unmarshaler which accepts text alone as well as with attributes.
+func (t *PlainText) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.Body); err == nil {
+		return nil
+	}
+	type attributed PlainText
+	return json.Unmarshal(data, (*attributed)(t))
+}
+
+func (a Attributes) clone() Attributes {
+	c := a
+	if a.Datestamp != nil {
+		v := a.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if a.Sourcetype != nil {
+		v := a.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if a.Sourcename != nil {
+		v := a.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	return c
+}
+
+// equal reports whether attributes are the same ones other than Datestamp, which tells when the element has been updated.
+func (a Attributes) equal(o Attributes) bool {
+	if (a.Sourcetype == nil) != (o.Sourcetype == nil) || (a.Sourcetype != nil && !a.Sourcetype.equal(*o.Sourcetype)) {
+		return false
+	}
+	return (a.Sourcename == nil) == (o.Sourcename == nil) && (a.Sourcename == nil || a.Sourcename.equal(*o.Sourcename))
+}
+
+func (t PlainText) clone() PlainText {
+	t.Attributes = t.Attributes.clone()
+	return t
+}
+
+func (t PlainText) equal(o PlainText) bool {
+	return t.Body == o.Body && t.Attributes.equal(o.Attributes)
+}
@@ -0,0 +1,136 @@
+package onix
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BarcodeTypeCode is code of List 141 which tells scheme of barcode on product, such as 03 for GTIN-13 with US dollar price.
+type BarcodeTypeCode string
+
+const (
+	// BarcodeNone is product which is not barcoded.
+	BarcodeNone BarcodeTypeCode = "00"
+	// BarcodeUnspecified is barcode of unspecified scheme.
+	BarcodeUnspecified BarcodeTypeCode = "01"
+	// BarcodeGTIN13 is EAN-13 barcode of GTIN-13.
+	BarcodeGTIN13 BarcodeTypeCode = "02"
+	// BarcodeGTIN13USD is EAN-13 barcode of GTIN-13 with 5-digit add-on of price in US dollar.
+	BarcodeGTIN13USD BarcodeTypeCode = "03"
+	// BarcodeGTIN13CAD is EAN-13 barcode of GTIN-13 with 5-digit add-on of price in Canadian dollar.
+	BarcodeGTIN13CAD BarcodeTypeCode = "04"
+	// BarcodeGTIN13NoPrice is EAN-13 barcode of GTIN-13 with 5-digit add-on which encodes no price.
+	BarcodeGTIN13NoPrice BarcodeTypeCode = "05"
+	// BarcodeUPC12ItemSpecific is item-specific UPC-12 barcode.
+	BarcodeUPC12ItemSpecific BarcodeTypeCode = "06"
+	// BarcodeUPC12ItemSpecificAddOn is item-specific UPC-12 barcode with 5-digit add-on.
+	BarcodeUPC12ItemSpecificAddOn BarcodeTypeCode = "07"
+	// BarcodeUPC12PricePoint is price-point UPC-12 barcode.
+	BarcodeUPC12PricePoint BarcodeTypeCode = "08"
+	// BarcodeUPC12PricePointAddOn is price-point UPC-12 barcode with 5-digit add-on.
+	BarcodeUPC12PricePointAddOn BarcodeTypeCode = "09"
+)
+
+// IsGTIN13 reports whether c is of EAN-13 barcode of GTIN-13, with or without add-on.
+func (c BarcodeTypeCode) IsGTIN13() bool {
+	return c == BarcodeGTIN13 || c == BarcodeGTIN13USD || c == BarcodeGTIN13CAD || c == BarcodeGTIN13NoPrice
+}
+
+// HasAddOn reports whether c is of barcode with 5-digit add-on.
+func (c BarcodeTypeCode) HasAddOn() bool {
+	switch c {
+	case BarcodeGTIN13USD, BarcodeGTIN13CAD, BarcodeGTIN13NoPrice, BarcodeUPC12ItemSpecificAddOn, BarcodeUPC12PricePointAddOn:
+		return true
+	}
+	return false
+}
+
+// AddOnCurrency returns ISO 4217 currency code of price which add-on of c encodes, which is empty unless it encodes price.
+func (c BarcodeTypeCode) AddOnCurrency() string {
+	switch c {
+	case BarcodeGTIN13USD:
+		return "USD"
+	case BarcodeGTIN13CAD:
+		return "CAD"
+	}
+	return ""
+}
+
+// BarcodePosition is code of List 142 which tells where barcode is on product, such as 01 for cover 4.
+type BarcodePosition string
+
+const (
+	// BarcodePositionUnspecified is position which is unknown or unspecified.
+	BarcodePositionUnspecified BarcodePosition = "00"
+	// BarcodePositionCover4 is outside back cover.
+	BarcodePositionCover4 BarcodePosition = "01"
+	// BarcodePositionCover3 is inside back cover.
+	BarcodePositionCover3 BarcodePosition = "02"
+	// BarcodePositionCover2 is inside front cover.
+	BarcodePositionCover2 BarcodePosition = "03"
+	// BarcodePositionCover1 is outside front cover.
+	BarcodePositionCover1 BarcodePosition = "04"
+	// BarcodePositionSpine is spine.
+	BarcodePositionSpine BarcodePosition = "05"
+	// BarcodePositionBox is box of product.
+	BarcodePositionBox BarcodePosition = "06"
+	// BarcodePositionTag is tag attached to product.
+	BarcodePositionTag BarcodePosition = "07"
+	// BarcodePositionBottom is bottom of product.
+	BarcodePositionBottom BarcodePosition = "08"
+	// BarcodePositionBack is back of product which is not a book.
+	BarcodePositionBack BarcodePosition = "09"
+	// BarcodePositionOuterSleeve is outer sleeve or back of product.
+	BarcodePositionOuterSleeve BarcodePosition = "10"
+	// BarcodePositionRemovableWrapping is removable wrapping of product.
+	BarcodePositionRemovableWrapping BarcodePosition = "11"
+)
+
+// barcodeTypes are codes of List 141 which codes of List 6 from 10 to 57 correspond to in each position.
+var barcodeTypes = []BarcodeTypeCode{
+	BarcodeGTIN13, BarcodeGTIN13USD, BarcodeUPC12ItemSpecific, BarcodeUPC12ItemSpecificAddOn, BarcodeUPC12PricePoint, BarcodeUPC12PricePointAddOn,
+}
+
+// barcodePositions are codes of List 142 which positions of codes of List 6 correspond to in order.
+var barcodePositions = []BarcodePosition{
+	BarcodePositionCover4, BarcodePositionCover3, BarcodePositionCover2, BarcodePositionBox,
+	BarcodePositionTag, BarcodePositionBottom, BarcodePositionBack, BarcodePositionOuterSleeve,
+}
+
+// barcode returns codes of List 141 and List 142 which code of List 6 corresponds to,
+// where UPC-12 of 04 and 05 whose kind is not told is taken as item-specific.
+func (b Barcode) barcode() (BarcodeTypeCode, BarcodePosition) {
+	n, err := strconv.Atoi(b.Code())
+	switch {
+	case err != nil || n < 0:
+		return BarcodeUnspecified, BarcodePositionUnspecified
+	case n == 4 || n == 5:
+		return BarcodeTypeCode(fmt.Sprintf("%02d", n+2)), BarcodePositionUnspecified
+	case n < 10:
+		return BarcodeTypeCode(fmt.Sprintf("%02d", n)), BarcodePositionUnspecified
+	case n < 58:
+		return barcodeTypes[(n-10)%6], barcodePositions[(n-10)/6]
+	case n < 76:
+		kind := BarcodeGTIN13NoPrice
+		if n >= 67 {
+			kind = BarcodeGTIN13CAD
+		}
+		if i := (n - 58) % 9; i > 0 {
+			return kind, barcodePositions[i-1]
+		}
+		return kind, BarcodePositionUnspecified
+	}
+	return BarcodeUnspecified, BarcodePositionUnspecified
+}
+
+// Kind returns scheme of barcode as a code of List 141 of 3.0, which List 6 combines with position.
+func (b Barcode) Kind() BarcodeTypeCode {
+	kind, _ := b.barcode()
+	return kind
+}
+
+// Position returns where barcode is on product as a code of List 142 of 3.0, which List 6 combines with scheme.
+func (b Barcode) Position() BarcodePosition {
+	_, position := b.barcode()
+	return position
+}
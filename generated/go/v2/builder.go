@@ -0,0 +1,191 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// MessageBuilder builds message step by step,
+// and checks required elements of it when the message is built.
+type MessageBuilder struct {
+	message  ONIXMessage
+	products []*ProductBuilder
+	err      error
+}
+
+// NewMessageBuilder returns MessageBuilder of message which is sent today.
+func NewMessageBuilder() *MessageBuilder {
+	b := &MessageBuilder{message: ONIXMessage{Header: &Header{}}}
+	return b.SentDate(time.Now())
+}
+
+// Sender sets company which sends message.
+func (b *MessageBuilder) Sender(company string) *MessageBuilder {
+	b.message.Header.FromCompany = plainText(company)
+	return b
+}
+
+// Contact sets person of sender and email address of the person.
+func (b *MessageBuilder) Contact(person, email string) *MessageBuilder {
+	b.message.Header.FromPerson = plainText(person)
+	b.message.Header.FromEmail = plainText(email)
+	return b
+}
+
+// Addressee sets company which message is sent to.
+func (b *MessageBuilder) Addressee(company string) *MessageBuilder {
+	b.message.Header.ToCompany = plainText(company)
+	return b
+}
+
+// SentDate sets date when message is sent.
+func (b *MessageBuilder) SentDate(t time.Time) *MessageBuilder {
+	b.message.Header.SentDate = PlainText{Body: t.Format("20060102")}
+	return b
+}
+
+// DefaultLanguage sets language of text in message by code of List 74.
+func (b *MessageBuilder) DefaultLanguage(code string) *MessageBuilder {
+	b.message.Header.DefaultLanguageOfText = &DefaultLanguageOfText{}
+	b.code(b.message.Header.DefaultLanguageOfText, code)
+	return b
+}
+
+// DefaultCurrency sets currency of prices in message by code of List 96.
+func (b *MessageBuilder) DefaultCurrency(code string) *MessageBuilder {
+	b.message.Header.DefaultCurrencyCode = &DefaultCurrencyCode{}
+	b.code(b.message.Header.DefaultCurrencyCode, code)
+	return b
+}
+
+// AddProduct adds product which p builds.
+func (b *MessageBuilder) AddProduct(p *ProductBuilder) *MessageBuilder {
+	b.products = append(b.products, p)
+	return b
+}
+
+// Build returns message which is ready to be marshaled.
+// It returns an error when any step has failed, or ValidationErrors when required elements are missing.
+func (b *MessageBuilder) Build() (*ONIXMessage, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	message := b.message
+	message.Products = nil
+	for _, p := range b.products {
+		if p.err != nil {
+			return nil, p.err
+		}
+		message.Products = append(message.Products, p.product)
+	}
+	if errs := Validate(&message); len(errs) > 0 {
+		return nil, ValidationErrors(errs)
+	}
+	return &message, nil
+}
+
+func (b *MessageBuilder) code(c xml.Unmarshaler, code string) {
+	if err := decodeCode(c, code); err != nil && b.err == nil {
+		b.err = err
+	}
+}
+
+// ProductBuilder builds product step by step.
+type ProductBuilder struct {
+	product Product
+	err     error
+}
+
+// NewProductBuilder returns ProductBuilder of product which is identified by recordReference.
+// The product is notified as confirmed on publication unless Notification is called.
+func NewProductBuilder(recordReference string) *ProductBuilder {
+	p := &ProductBuilder{product: Product{RecordReference: PlainText{Body: recordReference}}}
+	return p.Notification("03")
+}
+
+// Notification sets type of notification by code of List 1.
+func (p *ProductBuilder) Notification(code string) *ProductBuilder {
+	p.product.NotificationType = NotificationType{}
+	p.code(&p.product.NotificationType, code)
+	return p
+}
+
+// Identifier adds identifier of product whose type is code of List 5.
+func (p *ProductBuilder) Identifier(idType, value string) *ProductBuilder {
+	id := ProductIdentifier{IDValue: PlainText{Body: value}}
+	p.code(&id.ProductIDType, idType)
+	p.product.ProductIdentifiers = append(p.product.ProductIdentifiers, id)
+	return p
+}
+
+// ISBN13 adds ISBN-13 as identifier of product.
+func (p *ProductBuilder) ISBN13(isbn string) *ProductBuilder {
+	return p.Identifier("15", isbn)
+}
+
+// Form sets form of product by code of List 7.
+func (p *ProductBuilder) Form(code string) *ProductBuilder {
+	p.product.ProductForm = &ProductForm{}
+	p.code(p.product.ProductForm, code)
+	return p
+}
+
+// Title adds distinctive title of product.
+func (p *ProductBuilder) Title(text, subtitle string) *ProductBuilder {
+	title := Title{TitleText: plainText(text)}
+	if subtitle != "" {
+		title.Subtitle = plainText(subtitle)
+	}
+	p.code(&title.TitleType, "01")
+	p.product.Titles = append(p.product.Titles, title)
+	return p
+}
+
+// Contributor adds person who contributes to product in role of code of List 17.
+func (p *ProductBuilder) Contributor(role, name string) *ProductBuilder {
+	sequence := fmt.Sprint(len(p.product.Contributors) + 1)
+	contributor := Contributor{ContributorRole: &ContributorRole{}, PersonName: plainText(name), SequenceNumber: plainText(sequence)}
+	p.code(contributor.ContributorRole, role)
+	p.product.Contributors = append(p.product.Contributors, contributor)
+	return p
+}
+
+// Publisher adds publisher of product.
+func (p *ProductBuilder) Publisher(name string) *ProductBuilder {
+	publisher := Publisher{PublisherName: plainText(name), PublishingRole: &PublishingRole{}}
+	p.code(publisher.PublishingRole, "01")
+	p.product.Publishers = append(p.product.Publishers, publisher)
+	return p
+}
+
+// PublicationDate sets date when product is published.
+func (p *ProductBuilder) PublicationDate(t time.Time) *ProductBuilder {
+	p.product.PublicationDate = plainText(t.Format("20060102"))
+	return p
+}
+
+// Build returns product.
+// It returns an error when any step has failed, or ValidationErrors when required elements are missing.
+func (p *ProductBuilder) Build() (Product, error) {
+	if p.err != nil {
+		return Product{}, p.err
+	}
+	var errs []ValidationError
+	validate(reflect.ValueOf(&p.product).Elem(), "/Product", &errs)
+	if len(errs) > 0 {
+		return Product{}, ValidationErrors(errs)
+	}
+	return p.product, nil
+}
+
+func (p *ProductBuilder) code(c xml.Unmarshaler, code string) {
+	if err := decodeCode(c, code); err != nil && p.err == nil {
+		p.err = err
+	}
+}
+
+func plainText(s string) *PlainText {
+	return &PlainText{Body: s}
+}
@@ -0,0 +1,112 @@
+package codelists
+
+// listNumbers are numbers of List which code types of the model are defined as, by name of code type.
+var listNumbers = map[string]int{
+	"CountryCodeList": 91,
+	"TerritoryCodeList": 49,
+	"AddresseeIDType": 44,
+	"AudienceCode": 28,
+	"AudienceCodeType": 29,
+	"AudienceRangePrecision": 31,
+	"AudienceRangeQualifier": 30,
+	"AudienceRestrictionFlag": 56,
+	"AvailabilityCode": 54,
+	"Barcode": 6,
+	"BibleContents": 82,
+	"BiblePurpose": 85,
+	"BibleReferenceLocation": 87,
+	"BibleTextFeature": 97,
+	"BibleTextOrganization": 86,
+	"BibleVersion": 83,
+	"BookFormDetail": 8,
+	"ComplexitySchemeIdentifier": 32,
+	"ConferenceRole": 20,
+	"ConferenceSponsorIDType": 44,
+	"ContributorRole": 17,
+	"CopyrightOwnerIDType": 44,
+	"CountryCode": 91,
+	"CountryOfPublication": 91,
+	"CoverImageFormatCode": 36,
+	"CoverImageLinkTypeCode": 37,
+	"CurrencyCode": 96,
+	"DateFormat": 55,
+	"DefaultCurrencyCode": 96,
+	"DefaultLanguageOfText": 74,
+	"DefaultPriceTypeCode": 58,
+	"DiscountCodeType": 100,
+	"EditionTypeCode": 21,
+	"EpubFormat": 11,
+	"EpubSource": 11,
+	"EpubType": 10,
+	"ExtentType": 23,
+	"ExtentUnit": 24,
+	"IllustrationType": 25,
+	"LanguageCode": 74,
+	"LanguageOfText": 74,
+	"LanguageRole": 22,
+	"LocationIDType": 92,
+	"MainSubjectSchemeIdentifier": 26,
+	"MeasureTypeCode": 48,
+	"MeasureUnitCode": 50,
+	"MediaFileFormatCode": 39,
+	"MediaFileLinkTypeCode": 40,
+	"MediaFileTypeCode": 38,
+	"NameCodeType": 44,
+	"NotificationType": 1,
+	"OriginalLanguage": 74,
+	"PersonDateRole": 75,
+	"PersonNameIDType": 101,
+	"PersonNameType": 18,
+	"PricePer": 60,
+	"PriceQualifier": 59,
+	"PriceStatus": 61,
+	"PriceTypeCode": 58,
+	"PrizeCode": 41,
+	"PrizeCountry": 91,
+	"ProductAvailability": 65,
+	"ProductClassificationType": 9,
+	"ProductContentType": 81,
+	"ProductForm": 7,
+	"ProductFormDetail": 78,
+	"ProductFormFeatureType": 79,
+	"ProductIDType": 5,
+	"ProductPackaging": 80,
+	"PublishingRole": 45,
+	"PublishingStatus": 64,
+	"RecordSourceIdentifierType": 44,
+	"RecordSourceType": 3,
+	"RelationCode": 51,
+	"ReligiousTextFeatureCode": 90,
+	"ReligiousTextFeatureType": 89,
+	"ReturnsCodeType": 53,
+	"RightsRegion": 47,
+	"SalesOutletIDType": 102,
+	"SalesRestrictionType": 71,
+	"SalesRightsType": 46,
+	"SenderIDType": 44,
+	"SeriesIDType": 13,
+	"StockQuantityCodeType": 70,
+	"StudyBibleType": 84,
+	"SubjectSchemeIdentifier": 27,
+	"SupplierIDType": 92,
+	"SupplierRole": 93,
+	"TextCaseFlag": 14,
+	"TextFormat": 34,
+	"TextItemIDType": 43,
+	"TextItemType": 42,
+	"TextLinkType": 35,
+	"TextTypeCode": 33,
+	"ThesisType": 72,
+	"TitleType": 15,
+	"TradeCategory": 12,
+	"UnnamedPersons": 19,
+	"UnpricedItemType": 57,
+	"WebsiteRole": 73,
+	"WorkIDType": 16,
+}
+
+// ListOf returns number of List which code type of typeName is defined as, such as 5 for ProductIDType.
+func ListOf(typeName string) (int, bool) {
+	n, ok := listNumbers[typeName]
+	return n, ok
+}
@@ -0,0 +1,65 @@
+package onix
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Title returns distinctive title of series.
+// It falls back on TitleOfSeries element which is replaced by Title composite.
+func (s Series) Title() (string, bool) {
+	for _, t := range s.Titles {
+		if t.TitleType.Code() != "01" {
+			continue
+		}
+		if title, ok := titleOf(t.TitleText, t.TitlePrefix, t.TitleWithoutPrefix); ok {
+			return title, true
+		}
+	}
+	if s.TitleOfSeries != nil {
+		return s.TitleOfSeries.Body, true
+	}
+	return "", false
+}
+
+// Position returns sortable position of the product within series, which NumberWithinSeries tells.
+func (s Series) Position() (float64, bool) {
+	if s.NumberWithinSeries == nil {
+		return 0, false
+	}
+	return ParsePosition(s.NumberWithinSeries.Body)
+}
+
+var (
+	positionNumber = regexp.MustCompile(`[0-9]+(\.[0-9]+)?`)
+	positionRoman  = regexp.MustCompile(`(?i)^[IVXLCDM]+$`)
+	romanDigits    = map[rune]float64{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+)
+
+// ParsePosition parses number within series or collection such as 3, Vol. 3, 1.5 or Part IV into sortable value.
+// The first number in arabic numerals is taken, and a word of roman numerals is taken unless there is none.
+func ParsePosition(number string) (float64, bool) {
+	if n := positionNumber.FindString(number); n != "" {
+		position, err := strconv.ParseFloat(n, 64)
+		return position, err == nil
+	}
+	for _, word := range strings.FieldsFunc(number, func(r rune) bool { return r == ' ' || r == '.' || r == ',' }) {
+		if !positionRoman.MatchString(word) {
+			continue
+		}
+		word = strings.ToUpper(word)
+		var position, last float64
+		for i := len(word) - 1; i >= 0; i-- {
+			digit := romanDigits[rune(word[i])]
+			if digit < last {
+				position -= digit
+			} else {
+				position += digit
+				last = digit
+			}
+		}
+		return position, true
+	}
+	return 0, false
+}
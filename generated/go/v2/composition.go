@@ -0,0 +1,46 @@
+package onix
+
+import "strings"
+
+// ComponentRef is a reference to item which product consists of, such as a volume of box set or of mixed pack.
+type ComponentRef struct {
+	// ISBN is ISBN-13 of item, which is taken from its GTIN-13 of prefix 978 or 979 unless it is given.
+	ISBN string
+	// GTIN is GTIN-13 of item, which is empty unless it is given.
+	GTIN string
+	// Form is empty unless ProductForm is given.
+	Form ProductFormCode
+	// Quantity is number of copies of item in product, which is 1 unless it is given.
+	Quantity int64
+}
+
+// Component returns item as a ComponentRef.
+// GTIN falls back on EAN13 element which is replaced by ProductIdentifier composite.
+func (i ContainedItem) Component() ComponentRef {
+	c := ComponentRef{Quantity: 1}
+	c.ISBN, _ = identifierOf(i.ProductIdentifiers, "15")
+	c.GTIN, _ = identifierOf(i.ProductIdentifiers, "03")
+	if c.GTIN == "" && i.EAN13 != nil {
+		c.GTIN = i.EAN13.Body
+	}
+	c.ISBN, c.GTIN = strings.TrimSpace(c.ISBN), strings.TrimSpace(c.GTIN)
+	if c.ISBN == "" && (strings.HasPrefix(c.GTIN, "978") || strings.HasPrefix(c.GTIN, "979")) {
+		c.ISBN = c.GTIN
+	}
+	if i.ProductForm != nil {
+		c.Form = ProductFormCode(i.ProductForm.Code())
+	}
+	if quantity, ok := quantityOf(i.ItemQuantity); ok {
+		c.Quantity = quantity
+	}
+	return c
+}
+
+// Components returns items which product of multiple items such as box set or trade pack consists of.
+func (p Product) Components() []ComponentRef {
+	var components []ComponentRef
+	for _, i := range p.ContainedItems {
+		components = append(components, i.Component())
+	}
+	return components
+}
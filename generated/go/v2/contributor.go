@@ -0,0 +1,376 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ContributorRoleCode is code of List 17 which tells role of contributor, such as A01 for author.
+type ContributorRoleCode string
+
+const (
+	// ContributorRoleAuthor is author of textual work.
+	ContributorRoleAuthor ContributorRoleCode = "A01"
+	// ContributorRoleWith is the one with whom a work is written, such as ghost writer.
+	ContributorRoleWith ContributorRoleCode = "A02"
+	// ContributorRoleIllustrator is illustrator of textual work.
+	ContributorRoleIllustrator ContributorRoleCode = "A12"
+	// ContributorRoleEditor is editor of work.
+	ContributorRoleEditor ContributorRoleCode = "B01"
+	// ContributorRoleTranslator is translator of work.
+	ContributorRoleTranslator ContributorRoleCode = "B06"
+	// ContributorRoleReader is the one who reads work aloud, such as narrator of audiobook.
+	ContributorRoleReader ContributorRoleCode = "E07"
+)
+
+// UnnamedPersonsCode is code of List 19 which tells contributor without name, such as 03 for et al.
+type UnnamedPersonsCode string
+
+const (
+	// UnnamedPersonsUnknown is contributor who is unknown.
+	UnnamedPersonsUnknown UnnamedPersonsCode = "01"
+	// UnnamedPersonsAnonymous is contributor who is anonymous.
+	UnnamedPersonsAnonymous UnnamedPersonsCode = "02"
+	// UnnamedPersonsEtAl is contributors who follow the named ones, such as et al of an anthology.
+	UnnamedPersonsEtAl UnnamedPersonsCode = "03"
+	// UnnamedPersonsVarious is various contributors, such as authors of an anthology.
+	UnnamedPersonsVarious UnnamedPersonsCode = "04"
+	// UnnamedPersonsSynthesizedVoiceMale is male synthesized voice which reads audiobook.
+	UnnamedPersonsSynthesizedVoiceMale UnnamedPersonsCode = "05"
+	// UnnamedPersonsSynthesizedVoiceFemale is female synthesized voice which reads audiobook.
+	UnnamedPersonsSynthesizedVoiceFemale UnnamedPersonsCode = "06"
+	// UnnamedPersonsSynthesizedVoice is synthesized voice of unspecified gender which reads audiobook.
+	UnnamedPersonsSynthesizedVoice UnnamedPersonsCode = "07"
+)
+
+// IsSynthesizedVoice reports whether code tells voice which is synthesized rather than read by a person.
+func (c UnnamedPersonsCode) IsSynthesizedVoice() bool {
+	return c == UnnamedPersonsSynthesizedVoiceMale || c == UnnamedPersonsSynthesizedVoiceFemale || c == UnnamedPersonsSynthesizedVoice
+}
+
+// IsMultiple reports whether code tells more contributors than the named ones, such as et al or various.
+func (c UnnamedPersonsCode) IsMultiple() bool {
+	return c == UnnamedPersonsEtAl || c == UnnamedPersonsVarious
+}
+
+// PersonNameTypeCode is code of List 18 which tells type of alternative name of person, such as 01 for pseudonym.
+type PersonNameTypeCode string
+
+const (
+	// PersonNameTypeUnspecified is name of unspecified type.
+	PersonNameTypeUnspecified PersonNameTypeCode = "00"
+	// PersonNameTypePseudonym is pseudonym.
+	PersonNameTypePseudonym PersonNameTypeCode = "01"
+	// PersonNameTypeAuthorityControlled is name which an authority such as a library controls.
+	PersonNameTypeAuthorityControlled PersonNameTypeCode = "02"
+	// PersonNameTypeEarlier is name which person has used earlier.
+	PersonNameTypeEarlier PersonNameTypeCode = "03"
+	// PersonNameTypeReal is real name of person who writes under pseudonym.
+	PersonNameTypeReal PersonNameTypeCode = "04"
+	// PersonNameTypeTransliterated is transliterated form of primary name.
+	PersonNameTypeTransliterated PersonNameTypeCode = "05"
+	// PersonNameTypeLater is name which person has used later.
+	PersonNameTypeLater PersonNameTypeCode = "06"
+)
+
+// AlternativeName is a name of contributor other than the one which credits it, such as real name of pseudonymous author.
+type AlternativeName struct {
+	Type     PersonNameTypeCode
+	Name     string
+	SortName string
+}
+
+// Link is a website of contributor, such as its own website or blog.
+type Link struct {
+	// Role is a code of List 73 such as 06 for contributor's own website, which is empty unless it is given.
+	Role        string
+	URL         string
+	Description string
+}
+
+// Credit is a person or a corporate body credited for product, whose roles are merged from contributors of the same name.
+type Credit struct {
+	Name     string
+	SortName string
+	Roles    []ContributorRoleCode
+	// Corporate is whether the credit is of a corporate body rather than a person.
+	Corporate bool
+}
+
+// Has reports whether credit has role.
+func (c Credit) Has(role ContributorRoleCode) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Role returns role of contributor.
+func (c Contributor) Role() (ContributorRoleCode, bool) {
+	if c.ContributorRole == nil {
+		return "", false
+	}
+	return ContributorRoleCode(c.ContributorRole.Code()), true
+}
+
+// IsAuthor reports whether contributor is author of textual work.
+func (c Contributor) IsAuthor() bool {
+	role, ok := c.Role()
+	return ok && role == ContributorRoleAuthor
+}
+
+// IsTranslator reports whether contributor is translator of work.
+func (c Contributor) IsTranslator() bool {
+	role, ok := c.Role()
+	return ok && role == ContributorRoleTranslator
+}
+
+// Unnamed returns code of List 19 of contributor without name, which is absent if contributor has no UnnamedPersons.
+func (c Contributor) Unnamed() (UnnamedPersonsCode, bool) {
+	if c.UnnamedPersons == nil {
+		return "", false
+	}
+	return UnnamedPersonsCode(c.UnnamedPersons.Code()), true
+}
+
+// IsCorporate reports whether contributor is a corporate body, which has CorporateName and no name of person.
+func (c Contributor) IsCorporate() bool {
+	return c.CorporateName != nil && c.PersonName == nil && c.PersonNameInverted == nil && c.KeyNames == nil
+}
+
+// DisplayName returns name of contributor in the order it is written, such as Ludwig van Beethoven.
+// It prefers PersonName, and composes the name of its parts such as KeyNames unless it is present.
+func (c Contributor) DisplayName() (string, bool) {
+	if c.PersonName != nil {
+		return c.PersonName.Body, true
+	}
+	if c.KeyNames != nil {
+		return joinNames(c.TitlesBeforeNames, c.NamesBeforeKey, c.PrefixToKey, c.KeyNames, c.NamesAfterKey, c.SuffixToKey), true
+	}
+	if c.PersonNameInverted != nil {
+		return c.PersonNameInverted.Body, true
+	}
+	if c.CorporateName != nil {
+		return c.CorporateName.Body, true
+	}
+	return "", false
+}
+
+// SortName returns name of contributor in the order it is sorted, such as Beethoven, Ludwig van.
+// It prefers PersonNameInverted, and composes the name of its parts such as KeyNames unless it is present.
+// PersonName alone is inverted at its last space, and corporate name is returned as it is.
+func (c Contributor) SortName() (string, bool) {
+	if c.PersonNameInverted != nil {
+		return c.PersonNameInverted.Body, true
+	}
+	if c.KeyNames != nil {
+		name := joinNames(c.KeyNames, c.NamesAfterKey)
+		if before := joinNames(c.NamesBeforeKey, c.PrefixToKey); before != "" {
+			name += ", " + before
+		}
+		if c.SuffixToKey != nil {
+			name += ", " + c.SuffixToKey.Body
+		}
+		return name, true
+	}
+	if c.PersonName != nil {
+		name := strings.TrimSpace(c.PersonName.Body)
+		if i := strings.LastIndexByte(name, ' '); i >= 0 {
+			return name[i+1:] + ", " + strings.TrimSpace(name[:i]), true
+		}
+		return name, true
+	}
+	if c.CorporateName != nil {
+		return c.CorporateName.Body, true
+	}
+	return "", false
+}
+
+// Bio returns BiographicalNote of contributor as it is written, which may be HTML that package texts sanitizes.
+func (c Contributor) Bio() (string, bool) {
+	if c.BiographicalNote == nil {
+		return "", false
+	}
+	bio := strings.TrimSpace(string(*c.BiographicalNote))
+	return bio, bio != ""
+}
+
+// Links returns Website composites of contributor whose links are given, which is named so since Websites is the field itself.
+func (c Contributor) Links() []Link {
+	var links []Link
+	for _, w := range c.Websites {
+		url := strings.TrimSpace(w.WebsiteLink.Body)
+		if url == "" {
+			continue
+		}
+		link := Link{URL: url}
+		if w.WebsiteRole != nil {
+			link.Role = w.WebsiteRole.Code()
+		}
+		if w.WebsiteDescription != nil {
+			link.Description = strings.TrimSpace(string(*w.WebsiteDescription))
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// Born returns date of birth of contributor, which is typically a year.
+func (c Contributor) Born() (Date, bool) {
+	return c.dateOf(PersonDateRoleBirth)
+}
+
+// Died returns date of death of contributor, which is typically a year.
+func (c Contributor) Died() (Date, bool) {
+	return c.dateOf(PersonDateRoleDeath)
+}
+
+// Lifespan returns years of birth and death of contributor as authority headings write, such as 1835-1910 or 1947- of a living person.
+func (c Contributor) Lifespan() (string, bool) {
+	born, hasBorn := c.Born()
+	died, hasDied := c.Died()
+	return lifespan(born, hasBorn, died, hasDied)
+}
+
+func (c Contributor) dateOf(role string) (Date, bool) {
+	for _, d := range c.PersonDates {
+		if d.PersonDateRole.Code() == role {
+			return d.Value()
+		}
+	}
+	return Date{}, false
+}
+
+// AlternativeNames returns Name composites of contributor, whose names are composed as DisplayName and SortName do.
+func (c Contributor) AlternativeNames() []AlternativeName {
+	names := make([]AlternativeName, 0, len(c.Names))
+	for _, n := range c.Names {
+		alternative := Contributor{
+			PersonName:         n.PersonName,
+			PersonNameInverted: n.PersonNameInverted,
+			TitlesBeforeNames:  n.TitlesBeforeNames,
+			NamesBeforeKey:     n.NamesBeforeKey,
+			PrefixToKey:        n.PrefixToKey,
+			KeyNames:           n.KeyNames,
+			NamesAfterKey:      n.NamesAfterKey,
+			SuffixToKey:        n.SuffixToKey,
+		}
+		name, ok := alternative.DisplayName()
+		if !ok {
+			continue
+		}
+		sortName, _ := alternative.SortName()
+		names = append(names, AlternativeName{Type: PersonNameTypeCode(n.PersonNameType.Code()), Name: name, SortName: sortName})
+	}
+	return names
+}
+
+// MergeContributors merges roles of contributors who have the same name, in order of their sequence numbers.
+// Contributors without name, such as UnnamedPersons, are skipped.
+func MergeContributors(contributors []Contributor) []Credit {
+	var credits []Credit
+	index := map[string]int{}
+	for _, c := range sortedContributors(contributors) {
+		name, ok := c.DisplayName()
+		if !ok {
+			continue
+		}
+		i, ok := index[name]
+		if !ok {
+			sortName, _ := c.SortName()
+			i = len(credits)
+			index[name] = i
+			credits = append(credits, Credit{Name: name, SortName: sortName, Corporate: c.IsCorporate()})
+		}
+		if role, ok := c.Role(); ok && !credits[i].Has(role) {
+			credits[i].Roles = append(credits[i].Roles, role)
+		}
+	}
+	return credits
+}
+
+// Credits returns contributors of product whose roles are merged by MergeContributors.
+func (p Product) Credits() []Credit {
+	return MergeContributors(p.Contributors)
+}
+
+// Unnamed is a contributor without name, which Credits skips.
+type Unnamed struct {
+	Persons UnnamedPersonsCode
+	// Role is role of the contributor, which is empty if ContributorRole is absent.
+	Role ContributorRoleCode
+}
+
+// UnnamedContributors returns contributors of product which have UnnamedPersons, in order of their sequence numbers.
+func (p Product) UnnamedContributors() []Unnamed {
+	var unnamed []Unnamed
+	for _, c := range sortedContributors(p.Contributors) {
+		persons, ok := c.Unnamed()
+		if !ok {
+			continue
+		}
+		role, _ := c.Role()
+		unnamed = append(unnamed, Unnamed{Persons: persons, Role: role})
+	}
+	return unnamed
+}
+
+// IsReadBySynthesizedVoice reports whether product, such as audiobook, is read by a synthesized voice rather than a person.
+func (p Product) IsReadBySynthesizedVoice() bool {
+	for _, u := range p.UnnamedContributors() {
+		if u.Persons.IsSynthesizedVoice() && (u.Role == "" || u.Role == ContributorRoleReader) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMoreContributors reports whether product tells more contributors than the named ones, such as et al of an anthology.
+func (p Product) HasMoreContributors() bool {
+	for _, u := range p.UnnamedContributors() {
+		if u.Persons.IsMultiple() {
+			return true
+		}
+	}
+	return false
+}
+
+// lifespan joins years of birth and death which are present.
+func lifespan(born Date, hasBorn bool, died Date, hasDied bool) (string, bool) {
+	if !hasBorn && !hasDied {
+		return "", false
+	}
+	span := ""
+	if hasBorn {
+		span = strconv.Itoa(born.Time.Year())
+	}
+	span += "-"
+	if hasDied {
+		span += strconv.Itoa(died.Time.Year())
+	}
+	return span, true
+}
+
+// sortedContributors returns copy of contributors which is stably sorted by their sequence numbers.
+func sortedContributors(contributors []Contributor) []Contributor {
+	sorted := make([]Contributor, len(contributors))
+	copy(sorted, contributors)
+	for i := 1; i < len(sorted); i++ {
+		for j := i; j > 0 && sequenceOf(sorted[j]) < sequenceOf(sorted[j-1]); j-- {
+			sorted[j], sorted[j-1] = sorted[j-1], sorted[j]
+		}
+	}
+	return sorted
+}
+
+func joinNames(names ...*PlainText) string {
+	parts := []string{}
+	for _, n := range names {
+		if n != nil && n.Body != "" {
+			parts = append(parts, n.Body)
+		}
+	}
+	return strings.Join(parts, " ")
+}
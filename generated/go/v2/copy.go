@@ -0,0 +1,9138 @@
+package onix
+
+// Clone returns a deep copy of product, which shares no pointers, slices nor extensions with product,
+// so that either of them can be mutated without the other being changed.
+func (p Product) Clone() Product {
+	return p.clone()
+}
+
+// Equal reports whether product has the same elements and attributes as other, without reflection.
+// Datestamp attributes are ignored since they tell when the record has been updated rather than what it tells,
+// and repeated elements are compared by their positions. Nil other is not equal to any product.
+func (p Product) Equal(other *Product) bool {
+	return other != nil && p.equal(*other)
+}
+
+// equalStrings reports whether codes which are separated by spaces are the same ones in the same order.
+func equalStrings(x, y []string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (x AddresseeIdentifier) clone() AddresseeIdentifier {
+	c := x
+	c.AddresseeIDType = x.AddresseeIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AddresseeIdentifier) equal(y AddresseeIdentifier) bool {
+	if !x.AddresseeIDType.equal(y.AddresseeIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AgentIdentifier) clone() AgentIdentifier {
+	c := x
+	c.AgentIDType = x.AgentIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AgentIdentifier) equal(y AgentIdentifier) bool {
+	if !x.AgentIDType.equal(y.AgentIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Audience) clone() Audience {
+	c := x
+	c.AudienceCodeType = x.AudienceCodeType.clone()
+	if x.AudienceCodeTypeName != nil {
+		v := x.AudienceCodeTypeName.clone()
+		c.AudienceCodeTypeName = &v
+	}
+	c.AudienceCodeValue = x.AudienceCodeValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Audience) equal(y Audience) bool {
+	if !x.AudienceCodeType.equal(y.AudienceCodeType) {
+		return false
+	}
+	if (x.AudienceCodeTypeName == nil) != (y.AudienceCodeTypeName == nil) || (x.AudienceCodeTypeName != nil && !x.AudienceCodeTypeName.equal(*y.AudienceCodeTypeName)) {
+		return false
+	}
+	if !x.AudienceCodeValue.equal(y.AudienceCodeValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AudienceRange) clone() AudienceRange {
+	c := x
+	c.AudienceRangeQualifier = x.AudienceRangeQualifier.clone()
+	if x.AudienceRangePrecision != nil {
+		v := x.AudienceRangePrecision.clone()
+		c.AudienceRangePrecision = &v
+	}
+	if x.AudienceRangeValue != nil {
+		v := x.AudienceRangeValue.clone()
+		c.AudienceRangeValue = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AudienceRange) equal(y AudienceRange) bool {
+	if !x.AudienceRangeQualifier.equal(y.AudienceRangeQualifier) {
+		return false
+	}
+	if (x.AudienceRangePrecision == nil) != (y.AudienceRangePrecision == nil) || (x.AudienceRangePrecision != nil && !x.AudienceRangePrecision.equal(*y.AudienceRangePrecision)) {
+		return false
+	}
+	if (x.AudienceRangeValue == nil) != (y.AudienceRangeValue == nil) || (x.AudienceRangeValue != nil && !x.AudienceRangeValue.equal(*y.AudienceRangeValue)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x BatchBonus) clone() BatchBonus {
+	c := x
+	c.BatchQuantity = x.BatchQuantity.clone()
+	c.FreeQuantity = x.FreeQuantity.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x BatchBonus) equal(y BatchBonus) bool {
+	if !x.BatchQuantity.equal(y.BatchQuantity) {
+		return false
+	}
+	if !x.FreeQuantity.equal(y.FreeQuantity) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Bible) clone() Bible {
+	c := x
+	if x.BibleContentss != nil {
+		c.BibleContentss = make([]BibleContents, len(x.BibleContentss))
+		for i, v := range x.BibleContentss {
+			c.BibleContentss[i] = v.clone()
+		}
+	}
+	if x.BibleVersions != nil {
+		c.BibleVersions = make([]BibleVersion, len(x.BibleVersions))
+		for i, v := range x.BibleVersions {
+			c.BibleVersions[i] = v.clone()
+		}
+	}
+	if x.StudyBibleType != nil {
+		v := x.StudyBibleType.clone()
+		c.StudyBibleType = &v
+	}
+	if x.BiblePurposes != nil {
+		c.BiblePurposes = make([]BiblePurpose, len(x.BiblePurposes))
+		for i, v := range x.BiblePurposes {
+			c.BiblePurposes[i] = v.clone()
+		}
+	}
+	if x.BibleTextOrganization != nil {
+		v := x.BibleTextOrganization.clone()
+		c.BibleTextOrganization = &v
+	}
+	if x.BibleReferenceLocation != nil {
+		v := x.BibleReferenceLocation.clone()
+		c.BibleReferenceLocation = &v
+	}
+	if x.BibleTextFeatures != nil {
+		c.BibleTextFeatures = make([]BibleTextFeature, len(x.BibleTextFeatures))
+		for i, v := range x.BibleTextFeatures {
+			c.BibleTextFeatures[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Bible) equal(y Bible) bool {
+	if len(x.BibleContentss) != len(y.BibleContentss) {
+		return false
+	}
+	for i := range x.BibleContentss {
+		if !x.BibleContentss[i].equal(y.BibleContentss[i]) {
+			return false
+		}
+	}
+	if len(x.BibleVersions) != len(y.BibleVersions) {
+		return false
+	}
+	for i := range x.BibleVersions {
+		if !x.BibleVersions[i].equal(y.BibleVersions[i]) {
+			return false
+		}
+	}
+	if (x.StudyBibleType == nil) != (y.StudyBibleType == nil) || (x.StudyBibleType != nil && !x.StudyBibleType.equal(*y.StudyBibleType)) {
+		return false
+	}
+	if len(x.BiblePurposes) != len(y.BiblePurposes) {
+		return false
+	}
+	for i := range x.BiblePurposes {
+		if !x.BiblePurposes[i].equal(y.BiblePurposes[i]) {
+			return false
+		}
+	}
+	if (x.BibleTextOrganization == nil) != (y.BibleTextOrganization == nil) || (x.BibleTextOrganization != nil && !x.BibleTextOrganization.equal(*y.BibleTextOrganization)) {
+		return false
+	}
+	if (x.BibleReferenceLocation == nil) != (y.BibleReferenceLocation == nil) || (x.BibleReferenceLocation != nil && !x.BibleReferenceLocation.equal(*y.BibleReferenceLocation)) {
+		return false
+	}
+	if len(x.BibleTextFeatures) != len(y.BibleTextFeatures) {
+		return false
+	}
+	for i := range x.BibleTextFeatures {
+		if !x.BibleTextFeatures[i].equal(y.BibleTextFeatures[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Complexity) clone() Complexity {
+	c := x
+	c.ComplexitySchemeIdentifier = x.ComplexitySchemeIdentifier.clone()
+	c.ComplexityCode = x.ComplexityCode.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Complexity) equal(y Complexity) bool {
+	if !x.ComplexitySchemeIdentifier.equal(y.ComplexitySchemeIdentifier) {
+		return false
+	}
+	if !x.ComplexityCode.equal(y.ComplexityCode) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Conference) clone() Conference {
+	c := x
+	if x.ConferenceRole != nil {
+		v := x.ConferenceRole.clone()
+		c.ConferenceRole = &v
+	}
+	c.ConferenceName = x.ConferenceName.clone()
+	if x.ConferenceAcronym != nil {
+		v := x.ConferenceAcronym.clone()
+		c.ConferenceAcronym = &v
+	}
+	if x.ConferenceNumber != nil {
+		v := x.ConferenceNumber.clone()
+		c.ConferenceNumber = &v
+	}
+	if x.ConferenceTheme != nil {
+		v := x.ConferenceTheme.clone()
+		c.ConferenceTheme = &v
+	}
+	if x.ConferenceDate != nil {
+		v := x.ConferenceDate.clone()
+		c.ConferenceDate = &v
+	}
+	if x.ConferencePlace != nil {
+		v := x.ConferencePlace.clone()
+		c.ConferencePlace = &v
+	}
+	if x.ConferenceSponsors != nil {
+		c.ConferenceSponsors = make([]ConferenceSponsor, len(x.ConferenceSponsors))
+		for i, v := range x.ConferenceSponsors {
+			c.ConferenceSponsors[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Conference) equal(y Conference) bool {
+	if (x.ConferenceRole == nil) != (y.ConferenceRole == nil) || (x.ConferenceRole != nil && !x.ConferenceRole.equal(*y.ConferenceRole)) {
+		return false
+	}
+	if !x.ConferenceName.equal(y.ConferenceName) {
+		return false
+	}
+	if (x.ConferenceAcronym == nil) != (y.ConferenceAcronym == nil) || (x.ConferenceAcronym != nil && !x.ConferenceAcronym.equal(*y.ConferenceAcronym)) {
+		return false
+	}
+	if (x.ConferenceNumber == nil) != (y.ConferenceNumber == nil) || (x.ConferenceNumber != nil && !x.ConferenceNumber.equal(*y.ConferenceNumber)) {
+		return false
+	}
+	if (x.ConferenceTheme == nil) != (y.ConferenceTheme == nil) || (x.ConferenceTheme != nil && !x.ConferenceTheme.equal(*y.ConferenceTheme)) {
+		return false
+	}
+	if (x.ConferenceDate == nil) != (y.ConferenceDate == nil) || (x.ConferenceDate != nil && !x.ConferenceDate.equal(*y.ConferenceDate)) {
+		return false
+	}
+	if (x.ConferencePlace == nil) != (y.ConferencePlace == nil) || (x.ConferencePlace != nil && !x.ConferencePlace.equal(*y.ConferencePlace)) {
+		return false
+	}
+	if len(x.ConferenceSponsors) != len(y.ConferenceSponsors) {
+		return false
+	}
+	for i := range x.ConferenceSponsors {
+		if !x.ConferenceSponsors[i].equal(y.ConferenceSponsors[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ConferenceSponsor) clone() ConferenceSponsor {
+	c := x
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.ConferenceSponsorIdentifier != nil {
+		v := x.ConferenceSponsorIdentifier.clone()
+		c.ConferenceSponsorIdentifier = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ConferenceSponsor) equal(y ConferenceSponsor) bool {
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.ConferenceSponsorIdentifier == nil) != (y.ConferenceSponsorIdentifier == nil) || (x.ConferenceSponsorIdentifier != nil && !x.ConferenceSponsorIdentifier.equal(*y.ConferenceSponsorIdentifier)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ConferenceSponsorIdentifier) clone() ConferenceSponsorIdentifier {
+	c := x
+	c.ConferenceSponsorIDType = x.ConferenceSponsorIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ConferenceSponsorIdentifier) equal(y ConferenceSponsorIdentifier) bool {
+	if !x.ConferenceSponsorIDType.equal(y.ConferenceSponsorIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContainedItem) clone() ContainedItem {
+	c := x
+	if x.ISBN != nil {
+		v := x.ISBN.clone()
+		c.ISBN = &v
+	}
+	if x.EAN13 != nil {
+		v := x.EAN13.clone()
+		c.EAN13 = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ProductForm != nil {
+		v := x.ProductForm.clone()
+		c.ProductForm = &v
+	}
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductFormFeatures != nil {
+		c.ProductFormFeatures = make([]ProductFormFeature, len(x.ProductFormFeatures))
+		for i, v := range x.ProductFormFeatures {
+			c.ProductFormFeatures[i] = v.clone()
+		}
+	}
+	if x.BookFormDetails != nil {
+		c.BookFormDetails = make([]BookFormDetail, len(x.BookFormDetails))
+		for i, v := range x.BookFormDetails {
+			c.BookFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductPackaging != nil {
+		v := x.ProductPackaging.clone()
+		c.ProductPackaging = &v
+	}
+	if x.ProductFormDescription != nil {
+		v := x.ProductFormDescription.clone()
+		c.ProductFormDescription = &v
+	}
+	if x.NumberOfPieces != nil {
+		v := x.NumberOfPieces.clone()
+		c.NumberOfPieces = &v
+	}
+	if x.TradeCategory != nil {
+		v := x.TradeCategory.clone()
+		c.TradeCategory = &v
+	}
+	if x.ProductContentTypes != nil {
+		c.ProductContentTypes = make([]ProductContentType, len(x.ProductContentTypes))
+		for i, v := range x.ProductContentTypes {
+			c.ProductContentTypes[i] = v.clone()
+		}
+	}
+	if x.ItemQuantity != nil {
+		v := x.ItemQuantity.clone()
+		c.ItemQuantity = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContainedItem) equal(y ContainedItem) bool {
+	if (x.ISBN == nil) != (y.ISBN == nil) || (x.ISBN != nil && !x.ISBN.equal(*y.ISBN)) {
+		return false
+	}
+	if (x.EAN13 == nil) != (y.EAN13 == nil) || (x.EAN13 != nil && !x.EAN13.equal(*y.EAN13)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ProductForm == nil) != (y.ProductForm == nil) || (x.ProductForm != nil && !x.ProductForm.equal(*y.ProductForm)) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if len(x.ProductFormFeatures) != len(y.ProductFormFeatures) {
+		return false
+	}
+	for i := range x.ProductFormFeatures {
+		if !x.ProductFormFeatures[i].equal(y.ProductFormFeatures[i]) {
+			return false
+		}
+	}
+	if len(x.BookFormDetails) != len(y.BookFormDetails) {
+		return false
+	}
+	for i := range x.BookFormDetails {
+		if !x.BookFormDetails[i].equal(y.BookFormDetails[i]) {
+			return false
+		}
+	}
+	if (x.ProductPackaging == nil) != (y.ProductPackaging == nil) || (x.ProductPackaging != nil && !x.ProductPackaging.equal(*y.ProductPackaging)) {
+		return false
+	}
+	if (x.ProductFormDescription == nil) != (y.ProductFormDescription == nil) || (x.ProductFormDescription != nil && !x.ProductFormDescription.equal(*y.ProductFormDescription)) {
+		return false
+	}
+	if (x.NumberOfPieces == nil) != (y.NumberOfPieces == nil) || (x.NumberOfPieces != nil && !x.NumberOfPieces.equal(*y.NumberOfPieces)) {
+		return false
+	}
+	if (x.TradeCategory == nil) != (y.TradeCategory == nil) || (x.TradeCategory != nil && !x.TradeCategory.equal(*y.TradeCategory)) {
+		return false
+	}
+	if len(x.ProductContentTypes) != len(y.ProductContentTypes) {
+		return false
+	}
+	for i := range x.ProductContentTypes {
+		if !x.ProductContentTypes[i].equal(y.ProductContentTypes[i]) {
+			return false
+		}
+	}
+	if (x.ItemQuantity == nil) != (y.ItemQuantity == nil) || (x.ItemQuantity != nil && !x.ItemQuantity.equal(*y.ItemQuantity)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContentItem) clone() ContentItem {
+	c := x
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.ComponentTypeName != nil {
+		v := x.ComponentTypeName.clone()
+		c.ComponentTypeName = &v
+	}
+	if x.ComponentNumber != nil {
+		v := x.ComponentNumber.clone()
+		c.ComponentNumber = &v
+	}
+	if x.DistinctiveTitle != nil {
+		v := x.DistinctiveTitle.clone()
+		c.DistinctiveTitle = &v
+	}
+	if x.LevelSequenceNumber != nil {
+		v := x.LevelSequenceNumber.clone()
+		c.LevelSequenceNumber = &v
+	}
+	c.TextItem = x.TextItem.clone()
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.WorkIdentifiers != nil {
+		c.WorkIdentifiers = make([]WorkIdentifier, len(x.WorkIdentifiers))
+		for i, v := range x.WorkIdentifiers {
+			c.WorkIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Subjects != nil {
+		c.Subjects = make([]Subject, len(x.Subjects))
+		for i, v := range x.Subjects {
+			c.Subjects[i] = v.clone()
+		}
+	}
+	if x.PersonAsSubjects != nil {
+		c.PersonAsSubjects = make([]PersonAsSubject, len(x.PersonAsSubjects))
+		for i, v := range x.PersonAsSubjects {
+			c.PersonAsSubjects[i] = v.clone()
+		}
+	}
+	if x.CorporateBodyAsSubjects != nil {
+		c.CorporateBodyAsSubjects = make([]PlainText, len(x.CorporateBodyAsSubjects))
+		for i, v := range x.CorporateBodyAsSubjects {
+			c.CorporateBodyAsSubjects[i] = v.clone()
+		}
+	}
+	if x.PlaceAsSubjects != nil {
+		c.PlaceAsSubjects = make([]PlainText, len(x.PlaceAsSubjects))
+		for i, v := range x.PlaceAsSubjects {
+			c.PlaceAsSubjects[i] = v.clone()
+		}
+	}
+	if x.OtherTexts != nil {
+		c.OtherTexts = make([]OtherText, len(x.OtherTexts))
+		for i, v := range x.OtherTexts {
+			c.OtherTexts[i] = v.clone()
+		}
+	}
+	if x.MediaFiles != nil {
+		c.MediaFiles = make([]MediaFile, len(x.MediaFiles))
+		for i, v := range x.MediaFiles {
+			c.MediaFiles[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorStatement != nil {
+		v := x.ContributorStatement.clone()
+		c.ContributorStatement = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContentItem) equal(y ContentItem) bool {
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if (x.ComponentTypeName == nil) != (y.ComponentTypeName == nil) || (x.ComponentTypeName != nil && !x.ComponentTypeName.equal(*y.ComponentTypeName)) {
+		return false
+	}
+	if (x.ComponentNumber == nil) != (y.ComponentNumber == nil) || (x.ComponentNumber != nil && !x.ComponentNumber.equal(*y.ComponentNumber)) {
+		return false
+	}
+	if (x.DistinctiveTitle == nil) != (y.DistinctiveTitle == nil) || (x.DistinctiveTitle != nil && !x.DistinctiveTitle.equal(*y.DistinctiveTitle)) {
+		return false
+	}
+	if (x.LevelSequenceNumber == nil) != (y.LevelSequenceNumber == nil) || (x.LevelSequenceNumber != nil && !x.LevelSequenceNumber.equal(*y.LevelSequenceNumber)) {
+		return false
+	}
+	if !x.TextItem.equal(y.TextItem) {
+		return false
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if len(x.WorkIdentifiers) != len(y.WorkIdentifiers) {
+		return false
+	}
+	for i := range x.WorkIdentifiers {
+		if !x.WorkIdentifiers[i].equal(y.WorkIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Subjects) != len(y.Subjects) {
+		return false
+	}
+	for i := range x.Subjects {
+		if !x.Subjects[i].equal(y.Subjects[i]) {
+			return false
+		}
+	}
+	if len(x.PersonAsSubjects) != len(y.PersonAsSubjects) {
+		return false
+	}
+	for i := range x.PersonAsSubjects {
+		if !x.PersonAsSubjects[i].equal(y.PersonAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.CorporateBodyAsSubjects) != len(y.CorporateBodyAsSubjects) {
+		return false
+	}
+	for i := range x.CorporateBodyAsSubjects {
+		if !x.CorporateBodyAsSubjects[i].equal(y.CorporateBodyAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.PlaceAsSubjects) != len(y.PlaceAsSubjects) {
+		return false
+	}
+	for i := range x.PlaceAsSubjects {
+		if !x.PlaceAsSubjects[i].equal(y.PlaceAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.OtherTexts) != len(y.OtherTexts) {
+		return false
+	}
+	for i := range x.OtherTexts {
+		if !x.OtherTexts[i].equal(y.OtherTexts[i]) {
+			return false
+		}
+	}
+	if len(x.MediaFiles) != len(y.MediaFiles) {
+		return false
+	}
+	for i := range x.MediaFiles {
+		if !x.MediaFiles[i].equal(y.MediaFiles[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if (x.ContributorStatement == nil) != (y.ContributorStatement == nil) || (x.ContributorStatement != nil && !x.ContributorStatement.equal(*y.ContributorStatement)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Contributor) clone() Contributor {
+	c := x
+	if x.SequenceNumberWithinRole != nil {
+		v := x.SequenceNumberWithinRole.clone()
+		c.SequenceNumberWithinRole = &v
+	}
+	if x.ContributorRole != nil {
+		v := x.ContributorRole.clone()
+		c.ContributorRole = &v
+	}
+	if x.LanguageCodes != nil {
+		c.LanguageCodes = make([]LanguageCode, len(x.LanguageCodes))
+		for i, v := range x.LanguageCodes {
+			c.LanguageCodes[i] = v.clone()
+		}
+	}
+	if x.UnnamedPersons != nil {
+		v := x.UnnamedPersons.clone()
+		c.UnnamedPersons = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.PersonNameIdentifiers != nil {
+		c.PersonNameIdentifiers = make([]PersonNameIdentifier, len(x.PersonNameIdentifiers))
+		for i, v := range x.PersonNameIdentifiers {
+			c.PersonNameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.Names != nil {
+		c.Names = make([]Name, len(x.Names))
+		for i, v := range x.Names {
+			c.Names[i] = v.clone()
+		}
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	if x.PersonDates != nil {
+		c.PersonDates = make([]PersonDate, len(x.PersonDates))
+		for i, v := range x.PersonDates {
+			c.PersonDates[i] = v.clone()
+		}
+	}
+	if x.ProfessionalAffiliations != nil {
+		c.ProfessionalAffiliations = make([]ProfessionalAffiliation, len(x.ProfessionalAffiliations))
+		for i, v := range x.ProfessionalAffiliations {
+			c.ProfessionalAffiliations[i] = v.clone()
+		}
+	}
+	if x.BiographicalNote != nil {
+		v := x.BiographicalNote.clone()
+		c.BiographicalNote = &v
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.ProfessionalPosition != nil {
+		v := x.ProfessionalPosition.clone()
+		c.ProfessionalPosition = &v
+	}
+	if x.Affiliation != nil {
+		v := x.Affiliation.clone()
+		c.Affiliation = &v
+	}
+	if x.ContributorDescription != nil {
+		v := x.ContributorDescription.clone()
+		c.ContributorDescription = &v
+	}
+	if x.SequenceNumber != nil {
+		v := x.SequenceNumber.clone()
+		c.SequenceNumber = &v
+	}
+	if x.CountryCodes != nil {
+		c.CountryCodes = make([]CountryCode, len(x.CountryCodes))
+		for i, v := range x.CountryCodes {
+			c.CountryCodes[i] = v.clone()
+		}
+	}
+	if x.RegionCodes != nil {
+		c.RegionCodes = make([]PlainText, len(x.RegionCodes))
+		for i, v := range x.RegionCodes {
+			c.RegionCodes[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Contributor) equal(y Contributor) bool {
+	if (x.SequenceNumberWithinRole == nil) != (y.SequenceNumberWithinRole == nil) || (x.SequenceNumberWithinRole != nil && !x.SequenceNumberWithinRole.equal(*y.SequenceNumberWithinRole)) {
+		return false
+	}
+	if (x.ContributorRole == nil) != (y.ContributorRole == nil) || (x.ContributorRole != nil && !x.ContributorRole.equal(*y.ContributorRole)) {
+		return false
+	}
+	if len(x.LanguageCodes) != len(y.LanguageCodes) {
+		return false
+	}
+	for i := range x.LanguageCodes {
+		if !x.LanguageCodes[i].equal(y.LanguageCodes[i]) {
+			return false
+		}
+	}
+	if (x.UnnamedPersons == nil) != (y.UnnamedPersons == nil) || (x.UnnamedPersons != nil && !x.UnnamedPersons.equal(*y.UnnamedPersons)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if len(x.PersonNameIdentifiers) != len(y.PersonNameIdentifiers) {
+		return false
+	}
+	for i := range x.PersonNameIdentifiers {
+		if !x.PersonNameIdentifiers[i].equal(y.PersonNameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if len(x.Names) != len(y.Names) {
+		return false
+	}
+	for i := range x.Names {
+		if !x.Names[i].equal(y.Names[i]) {
+			return false
+		}
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if len(x.PersonDates) != len(y.PersonDates) {
+		return false
+	}
+	for i := range x.PersonDates {
+		if !x.PersonDates[i].equal(y.PersonDates[i]) {
+			return false
+		}
+	}
+	if len(x.ProfessionalAffiliations) != len(y.ProfessionalAffiliations) {
+		return false
+	}
+	for i := range x.ProfessionalAffiliations {
+		if !x.ProfessionalAffiliations[i].equal(y.ProfessionalAffiliations[i]) {
+			return false
+		}
+	}
+	if (x.BiographicalNote == nil) != (y.BiographicalNote == nil) || (x.BiographicalNote != nil && !x.BiographicalNote.equal(*y.BiographicalNote)) {
+		return false
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.ProfessionalPosition == nil) != (y.ProfessionalPosition == nil) || (x.ProfessionalPosition != nil && !x.ProfessionalPosition.equal(*y.ProfessionalPosition)) {
+		return false
+	}
+	if (x.Affiliation == nil) != (y.Affiliation == nil) || (x.Affiliation != nil && !x.Affiliation.equal(*y.Affiliation)) {
+		return false
+	}
+	if (x.ContributorDescription == nil) != (y.ContributorDescription == nil) || (x.ContributorDescription != nil && !x.ContributorDescription.equal(*y.ContributorDescription)) {
+		return false
+	}
+	if (x.SequenceNumber == nil) != (y.SequenceNumber == nil) || (x.SequenceNumber != nil && !x.SequenceNumber.equal(*y.SequenceNumber)) {
+		return false
+	}
+	if len(x.CountryCodes) != len(y.CountryCodes) {
+		return false
+	}
+	for i := range x.CountryCodes {
+		if !x.CountryCodes[i].equal(y.CountryCodes[i]) {
+			return false
+		}
+	}
+	if len(x.RegionCodes) != len(y.RegionCodes) {
+		return false
+	}
+	for i := range x.RegionCodes {
+		if !x.RegionCodes[i].equal(y.RegionCodes[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightOwner) clone() CopyrightOwner {
+	c := x
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.CopyrightOwnerIdentifier != nil {
+		v := x.CopyrightOwnerIdentifier.clone()
+		c.CopyrightOwnerIdentifier = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightOwner) equal(y CopyrightOwner) bool {
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.CopyrightOwnerIdentifier == nil) != (y.CopyrightOwnerIdentifier == nil) || (x.CopyrightOwnerIdentifier != nil && !x.CopyrightOwnerIdentifier.equal(*y.CopyrightOwnerIdentifier)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightOwnerIdentifier) clone() CopyrightOwnerIdentifier {
+	c := x
+	c.CopyrightOwnerIDType = x.CopyrightOwnerIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightOwnerIdentifier) equal(y CopyrightOwnerIdentifier) bool {
+	if !x.CopyrightOwnerIDType.equal(y.CopyrightOwnerIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightStatement) clone() CopyrightStatement {
+	c := x
+	if x.CopyrightYears != nil {
+		c.CopyrightYears = make([]PlainText, len(x.CopyrightYears))
+		for i, v := range x.CopyrightYears {
+			c.CopyrightYears[i] = v.clone()
+		}
+	}
+	if x.CopyrightOwners != nil {
+		c.CopyrightOwners = make([]CopyrightOwner, len(x.CopyrightOwners))
+		for i, v := range x.CopyrightOwners {
+			c.CopyrightOwners[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightStatement) equal(y CopyrightStatement) bool {
+	if len(x.CopyrightYears) != len(y.CopyrightYears) {
+		return false
+	}
+	for i := range x.CopyrightYears {
+		if !x.CopyrightYears[i].equal(y.CopyrightYears[i]) {
+			return false
+		}
+	}
+	if len(x.CopyrightOwners) != len(y.CopyrightOwners) {
+		return false
+	}
+	for i := range x.CopyrightOwners {
+		if !x.CopyrightOwners[i].equal(y.CopyrightOwners[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x DiscountCoded) clone() DiscountCoded {
+	c := x
+	c.DiscountCodeType = x.DiscountCodeType.clone()
+	if x.DiscountCodeTypeName != nil {
+		v := x.DiscountCodeTypeName.clone()
+		c.DiscountCodeTypeName = &v
+	}
+	c.DiscountCode = x.DiscountCode.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x DiscountCoded) equal(y DiscountCoded) bool {
+	if !x.DiscountCodeType.equal(y.DiscountCodeType) {
+		return false
+	}
+	if (x.DiscountCodeTypeName == nil) != (y.DiscountCodeTypeName == nil) || (x.DiscountCodeTypeName != nil && !x.DiscountCodeTypeName.equal(*y.DiscountCodeTypeName)) {
+		return false
+	}
+	if !x.DiscountCode.equal(y.DiscountCode) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Extent) clone() Extent {
+	c := x
+	c.ExtentType = x.ExtentType.clone()
+	c.ExtentValue = x.ExtentValue.clone()
+	c.ExtentUnit = x.ExtentUnit.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Extent) equal(y Extent) bool {
+	if !x.ExtentType.equal(y.ExtentType) {
+		return false
+	}
+	if !x.ExtentValue.equal(y.ExtentValue) {
+		return false
+	}
+	if !x.ExtentUnit.equal(y.ExtentUnit) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Header) clone() Header {
+	c := x
+	if x.FromCompany != nil {
+		v := x.FromCompany.clone()
+		c.FromCompany = &v
+	}
+	if x.FromEANNumber != nil {
+		v := x.FromEANNumber.clone()
+		c.FromEANNumber = &v
+	}
+	if x.FromSAN != nil {
+		v := x.FromSAN.clone()
+		c.FromSAN = &v
+	}
+	if x.SenderIdentifiers != nil {
+		c.SenderIdentifiers = make([]SenderIdentifier, len(x.SenderIdentifiers))
+		for i, v := range x.SenderIdentifiers {
+			c.SenderIdentifiers[i] = v.clone()
+		}
+	}
+	if x.FromPerson != nil {
+		v := x.FromPerson.clone()
+		c.FromPerson = &v
+	}
+	if x.FromEmail != nil {
+		v := x.FromEmail.clone()
+		c.FromEmail = &v
+	}
+	if x.ToEANNumber != nil {
+		v := x.ToEANNumber.clone()
+		c.ToEANNumber = &v
+	}
+	if x.ToSAN != nil {
+		v := x.ToSAN.clone()
+		c.ToSAN = &v
+	}
+	if x.AddresseeIdentifiers != nil {
+		c.AddresseeIdentifiers = make([]AddresseeIdentifier, len(x.AddresseeIdentifiers))
+		for i, v := range x.AddresseeIdentifiers {
+			c.AddresseeIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ToCompany != nil {
+		v := x.ToCompany.clone()
+		c.ToCompany = &v
+	}
+	if x.ToPerson != nil {
+		v := x.ToPerson.clone()
+		c.ToPerson = &v
+	}
+	if x.MessageNumber != nil {
+		v := x.MessageNumber.clone()
+		c.MessageNumber = &v
+	}
+	if x.MessageRepeat != nil {
+		v := x.MessageRepeat.clone()
+		c.MessageRepeat = &v
+	}
+	c.SentDate = x.SentDate.clone()
+	if x.MessageNote != nil {
+		v := x.MessageNote.clone()
+		c.MessageNote = &v
+	}
+	if x.DefaultLanguageOfText != nil {
+		v := x.DefaultLanguageOfText.clone()
+		c.DefaultLanguageOfText = &v
+	}
+	if x.DefaultPriceTypeCode != nil {
+		v := x.DefaultPriceTypeCode.clone()
+		c.DefaultPriceTypeCode = &v
+	}
+	if x.DefaultCurrencyCode != nil {
+		v := x.DefaultCurrencyCode.clone()
+		c.DefaultCurrencyCode = &v
+	}
+	if x.DefaultLinearUnit != nil {
+		v := x.DefaultLinearUnit.clone()
+		c.DefaultLinearUnit = &v
+	}
+	if x.DefaultWeightUnit != nil {
+		v := x.DefaultWeightUnit.clone()
+		c.DefaultWeightUnit = &v
+	}
+	if x.DefaultClassOfTrade != nil {
+		v := x.DefaultClassOfTrade.clone()
+		c.DefaultClassOfTrade = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Header) equal(y Header) bool {
+	if (x.FromCompany == nil) != (y.FromCompany == nil) || (x.FromCompany != nil && !x.FromCompany.equal(*y.FromCompany)) {
+		return false
+	}
+	if (x.FromEANNumber == nil) != (y.FromEANNumber == nil) || (x.FromEANNumber != nil && !x.FromEANNumber.equal(*y.FromEANNumber)) {
+		return false
+	}
+	if (x.FromSAN == nil) != (y.FromSAN == nil) || (x.FromSAN != nil && !x.FromSAN.equal(*y.FromSAN)) {
+		return false
+	}
+	if len(x.SenderIdentifiers) != len(y.SenderIdentifiers) {
+		return false
+	}
+	for i := range x.SenderIdentifiers {
+		if !x.SenderIdentifiers[i].equal(y.SenderIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.FromPerson == nil) != (y.FromPerson == nil) || (x.FromPerson != nil && !x.FromPerson.equal(*y.FromPerson)) {
+		return false
+	}
+	if (x.FromEmail == nil) != (y.FromEmail == nil) || (x.FromEmail != nil && !x.FromEmail.equal(*y.FromEmail)) {
+		return false
+	}
+	if (x.ToEANNumber == nil) != (y.ToEANNumber == nil) || (x.ToEANNumber != nil && !x.ToEANNumber.equal(*y.ToEANNumber)) {
+		return false
+	}
+	if (x.ToSAN == nil) != (y.ToSAN == nil) || (x.ToSAN != nil && !x.ToSAN.equal(*y.ToSAN)) {
+		return false
+	}
+	if len(x.AddresseeIdentifiers) != len(y.AddresseeIdentifiers) {
+		return false
+	}
+	for i := range x.AddresseeIdentifiers {
+		if !x.AddresseeIdentifiers[i].equal(y.AddresseeIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ToCompany == nil) != (y.ToCompany == nil) || (x.ToCompany != nil && !x.ToCompany.equal(*y.ToCompany)) {
+		return false
+	}
+	if (x.ToPerson == nil) != (y.ToPerson == nil) || (x.ToPerson != nil && !x.ToPerson.equal(*y.ToPerson)) {
+		return false
+	}
+	if (x.MessageNumber == nil) != (y.MessageNumber == nil) || (x.MessageNumber != nil && !x.MessageNumber.equal(*y.MessageNumber)) {
+		return false
+	}
+	if (x.MessageRepeat == nil) != (y.MessageRepeat == nil) || (x.MessageRepeat != nil && !x.MessageRepeat.equal(*y.MessageRepeat)) {
+		return false
+	}
+	if !x.SentDate.equal(y.SentDate) {
+		return false
+	}
+	if (x.MessageNote == nil) != (y.MessageNote == nil) || (x.MessageNote != nil && !x.MessageNote.equal(*y.MessageNote)) {
+		return false
+	}
+	if (x.DefaultLanguageOfText == nil) != (y.DefaultLanguageOfText == nil) || (x.DefaultLanguageOfText != nil && !x.DefaultLanguageOfText.equal(*y.DefaultLanguageOfText)) {
+		return false
+	}
+	if (x.DefaultPriceTypeCode == nil) != (y.DefaultPriceTypeCode == nil) || (x.DefaultPriceTypeCode != nil && !x.DefaultPriceTypeCode.equal(*y.DefaultPriceTypeCode)) {
+		return false
+	}
+	if (x.DefaultCurrencyCode == nil) != (y.DefaultCurrencyCode == nil) || (x.DefaultCurrencyCode != nil && !x.DefaultCurrencyCode.equal(*y.DefaultCurrencyCode)) {
+		return false
+	}
+	if (x.DefaultLinearUnit == nil) != (y.DefaultLinearUnit == nil) || (x.DefaultLinearUnit != nil && !x.DefaultLinearUnit.equal(*y.DefaultLinearUnit)) {
+		return false
+	}
+	if (x.DefaultWeightUnit == nil) != (y.DefaultWeightUnit == nil) || (x.DefaultWeightUnit != nil && !x.DefaultWeightUnit.equal(*y.DefaultWeightUnit)) {
+		return false
+	}
+	if (x.DefaultClassOfTrade == nil) != (y.DefaultClassOfTrade == nil) || (x.DefaultClassOfTrade != nil && !x.DefaultClassOfTrade.equal(*y.DefaultClassOfTrade)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Illustrations) clone() Illustrations {
+	c := x
+	c.IllustrationType = x.IllustrationType.clone()
+	if x.IllustrationTypeDescription != nil {
+		v := x.IllustrationTypeDescription.clone()
+		c.IllustrationTypeDescription = &v
+	}
+	if x.Number != nil {
+		v := x.Number.clone()
+		c.Number = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Illustrations) equal(y Illustrations) bool {
+	if !x.IllustrationType.equal(y.IllustrationType) {
+		return false
+	}
+	if (x.IllustrationTypeDescription == nil) != (y.IllustrationTypeDescription == nil) || (x.IllustrationTypeDescription != nil && !x.IllustrationTypeDescription.equal(*y.IllustrationTypeDescription)) {
+		return false
+	}
+	if (x.Number == nil) != (y.Number == nil) || (x.Number != nil && !x.Number.equal(*y.Number)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Imprint) clone() Imprint {
+	c := x
+	if x.ImprintName != nil {
+		v := x.ImprintName.clone()
+		c.ImprintName = &v
+	}
+	if x.NameCodeType != nil {
+		v := x.NameCodeType.clone()
+		c.NameCodeType = &v
+	}
+	if x.NameCodeTypeName != nil {
+		v := x.NameCodeTypeName.clone()
+		c.NameCodeTypeName = &v
+	}
+	if x.NameCodeValue != nil {
+		v := x.NameCodeValue.clone()
+		c.NameCodeValue = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Imprint) equal(y Imprint) bool {
+	if (x.ImprintName == nil) != (y.ImprintName == nil) || (x.ImprintName != nil && !x.ImprintName.equal(*y.ImprintName)) {
+		return false
+	}
+	if (x.NameCodeType == nil) != (y.NameCodeType == nil) || (x.NameCodeType != nil && !x.NameCodeType.equal(*y.NameCodeType)) {
+		return false
+	}
+	if (x.NameCodeTypeName == nil) != (y.NameCodeTypeName == nil) || (x.NameCodeTypeName != nil && !x.NameCodeTypeName.equal(*y.NameCodeTypeName)) {
+		return false
+	}
+	if (x.NameCodeValue == nil) != (y.NameCodeValue == nil) || (x.NameCodeValue != nil && !x.NameCodeValue.equal(*y.NameCodeValue)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Language) clone() Language {
+	c := x
+	c.LanguageRole = x.LanguageRole.clone()
+	c.LanguageCode = x.LanguageCode.clone()
+	if x.CountryCode != nil {
+		v := x.CountryCode.clone()
+		c.CountryCode = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Language) equal(y Language) bool {
+	if !x.LanguageRole.equal(y.LanguageRole) {
+		return false
+	}
+	if !x.LanguageCode.equal(y.LanguageCode) {
+		return false
+	}
+	if (x.CountryCode == nil) != (y.CountryCode == nil) || (x.CountryCode != nil && !x.CountryCode.equal(*y.CountryCode)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x LocationIdentifier) clone() LocationIdentifier {
+	c := x
+	c.LocationIDType = x.LocationIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x LocationIdentifier) equal(y LocationIdentifier) bool {
+	if !x.LocationIDType.equal(y.LocationIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MainSeriesRecord) clone() MainSeriesRecord {
+	c := x
+	c.RecordReference = x.RecordReference.clone()
+	c.NotificationType = x.NotificationType.clone()
+	if x.DeletionCode != nil {
+		v := x.DeletionCode.clone()
+		c.DeletionCode = &v
+	}
+	if x.DeletionText != nil {
+		v := x.DeletionText.clone()
+		c.DeletionText = &v
+	}
+	if x.RecordSourceType != nil {
+		v := x.RecordSourceType.clone()
+		c.RecordSourceType = &v
+	}
+	if x.RecordSourceName != nil {
+		v := x.RecordSourceName.clone()
+		c.RecordSourceName = &v
+	}
+	if x.SeriesIdentifiers != nil {
+		c.SeriesIdentifiers = make([]SeriesIdentifier, len(x.SeriesIdentifiers))
+		for i, v := range x.SeriesIdentifiers {
+			c.SeriesIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.OtherTexts != nil {
+		c.OtherTexts = make([]OtherText, len(x.OtherTexts))
+		for i, v := range x.OtherTexts {
+			c.OtherTexts[i] = v.clone()
+		}
+	}
+	if x.Publishers != nil {
+		c.Publishers = make([]Publisher, len(x.Publishers))
+		for i, v := range x.Publishers {
+			c.Publishers[i] = v.clone()
+		}
+	}
+	if x.SubordinateEntries != nil {
+		v := x.SubordinateEntries.clone()
+		c.SubordinateEntries = &v
+	}
+	if x.RecordSourceIdentifierType != nil {
+		v := x.RecordSourceIdentifierType.clone()
+		c.RecordSourceIdentifierType = &v
+	}
+	if x.RecordSourceIdentifier != nil {
+		v := x.RecordSourceIdentifier.clone()
+		c.RecordSourceIdentifier = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MainSeriesRecord) equal(y MainSeriesRecord) bool {
+	if !x.RecordReference.equal(y.RecordReference) {
+		return false
+	}
+	if !x.NotificationType.equal(y.NotificationType) {
+		return false
+	}
+	if (x.DeletionCode == nil) != (y.DeletionCode == nil) || (x.DeletionCode != nil && !x.DeletionCode.equal(*y.DeletionCode)) {
+		return false
+	}
+	if (x.DeletionText == nil) != (y.DeletionText == nil) || (x.DeletionText != nil && !x.DeletionText.equal(*y.DeletionText)) {
+		return false
+	}
+	if (x.RecordSourceType == nil) != (y.RecordSourceType == nil) || (x.RecordSourceType != nil && !x.RecordSourceType.equal(*y.RecordSourceType)) {
+		return false
+	}
+	if (x.RecordSourceName == nil) != (y.RecordSourceName == nil) || (x.RecordSourceName != nil && !x.RecordSourceName.equal(*y.RecordSourceName)) {
+		return false
+	}
+	if len(x.SeriesIdentifiers) != len(y.SeriesIdentifiers) {
+		return false
+	}
+	for i := range x.SeriesIdentifiers {
+		if !x.SeriesIdentifiers[i].equal(y.SeriesIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.OtherTexts) != len(y.OtherTexts) {
+		return false
+	}
+	for i := range x.OtherTexts {
+		if !x.OtherTexts[i].equal(y.OtherTexts[i]) {
+			return false
+		}
+	}
+	if len(x.Publishers) != len(y.Publishers) {
+		return false
+	}
+	for i := range x.Publishers {
+		if !x.Publishers[i].equal(y.Publishers[i]) {
+			return false
+		}
+	}
+	if (x.SubordinateEntries == nil) != (y.SubordinateEntries == nil) || (x.SubordinateEntries != nil && !x.SubordinateEntries.equal(*y.SubordinateEntries)) {
+		return false
+	}
+	if (x.RecordSourceIdentifierType == nil) != (y.RecordSourceIdentifierType == nil) || (x.RecordSourceIdentifierType != nil && !x.RecordSourceIdentifierType.equal(*y.RecordSourceIdentifierType)) {
+		return false
+	}
+	if (x.RecordSourceIdentifier == nil) != (y.RecordSourceIdentifier == nil) || (x.RecordSourceIdentifier != nil && !x.RecordSourceIdentifier.equal(*y.RecordSourceIdentifier)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MainSubject) clone() MainSubject {
+	c := x
+	if x.SubjectHeadingText != nil {
+		v := x.SubjectHeadingText.clone()
+		c.SubjectHeadingText = &v
+	}
+	if x.SubjectCode != nil {
+		v := x.SubjectCode.clone()
+		c.SubjectCode = &v
+	}
+	c.MainSubjectSchemeIdentifier = x.MainSubjectSchemeIdentifier.clone()
+	if x.SubjectSchemeVersion != nil {
+		v := x.SubjectSchemeVersion.clone()
+		c.SubjectSchemeVersion = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MainSubject) equal(y MainSubject) bool {
+	if (x.SubjectHeadingText == nil) != (y.SubjectHeadingText == nil) || (x.SubjectHeadingText != nil && !x.SubjectHeadingText.equal(*y.SubjectHeadingText)) {
+		return false
+	}
+	if (x.SubjectCode == nil) != (y.SubjectCode == nil) || (x.SubjectCode != nil && !x.SubjectCode.equal(*y.SubjectCode)) {
+		return false
+	}
+	if !x.MainSubjectSchemeIdentifier.equal(y.MainSubjectSchemeIdentifier) {
+		return false
+	}
+	if (x.SubjectSchemeVersion == nil) != (y.SubjectSchemeVersion == nil) || (x.SubjectSchemeVersion != nil && !x.SubjectSchemeVersion.equal(*y.SubjectSchemeVersion)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MarketDate) clone() MarketDate {
+	c := x
+	c.MarketDateRole = x.MarketDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MarketDate) equal(y MarketDate) bool {
+	if !x.MarketDateRole.equal(y.MarketDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MarketRepresentation) clone() MarketRepresentation {
+	c := x
+	if x.AgentName != nil {
+		v := x.AgentName.clone()
+		c.AgentName = &v
+	}
+	if x.AgentIdentifiers != nil {
+		c.AgentIdentifiers = make([]AgentIdentifier, len(x.AgentIdentifiers))
+		for i, v := range x.AgentIdentifiers {
+			c.AgentIdentifiers[i] = v.clone()
+		}
+	}
+	if x.MarketCountry != nil {
+		v := x.MarketCountry.clone()
+		c.MarketCountry = &v
+	}
+	if x.MarketTerritory != nil {
+		v := x.MarketTerritory.clone()
+		c.MarketTerritory = &v
+	}
+	if x.MarketCountryExcluded != nil {
+		v := x.MarketCountryExcluded.clone()
+		c.MarketCountryExcluded = &v
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.AgentRole != nil {
+		v := x.AgentRole.clone()
+		c.AgentRole = &v
+	}
+	if x.MarketRestrictionDetail != nil {
+		v := x.MarketRestrictionDetail.clone()
+		c.MarketRestrictionDetail = &v
+	}
+	if x.MarketPublishingStatus != nil {
+		v := x.MarketPublishingStatus.clone()
+		c.MarketPublishingStatus = &v
+	}
+	if x.MarketDates != nil {
+		c.MarketDates = make([]MarketDate, len(x.MarketDates))
+		for i, v := range x.MarketDates {
+			c.MarketDates[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MarketRepresentation) equal(y MarketRepresentation) bool {
+	if (x.AgentName == nil) != (y.AgentName == nil) || (x.AgentName != nil && !x.AgentName.equal(*y.AgentName)) {
+		return false
+	}
+	if len(x.AgentIdentifiers) != len(y.AgentIdentifiers) {
+		return false
+	}
+	for i := range x.AgentIdentifiers {
+		if !x.AgentIdentifiers[i].equal(y.AgentIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.MarketCountry == nil) != (y.MarketCountry == nil) || (x.MarketCountry != nil && !x.MarketCountry.equal(*y.MarketCountry)) {
+		return false
+	}
+	if (x.MarketTerritory == nil) != (y.MarketTerritory == nil) || (x.MarketTerritory != nil && !x.MarketTerritory.equal(*y.MarketTerritory)) {
+		return false
+	}
+	if (x.MarketCountryExcluded == nil) != (y.MarketCountryExcluded == nil) || (x.MarketCountryExcluded != nil && !x.MarketCountryExcluded.equal(*y.MarketCountryExcluded)) {
+		return false
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.AgentRole == nil) != (y.AgentRole == nil) || (x.AgentRole != nil && !x.AgentRole.equal(*y.AgentRole)) {
+		return false
+	}
+	if (x.MarketRestrictionDetail == nil) != (y.MarketRestrictionDetail == nil) || (x.MarketRestrictionDetail != nil && !x.MarketRestrictionDetail.equal(*y.MarketRestrictionDetail)) {
+		return false
+	}
+	if (x.MarketPublishingStatus == nil) != (y.MarketPublishingStatus == nil) || (x.MarketPublishingStatus != nil && !x.MarketPublishingStatus.equal(*y.MarketPublishingStatus)) {
+		return false
+	}
+	if len(x.MarketDates) != len(y.MarketDates) {
+		return false
+	}
+	for i := range x.MarketDates {
+		if !x.MarketDates[i].equal(y.MarketDates[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Measure) clone() Measure {
+	c := x
+	c.MeasureTypeCode = x.MeasureTypeCode.clone()
+	c.Measurement = x.Measurement.clone()
+	c.MeasureUnitCode = x.MeasureUnitCode.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Measure) equal(y Measure) bool {
+	if !x.MeasureTypeCode.equal(y.MeasureTypeCode) {
+		return false
+	}
+	if !x.Measurement.equal(y.Measurement) {
+		return false
+	}
+	if !x.MeasureUnitCode.equal(y.MeasureUnitCode) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MediaFile) clone() MediaFile {
+	c := x
+	if x.TextWithDownload != nil {
+		v := x.TextWithDownload.clone()
+		c.TextWithDownload = &v
+	}
+	if x.DownloadCopyrightNotice != nil {
+		v := x.DownloadCopyrightNotice.clone()
+		c.DownloadCopyrightNotice = &v
+	}
+	if x.DownloadCaption != nil {
+		v := x.DownloadCaption.clone()
+		c.DownloadCaption = &v
+	}
+	if x.DownloadCredit != nil {
+		v := x.DownloadCredit.clone()
+		c.DownloadCredit = &v
+	}
+	c.MediaFileTypeCode = x.MediaFileTypeCode.clone()
+	if x.MediaFileFormatCode != nil {
+		v := x.MediaFileFormatCode.clone()
+		c.MediaFileFormatCode = &v
+	}
+	if x.ImageResolution != nil {
+		v := x.ImageResolution.clone()
+		c.ImageResolution = &v
+	}
+	c.MediaFileLinkTypeCode = x.MediaFileLinkTypeCode.clone()
+	c.MediaFileLink = x.MediaFileLink.clone()
+	if x.DownloadTerms != nil {
+		v := x.DownloadTerms.clone()
+		c.DownloadTerms = &v
+	}
+	if x.MediaFileDate != nil {
+		v := x.MediaFileDate.clone()
+		c.MediaFileDate = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MediaFile) equal(y MediaFile) bool {
+	if (x.TextWithDownload == nil) != (y.TextWithDownload == nil) || (x.TextWithDownload != nil && !x.TextWithDownload.equal(*y.TextWithDownload)) {
+		return false
+	}
+	if (x.DownloadCopyrightNotice == nil) != (y.DownloadCopyrightNotice == nil) || (x.DownloadCopyrightNotice != nil && !x.DownloadCopyrightNotice.equal(*y.DownloadCopyrightNotice)) {
+		return false
+	}
+	if (x.DownloadCaption == nil) != (y.DownloadCaption == nil) || (x.DownloadCaption != nil && !x.DownloadCaption.equal(*y.DownloadCaption)) {
+		return false
+	}
+	if (x.DownloadCredit == nil) != (y.DownloadCredit == nil) || (x.DownloadCredit != nil && !x.DownloadCredit.equal(*y.DownloadCredit)) {
+		return false
+	}
+	if !x.MediaFileTypeCode.equal(y.MediaFileTypeCode) {
+		return false
+	}
+	if (x.MediaFileFormatCode == nil) != (y.MediaFileFormatCode == nil) || (x.MediaFileFormatCode != nil && !x.MediaFileFormatCode.equal(*y.MediaFileFormatCode)) {
+		return false
+	}
+	if (x.ImageResolution == nil) != (y.ImageResolution == nil) || (x.ImageResolution != nil && !x.ImageResolution.equal(*y.ImageResolution)) {
+		return false
+	}
+	if !x.MediaFileLinkTypeCode.equal(y.MediaFileLinkTypeCode) {
+		return false
+	}
+	if !x.MediaFileLink.equal(y.MediaFileLink) {
+		return false
+	}
+	if (x.DownloadTerms == nil) != (y.DownloadTerms == nil) || (x.DownloadTerms != nil && !x.DownloadTerms.equal(*y.DownloadTerms)) {
+		return false
+	}
+	if (x.MediaFileDate == nil) != (y.MediaFileDate == nil) || (x.MediaFileDate != nil && !x.MediaFileDate.equal(*y.MediaFileDate)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Name) clone() Name {
+	c := x
+	if x.PersonNameIdentifiers != nil {
+		c.PersonNameIdentifiers = make([]PersonNameIdentifier, len(x.PersonNameIdentifiers))
+		for i, v := range x.PersonNameIdentifiers {
+			c.PersonNameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	c.PersonNameType = x.PersonNameType.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Name) equal(y Name) bool {
+	if len(x.PersonNameIdentifiers) != len(y.PersonNameIdentifiers) {
+		return false
+	}
+	for i := range x.PersonNameIdentifiers {
+		if !x.PersonNameIdentifiers[i].equal(y.PersonNameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if !x.PersonNameType.equal(y.PersonNameType) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x NewSupplier) clone() NewSupplier {
+	c := x
+	if x.SupplierName != nil {
+		v := x.SupplierName.clone()
+		c.SupplierName = &v
+	}
+	if x.SupplierIdentifiers != nil {
+		c.SupplierIdentifiers = make([]SupplierIdentifier, len(x.SupplierIdentifiers))
+		for i, v := range x.SupplierIdentifiers {
+			c.SupplierIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SupplierSAN != nil {
+		v := x.SupplierSAN.clone()
+		c.SupplierSAN = &v
+	}
+	if x.SupplierEANLocationNumber != nil {
+		v := x.SupplierEANLocationNumber.clone()
+		c.SupplierEANLocationNumber = &v
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x NewSupplier) equal(y NewSupplier) bool {
+	if (x.SupplierName == nil) != (y.SupplierName == nil) || (x.SupplierName != nil && !x.SupplierName.equal(*y.SupplierName)) {
+		return false
+	}
+	if len(x.SupplierIdentifiers) != len(y.SupplierIdentifiers) {
+		return false
+	}
+	for i := range x.SupplierIdentifiers {
+		if !x.SupplierIdentifiers[i].equal(y.SupplierIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SupplierSAN == nil) != (y.SupplierSAN == nil) || (x.SupplierSAN != nil && !x.SupplierSAN.equal(*y.SupplierSAN)) {
+		return false
+	}
+	if (x.SupplierEANLocationNumber == nil) != (y.SupplierEANLocationNumber == nil) || (x.SupplierEANLocationNumber != nil && !x.SupplierEANLocationNumber.equal(*y.SupplierEANLocationNumber)) {
+		return false
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x NotForSale) clone() NotForSale {
+	c := x
+	if x.RightsTerritory != nil {
+		v := x.RightsTerritory.clone()
+		c.RightsTerritory = &v
+	}
+	if x.RightsCountrys != nil {
+		c.RightsCountrys = make([]CountryCodeList, len(x.RightsCountrys))
+		for i, v := range x.RightsCountrys {
+			c.RightsCountrys[i] = v.clone()
+		}
+	}
+	if x.ISBN != nil {
+		v := x.ISBN.clone()
+		c.ISBN = &v
+	}
+	if x.EAN13 != nil {
+		v := x.EAN13.clone()
+		c.EAN13 = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PublisherName != nil {
+		v := x.PublisherName.clone()
+		c.PublisherName = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x NotForSale) equal(y NotForSale) bool {
+	if (x.RightsTerritory == nil) != (y.RightsTerritory == nil) || (x.RightsTerritory != nil && !x.RightsTerritory.equal(*y.RightsTerritory)) {
+		return false
+	}
+	if len(x.RightsCountrys) != len(y.RightsCountrys) {
+		return false
+	}
+	for i := range x.RightsCountrys {
+		if !x.RightsCountrys[i].equal(y.RightsCountrys[i]) {
+			return false
+		}
+	}
+	if (x.ISBN == nil) != (y.ISBN == nil) || (x.ISBN != nil && !x.ISBN.equal(*y.ISBN)) {
+		return false
+	}
+	if (x.EAN13 == nil) != (y.EAN13 == nil) || (x.EAN13 != nil && !x.EAN13.equal(*y.EAN13)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PublisherName == nil) != (y.PublisherName == nil) || (x.PublisherName != nil && !x.PublisherName.equal(*y.PublisherName)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ONIXMessage) clone() ONIXMessage {
+	c := x
+	if x.Header != nil {
+		v := x.Header.clone()
+		c.Header = &v
+	}
+	if x.Products != nil {
+		c.Products = make([]Product, len(x.Products))
+		for i, v := range x.Products {
+			c.Products[i] = v.clone()
+		}
+	}
+	if x.MainSeriesRecords != nil {
+		c.MainSeriesRecords = make([]MainSeriesRecord, len(x.MainSeriesRecords))
+		for i, v := range x.MainSeriesRecords {
+			c.MainSeriesRecords[i] = v.clone()
+		}
+	}
+	if x.SubSeriesRecords != nil {
+		c.SubSeriesRecords = make([]SubSeriesRecord, len(x.SubSeriesRecords))
+		for i, v := range x.SubSeriesRecords {
+			c.SubSeriesRecords[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ONIXMessage) equal(y ONIXMessage) bool {
+	if (x.Header == nil) != (y.Header == nil) || (x.Header != nil && !x.Header.equal(*y.Header)) {
+		return false
+	}
+	if len(x.Products) != len(y.Products) {
+		return false
+	}
+	for i := range x.Products {
+		if !x.Products[i].equal(y.Products[i]) {
+			return false
+		}
+	}
+	if len(x.MainSeriesRecords) != len(y.MainSeriesRecords) {
+		return false
+	}
+	for i := range x.MainSeriesRecords {
+		if !x.MainSeriesRecords[i].equal(y.MainSeriesRecords[i]) {
+			return false
+		}
+	}
+	if len(x.SubSeriesRecords) != len(y.SubSeriesRecords) {
+		return false
+	}
+	for i := range x.SubSeriesRecords {
+		if !x.SubSeriesRecords[i].equal(y.SubSeriesRecords[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x OnOrderDetail) clone() OnOrderDetail {
+	c := x
+	c.OnOrder = x.OnOrder.clone()
+	c.ExpectedDate = x.ExpectedDate.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x OnOrderDetail) equal(y OnOrderDetail) bool {
+	if !x.OnOrder.equal(y.OnOrder) {
+		return false
+	}
+	if !x.ExpectedDate.equal(y.ExpectedDate) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x OtherText) clone() OtherText {
+	c := x
+	if x.Text != nil {
+		v := x.Text.clone()
+		c.Text = &v
+	}
+	if x.TextLinkType != nil {
+		v := x.TextLinkType.clone()
+		c.TextLinkType = &v
+	}
+	if x.TextLink != nil {
+		v := x.TextLink.clone()
+		c.TextLink = &v
+	}
+	c.TextTypeCode = x.TextTypeCode.clone()
+	if x.TextFormat != nil {
+		v := x.TextFormat.clone()
+		c.TextFormat = &v
+	}
+	if x.TextAuthor != nil {
+		v := x.TextAuthor.clone()
+		c.TextAuthor = &v
+	}
+	if x.TextSourceCorporate != nil {
+		v := x.TextSourceCorporate.clone()
+		c.TextSourceCorporate = &v
+	}
+	if x.TextSourceTitle != nil {
+		v := x.TextSourceTitle.clone()
+		c.TextSourceTitle = &v
+	}
+	if x.TextPublicationDate != nil {
+		v := x.TextPublicationDate.clone()
+		c.TextPublicationDate = &v
+	}
+	if x.StartDate != nil {
+		v := x.StartDate.clone()
+		c.StartDate = &v
+	}
+	if x.EndDate != nil {
+		v := x.EndDate.clone()
+		c.EndDate = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x OtherText) equal(y OtherText) bool {
+	if (x.Text == nil) != (y.Text == nil) || (x.Text != nil && !x.Text.equal(*y.Text)) {
+		return false
+	}
+	if (x.TextLinkType == nil) != (y.TextLinkType == nil) || (x.TextLinkType != nil && !x.TextLinkType.equal(*y.TextLinkType)) {
+		return false
+	}
+	if (x.TextLink == nil) != (y.TextLink == nil) || (x.TextLink != nil && !x.TextLink.equal(*y.TextLink)) {
+		return false
+	}
+	if !x.TextTypeCode.equal(y.TextTypeCode) {
+		return false
+	}
+	if (x.TextFormat == nil) != (y.TextFormat == nil) || (x.TextFormat != nil && !x.TextFormat.equal(*y.TextFormat)) {
+		return false
+	}
+	if (x.TextAuthor == nil) != (y.TextAuthor == nil) || (x.TextAuthor != nil && !x.TextAuthor.equal(*y.TextAuthor)) {
+		return false
+	}
+	if (x.TextSourceCorporate == nil) != (y.TextSourceCorporate == nil) || (x.TextSourceCorporate != nil && !x.TextSourceCorporate.equal(*y.TextSourceCorporate)) {
+		return false
+	}
+	if (x.TextSourceTitle == nil) != (y.TextSourceTitle == nil) || (x.TextSourceTitle != nil && !x.TextSourceTitle.equal(*y.TextSourceTitle)) {
+		return false
+	}
+	if (x.TextPublicationDate == nil) != (y.TextPublicationDate == nil) || (x.TextPublicationDate != nil && !x.TextPublicationDate.equal(*y.TextPublicationDate)) {
+		return false
+	}
+	if (x.StartDate == nil) != (y.StartDate == nil) || (x.StartDate != nil && !x.StartDate.equal(*y.StartDate)) {
+		return false
+	}
+	if (x.EndDate == nil) != (y.EndDate == nil) || (x.EndDate != nil && !x.EndDate.equal(*y.EndDate)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PageRun) clone() PageRun {
+	c := x
+	c.FirstPageNumber = x.FirstPageNumber.clone()
+	if x.LastPageNumber != nil {
+		v := x.LastPageNumber.clone()
+		c.LastPageNumber = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PageRun) equal(y PageRun) bool {
+	if !x.FirstPageNumber.equal(y.FirstPageNumber) {
+		return false
+	}
+	if (x.LastPageNumber == nil) != (y.LastPageNumber == nil) || (x.LastPageNumber != nil && !x.LastPageNumber.equal(*y.LastPageNumber)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ParentIdentifier) clone() ParentIdentifier {
+	c := x
+	c.SeriesIDType = x.SeriesIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ParentIdentifier) equal(y ParentIdentifier) bool {
+	if !x.SeriesIDType.equal(y.SeriesIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PersonAsSubject) clone() PersonAsSubject {
+	c := x
+	if x.PersonNameIdentifiers != nil {
+		c.PersonNameIdentifiers = make([]PersonNameIdentifier, len(x.PersonNameIdentifiers))
+		for i, v := range x.PersonNameIdentifiers {
+			c.PersonNameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.Names != nil {
+		c.Names = make([]Name, len(x.Names))
+		for i, v := range x.Names {
+			c.Names[i] = v.clone()
+		}
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PersonAsSubject) equal(y PersonAsSubject) bool {
+	if len(x.PersonNameIdentifiers) != len(y.PersonNameIdentifiers) {
+		return false
+	}
+	for i := range x.PersonNameIdentifiers {
+		if !x.PersonNameIdentifiers[i].equal(y.PersonNameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if len(x.Names) != len(y.Names) {
+		return false
+	}
+	for i := range x.Names {
+		if !x.Names[i].equal(y.Names[i]) {
+			return false
+		}
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PersonDate) clone() PersonDate {
+	c := x
+	c.PersonDateRole = x.PersonDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PersonDate) equal(y PersonDate) bool {
+	if !x.PersonDateRole.equal(y.PersonDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PersonNameIdentifier) clone() PersonNameIdentifier {
+	c := x
+	c.PersonNameIDType = x.PersonNameIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PersonNameIdentifier) equal(y PersonNameIdentifier) bool {
+	if !x.PersonNameIDType.equal(y.PersonNameIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Price) clone() Price {
+	c := x
+	if x.PriceTypeCode != nil {
+		v := x.PriceTypeCode.clone()
+		c.PriceTypeCode = &v
+	}
+	if x.PriceQualifier != nil {
+		v := x.PriceQualifier.clone()
+		c.PriceQualifier = &v
+	}
+	if x.PriceTypeDescription != nil {
+		v := x.PriceTypeDescription.clone()
+		c.PriceTypeDescription = &v
+	}
+	if x.PricePer != nil {
+		v := x.PricePer.clone()
+		c.PricePer = &v
+	}
+	if x.MinimumOrderQuantity != nil {
+		v := x.MinimumOrderQuantity.clone()
+		c.MinimumOrderQuantity = &v
+	}
+	if x.BatchBonuss != nil {
+		c.BatchBonuss = make([]BatchBonus, len(x.BatchBonuss))
+		for i, v := range x.BatchBonuss {
+			c.BatchBonuss[i] = v.clone()
+		}
+	}
+	if x.ClassOfTrade != nil {
+		v := x.ClassOfTrade.clone()
+		c.ClassOfTrade = &v
+	}
+	if x.BICDiscountGroupCode != nil {
+		v := x.BICDiscountGroupCode.clone()
+		c.BICDiscountGroupCode = &v
+	}
+	if x.DiscountCodeds != nil {
+		c.DiscountCodeds = make([]DiscountCoded, len(x.DiscountCodeds))
+		for i, v := range x.DiscountCodeds {
+			c.DiscountCodeds[i] = v.clone()
+		}
+	}
+	if x.DiscountPercent != nil {
+		v := x.DiscountPercent.clone()
+		c.DiscountPercent = &v
+	}
+	if x.PriceStatus != nil {
+		v := x.PriceStatus.clone()
+		c.PriceStatus = &v
+	}
+	c.PriceAmount = x.PriceAmount.clone()
+	if x.CurrencyCode != nil {
+		v := x.CurrencyCode.clone()
+		c.CurrencyCode = &v
+	}
+	if x.PriceEffectiveFrom != nil {
+		v := x.PriceEffectiveFrom.clone()
+		c.PriceEffectiveFrom = &v
+	}
+	if x.PriceEffectiveUntil != nil {
+		v := x.PriceEffectiveUntil.clone()
+		c.PriceEffectiveUntil = &v
+	}
+	if x.Territory != nil {
+		v := x.Territory.clone()
+		c.Territory = &v
+	}
+	if x.CountryCodes != nil {
+		c.CountryCodes = make([]CountryCode, len(x.CountryCodes))
+		for i, v := range x.CountryCodes {
+			c.CountryCodes[i] = v.clone()
+		}
+	}
+	if x.CountryExcluded != nil {
+		v := x.CountryExcluded.clone()
+		c.CountryExcluded = &v
+	}
+	if x.TerritoryExcluded != nil {
+		v := x.TerritoryExcluded.clone()
+		c.TerritoryExcluded = &v
+	}
+	if x.TaxRateCode1 != nil {
+		v := x.TaxRateCode1.clone()
+		c.TaxRateCode1 = &v
+	}
+	if x.TaxRatePercent1 != nil {
+		v := x.TaxRatePercent1.clone()
+		c.TaxRatePercent1 = &v
+	}
+	if x.TaxableAmount1 != nil {
+		v := x.TaxableAmount1.clone()
+		c.TaxableAmount1 = &v
+	}
+	if x.TaxAmount1 != nil {
+		v := x.TaxAmount1.clone()
+		c.TaxAmount1 = &v
+	}
+	if x.TaxRateCode2 != nil {
+		v := x.TaxRateCode2.clone()
+		c.TaxRateCode2 = &v
+	}
+	if x.TaxRatePercent2 != nil {
+		v := x.TaxRatePercent2.clone()
+		c.TaxRatePercent2 = &v
+	}
+	if x.TaxableAmount2 != nil {
+		v := x.TaxableAmount2.clone()
+		c.TaxableAmount2 = &v
+	}
+	if x.TaxAmount2 != nil {
+		v := x.TaxAmount2.clone()
+		c.TaxAmount2 = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Price) equal(y Price) bool {
+	if (x.PriceTypeCode == nil) != (y.PriceTypeCode == nil) || (x.PriceTypeCode != nil && !x.PriceTypeCode.equal(*y.PriceTypeCode)) {
+		return false
+	}
+	if (x.PriceQualifier == nil) != (y.PriceQualifier == nil) || (x.PriceQualifier != nil && !x.PriceQualifier.equal(*y.PriceQualifier)) {
+		return false
+	}
+	if (x.PriceTypeDescription == nil) != (y.PriceTypeDescription == nil) || (x.PriceTypeDescription != nil && !x.PriceTypeDescription.equal(*y.PriceTypeDescription)) {
+		return false
+	}
+	if (x.PricePer == nil) != (y.PricePer == nil) || (x.PricePer != nil && !x.PricePer.equal(*y.PricePer)) {
+		return false
+	}
+	if (x.MinimumOrderQuantity == nil) != (y.MinimumOrderQuantity == nil) || (x.MinimumOrderQuantity != nil && !x.MinimumOrderQuantity.equal(*y.MinimumOrderQuantity)) {
+		return false
+	}
+	if len(x.BatchBonuss) != len(y.BatchBonuss) {
+		return false
+	}
+	for i := range x.BatchBonuss {
+		if !x.BatchBonuss[i].equal(y.BatchBonuss[i]) {
+			return false
+		}
+	}
+	if (x.ClassOfTrade == nil) != (y.ClassOfTrade == nil) || (x.ClassOfTrade != nil && !x.ClassOfTrade.equal(*y.ClassOfTrade)) {
+		return false
+	}
+	if (x.BICDiscountGroupCode == nil) != (y.BICDiscountGroupCode == nil) || (x.BICDiscountGroupCode != nil && !x.BICDiscountGroupCode.equal(*y.BICDiscountGroupCode)) {
+		return false
+	}
+	if len(x.DiscountCodeds) != len(y.DiscountCodeds) {
+		return false
+	}
+	for i := range x.DiscountCodeds {
+		if !x.DiscountCodeds[i].equal(y.DiscountCodeds[i]) {
+			return false
+		}
+	}
+	if (x.DiscountPercent == nil) != (y.DiscountPercent == nil) || (x.DiscountPercent != nil && !x.DiscountPercent.equal(*y.DiscountPercent)) {
+		return false
+	}
+	if (x.PriceStatus == nil) != (y.PriceStatus == nil) || (x.PriceStatus != nil && !x.PriceStatus.equal(*y.PriceStatus)) {
+		return false
+	}
+	if !x.PriceAmount.equal(y.PriceAmount) {
+		return false
+	}
+	if (x.CurrencyCode == nil) != (y.CurrencyCode == nil) || (x.CurrencyCode != nil && !x.CurrencyCode.equal(*y.CurrencyCode)) {
+		return false
+	}
+	if (x.PriceEffectiveFrom == nil) != (y.PriceEffectiveFrom == nil) || (x.PriceEffectiveFrom != nil && !x.PriceEffectiveFrom.equal(*y.PriceEffectiveFrom)) {
+		return false
+	}
+	if (x.PriceEffectiveUntil == nil) != (y.PriceEffectiveUntil == nil) || (x.PriceEffectiveUntil != nil && !x.PriceEffectiveUntil.equal(*y.PriceEffectiveUntil)) {
+		return false
+	}
+	if (x.Territory == nil) != (y.Territory == nil) || (x.Territory != nil && !x.Territory.equal(*y.Territory)) {
+		return false
+	}
+	if len(x.CountryCodes) != len(y.CountryCodes) {
+		return false
+	}
+	for i := range x.CountryCodes {
+		if !x.CountryCodes[i].equal(y.CountryCodes[i]) {
+			return false
+		}
+	}
+	if (x.CountryExcluded == nil) != (y.CountryExcluded == nil) || (x.CountryExcluded != nil && !x.CountryExcluded.equal(*y.CountryExcluded)) {
+		return false
+	}
+	if (x.TerritoryExcluded == nil) != (y.TerritoryExcluded == nil) || (x.TerritoryExcluded != nil && !x.TerritoryExcluded.equal(*y.TerritoryExcluded)) {
+		return false
+	}
+	if (x.TaxRateCode1 == nil) != (y.TaxRateCode1 == nil) || (x.TaxRateCode1 != nil && !x.TaxRateCode1.equal(*y.TaxRateCode1)) {
+		return false
+	}
+	if (x.TaxRatePercent1 == nil) != (y.TaxRatePercent1 == nil) || (x.TaxRatePercent1 != nil && !x.TaxRatePercent1.equal(*y.TaxRatePercent1)) {
+		return false
+	}
+	if (x.TaxableAmount1 == nil) != (y.TaxableAmount1 == nil) || (x.TaxableAmount1 != nil && !x.TaxableAmount1.equal(*y.TaxableAmount1)) {
+		return false
+	}
+	if (x.TaxAmount1 == nil) != (y.TaxAmount1 == nil) || (x.TaxAmount1 != nil && !x.TaxAmount1.equal(*y.TaxAmount1)) {
+		return false
+	}
+	if (x.TaxRateCode2 == nil) != (y.TaxRateCode2 == nil) || (x.TaxRateCode2 != nil && !x.TaxRateCode2.equal(*y.TaxRateCode2)) {
+		return false
+	}
+	if (x.TaxRatePercent2 == nil) != (y.TaxRatePercent2 == nil) || (x.TaxRatePercent2 != nil && !x.TaxRatePercent2.equal(*y.TaxRatePercent2)) {
+		return false
+	}
+	if (x.TaxableAmount2 == nil) != (y.TaxableAmount2 == nil) || (x.TaxableAmount2 != nil && !x.TaxableAmount2.equal(*y.TaxableAmount2)) {
+		return false
+	}
+	if (x.TaxAmount2 == nil) != (y.TaxAmount2 == nil) || (x.TaxAmount2 != nil && !x.TaxAmount2.equal(*y.TaxAmount2)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Prize) clone() Prize {
+	c := x
+	c.PrizeName = x.PrizeName.clone()
+	if x.PrizeYear != nil {
+		v := x.PrizeYear.clone()
+		c.PrizeYear = &v
+	}
+	if x.PrizeCountry != nil {
+		v := x.PrizeCountry.clone()
+		c.PrizeCountry = &v
+	}
+	if x.PrizeCode != nil {
+		v := x.PrizeCode.clone()
+		c.PrizeCode = &v
+	}
+	if x.PrizeJury != nil {
+		v := x.PrizeJury.clone()
+		c.PrizeJury = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Prize) equal(y Prize) bool {
+	if !x.PrizeName.equal(y.PrizeName) {
+		return false
+	}
+	if (x.PrizeYear == nil) != (y.PrizeYear == nil) || (x.PrizeYear != nil && !x.PrizeYear.equal(*y.PrizeYear)) {
+		return false
+	}
+	if (x.PrizeCountry == nil) != (y.PrizeCountry == nil) || (x.PrizeCountry != nil && !x.PrizeCountry.equal(*y.PrizeCountry)) {
+		return false
+	}
+	if (x.PrizeCode == nil) != (y.PrizeCode == nil) || (x.PrizeCode != nil && !x.PrizeCode.equal(*y.PrizeCode)) {
+		return false
+	}
+	if (x.PrizeJury == nil) != (y.PrizeJury == nil) || (x.PrizeJury != nil && !x.PrizeJury.equal(*y.PrizeJury)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Product) clone() Product {
+	c := x
+	if x.Dimensions != nil {
+		v := x.Dimensions.clone()
+		c.Dimensions = &v
+	}
+	if x.Weight != nil {
+		v := x.Weight.clone()
+		c.Weight = &v
+	}
+	if x.Measures != nil {
+		c.Measures = make([]Measure, len(x.Measures))
+		for i, v := range x.Measures {
+			c.Measures[i] = v.clone()
+		}
+	}
+	if x.Height != nil {
+		v := x.Height.clone()
+		c.Height = &v
+	}
+	if x.Width != nil {
+		v := x.Width.clone()
+		c.Width = &v
+	}
+	if x.Thickness != nil {
+		v := x.Thickness.clone()
+		c.Thickness = &v
+	}
+	c.RecordReference = x.RecordReference.clone()
+	c.NotificationType = x.NotificationType.clone()
+	if x.DeletionCode != nil {
+		v := x.DeletionCode.clone()
+		c.DeletionCode = &v
+	}
+	if x.DeletionText != nil {
+		v := x.DeletionText.clone()
+		c.DeletionText = &v
+	}
+	if x.RecordSourceType != nil {
+		v := x.RecordSourceType.clone()
+		c.RecordSourceType = &v
+	}
+	if x.RecordSourceName != nil {
+		v := x.RecordSourceName.clone()
+		c.RecordSourceName = &v
+	}
+	if x.ReplacedByISBN != nil {
+		v := x.ReplacedByISBN.clone()
+		c.ReplacedByISBN = &v
+	}
+	if x.ReplacedByEAN13 != nil {
+		v := x.ReplacedByEAN13.clone()
+		c.ReplacedByEAN13 = &v
+	}
+	if x.AlternativeFormatISBN != nil {
+		v := x.AlternativeFormatISBN.clone()
+		c.AlternativeFormatISBN = &v
+	}
+	if x.AlternativeFormatEAN13 != nil {
+		v := x.AlternativeFormatEAN13.clone()
+		c.AlternativeFormatEAN13 = &v
+	}
+	if x.AlternativeProductISBN != nil {
+		v := x.AlternativeProductISBN.clone()
+		c.AlternativeProductISBN = &v
+	}
+	if x.AlternativeProductEAN13 != nil {
+		v := x.AlternativeProductEAN13.clone()
+		c.AlternativeProductEAN13 = &v
+	}
+	if x.RelatedProducts != nil {
+		c.RelatedProducts = make([]RelatedProduct, len(x.RelatedProducts))
+		for i, v := range x.RelatedProducts {
+			c.RelatedProducts[i] = v.clone()
+		}
+	}
+	if x.OutOfPrintDate != nil {
+		v := x.OutOfPrintDate.clone()
+		c.OutOfPrintDate = &v
+	}
+	if x.SupplyDetails != nil {
+		c.SupplyDetails = make([]SupplyDetail, len(x.SupplyDetails))
+		for i, v := range x.SupplyDetails {
+			c.SupplyDetails[i] = v.clone()
+		}
+	}
+	if x.MarketRepresentations != nil {
+		c.MarketRepresentations = make([]MarketRepresentation, len(x.MarketRepresentations))
+		for i, v := range x.MarketRepresentations {
+			c.MarketRepresentations[i] = v.clone()
+		}
+	}
+	if x.PromotionCampaign != nil {
+		v := x.PromotionCampaign.clone()
+		c.PromotionCampaign = &v
+	}
+	if x.PromotionContact != nil {
+		v := x.PromotionContact.clone()
+		c.PromotionContact = &v
+	}
+	if x.InitialPrintRun != nil {
+		v := x.InitialPrintRun.clone()
+		c.InitialPrintRun = &v
+	}
+	if x.ReprintDetails != nil {
+		c.ReprintDetails = make([]PlainText, len(x.ReprintDetails))
+		for i, v := range x.ReprintDetails {
+			c.ReprintDetails[i] = v.clone()
+		}
+	}
+	if x.CopiesSold != nil {
+		v := x.CopiesSold.clone()
+		c.CopiesSold = &v
+	}
+	if x.BookClubAdoption != nil {
+		v := x.BookClubAdoption.clone()
+		c.BookClubAdoption = &v
+	}
+	if x.RecordSourceIdentifierType != nil {
+		v := x.RecordSourceIdentifierType.clone()
+		c.RecordSourceIdentifierType = &v
+	}
+	if x.RecordSourceIdentifier != nil {
+		v := x.RecordSourceIdentifier.clone()
+		c.RecordSourceIdentifier = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ISBN != nil {
+		v := x.ISBN.clone()
+		c.ISBN = &v
+	}
+	if x.EAN13 != nil {
+		v := x.EAN13.clone()
+		c.EAN13 = &v
+	}
+	if x.UPC != nil {
+		v := x.UPC.clone()
+		c.UPC = &v
+	}
+	if x.PublisherProductNo != nil {
+		v := x.PublisherProductNo.clone()
+		c.PublisherProductNo = &v
+	}
+	if x.ISMN != nil {
+		v := x.ISMN.clone()
+		c.ISMN = &v
+	}
+	if x.DOI != nil {
+		v := x.DOI.clone()
+		c.DOI = &v
+	}
+	if x.Seriess != nil {
+		c.Seriess = make([]Series, len(x.Seriess))
+		for i, v := range x.Seriess {
+			c.Seriess[i] = v.clone()
+		}
+	}
+	if x.Sets != nil {
+		c.Sets = make([]Set, len(x.Sets))
+		for i, v := range x.Sets {
+			c.Sets[i] = v.clone()
+		}
+	}
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.DistinctiveTitle != nil {
+		v := x.DistinctiveTitle.clone()
+		c.DistinctiveTitle = &v
+	}
+	if x.TitlePrefix != nil {
+		v := x.TitlePrefix.clone()
+		c.TitlePrefix = &v
+	}
+	if x.TitleWithoutPrefix != nil {
+		v := x.TitleWithoutPrefix.clone()
+		c.TitleWithoutPrefix = &v
+	}
+	if x.Subtitle != nil {
+		v := x.Subtitle.clone()
+		c.Subtitle = &v
+	}
+	if x.TranslationOfTitle != nil {
+		v := x.TranslationOfTitle.clone()
+		c.TranslationOfTitle = &v
+	}
+	if x.FormerTitles != nil {
+		c.FormerTitles = make([]PlainText, len(x.FormerTitles))
+		for i, v := range x.FormerTitles {
+			c.FormerTitles[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorStatement != nil {
+		v := x.ContributorStatement.clone()
+		c.ContributorStatement = &v
+	}
+	if x.ConferenceDescription != nil {
+		v := x.ConferenceDescription.clone()
+		c.ConferenceDescription = &v
+	}
+	if x.Conferences != nil {
+		c.Conferences = make([]Conference, len(x.Conferences))
+		for i, v := range x.Conferences {
+			c.Conferences[i] = v.clone()
+		}
+	}
+	if x.ConferenceRole != nil {
+		v := x.ConferenceRole.clone()
+		c.ConferenceRole = &v
+	}
+	if x.ConferenceName != nil {
+		v := x.ConferenceName.clone()
+		c.ConferenceName = &v
+	}
+	if x.ConferenceNumber != nil {
+		v := x.ConferenceNumber.clone()
+		c.ConferenceNumber = &v
+	}
+	if x.ConferenceDate != nil {
+		v := x.ConferenceDate.clone()
+		c.ConferenceDate = &v
+	}
+	if x.ConferencePlace != nil {
+		v := x.ConferencePlace.clone()
+		c.ConferencePlace = &v
+	}
+	if x.EditionTypeCodes != nil {
+		c.EditionTypeCodes = make([]EditionTypeCode, len(x.EditionTypeCodes))
+		for i, v := range x.EditionTypeCodes {
+			c.EditionTypeCodes[i] = v.clone()
+		}
+	}
+	if x.EditionNumber != nil {
+		v := x.EditionNumber.clone()
+		c.EditionNumber = &v
+	}
+	if x.EditionVersionNumber != nil {
+		v := x.EditionVersionNumber.clone()
+		c.EditionVersionNumber = &v
+	}
+	if x.EditionStatement != nil {
+		v := x.EditionStatement.clone()
+		c.EditionStatement = &v
+	}
+	if x.PrizesDescription != nil {
+		v := x.PrizesDescription.clone()
+		c.PrizesDescription = &v
+	}
+	if x.Prizes != nil {
+		c.Prizes = make([]Prize, len(x.Prizes))
+		for i, v := range x.Prizes {
+			c.Prizes[i] = v.clone()
+		}
+	}
+	if x.Publishers != nil {
+		c.Publishers = make([]Publisher, len(x.Publishers))
+		for i, v := range x.Publishers {
+			c.Publishers[i] = v.clone()
+		}
+	}
+	if x.ImprintName != nil {
+		v := x.ImprintName.clone()
+		c.ImprintName = &v
+	}
+	if x.Imprints != nil {
+		c.Imprints = make([]Imprint, len(x.Imprints))
+		for i, v := range x.Imprints {
+			c.Imprints[i] = v.clone()
+		}
+	}
+	if x.PublisherName != nil {
+		v := x.PublisherName.clone()
+		c.PublisherName = &v
+	}
+	if x.CopyrightStatements != nil {
+		c.CopyrightStatements = make([]CopyrightStatement, len(x.CopyrightStatements))
+		for i, v := range x.CopyrightStatements {
+			c.CopyrightStatements[i] = v.clone()
+		}
+	}
+	if x.CopyrightYear != nil {
+		v := x.CopyrightYear.clone()
+		c.CopyrightYear = &v
+	}
+	if x.Barcodes != nil {
+		c.Barcodes = make([]Barcode, len(x.Barcodes))
+		for i, v := range x.Barcodes {
+			c.Barcodes[i] = v.clone()
+		}
+	}
+	if x.ReplacesISBN != nil {
+		v := x.ReplacesISBN.clone()
+		c.ReplacesISBN = &v
+	}
+	if x.ReplacesEAN13 != nil {
+		v := x.ReplacesEAN13.clone()
+		c.ReplacesEAN13 = &v
+	}
+	if x.ProductForm != nil {
+		v := x.ProductForm.clone()
+		c.ProductForm = &v
+	}
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductFormFeatures != nil {
+		c.ProductFormFeatures = make([]ProductFormFeature, len(x.ProductFormFeatures))
+		for i, v := range x.ProductFormFeatures {
+			c.ProductFormFeatures[i] = v.clone()
+		}
+	}
+	if x.BookFormDetails != nil {
+		c.BookFormDetails = make([]BookFormDetail, len(x.BookFormDetails))
+		for i, v := range x.BookFormDetails {
+			c.BookFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductPackaging != nil {
+		v := x.ProductPackaging.clone()
+		c.ProductPackaging = &v
+	}
+	if x.ProductFormDescription != nil {
+		v := x.ProductFormDescription.clone()
+		c.ProductFormDescription = &v
+	}
+	if x.NumberOfPieces != nil {
+		v := x.NumberOfPieces.clone()
+		c.NumberOfPieces = &v
+	}
+	if x.TradeCategory != nil {
+		v := x.TradeCategory.clone()
+		c.TradeCategory = &v
+	}
+	if x.ProductContentTypes != nil {
+		c.ProductContentTypes = make([]ProductContentType, len(x.ProductContentTypes))
+		for i, v := range x.ProductContentTypes {
+			c.ProductContentTypes[i] = v.clone()
+		}
+	}
+	if x.ContainedItems != nil {
+		c.ContainedItems = make([]ContainedItem, len(x.ContainedItems))
+		for i, v := range x.ContainedItems {
+			c.ContainedItems[i] = v.clone()
+		}
+	}
+	if x.ProductClassifications != nil {
+		c.ProductClassifications = make([]ProductClassification, len(x.ProductClassifications))
+		for i, v := range x.ProductClassifications {
+			c.ProductClassifications[i] = v.clone()
+		}
+	}
+	if x.TextCaseFlag != nil {
+		v := x.TextCaseFlag.clone()
+		c.TextCaseFlag = &v
+	}
+	if x.WorkIdentifiers != nil {
+		c.WorkIdentifiers = make([]WorkIdentifier, len(x.WorkIdentifiers))
+		for i, v := range x.WorkIdentifiers {
+			c.WorkIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.ReligiousText != nil {
+		v := x.ReligiousText.clone()
+		c.ReligiousText = &v
+	}
+	if x.LanguageOfTexts != nil {
+		c.LanguageOfTexts = make([]LanguageOfText, len(x.LanguageOfTexts))
+		for i, v := range x.LanguageOfTexts {
+			c.LanguageOfTexts[i] = v.clone()
+		}
+	}
+	if x.OriginalLanguage != nil {
+		v := x.OriginalLanguage.clone()
+		c.OriginalLanguage = &v
+	}
+	if x.Languages != nil {
+		c.Languages = make([]Language, len(x.Languages))
+		for i, v := range x.Languages {
+			c.Languages[i] = v.clone()
+		}
+	}
+	if x.NumberOfPages != nil {
+		v := x.NumberOfPages.clone()
+		c.NumberOfPages = &v
+	}
+	if x.PagesRoman != nil {
+		v := x.PagesRoman.clone()
+		c.PagesRoman = &v
+	}
+	if x.PagesArabic != nil {
+		v := x.PagesArabic.clone()
+		c.PagesArabic = &v
+	}
+	if x.Extents != nil {
+		c.Extents = make([]Extent, len(x.Extents))
+		for i, v := range x.Extents {
+			c.Extents[i] = v.clone()
+		}
+	}
+	if x.NumberOfIllustrations != nil {
+		v := x.NumberOfIllustrations.clone()
+		c.NumberOfIllustrations = &v
+	}
+	if x.IllustrationsNote != nil {
+		v := x.IllustrationsNote.clone()
+		c.IllustrationsNote = &v
+	}
+	if x.Illustrationss != nil {
+		c.Illustrationss = make([]Illustrations, len(x.Illustrationss))
+		for i, v := range x.Illustrationss {
+			c.Illustrationss[i] = v.clone()
+		}
+	}
+	if x.MapScales != nil {
+		c.MapScales = make([]PlainText, len(x.MapScales))
+		for i, v := range x.MapScales {
+			c.MapScales[i] = v.clone()
+		}
+	}
+	if x.MainSubjects != nil {
+		c.MainSubjects = make([]MainSubject, len(x.MainSubjects))
+		for i, v := range x.MainSubjects {
+			c.MainSubjects[i] = v.clone()
+		}
+	}
+	if x.Subjects != nil {
+		c.Subjects = make([]Subject, len(x.Subjects))
+		for i, v := range x.Subjects {
+			c.Subjects[i] = v.clone()
+		}
+	}
+	if x.PersonAsSubjects != nil {
+		c.PersonAsSubjects = make([]PersonAsSubject, len(x.PersonAsSubjects))
+		for i, v := range x.PersonAsSubjects {
+			c.PersonAsSubjects[i] = v.clone()
+		}
+	}
+	if x.CorporateBodyAsSubjects != nil {
+		c.CorporateBodyAsSubjects = make([]PlainText, len(x.CorporateBodyAsSubjects))
+		for i, v := range x.CorporateBodyAsSubjects {
+			c.CorporateBodyAsSubjects[i] = v.clone()
+		}
+	}
+	if x.PlaceAsSubjects != nil {
+		c.PlaceAsSubjects = make([]PlainText, len(x.PlaceAsSubjects))
+		for i, v := range x.PlaceAsSubjects {
+			c.PlaceAsSubjects[i] = v.clone()
+		}
+	}
+	if x.AudienceCodes != nil {
+		c.AudienceCodes = make([]AudienceCode, len(x.AudienceCodes))
+		for i, v := range x.AudienceCodes {
+			c.AudienceCodes[i] = v.clone()
+		}
+	}
+	if x.Audiences != nil {
+		c.Audiences = make([]Audience, len(x.Audiences))
+		for i, v := range x.Audiences {
+			c.Audiences[i] = v.clone()
+		}
+	}
+	if x.USSchoolGrade != nil {
+		v := x.USSchoolGrade.clone()
+		c.USSchoolGrade = &v
+	}
+	if x.InterestAge != nil {
+		v := x.InterestAge.clone()
+		c.InterestAge = &v
+	}
+	if x.AudienceRanges != nil {
+		c.AudienceRanges = make([]AudienceRange, len(x.AudienceRanges))
+		for i, v := range x.AudienceRanges {
+			c.AudienceRanges[i] = v.clone()
+		}
+	}
+	if x.AudienceDescription != nil {
+		v := x.AudienceDescription.clone()
+		c.AudienceDescription = &v
+	}
+	if x.Complexitys != nil {
+		c.Complexitys = make([]Complexity, len(x.Complexitys))
+		for i, v := range x.Complexitys {
+			c.Complexitys[i] = v.clone()
+		}
+	}
+	if x.Annotation != nil {
+		v := x.Annotation.clone()
+		c.Annotation = &v
+	}
+	if x.MainDescription != nil {
+		v := x.MainDescription.clone()
+		c.MainDescription = &v
+	}
+	if x.OtherTexts != nil {
+		c.OtherTexts = make([]OtherText, len(x.OtherTexts))
+		for i, v := range x.OtherTexts {
+			c.OtherTexts[i] = v.clone()
+		}
+	}
+	if x.ReviewQuotes != nil {
+		c.ReviewQuotes = make([]ReviewQuote, len(x.ReviewQuotes))
+		for i, v := range x.ReviewQuotes {
+			c.ReviewQuotes[i] = v.clone()
+		}
+	}
+	if x.MediaFiles != nil {
+		c.MediaFiles = make([]MediaFile, len(x.MediaFiles))
+		for i, v := range x.MediaFiles {
+			c.MediaFiles[i] = v.clone()
+		}
+	}
+	if x.ProductWebsites != nil {
+		c.ProductWebsites = make([]ProductWebsite, len(x.ProductWebsites))
+		for i, v := range x.ProductWebsites {
+			c.ProductWebsites[i] = v.clone()
+		}
+	}
+	if x.ContentItems != nil {
+		c.ContentItems = make([]ContentItem, len(x.ContentItems))
+		for i, v := range x.ContentItems {
+			c.ContentItems[i] = v.clone()
+		}
+	}
+	if x.CityOfPublications != nil {
+		c.CityOfPublications = make([]PlainText, len(x.CityOfPublications))
+		for i, v := range x.CityOfPublications {
+			c.CityOfPublications[i] = v.clone()
+		}
+	}
+	if x.CountryOfPublication != nil {
+		v := x.CountryOfPublication.clone()
+		c.CountryOfPublication = &v
+	}
+	if x.CopublisherNames != nil {
+		c.CopublisherNames = make([]PlainText, len(x.CopublisherNames))
+		for i, v := range x.CopublisherNames {
+			c.CopublisherNames[i] = v.clone()
+		}
+	}
+	if x.SponsorNames != nil {
+		c.SponsorNames = make([]PlainText, len(x.SponsorNames))
+		for i, v := range x.SponsorNames {
+			c.SponsorNames[i] = v.clone()
+		}
+	}
+	if x.OriginalPublisher != nil {
+		v := x.OriginalPublisher.clone()
+		c.OriginalPublisher = &v
+	}
+	if x.AnnouncementDate != nil {
+		v := x.AnnouncementDate.clone()
+		c.AnnouncementDate = &v
+	}
+	if x.TradeAnnouncementDate != nil {
+		v := x.TradeAnnouncementDate.clone()
+		c.TradeAnnouncementDate = &v
+	}
+	if x.PublicationDate != nil {
+		v := x.PublicationDate.clone()
+		c.PublicationDate = &v
+	}
+	if x.YearFirstPublished != nil {
+		v := x.YearFirstPublished.clone()
+		c.YearFirstPublished = &v
+	}
+	if x.SalesRightss != nil {
+		c.SalesRightss = make([]SalesRights, len(x.SalesRightss))
+		for i, v := range x.SalesRightss {
+			c.SalesRightss[i] = v.clone()
+		}
+	}
+	if x.NotForSales != nil {
+		c.NotForSales = make([]NotForSale, len(x.NotForSales))
+		for i, v := range x.NotForSales {
+			c.NotForSales[i] = v.clone()
+		}
+	}
+	if x.SalesRestrictions != nil {
+		c.SalesRestrictions = make([]SalesRestriction, len(x.SalesRestrictions))
+		for i, v := range x.SalesRestrictions {
+			c.SalesRestrictions[i] = v.clone()
+		}
+	}
+	if x.EpubType != nil {
+		v := x.EpubType.clone()
+		c.EpubType = &v
+	}
+	if x.EpubTypeVersion != nil {
+		v := x.EpubTypeVersion.clone()
+		c.EpubTypeVersion = &v
+	}
+	if x.EpubTypeDescription != nil {
+		v := x.EpubTypeDescription.clone()
+		c.EpubTypeDescription = &v
+	}
+	if x.EpubFormatDescription != nil {
+		v := x.EpubFormatDescription.clone()
+		c.EpubFormatDescription = &v
+	}
+	if x.EpubSourceDescription != nil {
+		v := x.EpubSourceDescription.clone()
+		c.EpubSourceDescription = &v
+	}
+	if x.EpubTypeNote != nil {
+		v := x.EpubTypeNote.clone()
+		c.EpubTypeNote = &v
+	}
+	if x.EpubFormat != nil {
+		v := x.EpubFormat.clone()
+		c.EpubFormat = &v
+	}
+	if x.EpubFormatVersion != nil {
+		v := x.EpubFormatVersion.clone()
+		c.EpubFormatVersion = &v
+	}
+	if x.EpubSource != nil {
+		v := x.EpubSource.clone()
+		c.EpubSource = &v
+	}
+	if x.EpubSourceVersion != nil {
+		v := x.EpubSourceVersion.clone()
+		c.EpubSourceVersion = &v
+	}
+	if x.ThesisType != nil {
+		v := x.ThesisType.clone()
+		c.ThesisType = &v
+	}
+	if x.ThesisPresentedTo != nil {
+		v := x.ThesisPresentedTo.clone()
+		c.ThesisPresentedTo = &v
+	}
+	if x.ThesisYear != nil {
+		v := x.ThesisYear.clone()
+		c.ThesisYear = &v
+	}
+	if x.BASICMainSubject != nil {
+		v := x.BASICMainSubject.clone()
+		c.BASICMainSubject = &v
+	}
+	if x.BASICVersion != nil {
+		v := x.BASICVersion.clone()
+		c.BASICVersion = &v
+	}
+	if x.BICMainSubject != nil {
+		v := x.BICMainSubject.clone()
+		c.BICMainSubject = &v
+	}
+	if x.BICVersion != nil {
+		v := x.BICVersion.clone()
+		c.BICVersion = &v
+	}
+	if x.CoverImageFormatCode != nil {
+		v := x.CoverImageFormatCode.clone()
+		c.CoverImageFormatCode = &v
+	}
+	if x.CoverImageLinkTypeCode != nil {
+		v := x.CoverImageLinkTypeCode.clone()
+		c.CoverImageLinkTypeCode = &v
+	}
+	if x.CoverImageLink != nil {
+		v := x.CoverImageLink.clone()
+		c.CoverImageLink = &v
+	}
+	if x.PublishingStatus != nil {
+		v := x.PublishingStatus.clone()
+		c.PublishingStatus = &v
+	}
+	if x.PublishingStatusNote != nil {
+		v := x.PublishingStatusNote.clone()
+		c.PublishingStatusNote = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Product) equal(y Product) bool {
+	if (x.Dimensions == nil) != (y.Dimensions == nil) || (x.Dimensions != nil && !x.Dimensions.equal(*y.Dimensions)) {
+		return false
+	}
+	if (x.Weight == nil) != (y.Weight == nil) || (x.Weight != nil && !x.Weight.equal(*y.Weight)) {
+		return false
+	}
+	if len(x.Measures) != len(y.Measures) {
+		return false
+	}
+	for i := range x.Measures {
+		if !x.Measures[i].equal(y.Measures[i]) {
+			return false
+		}
+	}
+	if (x.Height == nil) != (y.Height == nil) || (x.Height != nil && !x.Height.equal(*y.Height)) {
+		return false
+	}
+	if (x.Width == nil) != (y.Width == nil) || (x.Width != nil && !x.Width.equal(*y.Width)) {
+		return false
+	}
+	if (x.Thickness == nil) != (y.Thickness == nil) || (x.Thickness != nil && !x.Thickness.equal(*y.Thickness)) {
+		return false
+	}
+	if !x.RecordReference.equal(y.RecordReference) {
+		return false
+	}
+	if !x.NotificationType.equal(y.NotificationType) {
+		return false
+	}
+	if (x.DeletionCode == nil) != (y.DeletionCode == nil) || (x.DeletionCode != nil && !x.DeletionCode.equal(*y.DeletionCode)) {
+		return false
+	}
+	if (x.DeletionText == nil) != (y.DeletionText == nil) || (x.DeletionText != nil && !x.DeletionText.equal(*y.DeletionText)) {
+		return false
+	}
+	if (x.RecordSourceType == nil) != (y.RecordSourceType == nil) || (x.RecordSourceType != nil && !x.RecordSourceType.equal(*y.RecordSourceType)) {
+		return false
+	}
+	if (x.RecordSourceName == nil) != (y.RecordSourceName == nil) || (x.RecordSourceName != nil && !x.RecordSourceName.equal(*y.RecordSourceName)) {
+		return false
+	}
+	if (x.ReplacedByISBN == nil) != (y.ReplacedByISBN == nil) || (x.ReplacedByISBN != nil && !x.ReplacedByISBN.equal(*y.ReplacedByISBN)) {
+		return false
+	}
+	if (x.ReplacedByEAN13 == nil) != (y.ReplacedByEAN13 == nil) || (x.ReplacedByEAN13 != nil && !x.ReplacedByEAN13.equal(*y.ReplacedByEAN13)) {
+		return false
+	}
+	if (x.AlternativeFormatISBN == nil) != (y.AlternativeFormatISBN == nil) || (x.AlternativeFormatISBN != nil && !x.AlternativeFormatISBN.equal(*y.AlternativeFormatISBN)) {
+		return false
+	}
+	if (x.AlternativeFormatEAN13 == nil) != (y.AlternativeFormatEAN13 == nil) || (x.AlternativeFormatEAN13 != nil && !x.AlternativeFormatEAN13.equal(*y.AlternativeFormatEAN13)) {
+		return false
+	}
+	if (x.AlternativeProductISBN == nil) != (y.AlternativeProductISBN == nil) || (x.AlternativeProductISBN != nil && !x.AlternativeProductISBN.equal(*y.AlternativeProductISBN)) {
+		return false
+	}
+	if (x.AlternativeProductEAN13 == nil) != (y.AlternativeProductEAN13 == nil) || (x.AlternativeProductEAN13 != nil && !x.AlternativeProductEAN13.equal(*y.AlternativeProductEAN13)) {
+		return false
+	}
+	if len(x.RelatedProducts) != len(y.RelatedProducts) {
+		return false
+	}
+	for i := range x.RelatedProducts {
+		if !x.RelatedProducts[i].equal(y.RelatedProducts[i]) {
+			return false
+		}
+	}
+	if (x.OutOfPrintDate == nil) != (y.OutOfPrintDate == nil) || (x.OutOfPrintDate != nil && !x.OutOfPrintDate.equal(*y.OutOfPrintDate)) {
+		return false
+	}
+	if len(x.SupplyDetails) != len(y.SupplyDetails) {
+		return false
+	}
+	for i := range x.SupplyDetails {
+		if !x.SupplyDetails[i].equal(y.SupplyDetails[i]) {
+			return false
+		}
+	}
+	if len(x.MarketRepresentations) != len(y.MarketRepresentations) {
+		return false
+	}
+	for i := range x.MarketRepresentations {
+		if !x.MarketRepresentations[i].equal(y.MarketRepresentations[i]) {
+			return false
+		}
+	}
+	if (x.PromotionCampaign == nil) != (y.PromotionCampaign == nil) || (x.PromotionCampaign != nil && !x.PromotionCampaign.equal(*y.PromotionCampaign)) {
+		return false
+	}
+	if (x.PromotionContact == nil) != (y.PromotionContact == nil) || (x.PromotionContact != nil && !x.PromotionContact.equal(*y.PromotionContact)) {
+		return false
+	}
+	if (x.InitialPrintRun == nil) != (y.InitialPrintRun == nil) || (x.InitialPrintRun != nil && !x.InitialPrintRun.equal(*y.InitialPrintRun)) {
+		return false
+	}
+	if len(x.ReprintDetails) != len(y.ReprintDetails) {
+		return false
+	}
+	for i := range x.ReprintDetails {
+		if !x.ReprintDetails[i].equal(y.ReprintDetails[i]) {
+			return false
+		}
+	}
+	if (x.CopiesSold == nil) != (y.CopiesSold == nil) || (x.CopiesSold != nil && !x.CopiesSold.equal(*y.CopiesSold)) {
+		return false
+	}
+	if (x.BookClubAdoption == nil) != (y.BookClubAdoption == nil) || (x.BookClubAdoption != nil && !x.BookClubAdoption.equal(*y.BookClubAdoption)) {
+		return false
+	}
+	if (x.RecordSourceIdentifierType == nil) != (y.RecordSourceIdentifierType == nil) || (x.RecordSourceIdentifierType != nil && !x.RecordSourceIdentifierType.equal(*y.RecordSourceIdentifierType)) {
+		return false
+	}
+	if (x.RecordSourceIdentifier == nil) != (y.RecordSourceIdentifier == nil) || (x.RecordSourceIdentifier != nil && !x.RecordSourceIdentifier.equal(*y.RecordSourceIdentifier)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ISBN == nil) != (y.ISBN == nil) || (x.ISBN != nil && !x.ISBN.equal(*y.ISBN)) {
+		return false
+	}
+	if (x.EAN13 == nil) != (y.EAN13 == nil) || (x.EAN13 != nil && !x.EAN13.equal(*y.EAN13)) {
+		return false
+	}
+	if (x.UPC == nil) != (y.UPC == nil) || (x.UPC != nil && !x.UPC.equal(*y.UPC)) {
+		return false
+	}
+	if (x.PublisherProductNo == nil) != (y.PublisherProductNo == nil) || (x.PublisherProductNo != nil && !x.PublisherProductNo.equal(*y.PublisherProductNo)) {
+		return false
+	}
+	if (x.ISMN == nil) != (y.ISMN == nil) || (x.ISMN != nil && !x.ISMN.equal(*y.ISMN)) {
+		return false
+	}
+	if (x.DOI == nil) != (y.DOI == nil) || (x.DOI != nil && !x.DOI.equal(*y.DOI)) {
+		return false
+	}
+	if len(x.Seriess) != len(y.Seriess) {
+		return false
+	}
+	for i := range x.Seriess {
+		if !x.Seriess[i].equal(y.Seriess[i]) {
+			return false
+		}
+	}
+	if x.NoSeries != y.NoSeries {
+		return false
+	}
+	if len(x.Sets) != len(y.Sets) {
+		return false
+	}
+	for i := range x.Sets {
+		if !x.Sets[i].equal(y.Sets[i]) {
+			return false
+		}
+	}
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if (x.DistinctiveTitle == nil) != (y.DistinctiveTitle == nil) || (x.DistinctiveTitle != nil && !x.DistinctiveTitle.equal(*y.DistinctiveTitle)) {
+		return false
+	}
+	if (x.TitlePrefix == nil) != (y.TitlePrefix == nil) || (x.TitlePrefix != nil && !x.TitlePrefix.equal(*y.TitlePrefix)) {
+		return false
+	}
+	if (x.TitleWithoutPrefix == nil) != (y.TitleWithoutPrefix == nil) || (x.TitleWithoutPrefix != nil && !x.TitleWithoutPrefix.equal(*y.TitleWithoutPrefix)) {
+		return false
+	}
+	if (x.Subtitle == nil) != (y.Subtitle == nil) || (x.Subtitle != nil && !x.Subtitle.equal(*y.Subtitle)) {
+		return false
+	}
+	if (x.TranslationOfTitle == nil) != (y.TranslationOfTitle == nil) || (x.TranslationOfTitle != nil && !x.TranslationOfTitle.equal(*y.TranslationOfTitle)) {
+		return false
+	}
+	if len(x.FormerTitles) != len(y.FormerTitles) {
+		return false
+	}
+	for i := range x.FormerTitles {
+		if !x.FormerTitles[i].equal(y.FormerTitles[i]) {
+			return false
+		}
+	}
+	if x.NoContributor != y.NoContributor {
+		return false
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if (x.ContributorStatement == nil) != (y.ContributorStatement == nil) || (x.ContributorStatement != nil && !x.ContributorStatement.equal(*y.ContributorStatement)) {
+		return false
+	}
+	if (x.ConferenceDescription == nil) != (y.ConferenceDescription == nil) || (x.ConferenceDescription != nil && !x.ConferenceDescription.equal(*y.ConferenceDescription)) {
+		return false
+	}
+	if len(x.Conferences) != len(y.Conferences) {
+		return false
+	}
+	for i := range x.Conferences {
+		if !x.Conferences[i].equal(y.Conferences[i]) {
+			return false
+		}
+	}
+	if (x.ConferenceRole == nil) != (y.ConferenceRole == nil) || (x.ConferenceRole != nil && !x.ConferenceRole.equal(*y.ConferenceRole)) {
+		return false
+	}
+	if (x.ConferenceName == nil) != (y.ConferenceName == nil) || (x.ConferenceName != nil && !x.ConferenceName.equal(*y.ConferenceName)) {
+		return false
+	}
+	if (x.ConferenceNumber == nil) != (y.ConferenceNumber == nil) || (x.ConferenceNumber != nil && !x.ConferenceNumber.equal(*y.ConferenceNumber)) {
+		return false
+	}
+	if (x.ConferenceDate == nil) != (y.ConferenceDate == nil) || (x.ConferenceDate != nil && !x.ConferenceDate.equal(*y.ConferenceDate)) {
+		return false
+	}
+	if (x.ConferencePlace == nil) != (y.ConferencePlace == nil) || (x.ConferencePlace != nil && !x.ConferencePlace.equal(*y.ConferencePlace)) {
+		return false
+	}
+	if x.NoEdition != y.NoEdition {
+		return false
+	}
+	if len(x.EditionTypeCodes) != len(y.EditionTypeCodes) {
+		return false
+	}
+	for i := range x.EditionTypeCodes {
+		if !x.EditionTypeCodes[i].equal(y.EditionTypeCodes[i]) {
+			return false
+		}
+	}
+	if (x.EditionNumber == nil) != (y.EditionNumber == nil) || (x.EditionNumber != nil && !x.EditionNumber.equal(*y.EditionNumber)) {
+		return false
+	}
+	if (x.EditionVersionNumber == nil) != (y.EditionVersionNumber == nil) || (x.EditionVersionNumber != nil && !x.EditionVersionNumber.equal(*y.EditionVersionNumber)) {
+		return false
+	}
+	if (x.EditionStatement == nil) != (y.EditionStatement == nil) || (x.EditionStatement != nil && !x.EditionStatement.equal(*y.EditionStatement)) {
+		return false
+	}
+	if (x.PrizesDescription == nil) != (y.PrizesDescription == nil) || (x.PrizesDescription != nil && !x.PrizesDescription.equal(*y.PrizesDescription)) {
+		return false
+	}
+	if len(x.Prizes) != len(y.Prizes) {
+		return false
+	}
+	for i := range x.Prizes {
+		if !x.Prizes[i].equal(y.Prizes[i]) {
+			return false
+		}
+	}
+	if len(x.Publishers) != len(y.Publishers) {
+		return false
+	}
+	for i := range x.Publishers {
+		if !x.Publishers[i].equal(y.Publishers[i]) {
+			return false
+		}
+	}
+	if (x.ImprintName == nil) != (y.ImprintName == nil) || (x.ImprintName != nil && !x.ImprintName.equal(*y.ImprintName)) {
+		return false
+	}
+	if len(x.Imprints) != len(y.Imprints) {
+		return false
+	}
+	for i := range x.Imprints {
+		if !x.Imprints[i].equal(y.Imprints[i]) {
+			return false
+		}
+	}
+	if (x.PublisherName == nil) != (y.PublisherName == nil) || (x.PublisherName != nil && !x.PublisherName.equal(*y.PublisherName)) {
+		return false
+	}
+	if len(x.CopyrightStatements) != len(y.CopyrightStatements) {
+		return false
+	}
+	for i := range x.CopyrightStatements {
+		if !x.CopyrightStatements[i].equal(y.CopyrightStatements[i]) {
+			return false
+		}
+	}
+	if (x.CopyrightYear == nil) != (y.CopyrightYear == nil) || (x.CopyrightYear != nil && !x.CopyrightYear.equal(*y.CopyrightYear)) {
+		return false
+	}
+	if len(x.Barcodes) != len(y.Barcodes) {
+		return false
+	}
+	for i := range x.Barcodes {
+		if !x.Barcodes[i].equal(y.Barcodes[i]) {
+			return false
+		}
+	}
+	if (x.ReplacesISBN == nil) != (y.ReplacesISBN == nil) || (x.ReplacesISBN != nil && !x.ReplacesISBN.equal(*y.ReplacesISBN)) {
+		return false
+	}
+	if (x.ReplacesEAN13 == nil) != (y.ReplacesEAN13 == nil) || (x.ReplacesEAN13 != nil && !x.ReplacesEAN13.equal(*y.ReplacesEAN13)) {
+		return false
+	}
+	if (x.ProductForm == nil) != (y.ProductForm == nil) || (x.ProductForm != nil && !x.ProductForm.equal(*y.ProductForm)) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if len(x.ProductFormFeatures) != len(y.ProductFormFeatures) {
+		return false
+	}
+	for i := range x.ProductFormFeatures {
+		if !x.ProductFormFeatures[i].equal(y.ProductFormFeatures[i]) {
+			return false
+		}
+	}
+	if len(x.BookFormDetails) != len(y.BookFormDetails) {
+		return false
+	}
+	for i := range x.BookFormDetails {
+		if !x.BookFormDetails[i].equal(y.BookFormDetails[i]) {
+			return false
+		}
+	}
+	if (x.ProductPackaging == nil) != (y.ProductPackaging == nil) || (x.ProductPackaging != nil && !x.ProductPackaging.equal(*y.ProductPackaging)) {
+		return false
+	}
+	if (x.ProductFormDescription == nil) != (y.ProductFormDescription == nil) || (x.ProductFormDescription != nil && !x.ProductFormDescription.equal(*y.ProductFormDescription)) {
+		return false
+	}
+	if (x.NumberOfPieces == nil) != (y.NumberOfPieces == nil) || (x.NumberOfPieces != nil && !x.NumberOfPieces.equal(*y.NumberOfPieces)) {
+		return false
+	}
+	if (x.TradeCategory == nil) != (y.TradeCategory == nil) || (x.TradeCategory != nil && !x.TradeCategory.equal(*y.TradeCategory)) {
+		return false
+	}
+	if len(x.ProductContentTypes) != len(y.ProductContentTypes) {
+		return false
+	}
+	for i := range x.ProductContentTypes {
+		if !x.ProductContentTypes[i].equal(y.ProductContentTypes[i]) {
+			return false
+		}
+	}
+	if len(x.ContainedItems) != len(y.ContainedItems) {
+		return false
+	}
+	for i := range x.ContainedItems {
+		if !x.ContainedItems[i].equal(y.ContainedItems[i]) {
+			return false
+		}
+	}
+	if len(x.ProductClassifications) != len(y.ProductClassifications) {
+		return false
+	}
+	for i := range x.ProductClassifications {
+		if !x.ProductClassifications[i].equal(y.ProductClassifications[i]) {
+			return false
+		}
+	}
+	if (x.TextCaseFlag == nil) != (y.TextCaseFlag == nil) || (x.TextCaseFlag != nil && !x.TextCaseFlag.equal(*y.TextCaseFlag)) {
+		return false
+	}
+	if len(x.WorkIdentifiers) != len(y.WorkIdentifiers) {
+		return false
+	}
+	for i := range x.WorkIdentifiers {
+		if !x.WorkIdentifiers[i].equal(y.WorkIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.ReligiousText == nil) != (y.ReligiousText == nil) || (x.ReligiousText != nil && !x.ReligiousText.equal(*y.ReligiousText)) {
+		return false
+	}
+	if len(x.LanguageOfTexts) != len(y.LanguageOfTexts) {
+		return false
+	}
+	for i := range x.LanguageOfTexts {
+		if !x.LanguageOfTexts[i].equal(y.LanguageOfTexts[i]) {
+			return false
+		}
+	}
+	if (x.OriginalLanguage == nil) != (y.OriginalLanguage == nil) || (x.OriginalLanguage != nil && !x.OriginalLanguage.equal(*y.OriginalLanguage)) {
+		return false
+	}
+	if len(x.Languages) != len(y.Languages) {
+		return false
+	}
+	for i := range x.Languages {
+		if !x.Languages[i].equal(y.Languages[i]) {
+			return false
+		}
+	}
+	if (x.NumberOfPages == nil) != (y.NumberOfPages == nil) || (x.NumberOfPages != nil && !x.NumberOfPages.equal(*y.NumberOfPages)) {
+		return false
+	}
+	if (x.PagesRoman == nil) != (y.PagesRoman == nil) || (x.PagesRoman != nil && !x.PagesRoman.equal(*y.PagesRoman)) {
+		return false
+	}
+	if (x.PagesArabic == nil) != (y.PagesArabic == nil) || (x.PagesArabic != nil && !x.PagesArabic.equal(*y.PagesArabic)) {
+		return false
+	}
+	if len(x.Extents) != len(y.Extents) {
+		return false
+	}
+	for i := range x.Extents {
+		if !x.Extents[i].equal(y.Extents[i]) {
+			return false
+		}
+	}
+	if (x.NumberOfIllustrations == nil) != (y.NumberOfIllustrations == nil) || (x.NumberOfIllustrations != nil && !x.NumberOfIllustrations.equal(*y.NumberOfIllustrations)) {
+		return false
+	}
+	if (x.IllustrationsNote == nil) != (y.IllustrationsNote == nil) || (x.IllustrationsNote != nil && !x.IllustrationsNote.equal(*y.IllustrationsNote)) {
+		return false
+	}
+	if len(x.Illustrationss) != len(y.Illustrationss) {
+		return false
+	}
+	for i := range x.Illustrationss {
+		if !x.Illustrationss[i].equal(y.Illustrationss[i]) {
+			return false
+		}
+	}
+	if len(x.MapScales) != len(y.MapScales) {
+		return false
+	}
+	for i := range x.MapScales {
+		if !x.MapScales[i].equal(y.MapScales[i]) {
+			return false
+		}
+	}
+	if len(x.MainSubjects) != len(y.MainSubjects) {
+		return false
+	}
+	for i := range x.MainSubjects {
+		if !x.MainSubjects[i].equal(y.MainSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.Subjects) != len(y.Subjects) {
+		return false
+	}
+	for i := range x.Subjects {
+		if !x.Subjects[i].equal(y.Subjects[i]) {
+			return false
+		}
+	}
+	if len(x.PersonAsSubjects) != len(y.PersonAsSubjects) {
+		return false
+	}
+	for i := range x.PersonAsSubjects {
+		if !x.PersonAsSubjects[i].equal(y.PersonAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.CorporateBodyAsSubjects) != len(y.CorporateBodyAsSubjects) {
+		return false
+	}
+	for i := range x.CorporateBodyAsSubjects {
+		if !x.CorporateBodyAsSubjects[i].equal(y.CorporateBodyAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.PlaceAsSubjects) != len(y.PlaceAsSubjects) {
+		return false
+	}
+	for i := range x.PlaceAsSubjects {
+		if !x.PlaceAsSubjects[i].equal(y.PlaceAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.AudienceCodes) != len(y.AudienceCodes) {
+		return false
+	}
+	for i := range x.AudienceCodes {
+		if !x.AudienceCodes[i].equal(y.AudienceCodes[i]) {
+			return false
+		}
+	}
+	if len(x.Audiences) != len(y.Audiences) {
+		return false
+	}
+	for i := range x.Audiences {
+		if !x.Audiences[i].equal(y.Audiences[i]) {
+			return false
+		}
+	}
+	if (x.USSchoolGrade == nil) != (y.USSchoolGrade == nil) || (x.USSchoolGrade != nil && !x.USSchoolGrade.equal(*y.USSchoolGrade)) {
+		return false
+	}
+	if (x.InterestAge == nil) != (y.InterestAge == nil) || (x.InterestAge != nil && !x.InterestAge.equal(*y.InterestAge)) {
+		return false
+	}
+	if len(x.AudienceRanges) != len(y.AudienceRanges) {
+		return false
+	}
+	for i := range x.AudienceRanges {
+		if !x.AudienceRanges[i].equal(y.AudienceRanges[i]) {
+			return false
+		}
+	}
+	if (x.AudienceDescription == nil) != (y.AudienceDescription == nil) || (x.AudienceDescription != nil && !x.AudienceDescription.equal(*y.AudienceDescription)) {
+		return false
+	}
+	if len(x.Complexitys) != len(y.Complexitys) {
+		return false
+	}
+	for i := range x.Complexitys {
+		if !x.Complexitys[i].equal(y.Complexitys[i]) {
+			return false
+		}
+	}
+	if (x.Annotation == nil) != (y.Annotation == nil) || (x.Annotation != nil && !x.Annotation.equal(*y.Annotation)) {
+		return false
+	}
+	if (x.MainDescription == nil) != (y.MainDescription == nil) || (x.MainDescription != nil && !x.MainDescription.equal(*y.MainDescription)) {
+		return false
+	}
+	if len(x.OtherTexts) != len(y.OtherTexts) {
+		return false
+	}
+	for i := range x.OtherTexts {
+		if !x.OtherTexts[i].equal(y.OtherTexts[i]) {
+			return false
+		}
+	}
+	if len(x.ReviewQuotes) != len(y.ReviewQuotes) {
+		return false
+	}
+	for i := range x.ReviewQuotes {
+		if !x.ReviewQuotes[i].equal(y.ReviewQuotes[i]) {
+			return false
+		}
+	}
+	if len(x.MediaFiles) != len(y.MediaFiles) {
+		return false
+	}
+	for i := range x.MediaFiles {
+		if !x.MediaFiles[i].equal(y.MediaFiles[i]) {
+			return false
+		}
+	}
+	if len(x.ProductWebsites) != len(y.ProductWebsites) {
+		return false
+	}
+	for i := range x.ProductWebsites {
+		if !x.ProductWebsites[i].equal(y.ProductWebsites[i]) {
+			return false
+		}
+	}
+	if len(x.ContentItems) != len(y.ContentItems) {
+		return false
+	}
+	for i := range x.ContentItems {
+		if !x.ContentItems[i].equal(y.ContentItems[i]) {
+			return false
+		}
+	}
+	if len(x.CityOfPublications) != len(y.CityOfPublications) {
+		return false
+	}
+	for i := range x.CityOfPublications {
+		if !x.CityOfPublications[i].equal(y.CityOfPublications[i]) {
+			return false
+		}
+	}
+	if (x.CountryOfPublication == nil) != (y.CountryOfPublication == nil) || (x.CountryOfPublication != nil && !x.CountryOfPublication.equal(*y.CountryOfPublication)) {
+		return false
+	}
+	if len(x.CopublisherNames) != len(y.CopublisherNames) {
+		return false
+	}
+	for i := range x.CopublisherNames {
+		if !x.CopublisherNames[i].equal(y.CopublisherNames[i]) {
+			return false
+		}
+	}
+	if len(x.SponsorNames) != len(y.SponsorNames) {
+		return false
+	}
+	for i := range x.SponsorNames {
+		if !x.SponsorNames[i].equal(y.SponsorNames[i]) {
+			return false
+		}
+	}
+	if (x.OriginalPublisher == nil) != (y.OriginalPublisher == nil) || (x.OriginalPublisher != nil && !x.OriginalPublisher.equal(*y.OriginalPublisher)) {
+		return false
+	}
+	if (x.AnnouncementDate == nil) != (y.AnnouncementDate == nil) || (x.AnnouncementDate != nil && !x.AnnouncementDate.equal(*y.AnnouncementDate)) {
+		return false
+	}
+	if (x.TradeAnnouncementDate == nil) != (y.TradeAnnouncementDate == nil) || (x.TradeAnnouncementDate != nil && !x.TradeAnnouncementDate.equal(*y.TradeAnnouncementDate)) {
+		return false
+	}
+	if (x.PublicationDate == nil) != (y.PublicationDate == nil) || (x.PublicationDate != nil && !x.PublicationDate.equal(*y.PublicationDate)) {
+		return false
+	}
+	if (x.YearFirstPublished == nil) != (y.YearFirstPublished == nil) || (x.YearFirstPublished != nil && !x.YearFirstPublished.equal(*y.YearFirstPublished)) {
+		return false
+	}
+	if len(x.SalesRightss) != len(y.SalesRightss) {
+		return false
+	}
+	for i := range x.SalesRightss {
+		if !x.SalesRightss[i].equal(y.SalesRightss[i]) {
+			return false
+		}
+	}
+	if len(x.NotForSales) != len(y.NotForSales) {
+		return false
+	}
+	for i := range x.NotForSales {
+		if !x.NotForSales[i].equal(y.NotForSales[i]) {
+			return false
+		}
+	}
+	if len(x.SalesRestrictions) != len(y.SalesRestrictions) {
+		return false
+	}
+	for i := range x.SalesRestrictions {
+		if !x.SalesRestrictions[i].equal(y.SalesRestrictions[i]) {
+			return false
+		}
+	}
+	if (x.EpubType == nil) != (y.EpubType == nil) || (x.EpubType != nil && !x.EpubType.equal(*y.EpubType)) {
+		return false
+	}
+	if (x.EpubTypeVersion == nil) != (y.EpubTypeVersion == nil) || (x.EpubTypeVersion != nil && !x.EpubTypeVersion.equal(*y.EpubTypeVersion)) {
+		return false
+	}
+	if (x.EpubTypeDescription == nil) != (y.EpubTypeDescription == nil) || (x.EpubTypeDescription != nil && !x.EpubTypeDescription.equal(*y.EpubTypeDescription)) {
+		return false
+	}
+	if (x.EpubFormatDescription == nil) != (y.EpubFormatDescription == nil) || (x.EpubFormatDescription != nil && !x.EpubFormatDescription.equal(*y.EpubFormatDescription)) {
+		return false
+	}
+	if (x.EpubSourceDescription == nil) != (y.EpubSourceDescription == nil) || (x.EpubSourceDescription != nil && !x.EpubSourceDescription.equal(*y.EpubSourceDescription)) {
+		return false
+	}
+	if (x.EpubTypeNote == nil) != (y.EpubTypeNote == nil) || (x.EpubTypeNote != nil && !x.EpubTypeNote.equal(*y.EpubTypeNote)) {
+		return false
+	}
+	if (x.EpubFormat == nil) != (y.EpubFormat == nil) || (x.EpubFormat != nil && !x.EpubFormat.equal(*y.EpubFormat)) {
+		return false
+	}
+	if (x.EpubFormatVersion == nil) != (y.EpubFormatVersion == nil) || (x.EpubFormatVersion != nil && !x.EpubFormatVersion.equal(*y.EpubFormatVersion)) {
+		return false
+	}
+	if (x.EpubSource == nil) != (y.EpubSource == nil) || (x.EpubSource != nil && !x.EpubSource.equal(*y.EpubSource)) {
+		return false
+	}
+	if (x.EpubSourceVersion == nil) != (y.EpubSourceVersion == nil) || (x.EpubSourceVersion != nil && !x.EpubSourceVersion.equal(*y.EpubSourceVersion)) {
+		return false
+	}
+	if (x.ThesisType == nil) != (y.ThesisType == nil) || (x.ThesisType != nil && !x.ThesisType.equal(*y.ThesisType)) {
+		return false
+	}
+	if (x.ThesisPresentedTo == nil) != (y.ThesisPresentedTo == nil) || (x.ThesisPresentedTo != nil && !x.ThesisPresentedTo.equal(*y.ThesisPresentedTo)) {
+		return false
+	}
+	if (x.ThesisYear == nil) != (y.ThesisYear == nil) || (x.ThesisYear != nil && !x.ThesisYear.equal(*y.ThesisYear)) {
+		return false
+	}
+	if (x.BASICMainSubject == nil) != (y.BASICMainSubject == nil) || (x.BASICMainSubject != nil && !x.BASICMainSubject.equal(*y.BASICMainSubject)) {
+		return false
+	}
+	if (x.BASICVersion == nil) != (y.BASICVersion == nil) || (x.BASICVersion != nil && !x.BASICVersion.equal(*y.BASICVersion)) {
+		return false
+	}
+	if (x.BICMainSubject == nil) != (y.BICMainSubject == nil) || (x.BICMainSubject != nil && !x.BICMainSubject.equal(*y.BICMainSubject)) {
+		return false
+	}
+	if (x.BICVersion == nil) != (y.BICVersion == nil) || (x.BICVersion != nil && !x.BICVersion.equal(*y.BICVersion)) {
+		return false
+	}
+	if (x.CoverImageFormatCode == nil) != (y.CoverImageFormatCode == nil) || (x.CoverImageFormatCode != nil && !x.CoverImageFormatCode.equal(*y.CoverImageFormatCode)) {
+		return false
+	}
+	if (x.CoverImageLinkTypeCode == nil) != (y.CoverImageLinkTypeCode == nil) || (x.CoverImageLinkTypeCode != nil && !x.CoverImageLinkTypeCode.equal(*y.CoverImageLinkTypeCode)) {
+		return false
+	}
+	if (x.CoverImageLink == nil) != (y.CoverImageLink == nil) || (x.CoverImageLink != nil && !x.CoverImageLink.equal(*y.CoverImageLink)) {
+		return false
+	}
+	if (x.PublishingStatus == nil) != (y.PublishingStatus == nil) || (x.PublishingStatus != nil && !x.PublishingStatus.equal(*y.PublishingStatus)) {
+		return false
+	}
+	if (x.PublishingStatusNote == nil) != (y.PublishingStatusNote == nil) || (x.PublishingStatusNote != nil && !x.PublishingStatusNote.equal(*y.PublishingStatusNote)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductClassification) clone() ProductClassification {
+	c := x
+	c.ProductClassificationType = x.ProductClassificationType.clone()
+	c.ProductClassificationCode = x.ProductClassificationCode.clone()
+	if x.Percent != nil {
+		v := x.Percent.clone()
+		c.Percent = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductClassification) equal(y ProductClassification) bool {
+	if !x.ProductClassificationType.equal(y.ProductClassificationType) {
+		return false
+	}
+	if !x.ProductClassificationCode.equal(y.ProductClassificationCode) {
+		return false
+	}
+	if (x.Percent == nil) != (y.Percent == nil) || (x.Percent != nil && !x.Percent.equal(*y.Percent)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductFormFeature) clone() ProductFormFeature {
+	c := x
+	c.ProductFormFeatureType = x.ProductFormFeatureType.clone()
+	if x.ProductFormFeatureValue != nil {
+		v := x.ProductFormFeatureValue.clone()
+		c.ProductFormFeatureValue = &v
+	}
+	if x.ProductFormFeatureDescription != nil {
+		v := x.ProductFormFeatureDescription.clone()
+		c.ProductFormFeatureDescription = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductFormFeature) equal(y ProductFormFeature) bool {
+	if !x.ProductFormFeatureType.equal(y.ProductFormFeatureType) {
+		return false
+	}
+	if (x.ProductFormFeatureValue == nil) != (y.ProductFormFeatureValue == nil) || (x.ProductFormFeatureValue != nil && !x.ProductFormFeatureValue.equal(*y.ProductFormFeatureValue)) {
+		return false
+	}
+	if (x.ProductFormFeatureDescription == nil) != (y.ProductFormFeatureDescription == nil) || (x.ProductFormFeatureDescription != nil && !x.ProductFormFeatureDescription.equal(*y.ProductFormFeatureDescription)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductIdentifier) clone() ProductIdentifier {
+	c := x
+	c.ProductIDType = x.ProductIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductIdentifier) equal(y ProductIdentifier) bool {
+	if !x.ProductIDType.equal(y.ProductIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductWebsite) clone() ProductWebsite {
+	c := x
+	if x.WebsiteRole != nil {
+		v := x.WebsiteRole.clone()
+		c.WebsiteRole = &v
+	}
+	if x.ProductWebsiteDescription != nil {
+		v := x.ProductWebsiteDescription.clone()
+		c.ProductWebsiteDescription = &v
+	}
+	c.ProductWebsiteLink = x.ProductWebsiteLink.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductWebsite) equal(y ProductWebsite) bool {
+	if (x.WebsiteRole == nil) != (y.WebsiteRole == nil) || (x.WebsiteRole != nil && !x.WebsiteRole.equal(*y.WebsiteRole)) {
+		return false
+	}
+	if (x.ProductWebsiteDescription == nil) != (y.ProductWebsiteDescription == nil) || (x.ProductWebsiteDescription != nil && !x.ProductWebsiteDescription.equal(*y.ProductWebsiteDescription)) {
+		return false
+	}
+	if !x.ProductWebsiteLink.equal(y.ProductWebsiteLink) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProfessionalAffiliation) clone() ProfessionalAffiliation {
+	c := x
+	if x.Affiliation != nil {
+		v := x.Affiliation.clone()
+		c.Affiliation = &v
+	}
+	if x.ProfessionalPosition != nil {
+		v := x.ProfessionalPosition.clone()
+		c.ProfessionalPosition = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProfessionalAffiliation) equal(y ProfessionalAffiliation) bool {
+	if (x.Affiliation == nil) != (y.Affiliation == nil) || (x.Affiliation != nil && !x.Affiliation.equal(*y.Affiliation)) {
+		return false
+	}
+	if (x.ProfessionalPosition == nil) != (y.ProfessionalPosition == nil) || (x.ProfessionalPosition != nil && !x.ProfessionalPosition.equal(*y.ProfessionalPosition)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Publisher) clone() Publisher {
+	c := x
+	if x.PublisherName != nil {
+		v := x.PublisherName.clone()
+		c.PublisherName = &v
+	}
+	if x.NameCodeType != nil {
+		v := x.NameCodeType.clone()
+		c.NameCodeType = &v
+	}
+	if x.NameCodeTypeName != nil {
+		v := x.NameCodeTypeName.clone()
+		c.NameCodeTypeName = &v
+	}
+	if x.NameCodeValue != nil {
+		v := x.NameCodeValue.clone()
+		c.NameCodeValue = &v
+	}
+	if x.PublishingRole != nil {
+		v := x.PublishingRole.clone()
+		c.PublishingRole = &v
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Publisher) equal(y Publisher) bool {
+	if (x.PublisherName == nil) != (y.PublisherName == nil) || (x.PublisherName != nil && !x.PublisherName.equal(*y.PublisherName)) {
+		return false
+	}
+	if (x.NameCodeType == nil) != (y.NameCodeType == nil) || (x.NameCodeType != nil && !x.NameCodeType.equal(*y.NameCodeType)) {
+		return false
+	}
+	if (x.NameCodeTypeName == nil) != (y.NameCodeTypeName == nil) || (x.NameCodeTypeName != nil && !x.NameCodeTypeName.equal(*y.NameCodeTypeName)) {
+		return false
+	}
+	if (x.NameCodeValue == nil) != (y.NameCodeValue == nil) || (x.NameCodeValue != nil && !x.NameCodeValue.equal(*y.NameCodeValue)) {
+		return false
+	}
+	if (x.PublishingRole == nil) != (y.PublishingRole == nil) || (x.PublishingRole != nil && !x.PublishingRole.equal(*y.PublishingRole)) {
+		return false
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Reissue) clone() Reissue {
+	c := x
+	c.ReissueDate = x.ReissueDate.clone()
+	if x.ReissueDescription != nil {
+		v := x.ReissueDescription.clone()
+		c.ReissueDescription = &v
+	}
+	if x.Prices != nil {
+		c.Prices = make([]Price, len(x.Prices))
+		for i, v := range x.Prices {
+			c.Prices[i] = v.clone()
+		}
+	}
+	if x.MediaFiles != nil {
+		c.MediaFiles = make([]MediaFile, len(x.MediaFiles))
+		for i, v := range x.MediaFiles {
+			c.MediaFiles[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Reissue) equal(y Reissue) bool {
+	if !x.ReissueDate.equal(y.ReissueDate) {
+		return false
+	}
+	if (x.ReissueDescription == nil) != (y.ReissueDescription == nil) || (x.ReissueDescription != nil && !x.ReissueDescription.equal(*y.ReissueDescription)) {
+		return false
+	}
+	if len(x.Prices) != len(y.Prices) {
+		return false
+	}
+	for i := range x.Prices {
+		if !x.Prices[i].equal(y.Prices[i]) {
+			return false
+		}
+	}
+	if len(x.MediaFiles) != len(y.MediaFiles) {
+		return false
+	}
+	for i := range x.MediaFiles {
+		if !x.MediaFiles[i].equal(y.MediaFiles[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x RelatedProduct) clone() RelatedProduct {
+	c := x
+	if x.ISBN != nil {
+		v := x.ISBN.clone()
+		c.ISBN = &v
+	}
+	if x.EAN13 != nil {
+		v := x.EAN13.clone()
+		c.EAN13 = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.ProductForm != nil {
+		v := x.ProductForm.clone()
+		c.ProductForm = &v
+	}
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductFormFeatures != nil {
+		c.ProductFormFeatures = make([]ProductFormFeature, len(x.ProductFormFeatures))
+		for i, v := range x.ProductFormFeatures {
+			c.ProductFormFeatures[i] = v.clone()
+		}
+	}
+	if x.BookFormDetails != nil {
+		c.BookFormDetails = make([]BookFormDetail, len(x.BookFormDetails))
+		for i, v := range x.BookFormDetails {
+			c.BookFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductPackaging != nil {
+		v := x.ProductPackaging.clone()
+		c.ProductPackaging = &v
+	}
+	if x.ProductFormDescription != nil {
+		v := x.ProductFormDescription.clone()
+		c.ProductFormDescription = &v
+	}
+	c.RelationCode = x.RelationCode.clone()
+	if x.NumberOfPieces != nil {
+		v := x.NumberOfPieces.clone()
+		c.NumberOfPieces = &v
+	}
+	if x.TradeCategory != nil {
+		v := x.TradeCategory.clone()
+		c.TradeCategory = &v
+	}
+	if x.ProductContentTypes != nil {
+		c.ProductContentTypes = make([]ProductContentType, len(x.ProductContentTypes))
+		for i, v := range x.ProductContentTypes {
+			c.ProductContentTypes[i] = v.clone()
+		}
+	}
+	if x.Publishers != nil {
+		c.Publishers = make([]Publisher, len(x.Publishers))
+		for i, v := range x.Publishers {
+			c.Publishers[i] = v.clone()
+		}
+	}
+	if x.EpubType != nil {
+		v := x.EpubType.clone()
+		c.EpubType = &v
+	}
+	if x.EpubTypeVersion != nil {
+		v := x.EpubTypeVersion.clone()
+		c.EpubTypeVersion = &v
+	}
+	if x.EpubTypeDescription != nil {
+		v := x.EpubTypeDescription.clone()
+		c.EpubTypeDescription = &v
+	}
+	if x.EpubFormatDescription != nil {
+		v := x.EpubFormatDescription.clone()
+		c.EpubFormatDescription = &v
+	}
+	if x.EpubTypeNote != nil {
+		v := x.EpubTypeNote.clone()
+		c.EpubTypeNote = &v
+	}
+	if x.EpubFormat != nil {
+		v := x.EpubFormat.clone()
+		c.EpubFormat = &v
+	}
+	if x.EpubFormatVersion != nil {
+		v := x.EpubFormatVersion.clone()
+		c.EpubFormatVersion = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x RelatedProduct) equal(y RelatedProduct) bool {
+	if (x.ISBN == nil) != (y.ISBN == nil) || (x.ISBN != nil && !x.ISBN.equal(*y.ISBN)) {
+		return false
+	}
+	if (x.EAN13 == nil) != (y.EAN13 == nil) || (x.EAN13 != nil && !x.EAN13.equal(*y.EAN13)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.ProductForm == nil) != (y.ProductForm == nil) || (x.ProductForm != nil && !x.ProductForm.equal(*y.ProductForm)) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if len(x.ProductFormFeatures) != len(y.ProductFormFeatures) {
+		return false
+	}
+	for i := range x.ProductFormFeatures {
+		if !x.ProductFormFeatures[i].equal(y.ProductFormFeatures[i]) {
+			return false
+		}
+	}
+	if len(x.BookFormDetails) != len(y.BookFormDetails) {
+		return false
+	}
+	for i := range x.BookFormDetails {
+		if !x.BookFormDetails[i].equal(y.BookFormDetails[i]) {
+			return false
+		}
+	}
+	if (x.ProductPackaging == nil) != (y.ProductPackaging == nil) || (x.ProductPackaging != nil && !x.ProductPackaging.equal(*y.ProductPackaging)) {
+		return false
+	}
+	if (x.ProductFormDescription == nil) != (y.ProductFormDescription == nil) || (x.ProductFormDescription != nil && !x.ProductFormDescription.equal(*y.ProductFormDescription)) {
+		return false
+	}
+	if !x.RelationCode.equal(y.RelationCode) {
+		return false
+	}
+	if (x.NumberOfPieces == nil) != (y.NumberOfPieces == nil) || (x.NumberOfPieces != nil && !x.NumberOfPieces.equal(*y.NumberOfPieces)) {
+		return false
+	}
+	if (x.TradeCategory == nil) != (y.TradeCategory == nil) || (x.TradeCategory != nil && !x.TradeCategory.equal(*y.TradeCategory)) {
+		return false
+	}
+	if len(x.ProductContentTypes) != len(y.ProductContentTypes) {
+		return false
+	}
+	for i := range x.ProductContentTypes {
+		if !x.ProductContentTypes[i].equal(y.ProductContentTypes[i]) {
+			return false
+		}
+	}
+	if len(x.Publishers) != len(y.Publishers) {
+		return false
+	}
+	for i := range x.Publishers {
+		if !x.Publishers[i].equal(y.Publishers[i]) {
+			return false
+		}
+	}
+	if (x.EpubType == nil) != (y.EpubType == nil) || (x.EpubType != nil && !x.EpubType.equal(*y.EpubType)) {
+		return false
+	}
+	if (x.EpubTypeVersion == nil) != (y.EpubTypeVersion == nil) || (x.EpubTypeVersion != nil && !x.EpubTypeVersion.equal(*y.EpubTypeVersion)) {
+		return false
+	}
+	if (x.EpubTypeDescription == nil) != (y.EpubTypeDescription == nil) || (x.EpubTypeDescription != nil && !x.EpubTypeDescription.equal(*y.EpubTypeDescription)) {
+		return false
+	}
+	if (x.EpubFormatDescription == nil) != (y.EpubFormatDescription == nil) || (x.EpubFormatDescription != nil && !x.EpubFormatDescription.equal(*y.EpubFormatDescription)) {
+		return false
+	}
+	if (x.EpubTypeNote == nil) != (y.EpubTypeNote == nil) || (x.EpubTypeNote != nil && !x.EpubTypeNote.equal(*y.EpubTypeNote)) {
+		return false
+	}
+	if (x.EpubFormat == nil) != (y.EpubFormat == nil) || (x.EpubFormat != nil && !x.EpubFormat.equal(*y.EpubFormat)) {
+		return false
+	}
+	if (x.EpubFormatVersion == nil) != (y.EpubFormatVersion == nil) || (x.EpubFormatVersion != nil && !x.EpubFormatVersion.equal(*y.EpubFormatVersion)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReligiousText) clone() ReligiousText {
+	c := x
+	if x.Bible != nil {
+		v := x.Bible.clone()
+		c.Bible = &v
+	}
+	if x.ReligiousTextID != nil {
+		v := x.ReligiousTextID.clone()
+		c.ReligiousTextID = &v
+	}
+	if x.ReligiousTextFeatures != nil {
+		c.ReligiousTextFeatures = make([]ReligiousTextFeature, len(x.ReligiousTextFeatures))
+		for i, v := range x.ReligiousTextFeatures {
+			c.ReligiousTextFeatures[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReligiousText) equal(y ReligiousText) bool {
+	if (x.Bible == nil) != (y.Bible == nil) || (x.Bible != nil && !x.Bible.equal(*y.Bible)) {
+		return false
+	}
+	if (x.ReligiousTextID == nil) != (y.ReligiousTextID == nil) || (x.ReligiousTextID != nil && !x.ReligiousTextID.equal(*y.ReligiousTextID)) {
+		return false
+	}
+	if len(x.ReligiousTextFeatures) != len(y.ReligiousTextFeatures) {
+		return false
+	}
+	for i := range x.ReligiousTextFeatures {
+		if !x.ReligiousTextFeatures[i].equal(y.ReligiousTextFeatures[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReligiousTextFeature) clone() ReligiousTextFeature {
+	c := x
+	c.ReligiousTextFeatureType = x.ReligiousTextFeatureType.clone()
+	c.ReligiousTextFeatureCode = x.ReligiousTextFeatureCode.clone()
+	if x.ReligiousTextFeatureDescription != nil {
+		v := x.ReligiousTextFeatureDescription.clone()
+		c.ReligiousTextFeatureDescription = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReligiousTextFeature) equal(y ReligiousTextFeature) bool {
+	if !x.ReligiousTextFeatureType.equal(y.ReligiousTextFeatureType) {
+		return false
+	}
+	if !x.ReligiousTextFeatureCode.equal(y.ReligiousTextFeatureCode) {
+		return false
+	}
+	if (x.ReligiousTextFeatureDescription == nil) != (y.ReligiousTextFeatureDescription == nil) || (x.ReligiousTextFeatureDescription != nil && !x.ReligiousTextFeatureDescription.equal(*y.ReligiousTextFeatureDescription)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesOutlet) clone() SalesOutlet {
+	c := x
+	if x.SalesOutletName != nil {
+		v := x.SalesOutletName.clone()
+		c.SalesOutletName = &v
+	}
+	if x.SalesOutletIdentifier != nil {
+		v := x.SalesOutletIdentifier.clone()
+		c.SalesOutletIdentifier = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesOutlet) equal(y SalesOutlet) bool {
+	if (x.SalesOutletName == nil) != (y.SalesOutletName == nil) || (x.SalesOutletName != nil && !x.SalesOutletName.equal(*y.SalesOutletName)) {
+		return false
+	}
+	if (x.SalesOutletIdentifier == nil) != (y.SalesOutletIdentifier == nil) || (x.SalesOutletIdentifier != nil && !x.SalesOutletIdentifier.equal(*y.SalesOutletIdentifier)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesOutletIdentifier) clone() SalesOutletIdentifier {
+	c := x
+	c.SalesOutletIDType = x.SalesOutletIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesOutletIdentifier) equal(y SalesOutletIdentifier) bool {
+	if !x.SalesOutletIDType.equal(y.SalesOutletIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesRestriction) clone() SalesRestriction {
+	c := x
+	c.SalesRestrictionType = x.SalesRestrictionType.clone()
+	if x.SalesOutlets != nil {
+		c.SalesOutlets = make([]SalesOutlet, len(x.SalesOutlets))
+		for i, v := range x.SalesOutlets {
+			c.SalesOutlets[i] = v.clone()
+		}
+	}
+	if x.SalesRestrictionDetail != nil {
+		v := x.SalesRestrictionDetail.clone()
+		c.SalesRestrictionDetail = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesRestriction) equal(y SalesRestriction) bool {
+	if !x.SalesRestrictionType.equal(y.SalesRestrictionType) {
+		return false
+	}
+	if len(x.SalesOutlets) != len(y.SalesOutlets) {
+		return false
+	}
+	for i := range x.SalesOutlets {
+		if !x.SalesOutlets[i].equal(y.SalesOutlets[i]) {
+			return false
+		}
+	}
+	if (x.SalesRestrictionDetail == nil) != (y.SalesRestrictionDetail == nil) || (x.SalesRestrictionDetail != nil && !x.SalesRestrictionDetail.equal(*y.SalesRestrictionDetail)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesRights) clone() SalesRights {
+	c := x
+	if x.RightsTerritory != nil {
+		v := x.RightsTerritory.clone()
+		c.RightsTerritory = &v
+	}
+	if x.RightsRegions != nil {
+		c.RightsRegions = make([]RightsRegion, len(x.RightsRegions))
+		for i, v := range x.RightsRegions {
+			c.RightsRegions[i] = v.clone()
+		}
+	}
+	if x.RightsCountrys != nil {
+		c.RightsCountrys = make([]CountryCodeList, len(x.RightsCountrys))
+		for i, v := range x.RightsCountrys {
+			c.RightsCountrys[i] = v.clone()
+		}
+	}
+	c.SalesRightsType = x.SalesRightsType.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesRights) equal(y SalesRights) bool {
+	if (x.RightsTerritory == nil) != (y.RightsTerritory == nil) || (x.RightsTerritory != nil && !x.RightsTerritory.equal(*y.RightsTerritory)) {
+		return false
+	}
+	if len(x.RightsRegions) != len(y.RightsRegions) {
+		return false
+	}
+	for i := range x.RightsRegions {
+		if !x.RightsRegions[i].equal(y.RightsRegions[i]) {
+			return false
+		}
+	}
+	if len(x.RightsCountrys) != len(y.RightsCountrys) {
+		return false
+	}
+	for i := range x.RightsCountrys {
+		if !x.RightsCountrys[i].equal(y.RightsCountrys[i]) {
+			return false
+		}
+	}
+	if !x.SalesRightsType.equal(y.SalesRightsType) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SenderIdentifier) clone() SenderIdentifier {
+	c := x
+	c.SenderIDType = x.SenderIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SenderIdentifier) equal(y SenderIdentifier) bool {
+	if !x.SenderIDType.equal(y.SenderIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Series) clone() Series {
+	c := x
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.TitleOfSeries != nil {
+		v := x.TitleOfSeries.clone()
+		c.TitleOfSeries = &v
+	}
+	if x.SeriesISSN != nil {
+		v := x.SeriesISSN.clone()
+		c.SeriesISSN = &v
+	}
+	if x.PublisherSeriesCode != nil {
+		v := x.PublisherSeriesCode.clone()
+		c.PublisherSeriesCode = &v
+	}
+	if x.SeriesIdentifiers != nil {
+		c.SeriesIdentifiers = make([]SeriesIdentifier, len(x.SeriesIdentifiers))
+		for i, v := range x.SeriesIdentifiers {
+			c.SeriesIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.NumberWithinSeries != nil {
+		v := x.NumberWithinSeries.clone()
+		c.NumberWithinSeries = &v
+	}
+	if x.YearOfAnnual != nil {
+		v := x.YearOfAnnual.clone()
+		c.YearOfAnnual = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Series) equal(y Series) bool {
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if (x.TitleOfSeries == nil) != (y.TitleOfSeries == nil) || (x.TitleOfSeries != nil && !x.TitleOfSeries.equal(*y.TitleOfSeries)) {
+		return false
+	}
+	if (x.SeriesISSN == nil) != (y.SeriesISSN == nil) || (x.SeriesISSN != nil && !x.SeriesISSN.equal(*y.SeriesISSN)) {
+		return false
+	}
+	if (x.PublisherSeriesCode == nil) != (y.PublisherSeriesCode == nil) || (x.PublisherSeriesCode != nil && !x.PublisherSeriesCode.equal(*y.PublisherSeriesCode)) {
+		return false
+	}
+	if len(x.SeriesIdentifiers) != len(y.SeriesIdentifiers) {
+		return false
+	}
+	for i := range x.SeriesIdentifiers {
+		if !x.SeriesIdentifiers[i].equal(y.SeriesIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if (x.NumberWithinSeries == nil) != (y.NumberWithinSeries == nil) || (x.NumberWithinSeries != nil && !x.NumberWithinSeries.equal(*y.NumberWithinSeries)) {
+		return false
+	}
+	if (x.YearOfAnnual == nil) != (y.YearOfAnnual == nil) || (x.YearOfAnnual != nil && !x.YearOfAnnual.equal(*y.YearOfAnnual)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SeriesIdentifier) clone() SeriesIdentifier {
+	c := x
+	c.SeriesIDType = x.SeriesIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SeriesIdentifier) equal(y SeriesIdentifier) bool {
+	if !x.SeriesIDType.equal(y.SeriesIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Set) clone() Set {
+	c := x
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.TitleOfSet != nil {
+		v := x.TitleOfSet.clone()
+		c.TitleOfSet = &v
+	}
+	if x.ISBNOfSet != nil {
+		v := x.ISBNOfSet.clone()
+		c.ISBNOfSet = &v
+	}
+	if x.EAN13OfSet != nil {
+		v := x.EAN13OfSet.clone()
+		c.EAN13OfSet = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SetPartNumber != nil {
+		v := x.SetPartNumber.clone()
+		c.SetPartNumber = &v
+	}
+	if x.SetPartTitle != nil {
+		v := x.SetPartTitle.clone()
+		c.SetPartTitle = &v
+	}
+	if x.ItemNumberWithinSet != nil {
+		v := x.ItemNumberWithinSet.clone()
+		c.ItemNumberWithinSet = &v
+	}
+	if x.LevelSequenceNumber != nil {
+		v := x.LevelSequenceNumber.clone()
+		c.LevelSequenceNumber = &v
+	}
+	if x.SetItemTitle != nil {
+		v := x.SetItemTitle.clone()
+		c.SetItemTitle = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Set) equal(y Set) bool {
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if (x.TitleOfSet == nil) != (y.TitleOfSet == nil) || (x.TitleOfSet != nil && !x.TitleOfSet.equal(*y.TitleOfSet)) {
+		return false
+	}
+	if (x.ISBNOfSet == nil) != (y.ISBNOfSet == nil) || (x.ISBNOfSet != nil && !x.ISBNOfSet.equal(*y.ISBNOfSet)) {
+		return false
+	}
+	if (x.EAN13OfSet == nil) != (y.EAN13OfSet == nil) || (x.EAN13OfSet != nil && !x.EAN13OfSet.equal(*y.EAN13OfSet)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SetPartNumber == nil) != (y.SetPartNumber == nil) || (x.SetPartNumber != nil && !x.SetPartNumber.equal(*y.SetPartNumber)) {
+		return false
+	}
+	if (x.SetPartTitle == nil) != (y.SetPartTitle == nil) || (x.SetPartTitle != nil && !x.SetPartTitle.equal(*y.SetPartTitle)) {
+		return false
+	}
+	if (x.ItemNumberWithinSet == nil) != (y.ItemNumberWithinSet == nil) || (x.ItemNumberWithinSet != nil && !x.ItemNumberWithinSet.equal(*y.ItemNumberWithinSet)) {
+		return false
+	}
+	if (x.LevelSequenceNumber == nil) != (y.LevelSequenceNumber == nil) || (x.LevelSequenceNumber != nil && !x.LevelSequenceNumber.equal(*y.LevelSequenceNumber)) {
+		return false
+	}
+	if (x.SetItemTitle == nil) != (y.SetItemTitle == nil) || (x.SetItemTitle != nil && !x.SetItemTitle.equal(*y.SetItemTitle)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Stock) clone() Stock {
+	c := x
+	if x.OnHand != nil {
+		v := x.OnHand.clone()
+		c.OnHand = &v
+	}
+	if x.StockQuantityCoded != nil {
+		v := x.StockQuantityCoded.clone()
+		c.StockQuantityCoded = &v
+	}
+	if x.LocationIdentifier != nil {
+		v := x.LocationIdentifier.clone()
+		c.LocationIdentifier = &v
+	}
+	if x.LocationName != nil {
+		v := x.LocationName.clone()
+		c.LocationName = &v
+	}
+	if x.OnOrder != nil {
+		v := x.OnOrder.clone()
+		c.OnOrder = &v
+	}
+	if x.CBO != nil {
+		v := x.CBO.clone()
+		c.CBO = &v
+	}
+	if x.OnOrderDetails != nil {
+		c.OnOrderDetails = make([]OnOrderDetail, len(x.OnOrderDetails))
+		for i, v := range x.OnOrderDetails {
+			c.OnOrderDetails[i] = v.clone()
+		}
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Stock) equal(y Stock) bool {
+	if (x.OnHand == nil) != (y.OnHand == nil) || (x.OnHand != nil && !x.OnHand.equal(*y.OnHand)) {
+		return false
+	}
+	if (x.StockQuantityCoded == nil) != (y.StockQuantityCoded == nil) || (x.StockQuantityCoded != nil && !x.StockQuantityCoded.equal(*y.StockQuantityCoded)) {
+		return false
+	}
+	if (x.LocationIdentifier == nil) != (y.LocationIdentifier == nil) || (x.LocationIdentifier != nil && !x.LocationIdentifier.equal(*y.LocationIdentifier)) {
+		return false
+	}
+	if (x.LocationName == nil) != (y.LocationName == nil) || (x.LocationName != nil && !x.LocationName.equal(*y.LocationName)) {
+		return false
+	}
+	if (x.OnOrder == nil) != (y.OnOrder == nil) || (x.OnOrder != nil && !x.OnOrder.equal(*y.OnOrder)) {
+		return false
+	}
+	if (x.CBO == nil) != (y.CBO == nil) || (x.CBO != nil && !x.CBO.equal(*y.CBO)) {
+		return false
+	}
+	if len(x.OnOrderDetails) != len(y.OnOrderDetails) {
+		return false
+	}
+	for i := range x.OnOrderDetails {
+		if !x.OnOrderDetails[i].equal(y.OnOrderDetails[i]) {
+			return false
+		}
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x StockQuantityCoded) clone() StockQuantityCoded {
+	c := x
+	c.StockQuantityCodeType = x.StockQuantityCodeType.clone()
+	if x.StockQuantityCodeTypeName != nil {
+		v := x.StockQuantityCodeTypeName.clone()
+		c.StockQuantityCodeTypeName = &v
+	}
+	c.StockQuantityCode = x.StockQuantityCode.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x StockQuantityCoded) equal(y StockQuantityCoded) bool {
+	if !x.StockQuantityCodeType.equal(y.StockQuantityCodeType) {
+		return false
+	}
+	if (x.StockQuantityCodeTypeName == nil) != (y.StockQuantityCodeTypeName == nil) || (x.StockQuantityCodeTypeName != nil && !x.StockQuantityCodeTypeName.equal(*y.StockQuantityCodeTypeName)) {
+		return false
+	}
+	if !x.StockQuantityCode.equal(y.StockQuantityCode) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SubSeriesRecord) clone() SubSeriesRecord {
+	c := x
+	c.RecordReference = x.RecordReference.clone()
+	c.NotificationType = x.NotificationType.clone()
+	if x.DeletionCode != nil {
+		v := x.DeletionCode.clone()
+		c.DeletionCode = &v
+	}
+	if x.DeletionText != nil {
+		v := x.DeletionText.clone()
+		c.DeletionText = &v
+	}
+	if x.RecordSourceType != nil {
+		v := x.RecordSourceType.clone()
+		c.RecordSourceType = &v
+	}
+	if x.RecordSourceName != nil {
+		v := x.RecordSourceName.clone()
+		c.RecordSourceName = &v
+	}
+	if x.SeriesIdentifiers != nil {
+		c.SeriesIdentifiers = make([]SeriesIdentifier, len(x.SeriesIdentifiers))
+		for i, v := range x.SeriesIdentifiers {
+			c.SeriesIdentifiers[i] = v.clone()
+		}
+	}
+	c.ParentIdentifier = x.ParentIdentifier.clone()
+	c.LevelSequenceNumber = x.LevelSequenceNumber.clone()
+	if x.Titles != nil {
+		c.Titles = make([]Title, len(x.Titles))
+		for i, v := range x.Titles {
+			c.Titles[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.OtherTexts != nil {
+		c.OtherTexts = make([]OtherText, len(x.OtherTexts))
+		for i, v := range x.OtherTexts {
+			c.OtherTexts[i] = v.clone()
+		}
+	}
+	if x.Publishers != nil {
+		c.Publishers = make([]Publisher, len(x.Publishers))
+		for i, v := range x.Publishers {
+			c.Publishers[i] = v.clone()
+		}
+	}
+	if x.SubordinateEntries != nil {
+		v := x.SubordinateEntries.clone()
+		c.SubordinateEntries = &v
+	}
+	if x.RecordSourceIdentifierType != nil {
+		v := x.RecordSourceIdentifierType.clone()
+		c.RecordSourceIdentifierType = &v
+	}
+	if x.RecordSourceIdentifier != nil {
+		v := x.RecordSourceIdentifier.clone()
+		c.RecordSourceIdentifier = &v
+	}
+	if x.SeriesPartName != nil {
+		v := x.SeriesPartName.clone()
+		c.SeriesPartName = &v
+	}
+	if x.NumberWithinSeries != nil {
+		v := x.NumberWithinSeries.clone()
+		c.NumberWithinSeries = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SubSeriesRecord) equal(y SubSeriesRecord) bool {
+	if !x.RecordReference.equal(y.RecordReference) {
+		return false
+	}
+	if !x.NotificationType.equal(y.NotificationType) {
+		return false
+	}
+	if (x.DeletionCode == nil) != (y.DeletionCode == nil) || (x.DeletionCode != nil && !x.DeletionCode.equal(*y.DeletionCode)) {
+		return false
+	}
+	if (x.DeletionText == nil) != (y.DeletionText == nil) || (x.DeletionText != nil && !x.DeletionText.equal(*y.DeletionText)) {
+		return false
+	}
+	if (x.RecordSourceType == nil) != (y.RecordSourceType == nil) || (x.RecordSourceType != nil && !x.RecordSourceType.equal(*y.RecordSourceType)) {
+		return false
+	}
+	if (x.RecordSourceName == nil) != (y.RecordSourceName == nil) || (x.RecordSourceName != nil && !x.RecordSourceName.equal(*y.RecordSourceName)) {
+		return false
+	}
+	if len(x.SeriesIdentifiers) != len(y.SeriesIdentifiers) {
+		return false
+	}
+	for i := range x.SeriesIdentifiers {
+		if !x.SeriesIdentifiers[i].equal(y.SeriesIdentifiers[i]) {
+			return false
+		}
+	}
+	if !x.ParentIdentifier.equal(y.ParentIdentifier) {
+		return false
+	}
+	if !x.LevelSequenceNumber.equal(y.LevelSequenceNumber) {
+		return false
+	}
+	if len(x.Titles) != len(y.Titles) {
+		return false
+	}
+	for i := range x.Titles {
+		if !x.Titles[i].equal(y.Titles[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.OtherTexts) != len(y.OtherTexts) {
+		return false
+	}
+	for i := range x.OtherTexts {
+		if !x.OtherTexts[i].equal(y.OtherTexts[i]) {
+			return false
+		}
+	}
+	if len(x.Publishers) != len(y.Publishers) {
+		return false
+	}
+	for i := range x.Publishers {
+		if !x.Publishers[i].equal(y.Publishers[i]) {
+			return false
+		}
+	}
+	if (x.SubordinateEntries == nil) != (y.SubordinateEntries == nil) || (x.SubordinateEntries != nil && !x.SubordinateEntries.equal(*y.SubordinateEntries)) {
+		return false
+	}
+	if (x.RecordSourceIdentifierType == nil) != (y.RecordSourceIdentifierType == nil) || (x.RecordSourceIdentifierType != nil && !x.RecordSourceIdentifierType.equal(*y.RecordSourceIdentifierType)) {
+		return false
+	}
+	if (x.RecordSourceIdentifier == nil) != (y.RecordSourceIdentifier == nil) || (x.RecordSourceIdentifier != nil && !x.RecordSourceIdentifier.equal(*y.RecordSourceIdentifier)) {
+		return false
+	}
+	if (x.SeriesPartName == nil) != (y.SeriesPartName == nil) || (x.SeriesPartName != nil && !x.SeriesPartName.equal(*y.SeriesPartName)) {
+		return false
+	}
+	if (x.NumberWithinSeries == nil) != (y.NumberWithinSeries == nil) || (x.NumberWithinSeries != nil && !x.NumberWithinSeries.equal(*y.NumberWithinSeries)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Subject) clone() Subject {
+	c := x
+	if x.SubjectHeadingText != nil {
+		v := x.SubjectHeadingText.clone()
+		c.SubjectHeadingText = &v
+	}
+	if x.SubjectCode != nil {
+		v := x.SubjectCode.clone()
+		c.SubjectCode = &v
+	}
+	c.SubjectSchemeIdentifier = x.SubjectSchemeIdentifier.clone()
+	if x.SubjectSchemeName != nil {
+		v := x.SubjectSchemeName.clone()
+		c.SubjectSchemeName = &v
+	}
+	if x.SubjectSchemeVersion != nil {
+		v := x.SubjectSchemeVersion.clone()
+		c.SubjectSchemeVersion = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Subject) equal(y Subject) bool {
+	if (x.SubjectHeadingText == nil) != (y.SubjectHeadingText == nil) || (x.SubjectHeadingText != nil && !x.SubjectHeadingText.equal(*y.SubjectHeadingText)) {
+		return false
+	}
+	if (x.SubjectCode == nil) != (y.SubjectCode == nil) || (x.SubjectCode != nil && !x.SubjectCode.equal(*y.SubjectCode)) {
+		return false
+	}
+	if !x.SubjectSchemeIdentifier.equal(y.SubjectSchemeIdentifier) {
+		return false
+	}
+	if (x.SubjectSchemeName == nil) != (y.SubjectSchemeName == nil) || (x.SubjectSchemeName != nil && !x.SubjectSchemeName.equal(*y.SubjectSchemeName)) {
+		return false
+	}
+	if (x.SubjectSchemeVersion == nil) != (y.SubjectSchemeVersion == nil) || (x.SubjectSchemeVersion != nil && !x.SubjectSchemeVersion.equal(*y.SubjectSchemeVersion)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplierIdentifier) clone() SupplierIdentifier {
+	c := x
+	c.SupplierIDType = x.SupplierIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplierIdentifier) equal(y SupplierIdentifier) bool {
+	if !x.SupplierIDType.equal(y.SupplierIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplyDetail) clone() SupplyDetail {
+	c := x
+	if x.SupplierName != nil {
+		v := x.SupplierName.clone()
+		c.SupplierName = &v
+	}
+	if x.SupplierIdentifiers != nil {
+		c.SupplierIdentifiers = make([]SupplierIdentifier, len(x.SupplierIdentifiers))
+		for i, v := range x.SupplierIdentifiers {
+			c.SupplierIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SupplierSAN != nil {
+		v := x.SupplierSAN.clone()
+		c.SupplierSAN = &v
+	}
+	if x.SupplierEANLocationNumber != nil {
+		v := x.SupplierEANLocationNumber.clone()
+		c.SupplierEANLocationNumber = &v
+	}
+	if x.IntermediaryAvailabilityCode != nil {
+		v := x.IntermediaryAvailabilityCode.clone()
+		c.IntermediaryAvailabilityCode = &v
+	}
+	if x.AvailabilityCode != nil {
+		v := x.AvailabilityCode.clone()
+		c.AvailabilityCode = &v
+	}
+	if x.ProductAvailability != nil {
+		v := x.ProductAvailability.clone()
+		c.ProductAvailability = &v
+	}
+	if x.PriceAmount != nil {
+		v := x.PriceAmount.clone()
+		c.PriceAmount = &v
+	}
+	if x.UnpricedItemType != nil {
+		v := x.UnpricedItemType.clone()
+		c.UnpricedItemType = &v
+	}
+	if x.Prices != nil {
+		c.Prices = make([]Price, len(x.Prices))
+		for i, v := range x.Prices {
+			c.Prices[i] = v.clone()
+		}
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.SupplierRole != nil {
+		v := x.SupplierRole.clone()
+		c.SupplierRole = &v
+	}
+	if x.SupplyRestrictionDetail != nil {
+		v := x.SupplyRestrictionDetail.clone()
+		c.SupplyRestrictionDetail = &v
+	}
+	if x.LastDateForReturns != nil {
+		v := x.LastDateForReturns.clone()
+		c.LastDateForReturns = &v
+	}
+	if x.NewSupplier != nil {
+		v := x.NewSupplier.clone()
+		c.NewSupplier = &v
+	}
+	if x.OnSaleDate != nil {
+		v := x.OnSaleDate.clone()
+		c.OnSaleDate = &v
+	}
+	if x.OrderTime != nil {
+		v := x.OrderTime.clone()
+		c.OrderTime = &v
+	}
+	if x.Stocks != nil {
+		c.Stocks = make([]Stock, len(x.Stocks))
+		for i, v := range x.Stocks {
+			c.Stocks[i] = v.clone()
+		}
+	}
+	if x.PackQuantity != nil {
+		v := x.PackQuantity.clone()
+		c.PackQuantity = &v
+	}
+	if x.Reissue != nil {
+		v := x.Reissue.clone()
+		c.Reissue = &v
+	}
+	if x.SupplyToTerritory != nil {
+		v := x.SupplyToTerritory.clone()
+		c.SupplyToTerritory = &v
+	}
+	if x.SupplyToRegions != nil {
+		c.SupplyToRegions = make([]SupplyToRegion, len(x.SupplyToRegions))
+		for i, v := range x.SupplyToRegions {
+			c.SupplyToRegions[i] = v.clone()
+		}
+	}
+	if x.SupplyToCountrys != nil {
+		c.SupplyToCountrys = make([]CountryCodeList, len(x.SupplyToCountrys))
+		for i, v := range x.SupplyToCountrys {
+			c.SupplyToCountrys[i] = v.clone()
+		}
+	}
+	if x.SupplyToCountryExcludeds != nil {
+		c.SupplyToCountryExcludeds = make([]CountryCodeList, len(x.SupplyToCountryExcludeds))
+		for i, v := range x.SupplyToCountryExcludeds {
+			c.SupplyToCountryExcludeds[i] = v.clone()
+		}
+	}
+	if x.ReturnsCodeType != nil {
+		v := x.ReturnsCodeType.clone()
+		c.ReturnsCodeType = &v
+	}
+	if x.ReturnsCode != nil {
+		v := x.ReturnsCode.clone()
+		c.ReturnsCode = &v
+	}
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	if x.ExpectedShipDate != nil {
+		v := x.ExpectedShipDate.clone()
+		c.ExpectedShipDate = &v
+	}
+	if x.AudienceRestrictionFlag != nil {
+		v := x.AudienceRestrictionFlag.clone()
+		c.AudienceRestrictionFlag = &v
+	}
+	if x.AudienceRestrictionNote != nil {
+		v := x.AudienceRestrictionNote.clone()
+		c.AudienceRestrictionNote = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplyDetail) equal(y SupplyDetail) bool {
+	if (x.SupplierName == nil) != (y.SupplierName == nil) || (x.SupplierName != nil && !x.SupplierName.equal(*y.SupplierName)) {
+		return false
+	}
+	if len(x.SupplierIdentifiers) != len(y.SupplierIdentifiers) {
+		return false
+	}
+	for i := range x.SupplierIdentifiers {
+		if !x.SupplierIdentifiers[i].equal(y.SupplierIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SupplierSAN == nil) != (y.SupplierSAN == nil) || (x.SupplierSAN != nil && !x.SupplierSAN.equal(*y.SupplierSAN)) {
+		return false
+	}
+	if (x.SupplierEANLocationNumber == nil) != (y.SupplierEANLocationNumber == nil) || (x.SupplierEANLocationNumber != nil && !x.SupplierEANLocationNumber.equal(*y.SupplierEANLocationNumber)) {
+		return false
+	}
+	if (x.IntermediaryAvailabilityCode == nil) != (y.IntermediaryAvailabilityCode == nil) || (x.IntermediaryAvailabilityCode != nil && !x.IntermediaryAvailabilityCode.equal(*y.IntermediaryAvailabilityCode)) {
+		return false
+	}
+	if (x.AvailabilityCode == nil) != (y.AvailabilityCode == nil) || (x.AvailabilityCode != nil && !x.AvailabilityCode.equal(*y.AvailabilityCode)) {
+		return false
+	}
+	if (x.ProductAvailability == nil) != (y.ProductAvailability == nil) || (x.ProductAvailability != nil && !x.ProductAvailability.equal(*y.ProductAvailability)) {
+		return false
+	}
+	if (x.PriceAmount == nil) != (y.PriceAmount == nil) || (x.PriceAmount != nil && !x.PriceAmount.equal(*y.PriceAmount)) {
+		return false
+	}
+	if (x.UnpricedItemType == nil) != (y.UnpricedItemType == nil) || (x.UnpricedItemType != nil && !x.UnpricedItemType.equal(*y.UnpricedItemType)) {
+		return false
+	}
+	if len(x.Prices) != len(y.Prices) {
+		return false
+	}
+	for i := range x.Prices {
+		if !x.Prices[i].equal(y.Prices[i]) {
+			return false
+		}
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.SupplierRole == nil) != (y.SupplierRole == nil) || (x.SupplierRole != nil && !x.SupplierRole.equal(*y.SupplierRole)) {
+		return false
+	}
+	if (x.SupplyRestrictionDetail == nil) != (y.SupplyRestrictionDetail == nil) || (x.SupplyRestrictionDetail != nil && !x.SupplyRestrictionDetail.equal(*y.SupplyRestrictionDetail)) {
+		return false
+	}
+	if (x.LastDateForReturns == nil) != (y.LastDateForReturns == nil) || (x.LastDateForReturns != nil && !x.LastDateForReturns.equal(*y.LastDateForReturns)) {
+		return false
+	}
+	if (x.NewSupplier == nil) != (y.NewSupplier == nil) || (x.NewSupplier != nil && !x.NewSupplier.equal(*y.NewSupplier)) {
+		return false
+	}
+	if (x.OnSaleDate == nil) != (y.OnSaleDate == nil) || (x.OnSaleDate != nil && !x.OnSaleDate.equal(*y.OnSaleDate)) {
+		return false
+	}
+	if (x.OrderTime == nil) != (y.OrderTime == nil) || (x.OrderTime != nil && !x.OrderTime.equal(*y.OrderTime)) {
+		return false
+	}
+	if len(x.Stocks) != len(y.Stocks) {
+		return false
+	}
+	for i := range x.Stocks {
+		if !x.Stocks[i].equal(y.Stocks[i]) {
+			return false
+		}
+	}
+	if (x.PackQuantity == nil) != (y.PackQuantity == nil) || (x.PackQuantity != nil && !x.PackQuantity.equal(*y.PackQuantity)) {
+		return false
+	}
+	if (x.Reissue == nil) != (y.Reissue == nil) || (x.Reissue != nil && !x.Reissue.equal(*y.Reissue)) {
+		return false
+	}
+	if (x.SupplyToTerritory == nil) != (y.SupplyToTerritory == nil) || (x.SupplyToTerritory != nil && !x.SupplyToTerritory.equal(*y.SupplyToTerritory)) {
+		return false
+	}
+	if len(x.SupplyToRegions) != len(y.SupplyToRegions) {
+		return false
+	}
+	for i := range x.SupplyToRegions {
+		if !x.SupplyToRegions[i].equal(y.SupplyToRegions[i]) {
+			return false
+		}
+	}
+	if len(x.SupplyToCountrys) != len(y.SupplyToCountrys) {
+		return false
+	}
+	for i := range x.SupplyToCountrys {
+		if !x.SupplyToCountrys[i].equal(y.SupplyToCountrys[i]) {
+			return false
+		}
+	}
+	if len(x.SupplyToCountryExcludeds) != len(y.SupplyToCountryExcludeds) {
+		return false
+	}
+	for i := range x.SupplyToCountryExcludeds {
+		if !x.SupplyToCountryExcludeds[i].equal(y.SupplyToCountryExcludeds[i]) {
+			return false
+		}
+	}
+	if (x.ReturnsCodeType == nil) != (y.ReturnsCodeType == nil) || (x.ReturnsCodeType != nil && !x.ReturnsCodeType.equal(*y.ReturnsCodeType)) {
+		return false
+	}
+	if (x.ReturnsCode == nil) != (y.ReturnsCode == nil) || (x.ReturnsCode != nil && !x.ReturnsCode.equal(*y.ReturnsCode)) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if (x.ExpectedShipDate == nil) != (y.ExpectedShipDate == nil) || (x.ExpectedShipDate != nil && !x.ExpectedShipDate.equal(*y.ExpectedShipDate)) {
+		return false
+	}
+	if (x.AudienceRestrictionFlag == nil) != (y.AudienceRestrictionFlag == nil) || (x.AudienceRestrictionFlag != nil && !x.AudienceRestrictionFlag.equal(*y.AudienceRestrictionFlag)) {
+		return false
+	}
+	if (x.AudienceRestrictionNote == nil) != (y.AudienceRestrictionNote == nil) || (x.AudienceRestrictionNote != nil && !x.AudienceRestrictionNote.equal(*y.AudienceRestrictionNote)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TextItem) clone() TextItem {
+	c := x
+	if x.PageRuns != nil {
+		c.PageRuns = make([]PageRun, len(x.PageRuns))
+		for i, v := range x.PageRuns {
+			c.PageRuns[i] = v.clone()
+		}
+	}
+	if x.FirstPageNumber != nil {
+		v := x.FirstPageNumber.clone()
+		c.FirstPageNumber = &v
+	}
+	if x.LastPageNumber != nil {
+		v := x.LastPageNumber.clone()
+		c.LastPageNumber = &v
+	}
+	c.TextItemType = x.TextItemType.clone()
+	if x.TextItemIdentifiers != nil {
+		c.TextItemIdentifiers = make([]TextItemIdentifier, len(x.TextItemIdentifiers))
+		for i, v := range x.TextItemIdentifiers {
+			c.TextItemIdentifiers[i] = v.clone()
+		}
+	}
+	if x.NumberOfPages != nil {
+		v := x.NumberOfPages.clone()
+		c.NumberOfPages = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TextItem) equal(y TextItem) bool {
+	if len(x.PageRuns) != len(y.PageRuns) {
+		return false
+	}
+	for i := range x.PageRuns {
+		if !x.PageRuns[i].equal(y.PageRuns[i]) {
+			return false
+		}
+	}
+	if (x.FirstPageNumber == nil) != (y.FirstPageNumber == nil) || (x.FirstPageNumber != nil && !x.FirstPageNumber.equal(*y.FirstPageNumber)) {
+		return false
+	}
+	if (x.LastPageNumber == nil) != (y.LastPageNumber == nil) || (x.LastPageNumber != nil && !x.LastPageNumber.equal(*y.LastPageNumber)) {
+		return false
+	}
+	if !x.TextItemType.equal(y.TextItemType) {
+		return false
+	}
+	if len(x.TextItemIdentifiers) != len(y.TextItemIdentifiers) {
+		return false
+	}
+	for i := range x.TextItemIdentifiers {
+		if !x.TextItemIdentifiers[i].equal(y.TextItemIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.NumberOfPages == nil) != (y.NumberOfPages == nil) || (x.NumberOfPages != nil && !x.NumberOfPages.equal(*y.NumberOfPages)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TextItemIdentifier) clone() TextItemIdentifier {
+	c := x
+	c.TextItemIDType = x.TextItemIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TextItemIdentifier) equal(y TextItemIdentifier) bool {
+	if !x.TextItemIDType.equal(y.TextItemIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Title) clone() Title {
+	c := x
+	if x.TitleText != nil {
+		v := x.TitleText.clone()
+		c.TitleText = &v
+	}
+	if x.TitlePrefix != nil {
+		v := x.TitlePrefix.clone()
+		c.TitlePrefix = &v
+	}
+	if x.TitleWithoutPrefix != nil {
+		v := x.TitleWithoutPrefix.clone()
+		c.TitleWithoutPrefix = &v
+	}
+	c.TitleType = x.TitleType.clone()
+	if x.AbbreviatedLength != nil {
+		v := x.AbbreviatedLength.clone()
+		c.AbbreviatedLength = &v
+	}
+	if x.TextCaseFlag != nil {
+		v := x.TextCaseFlag.clone()
+		c.TextCaseFlag = &v
+	}
+	if x.Subtitle != nil {
+		v := x.Subtitle.clone()
+		c.Subtitle = &v
+	}
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Title) equal(y Title) bool {
+	if (x.TitleText == nil) != (y.TitleText == nil) || (x.TitleText != nil && !x.TitleText.equal(*y.TitleText)) {
+		return false
+	}
+	if (x.TitlePrefix == nil) != (y.TitlePrefix == nil) || (x.TitlePrefix != nil && !x.TitlePrefix.equal(*y.TitlePrefix)) {
+		return false
+	}
+	if (x.TitleWithoutPrefix == nil) != (y.TitleWithoutPrefix == nil) || (x.TitleWithoutPrefix != nil && !x.TitleWithoutPrefix.equal(*y.TitleWithoutPrefix)) {
+		return false
+	}
+	if !x.TitleType.equal(y.TitleType) {
+		return false
+	}
+	if (x.AbbreviatedLength == nil) != (y.AbbreviatedLength == nil) || (x.AbbreviatedLength != nil && !x.AbbreviatedLength.equal(*y.AbbreviatedLength)) {
+		return false
+	}
+	if (x.TextCaseFlag == nil) != (y.TextCaseFlag == nil) || (x.TextCaseFlag != nil && !x.TextCaseFlag.equal(*y.TextCaseFlag)) {
+		return false
+	}
+	if (x.Subtitle == nil) != (y.Subtitle == nil) || (x.Subtitle != nil && !x.Subtitle.equal(*y.Subtitle)) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Website) clone() Website {
+	c := x
+	if x.WebsiteRole != nil {
+		v := x.WebsiteRole.clone()
+		c.WebsiteRole = &v
+	}
+	if x.WebsiteDescription != nil {
+		v := x.WebsiteDescription.clone()
+		c.WebsiteDescription = &v
+	}
+	c.WebsiteLink = x.WebsiteLink.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Website) equal(y Website) bool {
+	if (x.WebsiteRole == nil) != (y.WebsiteRole == nil) || (x.WebsiteRole != nil && !x.WebsiteRole.equal(*y.WebsiteRole)) {
+		return false
+	}
+	if (x.WebsiteDescription == nil) != (y.WebsiteDescription == nil) || (x.WebsiteDescription != nil && !x.WebsiteDescription.equal(*y.WebsiteDescription)) {
+		return false
+	}
+	if !x.WebsiteLink.equal(y.WebsiteLink) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x WorkIdentifier) clone() WorkIdentifier {
+	c := x
+	c.WorkIDType = x.WorkIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Textformat != nil {
+		v := x.Textformat.clone()
+		c.Textformat = &v
+	}
+	if x.Textcase != nil {
+		v := x.Textcase.clone()
+		c.Textcase = &v
+	}
+	if x.Language != nil {
+		v := x.Language.clone()
+		c.Language = &v
+	}
+	if x.Transliteration != nil {
+		v := x.Transliteration.clone()
+		c.Transliteration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x WorkIdentifier) equal(y WorkIdentifier) bool {
+	if !x.WorkIDType.equal(y.WorkIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Textformat == nil) != (y.Textformat == nil) || (x.Textformat != nil && !x.Textformat.equal(*y.Textformat)) {
+		return false
+	}
+	if (x.Textcase == nil) != (y.Textcase == nil) || (x.Textcase != nil && !x.Textcase.equal(*y.Textcase)) {
+		return false
+	}
+	if (x.Language == nil) != (y.Language == nil) || (x.Language != nil && !x.Language.equal(*y.Language)) {
+		return false
+	}
+	if (x.Transliteration == nil) != (y.Transliteration == nil) || (x.Transliteration != nil && !x.Transliteration.equal(*y.Transliteration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
@@ -0,0 +1,210 @@
+package onix
+
+import "strings"
+
+// ConferenceRoleCode is code of List 20 which tells relation of product to conference or event, such as 02 for its proceedings.
+type ConferenceRoleCode string
+
+const (
+	// ConferenceRolePublicationLinked is publication linked to conference.
+	ConferenceRolePublicationLinked ConferenceRoleCode = "01"
+	// ConferenceRoleCompleteProceedings is complete proceedings of conference.
+	ConferenceRoleCompleteProceedings ConferenceRoleCode = "02"
+	// ConferenceRoleSelectedPapers is selected papers from conference.
+	ConferenceRoleSelectedPapers ConferenceRoleCode = "03"
+	// ConferenceRoleSportingEvent is publication linked to sporting event.
+	ConferenceRoleSportingEvent ConferenceRoleCode = "11"
+	// ConferenceRoleSportingEventProgramme is programme or guide for sporting event.
+	ConferenceRoleSportingEventProgramme ConferenceRoleCode = "12"
+	// ConferenceRoleArtisticEvent is publication linked to artistic event.
+	ConferenceRoleArtisticEvent ConferenceRoleCode = "21"
+	// ConferenceRoleArtisticEventProgramme is programme or guide for artistic event.
+	ConferenceRoleArtisticEventProgramme ConferenceRoleCode = "22"
+	// ConferenceRoleExposition is publication linked to exposition.
+	ConferenceRoleExposition ConferenceRoleCode = "31"
+	// ConferenceRoleExpositionProgramme is programme or guide for exposition.
+	ConferenceRoleExpositionProgramme ConferenceRoleCode = "32"
+)
+
+// IsProceedings reports whether code tells product consists of papers from conference.
+func (c ConferenceRoleCode) IsProceedings() bool {
+	return c == ConferenceRoleCompleteProceedings || c == ConferenceRoleSelectedPapers
+}
+
+// Role returns code of List 20 of conference, which is absent if ConferenceRole is omitted.
+func (c Conference) Role() (ConferenceRoleCode, bool) {
+	if c.ConferenceRole == nil {
+		return "", false
+	}
+	return ConferenceRoleCode(c.ConferenceRole.Code()), true
+}
+
+// Name returns ConferenceName of conference.
+func (c Conference) Name() string {
+	return strings.TrimSpace(c.ConferenceName.Body)
+}
+
+// Acronym returns ConferenceAcronym of conference, which is empty if it is absent.
+func (c Conference) Acronym() string {
+	return textOf(c.ConferenceAcronym)
+}
+
+// Number returns ConferenceNumber of conference in a series of conferences.
+func (c Conference) Number() (int64, bool) {
+	return quantityOf(c.ConferenceNumber)
+}
+
+// Theme returns ConferenceTheme of conference, which is empty if it is absent.
+func (c Conference) Theme() string {
+	return textOf(c.ConferenceTheme)
+}
+
+// Date returns ConferenceDate of conference, which is typically a year and is absent unless it can be parsed.
+func (c Conference) Date() (Date, bool) {
+	return dateOf(c.ConferenceDate, nil)
+}
+
+// Place returns ConferencePlace of conference, which is empty if it is absent.
+func (c Conference) Place() string {
+	return textOf(c.ConferencePlace)
+}
+
+// Sponsors returns names of sponsors of conference, which are either PersonName or CorporateName of each sponsor.
+func (c Conference) Sponsors() []string {
+	var sponsors []string
+	for _, s := range c.ConferenceSponsors {
+		if name, ok := nameOf(s.CorporateName); ok {
+			sponsors = append(sponsors, name)
+		} else if name, ok := nameOf(s.PersonName); ok {
+			sponsors = append(sponsors, name)
+		}
+	}
+	return sponsors
+}
+
+// ConferencesOf returns Conference composites of product whose role is role.
+func (p Product) ConferencesOf(role ConferenceRoleCode) []Conference {
+	var found []Conference
+	for _, c := range p.Conferences {
+		if r, ok := c.Role(); ok && r == role {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// IsProceedings reports whether product consists of papers from any of its conferences.
+func (p Product) IsProceedings() bool {
+	for _, c := range p.Conferences {
+		if r, ok := c.Role(); ok && r.IsProceedings() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReligiousTextFeatureTypeChurchSeason is code of List 89 of feature of church season or activity, which is the only type defined.
+const ReligiousTextFeatureTypeChurchSeason = "01"
+
+// ReligiousFeature is a feature of religious text, whose Type and Code are codes of List 89 and List 90.
+type ReligiousFeature struct {
+	Type        string
+	Code        string
+	Description string
+}
+
+// Features returns ReligiousTextFeature composites of religious text.
+func (r ReligiousText) Features() []ReligiousFeature {
+	features := make([]ReligiousFeature, 0, len(r.ReligiousTextFeatures))
+	for _, f := range r.ReligiousTextFeatures {
+		features = append(features, ReligiousFeature{
+			Type:        f.ReligiousTextFeatureType.Code(),
+			Code:        f.ReligiousTextFeatureCode.Code(),
+			Description: textOf(f.ReligiousTextFeatureDescription),
+		})
+	}
+	return features
+}
+
+// ID returns code of List 88 of religious text other than Bible.
+func (r ReligiousText) ID() (string, bool) {
+	if r.ReligiousTextID == nil {
+		return "", false
+	}
+	return strings.TrimSpace(r.ReligiousTextID.Body), true
+}
+
+// Contents returns codes of List 82 of the parts of Bible which product contains, such as OT for Old Testament.
+func (b Bible) Contents() []string {
+	codes := make([]string, 0, len(b.BibleContentss))
+	for _, c := range b.BibleContentss {
+		codes = append(codes, c.Code())
+	}
+	return codes
+}
+
+// Versions returns codes of List 83 of versions of Bible, such as KJV for King James.
+func (b Bible) Versions() []string {
+	codes := make([]string, 0, len(b.BibleVersions))
+	for _, v := range b.BibleVersions {
+		codes = append(codes, v.Code())
+	}
+	return codes
+}
+
+// Study returns code of List 84 of study Bible, which is absent unless Bible is a study Bible.
+func (b Bible) Study() (string, bool) {
+	if b.StudyBibleType == nil {
+		return "", false
+	}
+	return b.StudyBibleType.Code(), true
+}
+
+// Purposes returns codes of List 85 of purposes of Bible, such as PW for pew Bible.
+func (b Bible) Purposes() []string {
+	codes := make([]string, 0, len(b.BiblePurposes))
+	for _, p := range b.BiblePurposes {
+		codes = append(codes, p.Code())
+	}
+	return codes
+}
+
+// TextOrganization returns code of List 86 of how text of Bible is organized, such as CHR for chronological.
+func (b Bible) TextOrganization() (string, bool) {
+	if b.BibleTextOrganization == nil {
+		return "", false
+	}
+	return b.BibleTextOrganization.Code(), true
+}
+
+// ReferenceLocation returns code of List 87 of where references of Bible are located on page, such as PGE for foot of page.
+func (b Bible) ReferenceLocation() (string, bool) {
+	if b.BibleReferenceLocation == nil {
+		return "", false
+	}
+	return b.BibleReferenceLocation.Code(), true
+}
+
+// TextFeatures returns codes of List 97 of features of text of Bible, such as RL for red letter.
+func (b Bible) TextFeatures() []string {
+	codes := make([]string, 0, len(b.BibleTextFeatures))
+	for _, f := range b.BibleTextFeatures {
+		codes = append(codes, f.Code())
+	}
+	return codes
+}
+
+// IsBible reports whether product is, or contains, Bible.
+func (p Product) IsBible() bool {
+	return p.ReligiousText != nil && p.ReligiousText.Bible != nil
+}
+
+// IsWithoutSeries reports whether product affirms by NoSeries that it is not part of any series.
+func (p Product) IsWithoutSeries() bool {
+	return bool(p.NoSeries)
+}
+
+// IsWithoutContributors reports whether product affirms by NoContributor that it has no authorship.
+func (p Product) IsWithoutContributors() bool {
+	return bool(p.NoContributor)
+}
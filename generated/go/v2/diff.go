@@ -0,0 +1,190 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ChangeKind tells how element or attribute has changed between products.
+type ChangeKind string
+
+const (
+	// ChangeAdded is element or attribute which only the new product has.
+	ChangeAdded ChangeKind = "added"
+	// ChangeRemoved is element or attribute which only the old product has.
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeModified is element or attribute whose value differs between products.
+	ChangeModified ChangeKind = "modified"
+)
+
+// FieldChange is a change of element or attribute which DiffProducts has found.
+type FieldChange struct {
+	// Path is XPath-like location of the element such as /Product/Title[1]/TitleText.
+	Path string
+	Kind ChangeKind
+	// Old and New are values of the element as they are written in message, where codes are not human readable descriptions.
+	Old string
+	New string
+}
+
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %s [%s] -> [%s]", c.Path, c.Kind, c.Old, c.New)
+}
+
+// DiffProducts compares elements and attributes of old and new, and returns what has changed in order of fields of the model.
+// Repeated elements are compared by their positions, and nil product is regarded as an empty one.
+func DiffProducts(old, new *Product) []FieldChange {
+	if old == nil {
+		old = &Product{}
+	}
+	if new == nil {
+		new = &Product{}
+	}
+	var changes []FieldChange
+	diff(reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem(), "/Product", &changes)
+	return changes
+}
+
+func diff(o, n reflect.Value, path string, changes *[]FieldChange) {
+	switch o.Kind() {
+	case reflect.Ptr:
+		if o.IsNil() && n.IsNil() {
+			return
+		}
+		if o.IsNil() {
+			o = reflect.New(o.Type().Elem())
+		}
+		if n.IsNil() {
+			n = reflect.New(n.Type().Elem())
+		}
+		diff(o.Elem(), n.Elem(), path, changes)
+	case reflect.Slice:
+		length := o.Len()
+		if n.Len() > length {
+			length = n.Len()
+		}
+		for i := 0; i < length; i++ {
+			elem := reflect.Zero(o.Type().Elem())
+			oi, ni := elem, elem
+			if i < o.Len() {
+				oi = o.Index(i)
+			}
+			if i < n.Len() {
+				ni = n.Index(i)
+			}
+			diff(oi, ni, fmt.Sprintf("%s[%d]", path, i+1), changes)
+		}
+	case reflect.Struct:
+		if o.Type() == extensionType {
+			// Extensions are compared as XML which they are written in.
+			diffLeaf(xmlOf(o), xmlOf(n), path, changes)
+			return
+		}
+		if isCode(o.Type()) {
+			diffLeaf(codeText(o), codeText(n), path, changes)
+		}
+		diffFields(o, n, path, changes)
+	case reflect.String:
+		diffLeaf(o.String(), n.String(), path, changes)
+	default:
+		diffLeaf(fmt.Sprint(o.Interface()), fmt.Sprint(n.Interface()), path, changes)
+	}
+}
+
+func diffFields(o, n reflect.Value, path string, changes *[]FieldChange) {
+	t := o.Type()
+	code := isCode(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			// Attributes which PlainText embeds are of the element itself.
+			diff(o.Field(i), n.Field(i), path, changes)
+			continue
+		}
+		tag := field.Tag.Get("xml")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		if tag == ",any" {
+			// Elements which the model does not define are compared by their positions among them.
+			diff(o.Field(i), n.Field(i), path+"/*", changes)
+			continue
+		}
+		if opts[0] == "" {
+			// Text of code is compared as code rather than its human readable description,
+			// and text of the others is compared without whitespaces around it which indentation of message adds.
+			if !code && field.Type.Kind() == reflect.String {
+				diffLeaf(strings.TrimSpace(o.Field(i).String()), strings.TrimSpace(n.Field(i).String()), path, changes)
+			} else if !code {
+				diff(o.Field(i), n.Field(i), path, changes)
+			}
+			continue
+		}
+		name := opts[0]
+		if ref, ok := ReferenceTags[name]; ok {
+			name = ref
+		}
+		for _, opt := range opts[1:] {
+			if opt == "attr" {
+				name = "@" + name
+			}
+		}
+		diff(o.Field(i), n.Field(i), path+"/"+name, changes)
+	}
+}
+
+var extensionType = reflect.TypeOf(Extension{})
+
+type coded interface {
+	Code() string
+}
+
+type multiCoded interface {
+	Codes() []string
+}
+
+var (
+	codedType      = reflect.TypeOf((*coded)(nil)).Elem()
+	multiCodedType = reflect.TypeOf((*multiCoded)(nil)).Elem()
+)
+
+// isCode reports whether t is a code type, which composites such as Language that have Code of their own are not.
+func isCode(t reflect.Type) bool {
+	return t.Implements(marshalerType) && (t.Implements(codedType) || t.Implements(multiCodedType))
+}
+
+// codeText returns code of x which is a code type as it is written in message, where codes separated by spaces are joined.
+func codeText(x reflect.Value) string {
+	if c, ok := x.Interface().(coded); ok {
+		return c.Code()
+	}
+	return strings.Join(x.Interface().(multiCoded).Codes(), " ")
+}
+
+// xmlOf returns x which is Extension as XML, which is empty for zero extension of the shorter slice.
+func xmlOf(x reflect.Value) string {
+	if x.IsZero() {
+		return ""
+	}
+	b, err := xml.Marshal(x.Interface())
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func diffLeaf(o, n string, path string, changes *[]FieldChange) {
+	switch {
+	case o == n:
+		return
+	case o == "":
+		*changes = append(*changes, FieldChange{Path: path, Kind: ChangeAdded, New: n})
+	case n == "":
+		*changes = append(*changes, FieldChange{Path: path, Kind: ChangeRemoved, Old: o})
+	default:
+		*changes = append(*changes, FieldChange{Path: path, Kind: ChangeModified, Old: o, New: n})
+	}
+}
@@ -0,0 +1,117 @@
+package onix
+
+import "strings"
+
+// DiscountCodeTypeCode is code of List 100 which tells scheme of discount code, such as 01 for BIC discount group code.
+type DiscountCodeTypeCode string
+
+const (
+	// DiscountCodeBIC is BIC discount group code of UK.
+	DiscountCodeBIC DiscountCodeTypeCode = "01"
+	// DiscountCodeProprietary is proprietary discount code of supplier, whose scheme is named by DiscountCodeTypeName.
+	DiscountCodeProprietary DiscountCodeTypeCode = "02"
+	// DiscountCodeBoeksoort is Boeksoort code of the Netherlands.
+	DiscountCodeBoeksoort DiscountCodeTypeCode = "03"
+	// DiscountCodeGermanTerms is terms code of Germany.
+	DiscountCodeGermanTerms DiscountCodeTypeCode = "04"
+	// DiscountCodeProprietaryCommission is proprietary commission code of supplier for agency terms.
+	DiscountCodeProprietaryCommission DiscountCodeTypeCode = "05"
+	// DiscountCodeBICCommission is BIC commission group code of UK for agency terms.
+	DiscountCodeBICCommission DiscountCodeTypeCode = "06"
+	// DiscountCodeISNI is discount group code of supplier identified by its ISNI.
+	DiscountCodeISNI DiscountCodeTypeCode = "07"
+)
+
+// Kind returns scheme of discount code.
+func (d DiscountCoded) Kind() DiscountCodeTypeCode {
+	return DiscountCodeTypeCode(d.DiscountCodeType.Code())
+}
+
+// Code returns discount code itself.
+func (d DiscountCoded) Code() string {
+	return strings.TrimSpace(d.DiscountCode.Body)
+}
+
+// DiscountTier is a discount which trade is given on orders of quantity between From and To inclusive.
+// From and To of zero are unbounded.
+type DiscountTier struct {
+	From    int64
+	To      int64
+	Percent *Amount
+	Amount  *Amount
+}
+
+// Includes reports whether discount is given on order of quantity n.
+func (t DiscountTier) Includes(n int64) bool {
+	return n >= t.From && (t.To == 0 || n <= t.To)
+}
+
+// DiscountCode returns discount code of price of kind.
+// It falls back on BICDiscountGroupCode element which is replaced by DiscountCoded composite.
+func (p Price) DiscountCode(kind DiscountCodeTypeCode) (string, bool) {
+	for _, d := range p.DiscountCodeds {
+		if d.Kind() == kind && d.Code() != "" {
+			return d.Code(), true
+		}
+	}
+	if kind == DiscountCodeBIC {
+		return nameOf(p.BICDiscountGroupCode)
+	}
+	return "", false
+}
+
+// DiscountTiers returns discounts of price, which is the single one of DiscountPercent since 2.1 has no Discount composite.
+func (p Price) DiscountTiers() ([]DiscountTier, error) {
+	if p.DiscountPercent == nil {
+		return nil, nil
+	}
+	percent, err := ParseAmount(p.DiscountPercent.Body)
+	if err != nil {
+		return nil, err
+	}
+	tier := DiscountTier{Percent: &percent}
+	return []DiscountTier{tier}, nil
+}
+
+// DiscountForQuantity returns discount of price which is given on order of quantity n,
+// where int64 is taken as quantity as the one of 3.0 is.
+// Discount of malformed amount is ignored, which DiscountTiers reports as an error.
+func (p Price) DiscountForQuantity(n int64) (DiscountTier, bool) {
+	tiers, _ := p.DiscountTiers()
+	return discountFor(tiers, n)
+}
+
+// FreeQuantityFor returns quantity of free copies supplied with order of quantity n,
+// which are given for each batch of the largest batch bonus which n reaches.
+func (p Price) FreeQuantityFor(n int64) int64 {
+	return freeQuantityOf(p.BatchBonuss, n)
+}
+
+// discountFor returns the tier of tiers including n whose lower bound is the highest.
+func discountFor(tiers []DiscountTier, n int64) (DiscountTier, bool) {
+	var found *DiscountTier
+	for i, t := range tiers {
+		if t.Includes(n) && (found == nil || t.From > found.From) {
+			found = &tiers[i]
+		}
+	}
+	if found == nil {
+		return DiscountTier{}, false
+	}
+	return *found, true
+}
+
+func freeQuantityOf(bonuses []BatchBonus, n int64) int64 {
+	var batch, free int64
+	for _, b := range bonuses {
+		q, ok := quantityOf(&b.BatchQuantity)
+		f, fok := quantityOf(&b.FreeQuantity)
+		if ok && fok && q > 0 && q <= n && q > batch {
+			batch, free = q, f
+		}
+	}
+	if batch == 0 {
+		return 0
+	}
+	return n / batch * free
+}
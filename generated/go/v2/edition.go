@@ -0,0 +1,48 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Edition is edition of product, which EditionTypeCode, EditionNumber, EditionVersionNumber and EditionStatement tell.
+type Edition struct {
+	// Types are codes of List 21 such as REV for revised edition.
+	Types []string `json:"types,omitempty"`
+	// Number is number of edition, which is 0 unless product tells it.
+	Number int `json:"number,omitempty"`
+	// Version is version number of edition such as 2.1, mainly of digital products.
+	Version string `json:"version,omitempty"`
+	// Statement is edition statement as it is displayed, such as Third edition, revised.
+	Statement string `json:"statement,omitempty"`
+	// None is whether NoEdition tells that product has no edition statement.
+	None bool `json:"none,omitempty"`
+}
+
+// HasType reports whether edition is of editionType, which is a code of List 21.
+func (e Edition) HasType(editionType string) bool {
+	for _, t := range e.Types {
+		if t == editionType {
+			return true
+		}
+	}
+	return false
+}
+
+// Edition returns edition of product.
+func (p Product) Edition() Edition {
+	e := Edition{None: bool(p.NoEdition)}
+	for _, t := range p.EditionTypeCodes {
+		e.Types = append(e.Types, t.Code())
+	}
+	if p.EditionNumber != nil {
+		e.Number, _ = strconv.Atoi(strings.TrimSpace(p.EditionNumber.Body))
+	}
+	if p.EditionVersionNumber != nil {
+		e.Version = strings.TrimSpace(p.EditionVersionNumber.Body)
+	}
+	if p.EditionStatement != nil {
+		e.Statement = p.EditionStatement.Body
+	}
+	return e
+}
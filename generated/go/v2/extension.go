@@ -0,0 +1,198 @@
+package onix
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+)
+
+// Extension is an element which the model does not define, such as a newer element or proprietary extension of sender.
+// Composites keep such children as their Extensions, so that marshaling message does not drop them.
+type Extension struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr
+	// Tokens are content of the element as it has been decoded, including whitespace between elements,
+	// which is significant in mixed content such as XHTML.
+	Tokens []xml.Token
+}
+
+// UnmarshalXML keeps the element as it is, except declarations of namespaces,
+// which marshaling declares by names of elements and attributes again.
+func (x *Extension) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	start = start.Copy()
+	x.XMLName, x.Attrs, x.Tokens = start.Name, withoutDeclarations(start.Attr), nil
+	depth := 0
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+		token = xml.CopyToken(token)
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			t.Attr = withoutDeclarations(t.Attr)
+			token = t
+		case xml.EndElement:
+			if depth == 0 {
+				return nil
+			}
+			depth--
+		}
+		x.Tokens = append(x.Tokens, token)
+	}
+}
+
+// MarshalXML writes the element as it has been decoded, regardless of the name which start has.
+func (x Extension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start = xml.StartElement{Name: x.XMLName, Attr: x.Attrs}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, token := range x.Tokens {
+		if err := e.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// Text returns character data of the element and its children, whose leading and trailing whitespace is trimmed.
+func (x Extension) Text() string {
+	var text []byte
+	for _, token := range x.Tokens {
+		if t, ok := token.(xml.CharData); ok {
+			text = append(text, t...)
+		}
+	}
+	return string(bytes.TrimSpace(text))
+}
+
+// withoutDeclarations returns attrs except declarations of namespaces.
+func withoutDeclarations(attrs []xml.Attr) []xml.Attr {
+	var rest []xml.Attr
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		rest = append(rest, attr)
+	}
+	return rest
+}
+
+// MarshalJSON is marshaler which emits the element as XML.
+func (x Extension) MarshalJSON() ([]byte, error) {
+	b, err := xml.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON is unmarshaler which accepts the element as XML.
+func (x *Extension) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return xml.NewDecoder(bytes.NewReader([]byte(s))).Decode(x)
+}
+
+func (x Extension) clone() Extension {
+	c := Extension{XMLName: x.XMLName, Attrs: append([]xml.Attr(nil), x.Attrs...)}
+	if x.Tokens != nil {
+		c.Tokens = make([]xml.Token, len(x.Tokens))
+		for i, token := range x.Tokens {
+			c.Tokens[i] = xml.CopyToken(token)
+		}
+	}
+	return c
+}
+
+// equal reports whether x and o are the same element, where datestamp attributes are ignored as the ones of the model are.
+func (x Extension) equal(o Extension) bool {
+	if x.XMLName != o.XMLName || !equalAttrs(x.Attrs, o.Attrs) || len(x.Tokens) != len(o.Tokens) {
+		return false
+	}
+	for i := range x.Tokens {
+		if !equalTokens(x.Tokens[i], o.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneExtensions(extensions []Extension) []Extension {
+	if extensions == nil {
+		return nil
+	}
+	c := make([]Extension, len(extensions))
+	for i, x := range extensions {
+		c[i] = x.clone()
+	}
+	return c
+}
+
+func equalExtensions(x, y []Extension) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if !x[i].equal(y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalTokens(x, y xml.Token) bool {
+	switch t := x.(type) {
+	case xml.StartElement:
+		u, ok := y.(xml.StartElement)
+		return ok && t.Name == u.Name && equalAttrs(t.Attr, u.Attr)
+	case xml.EndElement:
+		u, ok := y.(xml.EndElement)
+		return ok && t.Name == u.Name
+	case xml.CharData:
+		u, ok := y.(xml.CharData)
+		return ok && bytes.Equal(t, u)
+	case xml.Comment:
+		u, ok := y.(xml.Comment)
+		return ok && bytes.Equal(t, u)
+	case xml.ProcInst:
+		u, ok := y.(xml.ProcInst)
+		return ok && t.Target == u.Target && bytes.Equal(t.Inst, u.Inst)
+	case xml.Directive:
+		u, ok := y.(xml.Directive)
+		return ok && bytes.Equal(t, u)
+	}
+	return false
+}
+
+// equalAttrs reports whether attributes other than datestamp are the same ones in the same order.
+func equalAttrs(x, y []xml.Attr) bool {
+	x, y = withoutDatestamp(x), withoutDatestamp(y)
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func withoutDatestamp(attrs []xml.Attr) []xml.Attr {
+	var rest []xml.Attr
+	for _, attr := range attrs {
+		if attr.Name.Local != "datestamp" {
+			rest = append(rest, attr)
+		}
+	}
+	return rest
+}
@@ -0,0 +1,51 @@
+package onix
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+)
+
+// Flag is an empty element whose presence is what it tells, such as NoEdition which tells product has no edition statement.
+// Its absence is false, and the element is marshaled only when it is true.
+type Flag bool
+
+// UnmarshalXML sets the flag, since the element is present regardless of its attributes and content.
+func (f *Flag) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*f = true
+	return d.Skip()
+}
+
+// MarshalXML writes the element without content if the flag is set.
+// encoding/xml writes it as a pair of tags, which Encoder of package onix writes as a self-closing tag such as <n386/>.
+func (f Flag) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !f {
+		return nil
+	}
+	if err := e.EncodeToken(xml.StartElement{Name: start.Name}); err != nil {
+		return err
+	}
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// UnmarshalJSON accepts an object as a set flag as well as a boolean, which is how the element has been marshaled before it is Flag.
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		*f = true
+		return nil
+	}
+	var b bool
+	if err := json.Unmarshal(data, &b); err != nil {
+		return err
+	}
+	*f = Flag(b)
+	return nil
+}
+
+func (f Flag) clone() Flag {
+	return f
+}
+
+func (f Flag) equal(o Flag) bool {
+	return f == o
+}
@@ -0,0 +1,167 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// From returns sender of message, whose GLN and SAN are identifiers of types 06 and 07.
+func (h Header) From() Party {
+	p := Party{Name: textOf(h.FromCompany), ContactName: textOf(h.FromPerson), Email: textOf(h.FromEmail), Identifiers: map[string]string{}}
+	if h.FromEANNumber != nil {
+		p.Identifiers["06"] = textOf(h.FromEANNumber)
+	}
+	if h.FromSAN != nil {
+		p.Identifiers["07"] = textOf(h.FromSAN)
+	}
+	for _, id := range h.SenderIdentifiers {
+		p.Identifiers[id.SenderIDType.Code()] = strings.TrimSpace(id.IDValue.Body)
+	}
+	return p
+}
+
+// To returns addressees of message, which are none or one in 2.1.
+func (h Header) To() []Party {
+	p := Party{Name: textOf(h.ToCompany), ContactName: textOf(h.ToPerson), Identifiers: map[string]string{}}
+	if h.ToEANNumber != nil {
+		p.Identifiers["06"] = textOf(h.ToEANNumber)
+	}
+	if h.ToSAN != nil {
+		p.Identifiers["07"] = textOf(h.ToSAN)
+	}
+	for _, id := range h.AddresseeIdentifiers {
+		p.Identifiers[id.AddresseeIDType.Code()] = strings.TrimSpace(id.IDValue.Body)
+	}
+	if p.Name == "" && p.ContactName == "" && len(p.Identifiers) == 0 {
+		return nil
+	}
+	return []Party{p}
+}
+
+// SentTime parses SentDate of YYYYMMDD or YYYYMMDDHHMM.
+func (h Header) SentTime() (time.Time, error) {
+	return parseSentTime(h.SentDate.Body)
+}
+
+// Normalize backfills products which lack values given as defaults of header.
+// Prices without currency or type become of DefaultCurrencyCode and DefaultPriceTypeCode,
+// and products without language of text become of DefaultLanguageOfText.
+func (m *ONIXMessage) Normalize() {
+	if m.Header == nil {
+		return
+	}
+	var language, currency, priceType string
+	if m.Header.DefaultLanguageOfText != nil {
+		language = m.Header.DefaultLanguageOfText.Code()
+	}
+	if m.Header.DefaultCurrencyCode != nil {
+		currency = m.Header.DefaultCurrencyCode.Code()
+	}
+	if m.Header.DefaultPriceTypeCode != nil {
+		priceType = m.Header.DefaultPriceTypeCode.Code()
+	}
+	for i := range m.Products {
+		p := &m.Products[i]
+		if language != "" && !p.hasLanguageOfText() {
+			l := Language{}
+			if defaultedCode(&l.LanguageRole, "01") && defaultedCode(&l.LanguageCode, language) {
+				p.Languages = append(p.Languages, l)
+			}
+		}
+		for j := range p.SupplyDetails {
+			normalizePrices(p.SupplyDetails[j].Prices, currency, priceType)
+		}
+	}
+}
+
+func normalizePrices(prices []Price, currency, priceType string) {
+	for i := range prices {
+		price := &prices[i]
+		if c := (&CurrencyCode{}); price.CurrencyCode == nil && defaultedCode(c, currency) {
+			price.CurrencyCode = c
+		}
+		if t := (&PriceTypeCode{}); price.PriceTypeCode == nil && defaultedCode(t, priceType) {
+			price.PriceTypeCode = t
+		}
+	}
+}
+
+func (p Product) hasLanguageOfText() bool {
+	if len(p.LanguageOfTexts) > 0 {
+		return true
+	}
+	for _, l := range p.Languages {
+		if l.LanguageRole.Code() == "01" {
+			return true
+		}
+	}
+	return false
+}
+
+// Party is sender or addressee of message.
+type Party struct {
+	Name        string
+	ContactName string
+	Email       string
+	// Identifiers are values of identifiers by their type, which is a code of List 44 such as 06 for GLN and 07 for SAN.
+	Identifiers map[string]string
+}
+
+// Is reports whether party is named name, or has identifier of value name.
+func (p Party) Is(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	if strings.EqualFold(p.Name, name) {
+		return true
+	}
+	for _, value := range p.Identifiers {
+		if value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAddressedTo reports whether message is addressed to party of name or identifier,
+// where message without addressees is regarded as addressed to anyone.
+func (h Header) IsAddressedTo(name string) bool {
+	addressees := h.To()
+	if len(addressees) == 0 {
+		return true
+	}
+	for _, a := range addressees {
+		if a.Is(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sentTimeLayouts are layouts of date and time when message is sent, which may have time zone such as Z or +0100.
+var sentTimeLayouts = []string{"20060102", "200601021504", "20060102T1504", "20060102T150405", "20060102T1504Z0700", "20060102T150405Z0700"}
+
+func parseSentTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range sentTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unexpected date and time of sending has been passed, got [%s]", value)
+}
+
+func textOf(t *PlainText) string {
+	if t == nil {
+		return ""
+	}
+	return strings.TrimSpace(t.Body)
+}
+
+// defaultedCode decodes code into c unless code is empty, and reports whether c is decoded.
+func defaultedCode(c xml.Unmarshaler, code string) bool {
+	return code != "" && decodeCode(c, code) == nil
+}
@@ -0,0 +1,163 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IllustrationTypeCode is code of List 25 which tells type of illustrations and other content, such as 14 for maps.
+type IllustrationTypeCode string
+
+const (
+	// IllustrationUnspecified is unspecified content, which the description tells.
+	IllustrationUnspecified IllustrationTypeCode = "00"
+	// IllustrationIllustrationsBlackAndWhite is black and white illustrations.
+	IllustrationIllustrationsBlackAndWhite IllustrationTypeCode = "01"
+	// IllustrationIllustrationsColor is color illustrations.
+	IllustrationIllustrationsColor IllustrationTypeCode = "02"
+	// IllustrationHalftonesBlackAndWhite is black and white halftones.
+	IllustrationHalftonesBlackAndWhite IllustrationTypeCode = "03"
+	// IllustrationHalftonesColor is color halftones.
+	IllustrationHalftonesColor IllustrationTypeCode = "04"
+	// IllustrationLineDrawingsBlackAndWhite is black and white line drawings.
+	IllustrationLineDrawingsBlackAndWhite IllustrationTypeCode = "05"
+	// IllustrationLineDrawingsColor is color line drawings.
+	IllustrationLineDrawingsColor IllustrationTypeCode = "06"
+	// IllustrationTablesBlackAndWhite is black and white tables.
+	IllustrationTablesBlackAndWhite IllustrationTypeCode = "07"
+	// IllustrationTablesColor is color tables.
+	IllustrationTablesColor IllustrationTypeCode = "08"
+	// IllustrationIllustrations is illustrations of unspecified color.
+	IllustrationIllustrations IllustrationTypeCode = "09"
+	// IllustrationHalftones is halftones of unspecified color.
+	IllustrationHalftones IllustrationTypeCode = "10"
+	// IllustrationTables is tables of unspecified color.
+	IllustrationTables IllustrationTypeCode = "11"
+	// IllustrationLineDrawings is line drawings of unspecified color.
+	IllustrationLineDrawings IllustrationTypeCode = "12"
+	// IllustrationHalftonesDuotone is duotone halftones.
+	IllustrationHalftonesDuotone IllustrationTypeCode = "13"
+	// IllustrationMaps is maps.
+	IllustrationMaps IllustrationTypeCode = "14"
+	// IllustrationFrontispiece is frontispiece.
+	IllustrationFrontispiece IllustrationTypeCode = "15"
+	// IllustrationDiagrams is diagrams.
+	IllustrationDiagrams IllustrationTypeCode = "16"
+	// IllustrationFigures is figures.
+	IllustrationFigures IllustrationTypeCode = "17"
+	// IllustrationCharts is charts.
+	IllustrationCharts IllustrationTypeCode = "18"
+	// IllustrationRecordedMusic is recorded music items.
+	IllustrationRecordedMusic IllustrationTypeCode = "19"
+	// IllustrationPrintedMusic is printed music items.
+	IllustrationPrintedMusic IllustrationTypeCode = "20"
+	// IllustrationGraphs is graphs.
+	IllustrationGraphs IllustrationTypeCode = "21"
+	// IllustrationPlates is plates of unspecified color.
+	IllustrationPlates IllustrationTypeCode = "22"
+	// IllustrationPlatesBlackAndWhite is black and white plates.
+	IllustrationPlatesBlackAndWhite IllustrationTypeCode = "23"
+	// IllustrationPlatesColor is color plates.
+	IllustrationPlatesColor IllustrationTypeCode = "24"
+	// IllustrationIndex is index.
+	IllustrationIndex IllustrationTypeCode = "25"
+	// IllustrationBibliography is bibliography.
+	IllustrationBibliography IllustrationTypeCode = "26"
+	// IllustrationInsetMaps is inset maps.
+	IllustrationInsetMaps IllustrationTypeCode = "27"
+	// IllustrationGPSGrids is GPS grids.
+	IllustrationGPSGrids IllustrationTypeCode = "28"
+	// IllustrationGlossary is glossary.
+	IllustrationGlossary IllustrationTypeCode = "29"
+)
+
+// IsColor reports whether content of code is in color.
+func (c IllustrationTypeCode) IsColor() bool {
+	switch c {
+	case IllustrationIllustrationsColor, IllustrationHalftonesColor, IllustrationLineDrawingsColor, IllustrationTablesColor, IllustrationPlatesColor:
+		return true
+	}
+	return false
+}
+
+// NewIllustrations returns Illustrations of number of content of t, which fails for codes which List 25 does not define.
+// Number of zero is omitted, and description is omitted if it is empty.
+func NewIllustrations(t IllustrationTypeCode, number int64, description string) (Illustrations, error) {
+	var i Illustrations
+	if err := decodeCode(&i.IllustrationType, string(t)); err != nil {
+		return Illustrations{}, err
+	}
+	if number > 0 {
+		i.Number = &PlainText{Body: strconv.FormatInt(number, 10)}
+	}
+	if description != "" {
+		i.IllustrationTypeDescription = &PlainText{Body: description}
+	}
+	return i, nil
+}
+
+// Type returns code of List 25 of illustrations.
+func (i Illustrations) Type() IllustrationTypeCode {
+	return IllustrationTypeCode(i.IllustrationType.Code())
+}
+
+// Count returns number of illustrations, which is absent for content which is not counted such as index.
+func (i Illustrations) Count() (int64, bool) {
+	return quantityOf(i.Number)
+}
+
+// Description returns IllustrationTypeDescription of illustrations, which is empty if it is absent.
+func (i Illustrations) Description() string {
+	if i.IllustrationTypeDescription == nil {
+		return ""
+	}
+	return strings.TrimSpace(i.IllustrationTypeDescription.Body)
+}
+
+// IllustrationNote returns IllustrationsNote of product, which is named so since IllustrationsNote is the element itself.
+func (p Product) IllustrationNote() (string, bool) {
+	if p.IllustrationsNote == nil {
+		return "", false
+	}
+	note := strings.TrimSpace(p.IllustrationsNote.Body)
+	return note, note != ""
+}
+
+// IllustrationsOf returns Illustrations composites of product whose type is t.
+func (p Product) IllustrationsOf(t IllustrationTypeCode) []Illustrations {
+	var found []Illustrations
+	for _, i := range p.Illustrationss {
+		if i.Type() == t {
+			found = append(found, i)
+		}
+	}
+	return found
+}
+
+// IllustrationCount returns NumberOfIllustrations of product, or sum of numbers of its Illustrations composites if it is absent.
+// It is named so since NumberOfIllustrations is the element itself.
+func (p Product) IllustrationCount() (int64, bool) {
+	if n, ok := quantityOf(p.NumberOfIllustrations); ok {
+		return n, true
+	}
+	var total int64
+	found := false
+	for _, i := range p.Illustrationss {
+		if n, ok := i.Count(); ok {
+			total += n
+			found = true
+		}
+	}
+	return total, found
+}
+
+// IsIllustrated reports whether product tells any illustrations by either of NumberOfIllustrations, IllustrationsNote and Illustrations composites.
+func (p Product) IsIllustrated() bool {
+	if _, ok := p.IllustrationNote(); ok {
+		return true
+	}
+	if n, ok := p.IllustrationCount(); ok && n > 0 {
+		return true
+	}
+	return len(p.Illustrationss) > 0
+}
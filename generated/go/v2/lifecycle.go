@@ -0,0 +1,234 @@
+package onix
+
+import "strings"
+
+// PublishingStatusCode is code of List 64 which tells status of publishing of product, such as 04 for active.
+type PublishingStatusCode string
+
+const (
+	// PublishingStatusUnspecified is status which is not specified.
+	PublishingStatusUnspecified PublishingStatusCode = "00"
+	// PublishingStatusCancelled is product which is cancelled before publication.
+	PublishingStatusCancelled PublishingStatusCode = "01"
+	// PublishingStatusForthcoming is product which is not yet published.
+	PublishingStatusForthcoming PublishingStatusCode = "02"
+	// PublishingStatusPostponedIndefinitely is product whose publication is postponed with no date.
+	PublishingStatusPostponedIndefinitely PublishingStatusCode = "03"
+	// PublishingStatusActive is product which is published and available.
+	PublishingStatusActive PublishingStatusCode = "04"
+	// PublishingStatusNoLongerOurProduct is product whose ownership has been transferred to another publisher.
+	PublishingStatusNoLongerOurProduct PublishingStatusCode = "05"
+	// PublishingStatusOutOfStockIndefinitely is product which is out of stock with no plan to reprint.
+	PublishingStatusOutOfStockIndefinitely PublishingStatusCode = "06"
+	// PublishingStatusOutOfPrint is product which is out of print.
+	PublishingStatusOutOfPrint PublishingStatusCode = "07"
+	// PublishingStatusInactive is product which is no longer available for unspecified reason.
+	PublishingStatusInactive PublishingStatusCode = "08"
+	// PublishingStatusUnknown is product whose status is unknown to sender.
+	PublishingStatusUnknown PublishingStatusCode = "09"
+	// PublishingStatusRemaindered is product whose remaining stock is sold at reduced price.
+	PublishingStatusRemaindered PublishingStatusCode = "10"
+	// PublishingStatusWithdrawn is product which is withdrawn from sale.
+	PublishingStatusWithdrawn PublishingStatusCode = "11"
+	// PublishingStatusRecalled is product which is recalled for reasons of consumer safety.
+	PublishingStatusRecalled PublishingStatusCode = "12"
+	// PublishingStatusActiveNotSoldSeparately is product which is published only as a part of another.
+	PublishingStatusActiveNotSoldSeparately PublishingStatusCode = "13"
+	// PublishingStatusRecalledForLegalReasons is product which is recalled for legal reasons.
+	PublishingStatusRecalledForLegalReasons PublishingStatusCode = "15"
+	// PublishingStatusTemporarilyWithdrawn is product which is temporarily withdrawn from sale.
+	PublishingStatusTemporarilyWithdrawn PublishingStatusCode = "16"
+	// PublishingStatusPermanentlyWithdrawn is product which is permanently withdrawn from sale.
+	PublishingStatusPermanentlyWithdrawn PublishingStatusCode = "17"
+)
+
+// LifecycleState is a normalized state of product through its life, which is computed from status of publishing and availability.
+type LifecycleState string
+
+const (
+	// LifecycleUnknown is product whose state is unknown.
+	LifecycleUnknown LifecycleState = "unknown"
+	// LifecycleForthcoming is product which is not yet published.
+	LifecycleForthcoming LifecycleState = "forthcoming"
+	// LifecyclePostponed is product whose publication is postponed indefinitely.
+	LifecyclePostponed LifecycleState = "postponed"
+	// LifecycleActive is product which can be ordered and supplied.
+	LifecycleActive LifecycleState = "active"
+	// LifecycleTemporarilyUnavailable is active product which is out of stock or being reprinted.
+	LifecycleTemporarilyUnavailable LifecycleState = "temporarily-unavailable"
+	// LifecycleInactive is product which is no longer available from publisher or supplier, such as one replaced by new edition.
+	LifecycleInactive LifecycleState = "inactive"
+	// LifecycleOutOfPrint is product which is out of print or remaindered.
+	LifecycleOutOfPrint LifecycleState = "out-of-print"
+	// LifecycleWithdrawn is product which is withdrawn from sale or recalled.
+	LifecycleWithdrawn LifecycleState = "withdrawn"
+	// LifecycleCancelled is product which is cancelled before publication.
+	LifecycleCancelled LifecycleState = "cancelled"
+)
+
+// lifecycleStates are states in order of precedence, where state of the most available supplier is taken.
+var lifecycleStates = []LifecycleState{
+	LifecycleActive, LifecycleTemporarilyUnavailable, LifecycleForthcoming, LifecyclePostponed,
+	LifecycleInactive, LifecycleOutOfPrint, LifecycleWithdrawn, LifecycleCancelled,
+}
+
+// State returns state of product of status c, which is unknown for unspecified or unknown status.
+func (c PublishingStatusCode) State() LifecycleState {
+	switch c {
+	case PublishingStatusCancelled:
+		return LifecycleCancelled
+	case PublishingStatusForthcoming:
+		return LifecycleForthcoming
+	case PublishingStatusPostponedIndefinitely:
+		return LifecyclePostponed
+	case PublishingStatusActive, PublishingStatusActiveNotSoldSeparately:
+		return LifecycleActive
+	case PublishingStatusNoLongerOurProduct, PublishingStatusInactive:
+		return LifecycleInactive
+	case PublishingStatusOutOfStockIndefinitely, PublishingStatusOutOfPrint, PublishingStatusRemaindered:
+		return LifecycleOutOfPrint
+	case PublishingStatusWithdrawn, PublishingStatusRecalled, PublishingStatusRecalledForLegalReasons,
+		PublishingStatusTemporarilyWithdrawn, PublishingStatusPermanentlyWithdrawn:
+		return LifecycleWithdrawn
+	}
+	return LifecycleUnknown
+}
+
+// State returns state of product of availability c, which is unknown for availability of supplier without recent updates.
+func (c ProductAvailabilityCode) State() LifecycleState {
+	switch {
+	case c == ProductAvailabilityCancelled:
+		return LifecycleCancelled
+	case c == "09":
+		return LifecyclePostponed
+	case c == "34" || c == "46" || c == "49":
+		return LifecycleWithdrawn
+	case c == "47" || c == ProductAvailabilityOutOfPrint:
+		return LifecycleOutOfPrint
+	case c.IsForthcoming():
+		return LifecycleForthcoming
+	case c.IsAvailable():
+		return LifecycleActive
+	case c.IsTemporarilyUnavailable():
+		return LifecycleTemporarilyUnavailable
+	case c.IsUnavailable():
+		return LifecycleInactive
+	}
+	return LifecycleUnknown
+}
+
+// Date returns date when product is reissued.
+func (r Reissue) Date() (Date, bool) {
+	return dateOf(&r.ReissueDate, nil)
+}
+
+// Description returns description of changes of product which is reissued.
+func (r Reissue) Description() string {
+	if r.ReissueDescription == nil {
+		return ""
+	}
+	return strings.TrimSpace(r.ReissueDescription.Body)
+}
+
+// ReissueDate returns the earliest date when suppliers reissue product.
+func (p Product) ReissueDate() (Date, bool) {
+	var earliest Date
+	found := false
+	for _, s := range p.SupplyDetails {
+		if s.Reissue == nil {
+			continue
+		}
+		if date, ok := s.Reissue.Date(); ok && (!found || date.Time.Before(earliest.Time)) {
+			earliest, found = date, true
+		}
+	}
+	return earliest, found
+}
+
+// LifecycleState returns state of product, which is computed from PublishingStatus and availability of suppliers.
+// Status of publishing takes precedence, where active product which no supplier has in stock is temporarily unavailable,
+// and forthcoming product which a supplier already supplies is active.
+// Availability of the most available supplier is taken unless status is specified.
+func (p Product) LifecycleState() LifecycleState {
+	state := LifecycleUnknown
+	if p.PublishingStatus != nil {
+		state = PublishingStatusCode(p.PublishingStatus.Code()).State()
+	}
+	var availabilities []ProductAvailabilityCode
+	for _, s := range p.SupplyDetails {
+		if availability, ok := s.Availability(); ok {
+			availabilities = append(availabilities, availability)
+		}
+	}
+	return lifecycleOf(state, availabilities)
+}
+
+// lifecycleOf combines state of status of publishing with availabilities of suppliers.
+func lifecycleOf(state LifecycleState, availabilities []ProductAvailabilityCode) LifecycleState {
+	var states []LifecycleState
+	for _, a := range availabilities {
+		states = append(states, a.State())
+	}
+	supplied := mostAvailable(states)
+	switch {
+	case state == LifecycleUnknown:
+		return supplied
+	case state == LifecycleActive && supplied == LifecycleTemporarilyUnavailable:
+		return LifecycleTemporarilyUnavailable
+	case state == LifecycleForthcoming && supplied == LifecycleActive:
+		return LifecycleActive
+	}
+	return state
+}
+
+// mostAvailable returns state of states which is the earliest in lifecycleStates, which is unknown for no states.
+func mostAvailable(states []LifecycleState) LifecycleState {
+	for _, s := range lifecycleStates {
+		for _, state := range states {
+			if state == s {
+				return s
+			}
+		}
+	}
+	return LifecycleUnknown
+}
+
+// NotificationCode is code of List 1 which tells what record of product notifies, such as 05 for delete.
+type NotificationCode string
+
+const (
+	// NotificationEarly is early notification of product which is not yet confirmed.
+	NotificationEarly NotificationCode = "01"
+	// NotificationAdvance is confirmed notification of product before its publication.
+	NotificationAdvance NotificationCode = "02"
+	// NotificationConfirmed is notification of product which is confirmed on its publication.
+	NotificationConfirmed NotificationCode = "03"
+	// NotificationUpdate is partial update of record of product.
+	NotificationUpdate NotificationCode = "04"
+	// NotificationDelete deletes record of product which has been sent before.
+	NotificationDelete NotificationCode = "05"
+	// NotificationSale is notice that product has been sold to another publisher.
+	NotificationSale NotificationCode = "08"
+	// NotificationAcquisition is notice that product has been acquired from another publisher.
+	NotificationAcquisition NotificationCode = "09"
+	// NotificationTestUpdate is partial update of record which is sent as a test.
+	NotificationTestUpdate NotificationCode = "88"
+	// NotificationTestRecord is record which is sent as a test.
+	NotificationTestRecord NotificationCode = "89"
+)
+
+// IsTest reports whether record of notification c is sent as a test, which recipients should not apply.
+func (c NotificationCode) IsTest() bool {
+	return c == NotificationTestUpdate || c == NotificationTestRecord
+}
+
+// Notification returns code of NotificationType of product.
+func (p Product) Notification() NotificationCode {
+	return NotificationCode(p.NotificationType.Code())
+}
+
+// IsDelete reports whether product is a delete notification of the record of its RecordReference,
+// whose other elements are not meant to be stored.
+func (p Product) IsDelete() bool {
+	return p.Notification() == NotificationDelete
+}
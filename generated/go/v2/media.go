@@ -0,0 +1,79 @@
+package onix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// MediaFileTypeFrontCover is code of List 38 of image of front cover.
+	MediaFileTypeFrontCover = "04"
+	// MediaFileTypeFrontCoverHighQuality is code of List 38 of high quality image of front cover.
+	MediaFileTypeFrontCoverHighQuality = "06"
+	// MediaFileTypeFrontCoverThumbnail is code of List 38 of thumbnail image of front cover.
+	MediaFileTypeFrontCoverThumbnail = "07"
+	// MediaFileTypeContributor is code of List 38 of image of contributors.
+	MediaFileTypeContributor = "08"
+	// MediaFileLinkTypeURL is code of List 40 of media file which is linked from URL.
+	MediaFileLinkTypeURL = "01"
+)
+
+// coverTypes are codes of List 38 of images of front cover in order of preference.
+var coverTypes = []string{MediaFileTypeFrontCoverHighQuality, MediaFileTypeFrontCover, MediaFileTypeFrontCoverThumbnail}
+
+// URL returns link of media file if it is a valid URL of http or https.
+func (m MediaFile) URL() (string, bool) {
+	if m.MediaFileLinkTypeCode.Code() != MediaFileLinkTypeURL {
+		return "", false
+	}
+	link := strings.TrimSpace(m.MediaFileLink.Body)
+	return link, ValidURL(link)
+}
+
+// CoverImageURL returns URL of image of front cover, where high quality image is preferred over the usual one and thumbnail.
+func (p Product) CoverImageURL() (string, bool) {
+	for _, t := range coverTypes {
+		for _, m := range p.MediaFiles {
+			if m.MediaFileTypeCode.Code() != t {
+				continue
+			}
+			if link, ok := m.URL(); ok {
+				return link, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ValidURL reports whether link is an absolute URL of http or https which storefronts can link to.
+func ValidURL(link string) bool {
+	u, err := url.Parse(strings.TrimSpace(link))
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// VerifyLink sends HEAD request to link by client, and returns error unless it is responded successfully.
+// http.DefaultClient is used when client is nil.
+func VerifyLink(ctx context.Context, client *http.Client, link string) error {
+	if !ValidURL(link) {
+		return fmt.Errorf("unexpected link has been passed, got [%s]", link)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, strings.TrimSpace(link), nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status of link [%s] has been responded, got [%s]", link, res.Status)
+	}
+	return nil
+}
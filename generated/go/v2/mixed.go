@@ -1,54 +1,267 @@
 package onix
 
+import (
+	"encoding/xml"
+	"strings"
+)
+
 
 // Annotation is html string.
 type Annotation string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h Annotation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h Annotation) clone() Annotation {
+	return h
+}
+
+func (h Annotation) equal(o Annotation) bool {
+	return h == o
+}
+
 
 // BiographicalNote is html string.
 type BiographicalNote string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h BiographicalNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h BiographicalNote) clone() BiographicalNote {
+	return h
+}
+
+func (h BiographicalNote) equal(o BiographicalNote) bool {
+	return h == o
+}
+
 
 // DownloadCaption is html string.
 type DownloadCaption string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h DownloadCaption) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h DownloadCaption) clone() DownloadCaption {
+	return h
+}
+
+func (h DownloadCaption) equal(o DownloadCaption) bool {
+	return h == o
+}
+
 
 // DownloadCopyrightNotice is html string.
 type DownloadCopyrightNotice string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h DownloadCopyrightNotice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h DownloadCopyrightNotice) clone() DownloadCopyrightNotice {
+	return h
+}
+
+func (h DownloadCopyrightNotice) equal(o DownloadCopyrightNotice) bool {
+	return h == o
+}
+
 
 // DownloadCredit is html string.
 type DownloadCredit string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h DownloadCredit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h DownloadCredit) clone() DownloadCredit {
+	return h
+}
+
+func (h DownloadCredit) equal(o DownloadCredit) bool {
+	return h == o
+}
+
 
 // DownloadTerms is html string.
 type DownloadTerms string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h DownloadTerms) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h DownloadTerms) clone() DownloadTerms {
+	return h
+}
+
+func (h DownloadTerms) equal(o DownloadTerms) bool {
+	return h == o
+}
+
 
 // MainDescription is html string.
 type MainDescription string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h MainDescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h MainDescription) clone() MainDescription {
+	return h
+}
+
+func (h MainDescription) equal(o MainDescription) bool {
+	return h == o
+}
+
 
 // PrizeJury is html string.
 type PrizeJury string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h PrizeJury) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h PrizeJury) clone() PrizeJury {
+	return h
+}
+
+func (h PrizeJury) equal(o PrizeJury) bool {
+	return h == o
+}
+
 
 // ProductWebsiteDescription is html string.
 type ProductWebsiteDescription string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h ProductWebsiteDescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h ProductWebsiteDescription) clone() ProductWebsiteDescription {
+	return h
+}
+
+func (h ProductWebsiteDescription) equal(o ProductWebsiteDescription) bool {
+	return h == o
+}
+
 
 // ReviewQuote is html string.
 type ReviewQuote string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h ReviewQuote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h ReviewQuote) clone() ReviewQuote {
+	return h
+}
+
+func (h ReviewQuote) equal(o ReviewQuote) bool {
+	return h == o
+}
+
 
 // Text is html string.
 type Text string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h Text) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h Text) clone() Text {
+	return h
+}
+
+func (h Text) equal(o Text) bool {
+	return h == o
+}
+
 
 // TextWithDownload is html string.
 type TextWithDownload string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h TextWithDownload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h TextWithDownload) clone() TextWithDownload {
+	return h
+}
+
+func (h TextWithDownload) equal(o TextWithDownload) bool {
+	return h == o
+}
+
 
 // WebsiteDescription is html string.
 type WebsiteDescription string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h WebsiteDescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h WebsiteDescription) clone() WebsiteDescription {
+	return h
+}
+
+func (h WebsiteDescription) equal(o WebsiteDescription) bool {
+	return h == o
+}
+
@@ -3,1443 +3,1482 @@ package onix
 
 // AddresseeIdentifier is not documented.
 type AddresseeIdentifier struct {
-	AddresseeIDType AddresseeIDType `xml:"m380"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AddresseeIDType AddresseeIDType `xml:"m380" json:"addresseeIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AgentIdentifier is not documented.
 type AgentIdentifier struct {
-	AgentIDType string `xml:"j400"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AgentIDType PlainText `xml:"j400" json:"agentIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Audience is not documented.
 type Audience struct {
-	AudienceCodeType AudienceCodeType `xml:"b204"`
-	AudienceCodeTypeName *string `xml:"b205,omitempty" json:",omitempty"`
-	AudienceCodeValue string `xml:"b206"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AudienceCodeType AudienceCodeType `xml:"b204" json:"audienceCodeType"`
+	AudienceCodeTypeName *PlainText `xml:"b205,omitempty" json:"audienceCodeTypeName,omitempty"`
+	AudienceCodeValue PlainText `xml:"b206" json:"audienceCodeValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AudienceRange is not documented.
 type AudienceRange struct {
-	AudienceRangeQualifier AudienceRangeQualifier `xml:"b074"`
-	AudienceRangePrecision *AudienceRangePrecision `xml:"b075,omitempty" json:",omitempty"`
-	AudienceRangeValue *string `xml:"b076,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AudienceRangeQualifier AudienceRangeQualifier `xml:"b074" json:"audienceRangeQualifier"`
+	AudienceRangePrecision *AudienceRangePrecision `xml:"b075,omitempty" json:"audienceRangePrecision,omitempty"`
+	AudienceRangeValue *PlainText `xml:"b076,omitempty" json:"audienceRangeValue,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // BatchBonus is not documented.
 type BatchBonus struct {
-	BatchQuantity string `xml:"j264"`
-	FreeQuantity string `xml:"j265"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	BatchQuantity PlainText `xml:"j264" json:"batchQuantity"`
+	FreeQuantity PlainText `xml:"j265" json:"freeQuantity"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Bible is not documented.
 type Bible struct {
-	BibleContentss []BibleContents `xml:"b352"`
-	BibleVersions []BibleVersion `xml:"b353"`
-	StudyBibleType *StudyBibleType `xml:"b389,omitempty" json:",omitempty"`
-	BiblePurposes []BiblePurpose `xml:"b354,omitempty" json:",omitempty"`
-	BibleTextOrganization *BibleTextOrganization `xml:"b355,omitempty" json:",omitempty"`
-	BibleReferenceLocation *BibleReferenceLocation `xml:"b356,omitempty" json:",omitempty"`
-	BibleTextFeatures []BibleTextFeature `xml:"b357,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	BibleContentss []BibleContents `xml:"b352" json:"bibleContentss"`
+	BibleVersions []BibleVersion `xml:"b353" json:"bibleVersions"`
+	StudyBibleType *StudyBibleType `xml:"b389,omitempty" json:"studyBibleType,omitempty"`
+	BiblePurposes []BiblePurpose `xml:"b354,omitempty" json:"biblePurposes,omitempty"`
+	BibleTextOrganization *BibleTextOrganization `xml:"b355,omitempty" json:"bibleTextOrganization,omitempty"`
+	BibleReferenceLocation *BibleReferenceLocation `xml:"b356,omitempty" json:"bibleReferenceLocation,omitempty"`
+	BibleTextFeatures []BibleTextFeature `xml:"b357,omitempty" json:"bibleTextFeatures,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Complexity is not documented.
 type Complexity struct {
-	ComplexitySchemeIdentifier ComplexitySchemeIdentifier `xml:"b077"`
-	ComplexityCode string `xml:"b078"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ComplexitySchemeIdentifier ComplexitySchemeIdentifier `xml:"b077" json:"complexitySchemeIdentifier"`
+	ComplexityCode PlainText `xml:"b078" json:"complexityCode"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Conference is not documented.
 type Conference struct {
-	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:",omitempty"`
-	ConferenceName string `xml:"b052"`
-	ConferenceAcronym *string `xml:"b341,omitempty" json:",omitempty"`
-	ConferenceNumber *string `xml:"b053,omitempty" json:",omitempty"`
-	ConferenceTheme *string `xml:"b342,omitempty" json:",omitempty"`
-	ConferenceDate *string `xml:"b054,omitempty" json:",omitempty"`
-	ConferencePlace *string `xml:"b055,omitempty" json:",omitempty"`
-	ConferenceSponsors []ConferenceSponsor `xml:"conferencesponsor,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:"conferenceRole,omitempty"`
+	ConferenceName PlainText `xml:"b052" json:"conferenceName"`
+	ConferenceAcronym *PlainText `xml:"b341,omitempty" json:"conferenceAcronym,omitempty"`
+	ConferenceNumber *PlainText `xml:"b053,omitempty" json:"conferenceNumber,omitempty"`
+	ConferenceTheme *PlainText `xml:"b342,omitempty" json:"conferenceTheme,omitempty"`
+	ConferenceDate *PlainText `xml:"b054,omitempty" json:"conferenceDate,omitempty"`
+	ConferencePlace *PlainText `xml:"b055,omitempty" json:"conferencePlace,omitempty"`
+	ConferenceSponsors []ConferenceSponsor `xml:"conferencesponsor,omitempty" json:"conferenceSponsors,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ConferenceSponsor is not documented.
 type ConferenceSponsor struct {
-	PersonName *string `xml:"b036,omitempty" json:",omitempty"`
-	CorporateName *string `xml:"b047,omitempty" json:",omitempty"`
-	ConferenceSponsorIdentifier *ConferenceSponsorIdentifier `xml:"conferencesponsoridentifier,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	ConferenceSponsorIdentifier *ConferenceSponsorIdentifier `xml:"conferencesponsoridentifier,omitempty" json:"conferenceSponsorIdentifier,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ConferenceSponsorIdentifier is not documented.
 type ConferenceSponsorIdentifier struct {
-	ConferenceSponsorIDType ConferenceSponsorIDType `xml:"b391"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ConferenceSponsorIDType ConferenceSponsorIDType `xml:"b391" json:"conferenceSponsorIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContainedItem is not documented.
 type ContainedItem struct {
-	ISBN *string `xml:"b004,omitempty" json:",omitempty"`
-	EAN13 *string `xml:"b005,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	ProductForm *ProductForm `xml:"b012,omitempty" json:",omitempty"`
-	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:",omitempty"`
-	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:",omitempty"`
-	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:",omitempty"`
-	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:",omitempty"`
-	ProductFormDescription *string `xml:"b014,omitempty" json:",omitempty"`
-	NumberOfPieces *string `xml:"b210,omitempty" json:",omitempty"`
-	TradeCategory *TradeCategory `xml:"b384,omitempty" json:",omitempty"`
-	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:",omitempty"`
-	ItemQuantity *string `xml:"b015,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ISBN *PlainText `xml:"b004,omitempty" json:"isbn,omitempty"`
+	EAN13 *PlainText `xml:"b005,omitempty" json:"ean13,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	ProductForm *ProductForm `xml:"b012,omitempty" json:"productForm,omitempty"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:"productFormFeatures,omitempty"`
+	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:"bookFormDetails,omitempty"`
+	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:"productPackaging,omitempty"`
+	ProductFormDescription *PlainText `xml:"b014,omitempty" json:"productFormDescription,omitempty"`
+	NumberOfPieces *PlainText `xml:"b210,omitempty" json:"numberOfPieces,omitempty"`
+	TradeCategory *TradeCategory `xml:"b384,omitempty" json:"tradeCategory,omitempty"`
+	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:"productContentTypes,omitempty"`
+	ItemQuantity *PlainText `xml:"b015,omitempty" json:"itemQuantity,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContentItem is not documented.
 type ContentItem struct {
-	Titles []Title `xml:"title,omitempty" json:",omitempty"`
-	ComponentTypeName *string `xml:"b288,omitempty" json:",omitempty"`
-	ComponentNumber *string `xml:"b289,omitempty" json:",omitempty"`
-	DistinctiveTitle *string `xml:"b028,omitempty" json:",omitempty"`
-	LevelSequenceNumber *string `xml:"b284,omitempty" json:",omitempty"`
-	TextItem TextItem `xml:"textitem"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	WorkIdentifiers []WorkIdentifier `xml:"workidentifier,omitempty" json:",omitempty"`
-	Subjects []Subject `xml:"subject,omitempty" json:",omitempty"`
-	PersonAsSubjects []PersonAsSubject `xml:"personassubject,omitempty" json:",omitempty"`
-	CorporateBodyAsSubjects []string `xml:"b071,omitempty" json:",omitempty"`
-	PlaceAsSubjects []string `xml:"b072,omitempty" json:",omitempty"`
-	OtherTexts []OtherText `xml:"othertext,omitempty" json:",omitempty"`
-	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:",omitempty"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	ContributorStatement *string `xml:"b049,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Titles []Title `xml:"title,omitempty" json:"titles,omitempty"`
+	ComponentTypeName *PlainText `xml:"b288,omitempty" json:"componentTypeName,omitempty"`
+	ComponentNumber *PlainText `xml:"b289,omitempty" json:"componentNumber,omitempty"`
+	DistinctiveTitle *PlainText `xml:"b028,omitempty" json:"distinctiveTitle,omitempty"`
+	LevelSequenceNumber *PlainText `xml:"b284,omitempty" json:"levelSequenceNumber,omitempty"`
+	TextItem TextItem `xml:"textitem" json:"textItem"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	WorkIdentifiers []WorkIdentifier `xml:"workidentifier,omitempty" json:"workIdentifiers,omitempty"`
+	Subjects []Subject `xml:"subject,omitempty" json:"subjects,omitempty"`
+	PersonAsSubjects []PersonAsSubject `xml:"personassubject,omitempty" json:"personAsSubjects,omitempty"`
+	CorporateBodyAsSubjects []PlainText `xml:"b071,omitempty" json:"corporateBodyAsSubjects,omitempty"`
+	PlaceAsSubjects []PlainText `xml:"b072,omitempty" json:"placeAsSubjects,omitempty"`
+	OtherTexts []OtherText `xml:"othertext,omitempty" json:"otherTexts,omitempty"`
+	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:"mediaFiles,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorStatement *PlainText `xml:"b049,omitempty" json:"contributorStatement,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Contributor is not documented.
 type Contributor struct {
-	SequenceNumberWithinRole *string `xml:"b340,omitempty" json:",omitempty"`
-	ContributorRole *ContributorRole `xml:"b035,omitempty" json:",omitempty"`
-	LanguageCodes []LanguageCode `xml:"b252,omitempty" json:",omitempty"`
-	UnnamedPersons *UnnamedPersons `xml:"b249,omitempty" json:",omitempty"`
-	CorporateName *string `xml:"b047,omitempty" json:",omitempty"`
-	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:",omitempty"`
-	PersonName *string `xml:"b036,omitempty" json:",omitempty"`
-	PersonNameInverted *string `xml:"b037,omitempty" json:",omitempty"`
-	Names []Name `xml:"name,omitempty" json:",omitempty"`
-	TitlesBeforeNames *string `xml:"b038,omitempty" json:",omitempty"`
-	NamesBeforeKey *string `xml:"b039,omitempty" json:",omitempty"`
-	PrefixToKey *string `xml:"b247,omitempty" json:",omitempty"`
-	KeyNames *string `xml:"b040,omitempty" json:",omitempty"`
-	NamesAfterKey *string `xml:"b041,omitempty" json:",omitempty"`
-	SuffixToKey *string `xml:"b248,omitempty" json:",omitempty"`
-	LettersAfterNames *string `xml:"b042,omitempty" json:",omitempty"`
-	TitlesAfterNames *string `xml:"b043,omitempty" json:",omitempty"`
-	PersonDates []PersonDate `xml:"persondate,omitempty" json:",omitempty"`
-	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:",omitempty"`
-	BiographicalNote *BiographicalNote `xml:"b044,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	ProfessionalPosition *string `xml:"b045,omitempty" json:",omitempty"`
-	Affiliation *string `xml:"b046,omitempty" json:",omitempty"`
-	ContributorDescription *string `xml:"b048,omitempty" json:",omitempty"`
-	SequenceNumber *string `xml:"b034,omitempty" json:",omitempty"`
-	CountryCodes []CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	RegionCodes []string `xml:"b398,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SequenceNumberWithinRole *PlainText `xml:"b340,omitempty" json:"sequenceNumberWithinRole,omitempty"`
+	ContributorRole *ContributorRole `xml:"b035,omitempty" json:"contributorRole,omitempty"`
+	LanguageCodes []LanguageCode `xml:"b252,omitempty" json:"languageCodes,omitempty"`
+	UnnamedPersons *UnnamedPersons `xml:"b249,omitempty" json:"unnamedPersons,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:"personNameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	Names []Name `xml:"name,omitempty" json:"names,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	PersonDates []PersonDate `xml:"persondate,omitempty" json:"personDates,omitempty"`
+	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:"professionalAffiliations,omitempty"`
+	BiographicalNote *BiographicalNote `xml:"b044,omitempty" json:"biographicalNote,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	ProfessionalPosition *PlainText `xml:"b045,omitempty" json:"professionalPosition,omitempty"`
+	Affiliation *PlainText `xml:"b046,omitempty" json:"affiliation,omitempty"`
+	ContributorDescription *PlainText `xml:"b048,omitempty" json:"contributorDescription,omitempty"`
+	SequenceNumber *PlainText `xml:"b034,omitempty" json:"sequenceNumber,omitempty"`
+	CountryCodes []CountryCode `xml:"b251,omitempty" json:"countryCodes,omitempty"`
+	RegionCodes []PlainText `xml:"b398,omitempty" json:"regionCodes,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightOwner is not documented.
 type CopyrightOwner struct {
-	PersonName *string `xml:"b036,omitempty" json:",omitempty"`
-	CorporateName *string `xml:"b047,omitempty" json:",omitempty"`
-	CopyrightOwnerIdentifier *CopyrightOwnerIdentifier `xml:"copyrightowneridentifier,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	CopyrightOwnerIdentifier *CopyrightOwnerIdentifier `xml:"copyrightowneridentifier,omitempty" json:"copyrightOwnerIdentifier,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightOwnerIdentifier is not documented.
 type CopyrightOwnerIdentifier struct {
-	CopyrightOwnerIDType CopyrightOwnerIDType `xml:"b392"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CopyrightOwnerIDType CopyrightOwnerIDType `xml:"b392" json:"copyrightOwnerIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightStatement is not documented.
 type CopyrightStatement struct {
-	CopyrightYears []string `xml:"b087"`
-	CopyrightOwners []CopyrightOwner `xml:"copyrightowner"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CopyrightYears []PlainText `xml:"b087" json:"copyrightYears"`
+	CopyrightOwners []CopyrightOwner `xml:"copyrightowner" json:"copyrightOwners"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // DiscountCoded is not documented.
 type DiscountCoded struct {
-	DiscountCodeType DiscountCodeType `xml:"j363"`
-	DiscountCodeTypeName *string `xml:"j378,omitempty" json:",omitempty"`
-	DiscountCode string `xml:"j364"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	DiscountCodeType DiscountCodeType `xml:"j363" json:"discountCodeType"`
+	DiscountCodeTypeName *PlainText `xml:"j378,omitempty" json:"discountCodeTypeName,omitempty"`
+	DiscountCode PlainText `xml:"j364" json:"discountCode"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Extent is not documented.
 type Extent struct {
-	ExtentType ExtentType `xml:"b218"`
-	ExtentValue string `xml:"b219"`
-	ExtentUnit ExtentUnit `xml:"b220"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ExtentType ExtentType `xml:"b218" json:"extentType"`
+	ExtentValue PlainText `xml:"b219" json:"extentValue"`
+	ExtentUnit ExtentUnit `xml:"b220" json:"extentUnit"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Header is not documented.
 type Header struct {
-	FromCompany *string `xml:"m174,omitempty" json:",omitempty"`
-	FromEANNumber *string `xml:"m172,omitempty" json:",omitempty"`
-	FromSAN *string `xml:"m173,omitempty" json:",omitempty"`
-	SenderIdentifiers []SenderIdentifier `xml:"senderidentifier,omitempty" json:",omitempty"`
-	FromPerson *string `xml:"m175,omitempty" json:",omitempty"`
-	FromEmail *string `xml:"m283,omitempty" json:",omitempty"`
-	ToEANNumber *string `xml:"m176,omitempty" json:",omitempty"`
-	ToSAN *string `xml:"m177,omitempty" json:",omitempty"`
-	AddresseeIdentifiers []AddresseeIdentifier `xml:"addresseeidentifier,omitempty" json:",omitempty"`
-	ToCompany *string `xml:"m178,omitempty" json:",omitempty"`
-	ToPerson *string `xml:"m179,omitempty" json:",omitempty"`
-	MessageNumber *string `xml:"m180,omitempty" json:",omitempty"`
-	MessageRepeat *string `xml:"m181,omitempty" json:",omitempty"`
-	SentDate string `xml:"m182"`
-	MessageNote *string `xml:"m183,omitempty" json:",omitempty"`
-	DefaultLanguageOfText *DefaultLanguageOfText `xml:"m184,omitempty" json:",omitempty"`
-	DefaultPriceTypeCode *DefaultPriceTypeCode `xml:"m185,omitempty" json:",omitempty"`
-	DefaultCurrencyCode *DefaultCurrencyCode `xml:"m186,omitempty" json:",omitempty"`
-	DefaultLinearUnit *DefaultLinearUnit `xml:"m187,omitempty" json:",omitempty"`
-	DefaultWeightUnit *DefaultWeightUnit `xml:"m188,omitempty" json:",omitempty"`
-	DefaultClassOfTrade *string `xml:"m193,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	FromCompany *PlainText `xml:"m174,omitempty" json:"fromCompany,omitempty"`
+	FromEANNumber *PlainText `xml:"m172,omitempty" json:"fromEANNumber,omitempty"`
+	FromSAN *PlainText `xml:"m173,omitempty" json:"fromSAN,omitempty"`
+	SenderIdentifiers []SenderIdentifier `xml:"senderidentifier,omitempty" json:"senderIdentifiers,omitempty"`
+	FromPerson *PlainText `xml:"m175,omitempty" json:"fromPerson,omitempty"`
+	FromEmail *PlainText `xml:"m283,omitempty" json:"fromEmail,omitempty"`
+	ToEANNumber *PlainText `xml:"m176,omitempty" json:"toEANNumber,omitempty"`
+	ToSAN *PlainText `xml:"m177,omitempty" json:"toSAN,omitempty"`
+	AddresseeIdentifiers []AddresseeIdentifier `xml:"addresseeidentifier,omitempty" json:"addresseeIdentifiers,omitempty"`
+	ToCompany *PlainText `xml:"m178,omitempty" json:"toCompany,omitempty"`
+	ToPerson *PlainText `xml:"m179,omitempty" json:"toPerson,omitempty"`
+	MessageNumber *PlainText `xml:"m180,omitempty" json:"messageNumber,omitempty"`
+	MessageRepeat *PlainText `xml:"m181,omitempty" json:"messageRepeat,omitempty"`
+	SentDate PlainText `xml:"m182" json:"sentDate"`
+	MessageNote *PlainText `xml:"m183,omitempty" json:"messageNote,omitempty"`
+	DefaultLanguageOfText *DefaultLanguageOfText `xml:"m184,omitempty" json:"defaultLanguageOfText,omitempty"`
+	DefaultPriceTypeCode *DefaultPriceTypeCode `xml:"m185,omitempty" json:"defaultPriceTypeCode,omitempty"`
+	DefaultCurrencyCode *DefaultCurrencyCode `xml:"m186,omitempty" json:"defaultCurrencyCode,omitempty"`
+	DefaultLinearUnit *DefaultLinearUnit `xml:"m187,omitempty" json:"defaultLinearUnit,omitempty"`
+	DefaultWeightUnit *DefaultWeightUnit `xml:"m188,omitempty" json:"defaultWeightUnit,omitempty"`
+	DefaultClassOfTrade *PlainText `xml:"m193,omitempty" json:"defaultClassOfTrade,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Illustrations is not documented.
 type Illustrations struct {
-	IllustrationType IllustrationType `xml:"b256"`
-	IllustrationTypeDescription *string `xml:"b361,omitempty" json:",omitempty"`
-	Number *string `xml:"b257,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	IllustrationType IllustrationType `xml:"b256" json:"illustrationType"`
+	IllustrationTypeDescription *PlainText `xml:"b361,omitempty" json:"illustrationTypeDescription,omitempty"`
+	Number *PlainText `xml:"b257,omitempty" json:"number,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Imprint is not documented.
 type Imprint struct {
-	ImprintName *string `xml:"b079,omitempty" json:",omitempty"`
-	NameCodeType *NameCodeType `xml:"b241,omitempty" json:",omitempty"`
-	NameCodeTypeName *string `xml:"b242,omitempty" json:",omitempty"`
-	NameCodeValue *string `xml:"b243,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ImprintName *PlainText `xml:"b079,omitempty" json:"imprintName,omitempty"`
+	NameCodeType *NameCodeType `xml:"b241,omitempty" json:"nameCodeType,omitempty"`
+	NameCodeTypeName *PlainText `xml:"b242,omitempty" json:"nameCodeTypeName,omitempty"`
+	NameCodeValue *PlainText `xml:"b243,omitempty" json:"nameCodeValue,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Language is not documented.
 type Language struct {
-	LanguageRole LanguageRole `xml:"b253"`
-	LanguageCode LanguageCode `xml:"b252"`
-	CountryCode *CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LanguageRole LanguageRole `xml:"b253" json:"languageRole"`
+	LanguageCode LanguageCode `xml:"b252" json:"languageCode"`
+	CountryCode *CountryCode `xml:"b251,omitempty" json:"countryCode,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // LocationIdentifier is not documented.
 type LocationIdentifier struct {
-	LocationIDType LocationIDType `xml:"j377"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LocationIDType LocationIDType `xml:"j377" json:"locationIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MainSeriesRecord is not documented.
 type MainSeriesRecord struct {
-	RecordReference string `xml:"a001"`
-	NotificationType NotificationType `xml:"a002"`
-	DeletionCode *DeletionCode `xml:"a198,omitempty" json:",omitempty"`
-	DeletionText *string `xml:"a199,omitempty" json:",omitempty"`
-	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:",omitempty"`
-	RecordSourceName *string `xml:"a197,omitempty" json:",omitempty"`
-	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier"`
-	Titles []Title `xml:"title"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	OtherTexts []OtherText `xml:"othertext,omitempty" json:",omitempty"`
-	Publishers []Publisher `xml:"publisher,omitempty" json:",omitempty"`
-	SubordinateEntries *string `xml:"a245,omitempty" json:",omitempty"`
-	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:",omitempty"`
-	RecordSourceIdentifier *string `xml:"a196,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RecordReference PlainText `xml:"a001" json:"recordReference"`
+	NotificationType NotificationType `xml:"a002" json:"notificationType"`
+	DeletionCode *DeletionCode `xml:"a198,omitempty" json:"deletionCode,omitempty"`
+	DeletionText *PlainText `xml:"a199,omitempty" json:"deletionText,omitempty"`
+	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:"recordSourceType,omitempty"`
+	RecordSourceName *PlainText `xml:"a197,omitempty" json:"recordSourceName,omitempty"`
+	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier" json:"seriesIdentifiers"`
+	Titles []Title `xml:"title" json:"titles"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	OtherTexts []OtherText `xml:"othertext,omitempty" json:"otherTexts,omitempty"`
+	Publishers []Publisher `xml:"publisher,omitempty" json:"publishers,omitempty"`
+	SubordinateEntries *PlainText `xml:"a245,omitempty" json:"subordinateEntries,omitempty"`
+	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:"recordSourceIdentifierType,omitempty"`
+	RecordSourceIdentifier *PlainText `xml:"a196,omitempty" json:"recordSourceIdentifier,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MainSubject is not documented.
 type MainSubject struct {
-	SubjectHeadingText *string `xml:"b070,omitempty" json:",omitempty"`
-	SubjectCode *string `xml:"b069,omitempty" json:",omitempty"`
-	MainSubjectSchemeIdentifier MainSubjectSchemeIdentifier `xml:"b191"`
-	SubjectSchemeVersion *string `xml:"b068,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SubjectHeadingText *PlainText `xml:"b070,omitempty" json:"subjectHeadingText,omitempty"`
+	SubjectCode *PlainText `xml:"b069,omitempty" json:"subjectCode,omitempty"`
+	MainSubjectSchemeIdentifier MainSubjectSchemeIdentifier `xml:"b191" json:"mainSubjectSchemeIdentifier"`
+	SubjectSchemeVersion *PlainText `xml:"b068,omitempty" json:"subjectSchemeVersion,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MarketDate is not documented.
 type MarketDate struct {
-	MarketDateRole string `xml:"j408"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date string `xml:"b306"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	MarketDateRole PlainText `xml:"j408" json:"marketDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MarketRepresentation is not documented.
 type MarketRepresentation struct {
-	AgentName *string `xml:"j401,omitempty" json:",omitempty"`
-	AgentIdentifiers []AgentIdentifier `xml:"agentidentifier,omitempty" json:",omitempty"`
-	MarketCountry *string `xml:"j403,omitempty" json:",omitempty"`
-	MarketTerritory *string `xml:"j404,omitempty" json:",omitempty"`
-	MarketCountryExcluded *string `xml:"j405,omitempty" json:",omitempty"`
-	TelephoneNumbers []string `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []string `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []string `xml:"j272,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	AgentRole *string `xml:"j402,omitempty" json:",omitempty"`
-	MarketRestrictionDetail *string `xml:"j406,omitempty" json:",omitempty"`
-	MarketPublishingStatus *string `xml:"j407,omitempty" json:",omitempty"`
-	MarketDates []MarketDate `xml:"marketdate,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AgentName *PlainText `xml:"j401,omitempty" json:"agentName,omitempty"`
+	AgentIdentifiers []AgentIdentifier `xml:"agentidentifier,omitempty" json:"agentIdentifiers,omitempty"`
+	MarketCountry *PlainText `xml:"j403,omitempty" json:"marketCountry,omitempty"`
+	MarketTerritory *PlainText `xml:"j404,omitempty" json:"marketTerritory,omitempty"`
+	MarketCountryExcluded *PlainText `xml:"j405,omitempty" json:"marketCountryExcluded,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	AgentRole *PlainText `xml:"j402,omitempty" json:"agentRole,omitempty"`
+	MarketRestrictionDetail *PlainText `xml:"j406,omitempty" json:"marketRestrictionDetail,omitempty"`
+	MarketPublishingStatus *PlainText `xml:"j407,omitempty" json:"marketPublishingStatus,omitempty"`
+	MarketDates []MarketDate `xml:"marketdate,omitempty" json:"marketDates,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Measure is not documented.
 type Measure struct {
-	MeasureTypeCode MeasureTypeCode `xml:"c093"`
-	Measurement string `xml:"c094"`
-	MeasureUnitCode MeasureUnitCode `xml:"c095"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	MeasureTypeCode MeasureTypeCode `xml:"c093" json:"measureTypeCode"`
+	Measurement PlainText `xml:"c094" json:"measurement"`
+	MeasureUnitCode MeasureUnitCode `xml:"c095" json:"measureUnitCode"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MediaFile is not documented.
 type MediaFile struct {
-	TextWithDownload *TextWithDownload `xml:"f118,omitempty" json:",omitempty"`
-	DownloadCopyrightNotice *DownloadCopyrightNotice `xml:"f121,omitempty" json:",omitempty"`
-	DownloadCaption *DownloadCaption `xml:"f119,omitempty" json:",omitempty"`
-	DownloadCredit *DownloadCredit `xml:"f120,omitempty" json:",omitempty"`
-	MediaFileTypeCode MediaFileTypeCode `xml:"f114"`
-	MediaFileFormatCode *MediaFileFormatCode `xml:"f115,omitempty" json:",omitempty"`
-	ImageResolution *string `xml:"f259,omitempty" json:",omitempty"`
-	MediaFileLinkTypeCode MediaFileLinkTypeCode `xml:"f116"`
-	MediaFileLink string `xml:"f117"`
-	DownloadTerms *DownloadTerms `xml:"f122,omitempty" json:",omitempty"`
-	MediaFileDate *string `xml:"f373,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextWithDownload *TextWithDownload `xml:"f118,omitempty" json:"textWithDownload,omitempty"`
+	DownloadCopyrightNotice *DownloadCopyrightNotice `xml:"f121,omitempty" json:"downloadCopyrightNotice,omitempty"`
+	DownloadCaption *DownloadCaption `xml:"f119,omitempty" json:"downloadCaption,omitempty"`
+	DownloadCredit *DownloadCredit `xml:"f120,omitempty" json:"downloadCredit,omitempty"`
+	MediaFileTypeCode MediaFileTypeCode `xml:"f114" json:"mediaFileTypeCode"`
+	MediaFileFormatCode *MediaFileFormatCode `xml:"f115,omitempty" json:"mediaFileFormatCode,omitempty"`
+	ImageResolution *PlainText `xml:"f259,omitempty" json:"imageResolution,omitempty"`
+	MediaFileLinkTypeCode MediaFileLinkTypeCode `xml:"f116" json:"mediaFileLinkTypeCode"`
+	MediaFileLink PlainText `xml:"f117" json:"mediaFileLink"`
+	DownloadTerms *DownloadTerms `xml:"f122,omitempty" json:"downloadTerms,omitempty"`
+	MediaFileDate *PlainText `xml:"f373,omitempty" json:"mediaFileDate,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Name is not documented.
 type Name struct {
-	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:",omitempty"`
-	PersonName *string `xml:"b036,omitempty" json:",omitempty"`
-	PersonNameInverted *string `xml:"b037,omitempty" json:",omitempty"`
-	TitlesBeforeNames *string `xml:"b038,omitempty" json:",omitempty"`
-	NamesBeforeKey *string `xml:"b039,omitempty" json:",omitempty"`
-	PrefixToKey *string `xml:"b247,omitempty" json:",omitempty"`
-	KeyNames *string `xml:"b040,omitempty" json:",omitempty"`
-	NamesAfterKey *string `xml:"b041,omitempty" json:",omitempty"`
-	SuffixToKey *string `xml:"b248,omitempty" json:",omitempty"`
-	LettersAfterNames *string `xml:"b042,omitempty" json:",omitempty"`
-	TitlesAfterNames *string `xml:"b043,omitempty" json:",omitempty"`
-	PersonNameType PersonNameType `xml:"b250"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:"personNameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	PersonNameType PersonNameType `xml:"b250" json:"personNameType"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // NewSupplier is not documented.
 type NewSupplier struct {
-	SupplierName *string `xml:"j137,omitempty" json:",omitempty"`
-	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:",omitempty"`
-	SupplierSAN *string `xml:"j136,omitempty" json:",omitempty"`
-	SupplierEANLocationNumber *string `xml:"j135,omitempty" json:",omitempty"`
-	TelephoneNumbers []string `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []string `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []string `xml:"j272,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoContributor is not documented.
-type NoContributor struct {
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoEdition is not documented.
-type NoEdition struct {
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoSeries is not documented.
-type NoSeries struct {
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierName *PlainText `xml:"j137,omitempty" json:"supplierName,omitempty"`
+	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:"supplierIdentifiers,omitempty"`
+	SupplierSAN *PlainText `xml:"j136,omitempty" json:"supplierSAN,omitempty"`
+	SupplierEANLocationNumber *PlainText `xml:"j135,omitempty" json:"supplierEANLocationNumber,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // NotForSale is not documented.
 type NotForSale struct {
-	RightsTerritory *TerritoryCodeList `xml:"b388,omitempty" json:",omitempty"`
-	RightsCountrys []CountryCodeList `xml:"b090,omitempty" json:",omitempty"`
-	ISBN *string `xml:"b004,omitempty" json:",omitempty"`
-	EAN13 *string `xml:"b005,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	PublisherName *string `xml:"b081,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RightsTerritory *TerritoryCodeList `xml:"b388,omitempty" json:"rightsTerritory,omitempty"`
+	RightsCountrys []CountryCodeList `xml:"b090,omitempty" json:"rightsCountrys,omitempty"`
+	ISBN *PlainText `xml:"b004,omitempty" json:"isbn,omitempty"`
+	EAN13 *PlainText `xml:"b005,omitempty" json:"ean13,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	PublisherName *PlainText `xml:"b081,omitempty" json:"publisherName,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ONIXMessage is not documented.
 type ONIXMessage struct {
-	Header *Header `xml:"header,omitempty" json:",omitempty"`
-	Products []Product `xml:"product,omitempty" json:",omitempty"`
-	MainSeriesRecords []MainSeriesRecord `xml:"mainseriesrecord,omitempty" json:",omitempty"`
-	SubSeriesRecords []SubSeriesRecord `xml:"subseriesrecord,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Header *Header `xml:"header,omitempty" json:"header,omitempty"`
+	Products []Product `xml:"product,omitempty" json:"products,omitempty"`
+	MainSeriesRecords []MainSeriesRecord `xml:"mainseriesrecord,omitempty" json:"mainSeriesRecords,omitempty"`
+	SubSeriesRecords []SubSeriesRecord `xml:"subseriesrecord,omitempty" json:"subSeriesRecords,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // OnOrderDetail is not documented.
 type OnOrderDetail struct {
-	OnOrder string `xml:"j351"`
-	ExpectedDate string `xml:"j302"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	OnOrder PlainText `xml:"j351" json:"onOrder"`
+	ExpectedDate PlainText `xml:"j302" json:"expectedDate"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // OtherText is not documented.
 type OtherText struct {
-	Text *Text `xml:"d104,omitempty" json:",omitempty"`
-	TextLinkType *TextLinkType `xml:"d105,omitempty" json:",omitempty"`
-	TextLink *string `xml:"d106,omitempty" json:",omitempty"`
-	TextTypeCode TextTypeCode `xml:"d102"`
-	TextFormat *TextFormat `xml:"d103,omitempty" json:",omitempty"`
-	TextAuthor *string `xml:"d107,omitempty" json:",omitempty"`
-	TextSourceCorporate *string `xml:"b374,omitempty" json:",omitempty"`
-	TextSourceTitle *string `xml:"d108,omitempty" json:",omitempty"`
-	TextPublicationDate *string `xml:"d109,omitempty" json:",omitempty"`
-	StartDate *string `xml:"b324,omitempty" json:",omitempty"`
-	EndDate *string `xml:"b325,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Text *Text `xml:"d104,omitempty" json:"text,omitempty"`
+	TextLinkType *TextLinkType `xml:"d105,omitempty" json:"textLinkType,omitempty"`
+	TextLink *PlainText `xml:"d106,omitempty" json:"textLink,omitempty"`
+	TextTypeCode TextTypeCode `xml:"d102" json:"textTypeCode"`
+	TextFormat *TextFormat `xml:"d103,omitempty" json:"textFormat,omitempty"`
+	TextAuthor *PlainText `xml:"d107,omitempty" json:"textAuthor,omitempty"`
+	TextSourceCorporate *PlainText `xml:"b374,omitempty" json:"textSourceCorporate,omitempty"`
+	TextSourceTitle *PlainText `xml:"d108,omitempty" json:"textSourceTitle,omitempty"`
+	TextPublicationDate *PlainText `xml:"d109,omitempty" json:"textPublicationDate,omitempty"`
+	StartDate *PlainText `xml:"b324,omitempty" json:"startDate,omitempty"`
+	EndDate *PlainText `xml:"b325,omitempty" json:"endDate,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PageRun is not documented.
 type PageRun struct {
-	FirstPageNumber string `xml:"b286"`
-	LastPageNumber *string `xml:"b287,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	FirstPageNumber PlainText `xml:"b286" json:"firstPageNumber"`
+	LastPageNumber *PlainText `xml:"b287,omitempty" json:"lastPageNumber,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ParentIdentifier is not documented.
 type ParentIdentifier struct {
-	SeriesIDType SeriesIDType `xml:"b273"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SeriesIDType SeriesIDType `xml:"b273" json:"seriesIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PersonAsSubject is not documented.
 type PersonAsSubject struct {
-	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:",omitempty"`
-	PersonName *string `xml:"b036,omitempty" json:",omitempty"`
-	PersonNameInverted *string `xml:"b037,omitempty" json:",omitempty"`
-	Names []Name `xml:"name,omitempty" json:",omitempty"`
-	TitlesBeforeNames *string `xml:"b038,omitempty" json:",omitempty"`
-	NamesBeforeKey *string `xml:"b039,omitempty" json:",omitempty"`
-	PrefixToKey *string `xml:"b247,omitempty" json:",omitempty"`
-	KeyNames *string `xml:"b040,omitempty" json:",omitempty"`
-	NamesAfterKey *string `xml:"b041,omitempty" json:",omitempty"`
-	SuffixToKey *string `xml:"b248,omitempty" json:",omitempty"`
-	LettersAfterNames *string `xml:"b042,omitempty" json:",omitempty"`
-	TitlesAfterNames *string `xml:"b043,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonNameIdentifiers []PersonNameIdentifier `xml:"personnameidentifier,omitempty" json:"personNameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	Names []Name `xml:"name,omitempty" json:"names,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PersonDate is not documented.
 type PersonDate struct {
-	PersonDateRole PersonDateRole `xml:"b305"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date string `xml:"b306"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonDateRole PersonDateRole `xml:"b305" json:"personDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PersonNameIdentifier is not documented.
 type PersonNameIdentifier struct {
-	PersonNameIDType PersonNameIDType `xml:"b390"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PersonNameIDType PersonNameIDType `xml:"b390" json:"personNameIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Price is not documented.
 type Price struct {
-	PriceTypeCode *PriceTypeCode `xml:"j148,omitempty" json:",omitempty"`
-	PriceQualifier *PriceQualifier `xml:"j261,omitempty" json:",omitempty"`
-	PriceTypeDescription *string `xml:"j262,omitempty" json:",omitempty"`
-	PricePer *PricePer `xml:"j239,omitempty" json:",omitempty"`
-	MinimumOrderQuantity *string `xml:"j263,omitempty" json:",omitempty"`
-	BatchBonuss []BatchBonus `xml:"batchbonus,omitempty" json:",omitempty"`
-	ClassOfTrade *string `xml:"j149,omitempty" json:",omitempty"`
-	BICDiscountGroupCode *string `xml:"j150,omitempty" json:",omitempty"`
-	DiscountCodeds []DiscountCoded `xml:"discountcoded,omitempty" json:",omitempty"`
-	DiscountPercent *string `xml:"j267,omitempty" json:",omitempty"`
-	PriceStatus *PriceStatus `xml:"j266,omitempty" json:",omitempty"`
-	PriceAmount string `xml:"j151"`
-	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:",omitempty"`
-	PriceEffectiveFrom *string `xml:"j161,omitempty" json:",omitempty"`
-	PriceEffectiveUntil *string `xml:"j162,omitempty" json:",omitempty"`
-	Territory *TerritoryCodeList `xml:"j303,omitempty" json:",omitempty"`
-	CountryCodes []CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	CountryExcluded *CountryCodeList `xml:"j304,omitempty" json:",omitempty"`
-	TerritoryExcluded *TerritoryCodeList `xml:"j308,omitempty" json:",omitempty"`
-	TaxRateCode1 *TaxRateCode1 `xml:"j153,omitempty" json:",omitempty"`
-	TaxRatePercent1 *string `xml:"j154,omitempty" json:",omitempty"`
-	TaxableAmount1 *string `xml:"j155,omitempty" json:",omitempty"`
-	TaxAmount1 *string `xml:"j156,omitempty" json:",omitempty"`
-	TaxRateCode2 *TaxRateCode2 `xml:"j157,omitempty" json:",omitempty"`
-	TaxRatePercent2 *string `xml:"j158,omitempty" json:",omitempty"`
-	TaxableAmount2 *string `xml:"j159,omitempty" json:",omitempty"`
-	TaxAmount2 *string `xml:"j160,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceTypeCode *PriceTypeCode `xml:"j148,omitempty" json:"priceTypeCode,omitempty"`
+	PriceQualifier *PriceQualifier `xml:"j261,omitempty" json:"priceQualifier,omitempty"`
+	PriceTypeDescription *PlainText `xml:"j262,omitempty" json:"priceTypeDescription,omitempty"`
+	PricePer *PricePer `xml:"j239,omitempty" json:"pricePer,omitempty"`
+	MinimumOrderQuantity *PlainText `xml:"j263,omitempty" json:"minimumOrderQuantity,omitempty"`
+	BatchBonuss []BatchBonus `xml:"batchbonus,omitempty" json:"batchBonuss,omitempty"`
+	ClassOfTrade *PlainText `xml:"j149,omitempty" json:"classOfTrade,omitempty"`
+	BICDiscountGroupCode *PlainText `xml:"j150,omitempty" json:"bicDiscountGroupCode,omitempty"`
+	DiscountCodeds []DiscountCoded `xml:"discountcoded,omitempty" json:"discountCodeds,omitempty"`
+	DiscountPercent *PlainText `xml:"j267,omitempty" json:"discountPercent,omitempty"`
+	PriceStatus *PriceStatus `xml:"j266,omitempty" json:"priceStatus,omitempty"`
+	PriceAmount PlainText `xml:"j151" json:"priceAmount"`
+	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:"currencyCode,omitempty"`
+	PriceEffectiveFrom *PlainText `xml:"j161,omitempty" json:"priceEffectiveFrom,omitempty"`
+	PriceEffectiveUntil *PlainText `xml:"j162,omitempty" json:"priceEffectiveUntil,omitempty"`
+	Territory *TerritoryCodeList `xml:"j303,omitempty" json:"territory,omitempty"`
+	CountryCodes []CountryCode `xml:"b251,omitempty" json:"countryCodes,omitempty"`
+	CountryExcluded *CountryCodeList `xml:"j304,omitempty" json:"countryExcluded,omitempty"`
+	TerritoryExcluded *TerritoryCodeList `xml:"j308,omitempty" json:"territoryExcluded,omitempty"`
+	TaxRateCode1 *TaxRateCode1 `xml:"j153,omitempty" json:"taxRateCode1,omitempty"`
+	TaxRatePercent1 *PlainText `xml:"j154,omitempty" json:"taxRatePercent1,omitempty"`
+	TaxableAmount1 *PlainText `xml:"j155,omitempty" json:"taxableAmount1,omitempty"`
+	TaxAmount1 *PlainText `xml:"j156,omitempty" json:"taxAmount1,omitempty"`
+	TaxRateCode2 *TaxRateCode2 `xml:"j157,omitempty" json:"taxRateCode2,omitempty"`
+	TaxRatePercent2 *PlainText `xml:"j158,omitempty" json:"taxRatePercent2,omitempty"`
+	TaxableAmount2 *PlainText `xml:"j159,omitempty" json:"taxableAmount2,omitempty"`
+	TaxAmount2 *PlainText `xml:"j160,omitempty" json:"taxAmount2,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Prize is not documented.
 type Prize struct {
-	PrizeName string `xml:"g126"`
-	PrizeYear *string `xml:"g127,omitempty" json:",omitempty"`
-	PrizeCountry *PrizeCountry `xml:"g128,omitempty" json:",omitempty"`
-	PrizeCode *PrizeCode `xml:"g129,omitempty" json:",omitempty"`
-	PrizeJury *PrizeJury `xml:"g343,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PrizeName PlainText `xml:"g126" json:"prizeName"`
+	PrizeYear *PlainText `xml:"g127,omitempty" json:"prizeYear,omitempty"`
+	PrizeCountry *PrizeCountry `xml:"g128,omitempty" json:"prizeCountry,omitempty"`
+	PrizeCode *PrizeCode `xml:"g129,omitempty" json:"prizeCode,omitempty"`
+	PrizeJury *PrizeJury `xml:"g343,omitempty" json:"prizeJury,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Product is not documented.
 type Product struct {
-	Dimensions *string `xml:"c258,omitempty" json:",omitempty"`
-	Weight *string `xml:"c099,omitempty" json:",omitempty"`
-	Measures []Measure `xml:"measure,omitempty" json:",omitempty"`
-	Height *string `xml:"c096,omitempty" json:",omitempty"`
-	Width *string `xml:"c097,omitempty" json:",omitempty"`
-	Thickness *string `xml:"c098,omitempty" json:",omitempty"`
-	RecordReference string `xml:"a001"`
-	NotificationType NotificationType `xml:"a002"`
-	DeletionCode *DeletionCode `xml:"a198,omitempty" json:",omitempty"`
-	DeletionText *string `xml:"a199,omitempty" json:",omitempty"`
-	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:",omitempty"`
-	RecordSourceName *string `xml:"a197,omitempty" json:",omitempty"`
-	ReplacedByISBN *string `xml:"h130,omitempty" json:",omitempty"`
-	ReplacedByEAN13 *string `xml:"h131,omitempty" json:",omitempty"`
-	AlternativeFormatISBN *string `xml:"h132,omitempty" json:",omitempty"`
-	AlternativeFormatEAN13 *string `xml:"h133,omitempty" json:",omitempty"`
-	AlternativeProductISBN *string `xml:"h163,omitempty" json:",omitempty"`
-	AlternativeProductEAN13 *string `xml:"h164,omitempty" json:",omitempty"`
-	RelatedProducts []RelatedProduct `xml:"relatedproduct,omitempty" json:",omitempty"`
-	OutOfPrintDate *string `xml:"h134,omitempty" json:",omitempty"`
-	SupplyDetails []SupplyDetail `xml:"supplydetail,omitempty" json:",omitempty"`
-	MarketRepresentations []MarketRepresentation `xml:"marketrepresentation,omitempty" json:",omitempty"`
-	PromotionCampaign *string `xml:"k165,omitempty" json:",omitempty"`
-	PromotionContact *string `xml:"k166,omitempty" json:",omitempty"`
-	InitialPrintRun *string `xml:"k167,omitempty" json:",omitempty"`
-	ReprintDetails []string `xml:"k309,omitempty" json:",omitempty"`
-	CopiesSold *string `xml:"k168,omitempty" json:",omitempty"`
-	BookClubAdoption *string `xml:"k169,omitempty" json:",omitempty"`
-	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:",omitempty"`
-	RecordSourceIdentifier *string `xml:"a196,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	ISBN *string `xml:"b004,omitempty" json:",omitempty"`
-	EAN13 *string `xml:"b005,omitempty" json:",omitempty"`
-	UPC *string `xml:"b006,omitempty" json:",omitempty"`
-	PublisherProductNo *string `xml:"b007,omitempty" json:",omitempty"`
-	ISMN *string `xml:"b008,omitempty" json:",omitempty"`
-	DOI *string `xml:"b009,omitempty" json:",omitempty"`
-	Seriess []Series `xml:"series,omitempty" json:",omitempty"`
-	NoSeries *NoSeries `xml:"n338,omitempty" json:",omitempty"`
-	Sets []Set `xml:"set,omitempty" json:",omitempty"`
-	Titles []Title `xml:"title,omitempty" json:",omitempty"`
-	DistinctiveTitle *string `xml:"b028,omitempty" json:",omitempty"`
-	TitlePrefix *string `xml:"b030,omitempty" json:",omitempty"`
-	TitleWithoutPrefix *string `xml:"b031,omitempty" json:",omitempty"`
-	Subtitle *string `xml:"b029,omitempty" json:",omitempty"`
-	TranslationOfTitle *string `xml:"b032,omitempty" json:",omitempty"`
-	FormerTitles []string `xml:"b033,omitempty" json:",omitempty"`
-	NoContributor *NoContributor `xml:"n339,omitempty" json:",omitempty"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	ContributorStatement *string `xml:"b049,omitempty" json:",omitempty"`
-	ConferenceDescription *string `xml:"b050,omitempty" json:",omitempty"`
-	Conferences []Conference `xml:"conference,omitempty" json:",omitempty"`
-	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:",omitempty"`
-	ConferenceName *string `xml:"b052,omitempty" json:",omitempty"`
-	ConferenceNumber *string `xml:"b053,omitempty" json:",omitempty"`
-	ConferenceDate *string `xml:"b054,omitempty" json:",omitempty"`
-	ConferencePlace *string `xml:"b055,omitempty" json:",omitempty"`
-	NoEdition *NoEdition `xml:"n386,omitempty" json:",omitempty"`
-	EditionTypeCodes []EditionTypeCode `xml:"b056,omitempty" json:",omitempty"`
-	EditionNumber *string `xml:"b057,omitempty" json:",omitempty"`
-	EditionVersionNumber *string `xml:"b217,omitempty" json:",omitempty"`
-	EditionStatement *string `xml:"b058,omitempty" json:",omitempty"`
-	PrizesDescription *string `xml:"g124,omitempty" json:",omitempty"`
-	Prizes []Prize `xml:"prize,omitempty" json:",omitempty"`
-	Publishers []Publisher `xml:"publisher,omitempty" json:",omitempty"`
-	ImprintName *string `xml:"b079,omitempty" json:",omitempty"`
-	Imprints []Imprint `xml:"imprint,omitempty" json:",omitempty"`
-	PublisherName *string `xml:"b081,omitempty" json:",omitempty"`
-	CopyrightStatements []CopyrightStatement `xml:"copyrightstatement,omitempty" json:",omitempty"`
-	CopyrightYear *string `xml:"b087,omitempty" json:",omitempty"`
-	Barcodes []Barcode `xml:"b246,omitempty" json:",omitempty"`
-	ReplacesISBN *string `xml:"b010,omitempty" json:",omitempty"`
-	ReplacesEAN13 *string `xml:"b011,omitempty" json:",omitempty"`
-	ProductForm *ProductForm `xml:"b012,omitempty" json:",omitempty"`
-	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:",omitempty"`
-	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:",omitempty"`
-	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:",omitempty"`
-	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:",omitempty"`
-	ProductFormDescription *string `xml:"b014,omitempty" json:",omitempty"`
-	NumberOfPieces *string `xml:"b210,omitempty" json:",omitempty"`
-	TradeCategory *TradeCategory `xml:"b384,omitempty" json:",omitempty"`
-	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:",omitempty"`
-	ContainedItems []ContainedItem `xml:"containeditem,omitempty" json:",omitempty"`
-	ProductClassifications []ProductClassification `xml:"productclassification,omitempty" json:",omitempty"`
-	TextCaseFlag *TextCaseFlag `xml:"b027,omitempty" json:",omitempty"`
-	WorkIdentifiers []WorkIdentifier `xml:"workidentifier,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	ReligiousText *ReligiousText `xml:"religioustext,omitempty" json:",omitempty"`
-	LanguageOfTexts []LanguageOfText `xml:"b059,omitempty" json:",omitempty"`
-	OriginalLanguage *OriginalLanguage `xml:"b060,omitempty" json:",omitempty"`
-	Languages []Language `xml:"language,omitempty" json:",omitempty"`
-	NumberOfPages *string `xml:"b061,omitempty" json:",omitempty"`
-	PagesRoman *string `xml:"b254,omitempty" json:",omitempty"`
-	PagesArabic *string `xml:"b255,omitempty" json:",omitempty"`
-	Extents []Extent `xml:"extent,omitempty" json:",omitempty"`
-	NumberOfIllustrations *string `xml:"b125,omitempty" json:",omitempty"`
-	IllustrationsNote *string `xml:"b062,omitempty" json:",omitempty"`
-	Illustrationss []Illustrations `xml:"illustrations,omitempty" json:",omitempty"`
-	MapScales []string `xml:"b063,omitempty" json:",omitempty"`
-	MainSubjects []MainSubject `xml:"mainsubject,omitempty" json:",omitempty"`
-	Subjects []Subject `xml:"subject,omitempty" json:",omitempty"`
-	PersonAsSubjects []PersonAsSubject `xml:"personassubject,omitempty" json:",omitempty"`
-	CorporateBodyAsSubjects []string `xml:"b071,omitempty" json:",omitempty"`
-	PlaceAsSubjects []string `xml:"b072,omitempty" json:",omitempty"`
-	AudienceCodes []AudienceCode `xml:"b073,omitempty" json:",omitempty"`
-	Audiences []Audience `xml:"audience,omitempty" json:",omitempty"`
-	USSchoolGrade *string `xml:"b189,omitempty" json:",omitempty"`
-	InterestAge *string `xml:"b190,omitempty" json:",omitempty"`
-	AudienceRanges []AudienceRange `xml:"audiencerange,omitempty" json:",omitempty"`
-	AudienceDescription *string `xml:"b207,omitempty" json:",omitempty"`
-	Complexitys []Complexity `xml:"complexity,omitempty" json:",omitempty"`
-	Annotation *Annotation `xml:"d100,omitempty" json:",omitempty"`
-	MainDescription *MainDescription `xml:"d101,omitempty" json:",omitempty"`
-	OtherTexts []OtherText `xml:"othertext,omitempty" json:",omitempty"`
-	ReviewQuotes []ReviewQuote `xml:"e110,omitempty" json:",omitempty"`
-	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:",omitempty"`
-	ProductWebsites []ProductWebsite `xml:"productwebsite,omitempty" json:",omitempty"`
-	ContentItems []ContentItem `xml:"contentitem,omitempty" json:",omitempty"`
-	CityOfPublications []string `xml:"b209,omitempty" json:",omitempty"`
-	CountryOfPublication *CountryOfPublication `xml:"b083,omitempty" json:",omitempty"`
-	CopublisherNames []string `xml:"b084,omitempty" json:",omitempty"`
-	SponsorNames []string `xml:"b085,omitempty" json:",omitempty"`
-	OriginalPublisher *string `xml:"b240,omitempty" json:",omitempty"`
-	AnnouncementDate *string `xml:"b086,omitempty" json:",omitempty"`
-	TradeAnnouncementDate *string `xml:"b362,omitempty" json:",omitempty"`
-	PublicationDate *string `xml:"b003,omitempty" json:",omitempty"`
-	YearFirstPublished *string `xml:"b088,omitempty" json:",omitempty"`
-	SalesRightss []SalesRights `xml:"salesrights,omitempty" json:",omitempty"`
-	NotForSales []NotForSale `xml:"notforsale,omitempty" json:",omitempty"`
-	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:",omitempty"`
-	EpubType *EpubType `xml:"b211,omitempty" json:",omitempty"`
-	EpubTypeVersion *string `xml:"b212,omitempty" json:",omitempty"`
-	EpubTypeDescription *string `xml:"b213,omitempty" json:",omitempty"`
-	EpubFormatDescription *string `xml:"b216,omitempty" json:",omitempty"`
-	EpubSourceDescription *string `xml:"b280,omitempty" json:",omitempty"`
-	EpubTypeNote *string `xml:"b277,omitempty" json:",omitempty"`
-	EpubFormat *EpubFormat `xml:"b214,omitempty" json:",omitempty"`
-	EpubFormatVersion *string `xml:"b215,omitempty" json:",omitempty"`
-	EpubSource *EpubSource `xml:"b278,omitempty" json:",omitempty"`
-	EpubSourceVersion *string `xml:"b279,omitempty" json:",omitempty"`
-	ThesisType *ThesisType `xml:"b368,omitempty" json:",omitempty"`
-	ThesisPresentedTo *string `xml:"b369,omitempty" json:",omitempty"`
-	ThesisYear *string `xml:"b370,omitempty" json:",omitempty"`
-	BASICMainSubject *string `xml:"b064,omitempty" json:",omitempty"`
-	BASICVersion *string `xml:"b200,omitempty" json:",omitempty"`
-	BICMainSubject *string `xml:"b065,omitempty" json:",omitempty"`
-	BICVersion *string `xml:"b066,omitempty" json:",omitempty"`
-	CoverImageFormatCode *CoverImageFormatCode `xml:"f111,omitempty" json:",omitempty"`
-	CoverImageLinkTypeCode *CoverImageLinkTypeCode `xml:"f112,omitempty" json:",omitempty"`
-	CoverImageLink *string `xml:"f113,omitempty" json:",omitempty"`
-	PublishingStatus *PublishingStatus `xml:"b394,omitempty" json:",omitempty"`
-	PublishingStatusNote *string `xml:"b395,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Dimensions *PlainText `xml:"c258,omitempty" json:"dimensions,omitempty"`
+	Weight *PlainText `xml:"c099,omitempty" json:"weight,omitempty"`
+	Measures []Measure `xml:"measure,omitempty" json:"measures,omitempty"`
+	Height *PlainText `xml:"c096,omitempty" json:"height,omitempty"`
+	Width *PlainText `xml:"c097,omitempty" json:"width,omitempty"`
+	Thickness *PlainText `xml:"c098,omitempty" json:"thickness,omitempty"`
+	RecordReference PlainText `xml:"a001" json:"recordReference"`
+	NotificationType NotificationType `xml:"a002" json:"notificationType"`
+	DeletionCode *DeletionCode `xml:"a198,omitempty" json:"deletionCode,omitempty"`
+	DeletionText *PlainText `xml:"a199,omitempty" json:"deletionText,omitempty"`
+	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:"recordSourceType,omitempty"`
+	RecordSourceName *PlainText `xml:"a197,omitempty" json:"recordSourceName,omitempty"`
+	ReplacedByISBN *PlainText `xml:"h130,omitempty" json:"replacedByISBN,omitempty"`
+	ReplacedByEAN13 *PlainText `xml:"h131,omitempty" json:"replacedByEAN13,omitempty"`
+	AlternativeFormatISBN *PlainText `xml:"h132,omitempty" json:"alternativeFormatISBN,omitempty"`
+	AlternativeFormatEAN13 *PlainText `xml:"h133,omitempty" json:"alternativeFormatEAN13,omitempty"`
+	AlternativeProductISBN *PlainText `xml:"h163,omitempty" json:"alternativeProductISBN,omitempty"`
+	AlternativeProductEAN13 *PlainText `xml:"h164,omitempty" json:"alternativeProductEAN13,omitempty"`
+	RelatedProducts []RelatedProduct `xml:"relatedproduct,omitempty" json:"relatedProducts,omitempty"`
+	OutOfPrintDate *PlainText `xml:"h134,omitempty" json:"outOfPrintDate,omitempty"`
+	SupplyDetails []SupplyDetail `xml:"supplydetail,omitempty" json:"supplyDetails,omitempty"`
+	MarketRepresentations []MarketRepresentation `xml:"marketrepresentation,omitempty" json:"marketRepresentations,omitempty"`
+	PromotionCampaign *PlainText `xml:"k165,omitempty" json:"promotionCampaign,omitempty"`
+	PromotionContact *PlainText `xml:"k166,omitempty" json:"promotionContact,omitempty"`
+	InitialPrintRun *PlainText `xml:"k167,omitempty" json:"initialPrintRun,omitempty"`
+	ReprintDetails []PlainText `xml:"k309,omitempty" json:"reprintDetails,omitempty"`
+	CopiesSold *PlainText `xml:"k168,omitempty" json:"copiesSold,omitempty"`
+	BookClubAdoption *PlainText `xml:"k169,omitempty" json:"bookClubAdoption,omitempty"`
+	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:"recordSourceIdentifierType,omitempty"`
+	RecordSourceIdentifier *PlainText `xml:"a196,omitempty" json:"recordSourceIdentifier,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	ISBN *PlainText `xml:"b004,omitempty" json:"isbn,omitempty"`
+	EAN13 *PlainText `xml:"b005,omitempty" json:"ean13,omitempty"`
+	UPC *PlainText `xml:"b006,omitempty" json:"upc,omitempty"`
+	PublisherProductNo *PlainText `xml:"b007,omitempty" json:"publisherProductNo,omitempty"`
+	ISMN *PlainText `xml:"b008,omitempty" json:"ismn,omitempty"`
+	DOI *PlainText `xml:"b009,omitempty" json:"doi,omitempty"`
+	Seriess []Series `xml:"series,omitempty" json:"seriess,omitempty"`
+	NoSeries Flag `xml:"n338,omitempty" json:"noSeries,omitempty"`
+	Sets []Set `xml:"set,omitempty" json:"sets,omitempty"`
+	Titles []Title `xml:"title,omitempty" json:"titles,omitempty"`
+	DistinctiveTitle *PlainText `xml:"b028,omitempty" json:"distinctiveTitle,omitempty"`
+	TitlePrefix *PlainText `xml:"b030,omitempty" json:"titlePrefix,omitempty"`
+	TitleWithoutPrefix *PlainText `xml:"b031,omitempty" json:"titleWithoutPrefix,omitempty"`
+	Subtitle *PlainText `xml:"b029,omitempty" json:"subtitle,omitempty"`
+	TranslationOfTitle *PlainText `xml:"b032,omitempty" json:"translationOfTitle,omitempty"`
+	FormerTitles []PlainText `xml:"b033,omitempty" json:"formerTitles,omitempty"`
+	NoContributor Flag `xml:"n339,omitempty" json:"noContributor,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorStatement *PlainText `xml:"b049,omitempty" json:"contributorStatement,omitempty"`
+	ConferenceDescription *PlainText `xml:"b050,omitempty" json:"conferenceDescription,omitempty"`
+	Conferences []Conference `xml:"conference,omitempty" json:"conferences,omitempty"`
+	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:"conferenceRole,omitempty"`
+	ConferenceName *PlainText `xml:"b052,omitempty" json:"conferenceName,omitempty"`
+	ConferenceNumber *PlainText `xml:"b053,omitempty" json:"conferenceNumber,omitempty"`
+	ConferenceDate *PlainText `xml:"b054,omitempty" json:"conferenceDate,omitempty"`
+	ConferencePlace *PlainText `xml:"b055,omitempty" json:"conferencePlace,omitempty"`
+	NoEdition Flag `xml:"n386,omitempty" json:"noEdition,omitempty"`
+	EditionTypeCodes []EditionTypeCode `xml:"b056,omitempty" json:"editionTypeCodes,omitempty"`
+	EditionNumber *PlainText `xml:"b057,omitempty" json:"editionNumber,omitempty"`
+	EditionVersionNumber *PlainText `xml:"b217,omitempty" json:"editionVersionNumber,omitempty"`
+	EditionStatement *PlainText `xml:"b058,omitempty" json:"editionStatement,omitempty"`
+	PrizesDescription *PlainText `xml:"g124,omitempty" json:"prizesDescription,omitempty"`
+	Prizes []Prize `xml:"prize,omitempty" json:"prizes,omitempty"`
+	Publishers []Publisher `xml:"publisher,omitempty" json:"publishers,omitempty"`
+	ImprintName *PlainText `xml:"b079,omitempty" json:"imprintName,omitempty"`
+	Imprints []Imprint `xml:"imprint,omitempty" json:"imprints,omitempty"`
+	PublisherName *PlainText `xml:"b081,omitempty" json:"publisherName,omitempty"`
+	CopyrightStatements []CopyrightStatement `xml:"copyrightstatement,omitempty" json:"copyrightStatements,omitempty"`
+	CopyrightYear *PlainText `xml:"b087,omitempty" json:"copyrightYear,omitempty"`
+	Barcodes []Barcode `xml:"b246,omitempty" json:"barcodes,omitempty"`
+	ReplacesISBN *PlainText `xml:"b010,omitempty" json:"replacesISBN,omitempty"`
+	ReplacesEAN13 *PlainText `xml:"b011,omitempty" json:"replacesEAN13,omitempty"`
+	ProductForm *ProductForm `xml:"b012,omitempty" json:"productForm,omitempty"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:"productFormFeatures,omitempty"`
+	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:"bookFormDetails,omitempty"`
+	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:"productPackaging,omitempty"`
+	ProductFormDescription *PlainText `xml:"b014,omitempty" json:"productFormDescription,omitempty"`
+	NumberOfPieces *PlainText `xml:"b210,omitempty" json:"numberOfPieces,omitempty"`
+	TradeCategory *TradeCategory `xml:"b384,omitempty" json:"tradeCategory,omitempty"`
+	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:"productContentTypes,omitempty"`
+	ContainedItems []ContainedItem `xml:"containeditem,omitempty" json:"containedItems,omitempty"`
+	ProductClassifications []ProductClassification `xml:"productclassification,omitempty" json:"productClassifications,omitempty"`
+	TextCaseFlag *TextCaseFlag `xml:"b027,omitempty" json:"textCaseFlag,omitempty"`
+	WorkIdentifiers []WorkIdentifier `xml:"workidentifier,omitempty" json:"workIdentifiers,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	ReligiousText *ReligiousText `xml:"religioustext,omitempty" json:"religiousText,omitempty"`
+	LanguageOfTexts []LanguageOfText `xml:"b059,omitempty" json:"languageOfTexts,omitempty"`
+	OriginalLanguage *OriginalLanguage `xml:"b060,omitempty" json:"originalLanguage,omitempty"`
+	Languages []Language `xml:"language,omitempty" json:"languages,omitempty"`
+	NumberOfPages *PlainText `xml:"b061,omitempty" json:"numberOfPages,omitempty"`
+	PagesRoman *PlainText `xml:"b254,omitempty" json:"pagesRoman,omitempty"`
+	PagesArabic *PlainText `xml:"b255,omitempty" json:"pagesArabic,omitempty"`
+	Extents []Extent `xml:"extent,omitempty" json:"extents,omitempty"`
+	NumberOfIllustrations *PlainText `xml:"b125,omitempty" json:"numberOfIllustrations,omitempty"`
+	IllustrationsNote *PlainText `xml:"b062,omitempty" json:"illustrationsNote,omitempty"`
+	Illustrationss []Illustrations `xml:"illustrations,omitempty" json:"illustrationss,omitempty"`
+	MapScales []PlainText `xml:"b063,omitempty" json:"mapScales,omitempty"`
+	MainSubjects []MainSubject `xml:"mainsubject,omitempty" json:"mainSubjects,omitempty"`
+	Subjects []Subject `xml:"subject,omitempty" json:"subjects,omitempty"`
+	PersonAsSubjects []PersonAsSubject `xml:"personassubject,omitempty" json:"personAsSubjects,omitempty"`
+	CorporateBodyAsSubjects []PlainText `xml:"b071,omitempty" json:"corporateBodyAsSubjects,omitempty"`
+	PlaceAsSubjects []PlainText `xml:"b072,omitempty" json:"placeAsSubjects,omitempty"`
+	AudienceCodes []AudienceCode `xml:"b073,omitempty" json:"audienceCodes,omitempty"`
+	Audiences []Audience `xml:"audience,omitempty" json:"audiences,omitempty"`
+	USSchoolGrade *PlainText `xml:"b189,omitempty" json:"usSchoolGrade,omitempty"`
+	InterestAge *PlainText `xml:"b190,omitempty" json:"interestAge,omitempty"`
+	AudienceRanges []AudienceRange `xml:"audiencerange,omitempty" json:"audienceRanges,omitempty"`
+	AudienceDescription *PlainText `xml:"b207,omitempty" json:"audienceDescription,omitempty"`
+	Complexitys []Complexity `xml:"complexity,omitempty" json:"complexitys,omitempty"`
+	Annotation *Annotation `xml:"d100,omitempty" json:"annotation,omitempty"`
+	MainDescription *MainDescription `xml:"d101,omitempty" json:"mainDescription,omitempty"`
+	OtherTexts []OtherText `xml:"othertext,omitempty" json:"otherTexts,omitempty"`
+	ReviewQuotes []ReviewQuote `xml:"e110,omitempty" json:"reviewQuotes,omitempty"`
+	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:"mediaFiles,omitempty"`
+	ProductWebsites []ProductWebsite `xml:"productwebsite,omitempty" json:"productWebsites,omitempty"`
+	ContentItems []ContentItem `xml:"contentitem,omitempty" json:"contentItems,omitempty"`
+	CityOfPublications []PlainText `xml:"b209,omitempty" json:"cityOfPublications,omitempty"`
+	CountryOfPublication *CountryOfPublication `xml:"b083,omitempty" json:"countryOfPublication,omitempty"`
+	CopublisherNames []PlainText `xml:"b084,omitempty" json:"copublisherNames,omitempty"`
+	SponsorNames []PlainText `xml:"b085,omitempty" json:"sponsorNames,omitempty"`
+	OriginalPublisher *PlainText `xml:"b240,omitempty" json:"originalPublisher,omitempty"`
+	AnnouncementDate *PlainText `xml:"b086,omitempty" json:"announcementDate,omitempty"`
+	TradeAnnouncementDate *PlainText `xml:"b362,omitempty" json:"tradeAnnouncementDate,omitempty"`
+	PublicationDate *PlainText `xml:"b003,omitempty" json:"publicationDate,omitempty"`
+	YearFirstPublished *PlainText `xml:"b088,omitempty" json:"yearFirstPublished,omitempty"`
+	SalesRightss []SalesRights `xml:"salesrights,omitempty" json:"salesRightss,omitempty"`
+	NotForSales []NotForSale `xml:"notforsale,omitempty" json:"notForSales,omitempty"`
+	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:"salesRestrictions,omitempty"`
+	EpubType *EpubType `xml:"b211,omitempty" json:"epubType,omitempty"`
+	EpubTypeVersion *PlainText `xml:"b212,omitempty" json:"epubTypeVersion,omitempty"`
+	EpubTypeDescription *PlainText `xml:"b213,omitempty" json:"epubTypeDescription,omitempty"`
+	EpubFormatDescription *PlainText `xml:"b216,omitempty" json:"epubFormatDescription,omitempty"`
+	EpubSourceDescription *PlainText `xml:"b280,omitempty" json:"epubSourceDescription,omitempty"`
+	EpubTypeNote *PlainText `xml:"b277,omitempty" json:"epubTypeNote,omitempty"`
+	EpubFormat *EpubFormat `xml:"b214,omitempty" json:"epubFormat,omitempty"`
+	EpubFormatVersion *PlainText `xml:"b215,omitempty" json:"epubFormatVersion,omitempty"`
+	EpubSource *EpubSource `xml:"b278,omitempty" json:"epubSource,omitempty"`
+	EpubSourceVersion *PlainText `xml:"b279,omitempty" json:"epubSourceVersion,omitempty"`
+	ThesisType *ThesisType `xml:"b368,omitempty" json:"thesisType,omitempty"`
+	ThesisPresentedTo *PlainText `xml:"b369,omitempty" json:"thesisPresentedTo,omitempty"`
+	ThesisYear *PlainText `xml:"b370,omitempty" json:"thesisYear,omitempty"`
+	BASICMainSubject *PlainText `xml:"b064,omitempty" json:"basicMainSubject,omitempty"`
+	BASICVersion *PlainText `xml:"b200,omitempty" json:"basicVersion,omitempty"`
+	BICMainSubject *PlainText `xml:"b065,omitempty" json:"bicMainSubject,omitempty"`
+	BICVersion *PlainText `xml:"b066,omitempty" json:"bicVersion,omitempty"`
+	CoverImageFormatCode *CoverImageFormatCode `xml:"f111,omitempty" json:"coverImageFormatCode,omitempty"`
+	CoverImageLinkTypeCode *CoverImageLinkTypeCode `xml:"f112,omitempty" json:"coverImageLinkTypeCode,omitempty"`
+	CoverImageLink *PlainText `xml:"f113,omitempty" json:"coverImageLink,omitempty"`
+	PublishingStatus *PublishingStatus `xml:"b394,omitempty" json:"publishingStatus,omitempty"`
+	PublishingStatusNote *PlainText `xml:"b395,omitempty" json:"publishingStatusNote,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductClassification is not documented.
 type ProductClassification struct {
-	ProductClassificationType ProductClassificationType `xml:"b274"`
-	ProductClassificationCode string `xml:"b275"`
-	Percent *string `xml:"b337,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductClassificationType ProductClassificationType `xml:"b274" json:"productClassificationType"`
+	ProductClassificationCode PlainText `xml:"b275" json:"productClassificationCode"`
+	Percent *PlainText `xml:"b337,omitempty" json:"percent,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductFormFeature is not documented.
 type ProductFormFeature struct {
-	ProductFormFeatureType ProductFormFeatureType `xml:"b334"`
-	ProductFormFeatureValue *string `xml:"b335,omitempty" json:",omitempty"`
-	ProductFormFeatureDescription *string `xml:"b336,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductFormFeatureType ProductFormFeatureType `xml:"b334" json:"productFormFeatureType"`
+	ProductFormFeatureValue *PlainText `xml:"b335,omitempty" json:"productFormFeatureValue,omitempty"`
+	ProductFormFeatureDescription *PlainText `xml:"b336,omitempty" json:"productFormFeatureDescription,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductIdentifier is not documented.
 type ProductIdentifier struct {
-	ProductIDType ProductIDType `xml:"b221"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductIDType ProductIDType `xml:"b221" json:"productIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductWebsite is not documented.
 type ProductWebsite struct {
-	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:",omitempty"`
-	ProductWebsiteDescription *ProductWebsiteDescription `xml:"f170,omitempty" json:",omitempty"`
-	ProductWebsiteLink string `xml:"f123"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:"websiteRole,omitempty"`
+	ProductWebsiteDescription *ProductWebsiteDescription `xml:"f170,omitempty" json:"productWebsiteDescription,omitempty"`
+	ProductWebsiteLink PlainText `xml:"f123" json:"productWebsiteLink"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProfessionalAffiliation is not documented.
 type ProfessionalAffiliation struct {
-	Affiliation *string `xml:"b046,omitempty" json:",omitempty"`
-	ProfessionalPosition *string `xml:"b045,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Affiliation *PlainText `xml:"b046,omitempty" json:"affiliation,omitempty"`
+	ProfessionalPosition *PlainText `xml:"b045,omitempty" json:"professionalPosition,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Publisher is not documented.
 type Publisher struct {
-	PublisherName *string `xml:"b081,omitempty" json:",omitempty"`
-	NameCodeType *NameCodeType `xml:"b241,omitempty" json:",omitempty"`
-	NameCodeTypeName *string `xml:"b242,omitempty" json:",omitempty"`
-	NameCodeValue *string `xml:"b243,omitempty" json:",omitempty"`
-	PublishingRole *PublishingRole `xml:"b291,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PublisherName *PlainText `xml:"b081,omitempty" json:"publisherName,omitempty"`
+	NameCodeType *NameCodeType `xml:"b241,omitempty" json:"nameCodeType,omitempty"`
+	NameCodeTypeName *PlainText `xml:"b242,omitempty" json:"nameCodeTypeName,omitempty"`
+	NameCodeValue *PlainText `xml:"b243,omitempty" json:"nameCodeValue,omitempty"`
+	PublishingRole *PublishingRole `xml:"b291,omitempty" json:"publishingRole,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Reissue is not documented.
 type Reissue struct {
-	ReissueDate string `xml:"j365"`
-	ReissueDescription *string `xml:"j366,omitempty" json:",omitempty"`
-	Prices []Price `xml:"price,omitempty" json:",omitempty"`
-	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ReissueDate PlainText `xml:"j365" json:"reissueDate"`
+	ReissueDescription *PlainText `xml:"j366,omitempty" json:"reissueDescription,omitempty"`
+	Prices []Price `xml:"price,omitempty" json:"prices,omitempty"`
+	MediaFiles []MediaFile `xml:"mediafile,omitempty" json:"mediaFiles,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // RelatedProduct is not documented.
 type RelatedProduct struct {
-	ISBN *string `xml:"b004,omitempty" json:",omitempty"`
-	EAN13 *string `xml:"b005,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	ProductForm *ProductForm `xml:"b012,omitempty" json:",omitempty"`
-	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:",omitempty"`
-	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:",omitempty"`
-	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:",omitempty"`
-	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:",omitempty"`
-	ProductFormDescription *string `xml:"b014,omitempty" json:",omitempty"`
-	RelationCode RelationCode `xml:"h208"`
-	NumberOfPieces *string `xml:"b210,omitempty" json:",omitempty"`
-	TradeCategory *TradeCategory `xml:"b384,omitempty" json:",omitempty"`
-	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:",omitempty"`
-	Publishers []Publisher `xml:"publisher,omitempty" json:",omitempty"`
-	EpubType *EpubType `xml:"b211,omitempty" json:",omitempty"`
-	EpubTypeVersion *string `xml:"b212,omitempty" json:",omitempty"`
-	EpubTypeDescription *string `xml:"b213,omitempty" json:",omitempty"`
-	EpubFormatDescription *string `xml:"b216,omitempty" json:",omitempty"`
-	EpubTypeNote *string `xml:"b277,omitempty" json:",omitempty"`
-	EpubFormat *EpubFormat `xml:"b214,omitempty" json:",omitempty"`
-	EpubFormatVersion *string `xml:"b215,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ISBN *PlainText `xml:"b004,omitempty" json:"isbn,omitempty"`
+	EAN13 *PlainText `xml:"b005,omitempty" json:"ean13,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	ProductForm *ProductForm `xml:"b012,omitempty" json:"productForm,omitempty"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:"productFormFeatures,omitempty"`
+	BookFormDetails []BookFormDetail `xml:"b013,omitempty" json:"bookFormDetails,omitempty"`
+	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:"productPackaging,omitempty"`
+	ProductFormDescription *PlainText `xml:"b014,omitempty" json:"productFormDescription,omitempty"`
+	RelationCode RelationCode `xml:"h208" json:"relationCode"`
+	NumberOfPieces *PlainText `xml:"b210,omitempty" json:"numberOfPieces,omitempty"`
+	TradeCategory *TradeCategory `xml:"b384,omitempty" json:"tradeCategory,omitempty"`
+	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:"productContentTypes,omitempty"`
+	Publishers []Publisher `xml:"publisher,omitempty" json:"publishers,omitempty"`
+	EpubType *EpubType `xml:"b211,omitempty" json:"epubType,omitempty"`
+	EpubTypeVersion *PlainText `xml:"b212,omitempty" json:"epubTypeVersion,omitempty"`
+	EpubTypeDescription *PlainText `xml:"b213,omitempty" json:"epubTypeDescription,omitempty"`
+	EpubFormatDescription *PlainText `xml:"b216,omitempty" json:"epubFormatDescription,omitempty"`
+	EpubTypeNote *PlainText `xml:"b277,omitempty" json:"epubTypeNote,omitempty"`
+	EpubFormat *EpubFormat `xml:"b214,omitempty" json:"epubFormat,omitempty"`
+	EpubFormatVersion *PlainText `xml:"b215,omitempty" json:"epubFormatVersion,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReligiousText is not documented.
 type ReligiousText struct {
-	Bible *Bible `xml:"bible,omitempty" json:",omitempty"`
-	ReligiousTextID *ReligiousTextID `xml:"b376,omitempty" json:",omitempty"`
-	ReligiousTextFeatures []ReligiousTextFeature `xml:"religioustextfeature,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Bible *Bible `xml:"bible,omitempty" json:"bible,omitempty"`
+	ReligiousTextID *ReligiousTextID `xml:"b376,omitempty" json:"religiousTextID,omitempty"`
+	ReligiousTextFeatures []ReligiousTextFeature `xml:"religioustextfeature,omitempty" json:"religiousTextFeatures,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReligiousTextFeature is not documented.
 type ReligiousTextFeature struct {
-	ReligiousTextFeatureType ReligiousTextFeatureType `xml:"b358"`
-	ReligiousTextFeatureCode ReligiousTextFeatureCode `xml:"b359"`
-	ReligiousTextFeatureDescription *string `xml:"b360,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ReligiousTextFeatureType ReligiousTextFeatureType `xml:"b358" json:"religiousTextFeatureType"`
+	ReligiousTextFeatureCode ReligiousTextFeatureCode `xml:"b359" json:"religiousTextFeatureCode"`
+	ReligiousTextFeatureDescription *PlainText `xml:"b360,omitempty" json:"religiousTextFeatureDescription,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesOutlet is not documented.
 type SalesOutlet struct {
-	SalesOutletName *string `xml:"b382,omitempty" json:",omitempty"`
-	SalesOutletIdentifier *SalesOutletIdentifier `xml:"salesoutletidentifier,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesOutletName *PlainText `xml:"b382,omitempty" json:"salesOutletName,omitempty"`
+	SalesOutletIdentifier *SalesOutletIdentifier `xml:"salesoutletidentifier,omitempty" json:"salesOutletIdentifier,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesOutletIdentifier is not documented.
 type SalesOutletIdentifier struct {
-	SalesOutletIDType SalesOutletIDType `xml:"b393"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesOutletIDType SalesOutletIDType `xml:"b393" json:"salesOutletIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesRestriction is not documented.
 type SalesRestriction struct {
-	SalesRestrictionType SalesRestrictionType `xml:"b381"`
-	SalesOutlets []SalesOutlet `xml:"salesoutlet,omitempty" json:",omitempty"`
-	SalesRestrictionDetail *string `xml:"b383,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesRestrictionType SalesRestrictionType `xml:"b381" json:"salesRestrictionType"`
+	SalesOutlets []SalesOutlet `xml:"salesoutlet,omitempty" json:"salesOutlets,omitempty"`
+	SalesRestrictionDetail *PlainText `xml:"b383,omitempty" json:"salesRestrictionDetail,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesRights is not documented.
 type SalesRights struct {
-	RightsTerritory *TerritoryCodeList `xml:"b388,omitempty" json:",omitempty"`
-	RightsRegions []RightsRegion `xml:"b091,omitempty" json:",omitempty"`
-	RightsCountrys []CountryCodeList `xml:"b090,omitempty" json:",omitempty"`
-	SalesRightsType SalesRightsType `xml:"b089"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RightsTerritory *TerritoryCodeList `xml:"b388,omitempty" json:"rightsTerritory,omitempty"`
+	RightsRegions []RightsRegion `xml:"b091,omitempty" json:"rightsRegions,omitempty"`
+	RightsCountrys []CountryCodeList `xml:"b090,omitempty" json:"rightsCountrys,omitempty"`
+	SalesRightsType SalesRightsType `xml:"b089" json:"salesRightsType"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SenderIdentifier is not documented.
 type SenderIdentifier struct {
-	SenderIDType SenderIDType `xml:"m379"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SenderIDType SenderIDType `xml:"m379" json:"senderIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Series is not documented.
 type Series struct {
-	Titles []Title `xml:"title,omitempty" json:",omitempty"`
-	TitleOfSeries *string `xml:"b018,omitempty" json:",omitempty"`
-	SeriesISSN *string `xml:"b016,omitempty" json:",omitempty"`
-	PublisherSeriesCode *string `xml:"b017,omitempty" json:",omitempty"`
-	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier,omitempty" json:",omitempty"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	NumberWithinSeries *string `xml:"b019,omitempty" json:",omitempty"`
-	YearOfAnnual *string `xml:"b020,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Titles []Title `xml:"title,omitempty" json:"titles,omitempty"`
+	TitleOfSeries *PlainText `xml:"b018,omitempty" json:"titleOfSeries,omitempty"`
+	SeriesISSN *PlainText `xml:"b016,omitempty" json:"seriesISSN,omitempty"`
+	PublisherSeriesCode *PlainText `xml:"b017,omitempty" json:"publisherSeriesCode,omitempty"`
+	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier,omitempty" json:"seriesIdentifiers,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	NumberWithinSeries *PlainText `xml:"b019,omitempty" json:"numberWithinSeries,omitempty"`
+	YearOfAnnual *PlainText `xml:"b020,omitempty" json:"yearOfAnnual,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SeriesIdentifier is not documented.
 type SeriesIdentifier struct {
-	SeriesIDType SeriesIDType `xml:"b273"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SeriesIDType SeriesIDType `xml:"b273" json:"seriesIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Set is not documented.
 type Set struct {
-	Titles []Title `xml:"title,omitempty" json:",omitempty"`
-	TitleOfSet *string `xml:"b023,omitempty" json:",omitempty"`
-	ISBNOfSet *string `xml:"b021,omitempty" json:",omitempty"`
-	EAN13OfSet *string `xml:"b022,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	SetPartNumber *string `xml:"b024,omitempty" json:",omitempty"`
-	SetPartTitle *string `xml:"b025,omitempty" json:",omitempty"`
-	ItemNumberWithinSet *string `xml:"b026,omitempty" json:",omitempty"`
-	LevelSequenceNumber *string `xml:"b284,omitempty" json:",omitempty"`
-	SetItemTitle *string `xml:"b281,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Titles []Title `xml:"title,omitempty" json:"titles,omitempty"`
+	TitleOfSet *PlainText `xml:"b023,omitempty" json:"titleOfSet,omitempty"`
+	ISBNOfSet *PlainText `xml:"b021,omitempty" json:"isbnOfSet,omitempty"`
+	EAN13OfSet *PlainText `xml:"b022,omitempty" json:"ean13OfSet,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	SetPartNumber *PlainText `xml:"b024,omitempty" json:"setPartNumber,omitempty"`
+	SetPartTitle *PlainText `xml:"b025,omitempty" json:"setPartTitle,omitempty"`
+	ItemNumberWithinSet *PlainText `xml:"b026,omitempty" json:"itemNumberWithinSet,omitempty"`
+	LevelSequenceNumber *PlainText `xml:"b284,omitempty" json:"levelSequenceNumber,omitempty"`
+	SetItemTitle *PlainText `xml:"b281,omitempty" json:"setItemTitle,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Stock is not documented.
 type Stock struct {
-	OnHand *string `xml:"j350,omitempty" json:",omitempty"`
-	StockQuantityCoded *StockQuantityCoded `xml:"stockquantitycoded,omitempty" json:",omitempty"`
-	LocationIdentifier *LocationIdentifier `xml:"locationidentifier,omitempty" json:",omitempty"`
-	LocationName *string `xml:"j349,omitempty" json:",omitempty"`
-	OnOrder *string `xml:"j351,omitempty" json:",omitempty"`
-	CBO *string `xml:"j375,omitempty" json:",omitempty"`
-	OnOrderDetails []OnOrderDetail `xml:"onorderdetail,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	OnHand *PlainText `xml:"j350,omitempty" json:"onHand,omitempty"`
+	StockQuantityCoded *StockQuantityCoded `xml:"stockquantitycoded,omitempty" json:"stockQuantityCoded,omitempty"`
+	LocationIdentifier *LocationIdentifier `xml:"locationidentifier,omitempty" json:"locationIdentifier,omitempty"`
+	LocationName *PlainText `xml:"j349,omitempty" json:"locationName,omitempty"`
+	OnOrder *PlainText `xml:"j351,omitempty" json:"onOrder,omitempty"`
+	CBO *PlainText `xml:"j375,omitempty" json:"cbo,omitempty"`
+	OnOrderDetails []OnOrderDetail `xml:"onorderdetail,omitempty" json:"onOrderDetails,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // StockQuantityCoded is not documented.
 type StockQuantityCoded struct {
-	StockQuantityCodeType StockQuantityCodeType `xml:"j293"`
-	StockQuantityCodeTypeName *string `xml:"j296,omitempty" json:",omitempty"`
-	StockQuantityCode string `xml:"j297"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	StockQuantityCodeType StockQuantityCodeType `xml:"j293" json:"stockQuantityCodeType"`
+	StockQuantityCodeTypeName *PlainText `xml:"j296,omitempty" json:"stockQuantityCodeTypeName,omitempty"`
+	StockQuantityCode PlainText `xml:"j297" json:"stockQuantityCode"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SubSeriesRecord is not documented.
 type SubSeriesRecord struct {
-	RecordReference string `xml:"a001"`
-	NotificationType NotificationType `xml:"a002"`
-	DeletionCode *DeletionCode `xml:"a198,omitempty" json:",omitempty"`
-	DeletionText *string `xml:"a199,omitempty" json:",omitempty"`
-	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:",omitempty"`
-	RecordSourceName *string `xml:"a197,omitempty" json:",omitempty"`
-	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier"`
-	ParentIdentifier ParentIdentifier `xml:"parentidentifier"`
-	LevelSequenceNumber string `xml:"b284"`
-	Titles []Title `xml:"title"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	OtherTexts []OtherText `xml:"othertext,omitempty" json:",omitempty"`
-	Publishers []Publisher `xml:"publisher,omitempty" json:",omitempty"`
-	SubordinateEntries *string `xml:"a245,omitempty" json:",omitempty"`
-	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:",omitempty"`
-	RecordSourceIdentifier *string `xml:"a196,omitempty" json:",omitempty"`
-	SeriesPartName *string `xml:"b282,omitempty" json:",omitempty"`
-	NumberWithinSeries *string `xml:"b019,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RecordReference PlainText `xml:"a001" json:"recordReference"`
+	NotificationType NotificationType `xml:"a002" json:"notificationType"`
+	DeletionCode *DeletionCode `xml:"a198,omitempty" json:"deletionCode,omitempty"`
+	DeletionText *PlainText `xml:"a199,omitempty" json:"deletionText,omitempty"`
+	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:"recordSourceType,omitempty"`
+	RecordSourceName *PlainText `xml:"a197,omitempty" json:"recordSourceName,omitempty"`
+	SeriesIdentifiers []SeriesIdentifier `xml:"seriesidentifier" json:"seriesIdentifiers"`
+	ParentIdentifier ParentIdentifier `xml:"parentidentifier" json:"parentIdentifier"`
+	LevelSequenceNumber PlainText `xml:"b284" json:"levelSequenceNumber"`
+	Titles []Title `xml:"title" json:"titles"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	OtherTexts []OtherText `xml:"othertext,omitempty" json:"otherTexts,omitempty"`
+	Publishers []Publisher `xml:"publisher,omitempty" json:"publishers,omitempty"`
+	SubordinateEntries *PlainText `xml:"a245,omitempty" json:"subordinateEntries,omitempty"`
+	RecordSourceIdentifierType *RecordSourceIdentifierType `xml:"a195,omitempty" json:"recordSourceIdentifierType,omitempty"`
+	RecordSourceIdentifier *PlainText `xml:"a196,omitempty" json:"recordSourceIdentifier,omitempty"`
+	SeriesPartName *PlainText `xml:"b282,omitempty" json:"seriesPartName,omitempty"`
+	NumberWithinSeries *PlainText `xml:"b019,omitempty" json:"numberWithinSeries,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Subject is not documented.
 type Subject struct {
-	SubjectHeadingText *string `xml:"b070,omitempty" json:",omitempty"`
-	SubjectCode *string `xml:"b069,omitempty" json:",omitempty"`
-	SubjectSchemeIdentifier SubjectSchemeIdentifier `xml:"b067"`
-	SubjectSchemeName *string `xml:"b171,omitempty" json:",omitempty"`
-	SubjectSchemeVersion *string `xml:"b068,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SubjectHeadingText *PlainText `xml:"b070,omitempty" json:"subjectHeadingText,omitempty"`
+	SubjectCode *PlainText `xml:"b069,omitempty" json:"subjectCode,omitempty"`
+	SubjectSchemeIdentifier SubjectSchemeIdentifier `xml:"b067" json:"subjectSchemeIdentifier"`
+	SubjectSchemeName *PlainText `xml:"b171,omitempty" json:"subjectSchemeName,omitempty"`
+	SubjectSchemeVersion *PlainText `xml:"b068,omitempty" json:"subjectSchemeVersion,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplierIdentifier is not documented.
 type SupplierIdentifier struct {
-	SupplierIDType SupplierIDType `xml:"j345"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierIDType SupplierIDType `xml:"j345" json:"supplierIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplyDetail is not documented.
 type SupplyDetail struct {
-	SupplierName *string `xml:"j137,omitempty" json:",omitempty"`
-	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:",omitempty"`
-	SupplierSAN *string `xml:"j136,omitempty" json:",omitempty"`
-	SupplierEANLocationNumber *string `xml:"j135,omitempty" json:",omitempty"`
-	IntermediaryAvailabilityCode *IntermediaryAvailabilityCode `xml:"j348,omitempty" json:",omitempty"`
-	AvailabilityCode *AvailabilityCode `xml:"j141,omitempty" json:",omitempty"`
-	ProductAvailability *ProductAvailability `xml:"j396,omitempty" json:",omitempty"`
-	PriceAmount *string `xml:"j151,omitempty" json:",omitempty"`
-	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:",omitempty"`
-	Prices []Price `xml:"price,omitempty" json:",omitempty"`
-	TelephoneNumbers []string `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []string `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []string `xml:"j272,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	SupplierRole *SupplierRole `xml:"j292,omitempty" json:",omitempty"`
-	SupplyRestrictionDetail *string `xml:"j399,omitempty" json:",omitempty"`
-	LastDateForReturns *string `xml:"j387,omitempty" json:",omitempty"`
-	NewSupplier *NewSupplier `xml:"newsupplier,omitempty" json:",omitempty"`
-	OnSaleDate *string `xml:"j143,omitempty" json:",omitempty"`
-	OrderTime *string `xml:"j144,omitempty" json:",omitempty"`
-	Stocks []Stock `xml:"stock,omitempty" json:",omitempty"`
-	PackQuantity *string `xml:"j145,omitempty" json:",omitempty"`
-	Reissue *Reissue `xml:"reissue,omitempty" json:",omitempty"`
-	SupplyToTerritory *TerritoryCodeList `xml:"j397,omitempty" json:",omitempty"`
-	SupplyToRegions []SupplyToRegion `xml:"j139,omitempty" json:",omitempty"`
-	SupplyToCountrys []CountryCodeList `xml:"j138,omitempty" json:",omitempty"`
-	SupplyToCountryExcludeds []CountryCodeList `xml:"j140,omitempty" json:",omitempty"`
-	ReturnsCodeType *ReturnsCodeType `xml:"j268,omitempty" json:",omitempty"`
-	ReturnsCode *string `xml:"j269,omitempty" json:",omitempty"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	ExpectedShipDate *string `xml:"j142,omitempty" json:",omitempty"`
-	AudienceRestrictionFlag *AudienceRestrictionFlag `xml:"j146,omitempty" json:",omitempty"`
-	AudienceRestrictionNote *string `xml:"j147,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierName *PlainText `xml:"j137,omitempty" json:"supplierName,omitempty"`
+	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:"supplierIdentifiers,omitempty"`
+	SupplierSAN *PlainText `xml:"j136,omitempty" json:"supplierSAN,omitempty"`
+	SupplierEANLocationNumber *PlainText `xml:"j135,omitempty" json:"supplierEANLocationNumber,omitempty"`
+	IntermediaryAvailabilityCode *IntermediaryAvailabilityCode `xml:"j348,omitempty" json:"intermediaryAvailabilityCode,omitempty"`
+	AvailabilityCode *AvailabilityCode `xml:"j141,omitempty" json:"availabilityCode,omitempty"`
+	ProductAvailability *ProductAvailability `xml:"j396,omitempty" json:"productAvailability,omitempty"`
+	PriceAmount *PlainText `xml:"j151,omitempty" json:"priceAmount,omitempty"`
+	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:"unpricedItemType,omitempty"`
+	Prices []Price `xml:"price,omitempty" json:"prices,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	SupplierRole *SupplierRole `xml:"j292,omitempty" json:"supplierRole,omitempty"`
+	SupplyRestrictionDetail *PlainText `xml:"j399,omitempty" json:"supplyRestrictionDetail,omitempty"`
+	LastDateForReturns *PlainText `xml:"j387,omitempty" json:"lastDateForReturns,omitempty"`
+	NewSupplier *NewSupplier `xml:"newsupplier,omitempty" json:"newSupplier,omitempty"`
+	OnSaleDate *PlainText `xml:"j143,omitempty" json:"onSaleDate,omitempty"`
+	OrderTime *PlainText `xml:"j144,omitempty" json:"orderTime,omitempty"`
+	Stocks []Stock `xml:"stock,omitempty" json:"stocks,omitempty"`
+	PackQuantity *PlainText `xml:"j145,omitempty" json:"packQuantity,omitempty"`
+	Reissue *Reissue `xml:"reissue,omitempty" json:"reissue,omitempty"`
+	SupplyToTerritory *TerritoryCodeList `xml:"j397,omitempty" json:"supplyToTerritory,omitempty"`
+	SupplyToRegions []SupplyToRegion `xml:"j139,omitempty" json:"supplyToRegions,omitempty"`
+	SupplyToCountrys []CountryCodeList `xml:"j138,omitempty" json:"supplyToCountrys,omitempty"`
+	SupplyToCountryExcludeds []CountryCodeList `xml:"j140,omitempty" json:"supplyToCountryExcludeds,omitempty"`
+	ReturnsCodeType *ReturnsCodeType `xml:"j268,omitempty" json:"returnsCodeType,omitempty"`
+	ReturnsCode *PlainText `xml:"j269,omitempty" json:"returnsCode,omitempty"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	ExpectedShipDate *PlainText `xml:"j142,omitempty" json:"expectedShipDate,omitempty"`
+	AudienceRestrictionFlag *AudienceRestrictionFlag `xml:"j146,omitempty" json:"audienceRestrictionFlag,omitempty"`
+	AudienceRestrictionNote *PlainText `xml:"j147,omitempty" json:"audienceRestrictionNote,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TextItem is not documented.
 type TextItem struct {
-	PageRuns []PageRun `xml:"pagerun,omitempty" json:",omitempty"`
-	FirstPageNumber *string `xml:"b286,omitempty" json:",omitempty"`
-	LastPageNumber *string `xml:"b287,omitempty" json:",omitempty"`
-	TextItemType TextItemType `xml:"b290"`
-	TextItemIdentifiers []TextItemIdentifier `xml:"textitemidentifier,omitempty" json:",omitempty"`
-	NumberOfPages *string `xml:"b061,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PageRuns []PageRun `xml:"pagerun,omitempty" json:"pageRuns,omitempty"`
+	FirstPageNumber *PlainText `xml:"b286,omitempty" json:"firstPageNumber,omitempty"`
+	LastPageNumber *PlainText `xml:"b287,omitempty" json:"lastPageNumber,omitempty"`
+	TextItemType TextItemType `xml:"b290" json:"textItemType"`
+	TextItemIdentifiers []TextItemIdentifier `xml:"textitemidentifier,omitempty" json:"textItemIdentifiers,omitempty"`
+	NumberOfPages *PlainText `xml:"b061,omitempty" json:"numberOfPages,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TextItemIdentifier is not documented.
 type TextItemIdentifier struct {
-	TextItemIDType TextItemIDType `xml:"b285"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextItemIDType TextItemIDType `xml:"b285" json:"textItemIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Title is not documented.
 type Title struct {
-	TitleText *string `xml:"b203,omitempty" json:",omitempty"`
-	TitlePrefix *string `xml:"b030,omitempty" json:",omitempty"`
-	TitleWithoutPrefix *string `xml:"b031,omitempty" json:",omitempty"`
-	TitleType TitleType `xml:"b202"`
-	AbbreviatedLength *string `xml:"b276,omitempty" json:",omitempty"`
-	TextCaseFlag *TextCaseFlag `xml:"b027,omitempty" json:",omitempty"`
-	Subtitle *string `xml:"b029,omitempty" json:",omitempty"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TitleText *PlainText `xml:"b203,omitempty" json:"titleText,omitempty"`
+	TitlePrefix *PlainText `xml:"b030,omitempty" json:"titlePrefix,omitempty"`
+	TitleWithoutPrefix *PlainText `xml:"b031,omitempty" json:"titleWithoutPrefix,omitempty"`
+	TitleType TitleType `xml:"b202" json:"titleType"`
+	AbbreviatedLength *PlainText `xml:"b276,omitempty" json:"abbreviatedLength,omitempty"`
+	TextCaseFlag *TextCaseFlag `xml:"b027,omitempty" json:"textCaseFlag,omitempty"`
+	Subtitle *PlainText `xml:"b029,omitempty" json:"subtitle,omitempty"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Website is not documented.
 type Website struct {
-	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:",omitempty"`
-	WebsiteDescription *WebsiteDescription `xml:"b294,omitempty" json:",omitempty"`
-	WebsiteLink string `xml:"b295"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:"websiteRole,omitempty"`
+	WebsiteDescription *WebsiteDescription `xml:"b294,omitempty" json:"websiteDescription,omitempty"`
+	WebsiteLink PlainText `xml:"b295" json:"websiteLink"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // WorkIdentifier is not documented.
 type WorkIdentifier struct {
-	WorkIDType WorkIDType `xml:"b201"`
-	IDTypeName *string `xml:"b233,omitempty" json:",omitempty"`
-	IDValue string `xml:"b244"`
-	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:",omitempty"`
-	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:",omitempty"`
-	Language *LanguageList74 `xml:"language,omitempty,attr" json:",omitempty"`
-	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:",omitempty"`
-	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WorkIDType WorkIDType `xml:"b201" json:"workIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Textformat *TextFormatCode `xml:"textformat,omitempty,attr" json:"textformat,omitempty"`
+	Textcase *TextCaseCode `xml:"textcase,omitempty,attr" json:"textcase,omitempty"`
+	Language *LanguageList74 `xml:"language,omitempty,attr" json:"language,omitempty"`
+	Transliteration *TransliterationCode `xml:"transliteration,omitempty,attr" json:"transliteration,omitempty"`
+	Datestamp *DateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *Sourcename `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
@@ -0,0 +1,15032 @@
+package onix
+
+// Optional elements and attributes are held by pointers, whose accessors read them without nil checks
+// even if the composites holding them are nil, as in p.ReligiousText.ReligiousTextIDOrElse(def).
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *AddresseeIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *AddresseeIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *AddresseeIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *AddresseeIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *AddresseeIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AddresseeIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AddresseeIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AddresseeIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *AgentIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *AgentIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *AgentIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *AgentIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *AgentIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AgentIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AgentIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AgentIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceCodeTypeName returns AudienceCodeTypeName and true, or false if it or x is nil.
+func (x *Audience) GetAudienceCodeTypeName() (PlainText, bool) {
+	if x == nil || x.AudienceCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceCodeTypeName, true
+}
+
+// AudienceCodeTypeNameOrElse returns AudienceCodeTypeName, or def if it or x is nil.
+func (x *Audience) AudienceCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Audience) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Audience) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Audience) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Audience) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Audience) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Audience) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Audience) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Audience) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Audience) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Audience) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Audience) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Audience) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Audience) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Audience) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRangePrecision returns AudienceRangePrecision and true, or false if it or x is nil.
+func (x *AudienceRange) GetAudienceRangePrecision() (AudienceRangePrecision, bool) {
+	if x == nil || x.AudienceRangePrecision == nil {
+		var zero AudienceRangePrecision
+		return zero, false
+	}
+	return *x.AudienceRangePrecision, true
+}
+
+// AudienceRangePrecisionOrElse returns AudienceRangePrecision, or def if it or x is nil.
+func (x *AudienceRange) AudienceRangePrecisionOrElse(def AudienceRangePrecision) AudienceRangePrecision {
+	if v, ok := x.GetAudienceRangePrecision(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRangeValue returns AudienceRangeValue and true, or false if it or x is nil.
+func (x *AudienceRange) GetAudienceRangeValue() (PlainText, bool) {
+	if x == nil || x.AudienceRangeValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceRangeValue, true
+}
+
+// AudienceRangeValueOrElse returns AudienceRangeValue, or def if it or x is nil.
+func (x *AudienceRange) AudienceRangeValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceRangeValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *AudienceRange) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *AudienceRange) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *AudienceRange) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *AudienceRange) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *AudienceRange) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *AudienceRange) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *AudienceRange) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *AudienceRange) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AudienceRange) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AudienceRange) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AudienceRange) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AudienceRange) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AudienceRange) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AudienceRange) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *BatchBonus) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *BatchBonus) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *BatchBonus) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *BatchBonus) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *BatchBonus) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *BatchBonus) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *BatchBonus) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *BatchBonus) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *BatchBonus) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *BatchBonus) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *BatchBonus) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *BatchBonus) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *BatchBonus) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *BatchBonus) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStudyBibleType returns StudyBibleType and true, or false if it or x is nil.
+func (x *Bible) GetStudyBibleType() (StudyBibleType, bool) {
+	if x == nil || x.StudyBibleType == nil {
+		var zero StudyBibleType
+		return zero, false
+	}
+	return *x.StudyBibleType, true
+}
+
+// StudyBibleTypeOrElse returns StudyBibleType, or def if it or x is nil.
+func (x *Bible) StudyBibleTypeOrElse(def StudyBibleType) StudyBibleType {
+	if v, ok := x.GetStudyBibleType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBibleTextOrganization returns BibleTextOrganization and true, or false if it or x is nil.
+func (x *Bible) GetBibleTextOrganization() (BibleTextOrganization, bool) {
+	if x == nil || x.BibleTextOrganization == nil {
+		var zero BibleTextOrganization
+		return zero, false
+	}
+	return *x.BibleTextOrganization, true
+}
+
+// BibleTextOrganizationOrElse returns BibleTextOrganization, or def if it or x is nil.
+func (x *Bible) BibleTextOrganizationOrElse(def BibleTextOrganization) BibleTextOrganization {
+	if v, ok := x.GetBibleTextOrganization(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBibleReferenceLocation returns BibleReferenceLocation and true, or false if it or x is nil.
+func (x *Bible) GetBibleReferenceLocation() (BibleReferenceLocation, bool) {
+	if x == nil || x.BibleReferenceLocation == nil {
+		var zero BibleReferenceLocation
+		return zero, false
+	}
+	return *x.BibleReferenceLocation, true
+}
+
+// BibleReferenceLocationOrElse returns BibleReferenceLocation, or def if it or x is nil.
+func (x *Bible) BibleReferenceLocationOrElse(def BibleReferenceLocation) BibleReferenceLocation {
+	if v, ok := x.GetBibleReferenceLocation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Bible) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Bible) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Bible) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Bible) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Bible) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Bible) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Bible) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Bible) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Bible) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Bible) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Bible) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Bible) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Bible) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Bible) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Complexity) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Complexity) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Complexity) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Complexity) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Complexity) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Complexity) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Complexity) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Complexity) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Complexity) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Complexity) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Complexity) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Complexity) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Complexity) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Complexity) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceRole returns ConferenceRole and true, or false if it or x is nil.
+func (x *Conference) GetConferenceRole() (ConferenceRole, bool) {
+	if x == nil || x.ConferenceRole == nil {
+		var zero ConferenceRole
+		return zero, false
+	}
+	return *x.ConferenceRole, true
+}
+
+// ConferenceRoleOrElse returns ConferenceRole, or def if it or x is nil.
+func (x *Conference) ConferenceRoleOrElse(def ConferenceRole) ConferenceRole {
+	if v, ok := x.GetConferenceRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceAcronym returns ConferenceAcronym and true, or false if it or x is nil.
+func (x *Conference) GetConferenceAcronym() (PlainText, bool) {
+	if x == nil || x.ConferenceAcronym == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceAcronym, true
+}
+
+// ConferenceAcronymOrElse returns ConferenceAcronym, or def if it or x is nil.
+func (x *Conference) ConferenceAcronymOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceAcronym(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceNumber returns ConferenceNumber and true, or false if it or x is nil.
+func (x *Conference) GetConferenceNumber() (PlainText, bool) {
+	if x == nil || x.ConferenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceNumber, true
+}
+
+// ConferenceNumberOrElse returns ConferenceNumber, or def if it or x is nil.
+func (x *Conference) ConferenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceTheme returns ConferenceTheme and true, or false if it or x is nil.
+func (x *Conference) GetConferenceTheme() (PlainText, bool) {
+	if x == nil || x.ConferenceTheme == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceTheme, true
+}
+
+// ConferenceThemeOrElse returns ConferenceTheme, or def if it or x is nil.
+func (x *Conference) ConferenceThemeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceTheme(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceDate returns ConferenceDate and true, or false if it or x is nil.
+func (x *Conference) GetConferenceDate() (PlainText, bool) {
+	if x == nil || x.ConferenceDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceDate, true
+}
+
+// ConferenceDateOrElse returns ConferenceDate, or def if it or x is nil.
+func (x *Conference) ConferenceDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferencePlace returns ConferencePlace and true, or false if it or x is nil.
+func (x *Conference) GetConferencePlace() (PlainText, bool) {
+	if x == nil || x.ConferencePlace == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferencePlace, true
+}
+
+// ConferencePlaceOrElse returns ConferencePlace, or def if it or x is nil.
+func (x *Conference) ConferencePlaceOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferencePlace(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Conference) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Conference) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Conference) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Conference) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Conference) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Conference) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Conference) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Conference) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Conference) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Conference) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Conference) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Conference) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Conference) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Conference) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *ConferenceSponsor) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *ConferenceSponsor) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceSponsorIdentifier returns ConferenceSponsorIdentifier and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetConferenceSponsorIdentifier() (ConferenceSponsorIdentifier, bool) {
+	if x == nil || x.ConferenceSponsorIdentifier == nil {
+		var zero ConferenceSponsorIdentifier
+		return zero, false
+	}
+	return *x.ConferenceSponsorIdentifier, true
+}
+
+// ConferenceSponsorIdentifierOrElse returns ConferenceSponsorIdentifier, or def if it or x is nil.
+func (x *ConferenceSponsor) ConferenceSponsorIdentifierOrElse(def ConferenceSponsorIdentifier) ConferenceSponsorIdentifier {
+	if v, ok := x.GetConferenceSponsorIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ConferenceSponsor) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ConferenceSponsor) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ConferenceSponsor) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ConferenceSponsor) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ConferenceSponsor) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ConferenceSponsor) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ConferenceSponsor) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISBN returns ISBN and true, or false if it or x is nil.
+func (x *ContainedItem) GetISBN() (PlainText, bool) {
+	if x == nil || x.ISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISBN, true
+}
+
+// ISBNOrElse returns ISBN, or def if it or x is nil.
+func (x *ContainedItem) ISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEAN13 returns EAN13 and true, or false if it or x is nil.
+func (x *ContainedItem) GetEAN13() (PlainText, bool) {
+	if x == nil || x.EAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EAN13, true
+}
+
+// EAN13OrElse returns EAN13, or def if it or x is nil.
+func (x *ContainedItem) EAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductForm returns ProductForm and true, or false if it or x is nil.
+func (x *ContainedItem) GetProductForm() (ProductForm, bool) {
+	if x == nil || x.ProductForm == nil {
+		var zero ProductForm
+		return zero, false
+	}
+	return *x.ProductForm, true
+}
+
+// ProductFormOrElse returns ProductForm, or def if it or x is nil.
+func (x *ContainedItem) ProductFormOrElse(def ProductForm) ProductForm {
+	if v, ok := x.GetProductForm(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductPackaging returns ProductPackaging and true, or false if it or x is nil.
+func (x *ContainedItem) GetProductPackaging() (ProductPackaging, bool) {
+	if x == nil || x.ProductPackaging == nil {
+		var zero ProductPackaging
+		return zero, false
+	}
+	return *x.ProductPackaging, true
+}
+
+// ProductPackagingOrElse returns ProductPackaging, or def if it or x is nil.
+func (x *ContainedItem) ProductPackagingOrElse(def ProductPackaging) ProductPackaging {
+	if v, ok := x.GetProductPackaging(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormDescription returns ProductFormDescription and true, or false if it or x is nil.
+func (x *ContainedItem) GetProductFormDescription() (PlainText, bool) {
+	if x == nil || x.ProductFormDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormDescription, true
+}
+
+// ProductFormDescriptionOrElse returns ProductFormDescription, or def if it or x is nil.
+func (x *ContainedItem) ProductFormDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPieces returns NumberOfPieces and true, or false if it or x is nil.
+func (x *ContainedItem) GetNumberOfPieces() (PlainText, bool) {
+	if x == nil || x.NumberOfPieces == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPieces, true
+}
+
+// NumberOfPiecesOrElse returns NumberOfPieces, or def if it or x is nil.
+func (x *ContainedItem) NumberOfPiecesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPieces(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTradeCategory returns TradeCategory and true, or false if it or x is nil.
+func (x *ContainedItem) GetTradeCategory() (TradeCategory, bool) {
+	if x == nil || x.TradeCategory == nil {
+		var zero TradeCategory
+		return zero, false
+	}
+	return *x.TradeCategory, true
+}
+
+// TradeCategoryOrElse returns TradeCategory, or def if it or x is nil.
+func (x *ContainedItem) TradeCategoryOrElse(def TradeCategory) TradeCategory {
+	if v, ok := x.GetTradeCategory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetItemQuantity returns ItemQuantity and true, or false if it or x is nil.
+func (x *ContainedItem) GetItemQuantity() (PlainText, bool) {
+	if x == nil || x.ItemQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ItemQuantity, true
+}
+
+// ItemQuantityOrElse returns ItemQuantity, or def if it or x is nil.
+func (x *ContainedItem) ItemQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetItemQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ContainedItem) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ContainedItem) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ContainedItem) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ContainedItem) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ContainedItem) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ContainedItem) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ContainedItem) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ContainedItem) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContainedItem) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContainedItem) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContainedItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContainedItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContainedItem) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContainedItem) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetComponentTypeName returns ComponentTypeName and true, or false if it or x is nil.
+func (x *ContentItem) GetComponentTypeName() (PlainText, bool) {
+	if x == nil || x.ComponentTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ComponentTypeName, true
+}
+
+// ComponentTypeNameOrElse returns ComponentTypeName, or def if it or x is nil.
+func (x *ContentItem) ComponentTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetComponentTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetComponentNumber returns ComponentNumber and true, or false if it or x is nil.
+func (x *ContentItem) GetComponentNumber() (PlainText, bool) {
+	if x == nil || x.ComponentNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ComponentNumber, true
+}
+
+// ComponentNumberOrElse returns ComponentNumber, or def if it or x is nil.
+func (x *ContentItem) ComponentNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetComponentNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDistinctiveTitle returns DistinctiveTitle and true, or false if it or x is nil.
+func (x *ContentItem) GetDistinctiveTitle() (PlainText, bool) {
+	if x == nil || x.DistinctiveTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DistinctiveTitle, true
+}
+
+// DistinctiveTitleOrElse returns DistinctiveTitle, or def if it or x is nil.
+func (x *ContentItem) DistinctiveTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDistinctiveTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLevelSequenceNumber returns LevelSequenceNumber and true, or false if it or x is nil.
+func (x *ContentItem) GetLevelSequenceNumber() (PlainText, bool) {
+	if x == nil || x.LevelSequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LevelSequenceNumber, true
+}
+
+// LevelSequenceNumberOrElse returns LevelSequenceNumber, or def if it or x is nil.
+func (x *ContentItem) LevelSequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLevelSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContributorStatement returns ContributorStatement and true, or false if it or x is nil.
+func (x *ContentItem) GetContributorStatement() (PlainText, bool) {
+	if x == nil || x.ContributorStatement == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContributorStatement, true
+}
+
+// ContributorStatementOrElse returns ContributorStatement, or def if it or x is nil.
+func (x *ContentItem) ContributorStatementOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContributorStatement(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ContentItem) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ContentItem) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ContentItem) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ContentItem) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ContentItem) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ContentItem) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ContentItem) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ContentItem) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContentItem) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContentItem) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContentItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContentItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContentItem) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContentItem) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSequenceNumberWithinRole returns SequenceNumberWithinRole and true, or false if it or x is nil.
+func (x *Contributor) GetSequenceNumberWithinRole() (PlainText, bool) {
+	if x == nil || x.SequenceNumberWithinRole == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SequenceNumberWithinRole, true
+}
+
+// SequenceNumberWithinRoleOrElse returns SequenceNumberWithinRole, or def if it or x is nil.
+func (x *Contributor) SequenceNumberWithinRoleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSequenceNumberWithinRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContributorRole returns ContributorRole and true, or false if it or x is nil.
+func (x *Contributor) GetContributorRole() (ContributorRole, bool) {
+	if x == nil || x.ContributorRole == nil {
+		var zero ContributorRole
+		return zero, false
+	}
+	return *x.ContributorRole, true
+}
+
+// ContributorRoleOrElse returns ContributorRole, or def if it or x is nil.
+func (x *Contributor) ContributorRoleOrElse(def ContributorRole) ContributorRole {
+	if v, ok := x.GetContributorRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUnnamedPersons returns UnnamedPersons and true, or false if it or x is nil.
+func (x *Contributor) GetUnnamedPersons() (UnnamedPersons, bool) {
+	if x == nil || x.UnnamedPersons == nil {
+		var zero UnnamedPersons
+		return zero, false
+	}
+	return *x.UnnamedPersons, true
+}
+
+// UnnamedPersonsOrElse returns UnnamedPersons, or def if it or x is nil.
+func (x *Contributor) UnnamedPersonsOrElse(def UnnamedPersons) UnnamedPersons {
+	if v, ok := x.GetUnnamedPersons(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *Contributor) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *Contributor) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *Contributor) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *Contributor) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *Contributor) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *Contributor) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *Contributor) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *Contributor) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *Contributor) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *Contributor) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *Contributor) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *Contributor) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *Contributor) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *Contributor) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *Contributor) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *Contributor) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *Contributor) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *Contributor) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *Contributor) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *Contributor) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *Contributor) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *Contributor) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBiographicalNote returns BiographicalNote and true, or false if it or x is nil.
+func (x *Contributor) GetBiographicalNote() (BiographicalNote, bool) {
+	if x == nil || x.BiographicalNote == nil {
+		var zero BiographicalNote
+		return zero, false
+	}
+	return *x.BiographicalNote, true
+}
+
+// BiographicalNoteOrElse returns BiographicalNote, or def if it or x is nil.
+func (x *Contributor) BiographicalNoteOrElse(def BiographicalNote) BiographicalNote {
+	if v, ok := x.GetBiographicalNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProfessionalPosition returns ProfessionalPosition and true, or false if it or x is nil.
+func (x *Contributor) GetProfessionalPosition() (PlainText, bool) {
+	if x == nil || x.ProfessionalPosition == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProfessionalPosition, true
+}
+
+// ProfessionalPositionOrElse returns ProfessionalPosition, or def if it or x is nil.
+func (x *Contributor) ProfessionalPositionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProfessionalPosition(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAffiliation returns Affiliation and true, or false if it or x is nil.
+func (x *Contributor) GetAffiliation() (PlainText, bool) {
+	if x == nil || x.Affiliation == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Affiliation, true
+}
+
+// AffiliationOrElse returns Affiliation, or def if it or x is nil.
+func (x *Contributor) AffiliationOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAffiliation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContributorDescription returns ContributorDescription and true, or false if it or x is nil.
+func (x *Contributor) GetContributorDescription() (PlainText, bool) {
+	if x == nil || x.ContributorDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContributorDescription, true
+}
+
+// ContributorDescriptionOrElse returns ContributorDescription, or def if it or x is nil.
+func (x *Contributor) ContributorDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContributorDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSequenceNumber returns SequenceNumber and true, or false if it or x is nil.
+func (x *Contributor) GetSequenceNumber() (PlainText, bool) {
+	if x == nil || x.SequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SequenceNumber, true
+}
+
+// SequenceNumberOrElse returns SequenceNumber, or def if it or x is nil.
+func (x *Contributor) SequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Contributor) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Contributor) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Contributor) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Contributor) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Contributor) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Contributor) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Contributor) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Contributor) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Contributor) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Contributor) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Contributor) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Contributor) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Contributor) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Contributor) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *CopyrightOwner) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *CopyrightOwner) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCopyrightOwnerIdentifier returns CopyrightOwnerIdentifier and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetCopyrightOwnerIdentifier() (CopyrightOwnerIdentifier, bool) {
+	if x == nil || x.CopyrightOwnerIdentifier == nil {
+		var zero CopyrightOwnerIdentifier
+		return zero, false
+	}
+	return *x.CopyrightOwnerIdentifier, true
+}
+
+// CopyrightOwnerIdentifierOrElse returns CopyrightOwnerIdentifier, or def if it or x is nil.
+func (x *CopyrightOwner) CopyrightOwnerIdentifierOrElse(def CopyrightOwnerIdentifier) CopyrightOwnerIdentifier {
+	if v, ok := x.GetCopyrightOwnerIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *CopyrightOwner) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *CopyrightOwner) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *CopyrightOwner) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *CopyrightOwner) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightOwner) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightOwner) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightOwner) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *CopyrightStatement) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *CopyrightStatement) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *CopyrightStatement) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *CopyrightStatement) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightStatement) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightStatement) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightStatement) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountCodeTypeName returns DiscountCodeTypeName and true, or false if it or x is nil.
+func (x *DiscountCoded) GetDiscountCodeTypeName() (PlainText, bool) {
+	if x == nil || x.DiscountCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DiscountCodeTypeName, true
+}
+
+// DiscountCodeTypeNameOrElse returns DiscountCodeTypeName, or def if it or x is nil.
+func (x *DiscountCoded) DiscountCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDiscountCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *DiscountCoded) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *DiscountCoded) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *DiscountCoded) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *DiscountCoded) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *DiscountCoded) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *DiscountCoded) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *DiscountCoded) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *DiscountCoded) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *DiscountCoded) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *DiscountCoded) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *DiscountCoded) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *DiscountCoded) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *DiscountCoded) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *DiscountCoded) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Extent) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Extent) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Extent) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Extent) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Extent) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Extent) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Extent) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Extent) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Extent) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Extent) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Extent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Extent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Extent) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Extent) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFromCompany returns FromCompany and true, or false if it or x is nil.
+func (x *Header) GetFromCompany() (PlainText, bool) {
+	if x == nil || x.FromCompany == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FromCompany, true
+}
+
+// FromCompanyOrElse returns FromCompany, or def if it or x is nil.
+func (x *Header) FromCompanyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFromCompany(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFromEANNumber returns FromEANNumber and true, or false if it or x is nil.
+func (x *Header) GetFromEANNumber() (PlainText, bool) {
+	if x == nil || x.FromEANNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FromEANNumber, true
+}
+
+// FromEANNumberOrElse returns FromEANNumber, or def if it or x is nil.
+func (x *Header) FromEANNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFromEANNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFromSAN returns FromSAN and true, or false if it or x is nil.
+func (x *Header) GetFromSAN() (PlainText, bool) {
+	if x == nil || x.FromSAN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FromSAN, true
+}
+
+// FromSANOrElse returns FromSAN, or def if it or x is nil.
+func (x *Header) FromSANOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFromSAN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFromPerson returns FromPerson and true, or false if it or x is nil.
+func (x *Header) GetFromPerson() (PlainText, bool) {
+	if x == nil || x.FromPerson == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FromPerson, true
+}
+
+// FromPersonOrElse returns FromPerson, or def if it or x is nil.
+func (x *Header) FromPersonOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFromPerson(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFromEmail returns FromEmail and true, or false if it or x is nil.
+func (x *Header) GetFromEmail() (PlainText, bool) {
+	if x == nil || x.FromEmail == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FromEmail, true
+}
+
+// FromEmailOrElse returns FromEmail, or def if it or x is nil.
+func (x *Header) FromEmailOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFromEmail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetToEANNumber returns ToEANNumber and true, or false if it or x is nil.
+func (x *Header) GetToEANNumber() (PlainText, bool) {
+	if x == nil || x.ToEANNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ToEANNumber, true
+}
+
+// ToEANNumberOrElse returns ToEANNumber, or def if it or x is nil.
+func (x *Header) ToEANNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetToEANNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetToSAN returns ToSAN and true, or false if it or x is nil.
+func (x *Header) GetToSAN() (PlainText, bool) {
+	if x == nil || x.ToSAN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ToSAN, true
+}
+
+// ToSANOrElse returns ToSAN, or def if it or x is nil.
+func (x *Header) ToSANOrElse(def PlainText) PlainText {
+	if v, ok := x.GetToSAN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetToCompany returns ToCompany and true, or false if it or x is nil.
+func (x *Header) GetToCompany() (PlainText, bool) {
+	if x == nil || x.ToCompany == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ToCompany, true
+}
+
+// ToCompanyOrElse returns ToCompany, or def if it or x is nil.
+func (x *Header) ToCompanyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetToCompany(); ok {
+		return v
+	}
+	return def
+}
+
+// GetToPerson returns ToPerson and true, or false if it or x is nil.
+func (x *Header) GetToPerson() (PlainText, bool) {
+	if x == nil || x.ToPerson == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ToPerson, true
+}
+
+// ToPersonOrElse returns ToPerson, or def if it or x is nil.
+func (x *Header) ToPersonOrElse(def PlainText) PlainText {
+	if v, ok := x.GetToPerson(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMessageNumber returns MessageNumber and true, or false if it or x is nil.
+func (x *Header) GetMessageNumber() (PlainText, bool) {
+	if x == nil || x.MessageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MessageNumber, true
+}
+
+// MessageNumberOrElse returns MessageNumber, or def if it or x is nil.
+func (x *Header) MessageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMessageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMessageRepeat returns MessageRepeat and true, or false if it or x is nil.
+func (x *Header) GetMessageRepeat() (PlainText, bool) {
+	if x == nil || x.MessageRepeat == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MessageRepeat, true
+}
+
+// MessageRepeatOrElse returns MessageRepeat, or def if it or x is nil.
+func (x *Header) MessageRepeatOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMessageRepeat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMessageNote returns MessageNote and true, or false if it or x is nil.
+func (x *Header) GetMessageNote() (PlainText, bool) {
+	if x == nil || x.MessageNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MessageNote, true
+}
+
+// MessageNoteOrElse returns MessageNote, or def if it or x is nil.
+func (x *Header) MessageNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMessageNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultLanguageOfText returns DefaultLanguageOfText and true, or false if it or x is nil.
+func (x *Header) GetDefaultLanguageOfText() (DefaultLanguageOfText, bool) {
+	if x == nil || x.DefaultLanguageOfText == nil {
+		var zero DefaultLanguageOfText
+		return zero, false
+	}
+	return *x.DefaultLanguageOfText, true
+}
+
+// DefaultLanguageOfTextOrElse returns DefaultLanguageOfText, or def if it or x is nil.
+func (x *Header) DefaultLanguageOfTextOrElse(def DefaultLanguageOfText) DefaultLanguageOfText {
+	if v, ok := x.GetDefaultLanguageOfText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultPriceTypeCode returns DefaultPriceTypeCode and true, or false if it or x is nil.
+func (x *Header) GetDefaultPriceTypeCode() (DefaultPriceTypeCode, bool) {
+	if x == nil || x.DefaultPriceTypeCode == nil {
+		var zero DefaultPriceTypeCode
+		return zero, false
+	}
+	return *x.DefaultPriceTypeCode, true
+}
+
+// DefaultPriceTypeCodeOrElse returns DefaultPriceTypeCode, or def if it or x is nil.
+func (x *Header) DefaultPriceTypeCodeOrElse(def DefaultPriceTypeCode) DefaultPriceTypeCode {
+	if v, ok := x.GetDefaultPriceTypeCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultCurrencyCode returns DefaultCurrencyCode and true, or false if it or x is nil.
+func (x *Header) GetDefaultCurrencyCode() (DefaultCurrencyCode, bool) {
+	if x == nil || x.DefaultCurrencyCode == nil {
+		var zero DefaultCurrencyCode
+		return zero, false
+	}
+	return *x.DefaultCurrencyCode, true
+}
+
+// DefaultCurrencyCodeOrElse returns DefaultCurrencyCode, or def if it or x is nil.
+func (x *Header) DefaultCurrencyCodeOrElse(def DefaultCurrencyCode) DefaultCurrencyCode {
+	if v, ok := x.GetDefaultCurrencyCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultLinearUnit returns DefaultLinearUnit and true, or false if it or x is nil.
+func (x *Header) GetDefaultLinearUnit() (DefaultLinearUnit, bool) {
+	if x == nil || x.DefaultLinearUnit == nil {
+		var zero DefaultLinearUnit
+		return zero, false
+	}
+	return *x.DefaultLinearUnit, true
+}
+
+// DefaultLinearUnitOrElse returns DefaultLinearUnit, or def if it or x is nil.
+func (x *Header) DefaultLinearUnitOrElse(def DefaultLinearUnit) DefaultLinearUnit {
+	if v, ok := x.GetDefaultLinearUnit(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultWeightUnit returns DefaultWeightUnit and true, or false if it or x is nil.
+func (x *Header) GetDefaultWeightUnit() (DefaultWeightUnit, bool) {
+	if x == nil || x.DefaultWeightUnit == nil {
+		var zero DefaultWeightUnit
+		return zero, false
+	}
+	return *x.DefaultWeightUnit, true
+}
+
+// DefaultWeightUnitOrElse returns DefaultWeightUnit, or def if it or x is nil.
+func (x *Header) DefaultWeightUnitOrElse(def DefaultWeightUnit) DefaultWeightUnit {
+	if v, ok := x.GetDefaultWeightUnit(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultClassOfTrade returns DefaultClassOfTrade and true, or false if it or x is nil.
+func (x *Header) GetDefaultClassOfTrade() (PlainText, bool) {
+	if x == nil || x.DefaultClassOfTrade == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DefaultClassOfTrade, true
+}
+
+// DefaultClassOfTradeOrElse returns DefaultClassOfTrade, or def if it or x is nil.
+func (x *Header) DefaultClassOfTradeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDefaultClassOfTrade(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Header) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Header) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Header) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Header) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Header) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Header) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Header) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Header) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Header) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Header) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Header) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Header) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Header) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Header) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIllustrationTypeDescription returns IllustrationTypeDescription and true, or false if it or x is nil.
+func (x *Illustrations) GetIllustrationTypeDescription() (PlainText, bool) {
+	if x == nil || x.IllustrationTypeDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IllustrationTypeDescription, true
+}
+
+// IllustrationTypeDescriptionOrElse returns IllustrationTypeDescription, or def if it or x is nil.
+func (x *Illustrations) IllustrationTypeDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIllustrationTypeDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumber returns Number and true, or false if it or x is nil.
+func (x *Illustrations) GetNumber() (PlainText, bool) {
+	if x == nil || x.Number == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Number, true
+}
+
+// NumberOrElse returns Number, or def if it or x is nil.
+func (x *Illustrations) NumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Illustrations) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Illustrations) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Illustrations) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Illustrations) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Illustrations) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Illustrations) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Illustrations) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Illustrations) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Illustrations) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Illustrations) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Illustrations) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Illustrations) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Illustrations) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Illustrations) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetImprintName returns ImprintName and true, or false if it or x is nil.
+func (x *Imprint) GetImprintName() (PlainText, bool) {
+	if x == nil || x.ImprintName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ImprintName, true
+}
+
+// ImprintNameOrElse returns ImprintName, or def if it or x is nil.
+func (x *Imprint) ImprintNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetImprintName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeType returns NameCodeType and true, or false if it or x is nil.
+func (x *Imprint) GetNameCodeType() (NameCodeType, bool) {
+	if x == nil || x.NameCodeType == nil {
+		var zero NameCodeType
+		return zero, false
+	}
+	return *x.NameCodeType, true
+}
+
+// NameCodeTypeOrElse returns NameCodeType, or def if it or x is nil.
+func (x *Imprint) NameCodeTypeOrElse(def NameCodeType) NameCodeType {
+	if v, ok := x.GetNameCodeType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeTypeName returns NameCodeTypeName and true, or false if it or x is nil.
+func (x *Imprint) GetNameCodeTypeName() (PlainText, bool) {
+	if x == nil || x.NameCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NameCodeTypeName, true
+}
+
+// NameCodeTypeNameOrElse returns NameCodeTypeName, or def if it or x is nil.
+func (x *Imprint) NameCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNameCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeValue returns NameCodeValue and true, or false if it or x is nil.
+func (x *Imprint) GetNameCodeValue() (PlainText, bool) {
+	if x == nil || x.NameCodeValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NameCodeValue, true
+}
+
+// NameCodeValueOrElse returns NameCodeValue, or def if it or x is nil.
+func (x *Imprint) NameCodeValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNameCodeValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Imprint) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Imprint) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Imprint) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Imprint) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Imprint) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Imprint) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Imprint) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Imprint) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Imprint) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Imprint) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Imprint) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Imprint) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Imprint) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Imprint) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryCode returns CountryCode and true, or false if it or x is nil.
+func (x *Language) GetCountryCode() (CountryCode, bool) {
+	if x == nil || x.CountryCode == nil {
+		var zero CountryCode
+		return zero, false
+	}
+	return *x.CountryCode, true
+}
+
+// CountryCodeOrElse returns CountryCode, or def if it or x is nil.
+func (x *Language) CountryCodeOrElse(def CountryCode) CountryCode {
+	if v, ok := x.GetCountryCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Language) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Language) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Language) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Language) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Language) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Language) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Language) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Language) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Language) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Language) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Language) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Language) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Language) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Language) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *LocationIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *LocationIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *LocationIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *LocationIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *LocationIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *LocationIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *LocationIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *LocationIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionCode returns DeletionCode and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetDeletionCode() (DeletionCode, bool) {
+	if x == nil || x.DeletionCode == nil {
+		var zero DeletionCode
+		return zero, false
+	}
+	return *x.DeletionCode, true
+}
+
+// DeletionCodeOrElse returns DeletionCode, or def if it or x is nil.
+func (x *MainSeriesRecord) DeletionCodeOrElse(def DeletionCode) DeletionCode {
+	if v, ok := x.GetDeletionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionText returns DeletionText and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetDeletionText() (PlainText, bool) {
+	if x == nil || x.DeletionText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DeletionText, true
+}
+
+// DeletionTextOrElse returns DeletionText, or def if it or x is nil.
+func (x *MainSeriesRecord) DeletionTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDeletionText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceType returns RecordSourceType and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetRecordSourceType() (RecordSourceType, bool) {
+	if x == nil || x.RecordSourceType == nil {
+		var zero RecordSourceType
+		return zero, false
+	}
+	return *x.RecordSourceType, true
+}
+
+// RecordSourceTypeOrElse returns RecordSourceType, or def if it or x is nil.
+func (x *MainSeriesRecord) RecordSourceTypeOrElse(def RecordSourceType) RecordSourceType {
+	if v, ok := x.GetRecordSourceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceName returns RecordSourceName and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetRecordSourceName() (PlainText, bool) {
+	if x == nil || x.RecordSourceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceName, true
+}
+
+// RecordSourceNameOrElse returns RecordSourceName, or def if it or x is nil.
+func (x *MainSeriesRecord) RecordSourceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubordinateEntries returns SubordinateEntries and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetSubordinateEntries() (PlainText, bool) {
+	if x == nil || x.SubordinateEntries == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubordinateEntries, true
+}
+
+// SubordinateEntriesOrElse returns SubordinateEntries, or def if it or x is nil.
+func (x *MainSeriesRecord) SubordinateEntriesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubordinateEntries(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifierType returns RecordSourceIdentifierType and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetRecordSourceIdentifierType() (RecordSourceIdentifierType, bool) {
+	if x == nil || x.RecordSourceIdentifierType == nil {
+		var zero RecordSourceIdentifierType
+		return zero, false
+	}
+	return *x.RecordSourceIdentifierType, true
+}
+
+// RecordSourceIdentifierTypeOrElse returns RecordSourceIdentifierType, or def if it or x is nil.
+func (x *MainSeriesRecord) RecordSourceIdentifierTypeOrElse(def RecordSourceIdentifierType) RecordSourceIdentifierType {
+	if v, ok := x.GetRecordSourceIdentifierType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifier returns RecordSourceIdentifier and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetRecordSourceIdentifier() (PlainText, bool) {
+	if x == nil || x.RecordSourceIdentifier == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceIdentifier, true
+}
+
+// RecordSourceIdentifierOrElse returns RecordSourceIdentifier, or def if it or x is nil.
+func (x *MainSeriesRecord) RecordSourceIdentifierOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *MainSeriesRecord) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *MainSeriesRecord) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *MainSeriesRecord) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *MainSeriesRecord) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MainSeriesRecord) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MainSeriesRecord) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MainSeriesRecord) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MainSeriesRecord) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectHeadingText returns SubjectHeadingText and true, or false if it or x is nil.
+func (x *MainSubject) GetSubjectHeadingText() (PlainText, bool) {
+	if x == nil || x.SubjectHeadingText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectHeadingText, true
+}
+
+// SubjectHeadingTextOrElse returns SubjectHeadingText, or def if it or x is nil.
+func (x *MainSubject) SubjectHeadingTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectHeadingText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectCode returns SubjectCode and true, or false if it or x is nil.
+func (x *MainSubject) GetSubjectCode() (PlainText, bool) {
+	if x == nil || x.SubjectCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectCode, true
+}
+
+// SubjectCodeOrElse returns SubjectCode, or def if it or x is nil.
+func (x *MainSubject) SubjectCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectSchemeVersion returns SubjectSchemeVersion and true, or false if it or x is nil.
+func (x *MainSubject) GetSubjectSchemeVersion() (PlainText, bool) {
+	if x == nil || x.SubjectSchemeVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectSchemeVersion, true
+}
+
+// SubjectSchemeVersionOrElse returns SubjectSchemeVersion, or def if it or x is nil.
+func (x *MainSubject) SubjectSchemeVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectSchemeVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *MainSubject) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *MainSubject) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *MainSubject) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *MainSubject) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *MainSubject) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *MainSubject) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *MainSubject) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *MainSubject) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MainSubject) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MainSubject) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MainSubject) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MainSubject) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MainSubject) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MainSubject) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *MarketDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *MarketDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *MarketDate) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *MarketDate) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *MarketDate) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *MarketDate) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *MarketDate) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *MarketDate) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *MarketDate) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *MarketDate) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MarketDate) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MarketDate) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MarketDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MarketDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MarketDate) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MarketDate) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAgentName returns AgentName and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetAgentName() (PlainText, bool) {
+	if x == nil || x.AgentName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AgentName, true
+}
+
+// AgentNameOrElse returns AgentName, or def if it or x is nil.
+func (x *MarketRepresentation) AgentNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAgentName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketCountry returns MarketCountry and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetMarketCountry() (PlainText, bool) {
+	if x == nil || x.MarketCountry == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MarketCountry, true
+}
+
+// MarketCountryOrElse returns MarketCountry, or def if it or x is nil.
+func (x *MarketRepresentation) MarketCountryOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMarketCountry(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketTerritory returns MarketTerritory and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetMarketTerritory() (PlainText, bool) {
+	if x == nil || x.MarketTerritory == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MarketTerritory, true
+}
+
+// MarketTerritoryOrElse returns MarketTerritory, or def if it or x is nil.
+func (x *MarketRepresentation) MarketTerritoryOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMarketTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketCountryExcluded returns MarketCountryExcluded and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetMarketCountryExcluded() (PlainText, bool) {
+	if x == nil || x.MarketCountryExcluded == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MarketCountryExcluded, true
+}
+
+// MarketCountryExcludedOrElse returns MarketCountryExcluded, or def if it or x is nil.
+func (x *MarketRepresentation) MarketCountryExcludedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMarketCountryExcluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAgentRole returns AgentRole and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetAgentRole() (PlainText, bool) {
+	if x == nil || x.AgentRole == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AgentRole, true
+}
+
+// AgentRoleOrElse returns AgentRole, or def if it or x is nil.
+func (x *MarketRepresentation) AgentRoleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAgentRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketRestrictionDetail returns MarketRestrictionDetail and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetMarketRestrictionDetail() (PlainText, bool) {
+	if x == nil || x.MarketRestrictionDetail == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MarketRestrictionDetail, true
+}
+
+// MarketRestrictionDetailOrElse returns MarketRestrictionDetail, or def if it or x is nil.
+func (x *MarketRepresentation) MarketRestrictionDetailOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMarketRestrictionDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketPublishingStatus returns MarketPublishingStatus and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetMarketPublishingStatus() (PlainText, bool) {
+	if x == nil || x.MarketPublishingStatus == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MarketPublishingStatus, true
+}
+
+// MarketPublishingStatusOrElse returns MarketPublishingStatus, or def if it or x is nil.
+func (x *MarketRepresentation) MarketPublishingStatusOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMarketPublishingStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *MarketRepresentation) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *MarketRepresentation) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *MarketRepresentation) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *MarketRepresentation) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MarketRepresentation) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MarketRepresentation) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MarketRepresentation) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MarketRepresentation) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Measure) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Measure) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Measure) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Measure) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Measure) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Measure) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Measure) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Measure) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Measure) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Measure) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Measure) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Measure) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Measure) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Measure) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextWithDownload returns TextWithDownload and true, or false if it or x is nil.
+func (x *MediaFile) GetTextWithDownload() (TextWithDownload, bool) {
+	if x == nil || x.TextWithDownload == nil {
+		var zero TextWithDownload
+		return zero, false
+	}
+	return *x.TextWithDownload, true
+}
+
+// TextWithDownloadOrElse returns TextWithDownload, or def if it or x is nil.
+func (x *MediaFile) TextWithDownloadOrElse(def TextWithDownload) TextWithDownload {
+	if v, ok := x.GetTextWithDownload(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDownloadCopyrightNotice returns DownloadCopyrightNotice and true, or false if it or x is nil.
+func (x *MediaFile) GetDownloadCopyrightNotice() (DownloadCopyrightNotice, bool) {
+	if x == nil || x.DownloadCopyrightNotice == nil {
+		var zero DownloadCopyrightNotice
+		return zero, false
+	}
+	return *x.DownloadCopyrightNotice, true
+}
+
+// DownloadCopyrightNoticeOrElse returns DownloadCopyrightNotice, or def if it or x is nil.
+func (x *MediaFile) DownloadCopyrightNoticeOrElse(def DownloadCopyrightNotice) DownloadCopyrightNotice {
+	if v, ok := x.GetDownloadCopyrightNotice(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDownloadCaption returns DownloadCaption and true, or false if it or x is nil.
+func (x *MediaFile) GetDownloadCaption() (DownloadCaption, bool) {
+	if x == nil || x.DownloadCaption == nil {
+		var zero DownloadCaption
+		return zero, false
+	}
+	return *x.DownloadCaption, true
+}
+
+// DownloadCaptionOrElse returns DownloadCaption, or def if it or x is nil.
+func (x *MediaFile) DownloadCaptionOrElse(def DownloadCaption) DownloadCaption {
+	if v, ok := x.GetDownloadCaption(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDownloadCredit returns DownloadCredit and true, or false if it or x is nil.
+func (x *MediaFile) GetDownloadCredit() (DownloadCredit, bool) {
+	if x == nil || x.DownloadCredit == nil {
+		var zero DownloadCredit
+		return zero, false
+	}
+	return *x.DownloadCredit, true
+}
+
+// DownloadCreditOrElse returns DownloadCredit, or def if it or x is nil.
+func (x *MediaFile) DownloadCreditOrElse(def DownloadCredit) DownloadCredit {
+	if v, ok := x.GetDownloadCredit(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMediaFileFormatCode returns MediaFileFormatCode and true, or false if it or x is nil.
+func (x *MediaFile) GetMediaFileFormatCode() (MediaFileFormatCode, bool) {
+	if x == nil || x.MediaFileFormatCode == nil {
+		var zero MediaFileFormatCode
+		return zero, false
+	}
+	return *x.MediaFileFormatCode, true
+}
+
+// MediaFileFormatCodeOrElse returns MediaFileFormatCode, or def if it or x is nil.
+func (x *MediaFile) MediaFileFormatCodeOrElse(def MediaFileFormatCode) MediaFileFormatCode {
+	if v, ok := x.GetMediaFileFormatCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetImageResolution returns ImageResolution and true, or false if it or x is nil.
+func (x *MediaFile) GetImageResolution() (PlainText, bool) {
+	if x == nil || x.ImageResolution == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ImageResolution, true
+}
+
+// ImageResolutionOrElse returns ImageResolution, or def if it or x is nil.
+func (x *MediaFile) ImageResolutionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetImageResolution(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDownloadTerms returns DownloadTerms and true, or false if it or x is nil.
+func (x *MediaFile) GetDownloadTerms() (DownloadTerms, bool) {
+	if x == nil || x.DownloadTerms == nil {
+		var zero DownloadTerms
+		return zero, false
+	}
+	return *x.DownloadTerms, true
+}
+
+// DownloadTermsOrElse returns DownloadTerms, or def if it or x is nil.
+func (x *MediaFile) DownloadTermsOrElse(def DownloadTerms) DownloadTerms {
+	if v, ok := x.GetDownloadTerms(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMediaFileDate returns MediaFileDate and true, or false if it or x is nil.
+func (x *MediaFile) GetMediaFileDate() (PlainText, bool) {
+	if x == nil || x.MediaFileDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MediaFileDate, true
+}
+
+// MediaFileDateOrElse returns MediaFileDate, or def if it or x is nil.
+func (x *MediaFile) MediaFileDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMediaFileDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *MediaFile) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *MediaFile) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *MediaFile) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *MediaFile) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *MediaFile) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *MediaFile) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *MediaFile) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *MediaFile) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MediaFile) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MediaFile) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MediaFile) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MediaFile) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MediaFile) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MediaFile) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *Name) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *Name) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *Name) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *Name) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *Name) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *Name) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *Name) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *Name) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *Name) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *Name) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *Name) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *Name) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *Name) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *Name) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *Name) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *Name) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *Name) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *Name) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *Name) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *Name) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Name) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Name) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Name) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Name) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Name) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Name) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Name) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Name) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Name) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Name) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Name) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Name) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Name) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Name) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierName returns SupplierName and true, or false if it or x is nil.
+func (x *NewSupplier) GetSupplierName() (PlainText, bool) {
+	if x == nil || x.SupplierName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierName, true
+}
+
+// SupplierNameOrElse returns SupplierName, or def if it or x is nil.
+func (x *NewSupplier) SupplierNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierSAN returns SupplierSAN and true, or false if it or x is nil.
+func (x *NewSupplier) GetSupplierSAN() (PlainText, bool) {
+	if x == nil || x.SupplierSAN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierSAN, true
+}
+
+// SupplierSANOrElse returns SupplierSAN, or def if it or x is nil.
+func (x *NewSupplier) SupplierSANOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierSAN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierEANLocationNumber returns SupplierEANLocationNumber and true, or false if it or x is nil.
+func (x *NewSupplier) GetSupplierEANLocationNumber() (PlainText, bool) {
+	if x == nil || x.SupplierEANLocationNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierEANLocationNumber, true
+}
+
+// SupplierEANLocationNumberOrElse returns SupplierEANLocationNumber, or def if it or x is nil.
+func (x *NewSupplier) SupplierEANLocationNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierEANLocationNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *NewSupplier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *NewSupplier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *NewSupplier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *NewSupplier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *NewSupplier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *NewSupplier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *NewSupplier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *NewSupplier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *NewSupplier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *NewSupplier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *NewSupplier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *NewSupplier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *NewSupplier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *NewSupplier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRightsTerritory returns RightsTerritory and true, or false if it or x is nil.
+func (x *NotForSale) GetRightsTerritory() (TerritoryCodeList, bool) {
+	if x == nil || x.RightsTerritory == nil {
+		var zero TerritoryCodeList
+		return zero, false
+	}
+	return *x.RightsTerritory, true
+}
+
+// RightsTerritoryOrElse returns RightsTerritory, or def if it or x is nil.
+func (x *NotForSale) RightsTerritoryOrElse(def TerritoryCodeList) TerritoryCodeList {
+	if v, ok := x.GetRightsTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISBN returns ISBN and true, or false if it or x is nil.
+func (x *NotForSale) GetISBN() (PlainText, bool) {
+	if x == nil || x.ISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISBN, true
+}
+
+// ISBNOrElse returns ISBN, or def if it or x is nil.
+func (x *NotForSale) ISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEAN13 returns EAN13 and true, or false if it or x is nil.
+func (x *NotForSale) GetEAN13() (PlainText, bool) {
+	if x == nil || x.EAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EAN13, true
+}
+
+// EAN13OrElse returns EAN13, or def if it or x is nil.
+func (x *NotForSale) EAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherName returns PublisherName and true, or false if it or x is nil.
+func (x *NotForSale) GetPublisherName() (PlainText, bool) {
+	if x == nil || x.PublisherName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherName, true
+}
+
+// PublisherNameOrElse returns PublisherName, or def if it or x is nil.
+func (x *NotForSale) PublisherNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *NotForSale) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *NotForSale) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *NotForSale) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *NotForSale) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *NotForSale) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *NotForSale) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *NotForSale) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *NotForSale) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *NotForSale) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *NotForSale) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *NotForSale) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *NotForSale) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *NotForSale) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *NotForSale) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetHeader returns Header and true, or false if it or x is nil.
+func (x *ONIXMessage) GetHeader() (Header, bool) {
+	if x == nil || x.Header == nil {
+		var zero Header
+		return zero, false
+	}
+	return *x.Header, true
+}
+
+// HeaderOrElse returns Header, or def if it or x is nil.
+func (x *ONIXMessage) HeaderOrElse(def Header) Header {
+	if v, ok := x.GetHeader(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ONIXMessage) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ONIXMessage) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ONIXMessage) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ONIXMessage) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ONIXMessage) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ONIXMessage) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ONIXMessage) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ONIXMessage) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ONIXMessage) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ONIXMessage) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ONIXMessage) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ONIXMessage) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ONIXMessage) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ONIXMessage) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *OnOrderDetail) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *OnOrderDetail) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *OnOrderDetail) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *OnOrderDetail) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *OnOrderDetail) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *OnOrderDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *OnOrderDetail) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetText returns Text and true, or false if it or x is nil.
+func (x *OtherText) GetText() (Text, bool) {
+	if x == nil || x.Text == nil {
+		var zero Text
+		return zero, false
+	}
+	return *x.Text, true
+}
+
+// TextOrElse returns Text, or def if it or x is nil.
+func (x *OtherText) TextOrElse(def Text) Text {
+	if v, ok := x.GetText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextLinkType returns TextLinkType and true, or false if it or x is nil.
+func (x *OtherText) GetTextLinkType() (TextLinkType, bool) {
+	if x == nil || x.TextLinkType == nil {
+		var zero TextLinkType
+		return zero, false
+	}
+	return *x.TextLinkType, true
+}
+
+// TextLinkTypeOrElse returns TextLinkType, or def if it or x is nil.
+func (x *OtherText) TextLinkTypeOrElse(def TextLinkType) TextLinkType {
+	if v, ok := x.GetTextLinkType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextLink returns TextLink and true, or false if it or x is nil.
+func (x *OtherText) GetTextLink() (PlainText, bool) {
+	if x == nil || x.TextLink == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextLink, true
+}
+
+// TextLinkOrElse returns TextLink, or def if it or x is nil.
+func (x *OtherText) TextLinkOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextLink(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextFormat returns TextFormat and true, or false if it or x is nil.
+func (x *OtherText) GetTextFormat() (TextFormat, bool) {
+	if x == nil || x.TextFormat == nil {
+		var zero TextFormat
+		return zero, false
+	}
+	return *x.TextFormat, true
+}
+
+// TextFormatOrElse returns TextFormat, or def if it or x is nil.
+func (x *OtherText) TextFormatOrElse(def TextFormat) TextFormat {
+	if v, ok := x.GetTextFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextAuthor returns TextAuthor and true, or false if it or x is nil.
+func (x *OtherText) GetTextAuthor() (PlainText, bool) {
+	if x == nil || x.TextAuthor == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextAuthor, true
+}
+
+// TextAuthorOrElse returns TextAuthor, or def if it or x is nil.
+func (x *OtherText) TextAuthorOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextAuthor(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextSourceCorporate returns TextSourceCorporate and true, or false if it or x is nil.
+func (x *OtherText) GetTextSourceCorporate() (PlainText, bool) {
+	if x == nil || x.TextSourceCorporate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextSourceCorporate, true
+}
+
+// TextSourceCorporateOrElse returns TextSourceCorporate, or def if it or x is nil.
+func (x *OtherText) TextSourceCorporateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextSourceCorporate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextSourceTitle returns TextSourceTitle and true, or false if it or x is nil.
+func (x *OtherText) GetTextSourceTitle() (PlainText, bool) {
+	if x == nil || x.TextSourceTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextSourceTitle, true
+}
+
+// TextSourceTitleOrElse returns TextSourceTitle, or def if it or x is nil.
+func (x *OtherText) TextSourceTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextSourceTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextPublicationDate returns TextPublicationDate and true, or false if it or x is nil.
+func (x *OtherText) GetTextPublicationDate() (PlainText, bool) {
+	if x == nil || x.TextPublicationDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextPublicationDate, true
+}
+
+// TextPublicationDateOrElse returns TextPublicationDate, or def if it or x is nil.
+func (x *OtherText) TextPublicationDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextPublicationDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStartDate returns StartDate and true, or false if it or x is nil.
+func (x *OtherText) GetStartDate() (PlainText, bool) {
+	if x == nil || x.StartDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.StartDate, true
+}
+
+// StartDateOrElse returns StartDate, or def if it or x is nil.
+func (x *OtherText) StartDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetStartDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEndDate returns EndDate and true, or false if it or x is nil.
+func (x *OtherText) GetEndDate() (PlainText, bool) {
+	if x == nil || x.EndDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EndDate, true
+}
+
+// EndDateOrElse returns EndDate, or def if it or x is nil.
+func (x *OtherText) EndDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEndDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *OtherText) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *OtherText) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *OtherText) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *OtherText) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *OtherText) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *OtherText) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *OtherText) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *OtherText) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *OtherText) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *OtherText) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *OtherText) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *OtherText) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *OtherText) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *OtherText) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLastPageNumber returns LastPageNumber and true, or false if it or x is nil.
+func (x *PageRun) GetLastPageNumber() (PlainText, bool) {
+	if x == nil || x.LastPageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LastPageNumber, true
+}
+
+// LastPageNumberOrElse returns LastPageNumber, or def if it or x is nil.
+func (x *PageRun) LastPageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLastPageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *PageRun) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *PageRun) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *PageRun) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *PageRun) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *PageRun) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *PageRun) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *PageRun) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *PageRun) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PageRun) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PageRun) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PageRun) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PageRun) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PageRun) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PageRun) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ParentIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ParentIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ParentIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ParentIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ParentIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ParentIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ParentIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ParentIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ParentIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *PersonAsSubject) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *PersonAsSubject) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *PersonAsSubject) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *PersonAsSubject) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *PersonAsSubject) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *PersonAsSubject) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *PersonAsSubject) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *PersonAsSubject) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *PersonAsSubject) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *PersonAsSubject) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *PersonAsSubject) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *PersonAsSubject) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *PersonAsSubject) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *PersonAsSubject) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PersonAsSubject) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PersonAsSubject) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PersonAsSubject) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PersonAsSubject) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *PersonDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *PersonDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *PersonDate) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *PersonDate) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *PersonDate) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *PersonDate) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *PersonDate) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *PersonDate) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *PersonDate) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *PersonDate) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PersonDate) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PersonDate) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PersonDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PersonDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PersonDate) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PersonDate) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *PersonNameIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *PersonNameIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *PersonNameIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *PersonNameIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *PersonNameIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PersonNameIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PersonNameIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PersonNameIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PersonNameIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceTypeCode returns PriceTypeCode and true, or false if it or x is nil.
+func (x *Price) GetPriceTypeCode() (PriceTypeCode, bool) {
+	if x == nil || x.PriceTypeCode == nil {
+		var zero PriceTypeCode
+		return zero, false
+	}
+	return *x.PriceTypeCode, true
+}
+
+// PriceTypeCodeOrElse returns PriceTypeCode, or def if it or x is nil.
+func (x *Price) PriceTypeCodeOrElse(def PriceTypeCode) PriceTypeCode {
+	if v, ok := x.GetPriceTypeCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceQualifier returns PriceQualifier and true, or false if it or x is nil.
+func (x *Price) GetPriceQualifier() (PriceQualifier, bool) {
+	if x == nil || x.PriceQualifier == nil {
+		var zero PriceQualifier
+		return zero, false
+	}
+	return *x.PriceQualifier, true
+}
+
+// PriceQualifierOrElse returns PriceQualifier, or def if it or x is nil.
+func (x *Price) PriceQualifierOrElse(def PriceQualifier) PriceQualifier {
+	if v, ok := x.GetPriceQualifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceTypeDescription returns PriceTypeDescription and true, or false if it or x is nil.
+func (x *Price) GetPriceTypeDescription() (PlainText, bool) {
+	if x == nil || x.PriceTypeDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceTypeDescription, true
+}
+
+// PriceTypeDescriptionOrElse returns PriceTypeDescription, or def if it or x is nil.
+func (x *Price) PriceTypeDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceTypeDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPricePer returns PricePer and true, or false if it or x is nil.
+func (x *Price) GetPricePer() (PricePer, bool) {
+	if x == nil || x.PricePer == nil {
+		var zero PricePer
+		return zero, false
+	}
+	return *x.PricePer, true
+}
+
+// PricePerOrElse returns PricePer, or def if it or x is nil.
+func (x *Price) PricePerOrElse(def PricePer) PricePer {
+	if v, ok := x.GetPricePer(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMinimumOrderQuantity returns MinimumOrderQuantity and true, or false if it or x is nil.
+func (x *Price) GetMinimumOrderQuantity() (PlainText, bool) {
+	if x == nil || x.MinimumOrderQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MinimumOrderQuantity, true
+}
+
+// MinimumOrderQuantityOrElse returns MinimumOrderQuantity, or def if it or x is nil.
+func (x *Price) MinimumOrderQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMinimumOrderQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetClassOfTrade returns ClassOfTrade and true, or false if it or x is nil.
+func (x *Price) GetClassOfTrade() (PlainText, bool) {
+	if x == nil || x.ClassOfTrade == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ClassOfTrade, true
+}
+
+// ClassOfTradeOrElse returns ClassOfTrade, or def if it or x is nil.
+func (x *Price) ClassOfTradeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetClassOfTrade(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBICDiscountGroupCode returns BICDiscountGroupCode and true, or false if it or x is nil.
+func (x *Price) GetBICDiscountGroupCode() (PlainText, bool) {
+	if x == nil || x.BICDiscountGroupCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BICDiscountGroupCode, true
+}
+
+// BICDiscountGroupCodeOrElse returns BICDiscountGroupCode, or def if it or x is nil.
+func (x *Price) BICDiscountGroupCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBICDiscountGroupCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountPercent returns DiscountPercent and true, or false if it or x is nil.
+func (x *Price) GetDiscountPercent() (PlainText, bool) {
+	if x == nil || x.DiscountPercent == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DiscountPercent, true
+}
+
+// DiscountPercentOrElse returns DiscountPercent, or def if it or x is nil.
+func (x *Price) DiscountPercentOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDiscountPercent(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceStatus returns PriceStatus and true, or false if it or x is nil.
+func (x *Price) GetPriceStatus() (PriceStatus, bool) {
+	if x == nil || x.PriceStatus == nil {
+		var zero PriceStatus
+		return zero, false
+	}
+	return *x.PriceStatus, true
+}
+
+// PriceStatusOrElse returns PriceStatus, or def if it or x is nil.
+func (x *Price) PriceStatusOrElse(def PriceStatus) PriceStatus {
+	if v, ok := x.GetPriceStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCurrencyCode returns CurrencyCode and true, or false if it or x is nil.
+func (x *Price) GetCurrencyCode() (CurrencyCode, bool) {
+	if x == nil || x.CurrencyCode == nil {
+		var zero CurrencyCode
+		return zero, false
+	}
+	return *x.CurrencyCode, true
+}
+
+// CurrencyCodeOrElse returns CurrencyCode, or def if it or x is nil.
+func (x *Price) CurrencyCodeOrElse(def CurrencyCode) CurrencyCode {
+	if v, ok := x.GetCurrencyCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceEffectiveFrom returns PriceEffectiveFrom and true, or false if it or x is nil.
+func (x *Price) GetPriceEffectiveFrom() (PlainText, bool) {
+	if x == nil || x.PriceEffectiveFrom == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceEffectiveFrom, true
+}
+
+// PriceEffectiveFromOrElse returns PriceEffectiveFrom, or def if it or x is nil.
+func (x *Price) PriceEffectiveFromOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceEffectiveFrom(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceEffectiveUntil returns PriceEffectiveUntil and true, or false if it or x is nil.
+func (x *Price) GetPriceEffectiveUntil() (PlainText, bool) {
+	if x == nil || x.PriceEffectiveUntil == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceEffectiveUntil, true
+}
+
+// PriceEffectiveUntilOrElse returns PriceEffectiveUntil, or def if it or x is nil.
+func (x *Price) PriceEffectiveUntilOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceEffectiveUntil(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritory returns Territory and true, or false if it or x is nil.
+func (x *Price) GetTerritory() (TerritoryCodeList, bool) {
+	if x == nil || x.Territory == nil {
+		var zero TerritoryCodeList
+		return zero, false
+	}
+	return *x.Territory, true
+}
+
+// TerritoryOrElse returns Territory, or def if it or x is nil.
+func (x *Price) TerritoryOrElse(def TerritoryCodeList) TerritoryCodeList {
+	if v, ok := x.GetTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryExcluded returns CountryExcluded and true, or false if it or x is nil.
+func (x *Price) GetCountryExcluded() (CountryCodeList, bool) {
+	if x == nil || x.CountryExcluded == nil {
+		var zero CountryCodeList
+		return zero, false
+	}
+	return *x.CountryExcluded, true
+}
+
+// CountryExcludedOrElse returns CountryExcluded, or def if it or x is nil.
+func (x *Price) CountryExcludedOrElse(def CountryCodeList) CountryCodeList {
+	if v, ok := x.GetCountryExcluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritoryExcluded returns TerritoryExcluded and true, or false if it or x is nil.
+func (x *Price) GetTerritoryExcluded() (TerritoryCodeList, bool) {
+	if x == nil || x.TerritoryExcluded == nil {
+		var zero TerritoryCodeList
+		return zero, false
+	}
+	return *x.TerritoryExcluded, true
+}
+
+// TerritoryExcludedOrElse returns TerritoryExcluded, or def if it or x is nil.
+func (x *Price) TerritoryExcludedOrElse(def TerritoryCodeList) TerritoryCodeList {
+	if v, ok := x.GetTerritoryExcluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRateCode1 returns TaxRateCode1 and true, or false if it or x is nil.
+func (x *Price) GetTaxRateCode1() (TaxRateCode1, bool) {
+	if x == nil || x.TaxRateCode1 == nil {
+		var zero TaxRateCode1
+		return zero, false
+	}
+	return *x.TaxRateCode1, true
+}
+
+// TaxRateCode1OrElse returns TaxRateCode1, or def if it or x is nil.
+func (x *Price) TaxRateCode1OrElse(def TaxRateCode1) TaxRateCode1 {
+	if v, ok := x.GetTaxRateCode1(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRatePercent1 returns TaxRatePercent1 and true, or false if it or x is nil.
+func (x *Price) GetTaxRatePercent1() (PlainText, bool) {
+	if x == nil || x.TaxRatePercent1 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxRatePercent1, true
+}
+
+// TaxRatePercent1OrElse returns TaxRatePercent1, or def if it or x is nil.
+func (x *Price) TaxRatePercent1OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxRatePercent1(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxableAmount1 returns TaxableAmount1 and true, or false if it or x is nil.
+func (x *Price) GetTaxableAmount1() (PlainText, bool) {
+	if x == nil || x.TaxableAmount1 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxableAmount1, true
+}
+
+// TaxableAmount1OrElse returns TaxableAmount1, or def if it or x is nil.
+func (x *Price) TaxableAmount1OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxableAmount1(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxAmount1 returns TaxAmount1 and true, or false if it or x is nil.
+func (x *Price) GetTaxAmount1() (PlainText, bool) {
+	if x == nil || x.TaxAmount1 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxAmount1, true
+}
+
+// TaxAmount1OrElse returns TaxAmount1, or def if it or x is nil.
+func (x *Price) TaxAmount1OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxAmount1(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRateCode2 returns TaxRateCode2 and true, or false if it or x is nil.
+func (x *Price) GetTaxRateCode2() (TaxRateCode2, bool) {
+	if x == nil || x.TaxRateCode2 == nil {
+		var zero TaxRateCode2
+		return zero, false
+	}
+	return *x.TaxRateCode2, true
+}
+
+// TaxRateCode2OrElse returns TaxRateCode2, or def if it or x is nil.
+func (x *Price) TaxRateCode2OrElse(def TaxRateCode2) TaxRateCode2 {
+	if v, ok := x.GetTaxRateCode2(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRatePercent2 returns TaxRatePercent2 and true, or false if it or x is nil.
+func (x *Price) GetTaxRatePercent2() (PlainText, bool) {
+	if x == nil || x.TaxRatePercent2 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxRatePercent2, true
+}
+
+// TaxRatePercent2OrElse returns TaxRatePercent2, or def if it or x is nil.
+func (x *Price) TaxRatePercent2OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxRatePercent2(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxableAmount2 returns TaxableAmount2 and true, or false if it or x is nil.
+func (x *Price) GetTaxableAmount2() (PlainText, bool) {
+	if x == nil || x.TaxableAmount2 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxableAmount2, true
+}
+
+// TaxableAmount2OrElse returns TaxableAmount2, or def if it or x is nil.
+func (x *Price) TaxableAmount2OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxableAmount2(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxAmount2 returns TaxAmount2 and true, or false if it or x is nil.
+func (x *Price) GetTaxAmount2() (PlainText, bool) {
+	if x == nil || x.TaxAmount2 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxAmount2, true
+}
+
+// TaxAmount2OrElse returns TaxAmount2, or def if it or x is nil.
+func (x *Price) TaxAmount2OrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxAmount2(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Price) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Price) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Price) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Price) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Price) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Price) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Price) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Price) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Price) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Price) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Price) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Price) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Price) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Price) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeYear returns PrizeYear and true, or false if it or x is nil.
+func (x *Prize) GetPrizeYear() (PlainText, bool) {
+	if x == nil || x.PrizeYear == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrizeYear, true
+}
+
+// PrizeYearOrElse returns PrizeYear, or def if it or x is nil.
+func (x *Prize) PrizeYearOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrizeYear(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeCountry returns PrizeCountry and true, or false if it or x is nil.
+func (x *Prize) GetPrizeCountry() (PrizeCountry, bool) {
+	if x == nil || x.PrizeCountry == nil {
+		var zero PrizeCountry
+		return zero, false
+	}
+	return *x.PrizeCountry, true
+}
+
+// PrizeCountryOrElse returns PrizeCountry, or def if it or x is nil.
+func (x *Prize) PrizeCountryOrElse(def PrizeCountry) PrizeCountry {
+	if v, ok := x.GetPrizeCountry(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeCode returns PrizeCode and true, or false if it or x is nil.
+func (x *Prize) GetPrizeCode() (PrizeCode, bool) {
+	if x == nil || x.PrizeCode == nil {
+		var zero PrizeCode
+		return zero, false
+	}
+	return *x.PrizeCode, true
+}
+
+// PrizeCodeOrElse returns PrizeCode, or def if it or x is nil.
+func (x *Prize) PrizeCodeOrElse(def PrizeCode) PrizeCode {
+	if v, ok := x.GetPrizeCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeJury returns PrizeJury and true, or false if it or x is nil.
+func (x *Prize) GetPrizeJury() (PrizeJury, bool) {
+	if x == nil || x.PrizeJury == nil {
+		var zero PrizeJury
+		return zero, false
+	}
+	return *x.PrizeJury, true
+}
+
+// PrizeJuryOrElse returns PrizeJury, or def if it or x is nil.
+func (x *Prize) PrizeJuryOrElse(def PrizeJury) PrizeJury {
+	if v, ok := x.GetPrizeJury(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Prize) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Prize) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Prize) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Prize) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Prize) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Prize) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Prize) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Prize) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Prize) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Prize) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Prize) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Prize) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Prize) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Prize) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDimensions returns Dimensions and true, or false if it or x is nil.
+func (x *Product) GetDimensions() (PlainText, bool) {
+	if x == nil || x.Dimensions == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Dimensions, true
+}
+
+// DimensionsOrElse returns Dimensions, or def if it or x is nil.
+func (x *Product) DimensionsOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDimensions(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWeight returns Weight and true, or false if it or x is nil.
+func (x *Product) GetWeight() (PlainText, bool) {
+	if x == nil || x.Weight == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Weight, true
+}
+
+// WeightOrElse returns Weight, or def if it or x is nil.
+func (x *Product) WeightOrElse(def PlainText) PlainText {
+	if v, ok := x.GetWeight(); ok {
+		return v
+	}
+	return def
+}
+
+// GetHeight returns Height and true, or false if it or x is nil.
+func (x *Product) GetHeight() (PlainText, bool) {
+	if x == nil || x.Height == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Height, true
+}
+
+// HeightOrElse returns Height, or def if it or x is nil.
+func (x *Product) HeightOrElse(def PlainText) PlainText {
+	if v, ok := x.GetHeight(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWidth returns Width and true, or false if it or x is nil.
+func (x *Product) GetWidth() (PlainText, bool) {
+	if x == nil || x.Width == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Width, true
+}
+
+// WidthOrElse returns Width, or def if it or x is nil.
+func (x *Product) WidthOrElse(def PlainText) PlainText {
+	if v, ok := x.GetWidth(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThickness returns Thickness and true, or false if it or x is nil.
+func (x *Product) GetThickness() (PlainText, bool) {
+	if x == nil || x.Thickness == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Thickness, true
+}
+
+// ThicknessOrElse returns Thickness, or def if it or x is nil.
+func (x *Product) ThicknessOrElse(def PlainText) PlainText {
+	if v, ok := x.GetThickness(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionCode returns DeletionCode and true, or false if it or x is nil.
+func (x *Product) GetDeletionCode() (DeletionCode, bool) {
+	if x == nil || x.DeletionCode == nil {
+		var zero DeletionCode
+		return zero, false
+	}
+	return *x.DeletionCode, true
+}
+
+// DeletionCodeOrElse returns DeletionCode, or def if it or x is nil.
+func (x *Product) DeletionCodeOrElse(def DeletionCode) DeletionCode {
+	if v, ok := x.GetDeletionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionText returns DeletionText and true, or false if it or x is nil.
+func (x *Product) GetDeletionText() (PlainText, bool) {
+	if x == nil || x.DeletionText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DeletionText, true
+}
+
+// DeletionTextOrElse returns DeletionText, or def if it or x is nil.
+func (x *Product) DeletionTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDeletionText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceType returns RecordSourceType and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceType() (RecordSourceType, bool) {
+	if x == nil || x.RecordSourceType == nil {
+		var zero RecordSourceType
+		return zero, false
+	}
+	return *x.RecordSourceType, true
+}
+
+// RecordSourceTypeOrElse returns RecordSourceType, or def if it or x is nil.
+func (x *Product) RecordSourceTypeOrElse(def RecordSourceType) RecordSourceType {
+	if v, ok := x.GetRecordSourceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceName returns RecordSourceName and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceName() (PlainText, bool) {
+	if x == nil || x.RecordSourceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceName, true
+}
+
+// RecordSourceNameOrElse returns RecordSourceName, or def if it or x is nil.
+func (x *Product) RecordSourceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReplacedByISBN returns ReplacedByISBN and true, or false if it or x is nil.
+func (x *Product) GetReplacedByISBN() (PlainText, bool) {
+	if x == nil || x.ReplacedByISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReplacedByISBN, true
+}
+
+// ReplacedByISBNOrElse returns ReplacedByISBN, or def if it or x is nil.
+func (x *Product) ReplacedByISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReplacedByISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReplacedByEAN13 returns ReplacedByEAN13 and true, or false if it or x is nil.
+func (x *Product) GetReplacedByEAN13() (PlainText, bool) {
+	if x == nil || x.ReplacedByEAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReplacedByEAN13, true
+}
+
+// ReplacedByEAN13OrElse returns ReplacedByEAN13, or def if it or x is nil.
+func (x *Product) ReplacedByEAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetReplacedByEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAlternativeFormatISBN returns AlternativeFormatISBN and true, or false if it or x is nil.
+func (x *Product) GetAlternativeFormatISBN() (PlainText, bool) {
+	if x == nil || x.AlternativeFormatISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AlternativeFormatISBN, true
+}
+
+// AlternativeFormatISBNOrElse returns AlternativeFormatISBN, or def if it or x is nil.
+func (x *Product) AlternativeFormatISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAlternativeFormatISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAlternativeFormatEAN13 returns AlternativeFormatEAN13 and true, or false if it or x is nil.
+func (x *Product) GetAlternativeFormatEAN13() (PlainText, bool) {
+	if x == nil || x.AlternativeFormatEAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AlternativeFormatEAN13, true
+}
+
+// AlternativeFormatEAN13OrElse returns AlternativeFormatEAN13, or def if it or x is nil.
+func (x *Product) AlternativeFormatEAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetAlternativeFormatEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAlternativeProductISBN returns AlternativeProductISBN and true, or false if it or x is nil.
+func (x *Product) GetAlternativeProductISBN() (PlainText, bool) {
+	if x == nil || x.AlternativeProductISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AlternativeProductISBN, true
+}
+
+// AlternativeProductISBNOrElse returns AlternativeProductISBN, or def if it or x is nil.
+func (x *Product) AlternativeProductISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAlternativeProductISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAlternativeProductEAN13 returns AlternativeProductEAN13 and true, or false if it or x is nil.
+func (x *Product) GetAlternativeProductEAN13() (PlainText, bool) {
+	if x == nil || x.AlternativeProductEAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AlternativeProductEAN13, true
+}
+
+// AlternativeProductEAN13OrElse returns AlternativeProductEAN13, or def if it or x is nil.
+func (x *Product) AlternativeProductEAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetAlternativeProductEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOutOfPrintDate returns OutOfPrintDate and true, or false if it or x is nil.
+func (x *Product) GetOutOfPrintDate() (PlainText, bool) {
+	if x == nil || x.OutOfPrintDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OutOfPrintDate, true
+}
+
+// OutOfPrintDateOrElse returns OutOfPrintDate, or def if it or x is nil.
+func (x *Product) OutOfPrintDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOutOfPrintDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPromotionCampaign returns PromotionCampaign and true, or false if it or x is nil.
+func (x *Product) GetPromotionCampaign() (PlainText, bool) {
+	if x == nil || x.PromotionCampaign == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PromotionCampaign, true
+}
+
+// PromotionCampaignOrElse returns PromotionCampaign, or def if it or x is nil.
+func (x *Product) PromotionCampaignOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPromotionCampaign(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPromotionContact returns PromotionContact and true, or false if it or x is nil.
+func (x *Product) GetPromotionContact() (PlainText, bool) {
+	if x == nil || x.PromotionContact == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PromotionContact, true
+}
+
+// PromotionContactOrElse returns PromotionContact, or def if it or x is nil.
+func (x *Product) PromotionContactOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPromotionContact(); ok {
+		return v
+	}
+	return def
+}
+
+// GetInitialPrintRun returns InitialPrintRun and true, or false if it or x is nil.
+func (x *Product) GetInitialPrintRun() (PlainText, bool) {
+	if x == nil || x.InitialPrintRun == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.InitialPrintRun, true
+}
+
+// InitialPrintRunOrElse returns InitialPrintRun, or def if it or x is nil.
+func (x *Product) InitialPrintRunOrElse(def PlainText) PlainText {
+	if v, ok := x.GetInitialPrintRun(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCopiesSold returns CopiesSold and true, or false if it or x is nil.
+func (x *Product) GetCopiesSold() (PlainText, bool) {
+	if x == nil || x.CopiesSold == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CopiesSold, true
+}
+
+// CopiesSoldOrElse returns CopiesSold, or def if it or x is nil.
+func (x *Product) CopiesSoldOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCopiesSold(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBookClubAdoption returns BookClubAdoption and true, or false if it or x is nil.
+func (x *Product) GetBookClubAdoption() (PlainText, bool) {
+	if x == nil || x.BookClubAdoption == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BookClubAdoption, true
+}
+
+// BookClubAdoptionOrElse returns BookClubAdoption, or def if it or x is nil.
+func (x *Product) BookClubAdoptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBookClubAdoption(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifierType returns RecordSourceIdentifierType and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceIdentifierType() (RecordSourceIdentifierType, bool) {
+	if x == nil || x.RecordSourceIdentifierType == nil {
+		var zero RecordSourceIdentifierType
+		return zero, false
+	}
+	return *x.RecordSourceIdentifierType, true
+}
+
+// RecordSourceIdentifierTypeOrElse returns RecordSourceIdentifierType, or def if it or x is nil.
+func (x *Product) RecordSourceIdentifierTypeOrElse(def RecordSourceIdentifierType) RecordSourceIdentifierType {
+	if v, ok := x.GetRecordSourceIdentifierType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifier returns RecordSourceIdentifier and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceIdentifier() (PlainText, bool) {
+	if x == nil || x.RecordSourceIdentifier == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceIdentifier, true
+}
+
+// RecordSourceIdentifierOrElse returns RecordSourceIdentifier, or def if it or x is nil.
+func (x *Product) RecordSourceIdentifierOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISBN returns ISBN and true, or false if it or x is nil.
+func (x *Product) GetISBN() (PlainText, bool) {
+	if x == nil || x.ISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISBN, true
+}
+
+// ISBNOrElse returns ISBN, or def if it or x is nil.
+func (x *Product) ISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEAN13 returns EAN13 and true, or false if it or x is nil.
+func (x *Product) GetEAN13() (PlainText, bool) {
+	if x == nil || x.EAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EAN13, true
+}
+
+// EAN13OrElse returns EAN13, or def if it or x is nil.
+func (x *Product) EAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUPC returns UPC and true, or false if it or x is nil.
+func (x *Product) GetUPC() (PlainText, bool) {
+	if x == nil || x.UPC == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.UPC, true
+}
+
+// UPCOrElse returns UPC, or def if it or x is nil.
+func (x *Product) UPCOrElse(def PlainText) PlainText {
+	if v, ok := x.GetUPC(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherProductNo returns PublisherProductNo and true, or false if it or x is nil.
+func (x *Product) GetPublisherProductNo() (PlainText, bool) {
+	if x == nil || x.PublisherProductNo == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherProductNo, true
+}
+
+// PublisherProductNoOrElse returns PublisherProductNo, or def if it or x is nil.
+func (x *Product) PublisherProductNoOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherProductNo(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISMN returns ISMN and true, or false if it or x is nil.
+func (x *Product) GetISMN() (PlainText, bool) {
+	if x == nil || x.ISMN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISMN, true
+}
+
+// ISMNOrElse returns ISMN, or def if it or x is nil.
+func (x *Product) ISMNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISMN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDOI returns DOI and true, or false if it or x is nil.
+func (x *Product) GetDOI() (PlainText, bool) {
+	if x == nil || x.DOI == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DOI, true
+}
+
+// DOIOrElse returns DOI, or def if it or x is nil.
+func (x *Product) DOIOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDOI(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDistinctiveTitle returns DistinctiveTitle and true, or false if it or x is nil.
+func (x *Product) GetDistinctiveTitle() (PlainText, bool) {
+	if x == nil || x.DistinctiveTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DistinctiveTitle, true
+}
+
+// DistinctiveTitleOrElse returns DistinctiveTitle, or def if it or x is nil.
+func (x *Product) DistinctiveTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDistinctiveTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlePrefix returns TitlePrefix and true, or false if it or x is nil.
+func (x *Product) GetTitlePrefix() (PlainText, bool) {
+	if x == nil || x.TitlePrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlePrefix, true
+}
+
+// TitlePrefixOrElse returns TitlePrefix, or def if it or x is nil.
+func (x *Product) TitlePrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlePrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleWithoutPrefix returns TitleWithoutPrefix and true, or false if it or x is nil.
+func (x *Product) GetTitleWithoutPrefix() (PlainText, bool) {
+	if x == nil || x.TitleWithoutPrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleWithoutPrefix, true
+}
+
+// TitleWithoutPrefixOrElse returns TitleWithoutPrefix, or def if it or x is nil.
+func (x *Product) TitleWithoutPrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleWithoutPrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubtitle returns Subtitle and true, or false if it or x is nil.
+func (x *Product) GetSubtitle() (PlainText, bool) {
+	if x == nil || x.Subtitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Subtitle, true
+}
+
+// SubtitleOrElse returns Subtitle, or def if it or x is nil.
+func (x *Product) SubtitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubtitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTranslationOfTitle returns TranslationOfTitle and true, or false if it or x is nil.
+func (x *Product) GetTranslationOfTitle() (PlainText, bool) {
+	if x == nil || x.TranslationOfTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TranslationOfTitle, true
+}
+
+// TranslationOfTitleOrElse returns TranslationOfTitle, or def if it or x is nil.
+func (x *Product) TranslationOfTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTranslationOfTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContributorStatement returns ContributorStatement and true, or false if it or x is nil.
+func (x *Product) GetContributorStatement() (PlainText, bool) {
+	if x == nil || x.ContributorStatement == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContributorStatement, true
+}
+
+// ContributorStatementOrElse returns ContributorStatement, or def if it or x is nil.
+func (x *Product) ContributorStatementOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContributorStatement(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceDescription returns ConferenceDescription and true, or false if it or x is nil.
+func (x *Product) GetConferenceDescription() (PlainText, bool) {
+	if x == nil || x.ConferenceDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceDescription, true
+}
+
+// ConferenceDescriptionOrElse returns ConferenceDescription, or def if it or x is nil.
+func (x *Product) ConferenceDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceRole returns ConferenceRole and true, or false if it or x is nil.
+func (x *Product) GetConferenceRole() (ConferenceRole, bool) {
+	if x == nil || x.ConferenceRole == nil {
+		var zero ConferenceRole
+		return zero, false
+	}
+	return *x.ConferenceRole, true
+}
+
+// ConferenceRoleOrElse returns ConferenceRole, or def if it or x is nil.
+func (x *Product) ConferenceRoleOrElse(def ConferenceRole) ConferenceRole {
+	if v, ok := x.GetConferenceRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceName returns ConferenceName and true, or false if it or x is nil.
+func (x *Product) GetConferenceName() (PlainText, bool) {
+	if x == nil || x.ConferenceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceName, true
+}
+
+// ConferenceNameOrElse returns ConferenceName, or def if it or x is nil.
+func (x *Product) ConferenceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceNumber returns ConferenceNumber and true, or false if it or x is nil.
+func (x *Product) GetConferenceNumber() (PlainText, bool) {
+	if x == nil || x.ConferenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceNumber, true
+}
+
+// ConferenceNumberOrElse returns ConferenceNumber, or def if it or x is nil.
+func (x *Product) ConferenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceDate returns ConferenceDate and true, or false if it or x is nil.
+func (x *Product) GetConferenceDate() (PlainText, bool) {
+	if x == nil || x.ConferenceDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceDate, true
+}
+
+// ConferenceDateOrElse returns ConferenceDate, or def if it or x is nil.
+func (x *Product) ConferenceDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferencePlace returns ConferencePlace and true, or false if it or x is nil.
+func (x *Product) GetConferencePlace() (PlainText, bool) {
+	if x == nil || x.ConferencePlace == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferencePlace, true
+}
+
+// ConferencePlaceOrElse returns ConferencePlace, or def if it or x is nil.
+func (x *Product) ConferencePlaceOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferencePlace(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEditionNumber returns EditionNumber and true, or false if it or x is nil.
+func (x *Product) GetEditionNumber() (PlainText, bool) {
+	if x == nil || x.EditionNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EditionNumber, true
+}
+
+// EditionNumberOrElse returns EditionNumber, or def if it or x is nil.
+func (x *Product) EditionNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEditionNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEditionVersionNumber returns EditionVersionNumber and true, or false if it or x is nil.
+func (x *Product) GetEditionVersionNumber() (PlainText, bool) {
+	if x == nil || x.EditionVersionNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EditionVersionNumber, true
+}
+
+// EditionVersionNumberOrElse returns EditionVersionNumber, or def if it or x is nil.
+func (x *Product) EditionVersionNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEditionVersionNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEditionStatement returns EditionStatement and true, or false if it or x is nil.
+func (x *Product) GetEditionStatement() (PlainText, bool) {
+	if x == nil || x.EditionStatement == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EditionStatement, true
+}
+
+// EditionStatementOrElse returns EditionStatement, or def if it or x is nil.
+func (x *Product) EditionStatementOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEditionStatement(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizesDescription returns PrizesDescription and true, or false if it or x is nil.
+func (x *Product) GetPrizesDescription() (PlainText, bool) {
+	if x == nil || x.PrizesDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrizesDescription, true
+}
+
+// PrizesDescriptionOrElse returns PrizesDescription, or def if it or x is nil.
+func (x *Product) PrizesDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrizesDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetImprintName returns ImprintName and true, or false if it or x is nil.
+func (x *Product) GetImprintName() (PlainText, bool) {
+	if x == nil || x.ImprintName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ImprintName, true
+}
+
+// ImprintNameOrElse returns ImprintName, or def if it or x is nil.
+func (x *Product) ImprintNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetImprintName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherName returns PublisherName and true, or false if it or x is nil.
+func (x *Product) GetPublisherName() (PlainText, bool) {
+	if x == nil || x.PublisherName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherName, true
+}
+
+// PublisherNameOrElse returns PublisherName, or def if it or x is nil.
+func (x *Product) PublisherNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCopyrightYear returns CopyrightYear and true, or false if it or x is nil.
+func (x *Product) GetCopyrightYear() (PlainText, bool) {
+	if x == nil || x.CopyrightYear == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CopyrightYear, true
+}
+
+// CopyrightYearOrElse returns CopyrightYear, or def if it or x is nil.
+func (x *Product) CopyrightYearOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCopyrightYear(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReplacesISBN returns ReplacesISBN and true, or false if it or x is nil.
+func (x *Product) GetReplacesISBN() (PlainText, bool) {
+	if x == nil || x.ReplacesISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReplacesISBN, true
+}
+
+// ReplacesISBNOrElse returns ReplacesISBN, or def if it or x is nil.
+func (x *Product) ReplacesISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReplacesISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReplacesEAN13 returns ReplacesEAN13 and true, or false if it or x is nil.
+func (x *Product) GetReplacesEAN13() (PlainText, bool) {
+	if x == nil || x.ReplacesEAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReplacesEAN13, true
+}
+
+// ReplacesEAN13OrElse returns ReplacesEAN13, or def if it or x is nil.
+func (x *Product) ReplacesEAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetReplacesEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductForm returns ProductForm and true, or false if it or x is nil.
+func (x *Product) GetProductForm() (ProductForm, bool) {
+	if x == nil || x.ProductForm == nil {
+		var zero ProductForm
+		return zero, false
+	}
+	return *x.ProductForm, true
+}
+
+// ProductFormOrElse returns ProductForm, or def if it or x is nil.
+func (x *Product) ProductFormOrElse(def ProductForm) ProductForm {
+	if v, ok := x.GetProductForm(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductPackaging returns ProductPackaging and true, or false if it or x is nil.
+func (x *Product) GetProductPackaging() (ProductPackaging, bool) {
+	if x == nil || x.ProductPackaging == nil {
+		var zero ProductPackaging
+		return zero, false
+	}
+	return *x.ProductPackaging, true
+}
+
+// ProductPackagingOrElse returns ProductPackaging, or def if it or x is nil.
+func (x *Product) ProductPackagingOrElse(def ProductPackaging) ProductPackaging {
+	if v, ok := x.GetProductPackaging(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormDescription returns ProductFormDescription and true, or false if it or x is nil.
+func (x *Product) GetProductFormDescription() (PlainText, bool) {
+	if x == nil || x.ProductFormDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormDescription, true
+}
+
+// ProductFormDescriptionOrElse returns ProductFormDescription, or def if it or x is nil.
+func (x *Product) ProductFormDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPieces returns NumberOfPieces and true, or false if it or x is nil.
+func (x *Product) GetNumberOfPieces() (PlainText, bool) {
+	if x == nil || x.NumberOfPieces == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPieces, true
+}
+
+// NumberOfPiecesOrElse returns NumberOfPieces, or def if it or x is nil.
+func (x *Product) NumberOfPiecesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPieces(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTradeCategory returns TradeCategory and true, or false if it or x is nil.
+func (x *Product) GetTradeCategory() (TradeCategory, bool) {
+	if x == nil || x.TradeCategory == nil {
+		var zero TradeCategory
+		return zero, false
+	}
+	return *x.TradeCategory, true
+}
+
+// TradeCategoryOrElse returns TradeCategory, or def if it or x is nil.
+func (x *Product) TradeCategoryOrElse(def TradeCategory) TradeCategory {
+	if v, ok := x.GetTradeCategory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextCaseFlag returns TextCaseFlag and true, or false if it or x is nil.
+func (x *Product) GetTextCaseFlag() (TextCaseFlag, bool) {
+	if x == nil || x.TextCaseFlag == nil {
+		var zero TextCaseFlag
+		return zero, false
+	}
+	return *x.TextCaseFlag, true
+}
+
+// TextCaseFlagOrElse returns TextCaseFlag, or def if it or x is nil.
+func (x *Product) TextCaseFlagOrElse(def TextCaseFlag) TextCaseFlag {
+	if v, ok := x.GetTextCaseFlag(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReligiousText returns ReligiousText and true, or false if it or x is nil.
+func (x *Product) GetReligiousText() (ReligiousText, bool) {
+	if x == nil || x.ReligiousText == nil {
+		var zero ReligiousText
+		return zero, false
+	}
+	return *x.ReligiousText, true
+}
+
+// ReligiousTextOrElse returns ReligiousText, or def if it or x is nil.
+func (x *Product) ReligiousTextOrElse(def ReligiousText) ReligiousText {
+	if v, ok := x.GetReligiousText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOriginalLanguage returns OriginalLanguage and true, or false if it or x is nil.
+func (x *Product) GetOriginalLanguage() (OriginalLanguage, bool) {
+	if x == nil || x.OriginalLanguage == nil {
+		var zero OriginalLanguage
+		return zero, false
+	}
+	return *x.OriginalLanguage, true
+}
+
+// OriginalLanguageOrElse returns OriginalLanguage, or def if it or x is nil.
+func (x *Product) OriginalLanguageOrElse(def OriginalLanguage) OriginalLanguage {
+	if v, ok := x.GetOriginalLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPages returns NumberOfPages and true, or false if it or x is nil.
+func (x *Product) GetNumberOfPages() (PlainText, bool) {
+	if x == nil || x.NumberOfPages == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPages, true
+}
+
+// NumberOfPagesOrElse returns NumberOfPages, or def if it or x is nil.
+func (x *Product) NumberOfPagesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPages(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPagesRoman returns PagesRoman and true, or false if it or x is nil.
+func (x *Product) GetPagesRoman() (PlainText, bool) {
+	if x == nil || x.PagesRoman == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PagesRoman, true
+}
+
+// PagesRomanOrElse returns PagesRoman, or def if it or x is nil.
+func (x *Product) PagesRomanOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPagesRoman(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPagesArabic returns PagesArabic and true, or false if it or x is nil.
+func (x *Product) GetPagesArabic() (PlainText, bool) {
+	if x == nil || x.PagesArabic == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PagesArabic, true
+}
+
+// PagesArabicOrElse returns PagesArabic, or def if it or x is nil.
+func (x *Product) PagesArabicOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPagesArabic(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfIllustrations returns NumberOfIllustrations and true, or false if it or x is nil.
+func (x *Product) GetNumberOfIllustrations() (PlainText, bool) {
+	if x == nil || x.NumberOfIllustrations == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfIllustrations, true
+}
+
+// NumberOfIllustrationsOrElse returns NumberOfIllustrations, or def if it or x is nil.
+func (x *Product) NumberOfIllustrationsOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfIllustrations(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIllustrationsNote returns IllustrationsNote and true, or false if it or x is nil.
+func (x *Product) GetIllustrationsNote() (PlainText, bool) {
+	if x == nil || x.IllustrationsNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IllustrationsNote, true
+}
+
+// IllustrationsNoteOrElse returns IllustrationsNote, or def if it or x is nil.
+func (x *Product) IllustrationsNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIllustrationsNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUSSchoolGrade returns USSchoolGrade and true, or false if it or x is nil.
+func (x *Product) GetUSSchoolGrade() (PlainText, bool) {
+	if x == nil || x.USSchoolGrade == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.USSchoolGrade, true
+}
+
+// USSchoolGradeOrElse returns USSchoolGrade, or def if it or x is nil.
+func (x *Product) USSchoolGradeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetUSSchoolGrade(); ok {
+		return v
+	}
+	return def
+}
+
+// GetInterestAge returns InterestAge and true, or false if it or x is nil.
+func (x *Product) GetInterestAge() (PlainText, bool) {
+	if x == nil || x.InterestAge == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.InterestAge, true
+}
+
+// InterestAgeOrElse returns InterestAge, or def if it or x is nil.
+func (x *Product) InterestAgeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetInterestAge(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceDescription returns AudienceDescription and true, or false if it or x is nil.
+func (x *Product) GetAudienceDescription() (PlainText, bool) {
+	if x == nil || x.AudienceDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceDescription, true
+}
+
+// AudienceDescriptionOrElse returns AudienceDescription, or def if it or x is nil.
+func (x *Product) AudienceDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAnnotation returns Annotation and true, or false if it or x is nil.
+func (x *Product) GetAnnotation() (Annotation, bool) {
+	if x == nil || x.Annotation == nil {
+		var zero Annotation
+		return zero, false
+	}
+	return *x.Annotation, true
+}
+
+// AnnotationOrElse returns Annotation, or def if it or x is nil.
+func (x *Product) AnnotationOrElse(def Annotation) Annotation {
+	if v, ok := x.GetAnnotation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMainDescription returns MainDescription and true, or false if it or x is nil.
+func (x *Product) GetMainDescription() (MainDescription, bool) {
+	if x == nil || x.MainDescription == nil {
+		var zero MainDescription
+		return zero, false
+	}
+	return *x.MainDescription, true
+}
+
+// MainDescriptionOrElse returns MainDescription, or def if it or x is nil.
+func (x *Product) MainDescriptionOrElse(def MainDescription) MainDescription {
+	if v, ok := x.GetMainDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryOfPublication returns CountryOfPublication and true, or false if it or x is nil.
+func (x *Product) GetCountryOfPublication() (CountryOfPublication, bool) {
+	if x == nil || x.CountryOfPublication == nil {
+		var zero CountryOfPublication
+		return zero, false
+	}
+	return *x.CountryOfPublication, true
+}
+
+// CountryOfPublicationOrElse returns CountryOfPublication, or def if it or x is nil.
+func (x *Product) CountryOfPublicationOrElse(def CountryOfPublication) CountryOfPublication {
+	if v, ok := x.GetCountryOfPublication(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOriginalPublisher returns OriginalPublisher and true, or false if it or x is nil.
+func (x *Product) GetOriginalPublisher() (PlainText, bool) {
+	if x == nil || x.OriginalPublisher == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OriginalPublisher, true
+}
+
+// OriginalPublisherOrElse returns OriginalPublisher, or def if it or x is nil.
+func (x *Product) OriginalPublisherOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOriginalPublisher(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAnnouncementDate returns AnnouncementDate and true, or false if it or x is nil.
+func (x *Product) GetAnnouncementDate() (PlainText, bool) {
+	if x == nil || x.AnnouncementDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AnnouncementDate, true
+}
+
+// AnnouncementDateOrElse returns AnnouncementDate, or def if it or x is nil.
+func (x *Product) AnnouncementDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAnnouncementDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTradeAnnouncementDate returns TradeAnnouncementDate and true, or false if it or x is nil.
+func (x *Product) GetTradeAnnouncementDate() (PlainText, bool) {
+	if x == nil || x.TradeAnnouncementDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TradeAnnouncementDate, true
+}
+
+// TradeAnnouncementDateOrElse returns TradeAnnouncementDate, or def if it or x is nil.
+func (x *Product) TradeAnnouncementDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTradeAnnouncementDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublicationDate returns PublicationDate and true, or false if it or x is nil.
+func (x *Product) GetPublicationDate() (PlainText, bool) {
+	if x == nil || x.PublicationDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublicationDate, true
+}
+
+// PublicationDateOrElse returns PublicationDate, or def if it or x is nil.
+func (x *Product) PublicationDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublicationDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetYearFirstPublished returns YearFirstPublished and true, or false if it or x is nil.
+func (x *Product) GetYearFirstPublished() (PlainText, bool) {
+	if x == nil || x.YearFirstPublished == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.YearFirstPublished, true
+}
+
+// YearFirstPublishedOrElse returns YearFirstPublished, or def if it or x is nil.
+func (x *Product) YearFirstPublishedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetYearFirstPublished(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubType returns EpubType and true, or false if it or x is nil.
+func (x *Product) GetEpubType() (EpubType, bool) {
+	if x == nil || x.EpubType == nil {
+		var zero EpubType
+		return zero, false
+	}
+	return *x.EpubType, true
+}
+
+// EpubTypeOrElse returns EpubType, or def if it or x is nil.
+func (x *Product) EpubTypeOrElse(def EpubType) EpubType {
+	if v, ok := x.GetEpubType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeVersion returns EpubTypeVersion and true, or false if it or x is nil.
+func (x *Product) GetEpubTypeVersion() (PlainText, bool) {
+	if x == nil || x.EpubTypeVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeVersion, true
+}
+
+// EpubTypeVersionOrElse returns EpubTypeVersion, or def if it or x is nil.
+func (x *Product) EpubTypeVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeDescription returns EpubTypeDescription and true, or false if it or x is nil.
+func (x *Product) GetEpubTypeDescription() (PlainText, bool) {
+	if x == nil || x.EpubTypeDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeDescription, true
+}
+
+// EpubTypeDescriptionOrElse returns EpubTypeDescription, or def if it or x is nil.
+func (x *Product) EpubTypeDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormatDescription returns EpubFormatDescription and true, or false if it or x is nil.
+func (x *Product) GetEpubFormatDescription() (PlainText, bool) {
+	if x == nil || x.EpubFormatDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubFormatDescription, true
+}
+
+// EpubFormatDescriptionOrElse returns EpubFormatDescription, or def if it or x is nil.
+func (x *Product) EpubFormatDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubFormatDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubSourceDescription returns EpubSourceDescription and true, or false if it or x is nil.
+func (x *Product) GetEpubSourceDescription() (PlainText, bool) {
+	if x == nil || x.EpubSourceDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubSourceDescription, true
+}
+
+// EpubSourceDescriptionOrElse returns EpubSourceDescription, or def if it or x is nil.
+func (x *Product) EpubSourceDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubSourceDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeNote returns EpubTypeNote and true, or false if it or x is nil.
+func (x *Product) GetEpubTypeNote() (PlainText, bool) {
+	if x == nil || x.EpubTypeNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeNote, true
+}
+
+// EpubTypeNoteOrElse returns EpubTypeNote, or def if it or x is nil.
+func (x *Product) EpubTypeNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormat returns EpubFormat and true, or false if it or x is nil.
+func (x *Product) GetEpubFormat() (EpubFormat, bool) {
+	if x == nil || x.EpubFormat == nil {
+		var zero EpubFormat
+		return zero, false
+	}
+	return *x.EpubFormat, true
+}
+
+// EpubFormatOrElse returns EpubFormat, or def if it or x is nil.
+func (x *Product) EpubFormatOrElse(def EpubFormat) EpubFormat {
+	if v, ok := x.GetEpubFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormatVersion returns EpubFormatVersion and true, or false if it or x is nil.
+func (x *Product) GetEpubFormatVersion() (PlainText, bool) {
+	if x == nil || x.EpubFormatVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubFormatVersion, true
+}
+
+// EpubFormatVersionOrElse returns EpubFormatVersion, or def if it or x is nil.
+func (x *Product) EpubFormatVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubFormatVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubSource returns EpubSource and true, or false if it or x is nil.
+func (x *Product) GetEpubSource() (EpubSource, bool) {
+	if x == nil || x.EpubSource == nil {
+		var zero EpubSource
+		return zero, false
+	}
+	return *x.EpubSource, true
+}
+
+// EpubSourceOrElse returns EpubSource, or def if it or x is nil.
+func (x *Product) EpubSourceOrElse(def EpubSource) EpubSource {
+	if v, ok := x.GetEpubSource(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubSourceVersion returns EpubSourceVersion and true, or false if it or x is nil.
+func (x *Product) GetEpubSourceVersion() (PlainText, bool) {
+	if x == nil || x.EpubSourceVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubSourceVersion, true
+}
+
+// EpubSourceVersionOrElse returns EpubSourceVersion, or def if it or x is nil.
+func (x *Product) EpubSourceVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubSourceVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisType returns ThesisType and true, or false if it or x is nil.
+func (x *Product) GetThesisType() (ThesisType, bool) {
+	if x == nil || x.ThesisType == nil {
+		var zero ThesisType
+		return zero, false
+	}
+	return *x.ThesisType, true
+}
+
+// ThesisTypeOrElse returns ThesisType, or def if it or x is nil.
+func (x *Product) ThesisTypeOrElse(def ThesisType) ThesisType {
+	if v, ok := x.GetThesisType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisPresentedTo returns ThesisPresentedTo and true, or false if it or x is nil.
+func (x *Product) GetThesisPresentedTo() (PlainText, bool) {
+	if x == nil || x.ThesisPresentedTo == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ThesisPresentedTo, true
+}
+
+// ThesisPresentedToOrElse returns ThesisPresentedTo, or def if it or x is nil.
+func (x *Product) ThesisPresentedToOrElse(def PlainText) PlainText {
+	if v, ok := x.GetThesisPresentedTo(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisYear returns ThesisYear and true, or false if it or x is nil.
+func (x *Product) GetThesisYear() (PlainText, bool) {
+	if x == nil || x.ThesisYear == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ThesisYear, true
+}
+
+// ThesisYearOrElse returns ThesisYear, or def if it or x is nil.
+func (x *Product) ThesisYearOrElse(def PlainText) PlainText {
+	if v, ok := x.GetThesisYear(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBASICMainSubject returns BASICMainSubject and true, or false if it or x is nil.
+func (x *Product) GetBASICMainSubject() (PlainText, bool) {
+	if x == nil || x.BASICMainSubject == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BASICMainSubject, true
+}
+
+// BASICMainSubjectOrElse returns BASICMainSubject, or def if it or x is nil.
+func (x *Product) BASICMainSubjectOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBASICMainSubject(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBASICVersion returns BASICVersion and true, or false if it or x is nil.
+func (x *Product) GetBASICVersion() (PlainText, bool) {
+	if x == nil || x.BASICVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BASICVersion, true
+}
+
+// BASICVersionOrElse returns BASICVersion, or def if it or x is nil.
+func (x *Product) BASICVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBASICVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBICMainSubject returns BICMainSubject and true, or false if it or x is nil.
+func (x *Product) GetBICMainSubject() (PlainText, bool) {
+	if x == nil || x.BICMainSubject == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BICMainSubject, true
+}
+
+// BICMainSubjectOrElse returns BICMainSubject, or def if it or x is nil.
+func (x *Product) BICMainSubjectOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBICMainSubject(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBICVersion returns BICVersion and true, or false if it or x is nil.
+func (x *Product) GetBICVersion() (PlainText, bool) {
+	if x == nil || x.BICVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.BICVersion, true
+}
+
+// BICVersionOrElse returns BICVersion, or def if it or x is nil.
+func (x *Product) BICVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetBICVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCoverImageFormatCode returns CoverImageFormatCode and true, or false if it or x is nil.
+func (x *Product) GetCoverImageFormatCode() (CoverImageFormatCode, bool) {
+	if x == nil || x.CoverImageFormatCode == nil {
+		var zero CoverImageFormatCode
+		return zero, false
+	}
+	return *x.CoverImageFormatCode, true
+}
+
+// CoverImageFormatCodeOrElse returns CoverImageFormatCode, or def if it or x is nil.
+func (x *Product) CoverImageFormatCodeOrElse(def CoverImageFormatCode) CoverImageFormatCode {
+	if v, ok := x.GetCoverImageFormatCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCoverImageLinkTypeCode returns CoverImageLinkTypeCode and true, or false if it or x is nil.
+func (x *Product) GetCoverImageLinkTypeCode() (CoverImageLinkTypeCode, bool) {
+	if x == nil || x.CoverImageLinkTypeCode == nil {
+		var zero CoverImageLinkTypeCode
+		return zero, false
+	}
+	return *x.CoverImageLinkTypeCode, true
+}
+
+// CoverImageLinkTypeCodeOrElse returns CoverImageLinkTypeCode, or def if it or x is nil.
+func (x *Product) CoverImageLinkTypeCodeOrElse(def CoverImageLinkTypeCode) CoverImageLinkTypeCode {
+	if v, ok := x.GetCoverImageLinkTypeCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCoverImageLink returns CoverImageLink and true, or false if it or x is nil.
+func (x *Product) GetCoverImageLink() (PlainText, bool) {
+	if x == nil || x.CoverImageLink == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CoverImageLink, true
+}
+
+// CoverImageLinkOrElse returns CoverImageLink, or def if it or x is nil.
+func (x *Product) CoverImageLinkOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCoverImageLink(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublishingStatus returns PublishingStatus and true, or false if it or x is nil.
+func (x *Product) GetPublishingStatus() (PublishingStatus, bool) {
+	if x == nil || x.PublishingStatus == nil {
+		var zero PublishingStatus
+		return zero, false
+	}
+	return *x.PublishingStatus, true
+}
+
+// PublishingStatusOrElse returns PublishingStatus, or def if it or x is nil.
+func (x *Product) PublishingStatusOrElse(def PublishingStatus) PublishingStatus {
+	if v, ok := x.GetPublishingStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublishingStatusNote returns PublishingStatusNote and true, or false if it or x is nil.
+func (x *Product) GetPublishingStatusNote() (PlainText, bool) {
+	if x == nil || x.PublishingStatusNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublishingStatusNote, true
+}
+
+// PublishingStatusNoteOrElse returns PublishingStatusNote, or def if it or x is nil.
+func (x *Product) PublishingStatusNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublishingStatusNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Product) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Product) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Product) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Product) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Product) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Product) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Product) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Product) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Product) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Product) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Product) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Product) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Product) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Product) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPercent returns Percent and true, or false if it or x is nil.
+func (x *ProductClassification) GetPercent() (PlainText, bool) {
+	if x == nil || x.Percent == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Percent, true
+}
+
+// PercentOrElse returns Percent, or def if it or x is nil.
+func (x *ProductClassification) PercentOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPercent(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ProductClassification) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ProductClassification) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ProductClassification) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ProductClassification) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ProductClassification) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ProductClassification) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ProductClassification) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ProductClassification) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductClassification) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductClassification) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductClassification) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductClassification) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductClassification) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductClassification) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormFeatureValue returns ProductFormFeatureValue and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetProductFormFeatureValue() (PlainText, bool) {
+	if x == nil || x.ProductFormFeatureValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormFeatureValue, true
+}
+
+// ProductFormFeatureValueOrElse returns ProductFormFeatureValue, or def if it or x is nil.
+func (x *ProductFormFeature) ProductFormFeatureValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormFeatureValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormFeatureDescription returns ProductFormFeatureDescription and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetProductFormFeatureDescription() (PlainText, bool) {
+	if x == nil || x.ProductFormFeatureDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormFeatureDescription, true
+}
+
+// ProductFormFeatureDescriptionOrElse returns ProductFormFeatureDescription, or def if it or x is nil.
+func (x *ProductFormFeature) ProductFormFeatureDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormFeatureDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ProductFormFeature) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ProductFormFeature) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ProductFormFeature) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ProductFormFeature) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductFormFeature) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductFormFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductFormFeature) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ProductIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ProductIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ProductIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ProductIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ProductIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWebsiteRole returns WebsiteRole and true, or false if it or x is nil.
+func (x *ProductWebsite) GetWebsiteRole() (WebsiteRole, bool) {
+	if x == nil || x.WebsiteRole == nil {
+		var zero WebsiteRole
+		return zero, false
+	}
+	return *x.WebsiteRole, true
+}
+
+// WebsiteRoleOrElse returns WebsiteRole, or def if it or x is nil.
+func (x *ProductWebsite) WebsiteRoleOrElse(def WebsiteRole) WebsiteRole {
+	if v, ok := x.GetWebsiteRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductWebsiteDescription returns ProductWebsiteDescription and true, or false if it or x is nil.
+func (x *ProductWebsite) GetProductWebsiteDescription() (ProductWebsiteDescription, bool) {
+	if x == nil || x.ProductWebsiteDescription == nil {
+		var zero ProductWebsiteDescription
+		return zero, false
+	}
+	return *x.ProductWebsiteDescription, true
+}
+
+// ProductWebsiteDescriptionOrElse returns ProductWebsiteDescription, or def if it or x is nil.
+func (x *ProductWebsite) ProductWebsiteDescriptionOrElse(def ProductWebsiteDescription) ProductWebsiteDescription {
+	if v, ok := x.GetProductWebsiteDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ProductWebsite) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ProductWebsite) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ProductWebsite) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ProductWebsite) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ProductWebsite) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ProductWebsite) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ProductWebsite) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ProductWebsite) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductWebsite) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductWebsite) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductWebsite) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductWebsite) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductWebsite) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductWebsite) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAffiliation returns Affiliation and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetAffiliation() (PlainText, bool) {
+	if x == nil || x.Affiliation == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Affiliation, true
+}
+
+// AffiliationOrElse returns Affiliation, or def if it or x is nil.
+func (x *ProfessionalAffiliation) AffiliationOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAffiliation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProfessionalPosition returns ProfessionalPosition and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetProfessionalPosition() (PlainText, bool) {
+	if x == nil || x.ProfessionalPosition == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProfessionalPosition, true
+}
+
+// ProfessionalPositionOrElse returns ProfessionalPosition, or def if it or x is nil.
+func (x *ProfessionalAffiliation) ProfessionalPositionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProfessionalPosition(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ProfessionalAffiliation) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ProfessionalAffiliation) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ProfessionalAffiliation) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ProfessionalAffiliation) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProfessionalAffiliation) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProfessionalAffiliation) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProfessionalAffiliation) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherName returns PublisherName and true, or false if it or x is nil.
+func (x *Publisher) GetPublisherName() (PlainText, bool) {
+	if x == nil || x.PublisherName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherName, true
+}
+
+// PublisherNameOrElse returns PublisherName, or def if it or x is nil.
+func (x *Publisher) PublisherNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeType returns NameCodeType and true, or false if it or x is nil.
+func (x *Publisher) GetNameCodeType() (NameCodeType, bool) {
+	if x == nil || x.NameCodeType == nil {
+		var zero NameCodeType
+		return zero, false
+	}
+	return *x.NameCodeType, true
+}
+
+// NameCodeTypeOrElse returns NameCodeType, or def if it or x is nil.
+func (x *Publisher) NameCodeTypeOrElse(def NameCodeType) NameCodeType {
+	if v, ok := x.GetNameCodeType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeTypeName returns NameCodeTypeName and true, or false if it or x is nil.
+func (x *Publisher) GetNameCodeTypeName() (PlainText, bool) {
+	if x == nil || x.NameCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NameCodeTypeName, true
+}
+
+// NameCodeTypeNameOrElse returns NameCodeTypeName, or def if it or x is nil.
+func (x *Publisher) NameCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNameCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameCodeValue returns NameCodeValue and true, or false if it or x is nil.
+func (x *Publisher) GetNameCodeValue() (PlainText, bool) {
+	if x == nil || x.NameCodeValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NameCodeValue, true
+}
+
+// NameCodeValueOrElse returns NameCodeValue, or def if it or x is nil.
+func (x *Publisher) NameCodeValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNameCodeValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublishingRole returns PublishingRole and true, or false if it or x is nil.
+func (x *Publisher) GetPublishingRole() (PublishingRole, bool) {
+	if x == nil || x.PublishingRole == nil {
+		var zero PublishingRole
+		return zero, false
+	}
+	return *x.PublishingRole, true
+}
+
+// PublishingRoleOrElse returns PublishingRole, or def if it or x is nil.
+func (x *Publisher) PublishingRoleOrElse(def PublishingRole) PublishingRole {
+	if v, ok := x.GetPublishingRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Publisher) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Publisher) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Publisher) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Publisher) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Publisher) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Publisher) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Publisher) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Publisher) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Publisher) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Publisher) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Publisher) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Publisher) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Publisher) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Publisher) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReissueDescription returns ReissueDescription and true, or false if it or x is nil.
+func (x *Reissue) GetReissueDescription() (PlainText, bool) {
+	if x == nil || x.ReissueDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReissueDescription, true
+}
+
+// ReissueDescriptionOrElse returns ReissueDescription, or def if it or x is nil.
+func (x *Reissue) ReissueDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReissueDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Reissue) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Reissue) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Reissue) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Reissue) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Reissue) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Reissue) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Reissue) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Reissue) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Reissue) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Reissue) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Reissue) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Reissue) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Reissue) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Reissue) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISBN returns ISBN and true, or false if it or x is nil.
+func (x *RelatedProduct) GetISBN() (PlainText, bool) {
+	if x == nil || x.ISBN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISBN, true
+}
+
+// ISBNOrElse returns ISBN, or def if it or x is nil.
+func (x *RelatedProduct) ISBNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISBN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEAN13 returns EAN13 and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEAN13() (PlainText, bool) {
+	if x == nil || x.EAN13 == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EAN13, true
+}
+
+// EAN13OrElse returns EAN13, or def if it or x is nil.
+func (x *RelatedProduct) EAN13OrElse(def PlainText) PlainText {
+	if v, ok := x.GetEAN13(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductForm returns ProductForm and true, or false if it or x is nil.
+func (x *RelatedProduct) GetProductForm() (ProductForm, bool) {
+	if x == nil || x.ProductForm == nil {
+		var zero ProductForm
+		return zero, false
+	}
+	return *x.ProductForm, true
+}
+
+// ProductFormOrElse returns ProductForm, or def if it or x is nil.
+func (x *RelatedProduct) ProductFormOrElse(def ProductForm) ProductForm {
+	if v, ok := x.GetProductForm(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductPackaging returns ProductPackaging and true, or false if it or x is nil.
+func (x *RelatedProduct) GetProductPackaging() (ProductPackaging, bool) {
+	if x == nil || x.ProductPackaging == nil {
+		var zero ProductPackaging
+		return zero, false
+	}
+	return *x.ProductPackaging, true
+}
+
+// ProductPackagingOrElse returns ProductPackaging, or def if it or x is nil.
+func (x *RelatedProduct) ProductPackagingOrElse(def ProductPackaging) ProductPackaging {
+	if v, ok := x.GetProductPackaging(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormDescription returns ProductFormDescription and true, or false if it or x is nil.
+func (x *RelatedProduct) GetProductFormDescription() (PlainText, bool) {
+	if x == nil || x.ProductFormDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormDescription, true
+}
+
+// ProductFormDescriptionOrElse returns ProductFormDescription, or def if it or x is nil.
+func (x *RelatedProduct) ProductFormDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPieces returns NumberOfPieces and true, or false if it or x is nil.
+func (x *RelatedProduct) GetNumberOfPieces() (PlainText, bool) {
+	if x == nil || x.NumberOfPieces == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPieces, true
+}
+
+// NumberOfPiecesOrElse returns NumberOfPieces, or def if it or x is nil.
+func (x *RelatedProduct) NumberOfPiecesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPieces(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTradeCategory returns TradeCategory and true, or false if it or x is nil.
+func (x *RelatedProduct) GetTradeCategory() (TradeCategory, bool) {
+	if x == nil || x.TradeCategory == nil {
+		var zero TradeCategory
+		return zero, false
+	}
+	return *x.TradeCategory, true
+}
+
+// TradeCategoryOrElse returns TradeCategory, or def if it or x is nil.
+func (x *RelatedProduct) TradeCategoryOrElse(def TradeCategory) TradeCategory {
+	if v, ok := x.GetTradeCategory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubType returns EpubType and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubType() (EpubType, bool) {
+	if x == nil || x.EpubType == nil {
+		var zero EpubType
+		return zero, false
+	}
+	return *x.EpubType, true
+}
+
+// EpubTypeOrElse returns EpubType, or def if it or x is nil.
+func (x *RelatedProduct) EpubTypeOrElse(def EpubType) EpubType {
+	if v, ok := x.GetEpubType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeVersion returns EpubTypeVersion and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubTypeVersion() (PlainText, bool) {
+	if x == nil || x.EpubTypeVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeVersion, true
+}
+
+// EpubTypeVersionOrElse returns EpubTypeVersion, or def if it or x is nil.
+func (x *RelatedProduct) EpubTypeVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeDescription returns EpubTypeDescription and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubTypeDescription() (PlainText, bool) {
+	if x == nil || x.EpubTypeDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeDescription, true
+}
+
+// EpubTypeDescriptionOrElse returns EpubTypeDescription, or def if it or x is nil.
+func (x *RelatedProduct) EpubTypeDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormatDescription returns EpubFormatDescription and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubFormatDescription() (PlainText, bool) {
+	if x == nil || x.EpubFormatDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubFormatDescription, true
+}
+
+// EpubFormatDescriptionOrElse returns EpubFormatDescription, or def if it or x is nil.
+func (x *RelatedProduct) EpubFormatDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubFormatDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubTypeNote returns EpubTypeNote and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubTypeNote() (PlainText, bool) {
+	if x == nil || x.EpubTypeNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubTypeNote, true
+}
+
+// EpubTypeNoteOrElse returns EpubTypeNote, or def if it or x is nil.
+func (x *RelatedProduct) EpubTypeNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubTypeNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormat returns EpubFormat and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubFormat() (EpubFormat, bool) {
+	if x == nil || x.EpubFormat == nil {
+		var zero EpubFormat
+		return zero, false
+	}
+	return *x.EpubFormat, true
+}
+
+// EpubFormatOrElse returns EpubFormat, or def if it or x is nil.
+func (x *RelatedProduct) EpubFormatOrElse(def EpubFormat) EpubFormat {
+	if v, ok := x.GetEpubFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubFormatVersion returns EpubFormatVersion and true, or false if it or x is nil.
+func (x *RelatedProduct) GetEpubFormatVersion() (PlainText, bool) {
+	if x == nil || x.EpubFormatVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubFormatVersion, true
+}
+
+// EpubFormatVersionOrElse returns EpubFormatVersion, or def if it or x is nil.
+func (x *RelatedProduct) EpubFormatVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubFormatVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *RelatedProduct) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *RelatedProduct) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *RelatedProduct) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *RelatedProduct) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *RelatedProduct) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *RelatedProduct) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *RelatedProduct) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *RelatedProduct) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *RelatedProduct) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *RelatedProduct) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *RelatedProduct) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *RelatedProduct) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *RelatedProduct) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *RelatedProduct) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBible returns Bible and true, or false if it or x is nil.
+func (x *ReligiousText) GetBible() (Bible, bool) {
+	if x == nil || x.Bible == nil {
+		var zero Bible
+		return zero, false
+	}
+	return *x.Bible, true
+}
+
+// BibleOrElse returns Bible, or def if it or x is nil.
+func (x *ReligiousText) BibleOrElse(def Bible) Bible {
+	if v, ok := x.GetBible(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReligiousTextID returns ReligiousTextID and true, or false if it or x is nil.
+func (x *ReligiousText) GetReligiousTextID() (ReligiousTextID, bool) {
+	if x == nil || x.ReligiousTextID == nil {
+		var zero ReligiousTextID
+		return zero, false
+	}
+	return *x.ReligiousTextID, true
+}
+
+// ReligiousTextIDOrElse returns ReligiousTextID, or def if it or x is nil.
+func (x *ReligiousText) ReligiousTextIDOrElse(def ReligiousTextID) ReligiousTextID {
+	if v, ok := x.GetReligiousTextID(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ReligiousText) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ReligiousText) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ReligiousText) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ReligiousText) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ReligiousText) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ReligiousText) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ReligiousText) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ReligiousText) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReligiousText) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReligiousText) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReligiousText) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReligiousText) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReligiousText) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReligiousText) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReligiousTextFeatureDescription returns ReligiousTextFeatureDescription and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetReligiousTextFeatureDescription() (PlainText, bool) {
+	if x == nil || x.ReligiousTextFeatureDescription == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReligiousTextFeatureDescription, true
+}
+
+// ReligiousTextFeatureDescriptionOrElse returns ReligiousTextFeatureDescription, or def if it or x is nil.
+func (x *ReligiousTextFeature) ReligiousTextFeatureDescriptionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReligiousTextFeatureDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *ReligiousTextFeature) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *ReligiousTextFeature) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *ReligiousTextFeature) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *ReligiousTextFeature) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReligiousTextFeature) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReligiousTextFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReligiousTextFeature) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSalesOutletName returns SalesOutletName and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSalesOutletName() (PlainText, bool) {
+	if x == nil || x.SalesOutletName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SalesOutletName, true
+}
+
+// SalesOutletNameOrElse returns SalesOutletName, or def if it or x is nil.
+func (x *SalesOutlet) SalesOutletNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSalesOutletName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSalesOutletIdentifier returns SalesOutletIdentifier and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSalesOutletIdentifier() (SalesOutletIdentifier, bool) {
+	if x == nil || x.SalesOutletIdentifier == nil {
+		var zero SalesOutletIdentifier
+		return zero, false
+	}
+	return *x.SalesOutletIdentifier, true
+}
+
+// SalesOutletIdentifierOrElse returns SalesOutletIdentifier, or def if it or x is nil.
+func (x *SalesOutlet) SalesOutletIdentifierOrElse(def SalesOutletIdentifier) SalesOutletIdentifier {
+	if v, ok := x.GetSalesOutletIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SalesOutlet) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SalesOutlet) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SalesOutlet) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SalesOutlet) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SalesOutlet) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SalesOutlet) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SalesOutlet) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SalesOutlet) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesOutlet) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesOutlet) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesOutlet) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesOutlet) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SalesOutletIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SalesOutletIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SalesOutletIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SalesOutletIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SalesOutletIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesOutletIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesOutletIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesOutletIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSalesRestrictionDetail returns SalesRestrictionDetail and true, or false if it or x is nil.
+func (x *SalesRestriction) GetSalesRestrictionDetail() (PlainText, bool) {
+	if x == nil || x.SalesRestrictionDetail == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SalesRestrictionDetail, true
+}
+
+// SalesRestrictionDetailOrElse returns SalesRestrictionDetail, or def if it or x is nil.
+func (x *SalesRestriction) SalesRestrictionDetailOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSalesRestrictionDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SalesRestriction) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SalesRestriction) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SalesRestriction) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SalesRestriction) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SalesRestriction) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SalesRestriction) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SalesRestriction) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SalesRestriction) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesRestriction) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesRestriction) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesRestriction) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesRestriction) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesRestriction) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesRestriction) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRightsTerritory returns RightsTerritory and true, or false if it or x is nil.
+func (x *SalesRights) GetRightsTerritory() (TerritoryCodeList, bool) {
+	if x == nil || x.RightsTerritory == nil {
+		var zero TerritoryCodeList
+		return zero, false
+	}
+	return *x.RightsTerritory, true
+}
+
+// RightsTerritoryOrElse returns RightsTerritory, or def if it or x is nil.
+func (x *SalesRights) RightsTerritoryOrElse(def TerritoryCodeList) TerritoryCodeList {
+	if v, ok := x.GetRightsTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SalesRights) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SalesRights) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SalesRights) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SalesRights) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SalesRights) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SalesRights) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SalesRights) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SalesRights) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesRights) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesRights) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesRights) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesRights) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesRights) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesRights) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SenderIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SenderIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SenderIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SenderIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SenderIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SenderIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SenderIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SenderIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleOfSeries returns TitleOfSeries and true, or false if it or x is nil.
+func (x *Series) GetTitleOfSeries() (PlainText, bool) {
+	if x == nil || x.TitleOfSeries == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleOfSeries, true
+}
+
+// TitleOfSeriesOrElse returns TitleOfSeries, or def if it or x is nil.
+func (x *Series) TitleOfSeriesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleOfSeries(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSeriesISSN returns SeriesISSN and true, or false if it or x is nil.
+func (x *Series) GetSeriesISSN() (PlainText, bool) {
+	if x == nil || x.SeriesISSN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SeriesISSN, true
+}
+
+// SeriesISSNOrElse returns SeriesISSN, or def if it or x is nil.
+func (x *Series) SeriesISSNOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSeriesISSN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherSeriesCode returns PublisherSeriesCode and true, or false if it or x is nil.
+func (x *Series) GetPublisherSeriesCode() (PlainText, bool) {
+	if x == nil || x.PublisherSeriesCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherSeriesCode, true
+}
+
+// PublisherSeriesCodeOrElse returns PublisherSeriesCode, or def if it or x is nil.
+func (x *Series) PublisherSeriesCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherSeriesCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberWithinSeries returns NumberWithinSeries and true, or false if it or x is nil.
+func (x *Series) GetNumberWithinSeries() (PlainText, bool) {
+	if x == nil || x.NumberWithinSeries == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberWithinSeries, true
+}
+
+// NumberWithinSeriesOrElse returns NumberWithinSeries, or def if it or x is nil.
+func (x *Series) NumberWithinSeriesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberWithinSeries(); ok {
+		return v
+	}
+	return def
+}
+
+// GetYearOfAnnual returns YearOfAnnual and true, or false if it or x is nil.
+func (x *Series) GetYearOfAnnual() (PlainText, bool) {
+	if x == nil || x.YearOfAnnual == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.YearOfAnnual, true
+}
+
+// YearOfAnnualOrElse returns YearOfAnnual, or def if it or x is nil.
+func (x *Series) YearOfAnnualOrElse(def PlainText) PlainText {
+	if v, ok := x.GetYearOfAnnual(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Series) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Series) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Series) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Series) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Series) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Series) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Series) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Series) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Series) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Series) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Series) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Series) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Series) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Series) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SeriesIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SeriesIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SeriesIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SeriesIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SeriesIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SeriesIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SeriesIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SeriesIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SeriesIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleOfSet returns TitleOfSet and true, or false if it or x is nil.
+func (x *Set) GetTitleOfSet() (PlainText, bool) {
+	if x == nil || x.TitleOfSet == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleOfSet, true
+}
+
+// TitleOfSetOrElse returns TitleOfSet, or def if it or x is nil.
+func (x *Set) TitleOfSetOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleOfSet(); ok {
+		return v
+	}
+	return def
+}
+
+// GetISBNOfSet returns ISBNOfSet and true, or false if it or x is nil.
+func (x *Set) GetISBNOfSet() (PlainText, bool) {
+	if x == nil || x.ISBNOfSet == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ISBNOfSet, true
+}
+
+// ISBNOfSetOrElse returns ISBNOfSet, or def if it or x is nil.
+func (x *Set) ISBNOfSetOrElse(def PlainText) PlainText {
+	if v, ok := x.GetISBNOfSet(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEAN13OfSet returns EAN13OfSet and true, or false if it or x is nil.
+func (x *Set) GetEAN13OfSet() (PlainText, bool) {
+	if x == nil || x.EAN13OfSet == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EAN13OfSet, true
+}
+
+// EAN13OfSetOrElse returns EAN13OfSet, or def if it or x is nil.
+func (x *Set) EAN13OfSetOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEAN13OfSet(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSetPartNumber returns SetPartNumber and true, or false if it or x is nil.
+func (x *Set) GetSetPartNumber() (PlainText, bool) {
+	if x == nil || x.SetPartNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SetPartNumber, true
+}
+
+// SetPartNumberOrElse returns SetPartNumber, or def if it or x is nil.
+func (x *Set) SetPartNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSetPartNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSetPartTitle returns SetPartTitle and true, or false if it or x is nil.
+func (x *Set) GetSetPartTitle() (PlainText, bool) {
+	if x == nil || x.SetPartTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SetPartTitle, true
+}
+
+// SetPartTitleOrElse returns SetPartTitle, or def if it or x is nil.
+func (x *Set) SetPartTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSetPartTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetItemNumberWithinSet returns ItemNumberWithinSet and true, or false if it or x is nil.
+func (x *Set) GetItemNumberWithinSet() (PlainText, bool) {
+	if x == nil || x.ItemNumberWithinSet == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ItemNumberWithinSet, true
+}
+
+// ItemNumberWithinSetOrElse returns ItemNumberWithinSet, or def if it or x is nil.
+func (x *Set) ItemNumberWithinSetOrElse(def PlainText) PlainText {
+	if v, ok := x.GetItemNumberWithinSet(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLevelSequenceNumber returns LevelSequenceNumber and true, or false if it or x is nil.
+func (x *Set) GetLevelSequenceNumber() (PlainText, bool) {
+	if x == nil || x.LevelSequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LevelSequenceNumber, true
+}
+
+// LevelSequenceNumberOrElse returns LevelSequenceNumber, or def if it or x is nil.
+func (x *Set) LevelSequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLevelSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSetItemTitle returns SetItemTitle and true, or false if it or x is nil.
+func (x *Set) GetSetItemTitle() (PlainText, bool) {
+	if x == nil || x.SetItemTitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SetItemTitle, true
+}
+
+// SetItemTitleOrElse returns SetItemTitle, or def if it or x is nil.
+func (x *Set) SetItemTitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSetItemTitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Set) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Set) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Set) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Set) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Set) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Set) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Set) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Set) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Set) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Set) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Set) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Set) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Set) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Set) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOnHand returns OnHand and true, or false if it or x is nil.
+func (x *Stock) GetOnHand() (PlainText, bool) {
+	if x == nil || x.OnHand == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OnHand, true
+}
+
+// OnHandOrElse returns OnHand, or def if it or x is nil.
+func (x *Stock) OnHandOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOnHand(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStockQuantityCoded returns StockQuantityCoded and true, or false if it or x is nil.
+func (x *Stock) GetStockQuantityCoded() (StockQuantityCoded, bool) {
+	if x == nil || x.StockQuantityCoded == nil {
+		var zero StockQuantityCoded
+		return zero, false
+	}
+	return *x.StockQuantityCoded, true
+}
+
+// StockQuantityCodedOrElse returns StockQuantityCoded, or def if it or x is nil.
+func (x *Stock) StockQuantityCodedOrElse(def StockQuantityCoded) StockQuantityCoded {
+	if v, ok := x.GetStockQuantityCoded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLocationIdentifier returns LocationIdentifier and true, or false if it or x is nil.
+func (x *Stock) GetLocationIdentifier() (LocationIdentifier, bool) {
+	if x == nil || x.LocationIdentifier == nil {
+		var zero LocationIdentifier
+		return zero, false
+	}
+	return *x.LocationIdentifier, true
+}
+
+// LocationIdentifierOrElse returns LocationIdentifier, or def if it or x is nil.
+func (x *Stock) LocationIdentifierOrElse(def LocationIdentifier) LocationIdentifier {
+	if v, ok := x.GetLocationIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLocationName returns LocationName and true, or false if it or x is nil.
+func (x *Stock) GetLocationName() (PlainText, bool) {
+	if x == nil || x.LocationName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LocationName, true
+}
+
+// LocationNameOrElse returns LocationName, or def if it or x is nil.
+func (x *Stock) LocationNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLocationName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOnOrder returns OnOrder and true, or false if it or x is nil.
+func (x *Stock) GetOnOrder() (PlainText, bool) {
+	if x == nil || x.OnOrder == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OnOrder, true
+}
+
+// OnOrderOrElse returns OnOrder, or def if it or x is nil.
+func (x *Stock) OnOrderOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOnOrder(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCBO returns CBO and true, or false if it or x is nil.
+func (x *Stock) GetCBO() (PlainText, bool) {
+	if x == nil || x.CBO == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CBO, true
+}
+
+// CBOOrElse returns CBO, or def if it or x is nil.
+func (x *Stock) CBOOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCBO(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Stock) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Stock) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Stock) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Stock) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Stock) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Stock) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Stock) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Stock) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Stock) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Stock) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Stock) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Stock) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Stock) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Stock) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStockQuantityCodeTypeName returns StockQuantityCodeTypeName and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetStockQuantityCodeTypeName() (PlainText, bool) {
+	if x == nil || x.StockQuantityCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.StockQuantityCodeTypeName, true
+}
+
+// StockQuantityCodeTypeNameOrElse returns StockQuantityCodeTypeName, or def if it or x is nil.
+func (x *StockQuantityCoded) StockQuantityCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetStockQuantityCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *StockQuantityCoded) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *StockQuantityCoded) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *StockQuantityCoded) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *StockQuantityCoded) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *StockQuantityCoded) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *StockQuantityCoded) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *StockQuantityCoded) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionCode returns DeletionCode and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetDeletionCode() (DeletionCode, bool) {
+	if x == nil || x.DeletionCode == nil {
+		var zero DeletionCode
+		return zero, false
+	}
+	return *x.DeletionCode, true
+}
+
+// DeletionCodeOrElse returns DeletionCode, or def if it or x is nil.
+func (x *SubSeriesRecord) DeletionCodeOrElse(def DeletionCode) DeletionCode {
+	if v, ok := x.GetDeletionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDeletionText returns DeletionText and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetDeletionText() (PlainText, bool) {
+	if x == nil || x.DeletionText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DeletionText, true
+}
+
+// DeletionTextOrElse returns DeletionText, or def if it or x is nil.
+func (x *SubSeriesRecord) DeletionTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDeletionText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceType returns RecordSourceType and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetRecordSourceType() (RecordSourceType, bool) {
+	if x == nil || x.RecordSourceType == nil {
+		var zero RecordSourceType
+		return zero, false
+	}
+	return *x.RecordSourceType, true
+}
+
+// RecordSourceTypeOrElse returns RecordSourceType, or def if it or x is nil.
+func (x *SubSeriesRecord) RecordSourceTypeOrElse(def RecordSourceType) RecordSourceType {
+	if v, ok := x.GetRecordSourceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceName returns RecordSourceName and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetRecordSourceName() (PlainText, bool) {
+	if x == nil || x.RecordSourceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceName, true
+}
+
+// RecordSourceNameOrElse returns RecordSourceName, or def if it or x is nil.
+func (x *SubSeriesRecord) RecordSourceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubordinateEntries returns SubordinateEntries and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetSubordinateEntries() (PlainText, bool) {
+	if x == nil || x.SubordinateEntries == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubordinateEntries, true
+}
+
+// SubordinateEntriesOrElse returns SubordinateEntries, or def if it or x is nil.
+func (x *SubSeriesRecord) SubordinateEntriesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubordinateEntries(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifierType returns RecordSourceIdentifierType and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetRecordSourceIdentifierType() (RecordSourceIdentifierType, bool) {
+	if x == nil || x.RecordSourceIdentifierType == nil {
+		var zero RecordSourceIdentifierType
+		return zero, false
+	}
+	return *x.RecordSourceIdentifierType, true
+}
+
+// RecordSourceIdentifierTypeOrElse returns RecordSourceIdentifierType, or def if it or x is nil.
+func (x *SubSeriesRecord) RecordSourceIdentifierTypeOrElse(def RecordSourceIdentifierType) RecordSourceIdentifierType {
+	if v, ok := x.GetRecordSourceIdentifierType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceIdentifier returns RecordSourceIdentifier and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetRecordSourceIdentifier() (PlainText, bool) {
+	if x == nil || x.RecordSourceIdentifier == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceIdentifier, true
+}
+
+// RecordSourceIdentifierOrElse returns RecordSourceIdentifier, or def if it or x is nil.
+func (x *SubSeriesRecord) RecordSourceIdentifierOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSeriesPartName returns SeriesPartName and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetSeriesPartName() (PlainText, bool) {
+	if x == nil || x.SeriesPartName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SeriesPartName, true
+}
+
+// SeriesPartNameOrElse returns SeriesPartName, or def if it or x is nil.
+func (x *SubSeriesRecord) SeriesPartNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSeriesPartName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberWithinSeries returns NumberWithinSeries and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetNumberWithinSeries() (PlainText, bool) {
+	if x == nil || x.NumberWithinSeries == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberWithinSeries, true
+}
+
+// NumberWithinSeriesOrElse returns NumberWithinSeries, or def if it or x is nil.
+func (x *SubSeriesRecord) NumberWithinSeriesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberWithinSeries(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SubSeriesRecord) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SubSeriesRecord) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SubSeriesRecord) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SubSeriesRecord) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SubSeriesRecord) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SubSeriesRecord) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SubSeriesRecord) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SubSeriesRecord) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectHeadingText returns SubjectHeadingText and true, or false if it or x is nil.
+func (x *Subject) GetSubjectHeadingText() (PlainText, bool) {
+	if x == nil || x.SubjectHeadingText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectHeadingText, true
+}
+
+// SubjectHeadingTextOrElse returns SubjectHeadingText, or def if it or x is nil.
+func (x *Subject) SubjectHeadingTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectHeadingText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectCode returns SubjectCode and true, or false if it or x is nil.
+func (x *Subject) GetSubjectCode() (PlainText, bool) {
+	if x == nil || x.SubjectCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectCode, true
+}
+
+// SubjectCodeOrElse returns SubjectCode, or def if it or x is nil.
+func (x *Subject) SubjectCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectSchemeName returns SubjectSchemeName and true, or false if it or x is nil.
+func (x *Subject) GetSubjectSchemeName() (PlainText, bool) {
+	if x == nil || x.SubjectSchemeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectSchemeName, true
+}
+
+// SubjectSchemeNameOrElse returns SubjectSchemeName, or def if it or x is nil.
+func (x *Subject) SubjectSchemeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectSchemeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectSchemeVersion returns SubjectSchemeVersion and true, or false if it or x is nil.
+func (x *Subject) GetSubjectSchemeVersion() (PlainText, bool) {
+	if x == nil || x.SubjectSchemeVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectSchemeVersion, true
+}
+
+// SubjectSchemeVersionOrElse returns SubjectSchemeVersion, or def if it or x is nil.
+func (x *Subject) SubjectSchemeVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectSchemeVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Subject) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Subject) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Subject) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Subject) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Subject) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Subject) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Subject) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Subject) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Subject) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Subject) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Subject) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Subject) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Subject) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Subject) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SupplierIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SupplierIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SupplierIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SupplierIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SupplierIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplierIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplierIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplierIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierName returns SupplierName and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplierName() (PlainText, bool) {
+	if x == nil || x.SupplierName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierName, true
+}
+
+// SupplierNameOrElse returns SupplierName, or def if it or x is nil.
+func (x *SupplyDetail) SupplierNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierSAN returns SupplierSAN and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplierSAN() (PlainText, bool) {
+	if x == nil || x.SupplierSAN == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierSAN, true
+}
+
+// SupplierSANOrElse returns SupplierSAN, or def if it or x is nil.
+func (x *SupplyDetail) SupplierSANOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierSAN(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierEANLocationNumber returns SupplierEANLocationNumber and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplierEANLocationNumber() (PlainText, bool) {
+	if x == nil || x.SupplierEANLocationNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierEANLocationNumber, true
+}
+
+// SupplierEANLocationNumberOrElse returns SupplierEANLocationNumber, or def if it or x is nil.
+func (x *SupplyDetail) SupplierEANLocationNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierEANLocationNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIntermediaryAvailabilityCode returns IntermediaryAvailabilityCode and true, or false if it or x is nil.
+func (x *SupplyDetail) GetIntermediaryAvailabilityCode() (IntermediaryAvailabilityCode, bool) {
+	if x == nil || x.IntermediaryAvailabilityCode == nil {
+		var zero IntermediaryAvailabilityCode
+		return zero, false
+	}
+	return *x.IntermediaryAvailabilityCode, true
+}
+
+// IntermediaryAvailabilityCodeOrElse returns IntermediaryAvailabilityCode, or def if it or x is nil.
+func (x *SupplyDetail) IntermediaryAvailabilityCodeOrElse(def IntermediaryAvailabilityCode) IntermediaryAvailabilityCode {
+	if v, ok := x.GetIntermediaryAvailabilityCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAvailabilityCode returns AvailabilityCode and true, or false if it or x is nil.
+func (x *SupplyDetail) GetAvailabilityCode() (AvailabilityCode, bool) {
+	if x == nil || x.AvailabilityCode == nil {
+		var zero AvailabilityCode
+		return zero, false
+	}
+	return *x.AvailabilityCode, true
+}
+
+// AvailabilityCodeOrElse returns AvailabilityCode, or def if it or x is nil.
+func (x *SupplyDetail) AvailabilityCodeOrElse(def AvailabilityCode) AvailabilityCode {
+	if v, ok := x.GetAvailabilityCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductAvailability returns ProductAvailability and true, or false if it or x is nil.
+func (x *SupplyDetail) GetProductAvailability() (ProductAvailability, bool) {
+	if x == nil || x.ProductAvailability == nil {
+		var zero ProductAvailability
+		return zero, false
+	}
+	return *x.ProductAvailability, true
+}
+
+// ProductAvailabilityOrElse returns ProductAvailability, or def if it or x is nil.
+func (x *SupplyDetail) ProductAvailabilityOrElse(def ProductAvailability) ProductAvailability {
+	if v, ok := x.GetProductAvailability(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceAmount returns PriceAmount and true, or false if it or x is nil.
+func (x *SupplyDetail) GetPriceAmount() (PlainText, bool) {
+	if x == nil || x.PriceAmount == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceAmount, true
+}
+
+// PriceAmountOrElse returns PriceAmount, or def if it or x is nil.
+func (x *SupplyDetail) PriceAmountOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceAmount(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUnpricedItemType returns UnpricedItemType and true, or false if it or x is nil.
+func (x *SupplyDetail) GetUnpricedItemType() (UnpricedItemType, bool) {
+	if x == nil || x.UnpricedItemType == nil {
+		var zero UnpricedItemType
+		return zero, false
+	}
+	return *x.UnpricedItemType, true
+}
+
+// UnpricedItemTypeOrElse returns UnpricedItemType, or def if it or x is nil.
+func (x *SupplyDetail) UnpricedItemTypeOrElse(def UnpricedItemType) UnpricedItemType {
+	if v, ok := x.GetUnpricedItemType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierRole returns SupplierRole and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplierRole() (SupplierRole, bool) {
+	if x == nil || x.SupplierRole == nil {
+		var zero SupplierRole
+		return zero, false
+	}
+	return *x.SupplierRole, true
+}
+
+// SupplierRoleOrElse returns SupplierRole, or def if it or x is nil.
+func (x *SupplyDetail) SupplierRoleOrElse(def SupplierRole) SupplierRole {
+	if v, ok := x.GetSupplierRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplyRestrictionDetail returns SupplyRestrictionDetail and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplyRestrictionDetail() (PlainText, bool) {
+	if x == nil || x.SupplyRestrictionDetail == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplyRestrictionDetail, true
+}
+
+// SupplyRestrictionDetailOrElse returns SupplyRestrictionDetail, or def if it or x is nil.
+func (x *SupplyDetail) SupplyRestrictionDetailOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplyRestrictionDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLastDateForReturns returns LastDateForReturns and true, or false if it or x is nil.
+func (x *SupplyDetail) GetLastDateForReturns() (PlainText, bool) {
+	if x == nil || x.LastDateForReturns == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LastDateForReturns, true
+}
+
+// LastDateForReturnsOrElse returns LastDateForReturns, or def if it or x is nil.
+func (x *SupplyDetail) LastDateForReturnsOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLastDateForReturns(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNewSupplier returns NewSupplier and true, or false if it or x is nil.
+func (x *SupplyDetail) GetNewSupplier() (NewSupplier, bool) {
+	if x == nil || x.NewSupplier == nil {
+		var zero NewSupplier
+		return zero, false
+	}
+	return *x.NewSupplier, true
+}
+
+// NewSupplierOrElse returns NewSupplier, or def if it or x is nil.
+func (x *SupplyDetail) NewSupplierOrElse(def NewSupplier) NewSupplier {
+	if v, ok := x.GetNewSupplier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOnSaleDate returns OnSaleDate and true, or false if it or x is nil.
+func (x *SupplyDetail) GetOnSaleDate() (PlainText, bool) {
+	if x == nil || x.OnSaleDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OnSaleDate, true
+}
+
+// OnSaleDateOrElse returns OnSaleDate, or def if it or x is nil.
+func (x *SupplyDetail) OnSaleDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOnSaleDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOrderTime returns OrderTime and true, or false if it or x is nil.
+func (x *SupplyDetail) GetOrderTime() (PlainText, bool) {
+	if x == nil || x.OrderTime == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OrderTime, true
+}
+
+// OrderTimeOrElse returns OrderTime, or def if it or x is nil.
+func (x *SupplyDetail) OrderTimeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOrderTime(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPackQuantity returns PackQuantity and true, or false if it or x is nil.
+func (x *SupplyDetail) GetPackQuantity() (PlainText, bool) {
+	if x == nil || x.PackQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PackQuantity, true
+}
+
+// PackQuantityOrElse returns PackQuantity, or def if it or x is nil.
+func (x *SupplyDetail) PackQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPackQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReissue returns Reissue and true, or false if it or x is nil.
+func (x *SupplyDetail) GetReissue() (Reissue, bool) {
+	if x == nil || x.Reissue == nil {
+		var zero Reissue
+		return zero, false
+	}
+	return *x.Reissue, true
+}
+
+// ReissueOrElse returns Reissue, or def if it or x is nil.
+func (x *SupplyDetail) ReissueOrElse(def Reissue) Reissue {
+	if v, ok := x.GetReissue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplyToTerritory returns SupplyToTerritory and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSupplyToTerritory() (TerritoryCodeList, bool) {
+	if x == nil || x.SupplyToTerritory == nil {
+		var zero TerritoryCodeList
+		return zero, false
+	}
+	return *x.SupplyToTerritory, true
+}
+
+// SupplyToTerritoryOrElse returns SupplyToTerritory, or def if it or x is nil.
+func (x *SupplyDetail) SupplyToTerritoryOrElse(def TerritoryCodeList) TerritoryCodeList {
+	if v, ok := x.GetSupplyToTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReturnsCodeType returns ReturnsCodeType and true, or false if it or x is nil.
+func (x *SupplyDetail) GetReturnsCodeType() (ReturnsCodeType, bool) {
+	if x == nil || x.ReturnsCodeType == nil {
+		var zero ReturnsCodeType
+		return zero, false
+	}
+	return *x.ReturnsCodeType, true
+}
+
+// ReturnsCodeTypeOrElse returns ReturnsCodeType, or def if it or x is nil.
+func (x *SupplyDetail) ReturnsCodeTypeOrElse(def ReturnsCodeType) ReturnsCodeType {
+	if v, ok := x.GetReturnsCodeType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReturnsCode returns ReturnsCode and true, or false if it or x is nil.
+func (x *SupplyDetail) GetReturnsCode() (PlainText, bool) {
+	if x == nil || x.ReturnsCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReturnsCode, true
+}
+
+// ReturnsCodeOrElse returns ReturnsCode, or def if it or x is nil.
+func (x *SupplyDetail) ReturnsCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReturnsCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *SupplyDetail) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *SupplyDetail) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetExpectedShipDate returns ExpectedShipDate and true, or false if it or x is nil.
+func (x *SupplyDetail) GetExpectedShipDate() (PlainText, bool) {
+	if x == nil || x.ExpectedShipDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ExpectedShipDate, true
+}
+
+// ExpectedShipDateOrElse returns ExpectedShipDate, or def if it or x is nil.
+func (x *SupplyDetail) ExpectedShipDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetExpectedShipDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRestrictionFlag returns AudienceRestrictionFlag and true, or false if it or x is nil.
+func (x *SupplyDetail) GetAudienceRestrictionFlag() (AudienceRestrictionFlag, bool) {
+	if x == nil || x.AudienceRestrictionFlag == nil {
+		var zero AudienceRestrictionFlag
+		return zero, false
+	}
+	return *x.AudienceRestrictionFlag, true
+}
+
+// AudienceRestrictionFlagOrElse returns AudienceRestrictionFlag, or def if it or x is nil.
+func (x *SupplyDetail) AudienceRestrictionFlagOrElse(def AudienceRestrictionFlag) AudienceRestrictionFlag {
+	if v, ok := x.GetAudienceRestrictionFlag(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRestrictionNote returns AudienceRestrictionNote and true, or false if it or x is nil.
+func (x *SupplyDetail) GetAudienceRestrictionNote() (PlainText, bool) {
+	if x == nil || x.AudienceRestrictionNote == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceRestrictionNote, true
+}
+
+// AudienceRestrictionNoteOrElse returns AudienceRestrictionNote, or def if it or x is nil.
+func (x *SupplyDetail) AudienceRestrictionNoteOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceRestrictionNote(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *SupplyDetail) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *SupplyDetail) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *SupplyDetail) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *SupplyDetail) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *SupplyDetail) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *SupplyDetail) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *SupplyDetail) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *SupplyDetail) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplyDetail) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplyDetail) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplyDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplyDetail) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFirstPageNumber returns FirstPageNumber and true, or false if it or x is nil.
+func (x *TextItem) GetFirstPageNumber() (PlainText, bool) {
+	if x == nil || x.FirstPageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FirstPageNumber, true
+}
+
+// FirstPageNumberOrElse returns FirstPageNumber, or def if it or x is nil.
+func (x *TextItem) FirstPageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFirstPageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLastPageNumber returns LastPageNumber and true, or false if it or x is nil.
+func (x *TextItem) GetLastPageNumber() (PlainText, bool) {
+	if x == nil || x.LastPageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LastPageNumber, true
+}
+
+// LastPageNumberOrElse returns LastPageNumber, or def if it or x is nil.
+func (x *TextItem) LastPageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLastPageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPages returns NumberOfPages and true, or false if it or x is nil.
+func (x *TextItem) GetNumberOfPages() (PlainText, bool) {
+	if x == nil || x.NumberOfPages == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPages, true
+}
+
+// NumberOfPagesOrElse returns NumberOfPages, or def if it or x is nil.
+func (x *TextItem) NumberOfPagesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPages(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *TextItem) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *TextItem) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *TextItem) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *TextItem) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *TextItem) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *TextItem) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *TextItem) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *TextItem) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TextItem) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TextItem) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TextItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TextItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TextItem) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TextItem) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *TextItemIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *TextItemIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *TextItemIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *TextItemIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *TextItemIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TextItemIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TextItemIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TextItemIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleText returns TitleText and true, or false if it or x is nil.
+func (x *Title) GetTitleText() (PlainText, bool) {
+	if x == nil || x.TitleText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleText, true
+}
+
+// TitleTextOrElse returns TitleText, or def if it or x is nil.
+func (x *Title) TitleTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlePrefix returns TitlePrefix and true, or false if it or x is nil.
+func (x *Title) GetTitlePrefix() (PlainText, bool) {
+	if x == nil || x.TitlePrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlePrefix, true
+}
+
+// TitlePrefixOrElse returns TitlePrefix, or def if it or x is nil.
+func (x *Title) TitlePrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlePrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleWithoutPrefix returns TitleWithoutPrefix and true, or false if it or x is nil.
+func (x *Title) GetTitleWithoutPrefix() (PlainText, bool) {
+	if x == nil || x.TitleWithoutPrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleWithoutPrefix, true
+}
+
+// TitleWithoutPrefixOrElse returns TitleWithoutPrefix, or def if it or x is nil.
+func (x *Title) TitleWithoutPrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleWithoutPrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAbbreviatedLength returns AbbreviatedLength and true, or false if it or x is nil.
+func (x *Title) GetAbbreviatedLength() (PlainText, bool) {
+	if x == nil || x.AbbreviatedLength == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AbbreviatedLength, true
+}
+
+// AbbreviatedLengthOrElse returns AbbreviatedLength, or def if it or x is nil.
+func (x *Title) AbbreviatedLengthOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAbbreviatedLength(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextCaseFlag returns TextCaseFlag and true, or false if it or x is nil.
+func (x *Title) GetTextCaseFlag() (TextCaseFlag, bool) {
+	if x == nil || x.TextCaseFlag == nil {
+		var zero TextCaseFlag
+		return zero, false
+	}
+	return *x.TextCaseFlag, true
+}
+
+// TextCaseFlagOrElse returns TextCaseFlag, or def if it or x is nil.
+func (x *Title) TextCaseFlagOrElse(def TextCaseFlag) TextCaseFlag {
+	if v, ok := x.GetTextCaseFlag(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubtitle returns Subtitle and true, or false if it or x is nil.
+func (x *Title) GetSubtitle() (PlainText, bool) {
+	if x == nil || x.Subtitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Subtitle, true
+}
+
+// SubtitleOrElse returns Subtitle, or def if it or x is nil.
+func (x *Title) SubtitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubtitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Title) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Title) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Title) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Title) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Title) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Title) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Title) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Title) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Title) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Title) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Title) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Title) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Title) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Title) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWebsiteRole returns WebsiteRole and true, or false if it or x is nil.
+func (x *Website) GetWebsiteRole() (WebsiteRole, bool) {
+	if x == nil || x.WebsiteRole == nil {
+		var zero WebsiteRole
+		return zero, false
+	}
+	return *x.WebsiteRole, true
+}
+
+// WebsiteRoleOrElse returns WebsiteRole, or def if it or x is nil.
+func (x *Website) WebsiteRoleOrElse(def WebsiteRole) WebsiteRole {
+	if v, ok := x.GetWebsiteRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWebsiteDescription returns WebsiteDescription and true, or false if it or x is nil.
+func (x *Website) GetWebsiteDescription() (WebsiteDescription, bool) {
+	if x == nil || x.WebsiteDescription == nil {
+		var zero WebsiteDescription
+		return zero, false
+	}
+	return *x.WebsiteDescription, true
+}
+
+// WebsiteDescriptionOrElse returns WebsiteDescription, or def if it or x is nil.
+func (x *Website) WebsiteDescriptionOrElse(def WebsiteDescription) WebsiteDescription {
+	if v, ok := x.GetWebsiteDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *Website) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *Website) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *Website) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *Website) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *Website) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *Website) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *Website) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *Website) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Website) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Website) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Website) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Website) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Website) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Website) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *WorkIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextformat returns Textformat and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetTextformat() (TextFormatCode, bool) {
+	if x == nil || x.Textformat == nil {
+		var zero TextFormatCode
+		return zero, false
+	}
+	return *x.Textformat, true
+}
+
+// TextformatOrElse returns Textformat, or def if it or x is nil.
+func (x *WorkIdentifier) TextformatOrElse(def TextFormatCode) TextFormatCode {
+	if v, ok := x.GetTextformat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextcase returns Textcase and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetTextcase() (TextCaseCode, bool) {
+	if x == nil || x.Textcase == nil {
+		var zero TextCaseCode
+		return zero, false
+	}
+	return *x.Textcase, true
+}
+
+// TextcaseOrElse returns Textcase, or def if it or x is nil.
+func (x *WorkIdentifier) TextcaseOrElse(def TextCaseCode) TextCaseCode {
+	if v, ok := x.GetTextcase(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLanguage returns Language and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetLanguage() (LanguageList74, bool) {
+	if x == nil || x.Language == nil {
+		var zero LanguageList74
+		return zero, false
+	}
+	return *x.Language, true
+}
+
+// LanguageOrElse returns Language, or def if it or x is nil.
+func (x *WorkIdentifier) LanguageOrElse(def LanguageList74) LanguageList74 {
+	if v, ok := x.GetLanguage(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTransliteration returns Transliteration and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetTransliteration() (TransliterationCode, bool) {
+	if x == nil || x.Transliteration == nil {
+		var zero TransliterationCode
+		return zero, false
+	}
+	return *x.Transliteration, true
+}
+
+// TransliterationOrElse returns Transliteration, or def if it or x is nil.
+func (x *WorkIdentifier) TransliterationOrElse(def TransliterationCode) TransliterationCode {
+	if v, ok := x.GetTransliteration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetDatestamp() (DateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *WorkIdentifier) DatestampOrElse(def DateOrDateTime) DateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *WorkIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetSourcename() (Sourcename, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero Sourcename
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *WorkIdentifier) SourcenameOrElse(def Sourcename) Sourcename {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
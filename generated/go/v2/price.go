@@ -0,0 +1,171 @@
+package onix
+
+// Currency is ISO 4217 currency code such as USD.
+type Currency string
+
+// PriceType is code of List 58 which tells type of price.
+type PriceType string
+
+const (
+	// PriceTypeRRPExcludingTax is recommended retail price excluding tax.
+	PriceTypeRRPExcludingTax PriceType = "01"
+	// PriceTypeRRPIncludingTax is recommended retail price including tax.
+	PriceTypeRRPIncludingTax PriceType = "02"
+	// PriceTypeFixedRetailExcludingTax is fixed retail price excluding tax.
+	PriceTypeFixedRetailExcludingTax PriceType = "03"
+	// PriceTypeFixedRetailIncludingTax is fixed retail price including tax.
+	PriceTypeFixedRetailIncludingTax PriceType = "04"
+	// PriceTypeSupplierNetExcludingTax is supplier's net price excluding tax.
+	PriceTypeSupplierNetExcludingTax PriceType = "05"
+	// PriceTypeSupplierNetIncludingTax is supplier's net price including tax.
+	PriceTypeSupplierNetIncludingTax PriceType = "07"
+	// PriceTypePublisherRetailExcludingTax is publisher's retail price excluding tax.
+	PriceTypePublisherRetailExcludingTax PriceType = "41"
+	// PriceTypePublisherRetailIncludingTax is publisher's retail price including tax.
+	PriceTypePublisherRetailIncludingTax PriceType = "42"
+)
+
+// IncludingTax reports whether price of t includes tax.
+func (t PriceType) IncludingTax() bool {
+	switch t {
+	case "02", "04", "07", "09", "12", "14", "17", "22", "24", "27", "34", "42":
+		return true
+	}
+	return false
+}
+
+// TaxRateCode is code of List 62 which tells rate of tax.
+type TaxRateCode string
+
+const (
+	// TaxRateHigher is higher rate.
+	TaxRateHigher TaxRateCode = "H"
+	// TaxRatePaidAtSource is tax paid at source in Italy.
+	TaxRatePaidAtSource TaxRateCode = "P"
+	// TaxRateLower is lower rate.
+	TaxRateLower TaxRateCode = "R"
+	// TaxRateStandard is standard rate.
+	TaxRateStandard TaxRateCode = "S"
+	// TaxRateZero is zero-rated.
+	TaxRateZero TaxRateCode = "Z"
+)
+
+// Tax is a tax which price includes, or which is added to price.
+type Tax struct {
+	Code    TaxRateCode
+	Percent *Amount
+	Taxable *Amount
+	Amount  *Amount
+}
+
+// Amount returns amount of price.
+func (p Price) Amount() (Amount, error) {
+	return ParseAmount(p.PriceAmount.Body)
+}
+
+// Currency returns currency of price.
+// Price without CurrencyCode is of DefaultCurrencyCode of header.
+func (p Price) Currency() (Currency, bool) {
+	if p.CurrencyCode == nil {
+		return "", false
+	}
+	return Currency(p.CurrencyCode.Code()), true
+}
+
+// Type returns type of price.
+// Price without PriceTypeCode is of DefaultPriceTypeCode of header.
+func (p Price) Type() (PriceType, bool) {
+	if p.PriceTypeCode == nil {
+		return "", false
+	}
+	return PriceType(p.PriceTypeCode.Code()), true
+}
+
+// Taxes returns taxes of price.
+func (p Price) Taxes() ([]Tax, error) {
+	var taxes []Tax
+	code1, code2 := &PlainText{}, &PlainText{}
+	if p.TaxRateCode1 != nil {
+		code1.Body = p.TaxRateCode1.Code()
+	}
+	if p.TaxRateCode2 != nil {
+		code2.Body = p.TaxRateCode2.Code()
+	}
+	for _, t := range [][]*PlainText{
+		{code1, p.TaxRatePercent1, p.TaxableAmount1, p.TaxAmount1},
+		{code2, p.TaxRatePercent2, p.TaxableAmount2, p.TaxAmount2},
+	} {
+		if t[0].Body == "" && t[1] == nil && t[2] == nil && t[3] == nil {
+			continue
+		}
+		tax := Tax{Code: TaxRateCode(t[0].Body)}
+		for i, to := range []**Amount{&tax.Percent, &tax.Taxable, &tax.Amount} {
+			if t[i+1] == nil {
+				continue
+			}
+			amount, err := ParseAmount(t[i+1].Body)
+			if err != nil {
+				return nil, err
+			}
+			*to = &amount
+		}
+		taxes = append(taxes, tax)
+	}
+	return taxes, nil
+}
+
+// TaxInclusive reports whether price includes tax by its type.
+func (p Price) TaxInclusive() bool {
+	t, ok := p.Type()
+	return ok && t.IncludingTax()
+}
+
+// NetAmount returns amount of price excluding tax, which is computed from its taxes when price includes tax.
+func (p Price) NetAmount() (Amount, error) {
+	amount, err := p.Amount()
+	if err != nil || !p.TaxInclusive() {
+		return amount, err
+	}
+	taxes, err := p.Taxes()
+	if err != nil {
+		return Amount{}, err
+	}
+	var parts []taxPart
+	for _, t := range taxes {
+		parts = append(parts, taxPart{percent: t.Percent, taxable: t.Taxable, amount: t.Amount, zeroRated: t.Code == TaxRateZero})
+	}
+	return netAmountOf(amount, parts)
+}
+
+// retailPriceTypes are types of price which consumers pay, in order of precedence.
+var retailPriceTypes = []PriceType{"04", "02", "42", "34", "03", "01", "41", "33"}
+
+// RetailPriceIn returns price of product which consumers pay in currency.
+// Fixed prices take precedence over recommended ones and prices including tax take precedence over the others,
+// and prices qualified for members, libraries and so on are not regarded as retail.
+func (p Product) RetailPriceIn(currency string) (Price, bool) {
+	var found *Price
+	rank := len(retailPriceTypes)
+	for i := range p.SupplyDetails {
+		for j, price := range p.SupplyDetails[i].Prices {
+			if c, ok := price.Currency(); !ok || string(c) != currency {
+				continue
+			}
+			if price.PriceQualifier != nil {
+				if q := price.PriceQualifier.Code(); q != "00" && q != "05" {
+					continue
+				}
+			}
+			t, _ := price.Type()
+			for r, retail := range retailPriceTypes {
+				if t == retail && r < rank {
+					found, rank = &p.SupplyDetails[i].Prices[j], r
+				}
+			}
+		}
+	}
+	if found == nil {
+		return Price{}, false
+	}
+	return *found, true
+}
@@ -0,0 +1,131 @@
+package onix
+
+import "strings"
+
+// PrizeAchievement is code of List 41 which tells achievement of product for prize, such as 01 for winner.
+type PrizeAchievement string
+
+const (
+	// PrizeWinner is winner of prize.
+	PrizeWinner PrizeAchievement = "01"
+	// PrizeRunnerUp is runner-up of prize.
+	PrizeRunnerUp PrizeAchievement = "02"
+	// PrizeCommended is commended by jury of prize.
+	PrizeCommended PrizeAchievement = "03"
+	// PrizeShortListed is short-listed for prize.
+	PrizeShortListed PrizeAchievement = "04"
+	// PrizeLongListed is long-listed for prize.
+	PrizeLongListed PrizeAchievement = "05"
+	// PrizeJointWinner is one of joint winners of prize.
+	PrizeJointWinner PrizeAchievement = "06"
+	// PrizeNominated is nominated for prize.
+	PrizeNominated PrizeAchievement = "07"
+)
+
+// IsWon reports whether a is of product which won prize, jointly or not.
+func (a PrizeAchievement) IsWon() bool {
+	return a == PrizeWinner || a == PrizeJointWinner
+}
+
+// Award is a prize which product has been awarded or is listed for.
+type Award struct {
+	Name string
+	// Year is zero unless PrizeYear is given.
+	Year int64
+	// Country is a code of List 91 of country which awards prize, which is empty unless it is given.
+	Country string
+	// Achievement is empty unless PrizeCode is given.
+	Achievement PrizeAchievement
+	Jury        string
+}
+
+// Award returns prize as an Award.
+func (p Prize) Award() Award {
+	a := Award{Name: strings.TrimSpace(p.PrizeName.Body)}
+	a.Year, _ = quantityOf(p.PrizeYear)
+	if p.PrizeCountry != nil {
+		a.Country = strings.Join(p.PrizeCountry.Codes(), " ")
+	}
+	if p.PrizeCode != nil {
+		a.Achievement = PrizeAchievement(p.PrizeCode.Code())
+	}
+	if p.PrizeJury != nil {
+		a.Jury = strings.TrimSpace(string(*p.PrizeJury))
+	}
+	return a
+}
+
+// Awards returns prizes of product, which is named so since Prizes is the composite itself.
+func (p Product) Awards() []Award {
+	var awards []Award
+	for _, prize := range p.Prizes {
+		awards = append(awards, prize.Award())
+	}
+	return awards
+}
+
+// reviewTextTypes are codes of List 33 of texts which quote or are reviews.
+var reviewTextTypes = []string{"05", "06", "07", "08", "10"}
+
+// Review is a quote from review of product, or the review itself.
+type Review struct {
+	// Type is a code of List 33 such as 08 for review quote.
+	Type  string
+	Quote string
+	// Author is author of review, which is empty unless it is given.
+	Author string
+	// Source is title of publication or name of organization which review is published by.
+	Source string
+	// Date is publication date of review, whose Precision is DatePrecisionText unless it is given.
+	Date Date
+	// Link is link to review, which is empty unless it is given.
+	Link string
+}
+
+// Review returns text as a Review if it quotes or is a review.
+func (o OtherText) Review() (Review, bool) {
+	r := Review{Type: o.TextTypeCode.Code()}
+	if !containsCode(reviewTextTypes, r.Type) {
+		return Review{}, false
+	}
+	if o.Text != nil {
+		r.Quote = strings.TrimSpace(string(*o.Text))
+	}
+	if o.TextAuthor != nil {
+		r.Author = strings.TrimSpace(o.TextAuthor.Body)
+	}
+	if o.TextSourceTitle != nil {
+		r.Source = strings.TrimSpace(o.TextSourceTitle.Body)
+	} else if o.TextSourceCorporate != nil {
+		r.Source = strings.TrimSpace(o.TextSourceCorporate.Body)
+	}
+	r.Date, _ = dateOf(o.TextPublicationDate, nil)
+	if o.TextLink != nil {
+		r.Link = strings.TrimSpace(o.TextLink.Body)
+	}
+	return r, true
+}
+
+// Reviews returns reviews among OtherText composites of product,
+// which are followed by ReviewQuote elements that OtherText replaces as reviews of type 08.
+func (p Product) Reviews() []Review {
+	var reviews []Review
+	for _, o := range p.OtherTexts {
+		if r, ok := o.Review(); ok {
+			reviews = append(reviews, r)
+		}
+	}
+	for _, q := range p.ReviewQuotes {
+		reviews = append(reviews, Review{Type: "08", Quote: strings.TrimSpace(string(q))})
+	}
+	return reviews
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
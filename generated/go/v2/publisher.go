@@ -0,0 +1,132 @@
+package onix
+
+import "strings"
+
+// PublishingRoleCode is code of List 45 which tells role of publisher, such as 02 for co-publisher.
+type PublishingRoleCode string
+
+const (
+	// PublishingRolePublisher is publisher of product.
+	PublishingRolePublisher PublishingRoleCode = "01"
+	// PublishingRoleCoPublisher is one of publishers which publish product jointly.
+	PublishingRoleCoPublisher PublishingRoleCode = "02"
+	// PublishingRoleSponsor is sponsor of publication.
+	PublishingRoleSponsor PublishingRoleCode = "03"
+	// PublishingRoleOriginalLanguagePublisher is publisher of version of product in original language.
+	PublishingRoleOriginalLanguagePublisher PublishingRoleCode = "04"
+	// PublishingRoleHost is host or distributor of electronic content.
+	PublishingRoleHost PublishingRoleCode = "05"
+	// PublishingRolePublishedFor is organization which product is published for or on behalf of.
+	PublishingRolePublishedFor PublishingRoleCode = "06"
+	// PublishingRolePublishedInAssociationWith is organization which product is published in association with.
+	PublishingRolePublishedInAssociationWith PublishingRoleCode = "07"
+	// PublishingRoleNewPublisher is new or acquiring publisher of product.
+	PublishingRoleNewPublisher PublishingRoleCode = "09"
+	// PublishingRolePublishingGroup is group which publisher belongs to.
+	PublishingRolePublishingGroup PublishingRoleCode = "10"
+	// PublishingRoleFacsimileOriginalPublisher is publisher of original of facsimile.
+	PublishingRoleFacsimileOriginalPublisher PublishingRoleCode = "11"
+	// PublishingRoleRepackager is repackager of prebound edition.
+	PublishingRoleRepackager PublishingRoleCode = "12"
+	// PublishingRoleFormerPublisher is former publisher of product.
+	PublishingRoleFormerPublisher PublishingRoleCode = "13"
+	// PublishingRolePublicationFunder is funder of publication.
+	PublishingRolePublicationFunder PublishingRoleCode = "14"
+	// PublishingRoleResearchFunder is funder of research which product is based on.
+	PublishingRoleResearchFunder PublishingRoleCode = "15"
+	// PublishingRoleFundingBody is body which funds publication or research.
+	PublishingRoleFundingBody PublishingRoleCode = "16"
+	// PublishingRolePrinter is printer of product.
+	PublishingRolePrinter PublishingRoleCode = "17"
+)
+
+// Role returns role of publisher, which is publisher unless PublishingRole is given.
+func (p Publisher) Role() PublishingRoleCode {
+	if p.PublishingRole == nil {
+		return PublishingRolePublisher
+	}
+	return PublishingRoleCode(p.PublishingRole.Code())
+}
+
+// Name returns name of publisher.
+func (p Publisher) Name() (string, bool) {
+	return nameOf(p.PublisherName)
+}
+
+// Identifier returns identifier of publisher of idType, which is a code of List 44 such as 06 for GLN.
+func (p Publisher) Identifier(idType string) (string, bool) {
+	return nameCodeOf(p.NameCodeType, p.NameCodeTypeName, p.NameCodeValue, idType, "")
+}
+
+// ProprietaryID returns proprietary identifier of publisher whose NameCodeTypeName is idTypeName.
+func (p Publisher) ProprietaryID(idTypeName string) (string, bool) {
+	return nameCodeOf(p.NameCodeType, p.NameCodeTypeName, p.NameCodeValue, "01", idTypeName)
+}
+
+// Name returns name of imprint.
+func (i Imprint) Name() (string, bool) {
+	return nameOf(i.ImprintName)
+}
+
+// Identifier returns identifier of imprint of idType, which is a code of List 44 such as 06 for GLN.
+func (i Imprint) Identifier(idType string) (string, bool) {
+	return nameCodeOf(i.NameCodeType, i.NameCodeTypeName, i.NameCodeValue, idType, "")
+}
+
+// ProprietaryID returns proprietary identifier of imprint whose NameCodeTypeName is idTypeName.
+func (i Imprint) ProprietaryID(idTypeName string) (string, bool) {
+	return nameCodeOf(i.NameCodeType, i.NameCodeTypeName, i.NameCodeValue, "01", idTypeName)
+}
+
+// Imprint returns name of the first imprint of product, which is named so since ImprintName is the element itself.
+// It falls back on ImprintName element which is replaced by Imprint composite.
+func (p Product) Imprint() (string, bool) {
+	for _, i := range p.Imprints {
+		if name, ok := i.Name(); ok {
+			return name, true
+		}
+	}
+	return nameOf(p.ImprintName)
+}
+
+// PublishersOf returns names of publishers of role, where Publisher returns the name of publisher itself.
+func (p Product) PublishersOf(role PublishingRoleCode) []string {
+	var names []string
+	for _, publisher := range p.Publishers {
+		if publisher.Role() != role {
+			continue
+		}
+		if name, ok := publisher.Name(); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// CoPublishers returns names of co-publishers of product.
+func (p Product) CoPublishers() []string {
+	return p.PublishersOf(PublishingRoleCoPublisher)
+}
+
+// PublishedFor returns names of organizations which product is published for or on behalf of.
+func (p Product) PublishedFor() []string {
+	return p.PublishersOf(PublishingRolePublishedFor)
+}
+
+func nameOf(t *PlainText) (string, bool) {
+	if t == nil || strings.TrimSpace(t.Body) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(t.Body), true
+}
+
+// nameCodeOf returns value of name code of idType, whose type name is idTypeName unless it is empty.
+func nameCodeOf(codeType *NameCodeType, typeName, value *PlainText, idType, idTypeName string) (string, bool) {
+	if codeType == nil || value == nil || codeType.Code() != idType {
+		return "", false
+	}
+	if idTypeName != "" && (typeName == nil || typeName.Body != idTypeName) {
+		return "", false
+	}
+	return strings.TrimSpace(value.Body), true
+}
@@ -0,0 +1,93 @@
+package onix
+
+// ProductRelation is code of List 51 which tells how related product relates to product, such as 06 for alternative format.
+type ProductRelation string
+
+const (
+	// ProductRelationUnspecified is relation which is not specified.
+	ProductRelationUnspecified ProductRelation = "00"
+	// ProductRelationIncludes is related product which product includes.
+	ProductRelationIncludes ProductRelation = "01"
+	// ProductRelationIsPartOf is related product which product is a part of.
+	ProductRelationIsPartOf ProductRelation = "02"
+	// ProductRelationReplaces is related product which product replaces.
+	ProductRelationReplaces ProductRelation = "03"
+	// ProductRelationReplacedBy is related product which replaces product.
+	ProductRelationReplacedBy ProductRelation = "05"
+	// ProductRelationAlternativeFormat is related product of the same work in alternative format.
+	ProductRelationAlternativeFormat ProductRelation = "06"
+	// ProductRelationOtherLanguageVersion is related product which is the same work in other language.
+	ProductRelationOtherLanguageVersion ProductRelation = "11"
+	// ProductRelationEpublicationBasedOn is print product which e-publication is based on.
+	ProductRelationEpublicationBasedOn ProductRelation = "13"
+	// ProductRelationSameAuthor is related product by the same author.
+	ProductRelationSameAuthor ProductRelation = "22"
+	// ProductRelationSimilarProduct is related product which is similar to product.
+	ProductRelationSimilarProduct ProductRelation = "23"
+	// ProductRelationElectronicVersion is e-publication of the same work which is available.
+	ProductRelationElectronicVersion ProductRelation = "27"
+	// ProductRelationSameCollection is related product in the same collection.
+	ProductRelationSameCollection ProductRelation = "30"
+)
+
+// IsOtherFormat reports whether r relates the same work in other format, such as e-book of print product.
+func (r ProductRelation) IsOtherFormat() bool {
+	return r == ProductRelationAlternativeFormat || r == ProductRelationEpublicationBasedOn || r == ProductRelationElectronicVersion
+}
+
+// Relation returns how related product relates to product.
+func (r RelatedProduct) Relation() ProductRelation {
+	return ProductRelation(r.RelationCode.Code())
+}
+
+// ISBN13 returns ISBN-13 of related product.
+func (r RelatedProduct) ISBN13() (string, bool) {
+	return identifierOf(r.ProductIdentifiers, "15")
+}
+
+// ISBN10 returns ISBN-10 of related product.
+// It falls back on ISBN element which is replaced by ProductIdentifier composite.
+func (r RelatedProduct) ISBN10() (string, bool) {
+	if id, ok := identifierOf(r.ProductIdentifiers, "02"); ok {
+		return id, true
+	}
+	if r.ISBN != nil {
+		return r.ISBN.Body, true
+	}
+	return "", false
+}
+
+// GTIN13 returns GTIN-13 of related product.
+// It falls back on EAN13 element which is replaced by ProductIdentifier composite.
+func (r RelatedProduct) GTIN13() (string, bool) {
+	if id, ok := identifierOf(r.ProductIdentifiers, "03"); ok {
+		return id, true
+	}
+	if r.EAN13 != nil {
+		return r.EAN13.Body, true
+	}
+	return "", false
+}
+
+// RelatedProductsOf returns related products of product which relate to it by any of relations.
+func (p Product) RelatedProductsOf(relations ...ProductRelation) []RelatedProduct {
+	var related []RelatedProduct
+	for _, r := range p.RelatedProducts {
+		for _, relation := range relations {
+			if r.Relation() == relation {
+				related = append(related, r)
+				break
+			}
+		}
+	}
+	return related
+}
+
+func identifierOf(ids []ProductIdentifier, idType string) (string, bool) {
+	for _, id := range ids {
+		if id.ProductIDType.Code() == idType {
+			return id.IDValue.Body, true
+		}
+	}
+	return "", false
+}
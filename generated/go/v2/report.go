@@ -0,0 +1,113 @@
+package onix
+
+import (
+	"io"
+	"strings"
+)
+
+// Report is statistics of products of a message, which aggregators check on incoming feeds for quality of data.
+// Counts are of products, so that a product of two prices in the same currency is counted once.
+type Report struct {
+	Products int
+	// NotificationTypes counts products by code of List 1, such as 03 for notification confirmed on publication.
+	NotificationTypes map[string]int
+	ProductForms      map[ProductFormCode]int
+	// Publishers counts products by name of publisher.
+	Publishers map[string]int
+	// Currencies counts products which have prices in each currency.
+	Currencies map[Currency]int
+	// WithCoverImage, WithDescription and WithBISAC count products which have each of key fields,
+	// where those without them are Products less them.
+	WithCoverImage  int
+	WithDescription int
+	WithBISAC       int
+	// Violations counts codes which are not defined at codelists by their types, and ViolatingProducts counts products which have them.
+	Violations        map[string]int
+	ViolatingProducts int
+}
+
+// Analyze reads message from r product by product, and returns Report of its products.
+// Codes which are not defined at codelists are kept as they are and counted as violations, instead of aborting decoding.
+func Analyze(r io.Reader) (Report, error) {
+	report := Report{
+		NotificationTypes: map[string]int{},
+		ProductForms:      map[ProductFormCode]int{},
+		Publishers:        map[string]int{},
+		Currencies:        map[Currency]int{},
+		Violations:        map[string]int{},
+	}
+	var warnings []Warning
+	reader := NewReader(r, WithUnknownCodePolicy(UnknownCodeKeep), WithWarnings(&warnings))
+	for {
+		seen := len(warnings)
+		p, err := reader.Next()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+		report.add(*p)
+		for _, w := range warnings[seen:] {
+			report.Violations[w.Type]++
+		}
+		if len(warnings) > seen {
+			report.ViolatingProducts++
+		}
+	}
+}
+
+func (r *Report) add(p Product) {
+	r.Products++
+	r.NotificationTypes[reportedCode(p.NotificationType.Code(), p.NotificationType.Body)]++
+	if p.ProductForm != nil {
+		r.ProductForms[ProductFormCode(reportedCode(p.ProductForm.Code(), p.ProductForm.Body))]++
+	}
+	if name, ok := p.Publisher(); ok {
+		r.Publishers[strings.TrimSpace(name)]++
+	}
+	currencies := map[Currency]bool{}
+	for _, s := range p.SupplyDetails {
+		for _, price := range s.Prices {
+			if c, ok := price.Currency(); ok && !currencies[c] {
+				currencies[c] = true
+				r.Currencies[c]++
+			}
+		}
+	}
+	if _, ok := p.CoverImageURL(); ok {
+		r.WithCoverImage++
+	}
+	if _, ok := p.Description(); ok {
+		r.WithDescription++
+	}
+	if p.hasBISAC() {
+		r.WithBISAC++
+	}
+}
+
+// hasBISAC reports whether product has BISAC subject heading, which is scheme 10 of List 26 and List 27.
+func (p Product) hasBISAC() bool {
+	if p.BASICMainSubject != nil {
+		return true
+	}
+	for _, s := range p.MainSubjects {
+		if s.MainSubjectSchemeIdentifier.Code() == "10" {
+			return true
+		}
+	}
+	for _, s := range p.Subjects {
+		if s.SubjectSchemeIdentifier.Code() == "10" {
+			return true
+		}
+	}
+	return false
+}
+
+// reportedCode returns code, which falls back on body of code which is not defined at codelists and has been kept as it is.
+func reportedCode(code, body string) string {
+	if code == "" {
+		return strings.TrimSpace(body)
+	}
+	return code
+}
@@ -0,0 +1,138 @@
+package onix
+
+import "strings"
+
+// SubjectSchemeKeywords is code of List 27 of keywords, whose SubjectHeadingText is keywords separated by semicolons.
+const SubjectSchemeKeywords = "20"
+
+// SplitKeywords splits text of keywords by semicolons, where keywords are trimmed and duplicates which differ only in case are dropped.
+// Text without semicolons is split by commas instead, as senders of 2.1 have commonly written keywords.
+func SplitKeywords(text string) []string {
+	sep := ";"
+	if !strings.Contains(text, sep) {
+		sep = ","
+	}
+	return appendKeywords(nil, strings.Split(text, sep))
+}
+
+// appendKeywords appends trimmed keywords to keywords, except empty ones and the ones which keywords already have.
+func appendKeywords(keywords []string, candidates []string) []string {
+	for _, k := range candidates {
+		k = strings.Join(strings.Fields(k), " ")
+		if k == "" {
+			continue
+		}
+		duplicated := false
+		for _, existing := range keywords {
+			if strings.EqualFold(existing, k) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			keywords = append(keywords, k)
+		}
+	}
+	return keywords
+}
+
+// IsKeywords reports whether subject is of keywords.
+func (s Subject) IsKeywords() bool {
+	return s.SubjectSchemeIdentifier.Code() == SubjectSchemeKeywords
+}
+
+// Keywords returns keywords of subject, which is nil unless subject is of keywords.
+func (s Subject) Keywords() []string {
+	if !s.IsKeywords() || s.SubjectHeadingText == nil {
+		return nil
+	}
+	return SplitKeywords(s.SubjectHeadingText.Body)
+}
+
+// Keywords returns keywords of Subject composites of keywords of product in order of the message, without duplicates.
+func (p Product) Keywords() []string {
+	var keywords []string
+	for _, s := range p.Subjects {
+		keywords = appendKeywords(keywords, s.Keywords())
+	}
+	return keywords
+}
+
+// Codes of List 79 of features which warn of hazards of product, whose value is a code of the list which each of them tells.
+const (
+	// ProductFormFeatureTypeCPSIAHazard is choking hazard warning of US CPSIA of List 143, which List 79 of 2.1 codes as 11 as well.
+	ProductFormFeatureTypeCPSIAHazard = "12"
+	// ProductFormFeatureTypeEUToySafetyHazard is hazard warning of EU Toy Safety Directive of List 184.
+	ProductFormFeatureTypeEUToySafetyHazard = "13"
+	// ProductFormFeatureTypeIATADangerousGoods is warning of IATA Dangerous Goods, whose description tells the details.
+	ProductFormFeatureTypeIATADangerousGoods = "14"
+)
+
+// HazardWarning is a warning of hazard of product, such as choking hazard of small parts of toys.
+type HazardWarning struct {
+	// Type is one of ProductFormFeatureTypeCPSIAHazard, ProductFormFeatureTypeEUToySafetyHazard and ProductFormFeatureTypeIATADangerousGoods.
+	Type  string
+	Value string
+	// Description is the wording of the warning, if any.
+	Description string
+}
+
+// HazardWarning returns warning which feature tells, if it is of a hazard.
+func (f ProductFormFeature) HazardWarning() (HazardWarning, bool) {
+	t := f.ProductFormFeatureType.Code()
+	switch t {
+	case "11":
+		t = ProductFormFeatureTypeCPSIAHazard
+	case ProductFormFeatureTypeCPSIAHazard, ProductFormFeatureTypeEUToySafetyHazard, ProductFormFeatureTypeIATADangerousGoods:
+	default:
+		return HazardWarning{}, false
+	}
+	w := HazardWarning{Type: t}
+	if f.ProductFormFeatureValue != nil {
+		w.Value = strings.TrimSpace(f.ProductFormFeatureValue.Body)
+	}
+	if f.ProductFormFeatureDescription != nil {
+		w.Description = strings.TrimSpace(f.ProductFormFeatureDescription.Body)
+	}
+	return w, true
+}
+
+// HazardWarnings returns warnings of hazards which ProductFormFeature composites of product tell, in order of the message.
+func (p Product) HazardWarnings() []HazardWarning {
+	var warnings []HazardWarning
+	for _, f := range p.ProductFormFeatures {
+		if w, ok := f.HazardWarning(); ok {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+// Codes of List 140 of AudienceRestrictionFlag of supplier.
+const (
+	// AudienceRestrictionApplies is restrictions on sale to audiences, which AudienceRestrictionNote tells.
+	AudienceRestrictionApplies = "R"
+	// AudienceRestrictionIndiziert is product which German law on protection of young persons has indexed, which may not be sold to minors.
+	AudienceRestrictionIndiziert = "X"
+)
+
+// AudienceRestriction returns AudienceRestrictionFlag of supplier along with AudienceRestrictionNote, which is empty if it is absent.
+func (s SupplyDetail) AudienceRestriction() (flag, note string, ok bool) {
+	if s.AudienceRestrictionFlag == nil {
+		return "", "", false
+	}
+	if s.AudienceRestrictionNote != nil {
+		note = strings.TrimSpace(s.AudienceRestrictionNote.Body)
+	}
+	return s.AudienceRestrictionFlag.Code(), note, true
+}
+
+// IsAudienceRestricted reports whether any supplier restricts sale of product to audiences.
+func (p Product) IsAudienceRestricted() bool {
+	for _, s := range p.SupplyDetails {
+		if _, _, ok := s.AudienceRestriction(); ok {
+			return true
+		}
+	}
+	return false
+}
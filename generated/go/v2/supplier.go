@@ -0,0 +1,92 @@
+package onix
+
+import "strings"
+
+// SupplierIDTypeCode is code of List 92 which tells scheme of identifier of supplier, such as 04 for GLN.
+type SupplierIDTypeCode string
+
+const (
+	// SupplierIDTypeProprietary is proprietary identifier whose scheme is named by IDTypeName.
+	SupplierIDTypeProprietary SupplierIDTypeCode = "01"
+	// SupplierIDTypeVerkehrsnummer is Verkehrsnummer of Börsenverein des Deutschen Buchhandels.
+	SupplierIDTypeVerkehrsnummer SupplierIDTypeCode = "02"
+	// SupplierIDTypeGermanISBNAgency is identifier of publisher of German ISBN Agency.
+	SupplierIDTypeGermanISBNAgency SupplierIDTypeCode = "03"
+	// SupplierIDTypeGLN is Global Location Number of GS1.
+	SupplierIDTypeGLN SupplierIDTypeCode = "04"
+	// SupplierIDTypeSAN is Standard Address Number of book trade in North America.
+	SupplierIDTypeSAN SupplierIDTypeCode = "05"
+	// SupplierIDTypeDistributeurscode is Distributeurscode of Centraal Boekhuis in Netherlands.
+	SupplierIDTypeDistributeurscode SupplierIDTypeCode = "06"
+	// SupplierIDTypeFondscode is Fondscode of Boekenbank in Flanders.
+	SupplierIDTypeFondscode SupplierIDTypeCode = "07"
+	// SupplierIDTypeVATIdentity is identity number of value added tax.
+	SupplierIDTypeVATIdentity SupplierIDTypeCode = "23"
+)
+
+// Kind returns scheme of identifier.
+func (id SupplierIdentifier) Kind() SupplierIDTypeCode {
+	return SupplierIDTypeCode(id.SupplierIDType.Code())
+}
+
+// Value returns identifier without surrounding whitespace.
+func (id SupplierIdentifier) Value() string {
+	return strings.TrimSpace(id.IDValue.Body)
+}
+
+// SupplierIdentifier returns identifier of supplier of idType, which also takes SupplierSAN and SupplierEANLocationNumber for SAN and GLN.
+func (s SupplyDetail) SupplierIdentifier(idType SupplierIDTypeCode) (string, bool) {
+	for _, id := range s.SupplierIdentifiers {
+		if id.Kind() == idType && id.Value() != "" {
+			return id.Value(), true
+		}
+	}
+	switch idType {
+	case SupplierIDTypeSAN:
+		return nameOf(s.SupplierSAN)
+	case SupplierIDTypeGLN:
+		return nameOf(s.SupplierEANLocationNumber)
+	}
+	return "", false
+}
+
+// SupplierProprietaryID returns proprietary identifier of supplier whose IDTypeName is idTypeName.
+func (s SupplyDetail) SupplierProprietaryID(idTypeName string) (string, bool) {
+	for _, id := range s.SupplierIdentifiers {
+		if id.Kind() == SupplierIDTypeProprietary && id.IDTypeName != nil && id.IDTypeName.Body == idTypeName {
+			return id.Value(), true
+		}
+	}
+	return "", false
+}
+
+// IsSuppliedBy reports whether supplier is the one of id, which is any of its identifiers or its name regardless of case.
+func (s SupplyDetail) IsSuppliedBy(id string) bool {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return false
+	}
+	for _, i := range s.SupplierIdentifiers {
+		if strings.EqualFold(i.Value(), id) {
+			return true
+		}
+	}
+	for _, t := range []*PlainText{s.SupplierSAN, s.SupplierEANLocationNumber, s.SupplierName} {
+		if t != nil && strings.EqualFold(strings.TrimSpace(t.Body), id) {
+			return true
+		}
+	}
+	return false
+}
+
+// SupplyDetailsFrom returns SupplyDetail composites of product whose supplier is the one of id, such as its GLN, SAN or name,
+// by which orders are routed to the supplying warehouse.
+func (p Product) SupplyDetailsFrom(id string) []SupplyDetail {
+	var details []SupplyDetail
+	for _, s := range p.SupplyDetails {
+		if s.IsSuppliedBy(id) {
+			details = append(details, s)
+		}
+	}
+	return details
+}
@@ -0,0 +1,151 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProductAvailabilityCode is code of List 65 which tells availability of product from supplier, such as 21 for in stock.
+type ProductAvailabilityCode string
+
+const (
+	// ProductAvailabilityCancelled is product which is cancelled before publication.
+	ProductAvailabilityCancelled ProductAvailabilityCode = "01"
+	// ProductAvailabilityNotYetAvailable is product which is not yet available.
+	ProductAvailabilityNotYetAvailable ProductAvailabilityCode = "10"
+	// ProductAvailabilityAwaitingStock is product which is not yet available, and awaits stock.
+	ProductAvailabilityAwaitingStock ProductAvailabilityCode = "11"
+	// ProductAvailabilityAvailable is product which is available.
+	ProductAvailabilityAvailable ProductAvailabilityCode = "20"
+	// ProductAvailabilityInStock is product which is available from stock.
+	ProductAvailabilityInStock ProductAvailabilityCode = "21"
+	// ProductAvailabilityToOrder is product which is available to order from publisher.
+	ProductAvailabilityToOrder ProductAvailabilityCode = "22"
+	// ProductAvailabilityPrintOnDemand is product which is printed on demand.
+	ProductAvailabilityPrintOnDemand ProductAvailabilityCode = "23"
+	// ProductAvailabilityTemporarilyUnavailable is product which is temporarily unavailable.
+	ProductAvailabilityTemporarilyUnavailable ProductAvailabilityCode = "30"
+	// ProductAvailabilityOutOfStock is product which is out of stock, and will be restocked.
+	ProductAvailabilityOutOfStock ProductAvailabilityCode = "31"
+	// ProductAvailabilityReprinting is product which is out of stock, and is being reprinted.
+	ProductAvailabilityReprinting ProductAvailabilityCode = "32"
+	// ProductAvailabilityNotAvailable is product which is no longer available for unspecified reason.
+	ProductAvailabilityNotAvailable ProductAvailabilityCode = "40"
+	// ProductAvailabilityOutOfPrint is product which is out of print.
+	ProductAvailabilityOutOfPrint ProductAvailabilityCode = "51"
+)
+
+// IsForthcoming reports whether c is of product which is not yet available.
+func (c ProductAvailabilityCode) IsForthcoming() bool {
+	return strings.HasPrefix(string(c), "1")
+}
+
+// IsAvailable reports whether c is of product which can be ordered and supplied.
+func (c ProductAvailabilityCode) IsAvailable() bool {
+	return strings.HasPrefix(string(c), "2")
+}
+
+// IsTemporarilyUnavailable reports whether c is of product which will be available again.
+func (c ProductAvailabilityCode) IsTemporarilyUnavailable() bool {
+	return strings.HasPrefix(string(c), "3")
+}
+
+// IsUnavailable reports whether c is of product which is no longer available, or is cancelled.
+func (c ProductAvailabilityCode) IsUnavailable() bool {
+	return c == ProductAvailabilityCancelled || strings.HasPrefix(string(c), "4") || strings.HasPrefix(string(c), "5")
+}
+
+// availabilities are codes of List 65 which codes of List 54 correspond to.
+var availabilities = map[string]ProductAvailabilityCode{
+	"AB": ProductAvailabilityCancelled,
+	"NP": ProductAvailabilityNotYetAvailable,
+	"IP": ProductAvailabilityAvailable,
+	"TU": ProductAvailabilityTemporarilyUnavailable,
+	"RP": ProductAvailabilityReprinting,
+	"OP": ProductAvailabilityOutOfPrint,
+}
+
+// Availability returns availability of product from supplier.
+// It falls back on AvailabilityCode of List 54 which is replaced by ProductAvailability.
+func (s SupplyDetail) Availability() (ProductAvailabilityCode, bool) {
+	if s.ProductAvailability != nil {
+		return ProductAvailabilityCode(s.ProductAvailability.Code()), true
+	}
+	if s.AvailabilityCode != nil {
+		availability, ok := availabilities[s.AvailabilityCode.Code()]
+		return availability, ok
+	}
+	return "", false
+}
+
+// ShipDate returns date when supplier expects to ship product which is not yet available or out of stock.
+func (s SupplyDetail) ShipDate() (time.Time, bool) {
+	d, ok := dateOf(s.ExpectedShipDate, s.DateFormat)
+	return d.Time, ok
+}
+
+// OnHandQuantity returns quantity of stock on hand.
+func (s Stock) OnHandQuantity() (int64, bool) {
+	return quantityOf(s.OnHand)
+}
+
+// OnOrderQuantity returns quantity of stock on order, which falls back on the sum of OnOrderDetail composites.
+func (s Stock) OnOrderQuantity() (int64, bool) {
+	if quantity, ok := quantityOf(s.OnOrder); ok {
+		return quantity, true
+	}
+	sum, found := int64(0), false
+	for _, d := range s.OnOrderDetails {
+		if quantity, ok := quantityOf(&d.OnOrder); ok {
+			sum, found = sum+quantity, true
+		}
+	}
+	return sum, found
+}
+
+// IsAvailable reports whether any of suppliers can supply product.
+func (p Product) IsAvailable() bool {
+	for _, s := range p.SupplyDetails {
+		if availability, ok := s.Availability(); ok && availability.IsAvailable() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpectedShipDate returns the earliest date when suppliers expect to ship product.
+func (p Product) ExpectedShipDate() (time.Time, bool) {
+	var earliest time.Time
+	found := false
+	for _, s := range p.SupplyDetails {
+		if date, ok := s.ShipDate(); ok && (!found || date.Before(earliest)) {
+			earliest, found = date, true
+		}
+	}
+	return earliest, found
+}
+
+// OnOrderQuantity returns the sum of quantities of stock on order of suppliers.
+func (p Product) OnOrderQuantity() (int64, bool) {
+	sum, found := int64(0), false
+	for _, s := range p.SupplyDetails {
+		for _, stock := range s.Stocks {
+			if quantity, ok := stock.OnOrderQuantity(); ok {
+				sum, found = sum+quantity, true
+			}
+		}
+	}
+	return sum, found
+}
+
+func quantityOf(t *PlainText) (int64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	quantity, err := strconv.ParseInt(strings.TrimSpace(t.Body), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return quantity, true
+}
@@ -0,0 +1,445 @@
+package onix
+
+// ShortTags is mapping from reference tag names to short tag names.
+var ShortTags = map[string]string{
+	"AbbreviatedLength": "b276",
+	"AddresseeIDType": "m380",
+	"AddresseeIdentifier": "addresseeidentifier",
+	"Affiliation": "b046",
+	"AgentIDType": "j400",
+	"AgentIdentifier": "agentidentifier",
+	"AgentName": "j401",
+	"AgentRole": "j402",
+	"AlternativeFormatEAN13": "h133",
+	"AlternativeFormatISBN": "h132",
+	"AlternativeProductEAN13": "h164",
+	"AlternativeProductISBN": "h163",
+	"Annotation": "d100",
+	"AnnouncementDate": "b086",
+	"Audience": "audience",
+	"AudienceCode": "b073",
+	"AudienceCodeType": "b204",
+	"AudienceCodeTypeName": "b205",
+	"AudienceCodeValue": "b206",
+	"AudienceDescription": "b207",
+	"AudienceRange": "audiencerange",
+	"AudienceRangePrecision": "b075",
+	"AudienceRangeQualifier": "b074",
+	"AudienceRangeValue": "b076",
+	"AudienceRestrictionFlag": "j146",
+	"AudienceRestrictionNote": "j147",
+	"AvailabilityCode": "j141",
+	"BASICMainSubject": "b064",
+	"BASICVersion": "b200",
+	"BICDiscountGroupCode": "j150",
+	"BICMainSubject": "b065",
+	"BICVersion": "b066",
+	"Barcode": "b246",
+	"BatchBonus": "batchbonus",
+	"BatchQuantity": "j264",
+	"Bible": "bible",
+	"BibleContents": "b352",
+	"BiblePurpose": "b354",
+	"BibleReferenceLocation": "b356",
+	"BibleTextFeature": "b357",
+	"BibleTextOrganization": "b355",
+	"BibleVersion": "b353",
+	"BiographicalNote": "b044",
+	"BookClubAdoption": "k169",
+	"BookFormDetail": "b013",
+	"CBO": "j375",
+	"CityOfPublication": "b209",
+	"ClassOfTrade": "j149",
+	"Complexity": "complexity",
+	"ComplexityCode": "b078",
+	"ComplexitySchemeIdentifier": "b077",
+	"ComponentNumber": "b289",
+	"ComponentTypeName": "b288",
+	"Conference": "conference",
+	"ConferenceAcronym": "b341",
+	"ConferenceDate": "b054",
+	"ConferenceDescription": "b050",
+	"ConferenceName": "b052",
+	"ConferenceNumber": "b053",
+	"ConferencePlace": "b055",
+	"ConferenceRole": "b051",
+	"ConferenceSponsor": "conferencesponsor",
+	"ConferenceSponsorIDType": "b391",
+	"ConferenceSponsorIdentifier": "conferencesponsoridentifier",
+	"ConferenceTheme": "b342",
+	"ContainedItem": "containeditem",
+	"ContentItem": "contentitem",
+	"Contributor": "contributor",
+	"ContributorDescription": "b048",
+	"ContributorRole": "b035",
+	"ContributorStatement": "b049",
+	"CopiesSold": "k168",
+	"CopublisherName": "b084",
+	"CopyrightOwner": "copyrightowner",
+	"CopyrightOwnerIDType": "b392",
+	"CopyrightOwnerIdentifier": "copyrightowneridentifier",
+	"CopyrightStatement": "copyrightstatement",
+	"CopyrightYear": "b087",
+	"CorporateBodyAsSubject": "b071",
+	"CorporateName": "b047",
+	"CountryCode": "b251",
+	"CountryExcluded": "j304",
+	"CountryOfPublication": "b083",
+	"CoverImageFormatCode": "f111",
+	"CoverImageLink": "f113",
+	"CoverImageLinkTypeCode": "f112",
+	"CurrencyCode": "j152",
+	"DOI": "b009",
+	"Date": "b306",
+	"DateFormat": "j260",
+	"DefaultClassOfTrade": "m193",
+	"DefaultCurrencyCode": "m186",
+	"DefaultLanguageOfText": "m184",
+	"DefaultLinearUnit": "m187",
+	"DefaultPriceTypeCode": "m185",
+	"DefaultWeightUnit": "m188",
+	"DeletionCode": "a198",
+	"DeletionText": "a199",
+	"Dimensions": "c258",
+	"DiscountCode": "j364",
+	"DiscountCodeType": "j363",
+	"DiscountCodeTypeName": "j378",
+	"DiscountCoded": "discountcoded",
+	"DiscountPercent": "j267",
+	"DistinctiveTitle": "b028",
+	"DownloadCaption": "f119",
+	"DownloadCopyrightNotice": "f121",
+	"DownloadCredit": "f120",
+	"DownloadTerms": "f122",
+	"EAN13": "b005",
+	"EAN13OfSet": "b022",
+	"EditionNumber": "b057",
+	"EditionStatement": "b058",
+	"EditionTypeCode": "b056",
+	"EditionVersionNumber": "b217",
+	"EmailAddress": "j272",
+	"EndDate": "b325",
+	"EpubFormat": "b214",
+	"EpubFormatDescription": "b216",
+	"EpubFormatVersion": "b215",
+	"EpubSource": "b278",
+	"EpubSourceDescription": "b280",
+	"EpubSourceVersion": "b279",
+	"EpubType": "b211",
+	"EpubTypeDescription": "b213",
+	"EpubTypeNote": "b277",
+	"EpubTypeVersion": "b212",
+	"ExpectedDate": "j302",
+	"ExpectedShipDate": "j142",
+	"Extent": "extent",
+	"ExtentType": "b218",
+	"ExtentUnit": "b220",
+	"ExtentValue": "b219",
+	"FaxNumber": "j271",
+	"FirstPageNumber": "b286",
+	"FormerTitle": "b033",
+	"FreeQuantity": "j265",
+	"FromCompany": "m174",
+	"FromEANNumber": "m172",
+	"FromEmail": "m283",
+	"FromPerson": "m175",
+	"FromSAN": "m173",
+	"Header": "header",
+	"Height": "c096",
+	"IDTypeName": "b233",
+	"IDValue": "b244",
+	"ISBN": "b004",
+	"ISBNOfSet": "b021",
+	"ISMN": "b008",
+	"IllustrationType": "b256",
+	"IllustrationTypeDescription": "b361",
+	"Illustrations": "illustrations",
+	"IllustrationsNote": "b062",
+	"ImageResolution": "f259",
+	"Imprint": "imprint",
+	"ImprintName": "b079",
+	"InitialPrintRun": "k167",
+	"InterestAge": "b190",
+	"IntermediaryAvailabilityCode": "j348",
+	"ItemNumberWithinSet": "b026",
+	"ItemQuantity": "b015",
+	"KeyNames": "b040",
+	"Language": "language",
+	"LanguageCode": "b252",
+	"LanguageOfText": "b059",
+	"LanguageRole": "b253",
+	"LastDateForReturns": "j387",
+	"LastPageNumber": "b287",
+	"LettersAfterNames": "b042",
+	"LevelSequenceNumber": "b284",
+	"LocationIDType": "j377",
+	"LocationIdentifier": "locationidentifier",
+	"LocationName": "j349",
+	"MainDescription": "d101",
+	"MainSeriesRecord": "mainseriesrecord",
+	"MainSubject": "mainsubject",
+	"MainSubjectSchemeIdentifier": "b191",
+	"MapScale": "b063",
+	"MarketCountry": "j403",
+	"MarketCountryExcluded": "j405",
+	"MarketDate": "marketdate",
+	"MarketDateRole": "j408",
+	"MarketPublishingStatus": "j407",
+	"MarketRepresentation": "marketrepresentation",
+	"MarketRestrictionDetail": "j406",
+	"MarketTerritory": "j404",
+	"Measure": "measure",
+	"MeasureTypeCode": "c093",
+	"MeasureUnitCode": "c095",
+	"Measurement": "c094",
+	"MediaFile": "mediafile",
+	"MediaFileDate": "f373",
+	"MediaFileFormatCode": "f115",
+	"MediaFileLink": "f117",
+	"MediaFileLinkTypeCode": "f116",
+	"MediaFileTypeCode": "f114",
+	"MessageNote": "m183",
+	"MessageNumber": "m180",
+	"MessageRepeat": "m181",
+	"MinimumOrderQuantity": "j263",
+	"Name": "name",
+	"NameCodeType": "b241",
+	"NameCodeTypeName": "b242",
+	"NameCodeValue": "b243",
+	"NamesAfterKey": "b041",
+	"NamesBeforeKey": "b039",
+	"NewSupplier": "newsupplier",
+	"NoContributor": "n339",
+	"NoEdition": "n386",
+	"NoSeries": "n338",
+	"NotForSale": "notforsale",
+	"NotificationType": "a002",
+	"Number": "b257",
+	"NumberOfIllustrations": "b125",
+	"NumberOfPages": "b061",
+	"NumberOfPieces": "b210",
+	"NumberWithinSeries": "b019",
+	"ONIXMessage": "ONIXmessage",
+	"OnHand": "j350",
+	"OnOrder": "j351",
+	"OnOrderDetail": "onorderdetail",
+	"OnSaleDate": "j143",
+	"OrderTime": "j144",
+	"OriginalLanguage": "b060",
+	"OriginalPublisher": "b240",
+	"OtherText": "othertext",
+	"OutOfPrintDate": "h134",
+	"PackQuantity": "j145",
+	"PageRun": "pagerun",
+	"PagesArabic": "b255",
+	"PagesRoman": "b254",
+	"ParentIdentifier": "parentidentifier",
+	"Percent": "b337",
+	"PersonAsSubject": "personassubject",
+	"PersonDate": "persondate",
+	"PersonDateRole": "b305",
+	"PersonName": "b036",
+	"PersonNameIDType": "b390",
+	"PersonNameIdentifier": "personnameidentifier",
+	"PersonNameInverted": "b037",
+	"PersonNameType": "b250",
+	"PlaceAsSubject": "b072",
+	"PrefixToKey": "b247",
+	"Price": "price",
+	"PriceAmount": "j151",
+	"PriceEffectiveFrom": "j161",
+	"PriceEffectiveUntil": "j162",
+	"PricePer": "j239",
+	"PriceQualifier": "j261",
+	"PriceStatus": "j266",
+	"PriceTypeCode": "j148",
+	"PriceTypeDescription": "j262",
+	"Prize": "prize",
+	"PrizeCode": "g129",
+	"PrizeCountry": "g128",
+	"PrizeJury": "g343",
+	"PrizeName": "g126",
+	"PrizeYear": "g127",
+	"PrizesDescription": "g124",
+	"Product": "product",
+	"ProductAvailability": "j396",
+	"ProductClassification": "productclassification",
+	"ProductClassificationCode": "b275",
+	"ProductClassificationType": "b274",
+	"ProductContentType": "b385",
+	"ProductForm": "b012",
+	"ProductFormDescription": "b014",
+	"ProductFormDetail": "b333",
+	"ProductFormFeature": "productformfeature",
+	"ProductFormFeatureDescription": "b336",
+	"ProductFormFeatureType": "b334",
+	"ProductFormFeatureValue": "b335",
+	"ProductIDType": "b221",
+	"ProductIdentifier": "productidentifier",
+	"ProductPackaging": "b225",
+	"ProductWebsite": "productwebsite",
+	"ProductWebsiteDescription": "f170",
+	"ProductWebsiteLink": "f123",
+	"ProfessionalAffiliation": "professionalaffiliation",
+	"ProfessionalPosition": "b045",
+	"PromotionCampaign": "k165",
+	"PromotionContact": "k166",
+	"PublicationDate": "b003",
+	"Publisher": "publisher",
+	"PublisherName": "b081",
+	"PublisherProductNo": "b007",
+	"PublisherSeriesCode": "b017",
+	"PublishingRole": "b291",
+	"PublishingStatus": "b394",
+	"PublishingStatusNote": "b395",
+	"RecordReference": "a001",
+	"RecordSourceIdentifier": "a196",
+	"RecordSourceIdentifierType": "a195",
+	"RecordSourceName": "a197",
+	"RecordSourceType": "a194",
+	"RegionCode": "b398",
+	"Reissue": "reissue",
+	"ReissueDate": "j365",
+	"ReissueDescription": "j366",
+	"RelatedProduct": "relatedproduct",
+	"RelationCode": "h208",
+	"ReligiousText": "religioustext",
+	"ReligiousTextFeature": "religioustextfeature",
+	"ReligiousTextFeatureCode": "b359",
+	"ReligiousTextFeatureDescription": "b360",
+	"ReligiousTextFeatureType": "b358",
+	"ReligiousTextID": "b376",
+	"ReplacedByEAN13": "h131",
+	"ReplacedByISBN": "h130",
+	"ReplacesEAN13": "b011",
+	"ReplacesISBN": "b010",
+	"ReprintDetail": "k309",
+	"ReturnsCode": "j269",
+	"ReturnsCodeType": "j268",
+	"ReviewQuote": "e110",
+	"RightsCountry": "b090",
+	"RightsRegion": "b091",
+	"RightsTerritory": "b388",
+	"SalesOutlet": "salesoutlet",
+	"SalesOutletIDType": "b393",
+	"SalesOutletIdentifier": "salesoutletidentifier",
+	"SalesOutletName": "b382",
+	"SalesRestriction": "salesrestriction",
+	"SalesRestrictionDetail": "b383",
+	"SalesRestrictionType": "b381",
+	"SalesRights": "salesrights",
+	"SalesRightsType": "b089",
+	"SenderIDType": "m379",
+	"SenderIdentifier": "senderidentifier",
+	"SentDate": "m182",
+	"SequenceNumber": "b034",
+	"SequenceNumberWithinRole": "b340",
+	"Series": "series",
+	"SeriesIDType": "b273",
+	"SeriesISSN": "b016",
+	"SeriesIdentifier": "seriesidentifier",
+	"SeriesPartName": "b282",
+	"Set": "set",
+	"SetItemTitle": "b281",
+	"SetPartNumber": "b024",
+	"SetPartTitle": "b025",
+	"SponsorName": "b085",
+	"StartDate": "b324",
+	"Stock": "stock",
+	"StockQuantityCode": "j297",
+	"StockQuantityCodeType": "j293",
+	"StockQuantityCodeTypeName": "j296",
+	"StockQuantityCoded": "stockquantitycoded",
+	"StudyBibleType": "b389",
+	"SubSeriesRecord": "subseriesrecord",
+	"Subject": "subject",
+	"SubjectCode": "b069",
+	"SubjectHeadingText": "b070",
+	"SubjectSchemeIdentifier": "b067",
+	"SubjectSchemeName": "b171",
+	"SubjectSchemeVersion": "b068",
+	"SubordinateEntries": "a245",
+	"Subtitle": "b029",
+	"SuffixToKey": "b248",
+	"SupplierEANLocationNumber": "j135",
+	"SupplierIDType": "j345",
+	"SupplierIdentifier": "supplieridentifier",
+	"SupplierName": "j137",
+	"SupplierRole": "j292",
+	"SupplierSAN": "j136",
+	"SupplyDetail": "supplydetail",
+	"SupplyRestrictionDetail": "j399",
+	"SupplyToCountry": "j138",
+	"SupplyToCountryExcluded": "j140",
+	"SupplyToRegion": "j139",
+	"SupplyToTerritory": "j397",
+	"TaxAmount1": "j156",
+	"TaxAmount2": "j160",
+	"TaxRateCode1": "j153",
+	"TaxRateCode2": "j157",
+	"TaxRatePercent1": "j154",
+	"TaxRatePercent2": "j158",
+	"TaxableAmount1": "j155",
+	"TaxableAmount2": "j159",
+	"TelephoneNumber": "j270",
+	"Territory": "j303",
+	"TerritoryExcluded": "j308",
+	"Text": "d104",
+	"TextAuthor": "d107",
+	"TextCaseFlag": "b027",
+	"TextFormat": "d103",
+	"TextItem": "textitem",
+	"TextItemIDType": "b285",
+	"TextItemIdentifier": "textitemidentifier",
+	"TextItemType": "b290",
+	"TextLink": "d106",
+	"TextLinkType": "d105",
+	"TextPublicationDate": "d109",
+	"TextSourceCorporate": "b374",
+	"TextSourceTitle": "d108",
+	"TextTypeCode": "d102",
+	"TextWithDownload": "f118",
+	"ThesisPresentedTo": "b369",
+	"ThesisType": "b368",
+	"ThesisYear": "b370",
+	"Thickness": "c098",
+	"Title": "title",
+	"TitleOfSeries": "b018",
+	"TitleOfSet": "b023",
+	"TitlePrefix": "b030",
+	"TitleText": "b203",
+	"TitleType": "b202",
+	"TitleWithoutPrefix": "b031",
+	"TitlesAfterNames": "b043",
+	"TitlesBeforeNames": "b038",
+	"ToCompany": "m178",
+	"ToEANNumber": "m176",
+	"ToPerson": "m179",
+	"ToSAN": "m177",
+	"TradeAnnouncementDate": "b362",
+	"TradeCategory": "b384",
+	"TranslationOfTitle": "b032",
+	"UPC": "b006",
+	"USSchoolGrade": "b189",
+	"UnnamedPersons": "b249",
+	"UnpricedItemType": "j192",
+	"Website": "website",
+	"WebsiteDescription": "b294",
+	"WebsiteLink": "b295",
+	"WebsiteRole": "b367",
+	"Weight": "c099",
+	"Width": "c097",
+	"WorkIDType": "b201",
+	"WorkIdentifier": "workidentifier",
+	"YearFirstPublished": "b088",
+	"YearOfAnnual": "b020",
+}
+
+// ReferenceTags is mapping from short tag names to reference tag names.
+var ReferenceTags = map[string]string{}
+
+func init() {
+	for ref, short := range ShortTags {
+		ReferenceTags[short] = ref
+	}
+}
@@ -0,0 +1,137 @@
+package onix
+
+import "strings"
+
+const (
+	// TitleTypeDistinctive is code of List 15 of distinctive title of product.
+	TitleTypeDistinctive = "01"
+	// TitleTypeAbbreviated is code of List 15 of abbreviated title of product, such as one of limited length for displays.
+	TitleTypeAbbreviated = "05"
+)
+
+// Text returns title, where TitlePrefix is joined to TitleWithoutPrefix.
+func (t Title) Text() (string, bool) {
+	return titleOf(t.TitleText, t.TitlePrefix, t.TitleWithoutPrefix)
+}
+
+// SortKey returns title for sorting, which is TitleWithoutPrefix if title is split into TitlePrefix and it,
+// or TitleText without its leading article of language, which is a code of List 74 such as eng.
+func (t Title) SortKey(language string) (string, bool) {
+	return sortKeyOf(t.TitleText, t.TitleWithoutPrefix, language)
+}
+
+// Title returns distinctive title of product.
+// It falls back on DistinctiveTitle element which is replaced by Title composite.
+func (p Product) Title() (string, bool) {
+	if t, ok := p.titleOf(TitleTypeDistinctive); ok {
+		if title, ok := t.Text(); ok {
+			return title, true
+		}
+	}
+	return titleOf(p.DistinctiveTitle, p.TitlePrefix, p.TitleWithoutPrefix)
+}
+
+// SubtitleText returns subtitle of distinctive title of product, which is not named Subtitle after the element of product.
+// It falls back on Subtitle element which is replaced by Title composite.
+func (p Product) SubtitleText() (string, bool) {
+	if t, ok := p.titleOf(TitleTypeDistinctive); ok && t.Subtitle != nil {
+		return t.Subtitle.Body, true
+	}
+	if p.Subtitle != nil {
+		return p.Subtitle.Body, true
+	}
+	return "", false
+}
+
+// AbbreviatedTitle returns abbreviated title of product, or distinctive title unless product has it.
+func (p Product) AbbreviatedTitle() (string, bool) {
+	if t, ok := p.titleOf(TitleTypeAbbreviated); ok {
+		if title, ok := t.Text(); ok {
+			return title, true
+		}
+	}
+	return p.Title()
+}
+
+// SortTitle returns distinctive title of product for sorting, where TitlePrefix such as The is dropped.
+// Title which is not split into TitlePrefix and TitleWithoutPrefix loses its leading article of the first language of text of product,
+// which is English unless product tells it.
+func (p Product) SortTitle() (string, bool) {
+	language := "eng"
+	if languages := p.TextLanguages(); len(languages) > 0 {
+		language = languages[0].Code()
+	}
+	if t, ok := p.titleOf(TitleTypeDistinctive); ok {
+		if title, ok := t.SortKey(language); ok {
+			return title, true
+		}
+	}
+	return sortKeyOf(p.DistinctiveTitle, p.TitleWithoutPrefix, language)
+}
+
+// titleOf returns the first Title composite of titleType, which is a code of List 15.
+func (p Product) titleOf(titleType string) (Title, bool) {
+	for _, t := range p.Titles {
+		if t.TitleType.Code() == titleType {
+			return t, true
+		}
+	}
+	return Title{}, false
+}
+
+func titleOf(text, prefix, withoutPrefix *PlainText) (string, bool) {
+	if text != nil {
+		return text.Body, true
+	}
+	if withoutPrefix == nil {
+		return "", false
+	}
+	if prefix == nil {
+		return withoutPrefix.Body, true
+	}
+	return prefix.Body + " " + withoutPrefix.Body, true
+}
+
+func sortKeyOf(text, withoutPrefix *PlainText, language string) (string, bool) {
+	if withoutPrefix != nil {
+		return strings.TrimSpace(withoutPrefix.Body), true
+	}
+	if text != nil {
+		return withoutArticle(text.Body, language), true
+	}
+	return "", false
+}
+
+// leadingArticles are articles by codes of List 74 of languages, which sorting of titles ignores at the beginning of them.
+// Articles which end with an apostrophe are elided into the next word, such as l'.
+var leadingArticles = map[string][]string{
+	"eng": {"the", "an", "a"},
+	"fre": {"les", "le", "la", "une", "un", "l'", "l’"},
+	"ger": {"der", "die", "das", "eine", "ein"},
+	"spa": {"los", "las", "el", "la", "una", "un"},
+	"ita": {"gli", "il", "lo", "la", "le", "i", "una", "uno", "un", "l'", "l’"},
+	"por": {"os", "as", "o", "a", "uma", "um"},
+	"dut": {"het", "de", "een"},
+}
+
+// withoutArticle returns title without its leading article of language, which is a code of List 74 such as eng.
+// Titles which consist of nothing but the article are returned as they are.
+func withoutArticle(title, language string) string {
+	title = strings.TrimSpace(title)
+	lower := strings.ToLower(title)
+	for _, article := range leadingArticles[strings.ToLower(language)] {
+		if !strings.HasPrefix(lower, article) {
+			continue
+		}
+		rest := title[len(article):]
+		if !strings.HasSuffix(article, "'") && !strings.HasSuffix(article, "’") {
+			if rest == "" || rest[0] != ' ' {
+				continue
+			}
+		}
+		if rest = strings.TrimSpace(rest); rest != "" {
+			return rest
+		}
+	}
+	return title
+}
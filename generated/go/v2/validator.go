@@ -0,0 +1,157 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidationError is a violation of the schema which Validate has found.
+type ValidationError struct {
+	// Path is XPath-like location of the element such as /ONIXMessage/Product[1]/NotificationType.
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors is every violation found in a message.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// requiredElements are elements which the schema requires
+// even though they are generated as optional fields because they appear in a choice.
+var requiredElements = map[string][]string{
+	"Product": {"RecordReference", "NotificationType", "ProductIdentifier"},
+}
+
+// Validate checks that required elements are present and codes are defined at codelists.
+// It returns every violation found in msg, or nil when msg is valid.
+func Validate(msg *ONIXMessage) []ValidationError {
+	var errs []ValidationError
+	validate(reflect.ValueOf(msg).Elem(), "/ONIXMessage", &errs)
+	return errs
+}
+
+var marshalerType = reflect.TypeOf((*xml.Marshaler)(nil)).Elem()
+
+func validate(v reflect.Value, path string, errs *[]ValidationError) {
+	t := v.Type()
+	required := map[string]bool{}
+	for _, name := range requiredElements[t.Name()] {
+		required[name] = true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			// Attributes which PlainText embeds are of the element itself.
+			validate(v.Field(i), path, errs)
+			continue
+		}
+		tag := field.Tag.Get("xml")
+		if tag == "" || tag == "-" || strings.HasPrefix(tag, ",") {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if ref, ok := ReferenceTags[name]; ok {
+			name = ref
+		}
+		optional, attr := false, false
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "omitempty":
+				optional = true
+			case "attr":
+				attr = true
+			}
+		}
+		if required[name] {
+			optional = false
+		}
+		if attr {
+			validateAttr(v.Field(i), path+"/@"+name, optional, errs)
+			continue
+		}
+		validateField(v.Field(i), path+"/"+name, optional, errs)
+	}
+}
+
+func validateField(v reflect.Value, path string, optional bool, errs *[]ValidationError) {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Len() == 0 && !optional {
+			*errs = append(*errs, ValidationError{Path: path, Message: "at least one element is required"})
+		}
+		for i := 0; i < v.Len(); i++ {
+			validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i+1), errs)
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			validateValue(v.Elem(), path, errs)
+		}
+	default:
+		if v.IsZero() {
+			if !optional {
+				*errs = append(*errs, ValidationError{Path: path, Message: "element is required"})
+			}
+			return
+		}
+		validateValue(v, path, errs)
+	}
+}
+
+// validateAttr checks attribute which holds a code as it is,
+// since attributes are not decoded into human readable descriptions.
+func validateAttr(v reflect.Value, path string, optional bool, errs *[]ValidationError) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.IsZero() {
+		if !optional {
+			*errs = append(*errs, ValidationError{Path: path, Message: "attribute is required"})
+		}
+		return
+	}
+	code, ok := reflect.New(v.Type()).Interface().(xml.Unmarshaler)
+	if !ok || v.Kind() != reflect.String {
+		return
+	}
+	if err := decodeCode(code, v.String()); err != nil {
+		*errs = append(*errs, ValidationError{Path: path, Message: err.Error()})
+	}
+}
+
+// decodeCode sets code of codelists into c as if it has been read from message.
+func decodeCode(c xml.Unmarshaler, code string) error {
+	var element strings.Builder
+	element.WriteString("<code>")
+	xml.EscapeText(&element, []byte(code))
+	element.WriteString("</code>")
+	return xml.Unmarshal([]byte(element.String()), c)
+}
+
+func validateValue(v reflect.Value, path string, errs *[]ValidationError) {
+	if v.Type().Implements(marshalerType) {
+		if _, err := xml.Marshal(v.Interface()); err != nil {
+			*errs = append(*errs, ValidationError{Path: path, Message: err.Error()})
+		}
+		return
+	}
+	if v.Kind() == reflect.Struct {
+		validate(v, path, errs)
+	}
+}
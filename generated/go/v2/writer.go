@@ -0,0 +1,44 @@
+package onix
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+)
+
+// Write writes ONIX for Books message to file.
+func Write(output string, data *ONIXMessage) error {
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return Encode(file, data)
+}
+
+// Encode encodes ONIX for Books message with XML declaration.
+func Encode(w io.Writer, data *ONIXMessage) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(data); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+const (
+	// Namespace is namespace of message written in short tags.
+	Namespace = "http://www.editeur.org/onix/2.1/short"
+	// ReferenceNamespace is namespace of message written in reference tags.
+	ReferenceNamespace = "http://www.editeur.org/onix/2.1/reference"
+)
+
+// MarshalXML is marshaler which names root element by its short tag in Namespace.
+func (m ONIXMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type message ONIXMessage
+	start.Name = xml.Name{Space: Namespace, Local: ShortTags["ONIXMessage"]}
+	return e.EncodeElement(message(m), start)
+}
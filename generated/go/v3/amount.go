@@ -0,0 +1,122 @@
+package onix
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Amount is a decimal amount of money which holds digits as they are written in message.
+type Amount struct {
+	units int64
+	scale int64
+}
+
+// ParseAmount parses decimal amount such as 12.99.
+func ParseAmount(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	digits, scale := s, int64(0)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits, scale = s[:i]+s[i+1:], int64(len(s)-i-1)
+	}
+	if digits == "" || digits == "-" || digits == "+" || scale > 18 {
+		return Amount{}, fmt.Errorf("unexpected amount has been passed, got [%s]", s)
+	}
+	units, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Amount{}, fmt.Errorf("unexpected amount has been passed, got [%s]", s)
+	}
+	return Amount{units: units, scale: scale}, nil
+}
+
+func (a Amount) String() string {
+	s := strconv.FormatInt(a.units, 10)
+	if a.scale == 0 {
+		return s
+	}
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	for int64(len(s)) <= a.scale {
+		s = "0" + s
+	}
+	point := int64(len(s)) - a.scale
+	return sign + s[:point] + "." + s[point:]
+}
+
+// Float64 returns the nearest floating point number of a.
+func (a Amount) Float64() float64 {
+	f, _ := strconv.ParseFloat(a.String(), 64)
+	return f
+}
+
+// Cmp compares a and b, and returns -1, 0 or +1 as a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) int {
+	x, y := a.units, b.units
+	for scale := a.scale; scale < b.scale; scale++ {
+		x *= 10
+	}
+	for scale := b.scale; scale < a.scale; scale++ {
+		y *= 10
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// rat returns a as a rational number.
+func (a Amount) rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(a.units), new(big.Int).Exp(big.NewInt(10), big.NewInt(a.scale), nil))
+}
+
+// amountOf rounds r half away from zero into Amount of scale.
+func amountOf(r *big.Rat, scale int64) Amount {
+	shifted := new(big.Rat).Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil)))
+	units, remainder := new(big.Int).QuoRem(shifted.Num(), shifted.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(shifted.Denom()) >= 0 {
+		units.Add(units, big.NewInt(int64(shifted.Sign())))
+	}
+	return Amount{units: units.Int64(), scale: scale}
+}
+
+// taxPart is a tax which price includes, whose rate, taxable amount and amount may be given or not.
+type taxPart struct {
+	percent, taxable, amount *Amount
+	// zeroRated reports whether tax is of zero rate, which needs neither rate nor amount.
+	zeroRated bool
+}
+
+// netAmountOf returns amount excluding taxes of gross, which is rounded to cents unless gross is more precise.
+// Amount of tax is taken as it is given, or is computed from taxable amount and rate,
+// and rates of taxes without taxable amount apply to the whole amount excluding tax.
+func netAmountOf(gross Amount, taxes []taxPart) (Amount, error) {
+	if len(taxes) == 0 {
+		return Amount{}, fmt.Errorf("unexpected price including tax without taxes has been passed, got [%s]", gross)
+	}
+	net := gross.rat()
+	rate := big.NewRat(1, 1)
+	for _, t := range taxes {
+		switch {
+		case t.amount != nil:
+			net.Sub(net, t.amount.rat())
+		case t.percent != nil && t.taxable != nil:
+			net.Sub(net, new(big.Rat).Mul(t.taxable.rat(), new(big.Rat).Quo(t.percent.rat(), big.NewRat(100, 1))))
+		case t.percent != nil:
+			rate.Add(rate, new(big.Rat).Quo(t.percent.rat(), big.NewRat(100, 1)))
+		case t.zeroRated:
+		default:
+			return Amount{}, fmt.Errorf("unexpected tax without rate or amount has been passed to price, got [%s]", gross)
+		}
+	}
+	scale := gross.scale
+	if scale < 2 {
+		scale = 2
+	}
+	return amountOf(net.Quo(net, rate), scale), nil
+}
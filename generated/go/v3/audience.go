@@ -0,0 +1,105 @@
+package onix
+
+import "strings"
+
+// AudienceTypeCode is code of List 28 which tells audience of product, such as 02 for children and juvenile.
+type AudienceTypeCode string
+
+const (
+	// AudienceGeneral is general and trade audience.
+	AudienceGeneral AudienceTypeCode = "01"
+	// AudienceChildren is children and juvenile audience.
+	AudienceChildren AudienceTypeCode = "02"
+	// AudienceYoungAdult is young adult audience.
+	AudienceYoungAdult AudienceTypeCode = "03"
+	// AudienceSchool is audience of primary and secondary schools.
+	AudienceSchool AudienceTypeCode = "04"
+	// AudienceCollege is audience of college and higher education.
+	AudienceCollege AudienceTypeCode = "05"
+	// AudienceProfessional is professional and scholarly audience.
+	AudienceProfessional AudienceTypeCode = "06"
+	// AudienceELT is audience of teaching English as second language.
+	AudienceELT AudienceTypeCode = "07"
+	// AudienceAdultEducation is audience of adult education.
+	AudienceAdultEducation AudienceTypeCode = "08"
+	// AudienceSecondLanguage is audience of teaching second language.
+	AudienceSecondLanguage AudienceTypeCode = "09"
+)
+
+// AudienceRangeQualifierCode is code of List 30 which tells what range of audience measures, such as 17 for interest age in years.
+type AudienceRangeQualifierCode string
+
+const (
+	// AudienceRangeUSGrade is range of US school grades such as K and 3.
+	AudienceRangeUSGrade AudienceRangeQualifierCode = "11"
+	// AudienceRangeUKGrade is UK school grade.
+	AudienceRangeUKGrade AudienceRangeQualifierCode = "12"
+	// AudienceRangeReadingSpeed is reading speed in words per minute.
+	AudienceRangeReadingSpeed AudienceRangeQualifierCode = "15"
+	// AudienceRangeInterestAgeMonths is interest age in months.
+	AudienceRangeInterestAgeMonths AudienceRangeQualifierCode = "16"
+	// AudienceRangeInterestAgeYears is interest age in years.
+	AudienceRangeInterestAgeYears AudienceRangeQualifierCode = "17"
+	// AudienceRangeReadingAgeYears is reading age in years.
+	AudienceRangeReadingAgeYears AudienceRangeQualifierCode = "18"
+)
+
+// AudienceRangePrecisionCode is code of List 31 which tells which bound of range value is.
+type AudienceRangePrecisionCode string
+
+const (
+	// AudienceRangeExact is value which is both of lower and upper bounds.
+	AudienceRangeExact AudienceRangePrecisionCode = "01"
+	// AudienceRangeFrom is value of lower bound.
+	AudienceRangeFrom AudienceRangePrecisionCode = "03"
+	// AudienceRangeTo is value of upper bound.
+	AudienceRangeTo AudienceRangePrecisionCode = "04"
+)
+
+// Scheme returns code of List 29 of scheme of audience, such as 01 for audience codes of List 28 or 03 for MPAA rating.
+func (a Audience) Scheme() string {
+	return a.AudienceCodeType.Code()
+}
+
+// Value returns code of audience in its scheme.
+func (a Audience) Value() string {
+	return strings.TrimSpace(a.AudienceCodeValue.Body)
+}
+
+// Qualifier returns what range measures.
+func (r AudienceRange) Qualifier() AudienceRangeQualifierCode {
+	return AudienceRangeQualifierCode(r.AudienceRangeQualifier.Code())
+}
+
+// Bound returns value of range and which bound it is.
+// The generated model keeps only the last pair of precision and value of the composite,
+// so that the composite of both of From and To is read as To.
+func (r AudienceRange) Bound() (AudienceRangePrecisionCode, string, bool) {
+	if r.AudienceRangePrecision == nil || r.AudienceRangeValue == nil {
+		return "", "", false
+	}
+	return AudienceRangePrecisionCode(r.AudienceRangePrecision.Code()), strings.TrimSpace(r.AudienceRangeValue.Body), true
+}
+
+// RangeOf returns lower and upper bounds of ranges of qualifier, which are combined across the composites.
+// A bound which is not given is empty.
+func RangeOf(ranges []AudienceRange, qualifier AudienceRangeQualifierCode) (from, to string, ok bool) {
+	for _, r := range ranges {
+		if r.Qualifier() != qualifier {
+			continue
+		}
+		precision, value, found := r.Bound()
+		if !found {
+			continue
+		}
+		switch precision {
+		case AudienceRangeExact:
+			from, to, ok = value, value, true
+		case AudienceRangeFrom:
+			from, ok = value, true
+		case AudienceRangeTo:
+			to, ok = value, true
+		}
+	}
+	return from, to, ok
+}
@@ -0,0 +1,87 @@
+package onix
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MessageBuilder builds message step by step,
+// and checks required elements of it when the message is built.
+type MessageBuilder struct {
+	message ONIXMessage
+	err     error
+}
+
+// NewMessageBuilder returns MessageBuilder of message of release 3.0 which is sent today.
+func NewMessageBuilder() *MessageBuilder {
+	b := &MessageBuilder{message: ONIXMessage{Release: Release("3.0")}}
+	return b.SentDate(time.Now())
+}
+
+// Sender sets name of sender of message.
+func (b *MessageBuilder) Sender(name string) *MessageBuilder {
+	b.message.Header.Sender.SenderName = plainText(name)
+	return b
+}
+
+// Contact sets person of sender and email address of the person.
+func (b *MessageBuilder) Contact(person, email string) *MessageBuilder {
+	b.message.Header.Sender.ContactName = plainText(person)
+	b.message.Header.Sender.EmailAddress = plainText(email)
+	return b
+}
+
+// Addressee adds name of addressee which message is sent to.
+func (b *MessageBuilder) Addressee(name string) *MessageBuilder {
+	b.message.Header.Addressees = append(b.message.Header.Addressees, Addressee{AddresseeName: plainText(name)})
+	return b
+}
+
+// SentDate sets date when message is sent.
+func (b *MessageBuilder) SentDate(t time.Time) *MessageBuilder {
+	b.message.Header.SentDateTime = PlainText{Body: t.Format("20060102")}
+	return b
+}
+
+// DefaultLanguage sets language of text in message by code of List 74.
+func (b *MessageBuilder) DefaultLanguage(code string) *MessageBuilder {
+	b.message.Header.DefaultLanguageOfText = &DefaultLanguageOfText{}
+	b.code(b.message.Header.DefaultLanguageOfText, code)
+	return b
+}
+
+// DefaultCurrency sets currency of prices in message by code of List 96.
+func (b *MessageBuilder) DefaultCurrency(code string) *MessageBuilder {
+	b.message.Header.DefaultCurrencyCode = &DefaultCurrencyCode{}
+	b.code(b.message.Header.DefaultCurrencyCode, code)
+	return b
+}
+
+// AddProduct adds product to message.
+func (b *MessageBuilder) AddProduct(p Product) *MessageBuilder {
+	b.message.Products = append(b.message.Products, p)
+	return b
+}
+
+// Build returns message which is ready to be marshaled.
+// It returns an error when any step has failed, or ValidationErrors when required elements are missing.
+func (b *MessageBuilder) Build() (*ONIXMessage, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	message := b.message
+	if errs := Validate(&message); len(errs) > 0 {
+		return nil, ValidationErrors(errs)
+	}
+	return &message, nil
+}
+
+func (b *MessageBuilder) code(c xml.Unmarshaler, code string) {
+	if err := decodeCode(c, code); err != nil && b.err == nil {
+		b.err = err
+	}
+}
+
+func plainText(s string) *PlainText {
+	return &PlainText{Body: s}
+}
@@ -0,0 +1,69 @@
+// Package codelists describes codes of codelists Issue 52 which ONIX for Books Release 3.0 refers to.
+package codelists
+
+import "sync"
+
+type code struct {
+	heading     string
+	description string
+}
+
+var lists = map[int]map[string]code{
+}
+
+// Issue returns issue of codelists which codes are described by.
+func Issue() int {
+	return 52
+}
+
+// Describe returns heading and description of code of List listNumber,
+// where description is empty when codelists has nothing to tell more than heading.
+func Describe(listNumber int, value string) (heading, description string, ok bool) {
+	c, ok := lists[listNumber][value]
+	if !ok {
+		label, ok := Registered(listNumber, value)
+		return label, "", ok
+	}
+	if c.description == c.heading {
+		return c.heading, "", true
+	}
+	return c.heading, c.description, true
+}
+
+// registry is codes which are not defined at codelists and have been registered by List and code.
+var registry = struct {
+	sync.RWMutex
+	labels map[int]map[string]string
+}{labels: map[int]map[string]string{}}
+
+// Register registers code of List listNumber which is not defined at codelists, such as proprietary extension of trading partners,
+// so that it is decoded into label as if it is defined instead of being an error.
+// Code which is defined at codelists is decoded as it is defined regardless of registration.
+func Register(listNumber int, value, label string) {
+	registry.Lock()
+	defer registry.Unlock()
+	if registry.labels[listNumber] == nil {
+		registry.labels[listNumber] = map[string]string{}
+	}
+	registry.labels[listNumber][value] = label
+}
+
+// Registered returns label which code of List listNumber has been registered with.
+func Registered(listNumber int, value string) (string, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	label, ok := registry.labels[listNumber][value]
+	return label, ok
+}
+
+// RegisteredCode returns code of List listNumber which has been registered with label, which encodes label back to code.
+func RegisteredCode(listNumber int, label string) (string, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	for value, l := range registry.labels[listNumber] {
+		if l == label {
+			return value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,153 @@
+package codelists
+
+// listNumbers are numbers of List which code types of the model are defined as, by name of code type.
+var listNumbers = map[string]int{
+	"AVItemIDType": 193,
+	"AddresseeIDType": 44,
+	"AgentIDType": 92,
+	"AgentRole": 69,
+	"AncillaryContentType": 25,
+	"AudienceCode": 28,
+	"AudienceCodeType": 29,
+	"AudienceRangePrecision": 31,
+	"AudienceRangeQualifier": 30,
+	"BarcodeType": 141,
+	"BibleContents": 82,
+	"BiblePurpose": 85,
+	"BibleReferenceLocation": 87,
+	"BibleTextFeature": 97,
+	"BibleTextOrganization": 86,
+	"BibleVersion": 83,
+	"CitedContentType": 156,
+	"CollectionIDType": 13,
+	"CollectionSequenceType": 197,
+	"CollectionType": 148,
+	"ComplexitySchemeIdentifier": 32,
+	"ConferenceRole": 20,
+	"ConferenceSponsorIDType": 44,
+	"ContentAudience": 154,
+	"ContentDateRole": 155,
+	"ContributorDateRole": 177,
+	"ContributorPlaceRelator": 151,
+	"ContributorRole": 17,
+	"CopyrightOwnerIDType": 44,
+	"CopyrightType": 219,
+	"CountryCode": 91,
+	"CountryOfManufacture": 91,
+	"CountryOfPublication": 91,
+	"CurrencyCode": 96,
+	"CurrencyZone": 172,
+	"DateFormat": 55,
+	"DefaultCurrencyCode": 96,
+	"DefaultLanguageOfText": 74,
+	"DefaultPriceType": 58,
+	"DiscountCodeType": 100,
+	"DiscountType": 170,
+	"EditionType": 21,
+	"EpubLicenseExpressionType": 218,
+	"EpubTechnicalProtection": 144,
+	"EpubUsageStatus": 146,
+	"EpubUsageType": 145,
+	"EpubUsageUnit": 147,
+	"EventRole": 20,
+	"EventSponsorIDType": 44,
+	"ExtentType": 23,
+	"ExtentUnit": 24,
+	"FromLanguage": 74,
+	"FundingIDType": 228,
+	"Gender": 229,
+	"Illustrated": 152,
+	"ImprintIDType": 44,
+	"LanguageCode": 74,
+	"LanguageRole": 22,
+	"LocationIDType": 92,
+	"MarketDateRole": 163,
+	"MarketPublishingStatus": 68,
+	"MeasureType": 48,
+	"MeasureUnitCode": 50,
+	"NameIDType": 44,
+	"NameType": 18,
+	"NotificationType": 1,
+	"PositionOnProduct": 142,
+	"PriceCodeType": 179,
+	"PriceConditionQuantityType": 168,
+	"PriceConditionType": 167,
+	"PriceConstraintStatus": 146,
+	"PriceConstraintType": 230,
+	"PriceConstraintUnit": 147,
+	"PriceDateRole": 173,
+	"PriceIDType": 217,
+	"PricePer": 60,
+	"PriceQualifier": 59,
+	"PriceStatus": 61,
+	"PriceType": 58,
+	"PrimaryContentType": 81,
+	"PrintedOnProduct": 174,
+	"PrizeCode": 41,
+	"PrizeCountry": 91,
+	"PrizeRegion": 49,
+	"ProductAvailability": 65,
+	"ProductClassificationType": 9,
+	"ProductComposition": 2,
+	"ProductContactIDType": 44,
+	"ProductContactRole": 198,
+	"ProductContentType": 81,
+	"ProductForm": 150,
+	"ProductFormDetail": 175,
+	"ProductFormFeatureType": 79,
+	"ProductIDType": 5,
+	"ProductPackaging": 80,
+	"ProductRelationCode": 51,
+	"Proximity": 215,
+	"PublisherIDType": 44,
+	"PublishingDateRole": 163,
+	"PublishingRole": 45,
+	"PublishingStatus": 64,
+	"QuantityUnit": 169,
+	"ROWSalesRightsType": 46,
+	"RecordSourceIDType": 44,
+	"RecordSourceType": 3,
+	"RegionCode": 49,
+	"ReligiousTextFeatureCode": 90,
+	"ReligiousTextFeatureType": 89,
+	"ResourceContentType": 158,
+	"ResourceFeatureType": 160,
+	"ResourceForm": 161,
+	"ResourceMode": 159,
+	"ResourceVersionFeatureType": 162,
+	"ReturnsCodeType": 53,
+	"SalesOutletIDType": 102,
+	"SalesRestrictionType": 71,
+	"SalesRightsType": 46,
+	"ScriptCode": 121,
+	"SenderIDType": 44,
+	"StockQuantityCodeType": 70,
+	"StudyBibleType": 84,
+	"SubjectSchemeIdentifier": 27,
+	"SupplierIDType": 92,
+	"SupplierRole": 93,
+	"SupplyContactIDType": 44,
+	"SupplyContactRole": 199,
+	"SupplyDateRole": 166,
+	"TaxRateCode": 62,
+	"TaxType": 171,
+	"TextItemIDType": 43,
+	"TextItemType": 42,
+	"TextType": 153,
+	"ThesisType": 72,
+	"TitleElementLevel": 149,
+	"TitleType": 15,
+	"ToLanguage": 74,
+	"TradeCategory": 12,
+	"UnnamedPersons": 19,
+	"UnpricedItemType": 57,
+	"WebsiteRole": 73,
+	"WorkIDType": 16,
+	"WorkRelationCode": 164,
+}
+
+// ListOf returns number of List which code type of typeName is defined as, such as 5 for ProductIDType.
+func ListOf(typeName string) (int, bool) {
+	n, ok := listNumbers[typeName]
+	return n, ok
+}
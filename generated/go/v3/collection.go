@@ -0,0 +1,118 @@
+package onix
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// TitleElementLevelProduct is code of List 149 of title element of product itself.
+	TitleElementLevelProduct = "01"
+	// TitleElementLevelCollection is code of List 149 of title element of collection.
+	TitleElementLevelCollection = "02"
+)
+
+// Text returns title which element tells, where TitlePrefix is joined to TitleWithoutPrefix.
+func (e TitleElement) Text() (string, bool) {
+	if e.TitleText != nil {
+		return e.TitleText.Body, true
+	}
+	if e.TitleWithoutPrefix == nil {
+		return "", false
+	}
+	if e.TitlePrefix == nil {
+		return e.TitleWithoutPrefix.Body, true
+	}
+	return e.TitlePrefix.Body + " " + e.TitleWithoutPrefix.Body, true
+}
+
+// Element returns title element of level, which is a code of List 149.
+func (t TitleDetail) Element(level string) (TitleElement, bool) {
+	for _, e := range t.TitleElements {
+		if e.TitleElementLevel.Code() == level {
+			return e, true
+		}
+	}
+	return TitleElement{}, false
+}
+
+// Title returns distinctive title of collection.
+func (c Collection) Title() (string, bool) {
+	e, ok := c.element()
+	if !ok {
+		return "", false
+	}
+	return e.Text()
+}
+
+// PartNumber returns number of the product within collection as it is written, such as Vol. 3.
+func (c Collection) PartNumber() (string, bool) {
+	e, ok := c.element()
+	if !ok || e.PartNumber == nil {
+		return "", false
+	}
+	return e.PartNumber.Body, true
+}
+
+// Position returns sortable position of the product within collection.
+// It parses PartNumber, and falls back on CollectionSequenceNumber of the first CollectionSequence.
+func (c Collection) Position() (float64, bool) {
+	if number, ok := c.PartNumber(); ok {
+		if position, ok := ParsePosition(number); ok {
+			return position, true
+		}
+	}
+	for _, s := range c.CollectionSequences {
+		if position, ok := ParsePosition(s.CollectionSequenceNumber.Body); ok {
+			return position, true
+		}
+	}
+	return 0, false
+}
+
+// element returns title element of collection level in distinctive title.
+func (c Collection) element() (TitleElement, bool) {
+	for _, t := range c.TitleDetails {
+		if t.TitleType.Code() != "01" {
+			continue
+		}
+		if e, ok := t.Element(TitleElementLevelCollection); ok {
+			return e, true
+		}
+	}
+	return TitleElement{}, false
+}
+
+var (
+	positionNumber = regexp.MustCompile(`[0-9]+(\.[0-9]+)?`)
+	positionRoman  = regexp.MustCompile(`(?i)^[IVXLCDM]+$`)
+	romanDigits    = map[rune]float64{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+)
+
+// ParsePosition parses number within series or collection such as 3, Vol. 3, 1.5 or Part IV into sortable value.
+// The first number in arabic numerals is taken, and a word of roman numerals is taken unless there is none.
+func ParsePosition(number string) (float64, bool) {
+	if n := positionNumber.FindString(number); n != "" {
+		position, err := strconv.ParseFloat(n, 64)
+		return position, err == nil
+	}
+	for _, word := range strings.FieldsFunc(number, func(r rune) bool { return r == ' ' || r == '.' || r == ',' }) {
+		if !positionRoman.MatchString(word) {
+			continue
+		}
+		word = strings.ToUpper(word)
+		var position, last float64
+		for i := len(word) - 1; i >= 0; i-- {
+			digit := romanDigits[rune(word[i])]
+			if digit < last {
+				position -= digit
+			} else {
+				position += digit
+				last = digit
+			}
+		}
+		return position, true
+	}
+	return 0, false
+}
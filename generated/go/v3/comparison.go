@@ -0,0 +1,93 @@
+package onix
+
+import "strings"
+
+// PriceKind is code of List 58 which tells type of price, which is named so since PriceType is the element itself.
+type PriceKind string
+
+const (
+	// PriceKindRRPExcludingTax is recommended retail price excluding tax.
+	PriceKindRRPExcludingTax PriceKind = "01"
+	// PriceKindRRPIncludingTax is recommended retail price including tax.
+	PriceKindRRPIncludingTax PriceKind = "02"
+	// PriceKindFixedRetailExcludingTax is fixed retail price excluding tax.
+	PriceKindFixedRetailExcludingTax PriceKind = "03"
+	// PriceKindFixedRetailIncludingTax is fixed retail price including tax.
+	PriceKindFixedRetailIncludingTax PriceKind = "04"
+	// PriceKindSupplierNetExcludingTax is supplier's net price excluding tax.
+	PriceKindSupplierNetExcludingTax PriceKind = "05"
+	// PriceKindSupplierNetIncludingTax is supplier's net price including tax.
+	PriceKindSupplierNetIncludingTax PriceKind = "07"
+	// PriceKindPublisherRetailExcludingTax is publisher's retail price excluding tax.
+	PriceKindPublisherRetailExcludingTax PriceKind = "41"
+	// PriceKindPublisherRetailIncludingTax is publisher's retail price including tax.
+	PriceKindPublisherRetailIncludingTax PriceKind = "42"
+)
+
+// IncludingTax reports whether price of k includes tax.
+func (k PriceKind) IncludingTax() bool {
+	switch k {
+	case "02", "04", "07", "09", "12", "14", "17", "22", "24", "27", "34", "42":
+		return true
+	}
+	return false
+}
+
+// Kind returns type of price, which is empty unless PriceType is given.
+func (p Price) Kind() PriceKind {
+	if p.PriceType == nil {
+		return ""
+	}
+	return PriceKind(p.PriceType.Code())
+}
+
+// ComparisonPrice is price of another product which price is compared with,
+// such as price of print edition which price of e-book under agency terms references.
+type ComparisonPrice struct {
+	// ISBN is ISBN-13 of the product, which is taken from its GTIN-13 of prefix 978 or 979 unless it is given.
+	ISBN string
+	// GTIN is GTIN-13 of the product, which is empty unless it is given.
+	GTIN   string
+	Kind   PriceKind
+	Amount Amount
+	// Currency is ISO 4217 currency code of price.
+	Currency string
+}
+
+// Identifier returns identifier of compared product of idType, which is a code of List 5 such as 15 for ISBN-13.
+func (c ComparisonProductPrice) Identifier(idType string) (string, bool) {
+	for _, id := range c.ProductIdentifiers {
+		if id.ProductIDType.Code() == idType {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// Comparisons returns ComparisonProductPrice composites of price as ComparisonPrice,
+// whose type and currency are those of price itself unless they are given, and which is an error for malformed amount.
+func (p Price) Comparisons() ([]ComparisonPrice, error) {
+	var comparisons []ComparisonPrice
+	for _, cp := range p.ComparisonProductPrices {
+		amount, err := ParseAmount(cp.PriceAmount.Body)
+		if err != nil {
+			return nil, err
+		}
+		c := ComparisonPrice{Kind: p.Kind(), Amount: amount}
+		if cp.PriceType != nil {
+			c.Kind = PriceKind(cp.PriceType.Code())
+		}
+		if cp.CurrencyCode != nil {
+			c.Currency = cp.CurrencyCode.Code()
+		} else if p.CurrencyCode != nil {
+			c.Currency = p.CurrencyCode.Code()
+		}
+		c.ISBN, _ = cp.Identifier("15")
+		c.GTIN, _ = cp.Identifier("03")
+		if c.ISBN == "" && (strings.HasPrefix(c.GTIN, "978") || strings.HasPrefix(c.GTIN, "979")) {
+			c.ISBN = c.GTIN
+		}
+		comparisons = append(comparisons, c)
+	}
+	return comparisons, nil
+}
@@ -0,0 +1,48 @@
+package onix
+
+import "strings"
+
+// ComponentRef is a reference to item which product consists of, such as a volume of box set or of mixed pack.
+type ComponentRef struct {
+	// ISBN is ISBN-13 of item, which is taken from its GTIN-13 of prefix 978 or 979 unless it is given.
+	ISBN string
+	// GTIN is GTIN-13 of item, which is empty unless it is given.
+	GTIN string
+	Form ProductFormCode
+	// Quantity is number of copies of item in product, which is 1 unless it is given.
+	Quantity int64
+	// Primary reports whether item is the primary part of product.
+	Primary bool
+}
+
+// Component returns part as a ComponentRef.
+// Quantity is NumberOfCopies of part which is identified, and falls back on NumberOfItemsOfThisForm of part which is not.
+func (p ProductPart) Component() ComponentRef {
+	c := ComponentRef{Form: ProductFormCode(p.ProductForm.Code()), Quantity: 1, Primary: bool(p.PrimaryPart)}
+	for _, id := range p.ProductIdentifiers {
+		switch id.ProductIDType.Code() {
+		case "15":
+			c.ISBN = strings.TrimSpace(id.IDValue.Body)
+		case "03":
+			c.GTIN = strings.TrimSpace(id.IDValue.Body)
+		}
+	}
+	if c.ISBN == "" && (strings.HasPrefix(c.GTIN, "978") || strings.HasPrefix(c.GTIN, "979")) {
+		c.ISBN = c.GTIN
+	}
+	if quantity, ok := quantityOf(p.NumberOfCopies); ok {
+		c.Quantity = quantity
+	} else if quantity, ok := quantityOf(p.NumberOfItemsOfThisForm); ok {
+		c.Quantity = quantity
+	}
+	return c
+}
+
+// Components returns ProductPart composites of detail as ComponentRef, which resolve box sets and mixed packs into their items.
+func (d DescriptiveDetail) Components() []ComponentRef {
+	var components []ComponentRef
+	for _, p := range d.ProductParts {
+		components = append(components, p.Component())
+	}
+	return components
+}
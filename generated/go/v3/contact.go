@@ -0,0 +1,110 @@
+package onix
+
+import (
+	"strings"
+
+	"github.com/kogai/onix-codegen/generated/go/v3/codelists"
+)
+
+// ProductContactRoleCode is code of List 198 which tells role of contact for product, such as 09 for product safety.
+type ProductContactRoleCode string
+
+const (
+	// ProductContactRoleMetadata is contact for metadata of product.
+	ProductContactRoleMetadata ProductContactRoleCode = "00"
+	// ProductContactRoleAccessibilityRequest is contact for requests of accessible formats of product.
+	ProductContactRoleAccessibilityRequest ProductContactRoleCode = "01"
+	// ProductContactRolePromotional is contact for promotion of product.
+	ProductContactRolePromotional ProductContactRoleCode = "02"
+	// ProductContactRoleAdvertising is contact for advertising of product.
+	ProductContactRoleAdvertising ProductContactRoleCode = "03"
+	// ProductContactRoleReviewCopy is contact for review copies of product.
+	ProductContactRoleReviewCopy ProductContactRoleCode = "04"
+	// ProductContactRoleEvaluationCopy is contact for evaluation copies of product.
+	ProductContactRoleEvaluationCopy ProductContactRoleCode = "05"
+	// ProductContactRolePermissions is contact for permissions to reuse content of product.
+	ProductContactRolePermissions ProductContactRoleCode = "06"
+	// ProductContactRoleReturnAuthorisation is contact for authorisation of returns of product.
+	ProductContactRoleReturnAuthorisation ProductContactRoleCode = "07"
+	// ProductContactRoleLegalDeposit is contact for CIP or legal deposit of product.
+	ProductContactRoleLegalDeposit ProductContactRoleCode = "08"
+	// ProductContactRoleProductSafety is contact for safety of product which EU General Product Safety Regulation requires,
+	// such as manufacturer or its responsible person in EU. It is newer than codelists which the model is generated from,
+	// so that it is registered by codelists.Register to be decoded.
+	ProductContactRoleProductSafety ProductContactRoleCode = "09"
+)
+
+func init() {
+	codelists.Register(198, string(ProductContactRoleProductSafety), "Product safety contact")
+}
+
+// Role returns code of List 198 of contact.
+func (c ProductContact) Role() ProductContactRoleCode {
+	return ProductContactRoleCode(c.ProductContactRole.Code())
+}
+
+// Name returns ProductContactName of contact, which is the name of organization.
+func (c ProductContact) Name() (string, bool) {
+	return nameOf(c.ProductContactName)
+}
+
+// Person returns ContactName of contact, which is the name of person or department within organization.
+func (c ProductContact) Person() (string, bool) {
+	return nameOf(c.ContactName)
+}
+
+// Email returns EmailAddress of contact.
+func (c ProductContact) Email() (string, bool) {
+	return nameOf(c.EmailAddress)
+}
+
+// Identifier returns identifier of contact of idType, which is a code of List 44 such as 06 for GLN.
+func (c ProductContact) Identifier(idType string) (string, bool) {
+	for _, id := range c.ProductContactIdentifiers {
+		if id.ProductContactIDType.Code() == idType {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// ContactsOf returns ProductContact composites in market whose role is role.
+func (d MarketPublishingDetail) ContactsOf(role ProductContactRoleCode) []ProductContact {
+	var found []ProductContact
+	for _, c := range d.ProductContacts {
+		if c.Role() == role {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// ContactsOf returns ProductContact composites of detail whose role is role.
+func (d PublishingDetail) ContactsOf(role ProductContactRoleCode) []ProductContact {
+	var found []ProductContact
+	for _, c := range d.ProductContacts {
+		if c.Role() == role {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// SafetyContact returns the first contact for product safety which EU retailers surface to consumers.
+// ProductContact composites of PublishingDetail take precedence over the ones of MarketPublishingDetail of each supply.
+func (p Product) SafetyContact() (ProductContact, bool) {
+	if p.PublishingDetail != nil {
+		if contacts := p.PublishingDetail.ContactsOf(ProductContactRoleProductSafety); len(contacts) > 0 {
+			return contacts[0], true
+		}
+	}
+	for _, s := range p.ProductSupplys {
+		if s.MarketPublishingDetail == nil {
+			continue
+		}
+		if contacts := s.MarketPublishingDetail.ContactsOf(ProductContactRoleProductSafety); len(contacts) > 0 {
+			return contacts[0], true
+		}
+	}
+	return ProductContact{}, false
+}
@@ -0,0 +1,163 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ContributorPlaceRelatorCode is code of List 151 which tells relation of contributor to place, such as 01 for born in.
+type ContributorPlaceRelatorCode string
+
+const (
+	// ContributorPlaceAssociatedWith is place which contributor is associated with.
+	ContributorPlaceAssociatedWith ContributorPlaceRelatorCode = "00"
+	// ContributorPlaceBornIn is place where contributor is born.
+	ContributorPlaceBornIn ContributorPlaceRelatorCode = "01"
+	// ContributorPlaceDiedIn is place where contributor has died.
+	ContributorPlaceDiedIn ContributorPlaceRelatorCode = "02"
+	// ContributorPlaceFormerlyResidedIn is place where contributor has resided formerly.
+	ContributorPlaceFormerlyResidedIn ContributorPlaceRelatorCode = "03"
+	// ContributorPlaceCurrentlyResidesIn is place where contributor resides currently.
+	ContributorPlaceCurrentlyResidesIn ContributorPlaceRelatorCode = "04"
+	// ContributorPlaceEducatedIn is place where contributor has been educated.
+	ContributorPlaceEducatedIn ContributorPlaceRelatorCode = "05"
+	// ContributorPlaceWorkedIn is place where contributor has worked.
+	ContributorPlaceWorkedIn ContributorPlaceRelatorCode = "06"
+	// ContributorPlaceFlourishedIn is place where contributor has flourished.
+	ContributorPlaceFlourishedIn ContributorPlaceRelatorCode = "07"
+	// ContributorPlaceCitizenOf is country which contributor is a citizen of.
+	ContributorPlaceCitizenOf ContributorPlaceRelatorCode = "08"
+	// ContributorPlaceRegisteredIn is place where corporate contributor is registered.
+	ContributorPlaceRegisteredIn ContributorPlaceRelatorCode = "09"
+	// ContributorPlaceOperatingFrom is place which corporate contributor operates from.
+	ContributorPlaceOperatingFrom ContributorPlaceRelatorCode = "10"
+)
+
+// Link is a website of contributor, such as its own website or blog.
+type Link struct {
+	// Role is a code of List 73 such as 06 for contributor's own website, which is empty unless it is given.
+	Role string
+	// URL is the first WebsiteLink of website, which may be repeated in languages.
+	URL string
+	// Description is the first WebsiteDescription of website, which may be repeated in languages.
+	Description string
+}
+
+// Relator returns code of List 151 of place.
+func (p ContributorPlace) Relator() ContributorPlaceRelatorCode {
+	return ContributorPlaceRelatorCode(p.ContributorPlaceRelator.Code())
+}
+
+// Country returns CountryCode of place, which is empty if it is absent.
+func (p ContributorPlace) Country() string {
+	if p.CountryCode == nil {
+		return ""
+	}
+	return strings.Join(p.CountryCode.Codes(), " ")
+}
+
+// Region returns RegionCode of place, which is empty if it is absent.
+func (p ContributorPlace) Region() string {
+	if p.RegionCode == nil {
+		return ""
+	}
+	return strings.Join(p.RegionCode.Codes(), " ")
+}
+
+// Names returns LocationName of place, such as a city within the country, which may be repeated in languages.
+func (p ContributorPlace) Names() []string {
+	var names []string
+	for _, n := range p.LocationNames {
+		if name := strings.TrimSpace(n.Body); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// PlacesOf returns ContributorPlace composites of contributor whose relator is relator.
+func (c Contributor) PlacesOf(relator ContributorPlaceRelatorCode) []ContributorPlace {
+	var found []ContributorPlace
+	for _, p := range c.ContributorPlaces {
+		if p.Relator() == relator {
+			found = append(found, p)
+		}
+	}
+	return found
+}
+
+// Bio returns the first BiographicalNote of contributor as it is written, which may be XHTML that package texts sanitizes.
+// It may be repeated in languages, which package texts reads as well.
+func (c Contributor) Bio() (string, bool) {
+	for _, note := range c.BiographicalNotes {
+		if bio := strings.TrimSpace(string(note)); bio != "" {
+			return bio, true
+		}
+	}
+	return "", false
+}
+
+// Links returns Website composites of contributor whose links are given, which is named so since Websites is the field itself.
+func (c Contributor) Links() []Link {
+	var links []Link
+	for _, w := range c.Websites {
+		link := Link{}
+		for _, l := range w.WebsiteLinks {
+			if url := strings.TrimSpace(l.Body); url != "" {
+				link.URL = url
+				break
+			}
+		}
+		if link.URL == "" {
+			continue
+		}
+		if w.WebsiteRole != nil {
+			link.Role = w.WebsiteRole.Code()
+		}
+		for _, d := range w.WebsiteDescriptions {
+			if description := strings.TrimSpace(string(d)); description != "" {
+				link.Description = description
+				break
+			}
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// Born returns date of birth of contributor, which is typically a year.
+func (c Contributor) Born() (Date, bool) {
+	return c.dateOf(ContributorDateRoleBirth)
+}
+
+// Died returns date of death of contributor, which is typically a year.
+func (c Contributor) Died() (Date, bool) {
+	return c.dateOf(ContributorDateRoleDeath)
+}
+
+// Lifespan returns years of birth and death of contributor as authority headings write, such as 1835-1910 or 1947- of a living person.
+func (c Contributor) Lifespan() (string, bool) {
+	born, hasBorn := c.Born()
+	died, hasDied := c.Died()
+	if !hasBorn && !hasDied {
+		return "", false
+	}
+	span := ""
+	if hasBorn {
+		span = strconv.Itoa(born.Time.Year())
+	}
+	span += "-"
+	if hasDied {
+		span += strconv.Itoa(died.Time.Year())
+	}
+	return span, true
+}
+
+func (c Contributor) dateOf(role string) (Date, bool) {
+	for _, d := range c.ContributorDates {
+		if d.ContributorDateRole.Code() == role {
+			return d.Value()
+		}
+	}
+	return Date{}, false
+}
@@ -0,0 +1,9589 @@
+package onix
+
+// Clone returns a deep copy of product, which shares no pointers, slices nor extensions with product,
+// so that either of them can be mutated without the other being changed.
+func (p Product) Clone() Product {
+	return p.clone()
+}
+
+// Equal reports whether product has the same elements and attributes as other, without reflection.
+// Datestamp attributes are ignored since they tell when the record has been updated rather than what it tells,
+// and repeated elements are compared by their positions. Nil other is not equal to any product.
+func (p Product) Equal(other *Product) bool {
+	return other != nil && p.equal(*other)
+}
+
+// equalStrings reports whether codes which are separated by spaces are the same ones in the same order.
+func equalStrings(x, y []string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (x AVItem) clone() AVItem {
+	c := x
+	c.AVItemType = x.AVItemType.clone()
+	if x.AVItemIdentifiers != nil {
+		c.AVItemIdentifiers = make([]AVItemIdentifier, len(x.AVItemIdentifiers))
+		for i, v := range x.AVItemIdentifiers {
+			c.AVItemIdentifiers[i] = v.clone()
+		}
+	}
+	if x.TimeRuns != nil {
+		c.TimeRuns = make([]TimeRun, len(x.TimeRuns))
+		for i, v := range x.TimeRuns {
+			c.TimeRuns[i] = v.clone()
+		}
+	}
+	if x.AVDuration != nil {
+		v := x.AVDuration.clone()
+		c.AVDuration = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AVItem) equal(y AVItem) bool {
+	if !x.AVItemType.equal(y.AVItemType) {
+		return false
+	}
+	if len(x.AVItemIdentifiers) != len(y.AVItemIdentifiers) {
+		return false
+	}
+	for i := range x.AVItemIdentifiers {
+		if !x.AVItemIdentifiers[i].equal(y.AVItemIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.TimeRuns) != len(y.TimeRuns) {
+		return false
+	}
+	for i := range x.TimeRuns {
+		if !x.TimeRuns[i].equal(y.TimeRuns[i]) {
+			return false
+		}
+	}
+	if (x.AVDuration == nil) != (y.AVDuration == nil) || (x.AVDuration != nil && !x.AVDuration.equal(*y.AVDuration)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AVItemIdentifier) clone() AVItemIdentifier {
+	c := x
+	c.AVItemIDType = x.AVItemIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AVItemIdentifier) equal(y AVItemIdentifier) bool {
+	if !x.AVItemIDType.equal(y.AVItemIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Addressee) clone() Addressee {
+	c := x
+	if x.AddresseeIdentifiers != nil {
+		c.AddresseeIdentifiers = make([]AddresseeIdentifier, len(x.AddresseeIdentifiers))
+		for i, v := range x.AddresseeIdentifiers {
+			c.AddresseeIdentifiers[i] = v.clone()
+		}
+	}
+	if x.AddresseeName != nil {
+		v := x.AddresseeName.clone()
+		c.AddresseeName = &v
+	}
+	if x.ContactName != nil {
+		v := x.ContactName.clone()
+		c.ContactName = &v
+	}
+	if x.EmailAddress != nil {
+		v := x.EmailAddress.clone()
+		c.EmailAddress = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Addressee) equal(y Addressee) bool {
+	if len(x.AddresseeIdentifiers) != len(y.AddresseeIdentifiers) {
+		return false
+	}
+	for i := range x.AddresseeIdentifiers {
+		if !x.AddresseeIdentifiers[i].equal(y.AddresseeIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.AddresseeName == nil) != (y.AddresseeName == nil) || (x.AddresseeName != nil && !x.AddresseeName.equal(*y.AddresseeName)) {
+		return false
+	}
+	if (x.ContactName == nil) != (y.ContactName == nil) || (x.ContactName != nil && !x.ContactName.equal(*y.ContactName)) {
+		return false
+	}
+	if (x.EmailAddress == nil) != (y.EmailAddress == nil) || (x.EmailAddress != nil && !x.EmailAddress.equal(*y.EmailAddress)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AddresseeIdentifier) clone() AddresseeIdentifier {
+	c := x
+	c.AddresseeIDType = x.AddresseeIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AddresseeIdentifier) equal(y AddresseeIdentifier) bool {
+	if !x.AddresseeIDType.equal(y.AddresseeIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AgentIdentifier) clone() AgentIdentifier {
+	c := x
+	c.AgentIDType = x.AgentIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AgentIdentifier) equal(y AgentIdentifier) bool {
+	if !x.AgentIDType.equal(y.AgentIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AlternativeName) clone() AlternativeName {
+	c := x
+	c.NameType = x.NameType.clone()
+	if x.NameIdentifiers != nil {
+		c.NameIdentifiers = make([]NameIdentifier, len(x.NameIdentifiers))
+		for i, v := range x.NameIdentifiers {
+			c.NameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	if x.Gender != nil {
+		v := x.Gender.clone()
+		c.Gender = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.CorporateNameInverted != nil {
+		v := x.CorporateNameInverted.clone()
+		c.CorporateNameInverted = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AlternativeName) equal(y AlternativeName) bool {
+	if !x.NameType.equal(y.NameType) {
+		return false
+	}
+	if len(x.NameIdentifiers) != len(y.NameIdentifiers) {
+		return false
+	}
+	for i := range x.NameIdentifiers {
+		if !x.NameIdentifiers[i].equal(y.NameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if (x.Gender == nil) != (y.Gender == nil) || (x.Gender != nil && !x.Gender.equal(*y.Gender)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.CorporateNameInverted == nil) != (y.CorporateNameInverted == nil) || (x.CorporateNameInverted != nil && !x.CorporateNameInverted.equal(*y.CorporateNameInverted)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AncillaryContent) clone() AncillaryContent {
+	c := x
+	c.AncillaryContentType = x.AncillaryContentType.clone()
+	if x.AncillaryContentDescriptions != nil {
+		c.AncillaryContentDescriptions = make([]Flow, len(x.AncillaryContentDescriptions))
+		for i, v := range x.AncillaryContentDescriptions {
+			c.AncillaryContentDescriptions[i] = v.clone()
+		}
+	}
+	if x.Number != nil {
+		v := x.Number.clone()
+		c.Number = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AncillaryContent) equal(y AncillaryContent) bool {
+	if !x.AncillaryContentType.equal(y.AncillaryContentType) {
+		return false
+	}
+	if len(x.AncillaryContentDescriptions) != len(y.AncillaryContentDescriptions) {
+		return false
+	}
+	for i := range x.AncillaryContentDescriptions {
+		if !x.AncillaryContentDescriptions[i].equal(y.AncillaryContentDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.Number == nil) != (y.Number == nil) || (x.Number != nil && !x.Number.equal(*y.Number)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Audience) clone() Audience {
+	c := x
+	c.AudienceCodeType = x.AudienceCodeType.clone()
+	if x.AudienceCodeTypeName != nil {
+		v := x.AudienceCodeTypeName.clone()
+		c.AudienceCodeTypeName = &v
+	}
+	c.AudienceCodeValue = x.AudienceCodeValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Audience) equal(y Audience) bool {
+	if !x.AudienceCodeType.equal(y.AudienceCodeType) {
+		return false
+	}
+	if (x.AudienceCodeTypeName == nil) != (y.AudienceCodeTypeName == nil) || (x.AudienceCodeTypeName != nil && !x.AudienceCodeTypeName.equal(*y.AudienceCodeTypeName)) {
+		return false
+	}
+	if !x.AudienceCodeValue.equal(y.AudienceCodeValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x AudienceRange) clone() AudienceRange {
+	c := x
+	c.AudienceRangeQualifier = x.AudienceRangeQualifier.clone()
+	if x.AudienceRangePrecision != nil {
+		v := x.AudienceRangePrecision.clone()
+		c.AudienceRangePrecision = &v
+	}
+	if x.AudienceRangeValue != nil {
+		v := x.AudienceRangeValue.clone()
+		c.AudienceRangeValue = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x AudienceRange) equal(y AudienceRange) bool {
+	if !x.AudienceRangeQualifier.equal(y.AudienceRangeQualifier) {
+		return false
+	}
+	if (x.AudienceRangePrecision == nil) != (y.AudienceRangePrecision == nil) || (x.AudienceRangePrecision != nil && !x.AudienceRangePrecision.equal(*y.AudienceRangePrecision)) {
+		return false
+	}
+	if (x.AudienceRangeValue == nil) != (y.AudienceRangeValue == nil) || (x.AudienceRangeValue != nil && !x.AudienceRangeValue.equal(*y.AudienceRangeValue)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Barcode) clone() Barcode {
+	c := x
+	c.BarcodeType = x.BarcodeType.clone()
+	if x.PositionOnProduct != nil {
+		v := x.PositionOnProduct.clone()
+		c.PositionOnProduct = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Barcode) equal(y Barcode) bool {
+	if !x.BarcodeType.equal(y.BarcodeType) {
+		return false
+	}
+	if (x.PositionOnProduct == nil) != (y.PositionOnProduct == nil) || (x.PositionOnProduct != nil && !x.PositionOnProduct.equal(*y.PositionOnProduct)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x BatchBonus) clone() BatchBonus {
+	c := x
+	c.BatchQuantity = x.BatchQuantity.clone()
+	c.FreeQuantity = x.FreeQuantity.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x BatchBonus) equal(y BatchBonus) bool {
+	if !x.BatchQuantity.equal(y.BatchQuantity) {
+		return false
+	}
+	if !x.FreeQuantity.equal(y.FreeQuantity) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Bible) clone() Bible {
+	c := x
+	if x.BibleContentss != nil {
+		c.BibleContentss = make([]BibleContents, len(x.BibleContentss))
+		for i, v := range x.BibleContentss {
+			c.BibleContentss[i] = v.clone()
+		}
+	}
+	if x.BibleVersions != nil {
+		c.BibleVersions = make([]BibleVersion, len(x.BibleVersions))
+		for i, v := range x.BibleVersions {
+			c.BibleVersions[i] = v.clone()
+		}
+	}
+	if x.StudyBibleType != nil {
+		v := x.StudyBibleType.clone()
+		c.StudyBibleType = &v
+	}
+	if x.BiblePurposes != nil {
+		c.BiblePurposes = make([]BiblePurpose, len(x.BiblePurposes))
+		for i, v := range x.BiblePurposes {
+			c.BiblePurposes[i] = v.clone()
+		}
+	}
+	if x.BibleTextOrganization != nil {
+		v := x.BibleTextOrganization.clone()
+		c.BibleTextOrganization = &v
+	}
+	if x.BibleReferenceLocation != nil {
+		v := x.BibleReferenceLocation.clone()
+		c.BibleReferenceLocation = &v
+	}
+	if x.BibleTextFeatures != nil {
+		c.BibleTextFeatures = make([]BibleTextFeature, len(x.BibleTextFeatures))
+		for i, v := range x.BibleTextFeatures {
+			c.BibleTextFeatures[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Bible) equal(y Bible) bool {
+	if len(x.BibleContentss) != len(y.BibleContentss) {
+		return false
+	}
+	for i := range x.BibleContentss {
+		if !x.BibleContentss[i].equal(y.BibleContentss[i]) {
+			return false
+		}
+	}
+	if len(x.BibleVersions) != len(y.BibleVersions) {
+		return false
+	}
+	for i := range x.BibleVersions {
+		if !x.BibleVersions[i].equal(y.BibleVersions[i]) {
+			return false
+		}
+	}
+	if (x.StudyBibleType == nil) != (y.StudyBibleType == nil) || (x.StudyBibleType != nil && !x.StudyBibleType.equal(*y.StudyBibleType)) {
+		return false
+	}
+	if len(x.BiblePurposes) != len(y.BiblePurposes) {
+		return false
+	}
+	for i := range x.BiblePurposes {
+		if !x.BiblePurposes[i].equal(y.BiblePurposes[i]) {
+			return false
+		}
+	}
+	if (x.BibleTextOrganization == nil) != (y.BibleTextOrganization == nil) || (x.BibleTextOrganization != nil && !x.BibleTextOrganization.equal(*y.BibleTextOrganization)) {
+		return false
+	}
+	if (x.BibleReferenceLocation == nil) != (y.BibleReferenceLocation == nil) || (x.BibleReferenceLocation != nil && !x.BibleReferenceLocation.equal(*y.BibleReferenceLocation)) {
+		return false
+	}
+	if len(x.BibleTextFeatures) != len(y.BibleTextFeatures) {
+		return false
+	}
+	for i := range x.BibleTextFeatures {
+		if !x.BibleTextFeatures[i].equal(y.BibleTextFeatures[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CitedContent) clone() CitedContent {
+	c := x
+	c.CitedContentType = x.CitedContentType.clone()
+	if x.ContentAudiences != nil {
+		c.ContentAudiences = make([]ContentAudience, len(x.ContentAudiences))
+		for i, v := range x.ContentAudiences {
+			c.ContentAudiences[i] = v.clone()
+		}
+	}
+	if x.Territory != nil {
+		v := x.Territory.clone()
+		c.Territory = &v
+	}
+	if x.SourceType != nil {
+		v := x.SourceType.clone()
+		c.SourceType = &v
+	}
+	if x.ReviewRating != nil {
+		v := x.ReviewRating.clone()
+		c.ReviewRating = &v
+	}
+	if x.SourceTitles != nil {
+		c.SourceTitles = make([]PlainText, len(x.SourceTitles))
+		for i, v := range x.SourceTitles {
+			c.SourceTitles[i] = v.clone()
+		}
+	}
+	if x.ListNames != nil {
+		c.ListNames = make([]PlainText, len(x.ListNames))
+		for i, v := range x.ListNames {
+			c.ListNames[i] = v.clone()
+		}
+	}
+	if x.PositionOnList != nil {
+		v := x.PositionOnList.clone()
+		c.PositionOnList = &v
+	}
+	if x.CitationNotes != nil {
+		c.CitationNotes = make([]Flow, len(x.CitationNotes))
+		for i, v := range x.CitationNotes {
+			c.CitationNotes[i] = v.clone()
+		}
+	}
+	if x.ResourceLinks != nil {
+		c.ResourceLinks = make([]PlainText, len(x.ResourceLinks))
+		for i, v := range x.ResourceLinks {
+			c.ResourceLinks[i] = v.clone()
+		}
+	}
+	if x.ContentDates != nil {
+		c.ContentDates = make([]ContentDate, len(x.ContentDates))
+		for i, v := range x.ContentDates {
+			c.ContentDates[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CitedContent) equal(y CitedContent) bool {
+	if !x.CitedContentType.equal(y.CitedContentType) {
+		return false
+	}
+	if len(x.ContentAudiences) != len(y.ContentAudiences) {
+		return false
+	}
+	for i := range x.ContentAudiences {
+		if !x.ContentAudiences[i].equal(y.ContentAudiences[i]) {
+			return false
+		}
+	}
+	if (x.Territory == nil) != (y.Territory == nil) || (x.Territory != nil && !x.Territory.equal(*y.Territory)) {
+		return false
+	}
+	if (x.SourceType == nil) != (y.SourceType == nil) || (x.SourceType != nil && !x.SourceType.equal(*y.SourceType)) {
+		return false
+	}
+	if (x.ReviewRating == nil) != (y.ReviewRating == nil) || (x.ReviewRating != nil && !x.ReviewRating.equal(*y.ReviewRating)) {
+		return false
+	}
+	if len(x.SourceTitles) != len(y.SourceTitles) {
+		return false
+	}
+	for i := range x.SourceTitles {
+		if !x.SourceTitles[i].equal(y.SourceTitles[i]) {
+			return false
+		}
+	}
+	if len(x.ListNames) != len(y.ListNames) {
+		return false
+	}
+	for i := range x.ListNames {
+		if !x.ListNames[i].equal(y.ListNames[i]) {
+			return false
+		}
+	}
+	if (x.PositionOnList == nil) != (y.PositionOnList == nil) || (x.PositionOnList != nil && !x.PositionOnList.equal(*y.PositionOnList)) {
+		return false
+	}
+	if len(x.CitationNotes) != len(y.CitationNotes) {
+		return false
+	}
+	for i := range x.CitationNotes {
+		if !x.CitationNotes[i].equal(y.CitationNotes[i]) {
+			return false
+		}
+	}
+	if len(x.ResourceLinks) != len(y.ResourceLinks) {
+		return false
+	}
+	for i := range x.ResourceLinks {
+		if !x.ResourceLinks[i].equal(y.ResourceLinks[i]) {
+			return false
+		}
+	}
+	if len(x.ContentDates) != len(y.ContentDates) {
+		return false
+	}
+	for i := range x.ContentDates {
+		if !x.ContentDates[i].equal(y.ContentDates[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CollateralDetail) clone() CollateralDetail {
+	c := x
+	if x.TextContents != nil {
+		c.TextContents = make([]TextContent, len(x.TextContents))
+		for i, v := range x.TextContents {
+			c.TextContents[i] = v.clone()
+		}
+	}
+	if x.CitedContents != nil {
+		c.CitedContents = make([]CitedContent, len(x.CitedContents))
+		for i, v := range x.CitedContents {
+			c.CitedContents[i] = v.clone()
+		}
+	}
+	if x.SupportingResources != nil {
+		c.SupportingResources = make([]SupportingResource, len(x.SupportingResources))
+		for i, v := range x.SupportingResources {
+			c.SupportingResources[i] = v.clone()
+		}
+	}
+	if x.Prizes != nil {
+		c.Prizes = make([]Prize, len(x.Prizes))
+		for i, v := range x.Prizes {
+			c.Prizes[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CollateralDetail) equal(y CollateralDetail) bool {
+	if len(x.TextContents) != len(y.TextContents) {
+		return false
+	}
+	for i := range x.TextContents {
+		if !x.TextContents[i].equal(y.TextContents[i]) {
+			return false
+		}
+	}
+	if len(x.CitedContents) != len(y.CitedContents) {
+		return false
+	}
+	for i := range x.CitedContents {
+		if !x.CitedContents[i].equal(y.CitedContents[i]) {
+			return false
+		}
+	}
+	if len(x.SupportingResources) != len(y.SupportingResources) {
+		return false
+	}
+	for i := range x.SupportingResources {
+		if !x.SupportingResources[i].equal(y.SupportingResources[i]) {
+			return false
+		}
+	}
+	if len(x.Prizes) != len(y.Prizes) {
+		return false
+	}
+	for i := range x.Prizes {
+		if !x.Prizes[i].equal(y.Prizes[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Collection) clone() Collection {
+	c := x
+	c.CollectionType = x.CollectionType.clone()
+	if x.SourceName != nil {
+		v := x.SourceName.clone()
+		c.SourceName = &v
+	}
+	if x.CollectionIdentifiers != nil {
+		c.CollectionIdentifiers = make([]CollectionIdentifier, len(x.CollectionIdentifiers))
+		for i, v := range x.CollectionIdentifiers {
+			c.CollectionIdentifiers[i] = v.clone()
+		}
+	}
+	if x.CollectionSequences != nil {
+		c.CollectionSequences = make([]CollectionSequence, len(x.CollectionSequences))
+		for i, v := range x.CollectionSequences {
+			c.CollectionSequences[i] = v.clone()
+		}
+	}
+	if x.TitleDetails != nil {
+		c.TitleDetails = make([]TitleDetail, len(x.TitleDetails))
+		for i, v := range x.TitleDetails {
+			c.TitleDetails[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorStatements != nil {
+		c.ContributorStatements = make([]Flow, len(x.ContributorStatements))
+		for i, v := range x.ContributorStatements {
+			c.ContributorStatements[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Collection) equal(y Collection) bool {
+	if !x.CollectionType.equal(y.CollectionType) {
+		return false
+	}
+	if (x.SourceName == nil) != (y.SourceName == nil) || (x.SourceName != nil && !x.SourceName.equal(*y.SourceName)) {
+		return false
+	}
+	if len(x.CollectionIdentifiers) != len(y.CollectionIdentifiers) {
+		return false
+	}
+	for i := range x.CollectionIdentifiers {
+		if !x.CollectionIdentifiers[i].equal(y.CollectionIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.CollectionSequences) != len(y.CollectionSequences) {
+		return false
+	}
+	for i := range x.CollectionSequences {
+		if !x.CollectionSequences[i].equal(y.CollectionSequences[i]) {
+			return false
+		}
+	}
+	if len(x.TitleDetails) != len(y.TitleDetails) {
+		return false
+	}
+	for i := range x.TitleDetails {
+		if !x.TitleDetails[i].equal(y.TitleDetails[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorStatements) != len(y.ContributorStatements) {
+		return false
+	}
+	for i := range x.ContributorStatements {
+		if !x.ContributorStatements[i].equal(y.ContributorStatements[i]) {
+			return false
+		}
+	}
+	if x.NoContributor != y.NoContributor {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CollectionIdentifier) clone() CollectionIdentifier {
+	c := x
+	c.CollectionIDType = x.CollectionIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CollectionIdentifier) equal(y CollectionIdentifier) bool {
+	if !x.CollectionIDType.equal(y.CollectionIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CollectionSequence) clone() CollectionSequence {
+	c := x
+	c.CollectionSequenceType = x.CollectionSequenceType.clone()
+	if x.CollectionSequenceTypeName != nil {
+		v := x.CollectionSequenceTypeName.clone()
+		c.CollectionSequenceTypeName = &v
+	}
+	c.CollectionSequenceNumber = x.CollectionSequenceNumber.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CollectionSequence) equal(y CollectionSequence) bool {
+	if !x.CollectionSequenceType.equal(y.CollectionSequenceType) {
+		return false
+	}
+	if (x.CollectionSequenceTypeName == nil) != (y.CollectionSequenceTypeName == nil) || (x.CollectionSequenceTypeName != nil && !x.CollectionSequenceTypeName.equal(*y.CollectionSequenceTypeName)) {
+		return false
+	}
+	if !x.CollectionSequenceNumber.equal(y.CollectionSequenceNumber) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ComparisonProductPrice) clone() ComparisonProductPrice {
+	c := x
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PriceType != nil {
+		v := x.PriceType.clone()
+		c.PriceType = &v
+	}
+	c.PriceAmount = x.PriceAmount.clone()
+	if x.CurrencyCode != nil {
+		v := x.CurrencyCode.clone()
+		c.CurrencyCode = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ComparisonProductPrice) equal(y ComparisonProductPrice) bool {
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PriceType == nil) != (y.PriceType == nil) || (x.PriceType != nil && !x.PriceType.equal(*y.PriceType)) {
+		return false
+	}
+	if !x.PriceAmount.equal(y.PriceAmount) {
+		return false
+	}
+	if (x.CurrencyCode == nil) != (y.CurrencyCode == nil) || (x.CurrencyCode != nil && !x.CurrencyCode.equal(*y.CurrencyCode)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Complexity) clone() Complexity {
+	c := x
+	c.ComplexitySchemeIdentifier = x.ComplexitySchemeIdentifier.clone()
+	c.ComplexityCode = x.ComplexityCode.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Complexity) equal(y Complexity) bool {
+	if !x.ComplexitySchemeIdentifier.equal(y.ComplexitySchemeIdentifier) {
+		return false
+	}
+	if !x.ComplexityCode.equal(y.ComplexityCode) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Conference) clone() Conference {
+	c := x
+	if x.ConferenceRole != nil {
+		v := x.ConferenceRole.clone()
+		c.ConferenceRole = &v
+	}
+	c.ConferenceName = x.ConferenceName.clone()
+	if x.ConferenceAcronym != nil {
+		v := x.ConferenceAcronym.clone()
+		c.ConferenceAcronym = &v
+	}
+	if x.ConferenceNumber != nil {
+		v := x.ConferenceNumber.clone()
+		c.ConferenceNumber = &v
+	}
+	if x.ConferenceTheme != nil {
+		v := x.ConferenceTheme.clone()
+		c.ConferenceTheme = &v
+	}
+	if x.ConferenceDate != nil {
+		v := x.ConferenceDate.clone()
+		c.ConferenceDate = &v
+	}
+	if x.ConferencePlace != nil {
+		v := x.ConferencePlace.clone()
+		c.ConferencePlace = &v
+	}
+	if x.ConferenceSponsors != nil {
+		c.ConferenceSponsors = make([]ConferenceSponsor, len(x.ConferenceSponsors))
+		for i, v := range x.ConferenceSponsors {
+			c.ConferenceSponsors[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Conference) equal(y Conference) bool {
+	if (x.ConferenceRole == nil) != (y.ConferenceRole == nil) || (x.ConferenceRole != nil && !x.ConferenceRole.equal(*y.ConferenceRole)) {
+		return false
+	}
+	if !x.ConferenceName.equal(y.ConferenceName) {
+		return false
+	}
+	if (x.ConferenceAcronym == nil) != (y.ConferenceAcronym == nil) || (x.ConferenceAcronym != nil && !x.ConferenceAcronym.equal(*y.ConferenceAcronym)) {
+		return false
+	}
+	if (x.ConferenceNumber == nil) != (y.ConferenceNumber == nil) || (x.ConferenceNumber != nil && !x.ConferenceNumber.equal(*y.ConferenceNumber)) {
+		return false
+	}
+	if (x.ConferenceTheme == nil) != (y.ConferenceTheme == nil) || (x.ConferenceTheme != nil && !x.ConferenceTheme.equal(*y.ConferenceTheme)) {
+		return false
+	}
+	if (x.ConferenceDate == nil) != (y.ConferenceDate == nil) || (x.ConferenceDate != nil && !x.ConferenceDate.equal(*y.ConferenceDate)) {
+		return false
+	}
+	if (x.ConferencePlace == nil) != (y.ConferencePlace == nil) || (x.ConferencePlace != nil && !x.ConferencePlace.equal(*y.ConferencePlace)) {
+		return false
+	}
+	if len(x.ConferenceSponsors) != len(y.ConferenceSponsors) {
+		return false
+	}
+	for i := range x.ConferenceSponsors {
+		if !x.ConferenceSponsors[i].equal(y.ConferenceSponsors[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ConferenceSponsor) clone() ConferenceSponsor {
+	c := x
+	if x.ConferenceSponsorIdentifiers != nil {
+		c.ConferenceSponsorIdentifiers = make([]ConferenceSponsorIdentifier, len(x.ConferenceSponsorIdentifiers))
+		for i, v := range x.ConferenceSponsorIdentifiers {
+			c.ConferenceSponsorIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ConferenceSponsor) equal(y ConferenceSponsor) bool {
+	if len(x.ConferenceSponsorIdentifiers) != len(y.ConferenceSponsorIdentifiers) {
+		return false
+	}
+	for i := range x.ConferenceSponsorIdentifiers {
+		if !x.ConferenceSponsorIdentifiers[i].equal(y.ConferenceSponsorIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ConferenceSponsorIdentifier) clone() ConferenceSponsorIdentifier {
+	c := x
+	c.ConferenceSponsorIDType = x.ConferenceSponsorIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ConferenceSponsorIdentifier) equal(y ConferenceSponsorIdentifier) bool {
+	if !x.ConferenceSponsorIDType.equal(y.ConferenceSponsorIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContentDate) clone() ContentDate {
+	c := x
+	c.ContentDateRole = x.ContentDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContentDate) equal(y ContentDate) bool {
+	if !x.ContentDateRole.equal(y.ContentDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContentDetail) clone() ContentDetail {
+	c := x
+	if x.ContentItems != nil {
+		c.ContentItems = make([]ContentItem, len(x.ContentItems))
+		for i, v := range x.ContentItems {
+			c.ContentItems[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContentDetail) equal(y ContentDetail) bool {
+	if len(x.ContentItems) != len(y.ContentItems) {
+		return false
+	}
+	for i := range x.ContentItems {
+		if !x.ContentItems[i].equal(y.ContentItems[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContentItem) clone() ContentItem {
+	c := x
+	if x.LevelSequenceNumber != nil {
+		v := x.LevelSequenceNumber.clone()
+		c.LevelSequenceNumber = &v
+	}
+	if x.TextItem != nil {
+		v := x.TextItem.clone()
+		c.TextItem = &v
+	}
+	if x.AVItem != nil {
+		v := x.AVItem.clone()
+		c.AVItem = &v
+	}
+	if x.ComponentTypeName != nil {
+		v := x.ComponentTypeName.clone()
+		c.ComponentTypeName = &v
+	}
+	if x.ComponentNumber != nil {
+		v := x.ComponentNumber.clone()
+		c.ComponentNumber = &v
+	}
+	if x.TitleDetails != nil {
+		c.TitleDetails = make([]TitleDetail, len(x.TitleDetails))
+		for i, v := range x.TitleDetails {
+			c.TitleDetails[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorStatements != nil {
+		c.ContributorStatements = make([]Flow, len(x.ContributorStatements))
+		for i, v := range x.ContributorStatements {
+			c.ContributorStatements[i] = v.clone()
+		}
+	}
+	if x.Subjects != nil {
+		c.Subjects = make([]Subject, len(x.Subjects))
+		for i, v := range x.Subjects {
+			c.Subjects[i] = v.clone()
+		}
+	}
+	if x.NameAsSubjects != nil {
+		c.NameAsSubjects = make([]NameAsSubject, len(x.NameAsSubjects))
+		for i, v := range x.NameAsSubjects {
+			c.NameAsSubjects[i] = v.clone()
+		}
+	}
+	if x.TextContents != nil {
+		c.TextContents = make([]TextContent, len(x.TextContents))
+		for i, v := range x.TextContents {
+			c.TextContents[i] = v.clone()
+		}
+	}
+	if x.CitedContents != nil {
+		c.CitedContents = make([]CitedContent, len(x.CitedContents))
+		for i, v := range x.CitedContents {
+			c.CitedContents[i] = v.clone()
+		}
+	}
+	if x.SupportingResources != nil {
+		c.SupportingResources = make([]SupportingResource, len(x.SupportingResources))
+		for i, v := range x.SupportingResources {
+			c.SupportingResources[i] = v.clone()
+		}
+	}
+	if x.RelatedWorks != nil {
+		c.RelatedWorks = make([]RelatedWork, len(x.RelatedWorks))
+		for i, v := range x.RelatedWorks {
+			c.RelatedWorks[i] = v.clone()
+		}
+	}
+	if x.RelatedProducts != nil {
+		c.RelatedProducts = make([]RelatedProduct, len(x.RelatedProducts))
+		for i, v := range x.RelatedProducts {
+			c.RelatedProducts[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContentItem) equal(y ContentItem) bool {
+	if (x.LevelSequenceNumber == nil) != (y.LevelSequenceNumber == nil) || (x.LevelSequenceNumber != nil && !x.LevelSequenceNumber.equal(*y.LevelSequenceNumber)) {
+		return false
+	}
+	if (x.TextItem == nil) != (y.TextItem == nil) || (x.TextItem != nil && !x.TextItem.equal(*y.TextItem)) {
+		return false
+	}
+	if (x.AVItem == nil) != (y.AVItem == nil) || (x.AVItem != nil && !x.AVItem.equal(*y.AVItem)) {
+		return false
+	}
+	if (x.ComponentTypeName == nil) != (y.ComponentTypeName == nil) || (x.ComponentTypeName != nil && !x.ComponentTypeName.equal(*y.ComponentTypeName)) {
+		return false
+	}
+	if (x.ComponentNumber == nil) != (y.ComponentNumber == nil) || (x.ComponentNumber != nil && !x.ComponentNumber.equal(*y.ComponentNumber)) {
+		return false
+	}
+	if len(x.TitleDetails) != len(y.TitleDetails) {
+		return false
+	}
+	for i := range x.TitleDetails {
+		if !x.TitleDetails[i].equal(y.TitleDetails[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorStatements) != len(y.ContributorStatements) {
+		return false
+	}
+	for i := range x.ContributorStatements {
+		if !x.ContributorStatements[i].equal(y.ContributorStatements[i]) {
+			return false
+		}
+	}
+	if x.NoContributor != y.NoContributor {
+		return false
+	}
+	if len(x.Subjects) != len(y.Subjects) {
+		return false
+	}
+	for i := range x.Subjects {
+		if !x.Subjects[i].equal(y.Subjects[i]) {
+			return false
+		}
+	}
+	if len(x.NameAsSubjects) != len(y.NameAsSubjects) {
+		return false
+	}
+	for i := range x.NameAsSubjects {
+		if !x.NameAsSubjects[i].equal(y.NameAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.TextContents) != len(y.TextContents) {
+		return false
+	}
+	for i := range x.TextContents {
+		if !x.TextContents[i].equal(y.TextContents[i]) {
+			return false
+		}
+	}
+	if len(x.CitedContents) != len(y.CitedContents) {
+		return false
+	}
+	for i := range x.CitedContents {
+		if !x.CitedContents[i].equal(y.CitedContents[i]) {
+			return false
+		}
+	}
+	if len(x.SupportingResources) != len(y.SupportingResources) {
+		return false
+	}
+	for i := range x.SupportingResources {
+		if !x.SupportingResources[i].equal(y.SupportingResources[i]) {
+			return false
+		}
+	}
+	if len(x.RelatedWorks) != len(y.RelatedWorks) {
+		return false
+	}
+	for i := range x.RelatedWorks {
+		if !x.RelatedWorks[i].equal(y.RelatedWorks[i]) {
+			return false
+		}
+	}
+	if len(x.RelatedProducts) != len(y.RelatedProducts) {
+		return false
+	}
+	for i := range x.RelatedProducts {
+		if !x.RelatedProducts[i].equal(y.RelatedProducts[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Contributor) clone() Contributor {
+	c := x
+	if x.SequenceNumber != nil {
+		v := x.SequenceNumber.clone()
+		c.SequenceNumber = &v
+	}
+	if x.ContributorRoles != nil {
+		c.ContributorRoles = make([]ContributorRole, len(x.ContributorRoles))
+		for i, v := range x.ContributorRoles {
+			c.ContributorRoles[i] = v.clone()
+		}
+	}
+	if x.FromLanguages != nil {
+		c.FromLanguages = make([]FromLanguage, len(x.FromLanguages))
+		for i, v := range x.FromLanguages {
+			c.FromLanguages[i] = v.clone()
+		}
+	}
+	if x.ToLanguages != nil {
+		c.ToLanguages = make([]ToLanguage, len(x.ToLanguages))
+		for i, v := range x.ToLanguages {
+			c.ToLanguages[i] = v.clone()
+		}
+	}
+	if x.NameType != nil {
+		v := x.NameType.clone()
+		c.NameType = &v
+	}
+	if x.NameIdentifiers != nil {
+		c.NameIdentifiers = make([]NameIdentifier, len(x.NameIdentifiers))
+		for i, v := range x.NameIdentifiers {
+			c.NameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	if x.Gender != nil {
+		v := x.Gender.clone()
+		c.Gender = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.CorporateNameInverted != nil {
+		v := x.CorporateNameInverted.clone()
+		c.CorporateNameInverted = &v
+	}
+	if x.UnnamedPersons != nil {
+		v := x.UnnamedPersons.clone()
+		c.UnnamedPersons = &v
+	}
+	if x.AlternativeNames != nil {
+		c.AlternativeNames = make([]AlternativeName, len(x.AlternativeNames))
+		for i, v := range x.AlternativeNames {
+			c.AlternativeNames[i] = v.clone()
+		}
+	}
+	if x.ContributorDates != nil {
+		c.ContributorDates = make([]ContributorDate, len(x.ContributorDates))
+		for i, v := range x.ContributorDates {
+			c.ContributorDates[i] = v.clone()
+		}
+	}
+	if x.ProfessionalAffiliations != nil {
+		c.ProfessionalAffiliations = make([]ProfessionalAffiliation, len(x.ProfessionalAffiliations))
+		for i, v := range x.ProfessionalAffiliations {
+			c.ProfessionalAffiliations[i] = v.clone()
+		}
+	}
+	if x.Prizes != nil {
+		c.Prizes = make([]Prize, len(x.Prizes))
+		for i, v := range x.Prizes {
+			c.Prizes[i] = v.clone()
+		}
+	}
+	if x.BiographicalNotes != nil {
+		c.BiographicalNotes = make([]Flow, len(x.BiographicalNotes))
+		for i, v := range x.BiographicalNotes {
+			c.BiographicalNotes[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.ContributorDescriptions != nil {
+		c.ContributorDescriptions = make([]Flow, len(x.ContributorDescriptions))
+		for i, v := range x.ContributorDescriptions {
+			c.ContributorDescriptions[i] = v.clone()
+		}
+	}
+	if x.ContributorPlaces != nil {
+		c.ContributorPlaces = make([]ContributorPlace, len(x.ContributorPlaces))
+		for i, v := range x.ContributorPlaces {
+			c.ContributorPlaces[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Contributor) equal(y Contributor) bool {
+	if (x.SequenceNumber == nil) != (y.SequenceNumber == nil) || (x.SequenceNumber != nil && !x.SequenceNumber.equal(*y.SequenceNumber)) {
+		return false
+	}
+	if len(x.ContributorRoles) != len(y.ContributorRoles) {
+		return false
+	}
+	for i := range x.ContributorRoles {
+		if !x.ContributorRoles[i].equal(y.ContributorRoles[i]) {
+			return false
+		}
+	}
+	if len(x.FromLanguages) != len(y.FromLanguages) {
+		return false
+	}
+	for i := range x.FromLanguages {
+		if !x.FromLanguages[i].equal(y.FromLanguages[i]) {
+			return false
+		}
+	}
+	if len(x.ToLanguages) != len(y.ToLanguages) {
+		return false
+	}
+	for i := range x.ToLanguages {
+		if !x.ToLanguages[i].equal(y.ToLanguages[i]) {
+			return false
+		}
+	}
+	if (x.NameType == nil) != (y.NameType == nil) || (x.NameType != nil && !x.NameType.equal(*y.NameType)) {
+		return false
+	}
+	if len(x.NameIdentifiers) != len(y.NameIdentifiers) {
+		return false
+	}
+	for i := range x.NameIdentifiers {
+		if !x.NameIdentifiers[i].equal(y.NameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if (x.Gender == nil) != (y.Gender == nil) || (x.Gender != nil && !x.Gender.equal(*y.Gender)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.CorporateNameInverted == nil) != (y.CorporateNameInverted == nil) || (x.CorporateNameInverted != nil && !x.CorporateNameInverted.equal(*y.CorporateNameInverted)) {
+		return false
+	}
+	if (x.UnnamedPersons == nil) != (y.UnnamedPersons == nil) || (x.UnnamedPersons != nil && !x.UnnamedPersons.equal(*y.UnnamedPersons)) {
+		return false
+	}
+	if len(x.AlternativeNames) != len(y.AlternativeNames) {
+		return false
+	}
+	for i := range x.AlternativeNames {
+		if !x.AlternativeNames[i].equal(y.AlternativeNames[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorDates) != len(y.ContributorDates) {
+		return false
+	}
+	for i := range x.ContributorDates {
+		if !x.ContributorDates[i].equal(y.ContributorDates[i]) {
+			return false
+		}
+	}
+	if len(x.ProfessionalAffiliations) != len(y.ProfessionalAffiliations) {
+		return false
+	}
+	for i := range x.ProfessionalAffiliations {
+		if !x.ProfessionalAffiliations[i].equal(y.ProfessionalAffiliations[i]) {
+			return false
+		}
+	}
+	if len(x.Prizes) != len(y.Prizes) {
+		return false
+	}
+	for i := range x.Prizes {
+		if !x.Prizes[i].equal(y.Prizes[i]) {
+			return false
+		}
+	}
+	if len(x.BiographicalNotes) != len(y.BiographicalNotes) {
+		return false
+	}
+	for i := range x.BiographicalNotes {
+		if !x.BiographicalNotes[i].equal(y.BiographicalNotes[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorDescriptions) != len(y.ContributorDescriptions) {
+		return false
+	}
+	for i := range x.ContributorDescriptions {
+		if !x.ContributorDescriptions[i].equal(y.ContributorDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorPlaces) != len(y.ContributorPlaces) {
+		return false
+	}
+	for i := range x.ContributorPlaces {
+		if !x.ContributorPlaces[i].equal(y.ContributorPlaces[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContributorDate) clone() ContributorDate {
+	c := x
+	c.ContributorDateRole = x.ContributorDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContributorDate) equal(y ContributorDate) bool {
+	if !x.ContributorDateRole.equal(y.ContributorDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContributorPlace) clone() ContributorPlace {
+	c := x
+	c.ContributorPlaceRelator = x.ContributorPlaceRelator.clone()
+	if x.CountryCode != nil {
+		v := x.CountryCode.clone()
+		c.CountryCode = &v
+	}
+	if x.RegionCode != nil {
+		v := x.RegionCode.clone()
+		c.RegionCode = &v
+	}
+	if x.LocationNames != nil {
+		c.LocationNames = make([]PlainText, len(x.LocationNames))
+		for i, v := range x.LocationNames {
+			c.LocationNames[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContributorPlace) equal(y ContributorPlace) bool {
+	if !x.ContributorPlaceRelator.equal(y.ContributorPlaceRelator) {
+		return false
+	}
+	if (x.CountryCode == nil) != (y.CountryCode == nil) || (x.CountryCode != nil && !x.CountryCode.equal(*y.CountryCode)) {
+		return false
+	}
+	if (x.RegionCode == nil) != (y.RegionCode == nil) || (x.RegionCode != nil && !x.RegionCode.equal(*y.RegionCode)) {
+		return false
+	}
+	if len(x.LocationNames) != len(y.LocationNames) {
+		return false
+	}
+	for i := range x.LocationNames {
+		if !x.LocationNames[i].equal(y.LocationNames[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ContributorReference) clone() ContributorReference {
+	c := x
+	if x.SequenceNumber != nil {
+		v := x.SequenceNumber.clone()
+		c.SequenceNumber = &v
+	}
+	if x.ContributorRoles != nil {
+		c.ContributorRoles = make([]ContributorRole, len(x.ContributorRoles))
+		for i, v := range x.ContributorRoles {
+			c.ContributorRoles[i] = v.clone()
+		}
+	}
+	if x.NameIdentifiers != nil {
+		c.NameIdentifiers = make([]NameIdentifier, len(x.NameIdentifiers))
+		for i, v := range x.NameIdentifiers {
+			c.NameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ContributorReference) equal(y ContributorReference) bool {
+	if (x.SequenceNumber == nil) != (y.SequenceNumber == nil) || (x.SequenceNumber != nil && !x.SequenceNumber.equal(*y.SequenceNumber)) {
+		return false
+	}
+	if len(x.ContributorRoles) != len(y.ContributorRoles) {
+		return false
+	}
+	for i := range x.ContributorRoles {
+		if !x.ContributorRoles[i].equal(y.ContributorRoles[i]) {
+			return false
+		}
+	}
+	if len(x.NameIdentifiers) != len(y.NameIdentifiers) {
+		return false
+	}
+	for i := range x.NameIdentifiers {
+		if !x.NameIdentifiers[i].equal(y.NameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightOwner) clone() CopyrightOwner {
+	c := x
+	if x.CopyrightOwnerIdentifiers != nil {
+		c.CopyrightOwnerIdentifiers = make([]CopyrightOwnerIdentifier, len(x.CopyrightOwnerIdentifiers))
+		for i, v := range x.CopyrightOwnerIdentifiers {
+			c.CopyrightOwnerIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightOwner) equal(y CopyrightOwner) bool {
+	if len(x.CopyrightOwnerIdentifiers) != len(y.CopyrightOwnerIdentifiers) {
+		return false
+	}
+	for i := range x.CopyrightOwnerIdentifiers {
+		if !x.CopyrightOwnerIdentifiers[i].equal(y.CopyrightOwnerIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightOwnerIdentifier) clone() CopyrightOwnerIdentifier {
+	c := x
+	c.CopyrightOwnerIDType = x.CopyrightOwnerIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightOwnerIdentifier) equal(y CopyrightOwnerIdentifier) bool {
+	if !x.CopyrightOwnerIDType.equal(y.CopyrightOwnerIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x CopyrightStatement) clone() CopyrightStatement {
+	c := x
+	if x.CopyrightType != nil {
+		v := x.CopyrightType.clone()
+		c.CopyrightType = &v
+	}
+	if x.CopyrightYears != nil {
+		c.CopyrightYears = make([]PlainText, len(x.CopyrightYears))
+		for i, v := range x.CopyrightYears {
+			c.CopyrightYears[i] = v.clone()
+		}
+	}
+	if x.CopyrightOwners != nil {
+		c.CopyrightOwners = make([]CopyrightOwner, len(x.CopyrightOwners))
+		for i, v := range x.CopyrightOwners {
+			c.CopyrightOwners[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x CopyrightStatement) equal(y CopyrightStatement) bool {
+	if (x.CopyrightType == nil) != (y.CopyrightType == nil) || (x.CopyrightType != nil && !x.CopyrightType.equal(*y.CopyrightType)) {
+		return false
+	}
+	if len(x.CopyrightYears) != len(y.CopyrightYears) {
+		return false
+	}
+	for i := range x.CopyrightYears {
+		if !x.CopyrightYears[i].equal(y.CopyrightYears[i]) {
+			return false
+		}
+	}
+	if len(x.CopyrightOwners) != len(y.CopyrightOwners) {
+		return false
+	}
+	for i := range x.CopyrightOwners {
+		if !x.CopyrightOwners[i].equal(y.CopyrightOwners[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x DescriptiveDetail) clone() DescriptiveDetail {
+	c := x
+	c.ProductComposition = x.ProductComposition.clone()
+	c.ProductForm = x.ProductForm.clone()
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductFormFeatures != nil {
+		c.ProductFormFeatures = make([]ProductFormFeature, len(x.ProductFormFeatures))
+		for i, v := range x.ProductFormFeatures {
+			c.ProductFormFeatures[i] = v.clone()
+		}
+	}
+	if x.ProductPackaging != nil {
+		v := x.ProductPackaging.clone()
+		c.ProductPackaging = &v
+	}
+	if x.ProductFormDescriptions != nil {
+		c.ProductFormDescriptions = make([]PlainText, len(x.ProductFormDescriptions))
+		for i, v := range x.ProductFormDescriptions {
+			c.ProductFormDescriptions[i] = v.clone()
+		}
+	}
+	if x.TradeCategory != nil {
+		v := x.TradeCategory.clone()
+		c.TradeCategory = &v
+	}
+	if x.PrimaryContentType != nil {
+		v := x.PrimaryContentType.clone()
+		c.PrimaryContentType = &v
+	}
+	if x.ProductContentTypes != nil {
+		c.ProductContentTypes = make([]ProductContentType, len(x.ProductContentTypes))
+		for i, v := range x.ProductContentTypes {
+			c.ProductContentTypes[i] = v.clone()
+		}
+	}
+	if x.Measures != nil {
+		c.Measures = make([]Measure, len(x.Measures))
+		for i, v := range x.Measures {
+			c.Measures[i] = v.clone()
+		}
+	}
+	if x.CountryOfManufacture != nil {
+		v := x.CountryOfManufacture.clone()
+		c.CountryOfManufacture = &v
+	}
+	if x.EpubTechnicalProtections != nil {
+		c.EpubTechnicalProtections = make([]EpubTechnicalProtection, len(x.EpubTechnicalProtections))
+		for i, v := range x.EpubTechnicalProtections {
+			c.EpubTechnicalProtections[i] = v.clone()
+		}
+	}
+	if x.EpubUsageConstraints != nil {
+		c.EpubUsageConstraints = make([]EpubUsageConstraint, len(x.EpubUsageConstraints))
+		for i, v := range x.EpubUsageConstraints {
+			c.EpubUsageConstraints[i] = v.clone()
+		}
+	}
+	if x.EpubLicense != nil {
+		v := x.EpubLicense.clone()
+		c.EpubLicense = &v
+	}
+	if x.MapScales != nil {
+		c.MapScales = make([]PlainText, len(x.MapScales))
+		for i, v := range x.MapScales {
+			c.MapScales[i] = v.clone()
+		}
+	}
+	if x.ProductClassifications != nil {
+		c.ProductClassifications = make([]ProductClassification, len(x.ProductClassifications))
+		for i, v := range x.ProductClassifications {
+			c.ProductClassifications[i] = v.clone()
+		}
+	}
+	if x.ProductParts != nil {
+		c.ProductParts = make([]ProductPart, len(x.ProductParts))
+		for i, v := range x.ProductParts {
+			c.ProductParts[i] = v.clone()
+		}
+	}
+	if x.Collections != nil {
+		c.Collections = make([]Collection, len(x.Collections))
+		for i, v := range x.Collections {
+			c.Collections[i] = v.clone()
+		}
+	}
+	if x.TitleDetails != nil {
+		c.TitleDetails = make([]TitleDetail, len(x.TitleDetails))
+		for i, v := range x.TitleDetails {
+			c.TitleDetails[i] = v.clone()
+		}
+	}
+	if x.ThesisType != nil {
+		v := x.ThesisType.clone()
+		c.ThesisType = &v
+	}
+	if x.ThesisPresentedTo != nil {
+		v := x.ThesisPresentedTo.clone()
+		c.ThesisPresentedTo = &v
+	}
+	if x.ThesisYear != nil {
+		v := x.ThesisYear.clone()
+		c.ThesisYear = &v
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorStatements != nil {
+		c.ContributorStatements = make([]Flow, len(x.ContributorStatements))
+		for i, v := range x.ContributorStatements {
+			c.ContributorStatements[i] = v.clone()
+		}
+	}
+	if x.Events != nil {
+		c.Events = make([]Event, len(x.Events))
+		for i, v := range x.Events {
+			c.Events[i] = v.clone()
+		}
+	}
+	if x.Conferences != nil {
+		c.Conferences = make([]Conference, len(x.Conferences))
+		for i, v := range x.Conferences {
+			c.Conferences[i] = v.clone()
+		}
+	}
+	if x.EditionTypes != nil {
+		c.EditionTypes = make([]EditionType, len(x.EditionTypes))
+		for i, v := range x.EditionTypes {
+			c.EditionTypes[i] = v.clone()
+		}
+	}
+	if x.EditionNumber != nil {
+		v := x.EditionNumber.clone()
+		c.EditionNumber = &v
+	}
+	if x.EditionVersionNumber != nil {
+		v := x.EditionVersionNumber.clone()
+		c.EditionVersionNumber = &v
+	}
+	if x.EditionStatements != nil {
+		c.EditionStatements = make([]Flow, len(x.EditionStatements))
+		for i, v := range x.EditionStatements {
+			c.EditionStatements[i] = v.clone()
+		}
+	}
+	if x.ReligiousText != nil {
+		v := x.ReligiousText.clone()
+		c.ReligiousText = &v
+	}
+	if x.Languages != nil {
+		c.Languages = make([]Language, len(x.Languages))
+		for i, v := range x.Languages {
+			c.Languages[i] = v.clone()
+		}
+	}
+	if x.Extents != nil {
+		c.Extents = make([]Extent, len(x.Extents))
+		for i, v := range x.Extents {
+			c.Extents[i] = v.clone()
+		}
+	}
+	if x.Illustrated != nil {
+		v := x.Illustrated.clone()
+		c.Illustrated = &v
+	}
+	if x.NumberOfIllustrations != nil {
+		v := x.NumberOfIllustrations.clone()
+		c.NumberOfIllustrations = &v
+	}
+	if x.IllustrationsNotes != nil {
+		c.IllustrationsNotes = make([]Flow, len(x.IllustrationsNotes))
+		for i, v := range x.IllustrationsNotes {
+			c.IllustrationsNotes[i] = v.clone()
+		}
+	}
+	if x.AncillaryContents != nil {
+		c.AncillaryContents = make([]AncillaryContent, len(x.AncillaryContents))
+		for i, v := range x.AncillaryContents {
+			c.AncillaryContents[i] = v.clone()
+		}
+	}
+	if x.Subjects != nil {
+		c.Subjects = make([]Subject, len(x.Subjects))
+		for i, v := range x.Subjects {
+			c.Subjects[i] = v.clone()
+		}
+	}
+	if x.NameAsSubjects != nil {
+		c.NameAsSubjects = make([]NameAsSubject, len(x.NameAsSubjects))
+		for i, v := range x.NameAsSubjects {
+			c.NameAsSubjects[i] = v.clone()
+		}
+	}
+	if x.AudienceCodes != nil {
+		c.AudienceCodes = make([]AudienceCode, len(x.AudienceCodes))
+		for i, v := range x.AudienceCodes {
+			c.AudienceCodes[i] = v.clone()
+		}
+	}
+	if x.Audiences != nil {
+		c.Audiences = make([]Audience, len(x.Audiences))
+		for i, v := range x.Audiences {
+			c.Audiences[i] = v.clone()
+		}
+	}
+	if x.AudienceRanges != nil {
+		c.AudienceRanges = make([]AudienceRange, len(x.AudienceRanges))
+		for i, v := range x.AudienceRanges {
+			c.AudienceRanges[i] = v.clone()
+		}
+	}
+	if x.AudienceDescriptions != nil {
+		c.AudienceDescriptions = make([]Flow, len(x.AudienceDescriptions))
+		for i, v := range x.AudienceDescriptions {
+			c.AudienceDescriptions[i] = v.clone()
+		}
+	}
+	if x.Complexitys != nil {
+		c.Complexitys = make([]Complexity, len(x.Complexitys))
+		for i, v := range x.Complexitys {
+			c.Complexitys[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x DescriptiveDetail) equal(y DescriptiveDetail) bool {
+	if !x.ProductComposition.equal(y.ProductComposition) {
+		return false
+	}
+	if !x.ProductForm.equal(y.ProductForm) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if len(x.ProductFormFeatures) != len(y.ProductFormFeatures) {
+		return false
+	}
+	for i := range x.ProductFormFeatures {
+		if !x.ProductFormFeatures[i].equal(y.ProductFormFeatures[i]) {
+			return false
+		}
+	}
+	if (x.ProductPackaging == nil) != (y.ProductPackaging == nil) || (x.ProductPackaging != nil && !x.ProductPackaging.equal(*y.ProductPackaging)) {
+		return false
+	}
+	if len(x.ProductFormDescriptions) != len(y.ProductFormDescriptions) {
+		return false
+	}
+	for i := range x.ProductFormDescriptions {
+		if !x.ProductFormDescriptions[i].equal(y.ProductFormDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.TradeCategory == nil) != (y.TradeCategory == nil) || (x.TradeCategory != nil && !x.TradeCategory.equal(*y.TradeCategory)) {
+		return false
+	}
+	if (x.PrimaryContentType == nil) != (y.PrimaryContentType == nil) || (x.PrimaryContentType != nil && !x.PrimaryContentType.equal(*y.PrimaryContentType)) {
+		return false
+	}
+	if len(x.ProductContentTypes) != len(y.ProductContentTypes) {
+		return false
+	}
+	for i := range x.ProductContentTypes {
+		if !x.ProductContentTypes[i].equal(y.ProductContentTypes[i]) {
+			return false
+		}
+	}
+	if len(x.Measures) != len(y.Measures) {
+		return false
+	}
+	for i := range x.Measures {
+		if !x.Measures[i].equal(y.Measures[i]) {
+			return false
+		}
+	}
+	if (x.CountryOfManufacture == nil) != (y.CountryOfManufacture == nil) || (x.CountryOfManufacture != nil && !x.CountryOfManufacture.equal(*y.CountryOfManufacture)) {
+		return false
+	}
+	if len(x.EpubTechnicalProtections) != len(y.EpubTechnicalProtections) {
+		return false
+	}
+	for i := range x.EpubTechnicalProtections {
+		if !x.EpubTechnicalProtections[i].equal(y.EpubTechnicalProtections[i]) {
+			return false
+		}
+	}
+	if len(x.EpubUsageConstraints) != len(y.EpubUsageConstraints) {
+		return false
+	}
+	for i := range x.EpubUsageConstraints {
+		if !x.EpubUsageConstraints[i].equal(y.EpubUsageConstraints[i]) {
+			return false
+		}
+	}
+	if (x.EpubLicense == nil) != (y.EpubLicense == nil) || (x.EpubLicense != nil && !x.EpubLicense.equal(*y.EpubLicense)) {
+		return false
+	}
+	if len(x.MapScales) != len(y.MapScales) {
+		return false
+	}
+	for i := range x.MapScales {
+		if !x.MapScales[i].equal(y.MapScales[i]) {
+			return false
+		}
+	}
+	if len(x.ProductClassifications) != len(y.ProductClassifications) {
+		return false
+	}
+	for i := range x.ProductClassifications {
+		if !x.ProductClassifications[i].equal(y.ProductClassifications[i]) {
+			return false
+		}
+	}
+	if len(x.ProductParts) != len(y.ProductParts) {
+		return false
+	}
+	for i := range x.ProductParts {
+		if !x.ProductParts[i].equal(y.ProductParts[i]) {
+			return false
+		}
+	}
+	if len(x.Collections) != len(y.Collections) {
+		return false
+	}
+	for i := range x.Collections {
+		if !x.Collections[i].equal(y.Collections[i]) {
+			return false
+		}
+	}
+	if x.NoCollection != y.NoCollection {
+		return false
+	}
+	if len(x.TitleDetails) != len(y.TitleDetails) {
+		return false
+	}
+	for i := range x.TitleDetails {
+		if !x.TitleDetails[i].equal(y.TitleDetails[i]) {
+			return false
+		}
+	}
+	if (x.ThesisType == nil) != (y.ThesisType == nil) || (x.ThesisType != nil && !x.ThesisType.equal(*y.ThesisType)) {
+		return false
+	}
+	if (x.ThesisPresentedTo == nil) != (y.ThesisPresentedTo == nil) || (x.ThesisPresentedTo != nil && !x.ThesisPresentedTo.equal(*y.ThesisPresentedTo)) {
+		return false
+	}
+	if (x.ThesisYear == nil) != (y.ThesisYear == nil) || (x.ThesisYear != nil && !x.ThesisYear.equal(*y.ThesisYear)) {
+		return false
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorStatements) != len(y.ContributorStatements) {
+		return false
+	}
+	for i := range x.ContributorStatements {
+		if !x.ContributorStatements[i].equal(y.ContributorStatements[i]) {
+			return false
+		}
+	}
+	if x.NoContributor != y.NoContributor {
+		return false
+	}
+	if len(x.Events) != len(y.Events) {
+		return false
+	}
+	for i := range x.Events {
+		if !x.Events[i].equal(y.Events[i]) {
+			return false
+		}
+	}
+	if len(x.Conferences) != len(y.Conferences) {
+		return false
+	}
+	for i := range x.Conferences {
+		if !x.Conferences[i].equal(y.Conferences[i]) {
+			return false
+		}
+	}
+	if len(x.EditionTypes) != len(y.EditionTypes) {
+		return false
+	}
+	for i := range x.EditionTypes {
+		if !x.EditionTypes[i].equal(y.EditionTypes[i]) {
+			return false
+		}
+	}
+	if (x.EditionNumber == nil) != (y.EditionNumber == nil) || (x.EditionNumber != nil && !x.EditionNumber.equal(*y.EditionNumber)) {
+		return false
+	}
+	if (x.EditionVersionNumber == nil) != (y.EditionVersionNumber == nil) || (x.EditionVersionNumber != nil && !x.EditionVersionNumber.equal(*y.EditionVersionNumber)) {
+		return false
+	}
+	if len(x.EditionStatements) != len(y.EditionStatements) {
+		return false
+	}
+	for i := range x.EditionStatements {
+		if !x.EditionStatements[i].equal(y.EditionStatements[i]) {
+			return false
+		}
+	}
+	if x.NoEdition != y.NoEdition {
+		return false
+	}
+	if (x.ReligiousText == nil) != (y.ReligiousText == nil) || (x.ReligiousText != nil && !x.ReligiousText.equal(*y.ReligiousText)) {
+		return false
+	}
+	if len(x.Languages) != len(y.Languages) {
+		return false
+	}
+	for i := range x.Languages {
+		if !x.Languages[i].equal(y.Languages[i]) {
+			return false
+		}
+	}
+	if len(x.Extents) != len(y.Extents) {
+		return false
+	}
+	for i := range x.Extents {
+		if !x.Extents[i].equal(y.Extents[i]) {
+			return false
+		}
+	}
+	if (x.Illustrated == nil) != (y.Illustrated == nil) || (x.Illustrated != nil && !x.Illustrated.equal(*y.Illustrated)) {
+		return false
+	}
+	if (x.NumberOfIllustrations == nil) != (y.NumberOfIllustrations == nil) || (x.NumberOfIllustrations != nil && !x.NumberOfIllustrations.equal(*y.NumberOfIllustrations)) {
+		return false
+	}
+	if len(x.IllustrationsNotes) != len(y.IllustrationsNotes) {
+		return false
+	}
+	for i := range x.IllustrationsNotes {
+		if !x.IllustrationsNotes[i].equal(y.IllustrationsNotes[i]) {
+			return false
+		}
+	}
+	if len(x.AncillaryContents) != len(y.AncillaryContents) {
+		return false
+	}
+	for i := range x.AncillaryContents {
+		if !x.AncillaryContents[i].equal(y.AncillaryContents[i]) {
+			return false
+		}
+	}
+	if len(x.Subjects) != len(y.Subjects) {
+		return false
+	}
+	for i := range x.Subjects {
+		if !x.Subjects[i].equal(y.Subjects[i]) {
+			return false
+		}
+	}
+	if len(x.NameAsSubjects) != len(y.NameAsSubjects) {
+		return false
+	}
+	for i := range x.NameAsSubjects {
+		if !x.NameAsSubjects[i].equal(y.NameAsSubjects[i]) {
+			return false
+		}
+	}
+	if len(x.AudienceCodes) != len(y.AudienceCodes) {
+		return false
+	}
+	for i := range x.AudienceCodes {
+		if !x.AudienceCodes[i].equal(y.AudienceCodes[i]) {
+			return false
+		}
+	}
+	if len(x.Audiences) != len(y.Audiences) {
+		return false
+	}
+	for i := range x.Audiences {
+		if !x.Audiences[i].equal(y.Audiences[i]) {
+			return false
+		}
+	}
+	if len(x.AudienceRanges) != len(y.AudienceRanges) {
+		return false
+	}
+	for i := range x.AudienceRanges {
+		if !x.AudienceRanges[i].equal(y.AudienceRanges[i]) {
+			return false
+		}
+	}
+	if len(x.AudienceDescriptions) != len(y.AudienceDescriptions) {
+		return false
+	}
+	for i := range x.AudienceDescriptions {
+		if !x.AudienceDescriptions[i].equal(y.AudienceDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.Complexitys) != len(y.Complexitys) {
+		return false
+	}
+	for i := range x.Complexitys {
+		if !x.Complexitys[i].equal(y.Complexitys[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Discount) clone() Discount {
+	c := x
+	if x.DiscountType != nil {
+		v := x.DiscountType.clone()
+		c.DiscountType = &v
+	}
+	if x.Quantity != nil {
+		v := x.Quantity.clone()
+		c.Quantity = &v
+	}
+	if x.ToQuantity != nil {
+		v := x.ToQuantity.clone()
+		c.ToQuantity = &v
+	}
+	if x.DiscountPercent != nil {
+		v := x.DiscountPercent.clone()
+		c.DiscountPercent = &v
+	}
+	if x.DiscountAmount != nil {
+		v := x.DiscountAmount.clone()
+		c.DiscountAmount = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Discount) equal(y Discount) bool {
+	if (x.DiscountType == nil) != (y.DiscountType == nil) || (x.DiscountType != nil && !x.DiscountType.equal(*y.DiscountType)) {
+		return false
+	}
+	if (x.Quantity == nil) != (y.Quantity == nil) || (x.Quantity != nil && !x.Quantity.equal(*y.Quantity)) {
+		return false
+	}
+	if (x.ToQuantity == nil) != (y.ToQuantity == nil) || (x.ToQuantity != nil && !x.ToQuantity.equal(*y.ToQuantity)) {
+		return false
+	}
+	if (x.DiscountPercent == nil) != (y.DiscountPercent == nil) || (x.DiscountPercent != nil && !x.DiscountPercent.equal(*y.DiscountPercent)) {
+		return false
+	}
+	if (x.DiscountAmount == nil) != (y.DiscountAmount == nil) || (x.DiscountAmount != nil && !x.DiscountAmount.equal(*y.DiscountAmount)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x DiscountCoded) clone() DiscountCoded {
+	c := x
+	c.DiscountCodeType = x.DiscountCodeType.clone()
+	if x.DiscountCodeTypeName != nil {
+		v := x.DiscountCodeTypeName.clone()
+		c.DiscountCodeTypeName = &v
+	}
+	c.DiscountCode = x.DiscountCode.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x DiscountCoded) equal(y DiscountCoded) bool {
+	if !x.DiscountCodeType.equal(y.DiscountCodeType) {
+		return false
+	}
+	if (x.DiscountCodeTypeName == nil) != (y.DiscountCodeTypeName == nil) || (x.DiscountCodeTypeName != nil && !x.DiscountCodeTypeName.equal(*y.DiscountCodeTypeName)) {
+		return false
+	}
+	if !x.DiscountCode.equal(y.DiscountCode) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EpubLicense) clone() EpubLicense {
+	c := x
+	if x.EpubLicenseNames != nil {
+		c.EpubLicenseNames = make([]PlainText, len(x.EpubLicenseNames))
+		for i, v := range x.EpubLicenseNames {
+			c.EpubLicenseNames[i] = v.clone()
+		}
+	}
+	if x.EpubLicenseExpressions != nil {
+		c.EpubLicenseExpressions = make([]EpubLicenseExpression, len(x.EpubLicenseExpressions))
+		for i, v := range x.EpubLicenseExpressions {
+			c.EpubLicenseExpressions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EpubLicense) equal(y EpubLicense) bool {
+	if len(x.EpubLicenseNames) != len(y.EpubLicenseNames) {
+		return false
+	}
+	for i := range x.EpubLicenseNames {
+		if !x.EpubLicenseNames[i].equal(y.EpubLicenseNames[i]) {
+			return false
+		}
+	}
+	if len(x.EpubLicenseExpressions) != len(y.EpubLicenseExpressions) {
+		return false
+	}
+	for i := range x.EpubLicenseExpressions {
+		if !x.EpubLicenseExpressions[i].equal(y.EpubLicenseExpressions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EpubLicenseExpression) clone() EpubLicenseExpression {
+	c := x
+	c.EpubLicenseExpressionType = x.EpubLicenseExpressionType.clone()
+	if x.EpubLicenseExpressionTypeName != nil {
+		v := x.EpubLicenseExpressionTypeName.clone()
+		c.EpubLicenseExpressionTypeName = &v
+	}
+	c.EpubLicenseExpressionLink = x.EpubLicenseExpressionLink.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EpubLicenseExpression) equal(y EpubLicenseExpression) bool {
+	if !x.EpubLicenseExpressionType.equal(y.EpubLicenseExpressionType) {
+		return false
+	}
+	if (x.EpubLicenseExpressionTypeName == nil) != (y.EpubLicenseExpressionTypeName == nil) || (x.EpubLicenseExpressionTypeName != nil && !x.EpubLicenseExpressionTypeName.equal(*y.EpubLicenseExpressionTypeName)) {
+		return false
+	}
+	if !x.EpubLicenseExpressionLink.equal(y.EpubLicenseExpressionLink) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EpubUsageConstraint) clone() EpubUsageConstraint {
+	c := x
+	c.EpubUsageType = x.EpubUsageType.clone()
+	c.EpubUsageStatus = x.EpubUsageStatus.clone()
+	if x.EpubUsageLimits != nil {
+		c.EpubUsageLimits = make([]EpubUsageLimit, len(x.EpubUsageLimits))
+		for i, v := range x.EpubUsageLimits {
+			c.EpubUsageLimits[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EpubUsageConstraint) equal(y EpubUsageConstraint) bool {
+	if !x.EpubUsageType.equal(y.EpubUsageType) {
+		return false
+	}
+	if !x.EpubUsageStatus.equal(y.EpubUsageStatus) {
+		return false
+	}
+	if len(x.EpubUsageLimits) != len(y.EpubUsageLimits) {
+		return false
+	}
+	for i := range x.EpubUsageLimits {
+		if !x.EpubUsageLimits[i].equal(y.EpubUsageLimits[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EpubUsageLimit) clone() EpubUsageLimit {
+	c := x
+	c.Quantity = x.Quantity.clone()
+	c.EpubUsageUnit = x.EpubUsageUnit.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EpubUsageLimit) equal(y EpubUsageLimit) bool {
+	if !x.Quantity.equal(y.Quantity) {
+		return false
+	}
+	if !x.EpubUsageUnit.equal(y.EpubUsageUnit) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Event) clone() Event {
+	c := x
+	c.EventRole = x.EventRole.clone()
+	if x.EventNames != nil {
+		c.EventNames = make([]PlainText, len(x.EventNames))
+		for i, v := range x.EventNames {
+			c.EventNames[i] = v.clone()
+		}
+	}
+	if x.EventAcronyms != nil {
+		c.EventAcronyms = make([]PlainText, len(x.EventAcronyms))
+		for i, v := range x.EventAcronyms {
+			c.EventAcronyms[i] = v.clone()
+		}
+	}
+	if x.EventNumber != nil {
+		v := x.EventNumber.clone()
+		c.EventNumber = &v
+	}
+	if x.EventThemes != nil {
+		c.EventThemes = make([]PlainText, len(x.EventThemes))
+		for i, v := range x.EventThemes {
+			c.EventThemes[i] = v.clone()
+		}
+	}
+	if x.EventDate != nil {
+		v := x.EventDate.clone()
+		c.EventDate = &v
+	}
+	if x.EventPlaces != nil {
+		c.EventPlaces = make([]PlainText, len(x.EventPlaces))
+		for i, v := range x.EventPlaces {
+			c.EventPlaces[i] = v.clone()
+		}
+	}
+	if x.EventSponsors != nil {
+		c.EventSponsors = make([]EventSponsor, len(x.EventSponsors))
+		for i, v := range x.EventSponsors {
+			c.EventSponsors[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Event) equal(y Event) bool {
+	if !x.EventRole.equal(y.EventRole) {
+		return false
+	}
+	if len(x.EventNames) != len(y.EventNames) {
+		return false
+	}
+	for i := range x.EventNames {
+		if !x.EventNames[i].equal(y.EventNames[i]) {
+			return false
+		}
+	}
+	if len(x.EventAcronyms) != len(y.EventAcronyms) {
+		return false
+	}
+	for i := range x.EventAcronyms {
+		if !x.EventAcronyms[i].equal(y.EventAcronyms[i]) {
+			return false
+		}
+	}
+	if (x.EventNumber == nil) != (y.EventNumber == nil) || (x.EventNumber != nil && !x.EventNumber.equal(*y.EventNumber)) {
+		return false
+	}
+	if len(x.EventThemes) != len(y.EventThemes) {
+		return false
+	}
+	for i := range x.EventThemes {
+		if !x.EventThemes[i].equal(y.EventThemes[i]) {
+			return false
+		}
+	}
+	if (x.EventDate == nil) != (y.EventDate == nil) || (x.EventDate != nil && !x.EventDate.equal(*y.EventDate)) {
+		return false
+	}
+	if len(x.EventPlaces) != len(y.EventPlaces) {
+		return false
+	}
+	for i := range x.EventPlaces {
+		if !x.EventPlaces[i].equal(y.EventPlaces[i]) {
+			return false
+		}
+	}
+	if len(x.EventSponsors) != len(y.EventSponsors) {
+		return false
+	}
+	for i := range x.EventSponsors {
+		if !x.EventSponsors[i].equal(y.EventSponsors[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EventIdentifier) clone() EventIdentifier {
+	c := x
+	c.EventIDType = x.EventIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EventIdentifier) equal(y EventIdentifier) bool {
+	if !x.EventIDType.equal(y.EventIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EventOccurrence) clone() EventOccurrence {
+	c := x
+	if x.EventIdentifiers != nil {
+		c.EventIdentifiers = make([]EventIdentifier, len(x.EventIdentifiers))
+		for i, v := range x.EventIdentifiers {
+			c.EventIdentifiers[i] = v.clone()
+		}
+	}
+	if x.OccurrenceDates != nil {
+		c.OccurrenceDates = make([]OccurrenceDate, len(x.OccurrenceDates))
+		for i, v := range x.OccurrenceDates {
+			c.OccurrenceDates[i] = v.clone()
+		}
+	}
+	c.EventStatus = x.EventStatus.clone()
+	if x.CountryCode != nil {
+		v := x.CountryCode.clone()
+		c.CountryCode = &v
+	}
+	if x.RegionCode != nil {
+		v := x.RegionCode.clone()
+		c.RegionCode = &v
+	}
+	if x.LocationNames != nil {
+		c.LocationNames = make([]PlainText, len(x.LocationNames))
+		for i, v := range x.LocationNames {
+			c.LocationNames[i] = v.clone()
+		}
+	}
+	if x.VenueName != nil {
+		v := x.VenueName.clone()
+		c.VenueName = &v
+	}
+	if x.StreetAddress != nil {
+		v := x.StreetAddress.clone()
+		c.StreetAddress = &v
+	}
+	if x.VenueNotes != nil {
+		c.VenueNotes = make([]Flow, len(x.VenueNotes))
+		for i, v := range x.VenueNotes {
+			c.VenueNotes[i] = v.clone()
+		}
+	}
+	if x.EventDescriptions != nil {
+		c.EventDescriptions = make([]Flow, len(x.EventDescriptions))
+		for i, v := range x.EventDescriptions {
+			c.EventDescriptions[i] = v.clone()
+		}
+	}
+	if x.EventSponsors != nil {
+		c.EventSponsors = make([]EventSponsor, len(x.EventSponsors))
+		for i, v := range x.EventSponsors {
+			c.EventSponsors[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EventOccurrence) equal(y EventOccurrence) bool {
+	if len(x.EventIdentifiers) != len(y.EventIdentifiers) {
+		return false
+	}
+	for i := range x.EventIdentifiers {
+		if !x.EventIdentifiers[i].equal(y.EventIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.OccurrenceDates) != len(y.OccurrenceDates) {
+		return false
+	}
+	for i := range x.OccurrenceDates {
+		if !x.OccurrenceDates[i].equal(y.OccurrenceDates[i]) {
+			return false
+		}
+	}
+	if !x.EventStatus.equal(y.EventStatus) {
+		return false
+	}
+	if (x.CountryCode == nil) != (y.CountryCode == nil) || (x.CountryCode != nil && !x.CountryCode.equal(*y.CountryCode)) {
+		return false
+	}
+	if (x.RegionCode == nil) != (y.RegionCode == nil) || (x.RegionCode != nil && !x.RegionCode.equal(*y.RegionCode)) {
+		return false
+	}
+	if len(x.LocationNames) != len(y.LocationNames) {
+		return false
+	}
+	for i := range x.LocationNames {
+		if !x.LocationNames[i].equal(y.LocationNames[i]) {
+			return false
+		}
+	}
+	if (x.VenueName == nil) != (y.VenueName == nil) || (x.VenueName != nil && !x.VenueName.equal(*y.VenueName)) {
+		return false
+	}
+	if (x.StreetAddress == nil) != (y.StreetAddress == nil) || (x.StreetAddress != nil && !x.StreetAddress.equal(*y.StreetAddress)) {
+		return false
+	}
+	if len(x.VenueNotes) != len(y.VenueNotes) {
+		return false
+	}
+	for i := range x.VenueNotes {
+		if !x.VenueNotes[i].equal(y.VenueNotes[i]) {
+			return false
+		}
+	}
+	if len(x.EventDescriptions) != len(y.EventDescriptions) {
+		return false
+	}
+	for i := range x.EventDescriptions {
+		if !x.EventDescriptions[i].equal(y.EventDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.EventSponsors) != len(y.EventSponsors) {
+		return false
+	}
+	for i := range x.EventSponsors {
+		if !x.EventSponsors[i].equal(y.EventSponsors[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EventSponsor) clone() EventSponsor {
+	c := x
+	if x.EventSponsorIdentifiers != nil {
+		c.EventSponsorIdentifiers = make([]EventSponsorIdentifier, len(x.EventSponsorIdentifiers))
+		for i, v := range x.EventSponsorIdentifiers {
+			c.EventSponsorIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EventSponsor) equal(y EventSponsor) bool {
+	if len(x.EventSponsorIdentifiers) != len(y.EventSponsorIdentifiers) {
+		return false
+	}
+	for i := range x.EventSponsorIdentifiers {
+		if !x.EventSponsorIdentifiers[i].equal(y.EventSponsorIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x EventSponsorIdentifier) clone() EventSponsorIdentifier {
+	c := x
+	c.EventSponsorIDType = x.EventSponsorIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x EventSponsorIdentifier) equal(y EventSponsorIdentifier) bool {
+	if !x.EventSponsorIDType.equal(y.EventSponsorIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Extent) clone() Extent {
+	c := x
+	c.ExtentType = x.ExtentType.clone()
+	if x.ExtentValue != nil {
+		v := x.ExtentValue.clone()
+		c.ExtentValue = &v
+	}
+	if x.ExtentValueRoman != nil {
+		v := x.ExtentValueRoman.clone()
+		c.ExtentValueRoman = &v
+	}
+	c.ExtentUnit = x.ExtentUnit.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Extent) equal(y Extent) bool {
+	if !x.ExtentType.equal(y.ExtentType) {
+		return false
+	}
+	if (x.ExtentValue == nil) != (y.ExtentValue == nil) || (x.ExtentValue != nil && !x.ExtentValue.equal(*y.ExtentValue)) {
+		return false
+	}
+	if (x.ExtentValueRoman == nil) != (y.ExtentValueRoman == nil) || (x.ExtentValueRoman != nil && !x.ExtentValueRoman.equal(*y.ExtentValueRoman)) {
+		return false
+	}
+	if !x.ExtentUnit.equal(y.ExtentUnit) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Funding) clone() Funding {
+	c := x
+	if x.FundingIdentifiers != nil {
+		c.FundingIdentifiers = make([]FundingIdentifier, len(x.FundingIdentifiers))
+		for i, v := range x.FundingIdentifiers {
+			c.FundingIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Funding) equal(y Funding) bool {
+	if len(x.FundingIdentifiers) != len(y.FundingIdentifiers) {
+		return false
+	}
+	for i := range x.FundingIdentifiers {
+		if !x.FundingIdentifiers[i].equal(y.FundingIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x FundingIdentifier) clone() FundingIdentifier {
+	c := x
+	c.FundingIDType = x.FundingIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x FundingIdentifier) equal(y FundingIdentifier) bool {
+	if !x.FundingIDType.equal(y.FundingIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Header) clone() Header {
+	c := x
+	c.Sender = x.Sender.clone()
+	if x.Addressees != nil {
+		c.Addressees = make([]Addressee, len(x.Addressees))
+		for i, v := range x.Addressees {
+			c.Addressees[i] = v.clone()
+		}
+	}
+	if x.MessageNumber != nil {
+		v := x.MessageNumber.clone()
+		c.MessageNumber = &v
+	}
+	if x.MessageRepeat != nil {
+		v := x.MessageRepeat.clone()
+		c.MessageRepeat = &v
+	}
+	c.SentDateTime = x.SentDateTime.clone()
+	if x.MessageNotes != nil {
+		c.MessageNotes = make([]PlainText, len(x.MessageNotes))
+		for i, v := range x.MessageNotes {
+			c.MessageNotes[i] = v.clone()
+		}
+	}
+	if x.DefaultLanguageOfText != nil {
+		v := x.DefaultLanguageOfText.clone()
+		c.DefaultLanguageOfText = &v
+	}
+	if x.DefaultPriceType != nil {
+		v := x.DefaultPriceType.clone()
+		c.DefaultPriceType = &v
+	}
+	if x.DefaultCurrencyCode != nil {
+		v := x.DefaultCurrencyCode.clone()
+		c.DefaultCurrencyCode = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Header) equal(y Header) bool {
+	if !x.Sender.equal(y.Sender) {
+		return false
+	}
+	if len(x.Addressees) != len(y.Addressees) {
+		return false
+	}
+	for i := range x.Addressees {
+		if !x.Addressees[i].equal(y.Addressees[i]) {
+			return false
+		}
+	}
+	if (x.MessageNumber == nil) != (y.MessageNumber == nil) || (x.MessageNumber != nil && !x.MessageNumber.equal(*y.MessageNumber)) {
+		return false
+	}
+	if (x.MessageRepeat == nil) != (y.MessageRepeat == nil) || (x.MessageRepeat != nil && !x.MessageRepeat.equal(*y.MessageRepeat)) {
+		return false
+	}
+	if !x.SentDateTime.equal(y.SentDateTime) {
+		return false
+	}
+	if len(x.MessageNotes) != len(y.MessageNotes) {
+		return false
+	}
+	for i := range x.MessageNotes {
+		if !x.MessageNotes[i].equal(y.MessageNotes[i]) {
+			return false
+		}
+	}
+	if (x.DefaultLanguageOfText == nil) != (y.DefaultLanguageOfText == nil) || (x.DefaultLanguageOfText != nil && !x.DefaultLanguageOfText.equal(*y.DefaultLanguageOfText)) {
+		return false
+	}
+	if (x.DefaultPriceType == nil) != (y.DefaultPriceType == nil) || (x.DefaultPriceType != nil && !x.DefaultPriceType.equal(*y.DefaultPriceType)) {
+		return false
+	}
+	if (x.DefaultCurrencyCode == nil) != (y.DefaultCurrencyCode == nil) || (x.DefaultCurrencyCode != nil && !x.DefaultCurrencyCode.equal(*y.DefaultCurrencyCode)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Imprint) clone() Imprint {
+	c := x
+	if x.ImprintIdentifiers != nil {
+		c.ImprintIdentifiers = make([]ImprintIdentifier, len(x.ImprintIdentifiers))
+		for i, v := range x.ImprintIdentifiers {
+			c.ImprintIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ImprintName != nil {
+		v := x.ImprintName.clone()
+		c.ImprintName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Imprint) equal(y Imprint) bool {
+	if len(x.ImprintIdentifiers) != len(y.ImprintIdentifiers) {
+		return false
+	}
+	for i := range x.ImprintIdentifiers {
+		if !x.ImprintIdentifiers[i].equal(y.ImprintIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ImprintName == nil) != (y.ImprintName == nil) || (x.ImprintName != nil && !x.ImprintName.equal(*y.ImprintName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ImprintIdentifier) clone() ImprintIdentifier {
+	c := x
+	c.ImprintIDType = x.ImprintIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ImprintIdentifier) equal(y ImprintIdentifier) bool {
+	if !x.ImprintIDType.equal(y.ImprintIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Language) clone() Language {
+	c := x
+	c.LanguageRole = x.LanguageRole.clone()
+	c.LanguageCode = x.LanguageCode.clone()
+	if x.CountryCode != nil {
+		v := x.CountryCode.clone()
+		c.CountryCode = &v
+	}
+	if x.RegionCode != nil {
+		v := x.RegionCode.clone()
+		c.RegionCode = &v
+	}
+	if x.ScriptCode != nil {
+		v := x.ScriptCode.clone()
+		c.ScriptCode = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Language) equal(y Language) bool {
+	if !x.LanguageRole.equal(y.LanguageRole) {
+		return false
+	}
+	if !x.LanguageCode.equal(y.LanguageCode) {
+		return false
+	}
+	if (x.CountryCode == nil) != (y.CountryCode == nil) || (x.CountryCode != nil && !x.CountryCode.equal(*y.CountryCode)) {
+		return false
+	}
+	if (x.RegionCode == nil) != (y.RegionCode == nil) || (x.RegionCode != nil && !x.RegionCode.equal(*y.RegionCode)) {
+		return false
+	}
+	if (x.ScriptCode == nil) != (y.ScriptCode == nil) || (x.ScriptCode != nil && !x.ScriptCode.equal(*y.ScriptCode)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x LocationIdentifier) clone() LocationIdentifier {
+	c := x
+	c.LocationIDType = x.LocationIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x LocationIdentifier) equal(y LocationIdentifier) bool {
+	if !x.LocationIDType.equal(y.LocationIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Market) clone() Market {
+	c := x
+	c.Territory = x.Territory.clone()
+	if x.SalesRestrictions != nil {
+		c.SalesRestrictions = make([]SalesRestriction, len(x.SalesRestrictions))
+		for i, v := range x.SalesRestrictions {
+			c.SalesRestrictions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Market) equal(y Market) bool {
+	if !x.Territory.equal(y.Territory) {
+		return false
+	}
+	if len(x.SalesRestrictions) != len(y.SalesRestrictions) {
+		return false
+	}
+	for i := range x.SalesRestrictions {
+		if !x.SalesRestrictions[i].equal(y.SalesRestrictions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MarketDate) clone() MarketDate {
+	c := x
+	c.MarketDateRole = x.MarketDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MarketDate) equal(y MarketDate) bool {
+	if !x.MarketDateRole.equal(y.MarketDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x MarketPublishingDetail) clone() MarketPublishingDetail {
+	c := x
+	if x.PublisherRepresentatives != nil {
+		c.PublisherRepresentatives = make([]PublisherRepresentative, len(x.PublisherRepresentatives))
+		for i, v := range x.PublisherRepresentatives {
+			c.PublisherRepresentatives[i] = v.clone()
+		}
+	}
+	if x.ProductContacts != nil {
+		c.ProductContacts = make([]ProductContact, len(x.ProductContacts))
+		for i, v := range x.ProductContacts {
+			c.ProductContacts[i] = v.clone()
+		}
+	}
+	c.MarketPublishingStatus = x.MarketPublishingStatus.clone()
+	if x.MarketPublishingStatusNotes != nil {
+		c.MarketPublishingStatusNotes = make([]Flow, len(x.MarketPublishingStatusNotes))
+		for i, v := range x.MarketPublishingStatusNotes {
+			c.MarketPublishingStatusNotes[i] = v.clone()
+		}
+	}
+	if x.MarketDates != nil {
+		c.MarketDates = make([]MarketDate, len(x.MarketDates))
+		for i, v := range x.MarketDates {
+			c.MarketDates[i] = v.clone()
+		}
+	}
+	if x.PromotionCampaigns != nil {
+		c.PromotionCampaigns = make([]Flow, len(x.PromotionCampaigns))
+		for i, v := range x.PromotionCampaigns {
+			c.PromotionCampaigns[i] = v.clone()
+		}
+	}
+	if x.PromotionContact != nil {
+		v := x.PromotionContact.clone()
+		c.PromotionContact = &v
+	}
+	if x.InitialPrintRuns != nil {
+		c.InitialPrintRuns = make([]Flow, len(x.InitialPrintRuns))
+		for i, v := range x.InitialPrintRuns {
+			c.InitialPrintRuns[i] = v.clone()
+		}
+	}
+	if x.ReprintDetails != nil {
+		c.ReprintDetails = make([]Flow, len(x.ReprintDetails))
+		for i, v := range x.ReprintDetails {
+			c.ReprintDetails[i] = v.clone()
+		}
+	}
+	if x.CopiesSolds != nil {
+		c.CopiesSolds = make([]Flow, len(x.CopiesSolds))
+		for i, v := range x.CopiesSolds {
+			c.CopiesSolds[i] = v.clone()
+		}
+	}
+	if x.BookClubAdoptions != nil {
+		c.BookClubAdoptions = make([]Flow, len(x.BookClubAdoptions))
+		for i, v := range x.BookClubAdoptions {
+			c.BookClubAdoptions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x MarketPublishingDetail) equal(y MarketPublishingDetail) bool {
+	if len(x.PublisherRepresentatives) != len(y.PublisherRepresentatives) {
+		return false
+	}
+	for i := range x.PublisherRepresentatives {
+		if !x.PublisherRepresentatives[i].equal(y.PublisherRepresentatives[i]) {
+			return false
+		}
+	}
+	if len(x.ProductContacts) != len(y.ProductContacts) {
+		return false
+	}
+	for i := range x.ProductContacts {
+		if !x.ProductContacts[i].equal(y.ProductContacts[i]) {
+			return false
+		}
+	}
+	if !x.MarketPublishingStatus.equal(y.MarketPublishingStatus) {
+		return false
+	}
+	if len(x.MarketPublishingStatusNotes) != len(y.MarketPublishingStatusNotes) {
+		return false
+	}
+	for i := range x.MarketPublishingStatusNotes {
+		if !x.MarketPublishingStatusNotes[i].equal(y.MarketPublishingStatusNotes[i]) {
+			return false
+		}
+	}
+	if len(x.MarketDates) != len(y.MarketDates) {
+		return false
+	}
+	for i := range x.MarketDates {
+		if !x.MarketDates[i].equal(y.MarketDates[i]) {
+			return false
+		}
+	}
+	if len(x.PromotionCampaigns) != len(y.PromotionCampaigns) {
+		return false
+	}
+	for i := range x.PromotionCampaigns {
+		if !x.PromotionCampaigns[i].equal(y.PromotionCampaigns[i]) {
+			return false
+		}
+	}
+	if (x.PromotionContact == nil) != (y.PromotionContact == nil) || (x.PromotionContact != nil && !x.PromotionContact.equal(*y.PromotionContact)) {
+		return false
+	}
+	if len(x.InitialPrintRuns) != len(y.InitialPrintRuns) {
+		return false
+	}
+	for i := range x.InitialPrintRuns {
+		if !x.InitialPrintRuns[i].equal(y.InitialPrintRuns[i]) {
+			return false
+		}
+	}
+	if len(x.ReprintDetails) != len(y.ReprintDetails) {
+		return false
+	}
+	for i := range x.ReprintDetails {
+		if !x.ReprintDetails[i].equal(y.ReprintDetails[i]) {
+			return false
+		}
+	}
+	if len(x.CopiesSolds) != len(y.CopiesSolds) {
+		return false
+	}
+	for i := range x.CopiesSolds {
+		if !x.CopiesSolds[i].equal(y.CopiesSolds[i]) {
+			return false
+		}
+	}
+	if len(x.BookClubAdoptions) != len(y.BookClubAdoptions) {
+		return false
+	}
+	for i := range x.BookClubAdoptions {
+		if !x.BookClubAdoptions[i].equal(y.BookClubAdoptions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Measure) clone() Measure {
+	c := x
+	c.MeasureType = x.MeasureType.clone()
+	c.Measurement = x.Measurement.clone()
+	c.MeasureUnitCode = x.MeasureUnitCode.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Measure) equal(y Measure) bool {
+	if !x.MeasureType.equal(y.MeasureType) {
+		return false
+	}
+	if !x.Measurement.equal(y.Measurement) {
+		return false
+	}
+	if !x.MeasureUnitCode.equal(y.MeasureUnitCode) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x NameAsSubject) clone() NameAsSubject {
+	c := x
+	if x.NameType != nil {
+		v := x.NameType.clone()
+		c.NameType = &v
+	}
+	if x.NameIdentifiers != nil {
+		c.NameIdentifiers = make([]NameIdentifier, len(x.NameIdentifiers))
+		for i, v := range x.NameIdentifiers {
+			c.NameIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PersonName != nil {
+		v := x.PersonName.clone()
+		c.PersonName = &v
+	}
+	if x.PersonNameInverted != nil {
+		v := x.PersonNameInverted.clone()
+		c.PersonNameInverted = &v
+	}
+	if x.TitlesBeforeNames != nil {
+		v := x.TitlesBeforeNames.clone()
+		c.TitlesBeforeNames = &v
+	}
+	if x.NamesBeforeKey != nil {
+		v := x.NamesBeforeKey.clone()
+		c.NamesBeforeKey = &v
+	}
+	if x.PrefixToKey != nil {
+		v := x.PrefixToKey.clone()
+		c.PrefixToKey = &v
+	}
+	if x.KeyNames != nil {
+		v := x.KeyNames.clone()
+		c.KeyNames = &v
+	}
+	if x.NamesAfterKey != nil {
+		v := x.NamesAfterKey.clone()
+		c.NamesAfterKey = &v
+	}
+	if x.SuffixToKey != nil {
+		v := x.SuffixToKey.clone()
+		c.SuffixToKey = &v
+	}
+	if x.LettersAfterNames != nil {
+		v := x.LettersAfterNames.clone()
+		c.LettersAfterNames = &v
+	}
+	if x.TitlesAfterNames != nil {
+		v := x.TitlesAfterNames.clone()
+		c.TitlesAfterNames = &v
+	}
+	if x.Gender != nil {
+		v := x.Gender.clone()
+		c.Gender = &v
+	}
+	if x.CorporateName != nil {
+		v := x.CorporateName.clone()
+		c.CorporateName = &v
+	}
+	if x.CorporateNameInverted != nil {
+		v := x.CorporateNameInverted.clone()
+		c.CorporateNameInverted = &v
+	}
+	if x.AlternativeNames != nil {
+		c.AlternativeNames = make([]AlternativeName, len(x.AlternativeNames))
+		for i, v := range x.AlternativeNames {
+			c.AlternativeNames[i] = v.clone()
+		}
+	}
+	if x.SubjectDates != nil {
+		c.SubjectDates = make([]SubjectDate, len(x.SubjectDates))
+		for i, v := range x.SubjectDates {
+			c.SubjectDates[i] = v.clone()
+		}
+	}
+	if x.ProfessionalAffiliations != nil {
+		c.ProfessionalAffiliations = make([]ProfessionalAffiliation, len(x.ProfessionalAffiliations))
+		for i, v := range x.ProfessionalAffiliations {
+			c.ProfessionalAffiliations[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x NameAsSubject) equal(y NameAsSubject) bool {
+	if (x.NameType == nil) != (y.NameType == nil) || (x.NameType != nil && !x.NameType.equal(*y.NameType)) {
+		return false
+	}
+	if len(x.NameIdentifiers) != len(y.NameIdentifiers) {
+		return false
+	}
+	for i := range x.NameIdentifiers {
+		if !x.NameIdentifiers[i].equal(y.NameIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PersonName == nil) != (y.PersonName == nil) || (x.PersonName != nil && !x.PersonName.equal(*y.PersonName)) {
+		return false
+	}
+	if (x.PersonNameInverted == nil) != (y.PersonNameInverted == nil) || (x.PersonNameInverted != nil && !x.PersonNameInverted.equal(*y.PersonNameInverted)) {
+		return false
+	}
+	if (x.TitlesBeforeNames == nil) != (y.TitlesBeforeNames == nil) || (x.TitlesBeforeNames != nil && !x.TitlesBeforeNames.equal(*y.TitlesBeforeNames)) {
+		return false
+	}
+	if (x.NamesBeforeKey == nil) != (y.NamesBeforeKey == nil) || (x.NamesBeforeKey != nil && !x.NamesBeforeKey.equal(*y.NamesBeforeKey)) {
+		return false
+	}
+	if (x.PrefixToKey == nil) != (y.PrefixToKey == nil) || (x.PrefixToKey != nil && !x.PrefixToKey.equal(*y.PrefixToKey)) {
+		return false
+	}
+	if (x.KeyNames == nil) != (y.KeyNames == nil) || (x.KeyNames != nil && !x.KeyNames.equal(*y.KeyNames)) {
+		return false
+	}
+	if (x.NamesAfterKey == nil) != (y.NamesAfterKey == nil) || (x.NamesAfterKey != nil && !x.NamesAfterKey.equal(*y.NamesAfterKey)) {
+		return false
+	}
+	if (x.SuffixToKey == nil) != (y.SuffixToKey == nil) || (x.SuffixToKey != nil && !x.SuffixToKey.equal(*y.SuffixToKey)) {
+		return false
+	}
+	if (x.LettersAfterNames == nil) != (y.LettersAfterNames == nil) || (x.LettersAfterNames != nil && !x.LettersAfterNames.equal(*y.LettersAfterNames)) {
+		return false
+	}
+	if (x.TitlesAfterNames == nil) != (y.TitlesAfterNames == nil) || (x.TitlesAfterNames != nil && !x.TitlesAfterNames.equal(*y.TitlesAfterNames)) {
+		return false
+	}
+	if (x.Gender == nil) != (y.Gender == nil) || (x.Gender != nil && !x.Gender.equal(*y.Gender)) {
+		return false
+	}
+	if (x.CorporateName == nil) != (y.CorporateName == nil) || (x.CorporateName != nil && !x.CorporateName.equal(*y.CorporateName)) {
+		return false
+	}
+	if (x.CorporateNameInverted == nil) != (y.CorporateNameInverted == nil) || (x.CorporateNameInverted != nil && !x.CorporateNameInverted.equal(*y.CorporateNameInverted)) {
+		return false
+	}
+	if len(x.AlternativeNames) != len(y.AlternativeNames) {
+		return false
+	}
+	for i := range x.AlternativeNames {
+		if !x.AlternativeNames[i].equal(y.AlternativeNames[i]) {
+			return false
+		}
+	}
+	if len(x.SubjectDates) != len(y.SubjectDates) {
+		return false
+	}
+	for i := range x.SubjectDates {
+		if !x.SubjectDates[i].equal(y.SubjectDates[i]) {
+			return false
+		}
+	}
+	if len(x.ProfessionalAffiliations) != len(y.ProfessionalAffiliations) {
+		return false
+	}
+	for i := range x.ProfessionalAffiliations {
+		if !x.ProfessionalAffiliations[i].equal(y.ProfessionalAffiliations[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x NameIdentifier) clone() NameIdentifier {
+	c := x
+	c.NameIDType = x.NameIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x NameIdentifier) equal(y NameIdentifier) bool {
+	if !x.NameIDType.equal(y.NameIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x NewSupplier) clone() NewSupplier {
+	c := x
+	if x.SupplierIdentifiers != nil {
+		c.SupplierIdentifiers = make([]SupplierIdentifier, len(x.SupplierIdentifiers))
+		for i, v := range x.SupplierIdentifiers {
+			c.SupplierIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SupplierName != nil {
+		v := x.SupplierName.clone()
+		c.SupplierName = &v
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x NewSupplier) equal(y NewSupplier) bool {
+	if len(x.SupplierIdentifiers) != len(y.SupplierIdentifiers) {
+		return false
+	}
+	for i := range x.SupplierIdentifiers {
+		if !x.SupplierIdentifiers[i].equal(y.SupplierIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SupplierName == nil) != (y.SupplierName == nil) || (x.SupplierName != nil && !x.SupplierName.equal(*y.SupplierName)) {
+		return false
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ONIXMessage) clone() ONIXMessage {
+	c := x
+	c.Header = x.Header.clone()
+	if x.Products != nil {
+		c.Products = make([]Product, len(x.Products))
+		for i, v := range x.Products {
+			c.Products[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Release = x.Release.clone()
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ONIXMessage) equal(y ONIXMessage) bool {
+	if !x.Header.equal(y.Header) {
+		return false
+	}
+	if x.NoProduct != y.NoProduct {
+		return false
+	}
+	if len(x.Products) != len(y.Products) {
+		return false
+	}
+	for i := range x.Products {
+		if !x.Products[i].equal(y.Products[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	if !x.Release.equal(y.Release) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x OccurrenceDate) clone() OccurrenceDate {
+	c := x
+	c.OccurrenceDateRole = x.OccurrenceDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x OccurrenceDate) equal(y OccurrenceDate) bool {
+	if !x.OccurrenceDateRole.equal(y.OccurrenceDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x OnOrderDetail) clone() OnOrderDetail {
+	c := x
+	c.OnOrder = x.OnOrder.clone()
+	if x.Proximity != nil {
+		v := x.Proximity.clone()
+		c.Proximity = &v
+	}
+	c.ExpectedDate = x.ExpectedDate.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x OnOrderDetail) equal(y OnOrderDetail) bool {
+	if !x.OnOrder.equal(y.OnOrder) {
+		return false
+	}
+	if (x.Proximity == nil) != (y.Proximity == nil) || (x.Proximity != nil && !x.Proximity.equal(*y.Proximity)) {
+		return false
+	}
+	if !x.ExpectedDate.equal(y.ExpectedDate) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PageRun) clone() PageRun {
+	c := x
+	c.FirstPageNumber = x.FirstPageNumber.clone()
+	if x.LastPageNumber != nil {
+		v := x.LastPageNumber.clone()
+		c.LastPageNumber = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PageRun) equal(y PageRun) bool {
+	if !x.FirstPageNumber.equal(y.FirstPageNumber) {
+		return false
+	}
+	if (x.LastPageNumber == nil) != (y.LastPageNumber == nil) || (x.LastPageNumber != nil && !x.LastPageNumber.equal(*y.LastPageNumber)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Price) clone() Price {
+	c := x
+	if x.PriceIdentifiers != nil {
+		c.PriceIdentifiers = make([]PriceIdentifier, len(x.PriceIdentifiers))
+		for i, v := range x.PriceIdentifiers {
+			c.PriceIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PriceType != nil {
+		v := x.PriceType.clone()
+		c.PriceType = &v
+	}
+	if x.PriceQualifier != nil {
+		v := x.PriceQualifier.clone()
+		c.PriceQualifier = &v
+	}
+	if x.EpubTechnicalProtections != nil {
+		c.EpubTechnicalProtections = make([]EpubTechnicalProtection, len(x.EpubTechnicalProtections))
+		for i, v := range x.EpubTechnicalProtections {
+			c.EpubTechnicalProtections[i] = v.clone()
+		}
+	}
+	if x.PriceConstraints != nil {
+		c.PriceConstraints = make([]PriceConstraint, len(x.PriceConstraints))
+		for i, v := range x.PriceConstraints {
+			c.PriceConstraints[i] = v.clone()
+		}
+	}
+	if x.EpubLicense != nil {
+		v := x.EpubLicense.clone()
+		c.EpubLicense = &v
+	}
+	if x.PriceTypeDescriptions != nil {
+		c.PriceTypeDescriptions = make([]PlainText, len(x.PriceTypeDescriptions))
+		for i, v := range x.PriceTypeDescriptions {
+			c.PriceTypeDescriptions[i] = v.clone()
+		}
+	}
+	if x.PricePer != nil {
+		v := x.PricePer.clone()
+		c.PricePer = &v
+	}
+	if x.PriceConditions != nil {
+		c.PriceConditions = make([]PriceCondition, len(x.PriceConditions))
+		for i, v := range x.PriceConditions {
+			c.PriceConditions[i] = v.clone()
+		}
+	}
+	if x.MinimumOrderQuantity != nil {
+		v := x.MinimumOrderQuantity.clone()
+		c.MinimumOrderQuantity = &v
+	}
+	if x.BatchBonuss != nil {
+		c.BatchBonuss = make([]BatchBonus, len(x.BatchBonuss))
+		for i, v := range x.BatchBonuss {
+			c.BatchBonuss[i] = v.clone()
+		}
+	}
+	if x.DiscountCodeds != nil {
+		c.DiscountCodeds = make([]DiscountCoded, len(x.DiscountCodeds))
+		for i, v := range x.DiscountCodeds {
+			c.DiscountCodeds[i] = v.clone()
+		}
+	}
+	if x.Discounts != nil {
+		c.Discounts = make([]Discount, len(x.Discounts))
+		for i, v := range x.Discounts {
+			c.Discounts[i] = v.clone()
+		}
+	}
+	if x.PriceStatus != nil {
+		v := x.PriceStatus.clone()
+		c.PriceStatus = &v
+	}
+	if x.PriceAmount != nil {
+		v := x.PriceAmount.clone()
+		c.PriceAmount = &v
+	}
+	if x.PriceCoded != nil {
+		v := x.PriceCoded.clone()
+		c.PriceCoded = &v
+	}
+	if x.Taxs != nil {
+		c.Taxs = make([]Tax, len(x.Taxs))
+		for i, v := range x.Taxs {
+			c.Taxs[i] = v.clone()
+		}
+	}
+	if x.UnpricedItemType != nil {
+		v := x.UnpricedItemType.clone()
+		c.UnpricedItemType = &v
+	}
+	if x.CurrencyCode != nil {
+		v := x.CurrencyCode.clone()
+		c.CurrencyCode = &v
+	}
+	if x.Territory != nil {
+		v := x.Territory.clone()
+		c.Territory = &v
+	}
+	if x.CurrencyZone != nil {
+		v := x.CurrencyZone.clone()
+		c.CurrencyZone = &v
+	}
+	if x.ComparisonProductPrices != nil {
+		c.ComparisonProductPrices = make([]ComparisonProductPrice, len(x.ComparisonProductPrices))
+		for i, v := range x.ComparisonProductPrices {
+			c.ComparisonProductPrices[i] = v.clone()
+		}
+	}
+	if x.PriceDates != nil {
+		c.PriceDates = make([]PriceDate, len(x.PriceDates))
+		for i, v := range x.PriceDates {
+			c.PriceDates[i] = v.clone()
+		}
+	}
+	if x.PrintedOnProduct != nil {
+		v := x.PrintedOnProduct.clone()
+		c.PrintedOnProduct = &v
+	}
+	if x.PositionOnProduct != nil {
+		v := x.PositionOnProduct.clone()
+		c.PositionOnProduct = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Price) equal(y Price) bool {
+	if len(x.PriceIdentifiers) != len(y.PriceIdentifiers) {
+		return false
+	}
+	for i := range x.PriceIdentifiers {
+		if !x.PriceIdentifiers[i].equal(y.PriceIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PriceType == nil) != (y.PriceType == nil) || (x.PriceType != nil && !x.PriceType.equal(*y.PriceType)) {
+		return false
+	}
+	if (x.PriceQualifier == nil) != (y.PriceQualifier == nil) || (x.PriceQualifier != nil && !x.PriceQualifier.equal(*y.PriceQualifier)) {
+		return false
+	}
+	if len(x.EpubTechnicalProtections) != len(y.EpubTechnicalProtections) {
+		return false
+	}
+	for i := range x.EpubTechnicalProtections {
+		if !x.EpubTechnicalProtections[i].equal(y.EpubTechnicalProtections[i]) {
+			return false
+		}
+	}
+	if len(x.PriceConstraints) != len(y.PriceConstraints) {
+		return false
+	}
+	for i := range x.PriceConstraints {
+		if !x.PriceConstraints[i].equal(y.PriceConstraints[i]) {
+			return false
+		}
+	}
+	if (x.EpubLicense == nil) != (y.EpubLicense == nil) || (x.EpubLicense != nil && !x.EpubLicense.equal(*y.EpubLicense)) {
+		return false
+	}
+	if len(x.PriceTypeDescriptions) != len(y.PriceTypeDescriptions) {
+		return false
+	}
+	for i := range x.PriceTypeDescriptions {
+		if !x.PriceTypeDescriptions[i].equal(y.PriceTypeDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.PricePer == nil) != (y.PricePer == nil) || (x.PricePer != nil && !x.PricePer.equal(*y.PricePer)) {
+		return false
+	}
+	if len(x.PriceConditions) != len(y.PriceConditions) {
+		return false
+	}
+	for i := range x.PriceConditions {
+		if !x.PriceConditions[i].equal(y.PriceConditions[i]) {
+			return false
+		}
+	}
+	if (x.MinimumOrderQuantity == nil) != (y.MinimumOrderQuantity == nil) || (x.MinimumOrderQuantity != nil && !x.MinimumOrderQuantity.equal(*y.MinimumOrderQuantity)) {
+		return false
+	}
+	if len(x.BatchBonuss) != len(y.BatchBonuss) {
+		return false
+	}
+	for i := range x.BatchBonuss {
+		if !x.BatchBonuss[i].equal(y.BatchBonuss[i]) {
+			return false
+		}
+	}
+	if len(x.DiscountCodeds) != len(y.DiscountCodeds) {
+		return false
+	}
+	for i := range x.DiscountCodeds {
+		if !x.DiscountCodeds[i].equal(y.DiscountCodeds[i]) {
+			return false
+		}
+	}
+	if len(x.Discounts) != len(y.Discounts) {
+		return false
+	}
+	for i := range x.Discounts {
+		if !x.Discounts[i].equal(y.Discounts[i]) {
+			return false
+		}
+	}
+	if (x.PriceStatus == nil) != (y.PriceStatus == nil) || (x.PriceStatus != nil && !x.PriceStatus.equal(*y.PriceStatus)) {
+		return false
+	}
+	if (x.PriceAmount == nil) != (y.PriceAmount == nil) || (x.PriceAmount != nil && !x.PriceAmount.equal(*y.PriceAmount)) {
+		return false
+	}
+	if (x.PriceCoded == nil) != (y.PriceCoded == nil) || (x.PriceCoded != nil && !x.PriceCoded.equal(*y.PriceCoded)) {
+		return false
+	}
+	if len(x.Taxs) != len(y.Taxs) {
+		return false
+	}
+	for i := range x.Taxs {
+		if !x.Taxs[i].equal(y.Taxs[i]) {
+			return false
+		}
+	}
+	if x.TaxExempt != y.TaxExempt {
+		return false
+	}
+	if (x.UnpricedItemType == nil) != (y.UnpricedItemType == nil) || (x.UnpricedItemType != nil && !x.UnpricedItemType.equal(*y.UnpricedItemType)) {
+		return false
+	}
+	if (x.CurrencyCode == nil) != (y.CurrencyCode == nil) || (x.CurrencyCode != nil && !x.CurrencyCode.equal(*y.CurrencyCode)) {
+		return false
+	}
+	if (x.Territory == nil) != (y.Territory == nil) || (x.Territory != nil && !x.Territory.equal(*y.Territory)) {
+		return false
+	}
+	if (x.CurrencyZone == nil) != (y.CurrencyZone == nil) || (x.CurrencyZone != nil && !x.CurrencyZone.equal(*y.CurrencyZone)) {
+		return false
+	}
+	if len(x.ComparisonProductPrices) != len(y.ComparisonProductPrices) {
+		return false
+	}
+	for i := range x.ComparisonProductPrices {
+		if !x.ComparisonProductPrices[i].equal(y.ComparisonProductPrices[i]) {
+			return false
+		}
+	}
+	if len(x.PriceDates) != len(y.PriceDates) {
+		return false
+	}
+	for i := range x.PriceDates {
+		if !x.PriceDates[i].equal(y.PriceDates[i]) {
+			return false
+		}
+	}
+	if (x.PrintedOnProduct == nil) != (y.PrintedOnProduct == nil) || (x.PrintedOnProduct != nil && !x.PrintedOnProduct.equal(*y.PrintedOnProduct)) {
+		return false
+	}
+	if (x.PositionOnProduct == nil) != (y.PositionOnProduct == nil) || (x.PositionOnProduct != nil && !x.PositionOnProduct.equal(*y.PositionOnProduct)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceCoded) clone() PriceCoded {
+	c := x
+	c.PriceCodeType = x.PriceCodeType.clone()
+	if x.PriceCodeTypeName != nil {
+		v := x.PriceCodeTypeName.clone()
+		c.PriceCodeTypeName = &v
+	}
+	c.PriceCode = x.PriceCode.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceCoded) equal(y PriceCoded) bool {
+	if !x.PriceCodeType.equal(y.PriceCodeType) {
+		return false
+	}
+	if (x.PriceCodeTypeName == nil) != (y.PriceCodeTypeName == nil) || (x.PriceCodeTypeName != nil && !x.PriceCodeTypeName.equal(*y.PriceCodeTypeName)) {
+		return false
+	}
+	if !x.PriceCode.equal(y.PriceCode) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceCondition) clone() PriceCondition {
+	c := x
+	c.PriceConditionType = x.PriceConditionType.clone()
+	if x.PriceConditionQuantitys != nil {
+		c.PriceConditionQuantitys = make([]PriceConditionQuantity, len(x.PriceConditionQuantitys))
+		for i, v := range x.PriceConditionQuantitys {
+			c.PriceConditionQuantitys[i] = v.clone()
+		}
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceCondition) equal(y PriceCondition) bool {
+	if !x.PriceConditionType.equal(y.PriceConditionType) {
+		return false
+	}
+	if len(x.PriceConditionQuantitys) != len(y.PriceConditionQuantitys) {
+		return false
+	}
+	for i := range x.PriceConditionQuantitys {
+		if !x.PriceConditionQuantitys[i].equal(y.PriceConditionQuantitys[i]) {
+			return false
+		}
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceConditionQuantity) clone() PriceConditionQuantity {
+	c := x
+	c.PriceConditionQuantityType = x.PriceConditionQuantityType.clone()
+	c.Quantity = x.Quantity.clone()
+	c.QuantityUnit = x.QuantityUnit.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceConditionQuantity) equal(y PriceConditionQuantity) bool {
+	if !x.PriceConditionQuantityType.equal(y.PriceConditionQuantityType) {
+		return false
+	}
+	if !x.Quantity.equal(y.Quantity) {
+		return false
+	}
+	if !x.QuantityUnit.equal(y.QuantityUnit) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceConstraint) clone() PriceConstraint {
+	c := x
+	c.PriceConstraintType = x.PriceConstraintType.clone()
+	c.PriceConstraintStatus = x.PriceConstraintStatus.clone()
+	if x.PriceConstraintLimits != nil {
+		c.PriceConstraintLimits = make([]PriceConstraintLimit, len(x.PriceConstraintLimits))
+		for i, v := range x.PriceConstraintLimits {
+			c.PriceConstraintLimits[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceConstraint) equal(y PriceConstraint) bool {
+	if !x.PriceConstraintType.equal(y.PriceConstraintType) {
+		return false
+	}
+	if !x.PriceConstraintStatus.equal(y.PriceConstraintStatus) {
+		return false
+	}
+	if len(x.PriceConstraintLimits) != len(y.PriceConstraintLimits) {
+		return false
+	}
+	for i := range x.PriceConstraintLimits {
+		if !x.PriceConstraintLimits[i].equal(y.PriceConstraintLimits[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceConstraintLimit) clone() PriceConstraintLimit {
+	c := x
+	c.Quantity = x.Quantity.clone()
+	c.PriceConstraintUnit = x.PriceConstraintUnit.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceConstraintLimit) equal(y PriceConstraintLimit) bool {
+	if !x.Quantity.equal(y.Quantity) {
+		return false
+	}
+	if !x.PriceConstraintUnit.equal(y.PriceConstraintUnit) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceDate) clone() PriceDate {
+	c := x
+	c.PriceDateRole = x.PriceDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceDate) equal(y PriceDate) bool {
+	if !x.PriceDateRole.equal(y.PriceDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PriceIdentifier) clone() PriceIdentifier {
+	c := x
+	c.PriceIDType = x.PriceIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PriceIdentifier) equal(y PriceIdentifier) bool {
+	if !x.PriceIDType.equal(y.PriceIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Prize) clone() Prize {
+	c := x
+	if x.PrizeNames != nil {
+		c.PrizeNames = make([]PlainText, len(x.PrizeNames))
+		for i, v := range x.PrizeNames {
+			c.PrizeNames[i] = v.clone()
+		}
+	}
+	if x.PrizeYear != nil {
+		v := x.PrizeYear.clone()
+		c.PrizeYear = &v
+	}
+	if x.PrizeCountry != nil {
+		v := x.PrizeCountry.clone()
+		c.PrizeCountry = &v
+	}
+	if x.PrizeRegion != nil {
+		v := x.PrizeRegion.clone()
+		c.PrizeRegion = &v
+	}
+	if x.PrizeCode != nil {
+		v := x.PrizeCode.clone()
+		c.PrizeCode = &v
+	}
+	if x.PrizeStatements != nil {
+		c.PrizeStatements = make([]Flow, len(x.PrizeStatements))
+		for i, v := range x.PrizeStatements {
+			c.PrizeStatements[i] = v.clone()
+		}
+	}
+	if x.PrizeJurys != nil {
+		c.PrizeJurys = make([]Flow, len(x.PrizeJurys))
+		for i, v := range x.PrizeJurys {
+			c.PrizeJurys[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Prize) equal(y Prize) bool {
+	if len(x.PrizeNames) != len(y.PrizeNames) {
+		return false
+	}
+	for i := range x.PrizeNames {
+		if !x.PrizeNames[i].equal(y.PrizeNames[i]) {
+			return false
+		}
+	}
+	if (x.PrizeYear == nil) != (y.PrizeYear == nil) || (x.PrizeYear != nil && !x.PrizeYear.equal(*y.PrizeYear)) {
+		return false
+	}
+	if (x.PrizeCountry == nil) != (y.PrizeCountry == nil) || (x.PrizeCountry != nil && !x.PrizeCountry.equal(*y.PrizeCountry)) {
+		return false
+	}
+	if (x.PrizeRegion == nil) != (y.PrizeRegion == nil) || (x.PrizeRegion != nil && !x.PrizeRegion.equal(*y.PrizeRegion)) {
+		return false
+	}
+	if (x.PrizeCode == nil) != (y.PrizeCode == nil) || (x.PrizeCode != nil && !x.PrizeCode.equal(*y.PrizeCode)) {
+		return false
+	}
+	if len(x.PrizeStatements) != len(y.PrizeStatements) {
+		return false
+	}
+	for i := range x.PrizeStatements {
+		if !x.PrizeStatements[i].equal(y.PrizeStatements[i]) {
+			return false
+		}
+	}
+	if len(x.PrizeJurys) != len(y.PrizeJurys) {
+		return false
+	}
+	for i := range x.PrizeJurys {
+		if !x.PrizeJurys[i].equal(y.PrizeJurys[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Product) clone() Product {
+	c := x
+	c.RecordReference = x.RecordReference.clone()
+	c.NotificationType = x.NotificationType.clone()
+	if x.DeletionTexts != nil {
+		c.DeletionTexts = make([]PlainText, len(x.DeletionTexts))
+		for i, v := range x.DeletionTexts {
+			c.DeletionTexts[i] = v.clone()
+		}
+	}
+	if x.RecordSourceType != nil {
+		v := x.RecordSourceType.clone()
+		c.RecordSourceType = &v
+	}
+	if x.RecordSourceIdentifiers != nil {
+		c.RecordSourceIdentifiers = make([]RecordSourceIdentifier, len(x.RecordSourceIdentifiers))
+		for i, v := range x.RecordSourceIdentifiers {
+			c.RecordSourceIdentifiers[i] = v.clone()
+		}
+	}
+	if x.RecordSourceName != nil {
+		v := x.RecordSourceName.clone()
+		c.RecordSourceName = &v
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Barcodes != nil {
+		c.Barcodes = make([]Barcode, len(x.Barcodes))
+		for i, v := range x.Barcodes {
+			c.Barcodes[i] = v.clone()
+		}
+	}
+	if x.DescriptiveDetail != nil {
+		v := x.DescriptiveDetail.clone()
+		c.DescriptiveDetail = &v
+	}
+	if x.CollateralDetail != nil {
+		v := x.CollateralDetail.clone()
+		c.CollateralDetail = &v
+	}
+	if x.PromotionDetail != nil {
+		v := x.PromotionDetail.clone()
+		c.PromotionDetail = &v
+	}
+	if x.ContentDetail != nil {
+		v := x.ContentDetail.clone()
+		c.ContentDetail = &v
+	}
+	if x.PublishingDetail != nil {
+		v := x.PublishingDetail.clone()
+		c.PublishingDetail = &v
+	}
+	if x.RelatedMaterial != nil {
+		v := x.RelatedMaterial.clone()
+		c.RelatedMaterial = &v
+	}
+	if x.ProductSupplys != nil {
+		c.ProductSupplys = make([]ProductSupply, len(x.ProductSupplys))
+		for i, v := range x.ProductSupplys {
+			c.ProductSupplys[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Product) equal(y Product) bool {
+	if !x.RecordReference.equal(y.RecordReference) {
+		return false
+	}
+	if !x.NotificationType.equal(y.NotificationType) {
+		return false
+	}
+	if len(x.DeletionTexts) != len(y.DeletionTexts) {
+		return false
+	}
+	for i := range x.DeletionTexts {
+		if !x.DeletionTexts[i].equal(y.DeletionTexts[i]) {
+			return false
+		}
+	}
+	if (x.RecordSourceType == nil) != (y.RecordSourceType == nil) || (x.RecordSourceType != nil && !x.RecordSourceType.equal(*y.RecordSourceType)) {
+		return false
+	}
+	if len(x.RecordSourceIdentifiers) != len(y.RecordSourceIdentifiers) {
+		return false
+	}
+	for i := range x.RecordSourceIdentifiers {
+		if !x.RecordSourceIdentifiers[i].equal(y.RecordSourceIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.RecordSourceName == nil) != (y.RecordSourceName == nil) || (x.RecordSourceName != nil && !x.RecordSourceName.equal(*y.RecordSourceName)) {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.Barcodes) != len(y.Barcodes) {
+		return false
+	}
+	for i := range x.Barcodes {
+		if !x.Barcodes[i].equal(y.Barcodes[i]) {
+			return false
+		}
+	}
+	if (x.DescriptiveDetail == nil) != (y.DescriptiveDetail == nil) || (x.DescriptiveDetail != nil && !x.DescriptiveDetail.equal(*y.DescriptiveDetail)) {
+		return false
+	}
+	if (x.CollateralDetail == nil) != (y.CollateralDetail == nil) || (x.CollateralDetail != nil && !x.CollateralDetail.equal(*y.CollateralDetail)) {
+		return false
+	}
+	if (x.PromotionDetail == nil) != (y.PromotionDetail == nil) || (x.PromotionDetail != nil && !x.PromotionDetail.equal(*y.PromotionDetail)) {
+		return false
+	}
+	if (x.ContentDetail == nil) != (y.ContentDetail == nil) || (x.ContentDetail != nil && !x.ContentDetail.equal(*y.ContentDetail)) {
+		return false
+	}
+	if (x.PublishingDetail == nil) != (y.PublishingDetail == nil) || (x.PublishingDetail != nil && !x.PublishingDetail.equal(*y.PublishingDetail)) {
+		return false
+	}
+	if (x.RelatedMaterial == nil) != (y.RelatedMaterial == nil) || (x.RelatedMaterial != nil && !x.RelatedMaterial.equal(*y.RelatedMaterial)) {
+		return false
+	}
+	if len(x.ProductSupplys) != len(y.ProductSupplys) {
+		return false
+	}
+	for i := range x.ProductSupplys {
+		if !x.ProductSupplys[i].equal(y.ProductSupplys[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductClassification) clone() ProductClassification {
+	c := x
+	c.ProductClassificationType = x.ProductClassificationType.clone()
+	if x.ProductClassificationTypeName != nil {
+		v := x.ProductClassificationTypeName.clone()
+		c.ProductClassificationTypeName = &v
+	}
+	c.ProductClassificationCode = x.ProductClassificationCode.clone()
+	if x.Percent != nil {
+		v := x.Percent.clone()
+		c.Percent = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductClassification) equal(y ProductClassification) bool {
+	if !x.ProductClassificationType.equal(y.ProductClassificationType) {
+		return false
+	}
+	if (x.ProductClassificationTypeName == nil) != (y.ProductClassificationTypeName == nil) || (x.ProductClassificationTypeName != nil && !x.ProductClassificationTypeName.equal(*y.ProductClassificationTypeName)) {
+		return false
+	}
+	if !x.ProductClassificationCode.equal(y.ProductClassificationCode) {
+		return false
+	}
+	if (x.Percent == nil) != (y.Percent == nil) || (x.Percent != nil && !x.Percent.equal(*y.Percent)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductContact) clone() ProductContact {
+	c := x
+	c.ProductContactRole = x.ProductContactRole.clone()
+	if x.ProductContactIdentifiers != nil {
+		c.ProductContactIdentifiers = make([]ProductContactIdentifier, len(x.ProductContactIdentifiers))
+		for i, v := range x.ProductContactIdentifiers {
+			c.ProductContactIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ProductContactName != nil {
+		v := x.ProductContactName.clone()
+		c.ProductContactName = &v
+	}
+	if x.ContactName != nil {
+		v := x.ContactName.clone()
+		c.ContactName = &v
+	}
+	if x.EmailAddress != nil {
+		v := x.EmailAddress.clone()
+		c.EmailAddress = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductContact) equal(y ProductContact) bool {
+	if !x.ProductContactRole.equal(y.ProductContactRole) {
+		return false
+	}
+	if len(x.ProductContactIdentifiers) != len(y.ProductContactIdentifiers) {
+		return false
+	}
+	for i := range x.ProductContactIdentifiers {
+		if !x.ProductContactIdentifiers[i].equal(y.ProductContactIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ProductContactName == nil) != (y.ProductContactName == nil) || (x.ProductContactName != nil && !x.ProductContactName.equal(*y.ProductContactName)) {
+		return false
+	}
+	if (x.ContactName == nil) != (y.ContactName == nil) || (x.ContactName != nil && !x.ContactName.equal(*y.ContactName)) {
+		return false
+	}
+	if (x.EmailAddress == nil) != (y.EmailAddress == nil) || (x.EmailAddress != nil && !x.EmailAddress.equal(*y.EmailAddress)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductContactIdentifier) clone() ProductContactIdentifier {
+	c := x
+	c.ProductContactIDType = x.ProductContactIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductContactIdentifier) equal(y ProductContactIdentifier) bool {
+	if !x.ProductContactIDType.equal(y.ProductContactIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductFormFeature) clone() ProductFormFeature {
+	c := x
+	c.ProductFormFeatureType = x.ProductFormFeatureType.clone()
+	if x.ProductFormFeatureValue != nil {
+		v := x.ProductFormFeatureValue.clone()
+		c.ProductFormFeatureValue = &v
+	}
+	if x.ProductFormFeatureDescriptions != nil {
+		c.ProductFormFeatureDescriptions = make([]PlainText, len(x.ProductFormFeatureDescriptions))
+		for i, v := range x.ProductFormFeatureDescriptions {
+			c.ProductFormFeatureDescriptions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductFormFeature) equal(y ProductFormFeature) bool {
+	if !x.ProductFormFeatureType.equal(y.ProductFormFeatureType) {
+		return false
+	}
+	if (x.ProductFormFeatureValue == nil) != (y.ProductFormFeatureValue == nil) || (x.ProductFormFeatureValue != nil && !x.ProductFormFeatureValue.equal(*y.ProductFormFeatureValue)) {
+		return false
+	}
+	if len(x.ProductFormFeatureDescriptions) != len(y.ProductFormFeatureDescriptions) {
+		return false
+	}
+	for i := range x.ProductFormFeatureDescriptions {
+		if !x.ProductFormFeatureDescriptions[i].equal(y.ProductFormFeatureDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductIdentifier) clone() ProductIdentifier {
+	c := x
+	c.ProductIDType = x.ProductIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductIdentifier) equal(y ProductIdentifier) bool {
+	if !x.ProductIDType.equal(y.ProductIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductPart) clone() ProductPart {
+	c := x
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	c.ProductForm = x.ProductForm.clone()
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.ProductFormFeatures != nil {
+		c.ProductFormFeatures = make([]ProductFormFeature, len(x.ProductFormFeatures))
+		for i, v := range x.ProductFormFeatures {
+			c.ProductFormFeatures[i] = v.clone()
+		}
+	}
+	if x.ProductPackaging != nil {
+		v := x.ProductPackaging.clone()
+		c.ProductPackaging = &v
+	}
+	if x.ProductFormDescriptions != nil {
+		c.ProductFormDescriptions = make([]PlainText, len(x.ProductFormDescriptions))
+		for i, v := range x.ProductFormDescriptions {
+			c.ProductFormDescriptions[i] = v.clone()
+		}
+	}
+	if x.ProductContentTypes != nil {
+		c.ProductContentTypes = make([]ProductContentType, len(x.ProductContentTypes))
+		for i, v := range x.ProductContentTypes {
+			c.ProductContentTypes[i] = v.clone()
+		}
+	}
+	if x.Measures != nil {
+		c.Measures = make([]Measure, len(x.Measures))
+		for i, v := range x.Measures {
+			c.Measures[i] = v.clone()
+		}
+	}
+	if x.NumberOfItemsOfThisForm != nil {
+		v := x.NumberOfItemsOfThisForm.clone()
+		c.NumberOfItemsOfThisForm = &v
+	}
+	if x.NumberOfCopies != nil {
+		v := x.NumberOfCopies.clone()
+		c.NumberOfCopies = &v
+	}
+	if x.CountryOfManufacture != nil {
+		v := x.CountryOfManufacture.clone()
+		c.CountryOfManufacture = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductPart) equal(y ProductPart) bool {
+	if x.PrimaryPart != y.PrimaryPart {
+		return false
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if !x.ProductForm.equal(y.ProductForm) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if len(x.ProductFormFeatures) != len(y.ProductFormFeatures) {
+		return false
+	}
+	for i := range x.ProductFormFeatures {
+		if !x.ProductFormFeatures[i].equal(y.ProductFormFeatures[i]) {
+			return false
+		}
+	}
+	if (x.ProductPackaging == nil) != (y.ProductPackaging == nil) || (x.ProductPackaging != nil && !x.ProductPackaging.equal(*y.ProductPackaging)) {
+		return false
+	}
+	if len(x.ProductFormDescriptions) != len(y.ProductFormDescriptions) {
+		return false
+	}
+	for i := range x.ProductFormDescriptions {
+		if !x.ProductFormDescriptions[i].equal(y.ProductFormDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.ProductContentTypes) != len(y.ProductContentTypes) {
+		return false
+	}
+	for i := range x.ProductContentTypes {
+		if !x.ProductContentTypes[i].equal(y.ProductContentTypes[i]) {
+			return false
+		}
+	}
+	if len(x.Measures) != len(y.Measures) {
+		return false
+	}
+	for i := range x.Measures {
+		if !x.Measures[i].equal(y.Measures[i]) {
+			return false
+		}
+	}
+	if (x.NumberOfItemsOfThisForm == nil) != (y.NumberOfItemsOfThisForm == nil) || (x.NumberOfItemsOfThisForm != nil && !x.NumberOfItemsOfThisForm.equal(*y.NumberOfItemsOfThisForm)) {
+		return false
+	}
+	if (x.NumberOfCopies == nil) != (y.NumberOfCopies == nil) || (x.NumberOfCopies != nil && !x.NumberOfCopies.equal(*y.NumberOfCopies)) {
+		return false
+	}
+	if (x.CountryOfManufacture == nil) != (y.CountryOfManufacture == nil) || (x.CountryOfManufacture != nil && !x.CountryOfManufacture.equal(*y.CountryOfManufacture)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProductSupply) clone() ProductSupply {
+	c := x
+	if x.Markets != nil {
+		c.Markets = make([]Market, len(x.Markets))
+		for i, v := range x.Markets {
+			c.Markets[i] = v.clone()
+		}
+	}
+	if x.MarketPublishingDetail != nil {
+		v := x.MarketPublishingDetail.clone()
+		c.MarketPublishingDetail = &v
+	}
+	if x.SupplyDetails != nil {
+		c.SupplyDetails = make([]SupplyDetail, len(x.SupplyDetails))
+		for i, v := range x.SupplyDetails {
+			c.SupplyDetails[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProductSupply) equal(y ProductSupply) bool {
+	if len(x.Markets) != len(y.Markets) {
+		return false
+	}
+	for i := range x.Markets {
+		if !x.Markets[i].equal(y.Markets[i]) {
+			return false
+		}
+	}
+	if (x.MarketPublishingDetail == nil) != (y.MarketPublishingDetail == nil) || (x.MarketPublishingDetail != nil && !x.MarketPublishingDetail.equal(*y.MarketPublishingDetail)) {
+		return false
+	}
+	if len(x.SupplyDetails) != len(y.SupplyDetails) {
+		return false
+	}
+	for i := range x.SupplyDetails {
+		if !x.SupplyDetails[i].equal(y.SupplyDetails[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ProfessionalAffiliation) clone() ProfessionalAffiliation {
+	c := x
+	if x.ProfessionalPositions != nil {
+		c.ProfessionalPositions = make([]PlainText, len(x.ProfessionalPositions))
+		for i, v := range x.ProfessionalPositions {
+			c.ProfessionalPositions[i] = v.clone()
+		}
+	}
+	if x.Affiliation != nil {
+		v := x.Affiliation.clone()
+		c.Affiliation = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ProfessionalAffiliation) equal(y ProfessionalAffiliation) bool {
+	if len(x.ProfessionalPositions) != len(y.ProfessionalPositions) {
+		return false
+	}
+	for i := range x.ProfessionalPositions {
+		if !x.ProfessionalPositions[i].equal(y.ProfessionalPositions[i]) {
+			return false
+		}
+	}
+	if (x.Affiliation == nil) != (y.Affiliation == nil) || (x.Affiliation != nil && !x.Affiliation.equal(*y.Affiliation)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PromotionDetail) clone() PromotionDetail {
+	c := x
+	if x.PromotionalEvents != nil {
+		c.PromotionalEvents = make([]PromotionalEvent, len(x.PromotionalEvents))
+		for i, v := range x.PromotionalEvents {
+			c.PromotionalEvents[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PromotionDetail) equal(y PromotionDetail) bool {
+	if len(x.PromotionalEvents) != len(y.PromotionalEvents) {
+		return false
+	}
+	for i := range x.PromotionalEvents {
+		if !x.PromotionalEvents[i].equal(y.PromotionalEvents[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PromotionalEvent) clone() PromotionalEvent {
+	c := x
+	if x.EventIdentifiers != nil {
+		c.EventIdentifiers = make([]EventIdentifier, len(x.EventIdentifiers))
+		for i, v := range x.EventIdentifiers {
+			c.EventIdentifiers[i] = v.clone()
+		}
+	}
+	if x.EventTypes != nil {
+		c.EventTypes = make([]EventType, len(x.EventTypes))
+		for i, v := range x.EventTypes {
+			c.EventTypes[i] = v.clone()
+		}
+	}
+	if x.EventStatus != nil {
+		v := x.EventStatus.clone()
+		c.EventStatus = &v
+	}
+	if x.ContentAudiences != nil {
+		c.ContentAudiences = make([]ContentAudience, len(x.ContentAudiences))
+		for i, v := range x.ContentAudiences {
+			c.ContentAudiences[i] = v.clone()
+		}
+	}
+	if x.EventNames != nil {
+		c.EventNames = make([]PlainText, len(x.EventNames))
+		for i, v := range x.EventNames {
+			c.EventNames[i] = v.clone()
+		}
+	}
+	if x.EventDescriptions != nil {
+		c.EventDescriptions = make([]Flow, len(x.EventDescriptions))
+		for i, v := range x.EventDescriptions {
+			c.EventDescriptions[i] = v.clone()
+		}
+	}
+	if x.Contributors != nil {
+		c.Contributors = make([]Contributor, len(x.Contributors))
+		for i, v := range x.Contributors {
+			c.Contributors[i] = v.clone()
+		}
+	}
+	if x.ContributorReferences != nil {
+		c.ContributorReferences = make([]ContributorReference, len(x.ContributorReferences))
+		for i, v := range x.ContributorReferences {
+			c.ContributorReferences[i] = v.clone()
+		}
+	}
+	if x.ContributorStatements != nil {
+		c.ContributorStatements = make([]Flow, len(x.ContributorStatements))
+		for i, v := range x.ContributorStatements {
+			c.ContributorStatements[i] = v.clone()
+		}
+	}
+	if x.EventOccurrences != nil {
+		c.EventOccurrences = make([]EventOccurrence, len(x.EventOccurrences))
+		for i, v := range x.EventOccurrences {
+			c.EventOccurrences[i] = v.clone()
+		}
+	}
+	if x.EventSponsors != nil {
+		c.EventSponsors = make([]EventSponsor, len(x.EventSponsors))
+		for i, v := range x.EventSponsors {
+			c.EventSponsors[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PromotionalEvent) equal(y PromotionalEvent) bool {
+	if len(x.EventIdentifiers) != len(y.EventIdentifiers) {
+		return false
+	}
+	for i := range x.EventIdentifiers {
+		if !x.EventIdentifiers[i].equal(y.EventIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.EventTypes) != len(y.EventTypes) {
+		return false
+	}
+	for i := range x.EventTypes {
+		if !x.EventTypes[i].equal(y.EventTypes[i]) {
+			return false
+		}
+	}
+	if (x.EventStatus == nil) != (y.EventStatus == nil) || (x.EventStatus != nil && !x.EventStatus.equal(*y.EventStatus)) {
+		return false
+	}
+	if len(x.ContentAudiences) != len(y.ContentAudiences) {
+		return false
+	}
+	for i := range x.ContentAudiences {
+		if !x.ContentAudiences[i].equal(y.ContentAudiences[i]) {
+			return false
+		}
+	}
+	if len(x.EventNames) != len(y.EventNames) {
+		return false
+	}
+	for i := range x.EventNames {
+		if !x.EventNames[i].equal(y.EventNames[i]) {
+			return false
+		}
+	}
+	if len(x.EventDescriptions) != len(y.EventDescriptions) {
+		return false
+	}
+	for i := range x.EventDescriptions {
+		if !x.EventDescriptions[i].equal(y.EventDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.Contributors) != len(y.Contributors) {
+		return false
+	}
+	for i := range x.Contributors {
+		if !x.Contributors[i].equal(y.Contributors[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorReferences) != len(y.ContributorReferences) {
+		return false
+	}
+	for i := range x.ContributorReferences {
+		if !x.ContributorReferences[i].equal(y.ContributorReferences[i]) {
+			return false
+		}
+	}
+	if len(x.ContributorStatements) != len(y.ContributorStatements) {
+		return false
+	}
+	for i := range x.ContributorStatements {
+		if !x.ContributorStatements[i].equal(y.ContributorStatements[i]) {
+			return false
+		}
+	}
+	if x.NoContributor != y.NoContributor {
+		return false
+	}
+	if len(x.EventOccurrences) != len(y.EventOccurrences) {
+		return false
+	}
+	for i := range x.EventOccurrences {
+		if !x.EventOccurrences[i].equal(y.EventOccurrences[i]) {
+			return false
+		}
+	}
+	if len(x.EventSponsors) != len(y.EventSponsors) {
+		return false
+	}
+	for i := range x.EventSponsors {
+		if !x.EventSponsors[i].equal(y.EventSponsors[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Publisher) clone() Publisher {
+	c := x
+	c.PublishingRole = x.PublishingRole.clone()
+	if x.PublisherIdentifiers != nil {
+		c.PublisherIdentifiers = make([]PublisherIdentifier, len(x.PublisherIdentifiers))
+		for i, v := range x.PublisherIdentifiers {
+			c.PublisherIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PublisherName != nil {
+		v := x.PublisherName.clone()
+		c.PublisherName = &v
+	}
+	if x.Fundings != nil {
+		c.Fundings = make([]Funding, len(x.Fundings))
+		for i, v := range x.Fundings {
+			c.Fundings[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Publisher) equal(y Publisher) bool {
+	if !x.PublishingRole.equal(y.PublishingRole) {
+		return false
+	}
+	if len(x.PublisherIdentifiers) != len(y.PublisherIdentifiers) {
+		return false
+	}
+	for i := range x.PublisherIdentifiers {
+		if !x.PublisherIdentifiers[i].equal(y.PublisherIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PublisherName == nil) != (y.PublisherName == nil) || (x.PublisherName != nil && !x.PublisherName.equal(*y.PublisherName)) {
+		return false
+	}
+	if len(x.Fundings) != len(y.Fundings) {
+		return false
+	}
+	for i := range x.Fundings {
+		if !x.Fundings[i].equal(y.Fundings[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PublisherIdentifier) clone() PublisherIdentifier {
+	c := x
+	c.PublisherIDType = x.PublisherIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PublisherIdentifier) equal(y PublisherIdentifier) bool {
+	if !x.PublisherIDType.equal(y.PublisherIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PublisherRepresentative) clone() PublisherRepresentative {
+	c := x
+	c.AgentRole = x.AgentRole.clone()
+	if x.AgentIdentifiers != nil {
+		c.AgentIdentifiers = make([]AgentIdentifier, len(x.AgentIdentifiers))
+		for i, v := range x.AgentIdentifiers {
+			c.AgentIdentifiers[i] = v.clone()
+		}
+	}
+	if x.AgentName != nil {
+		v := x.AgentName.clone()
+		c.AgentName = &v
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PublisherRepresentative) equal(y PublisherRepresentative) bool {
+	if !x.AgentRole.equal(y.AgentRole) {
+		return false
+	}
+	if len(x.AgentIdentifiers) != len(y.AgentIdentifiers) {
+		return false
+	}
+	for i := range x.AgentIdentifiers {
+		if !x.AgentIdentifiers[i].equal(y.AgentIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.AgentName == nil) != (y.AgentName == nil) || (x.AgentName != nil && !x.AgentName.equal(*y.AgentName)) {
+		return false
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PublishingDate) clone() PublishingDate {
+	c := x
+	c.PublishingDateRole = x.PublishingDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PublishingDate) equal(y PublishingDate) bool {
+	if !x.PublishingDateRole.equal(y.PublishingDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x PublishingDetail) clone() PublishingDetail {
+	c := x
+	if x.Imprints != nil {
+		c.Imprints = make([]Imprint, len(x.Imprints))
+		for i, v := range x.Imprints {
+			c.Imprints[i] = v.clone()
+		}
+	}
+	if x.Publishers != nil {
+		c.Publishers = make([]Publisher, len(x.Publishers))
+		for i, v := range x.Publishers {
+			c.Publishers[i] = v.clone()
+		}
+	}
+	if x.CityOfPublications != nil {
+		c.CityOfPublications = make([]PlainText, len(x.CityOfPublications))
+		for i, v := range x.CityOfPublications {
+			c.CityOfPublications[i] = v.clone()
+		}
+	}
+	if x.CountryOfPublication != nil {
+		v := x.CountryOfPublication.clone()
+		c.CountryOfPublication = &v
+	}
+	if x.ProductContacts != nil {
+		c.ProductContacts = make([]ProductContact, len(x.ProductContacts))
+		for i, v := range x.ProductContacts {
+			c.ProductContacts[i] = v.clone()
+		}
+	}
+	if x.PublishingStatus != nil {
+		v := x.PublishingStatus.clone()
+		c.PublishingStatus = &v
+	}
+	if x.PublishingStatusNotes != nil {
+		c.PublishingStatusNotes = make([]Flow, len(x.PublishingStatusNotes))
+		for i, v := range x.PublishingStatusNotes {
+			c.PublishingStatusNotes[i] = v.clone()
+		}
+	}
+	if x.PublishingDates != nil {
+		c.PublishingDates = make([]PublishingDate, len(x.PublishingDates))
+		for i, v := range x.PublishingDates {
+			c.PublishingDates[i] = v.clone()
+		}
+	}
+	if x.LatestReprintNumber != nil {
+		v := x.LatestReprintNumber.clone()
+		c.LatestReprintNumber = &v
+	}
+	if x.CopyrightStatements != nil {
+		c.CopyrightStatements = make([]CopyrightStatement, len(x.CopyrightStatements))
+		for i, v := range x.CopyrightStatements {
+			c.CopyrightStatements[i] = v.clone()
+		}
+	}
+	if x.SalesRightss != nil {
+		c.SalesRightss = make([]SalesRights, len(x.SalesRightss))
+		for i, v := range x.SalesRightss {
+			c.SalesRightss[i] = v.clone()
+		}
+	}
+	if x.ROWSalesRightsType != nil {
+		v := x.ROWSalesRightsType.clone()
+		c.ROWSalesRightsType = &v
+	}
+	if x.SalesRestrictions != nil {
+		c.SalesRestrictions = make([]SalesRestriction, len(x.SalesRestrictions))
+		for i, v := range x.SalesRestrictions {
+			c.SalesRestrictions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x PublishingDetail) equal(y PublishingDetail) bool {
+	if len(x.Imprints) != len(y.Imprints) {
+		return false
+	}
+	for i := range x.Imprints {
+		if !x.Imprints[i].equal(y.Imprints[i]) {
+			return false
+		}
+	}
+	if len(x.Publishers) != len(y.Publishers) {
+		return false
+	}
+	for i := range x.Publishers {
+		if !x.Publishers[i].equal(y.Publishers[i]) {
+			return false
+		}
+	}
+	if len(x.CityOfPublications) != len(y.CityOfPublications) {
+		return false
+	}
+	for i := range x.CityOfPublications {
+		if !x.CityOfPublications[i].equal(y.CityOfPublications[i]) {
+			return false
+		}
+	}
+	if (x.CountryOfPublication == nil) != (y.CountryOfPublication == nil) || (x.CountryOfPublication != nil && !x.CountryOfPublication.equal(*y.CountryOfPublication)) {
+		return false
+	}
+	if len(x.ProductContacts) != len(y.ProductContacts) {
+		return false
+	}
+	for i := range x.ProductContacts {
+		if !x.ProductContacts[i].equal(y.ProductContacts[i]) {
+			return false
+		}
+	}
+	if (x.PublishingStatus == nil) != (y.PublishingStatus == nil) || (x.PublishingStatus != nil && !x.PublishingStatus.equal(*y.PublishingStatus)) {
+		return false
+	}
+	if len(x.PublishingStatusNotes) != len(y.PublishingStatusNotes) {
+		return false
+	}
+	for i := range x.PublishingStatusNotes {
+		if !x.PublishingStatusNotes[i].equal(y.PublishingStatusNotes[i]) {
+			return false
+		}
+	}
+	if len(x.PublishingDates) != len(y.PublishingDates) {
+		return false
+	}
+	for i := range x.PublishingDates {
+		if !x.PublishingDates[i].equal(y.PublishingDates[i]) {
+			return false
+		}
+	}
+	if (x.LatestReprintNumber == nil) != (y.LatestReprintNumber == nil) || (x.LatestReprintNumber != nil && !x.LatestReprintNumber.equal(*y.LatestReprintNumber)) {
+		return false
+	}
+	if len(x.CopyrightStatements) != len(y.CopyrightStatements) {
+		return false
+	}
+	for i := range x.CopyrightStatements {
+		if !x.CopyrightStatements[i].equal(y.CopyrightStatements[i]) {
+			return false
+		}
+	}
+	if len(x.SalesRightss) != len(y.SalesRightss) {
+		return false
+	}
+	for i := range x.SalesRightss {
+		if !x.SalesRightss[i].equal(y.SalesRightss[i]) {
+			return false
+		}
+	}
+	if (x.ROWSalesRightsType == nil) != (y.ROWSalesRightsType == nil) || (x.ROWSalesRightsType != nil && !x.ROWSalesRightsType.equal(*y.ROWSalesRightsType)) {
+		return false
+	}
+	if len(x.SalesRestrictions) != len(y.SalesRestrictions) {
+		return false
+	}
+	for i := range x.SalesRestrictions {
+		if !x.SalesRestrictions[i].equal(y.SalesRestrictions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x RecordSourceIdentifier) clone() RecordSourceIdentifier {
+	c := x
+	c.RecordSourceIDType = x.RecordSourceIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x RecordSourceIdentifier) equal(y RecordSourceIdentifier) bool {
+	if !x.RecordSourceIDType.equal(y.RecordSourceIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Reissue) clone() Reissue {
+	c := x
+	c.ReissueDate = x.ReissueDate.clone()
+	if x.ReissueDescription != nil {
+		v := x.ReissueDescription.clone()
+		c.ReissueDescription = &v
+	}
+	if x.Prices != nil {
+		c.Prices = make([]Price, len(x.Prices))
+		for i, v := range x.Prices {
+			c.Prices[i] = v.clone()
+		}
+	}
+	if x.SupportingResources != nil {
+		c.SupportingResources = make([]SupportingResource, len(x.SupportingResources))
+		for i, v := range x.SupportingResources {
+			c.SupportingResources[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Reissue) equal(y Reissue) bool {
+	if !x.ReissueDate.equal(y.ReissueDate) {
+		return false
+	}
+	if (x.ReissueDescription == nil) != (y.ReissueDescription == nil) || (x.ReissueDescription != nil && !x.ReissueDescription.equal(*y.ReissueDescription)) {
+		return false
+	}
+	if len(x.Prices) != len(y.Prices) {
+		return false
+	}
+	for i := range x.Prices {
+		if !x.Prices[i].equal(y.Prices[i]) {
+			return false
+		}
+	}
+	if len(x.SupportingResources) != len(y.SupportingResources) {
+		return false
+	}
+	for i := range x.SupportingResources {
+		if !x.SupportingResources[i].equal(y.SupportingResources[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x RelatedMaterial) clone() RelatedMaterial {
+	c := x
+	if x.RelatedWorks != nil {
+		c.RelatedWorks = make([]RelatedWork, len(x.RelatedWorks))
+		for i, v := range x.RelatedWorks {
+			c.RelatedWorks[i] = v.clone()
+		}
+	}
+	if x.RelatedProducts != nil {
+		c.RelatedProducts = make([]RelatedProduct, len(x.RelatedProducts))
+		for i, v := range x.RelatedProducts {
+			c.RelatedProducts[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x RelatedMaterial) equal(y RelatedMaterial) bool {
+	if len(x.RelatedWorks) != len(y.RelatedWorks) {
+		return false
+	}
+	for i := range x.RelatedWorks {
+		if !x.RelatedWorks[i].equal(y.RelatedWorks[i]) {
+			return false
+		}
+	}
+	if len(x.RelatedProducts) != len(y.RelatedProducts) {
+		return false
+	}
+	for i := range x.RelatedProducts {
+		if !x.RelatedProducts[i].equal(y.RelatedProducts[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x RelatedProduct) clone() RelatedProduct {
+	c := x
+	if x.ProductRelationCodes != nil {
+		c.ProductRelationCodes = make([]ProductRelationCode, len(x.ProductRelationCodes))
+		for i, v := range x.ProductRelationCodes {
+			c.ProductRelationCodes[i] = v.clone()
+		}
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.ProductForm != nil {
+		v := x.ProductForm.clone()
+		c.ProductForm = &v
+	}
+	if x.ProductFormDetails != nil {
+		c.ProductFormDetails = make([]ProductFormDetail, len(x.ProductFormDetails))
+		for i, v := range x.ProductFormDetails {
+			c.ProductFormDetails[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x RelatedProduct) equal(y RelatedProduct) bool {
+	if len(x.ProductRelationCodes) != len(y.ProductRelationCodes) {
+		return false
+	}
+	for i := range x.ProductRelationCodes {
+		if !x.ProductRelationCodes[i].equal(y.ProductRelationCodes[i]) {
+			return false
+		}
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.ProductForm == nil) != (y.ProductForm == nil) || (x.ProductForm != nil && !x.ProductForm.equal(*y.ProductForm)) {
+		return false
+	}
+	if len(x.ProductFormDetails) != len(y.ProductFormDetails) {
+		return false
+	}
+	for i := range x.ProductFormDetails {
+		if !x.ProductFormDetails[i].equal(y.ProductFormDetails[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x RelatedWork) clone() RelatedWork {
+	c := x
+	c.WorkRelationCode = x.WorkRelationCode.clone()
+	if x.WorkIdentifiers != nil {
+		c.WorkIdentifiers = make([]WorkIdentifier, len(x.WorkIdentifiers))
+		for i, v := range x.WorkIdentifiers {
+			c.WorkIdentifiers[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x RelatedWork) equal(y RelatedWork) bool {
+	if !x.WorkRelationCode.equal(y.WorkRelationCode) {
+		return false
+	}
+	if len(x.WorkIdentifiers) != len(y.WorkIdentifiers) {
+		return false
+	}
+	for i := range x.WorkIdentifiers {
+		if !x.WorkIdentifiers[i].equal(y.WorkIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReligiousText) clone() ReligiousText {
+	c := x
+	if x.Bible != nil {
+		v := x.Bible.clone()
+		c.Bible = &v
+	}
+	if x.ReligiousTextIdentifier != nil {
+		v := x.ReligiousTextIdentifier.clone()
+		c.ReligiousTextIdentifier = &v
+	}
+	if x.ReligiousTextFeatures != nil {
+		c.ReligiousTextFeatures = make([]ReligiousTextFeature, len(x.ReligiousTextFeatures))
+		for i, v := range x.ReligiousTextFeatures {
+			c.ReligiousTextFeatures[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReligiousText) equal(y ReligiousText) bool {
+	if (x.Bible == nil) != (y.Bible == nil) || (x.Bible != nil && !x.Bible.equal(*y.Bible)) {
+		return false
+	}
+	if (x.ReligiousTextIdentifier == nil) != (y.ReligiousTextIdentifier == nil) || (x.ReligiousTextIdentifier != nil && !x.ReligiousTextIdentifier.equal(*y.ReligiousTextIdentifier)) {
+		return false
+	}
+	if len(x.ReligiousTextFeatures) != len(y.ReligiousTextFeatures) {
+		return false
+	}
+	for i := range x.ReligiousTextFeatures {
+		if !x.ReligiousTextFeatures[i].equal(y.ReligiousTextFeatures[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReligiousTextFeature) clone() ReligiousTextFeature {
+	c := x
+	c.ReligiousTextFeatureType = x.ReligiousTextFeatureType.clone()
+	c.ReligiousTextFeatureCode = x.ReligiousTextFeatureCode.clone()
+	if x.ReligiousTextFeatureDescriptions != nil {
+		c.ReligiousTextFeatureDescriptions = make([]Flow, len(x.ReligiousTextFeatureDescriptions))
+		for i, v := range x.ReligiousTextFeatureDescriptions {
+			c.ReligiousTextFeatureDescriptions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReligiousTextFeature) equal(y ReligiousTextFeature) bool {
+	if !x.ReligiousTextFeatureType.equal(y.ReligiousTextFeatureType) {
+		return false
+	}
+	if !x.ReligiousTextFeatureCode.equal(y.ReligiousTextFeatureCode) {
+		return false
+	}
+	if len(x.ReligiousTextFeatureDescriptions) != len(y.ReligiousTextFeatureDescriptions) {
+		return false
+	}
+	for i := range x.ReligiousTextFeatureDescriptions {
+		if !x.ReligiousTextFeatureDescriptions[i].equal(y.ReligiousTextFeatureDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ResourceFeature) clone() ResourceFeature {
+	c := x
+	c.ResourceFeatureType = x.ResourceFeatureType.clone()
+	if x.FeatureValue != nil {
+		v := x.FeatureValue.clone()
+		c.FeatureValue = &v
+	}
+	if x.FeatureNotes != nil {
+		c.FeatureNotes = make([]Flow, len(x.FeatureNotes))
+		for i, v := range x.FeatureNotes {
+			c.FeatureNotes[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ResourceFeature) equal(y ResourceFeature) bool {
+	if !x.ResourceFeatureType.equal(y.ResourceFeatureType) {
+		return false
+	}
+	if (x.FeatureValue == nil) != (y.FeatureValue == nil) || (x.FeatureValue != nil && !x.FeatureValue.equal(*y.FeatureValue)) {
+		return false
+	}
+	if len(x.FeatureNotes) != len(y.FeatureNotes) {
+		return false
+	}
+	for i := range x.FeatureNotes {
+		if !x.FeatureNotes[i].equal(y.FeatureNotes[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ResourceVersion) clone() ResourceVersion {
+	c := x
+	c.ResourceForm = x.ResourceForm.clone()
+	if x.ResourceVersionFeatures != nil {
+		c.ResourceVersionFeatures = make([]ResourceVersionFeature, len(x.ResourceVersionFeatures))
+		for i, v := range x.ResourceVersionFeatures {
+			c.ResourceVersionFeatures[i] = v.clone()
+		}
+	}
+	if x.ResourceLinks != nil {
+		c.ResourceLinks = make([]PlainText, len(x.ResourceLinks))
+		for i, v := range x.ResourceLinks {
+			c.ResourceLinks[i] = v.clone()
+		}
+	}
+	if x.ContentDates != nil {
+		c.ContentDates = make([]ContentDate, len(x.ContentDates))
+		for i, v := range x.ContentDates {
+			c.ContentDates[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ResourceVersion) equal(y ResourceVersion) bool {
+	if !x.ResourceForm.equal(y.ResourceForm) {
+		return false
+	}
+	if len(x.ResourceVersionFeatures) != len(y.ResourceVersionFeatures) {
+		return false
+	}
+	for i := range x.ResourceVersionFeatures {
+		if !x.ResourceVersionFeatures[i].equal(y.ResourceVersionFeatures[i]) {
+			return false
+		}
+	}
+	if len(x.ResourceLinks) != len(y.ResourceLinks) {
+		return false
+	}
+	for i := range x.ResourceLinks {
+		if !x.ResourceLinks[i].equal(y.ResourceLinks[i]) {
+			return false
+		}
+	}
+	if len(x.ContentDates) != len(y.ContentDates) {
+		return false
+	}
+	for i := range x.ContentDates {
+		if !x.ContentDates[i].equal(y.ContentDates[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ResourceVersionFeature) clone() ResourceVersionFeature {
+	c := x
+	c.ResourceVersionFeatureType = x.ResourceVersionFeatureType.clone()
+	if x.FeatureValue != nil {
+		v := x.FeatureValue.clone()
+		c.FeatureValue = &v
+	}
+	if x.FeatureNotes != nil {
+		c.FeatureNotes = make([]Flow, len(x.FeatureNotes))
+		for i, v := range x.FeatureNotes {
+			c.FeatureNotes[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ResourceVersionFeature) equal(y ResourceVersionFeature) bool {
+	if !x.ResourceVersionFeatureType.equal(y.ResourceVersionFeatureType) {
+		return false
+	}
+	if (x.FeatureValue == nil) != (y.FeatureValue == nil) || (x.FeatureValue != nil && !x.FeatureValue.equal(*y.FeatureValue)) {
+		return false
+	}
+	if len(x.FeatureNotes) != len(y.FeatureNotes) {
+		return false
+	}
+	for i := range x.FeatureNotes {
+		if !x.FeatureNotes[i].equal(y.FeatureNotes[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReturnsConditions) clone() ReturnsConditions {
+	c := x
+	c.ReturnsCodeType = x.ReturnsCodeType.clone()
+	if x.ReturnsCodeTypeName != nil {
+		v := x.ReturnsCodeTypeName.clone()
+		c.ReturnsCodeTypeName = &v
+	}
+	c.ReturnsCode = x.ReturnsCode.clone()
+	if x.ReturnsNotes != nil {
+		c.ReturnsNotes = make([]PlainText, len(x.ReturnsNotes))
+		for i, v := range x.ReturnsNotes {
+			c.ReturnsNotes[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReturnsConditions) equal(y ReturnsConditions) bool {
+	if !x.ReturnsCodeType.equal(y.ReturnsCodeType) {
+		return false
+	}
+	if (x.ReturnsCodeTypeName == nil) != (y.ReturnsCodeTypeName == nil) || (x.ReturnsCodeTypeName != nil && !x.ReturnsCodeTypeName.equal(*y.ReturnsCodeTypeName)) {
+		return false
+	}
+	if !x.ReturnsCode.equal(y.ReturnsCode) {
+		return false
+	}
+	if len(x.ReturnsNotes) != len(y.ReturnsNotes) {
+		return false
+	}
+	for i := range x.ReturnsNotes {
+		if !x.ReturnsNotes[i].equal(y.ReturnsNotes[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x ReviewRating) clone() ReviewRating {
+	c := x
+	c.Rating = x.Rating.clone()
+	if x.RatingLimit != nil {
+		v := x.RatingLimit.clone()
+		c.RatingLimit = &v
+	}
+	if x.RatingUnitss != nil {
+		c.RatingUnitss = make([]PlainText, len(x.RatingUnitss))
+		for i, v := range x.RatingUnitss {
+			c.RatingUnitss[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x ReviewRating) equal(y ReviewRating) bool {
+	if !x.Rating.equal(y.Rating) {
+		return false
+	}
+	if (x.RatingLimit == nil) != (y.RatingLimit == nil) || (x.RatingLimit != nil && !x.RatingLimit.equal(*y.RatingLimit)) {
+		return false
+	}
+	if len(x.RatingUnitss) != len(y.RatingUnitss) {
+		return false
+	}
+	for i := range x.RatingUnitss {
+		if !x.RatingUnitss[i].equal(y.RatingUnitss[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesOutlet) clone() SalesOutlet {
+	c := x
+	if x.SalesOutletIdentifiers != nil {
+		c.SalesOutletIdentifiers = make([]SalesOutletIdentifier, len(x.SalesOutletIdentifiers))
+		for i, v := range x.SalesOutletIdentifiers {
+			c.SalesOutletIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SalesOutletName != nil {
+		v := x.SalesOutletName.clone()
+		c.SalesOutletName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesOutlet) equal(y SalesOutlet) bool {
+	if len(x.SalesOutletIdentifiers) != len(y.SalesOutletIdentifiers) {
+		return false
+	}
+	for i := range x.SalesOutletIdentifiers {
+		if !x.SalesOutletIdentifiers[i].equal(y.SalesOutletIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SalesOutletName == nil) != (y.SalesOutletName == nil) || (x.SalesOutletName != nil && !x.SalesOutletName.equal(*y.SalesOutletName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesOutletIdentifier) clone() SalesOutletIdentifier {
+	c := x
+	c.SalesOutletIDType = x.SalesOutletIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesOutletIdentifier) equal(y SalesOutletIdentifier) bool {
+	if !x.SalesOutletIDType.equal(y.SalesOutletIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesRestriction) clone() SalesRestriction {
+	c := x
+	c.SalesRestrictionType = x.SalesRestrictionType.clone()
+	if x.SalesOutlets != nil {
+		c.SalesOutlets = make([]SalesOutlet, len(x.SalesOutlets))
+		for i, v := range x.SalesOutlets {
+			c.SalesOutlets[i] = v.clone()
+		}
+	}
+	if x.SalesRestrictionNotes != nil {
+		c.SalesRestrictionNotes = make([]Flow, len(x.SalesRestrictionNotes))
+		for i, v := range x.SalesRestrictionNotes {
+			c.SalesRestrictionNotes[i] = v.clone()
+		}
+	}
+	if x.StartDate != nil {
+		v := x.StartDate.clone()
+		c.StartDate = &v
+	}
+	if x.EndDate != nil {
+		v := x.EndDate.clone()
+		c.EndDate = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesRestriction) equal(y SalesRestriction) bool {
+	if !x.SalesRestrictionType.equal(y.SalesRestrictionType) {
+		return false
+	}
+	if len(x.SalesOutlets) != len(y.SalesOutlets) {
+		return false
+	}
+	for i := range x.SalesOutlets {
+		if !x.SalesOutlets[i].equal(y.SalesOutlets[i]) {
+			return false
+		}
+	}
+	if len(x.SalesRestrictionNotes) != len(y.SalesRestrictionNotes) {
+		return false
+	}
+	for i := range x.SalesRestrictionNotes {
+		if !x.SalesRestrictionNotes[i].equal(y.SalesRestrictionNotes[i]) {
+			return false
+		}
+	}
+	if (x.StartDate == nil) != (y.StartDate == nil) || (x.StartDate != nil && !x.StartDate.equal(*y.StartDate)) {
+		return false
+	}
+	if (x.EndDate == nil) != (y.EndDate == nil) || (x.EndDate != nil && !x.EndDate.equal(*y.EndDate)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SalesRights) clone() SalesRights {
+	c := x
+	c.SalesRightsType = x.SalesRightsType.clone()
+	c.Territory = x.Territory.clone()
+	if x.SalesRestrictions != nil {
+		c.SalesRestrictions = make([]SalesRestriction, len(x.SalesRestrictions))
+		for i, v := range x.SalesRestrictions {
+			c.SalesRestrictions[i] = v.clone()
+		}
+	}
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PublisherName != nil {
+		v := x.PublisherName.clone()
+		c.PublisherName = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SalesRights) equal(y SalesRights) bool {
+	if !x.SalesRightsType.equal(y.SalesRightsType) {
+		return false
+	}
+	if !x.Territory.equal(y.Territory) {
+		return false
+	}
+	if len(x.SalesRestrictions) != len(y.SalesRestrictions) {
+		return false
+	}
+	for i := range x.SalesRestrictions {
+		if !x.SalesRestrictions[i].equal(y.SalesRestrictions[i]) {
+			return false
+		}
+	}
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.PublisherName == nil) != (y.PublisherName == nil) || (x.PublisherName != nil && !x.PublisherName.equal(*y.PublisherName)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Sender) clone() Sender {
+	c := x
+	if x.SenderIdentifiers != nil {
+		c.SenderIdentifiers = make([]SenderIdentifier, len(x.SenderIdentifiers))
+		for i, v := range x.SenderIdentifiers {
+			c.SenderIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SenderName != nil {
+		v := x.SenderName.clone()
+		c.SenderName = &v
+	}
+	if x.ContactName != nil {
+		v := x.ContactName.clone()
+		c.ContactName = &v
+	}
+	if x.EmailAddress != nil {
+		v := x.EmailAddress.clone()
+		c.EmailAddress = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Sender) equal(y Sender) bool {
+	if len(x.SenderIdentifiers) != len(y.SenderIdentifiers) {
+		return false
+	}
+	for i := range x.SenderIdentifiers {
+		if !x.SenderIdentifiers[i].equal(y.SenderIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SenderName == nil) != (y.SenderName == nil) || (x.SenderName != nil && !x.SenderName.equal(*y.SenderName)) {
+		return false
+	}
+	if (x.ContactName == nil) != (y.ContactName == nil) || (x.ContactName != nil && !x.ContactName.equal(*y.ContactName)) {
+		return false
+	}
+	if (x.EmailAddress == nil) != (y.EmailAddress == nil) || (x.EmailAddress != nil && !x.EmailAddress.equal(*y.EmailAddress)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SenderIdentifier) clone() SenderIdentifier {
+	c := x
+	c.SenderIDType = x.SenderIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SenderIdentifier) equal(y SenderIdentifier) bool {
+	if !x.SenderIDType.equal(y.SenderIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Stock) clone() Stock {
+	c := x
+	if x.LocationIdentifiers != nil {
+		c.LocationIdentifiers = make([]LocationIdentifier, len(x.LocationIdentifiers))
+		for i, v := range x.LocationIdentifiers {
+			c.LocationIdentifiers[i] = v.clone()
+		}
+	}
+	if x.LocationNames != nil {
+		c.LocationNames = make([]PlainText, len(x.LocationNames))
+		for i, v := range x.LocationNames {
+			c.LocationNames[i] = v.clone()
+		}
+	}
+	if x.StockQuantityCodeds != nil {
+		c.StockQuantityCodeds = make([]StockQuantityCoded, len(x.StockQuantityCodeds))
+		for i, v := range x.StockQuantityCodeds {
+			c.StockQuantityCodeds[i] = v.clone()
+		}
+	}
+	if x.OnHand != nil {
+		v := x.OnHand.clone()
+		c.OnHand = &v
+	}
+	if x.Proximity != nil {
+		v := x.Proximity.clone()
+		c.Proximity = &v
+	}
+	if x.Reserved != nil {
+		v := x.Reserved.clone()
+		c.Reserved = &v
+	}
+	if x.OnOrder != nil {
+		v := x.OnOrder.clone()
+		c.OnOrder = &v
+	}
+	if x.CBO != nil {
+		v := x.CBO.clone()
+		c.CBO = &v
+	}
+	if x.OnOrderDetails != nil {
+		c.OnOrderDetails = make([]OnOrderDetail, len(x.OnOrderDetails))
+		for i, v := range x.OnOrderDetails {
+			c.OnOrderDetails[i] = v.clone()
+		}
+	}
+	if x.Velocitys != nil {
+		c.Velocitys = make([]Velocity, len(x.Velocitys))
+		for i, v := range x.Velocitys {
+			c.Velocitys[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Stock) equal(y Stock) bool {
+	if len(x.LocationIdentifiers) != len(y.LocationIdentifiers) {
+		return false
+	}
+	for i := range x.LocationIdentifiers {
+		if !x.LocationIdentifiers[i].equal(y.LocationIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.LocationNames) != len(y.LocationNames) {
+		return false
+	}
+	for i := range x.LocationNames {
+		if !x.LocationNames[i].equal(y.LocationNames[i]) {
+			return false
+		}
+	}
+	if len(x.StockQuantityCodeds) != len(y.StockQuantityCodeds) {
+		return false
+	}
+	for i := range x.StockQuantityCodeds {
+		if !x.StockQuantityCodeds[i].equal(y.StockQuantityCodeds[i]) {
+			return false
+		}
+	}
+	if (x.OnHand == nil) != (y.OnHand == nil) || (x.OnHand != nil && !x.OnHand.equal(*y.OnHand)) {
+		return false
+	}
+	if (x.Proximity == nil) != (y.Proximity == nil) || (x.Proximity != nil && !x.Proximity.equal(*y.Proximity)) {
+		return false
+	}
+	if (x.Reserved == nil) != (y.Reserved == nil) || (x.Reserved != nil && !x.Reserved.equal(*y.Reserved)) {
+		return false
+	}
+	if (x.OnOrder == nil) != (y.OnOrder == nil) || (x.OnOrder != nil && !x.OnOrder.equal(*y.OnOrder)) {
+		return false
+	}
+	if (x.CBO == nil) != (y.CBO == nil) || (x.CBO != nil && !x.CBO.equal(*y.CBO)) {
+		return false
+	}
+	if len(x.OnOrderDetails) != len(y.OnOrderDetails) {
+		return false
+	}
+	for i := range x.OnOrderDetails {
+		if !x.OnOrderDetails[i].equal(y.OnOrderDetails[i]) {
+			return false
+		}
+	}
+	if len(x.Velocitys) != len(y.Velocitys) {
+		return false
+	}
+	for i := range x.Velocitys {
+		if !x.Velocitys[i].equal(y.Velocitys[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x StockQuantityCoded) clone() StockQuantityCoded {
+	c := x
+	c.StockQuantityCodeType = x.StockQuantityCodeType.clone()
+	if x.StockQuantityCodeTypeName != nil {
+		v := x.StockQuantityCodeTypeName.clone()
+		c.StockQuantityCodeTypeName = &v
+	}
+	c.StockQuantityCode = x.StockQuantityCode.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x StockQuantityCoded) equal(y StockQuantityCoded) bool {
+	if !x.StockQuantityCodeType.equal(y.StockQuantityCodeType) {
+		return false
+	}
+	if (x.StockQuantityCodeTypeName == nil) != (y.StockQuantityCodeTypeName == nil) || (x.StockQuantityCodeTypeName != nil && !x.StockQuantityCodeTypeName.equal(*y.StockQuantityCodeTypeName)) {
+		return false
+	}
+	if !x.StockQuantityCode.equal(y.StockQuantityCode) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Subject) clone() Subject {
+	c := x
+	c.SubjectSchemeIdentifier = x.SubjectSchemeIdentifier.clone()
+	if x.SubjectSchemeName != nil {
+		v := x.SubjectSchemeName.clone()
+		c.SubjectSchemeName = &v
+	}
+	if x.SubjectSchemeVersion != nil {
+		v := x.SubjectSchemeVersion.clone()
+		c.SubjectSchemeVersion = &v
+	}
+	if x.SubjectCode != nil {
+		v := x.SubjectCode.clone()
+		c.SubjectCode = &v
+	}
+	if x.SubjectHeadingTexts != nil {
+		c.SubjectHeadingTexts = make([]PlainText, len(x.SubjectHeadingTexts))
+		for i, v := range x.SubjectHeadingTexts {
+			c.SubjectHeadingTexts[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Subject) equal(y Subject) bool {
+	if x.MainSubject != y.MainSubject {
+		return false
+	}
+	if !x.SubjectSchemeIdentifier.equal(y.SubjectSchemeIdentifier) {
+		return false
+	}
+	if (x.SubjectSchemeName == nil) != (y.SubjectSchemeName == nil) || (x.SubjectSchemeName != nil && !x.SubjectSchemeName.equal(*y.SubjectSchemeName)) {
+		return false
+	}
+	if (x.SubjectSchemeVersion == nil) != (y.SubjectSchemeVersion == nil) || (x.SubjectSchemeVersion != nil && !x.SubjectSchemeVersion.equal(*y.SubjectSchemeVersion)) {
+		return false
+	}
+	if (x.SubjectCode == nil) != (y.SubjectCode == nil) || (x.SubjectCode != nil && !x.SubjectCode.equal(*y.SubjectCode)) {
+		return false
+	}
+	if len(x.SubjectHeadingTexts) != len(y.SubjectHeadingTexts) {
+		return false
+	}
+	for i := range x.SubjectHeadingTexts {
+		if !x.SubjectHeadingTexts[i].equal(y.SubjectHeadingTexts[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SubjectDate) clone() SubjectDate {
+	c := x
+	c.SubjectDateRole = x.SubjectDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SubjectDate) equal(y SubjectDate) bool {
+	if !x.SubjectDateRole.equal(y.SubjectDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Supplier) clone() Supplier {
+	c := x
+	c.SupplierRole = x.SupplierRole.clone()
+	if x.SupplierIdentifiers != nil {
+		c.SupplierIdentifiers = make([]SupplierIdentifier, len(x.SupplierIdentifiers))
+		for i, v := range x.SupplierIdentifiers {
+			c.SupplierIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SupplierName != nil {
+		v := x.SupplierName.clone()
+		c.SupplierName = &v
+	}
+	if x.TelephoneNumbers != nil {
+		c.TelephoneNumbers = make([]PlainText, len(x.TelephoneNumbers))
+		for i, v := range x.TelephoneNumbers {
+			c.TelephoneNumbers[i] = v.clone()
+		}
+	}
+	if x.FaxNumbers != nil {
+		c.FaxNumbers = make([]PlainText, len(x.FaxNumbers))
+		for i, v := range x.FaxNumbers {
+			c.FaxNumbers[i] = v.clone()
+		}
+	}
+	if x.EmailAddresss != nil {
+		c.EmailAddresss = make([]PlainText, len(x.EmailAddresss))
+		for i, v := range x.EmailAddresss {
+			c.EmailAddresss[i] = v.clone()
+		}
+	}
+	if x.Websites != nil {
+		c.Websites = make([]Website, len(x.Websites))
+		for i, v := range x.Websites {
+			c.Websites[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Supplier) equal(y Supplier) bool {
+	if !x.SupplierRole.equal(y.SupplierRole) {
+		return false
+	}
+	if len(x.SupplierIdentifiers) != len(y.SupplierIdentifiers) {
+		return false
+	}
+	for i := range x.SupplierIdentifiers {
+		if !x.SupplierIdentifiers[i].equal(y.SupplierIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SupplierName == nil) != (y.SupplierName == nil) || (x.SupplierName != nil && !x.SupplierName.equal(*y.SupplierName)) {
+		return false
+	}
+	if len(x.TelephoneNumbers) != len(y.TelephoneNumbers) {
+		return false
+	}
+	for i := range x.TelephoneNumbers {
+		if !x.TelephoneNumbers[i].equal(y.TelephoneNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.FaxNumbers) != len(y.FaxNumbers) {
+		return false
+	}
+	for i := range x.FaxNumbers {
+		if !x.FaxNumbers[i].equal(y.FaxNumbers[i]) {
+			return false
+		}
+	}
+	if len(x.EmailAddresss) != len(y.EmailAddresss) {
+		return false
+	}
+	for i := range x.EmailAddresss {
+		if !x.EmailAddresss[i].equal(y.EmailAddresss[i]) {
+			return false
+		}
+	}
+	if len(x.Websites) != len(y.Websites) {
+		return false
+	}
+	for i := range x.Websites {
+		if !x.Websites[i].equal(y.Websites[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplierIdentifier) clone() SupplierIdentifier {
+	c := x
+	c.SupplierIDType = x.SupplierIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplierIdentifier) equal(y SupplierIdentifier) bool {
+	if !x.SupplierIDType.equal(y.SupplierIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplierOwnCoding) clone() SupplierOwnCoding {
+	c := x
+	c.SupplierCodeType = x.SupplierCodeType.clone()
+	if x.SupplierCodeTypeName != nil {
+		v := x.SupplierCodeTypeName.clone()
+		c.SupplierCodeTypeName = &v
+	}
+	c.SupplierCodeValue = x.SupplierCodeValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplierOwnCoding) equal(y SupplierOwnCoding) bool {
+	if !x.SupplierCodeType.equal(y.SupplierCodeType) {
+		return false
+	}
+	if (x.SupplierCodeTypeName == nil) != (y.SupplierCodeTypeName == nil) || (x.SupplierCodeTypeName != nil && !x.SupplierCodeTypeName.equal(*y.SupplierCodeTypeName)) {
+		return false
+	}
+	if !x.SupplierCodeValue.equal(y.SupplierCodeValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplyContact) clone() SupplyContact {
+	c := x
+	c.SupplyContactRole = x.SupplyContactRole.clone()
+	if x.SupplyContactIdentifiers != nil {
+		c.SupplyContactIdentifiers = make([]SupplyContactIdentifier, len(x.SupplyContactIdentifiers))
+		for i, v := range x.SupplyContactIdentifiers {
+			c.SupplyContactIdentifiers[i] = v.clone()
+		}
+	}
+	if x.SupplyContactName != nil {
+		v := x.SupplyContactName.clone()
+		c.SupplyContactName = &v
+	}
+	if x.ContactName != nil {
+		v := x.ContactName.clone()
+		c.ContactName = &v
+	}
+	if x.EmailAddress != nil {
+		v := x.EmailAddress.clone()
+		c.EmailAddress = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplyContact) equal(y SupplyContact) bool {
+	if !x.SupplyContactRole.equal(y.SupplyContactRole) {
+		return false
+	}
+	if len(x.SupplyContactIdentifiers) != len(y.SupplyContactIdentifiers) {
+		return false
+	}
+	for i := range x.SupplyContactIdentifiers {
+		if !x.SupplyContactIdentifiers[i].equal(y.SupplyContactIdentifiers[i]) {
+			return false
+		}
+	}
+	if (x.SupplyContactName == nil) != (y.SupplyContactName == nil) || (x.SupplyContactName != nil && !x.SupplyContactName.equal(*y.SupplyContactName)) {
+		return false
+	}
+	if (x.ContactName == nil) != (y.ContactName == nil) || (x.ContactName != nil && !x.ContactName.equal(*y.ContactName)) {
+		return false
+	}
+	if (x.EmailAddress == nil) != (y.EmailAddress == nil) || (x.EmailAddress != nil && !x.EmailAddress.equal(*y.EmailAddress)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplyContactIdentifier) clone() SupplyContactIdentifier {
+	c := x
+	c.SupplyContactIDType = x.SupplyContactIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplyContactIdentifier) equal(y SupplyContactIdentifier) bool {
+	if !x.SupplyContactIDType.equal(y.SupplyContactIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplyDate) clone() SupplyDate {
+	c := x
+	c.SupplyDateRole = x.SupplyDateRole.clone()
+	if x.DateFormat != nil {
+		v := x.DateFormat.clone()
+		c.DateFormat = &v
+	}
+	c.Date = x.Date.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplyDate) equal(y SupplyDate) bool {
+	if !x.SupplyDateRole.equal(y.SupplyDateRole) {
+		return false
+	}
+	if (x.DateFormat == nil) != (y.DateFormat == nil) || (x.DateFormat != nil && !x.DateFormat.equal(*y.DateFormat)) {
+		return false
+	}
+	if !x.Date.equal(y.Date) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupplyDetail) clone() SupplyDetail {
+	c := x
+	c.Supplier = x.Supplier.clone()
+	if x.SupplyContacts != nil {
+		c.SupplyContacts = make([]SupplyContact, len(x.SupplyContacts))
+		for i, v := range x.SupplyContacts {
+			c.SupplyContacts[i] = v.clone()
+		}
+	}
+	if x.SupplierOwnCodings != nil {
+		c.SupplierOwnCodings = make([]SupplierOwnCoding, len(x.SupplierOwnCodings))
+		for i, v := range x.SupplierOwnCodings {
+			c.SupplierOwnCodings[i] = v.clone()
+		}
+	}
+	if x.ReturnsConditionss != nil {
+		c.ReturnsConditionss = make([]ReturnsConditions, len(x.ReturnsConditionss))
+		for i, v := range x.ReturnsConditionss {
+			c.ReturnsConditionss[i] = v.clone()
+		}
+	}
+	c.ProductAvailability = x.ProductAvailability.clone()
+	if x.SupplyDates != nil {
+		c.SupplyDates = make([]SupplyDate, len(x.SupplyDates))
+		for i, v := range x.SupplyDates {
+			c.SupplyDates[i] = v.clone()
+		}
+	}
+	if x.OrderTime != nil {
+		v := x.OrderTime.clone()
+		c.OrderTime = &v
+	}
+	if x.NewSupplier != nil {
+		v := x.NewSupplier.clone()
+		c.NewSupplier = &v
+	}
+	if x.Stocks != nil {
+		c.Stocks = make([]Stock, len(x.Stocks))
+		for i, v := range x.Stocks {
+			c.Stocks[i] = v.clone()
+		}
+	}
+	if x.PackQuantity != nil {
+		v := x.PackQuantity.clone()
+		c.PackQuantity = &v
+	}
+	if x.PalletQuantity != nil {
+		v := x.PalletQuantity.clone()
+		c.PalletQuantity = &v
+	}
+	if x.OrderQuantityMinimums != nil {
+		c.OrderQuantityMinimums = make([]PlainText, len(x.OrderQuantityMinimums))
+		for i, v := range x.OrderQuantityMinimums {
+			c.OrderQuantityMinimums[i] = v.clone()
+		}
+	}
+	if x.OrderQuantityMultiple != nil {
+		v := x.OrderQuantityMultiple.clone()
+		c.OrderQuantityMultiple = &v
+	}
+	if x.UnpricedItemType != nil {
+		v := x.UnpricedItemType.clone()
+		c.UnpricedItemType = &v
+	}
+	if x.Prices != nil {
+		c.Prices = make([]Price, len(x.Prices))
+		for i, v := range x.Prices {
+			c.Prices[i] = v.clone()
+		}
+	}
+	if x.Reissue != nil {
+		v := x.Reissue.clone()
+		c.Reissue = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupplyDetail) equal(y SupplyDetail) bool {
+	if !x.Supplier.equal(y.Supplier) {
+		return false
+	}
+	if len(x.SupplyContacts) != len(y.SupplyContacts) {
+		return false
+	}
+	for i := range x.SupplyContacts {
+		if !x.SupplyContacts[i].equal(y.SupplyContacts[i]) {
+			return false
+		}
+	}
+	if len(x.SupplierOwnCodings) != len(y.SupplierOwnCodings) {
+		return false
+	}
+	for i := range x.SupplierOwnCodings {
+		if !x.SupplierOwnCodings[i].equal(y.SupplierOwnCodings[i]) {
+			return false
+		}
+	}
+	if len(x.ReturnsConditionss) != len(y.ReturnsConditionss) {
+		return false
+	}
+	for i := range x.ReturnsConditionss {
+		if !x.ReturnsConditionss[i].equal(y.ReturnsConditionss[i]) {
+			return false
+		}
+	}
+	if !x.ProductAvailability.equal(y.ProductAvailability) {
+		return false
+	}
+	if len(x.SupplyDates) != len(y.SupplyDates) {
+		return false
+	}
+	for i := range x.SupplyDates {
+		if !x.SupplyDates[i].equal(y.SupplyDates[i]) {
+			return false
+		}
+	}
+	if (x.OrderTime == nil) != (y.OrderTime == nil) || (x.OrderTime != nil && !x.OrderTime.equal(*y.OrderTime)) {
+		return false
+	}
+	if (x.NewSupplier == nil) != (y.NewSupplier == nil) || (x.NewSupplier != nil && !x.NewSupplier.equal(*y.NewSupplier)) {
+		return false
+	}
+	if len(x.Stocks) != len(y.Stocks) {
+		return false
+	}
+	for i := range x.Stocks {
+		if !x.Stocks[i].equal(y.Stocks[i]) {
+			return false
+		}
+	}
+	if (x.PackQuantity == nil) != (y.PackQuantity == nil) || (x.PackQuantity != nil && !x.PackQuantity.equal(*y.PackQuantity)) {
+		return false
+	}
+	if (x.PalletQuantity == nil) != (y.PalletQuantity == nil) || (x.PalletQuantity != nil && !x.PalletQuantity.equal(*y.PalletQuantity)) {
+		return false
+	}
+	if len(x.OrderQuantityMinimums) != len(y.OrderQuantityMinimums) {
+		return false
+	}
+	for i := range x.OrderQuantityMinimums {
+		if !x.OrderQuantityMinimums[i].equal(y.OrderQuantityMinimums[i]) {
+			return false
+		}
+	}
+	if (x.OrderQuantityMultiple == nil) != (y.OrderQuantityMultiple == nil) || (x.OrderQuantityMultiple != nil && !x.OrderQuantityMultiple.equal(*y.OrderQuantityMultiple)) {
+		return false
+	}
+	if (x.UnpricedItemType == nil) != (y.UnpricedItemType == nil) || (x.UnpricedItemType != nil && !x.UnpricedItemType.equal(*y.UnpricedItemType)) {
+		return false
+	}
+	if len(x.Prices) != len(y.Prices) {
+		return false
+	}
+	for i := range x.Prices {
+		if !x.Prices[i].equal(y.Prices[i]) {
+			return false
+		}
+	}
+	if (x.Reissue == nil) != (y.Reissue == nil) || (x.Reissue != nil && !x.Reissue.equal(*y.Reissue)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x SupportingResource) clone() SupportingResource {
+	c := x
+	c.ResourceContentType = x.ResourceContentType.clone()
+	if x.ContentAudiences != nil {
+		c.ContentAudiences = make([]ContentAudience, len(x.ContentAudiences))
+		for i, v := range x.ContentAudiences {
+			c.ContentAudiences[i] = v.clone()
+		}
+	}
+	if x.Territory != nil {
+		v := x.Territory.clone()
+		c.Territory = &v
+	}
+	c.ResourceMode = x.ResourceMode.clone()
+	if x.ResourceFeatures != nil {
+		c.ResourceFeatures = make([]ResourceFeature, len(x.ResourceFeatures))
+		for i, v := range x.ResourceFeatures {
+			c.ResourceFeatures[i] = v.clone()
+		}
+	}
+	if x.ResourceVersions != nil {
+		c.ResourceVersions = make([]ResourceVersion, len(x.ResourceVersions))
+		for i, v := range x.ResourceVersions {
+			c.ResourceVersions[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x SupportingResource) equal(y SupportingResource) bool {
+	if !x.ResourceContentType.equal(y.ResourceContentType) {
+		return false
+	}
+	if len(x.ContentAudiences) != len(y.ContentAudiences) {
+		return false
+	}
+	for i := range x.ContentAudiences {
+		if !x.ContentAudiences[i].equal(y.ContentAudiences[i]) {
+			return false
+		}
+	}
+	if (x.Territory == nil) != (y.Territory == nil) || (x.Territory != nil && !x.Territory.equal(*y.Territory)) {
+		return false
+	}
+	if !x.ResourceMode.equal(y.ResourceMode) {
+		return false
+	}
+	if len(x.ResourceFeatures) != len(y.ResourceFeatures) {
+		return false
+	}
+	for i := range x.ResourceFeatures {
+		if !x.ResourceFeatures[i].equal(y.ResourceFeatures[i]) {
+			return false
+		}
+	}
+	if len(x.ResourceVersions) != len(y.ResourceVersions) {
+		return false
+	}
+	for i := range x.ResourceVersions {
+		if !x.ResourceVersions[i].equal(y.ResourceVersions[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Tax) clone() Tax {
+	c := x
+	if x.ProductIdentifiers != nil {
+		c.ProductIdentifiers = make([]ProductIdentifier, len(x.ProductIdentifiers))
+		for i, v := range x.ProductIdentifiers {
+			c.ProductIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PricePartDescriptions != nil {
+		c.PricePartDescriptions = make([]PlainText, len(x.PricePartDescriptions))
+		for i, v := range x.PricePartDescriptions {
+			c.PricePartDescriptions[i] = v.clone()
+		}
+	}
+	if x.TaxType != nil {
+		v := x.TaxType.clone()
+		c.TaxType = &v
+	}
+	if x.TaxRateCode != nil {
+		v := x.TaxRateCode.clone()
+		c.TaxRateCode = &v
+	}
+	if x.TaxRatePercent != nil {
+		v := x.TaxRatePercent.clone()
+		c.TaxRatePercent = &v
+	}
+	if x.TaxableAmount != nil {
+		v := x.TaxableAmount.clone()
+		c.TaxableAmount = &v
+	}
+	if x.TaxAmount != nil {
+		v := x.TaxAmount.clone()
+		c.TaxAmount = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Tax) equal(y Tax) bool {
+	if len(x.ProductIdentifiers) != len(y.ProductIdentifiers) {
+		return false
+	}
+	for i := range x.ProductIdentifiers {
+		if !x.ProductIdentifiers[i].equal(y.ProductIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.PricePartDescriptions) != len(y.PricePartDescriptions) {
+		return false
+	}
+	for i := range x.PricePartDescriptions {
+		if !x.PricePartDescriptions[i].equal(y.PricePartDescriptions[i]) {
+			return false
+		}
+	}
+	if (x.TaxType == nil) != (y.TaxType == nil) || (x.TaxType != nil && !x.TaxType.equal(*y.TaxType)) {
+		return false
+	}
+	if (x.TaxRateCode == nil) != (y.TaxRateCode == nil) || (x.TaxRateCode != nil && !x.TaxRateCode.equal(*y.TaxRateCode)) {
+		return false
+	}
+	if (x.TaxRatePercent == nil) != (y.TaxRatePercent == nil) || (x.TaxRatePercent != nil && !x.TaxRatePercent.equal(*y.TaxRatePercent)) {
+		return false
+	}
+	if (x.TaxableAmount == nil) != (y.TaxableAmount == nil) || (x.TaxableAmount != nil && !x.TaxableAmount.equal(*y.TaxableAmount)) {
+		return false
+	}
+	if (x.TaxAmount == nil) != (y.TaxAmount == nil) || (x.TaxAmount != nil && !x.TaxAmount.equal(*y.TaxAmount)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Territory) clone() Territory {
+	c := x
+	if x.CountriesIncluded != nil {
+		v := x.CountriesIncluded.clone()
+		c.CountriesIncluded = &v
+	}
+	if x.RegionsIncluded != nil {
+		v := x.RegionsIncluded.clone()
+		c.RegionsIncluded = &v
+	}
+	if x.CountriesExcluded != nil {
+		v := x.CountriesExcluded.clone()
+		c.CountriesExcluded = &v
+	}
+	if x.RegionsExcluded != nil {
+		v := x.RegionsExcluded.clone()
+		c.RegionsExcluded = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Territory) equal(y Territory) bool {
+	if (x.CountriesIncluded == nil) != (y.CountriesIncluded == nil) || (x.CountriesIncluded != nil && !x.CountriesIncluded.equal(*y.CountriesIncluded)) {
+		return false
+	}
+	if (x.RegionsIncluded == nil) != (y.RegionsIncluded == nil) || (x.RegionsIncluded != nil && !x.RegionsIncluded.equal(*y.RegionsIncluded)) {
+		return false
+	}
+	if (x.CountriesExcluded == nil) != (y.CountriesExcluded == nil) || (x.CountriesExcluded != nil && !x.CountriesExcluded.equal(*y.CountriesExcluded)) {
+		return false
+	}
+	if (x.RegionsExcluded == nil) != (y.RegionsExcluded == nil) || (x.RegionsExcluded != nil && !x.RegionsExcluded.equal(*y.RegionsExcluded)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TextContent) clone() TextContent {
+	c := x
+	c.TextType = x.TextType.clone()
+	if x.ContentAudiences != nil {
+		c.ContentAudiences = make([]ContentAudience, len(x.ContentAudiences))
+		for i, v := range x.ContentAudiences {
+			c.ContentAudiences[i] = v.clone()
+		}
+	}
+	if x.Territory != nil {
+		v := x.Territory.clone()
+		c.Territory = &v
+	}
+	if x.Texts != nil {
+		c.Texts = make([]Flow, len(x.Texts))
+		for i, v := range x.Texts {
+			c.Texts[i] = v.clone()
+		}
+	}
+	if x.ReviewRating != nil {
+		v := x.ReviewRating.clone()
+		c.ReviewRating = &v
+	}
+	if x.TextAuthors != nil {
+		c.TextAuthors = make([]PlainText, len(x.TextAuthors))
+		for i, v := range x.TextAuthors {
+			c.TextAuthors[i] = v.clone()
+		}
+	}
+	if x.TextSourceCorporate != nil {
+		v := x.TextSourceCorporate.clone()
+		c.TextSourceCorporate = &v
+	}
+	if x.TextSourceDescriptions != nil {
+		c.TextSourceDescriptions = make([]Flow, len(x.TextSourceDescriptions))
+		for i, v := range x.TextSourceDescriptions {
+			c.TextSourceDescriptions[i] = v.clone()
+		}
+	}
+	if x.SourceTitles != nil {
+		c.SourceTitles = make([]PlainText, len(x.SourceTitles))
+		for i, v := range x.SourceTitles {
+			c.SourceTitles[i] = v.clone()
+		}
+	}
+	if x.ContentDates != nil {
+		c.ContentDates = make([]ContentDate, len(x.ContentDates))
+		for i, v := range x.ContentDates {
+			c.ContentDates[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TextContent) equal(y TextContent) bool {
+	if !x.TextType.equal(y.TextType) {
+		return false
+	}
+	if len(x.ContentAudiences) != len(y.ContentAudiences) {
+		return false
+	}
+	for i := range x.ContentAudiences {
+		if !x.ContentAudiences[i].equal(y.ContentAudiences[i]) {
+			return false
+		}
+	}
+	if (x.Territory == nil) != (y.Territory == nil) || (x.Territory != nil && !x.Territory.equal(*y.Territory)) {
+		return false
+	}
+	if len(x.Texts) != len(y.Texts) {
+		return false
+	}
+	for i := range x.Texts {
+		if !x.Texts[i].equal(y.Texts[i]) {
+			return false
+		}
+	}
+	if (x.ReviewRating == nil) != (y.ReviewRating == nil) || (x.ReviewRating != nil && !x.ReviewRating.equal(*y.ReviewRating)) {
+		return false
+	}
+	if len(x.TextAuthors) != len(y.TextAuthors) {
+		return false
+	}
+	for i := range x.TextAuthors {
+		if !x.TextAuthors[i].equal(y.TextAuthors[i]) {
+			return false
+		}
+	}
+	if (x.TextSourceCorporate == nil) != (y.TextSourceCorporate == nil) || (x.TextSourceCorporate != nil && !x.TextSourceCorporate.equal(*y.TextSourceCorporate)) {
+		return false
+	}
+	if len(x.TextSourceDescriptions) != len(y.TextSourceDescriptions) {
+		return false
+	}
+	for i := range x.TextSourceDescriptions {
+		if !x.TextSourceDescriptions[i].equal(y.TextSourceDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.SourceTitles) != len(y.SourceTitles) {
+		return false
+	}
+	for i := range x.SourceTitles {
+		if !x.SourceTitles[i].equal(y.SourceTitles[i]) {
+			return false
+		}
+	}
+	if len(x.ContentDates) != len(y.ContentDates) {
+		return false
+	}
+	for i := range x.ContentDates {
+		if !x.ContentDates[i].equal(y.ContentDates[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TextItem) clone() TextItem {
+	c := x
+	c.TextItemType = x.TextItemType.clone()
+	if x.TextItemIdentifiers != nil {
+		c.TextItemIdentifiers = make([]TextItemIdentifier, len(x.TextItemIdentifiers))
+		for i, v := range x.TextItemIdentifiers {
+			c.TextItemIdentifiers[i] = v.clone()
+		}
+	}
+	if x.PageRuns != nil {
+		c.PageRuns = make([]PageRun, len(x.PageRuns))
+		for i, v := range x.PageRuns {
+			c.PageRuns[i] = v.clone()
+		}
+	}
+	if x.NumberOfPages != nil {
+		v := x.NumberOfPages.clone()
+		c.NumberOfPages = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TextItem) equal(y TextItem) bool {
+	if !x.TextItemType.equal(y.TextItemType) {
+		return false
+	}
+	if len(x.TextItemIdentifiers) != len(y.TextItemIdentifiers) {
+		return false
+	}
+	for i := range x.TextItemIdentifiers {
+		if !x.TextItemIdentifiers[i].equal(y.TextItemIdentifiers[i]) {
+			return false
+		}
+	}
+	if len(x.PageRuns) != len(y.PageRuns) {
+		return false
+	}
+	for i := range x.PageRuns {
+		if !x.PageRuns[i].equal(y.PageRuns[i]) {
+			return false
+		}
+	}
+	if (x.NumberOfPages == nil) != (y.NumberOfPages == nil) || (x.NumberOfPages != nil && !x.NumberOfPages.equal(*y.NumberOfPages)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TextItemIdentifier) clone() TextItemIdentifier {
+	c := x
+	c.TextItemIDType = x.TextItemIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TextItemIdentifier) equal(y TextItemIdentifier) bool {
+	if !x.TextItemIDType.equal(y.TextItemIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TimeRun) clone() TimeRun {
+	c := x
+	c.StartTime = x.StartTime.clone()
+	if x.EndTime != nil {
+		v := x.EndTime.clone()
+		c.EndTime = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TimeRun) equal(y TimeRun) bool {
+	if !x.StartTime.equal(y.StartTime) {
+		return false
+	}
+	if (x.EndTime == nil) != (y.EndTime == nil) || (x.EndTime != nil && !x.EndTime.equal(*y.EndTime)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TitleDetail) clone() TitleDetail {
+	c := x
+	c.TitleType = x.TitleType.clone()
+	if x.TitleElements != nil {
+		c.TitleElements = make([]TitleElement, len(x.TitleElements))
+		for i, v := range x.TitleElements {
+			c.TitleElements[i] = v.clone()
+		}
+	}
+	if x.TitleStatement != nil {
+		v := x.TitleStatement.clone()
+		c.TitleStatement = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TitleDetail) equal(y TitleDetail) bool {
+	if !x.TitleType.equal(y.TitleType) {
+		return false
+	}
+	if len(x.TitleElements) != len(y.TitleElements) {
+		return false
+	}
+	for i := range x.TitleElements {
+		if !x.TitleElements[i].equal(y.TitleElements[i]) {
+			return false
+		}
+	}
+	if (x.TitleStatement == nil) != (y.TitleStatement == nil) || (x.TitleStatement != nil && !x.TitleStatement.equal(*y.TitleStatement)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x TitleElement) clone() TitleElement {
+	c := x
+	if x.SequenceNumber != nil {
+		v := x.SequenceNumber.clone()
+		c.SequenceNumber = &v
+	}
+	c.TitleElementLevel = x.TitleElementLevel.clone()
+	if x.PartNumber != nil {
+		v := x.PartNumber.clone()
+		c.PartNumber = &v
+	}
+	if x.YearOfAnnual != nil {
+		v := x.YearOfAnnual.clone()
+		c.YearOfAnnual = &v
+	}
+	if x.TitleText != nil {
+		v := x.TitleText.clone()
+		c.TitleText = &v
+	}
+	if x.TitlePrefix != nil {
+		v := x.TitlePrefix.clone()
+		c.TitlePrefix = &v
+	}
+	if x.TitleWithoutPrefix != nil {
+		v := x.TitleWithoutPrefix.clone()
+		c.TitleWithoutPrefix = &v
+	}
+	if x.Subtitle != nil {
+		v := x.Subtitle.clone()
+		c.Subtitle = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x TitleElement) equal(y TitleElement) bool {
+	if (x.SequenceNumber == nil) != (y.SequenceNumber == nil) || (x.SequenceNumber != nil && !x.SequenceNumber.equal(*y.SequenceNumber)) {
+		return false
+	}
+	if !x.TitleElementLevel.equal(y.TitleElementLevel) {
+		return false
+	}
+	if (x.PartNumber == nil) != (y.PartNumber == nil) || (x.PartNumber != nil && !x.PartNumber.equal(*y.PartNumber)) {
+		return false
+	}
+	if (x.YearOfAnnual == nil) != (y.YearOfAnnual == nil) || (x.YearOfAnnual != nil && !x.YearOfAnnual.equal(*y.YearOfAnnual)) {
+		return false
+	}
+	if (x.TitleText == nil) != (y.TitleText == nil) || (x.TitleText != nil && !x.TitleText.equal(*y.TitleText)) {
+		return false
+	}
+	if (x.TitlePrefix == nil) != (y.TitlePrefix == nil) || (x.TitlePrefix != nil && !x.TitlePrefix.equal(*y.TitlePrefix)) {
+		return false
+	}
+	if x.NoPrefix != y.NoPrefix {
+		return false
+	}
+	if (x.TitleWithoutPrefix == nil) != (y.TitleWithoutPrefix == nil) || (x.TitleWithoutPrefix != nil && !x.TitleWithoutPrefix.equal(*y.TitleWithoutPrefix)) {
+		return false
+	}
+	if (x.Subtitle == nil) != (y.Subtitle == nil) || (x.Subtitle != nil && !x.Subtitle.equal(*y.Subtitle)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Velocity) clone() Velocity {
+	c := x
+	c.VelocityMetric = x.VelocityMetric.clone()
+	c.Rate = x.Rate.clone()
+	if x.Proximity != nil {
+		v := x.Proximity.clone()
+		c.Proximity = &v
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Velocity) equal(y Velocity) bool {
+	if !x.VelocityMetric.equal(y.VelocityMetric) {
+		return false
+	}
+	if !x.Rate.equal(y.Rate) {
+		return false
+	}
+	if (x.Proximity == nil) != (y.Proximity == nil) || (x.Proximity != nil && !x.Proximity.equal(*y.Proximity)) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x Website) clone() Website {
+	c := x
+	if x.WebsiteRole != nil {
+		v := x.WebsiteRole.clone()
+		c.WebsiteRole = &v
+	}
+	if x.WebsiteDescriptions != nil {
+		c.WebsiteDescriptions = make([]Flow, len(x.WebsiteDescriptions))
+		for i, v := range x.WebsiteDescriptions {
+			c.WebsiteDescriptions[i] = v.clone()
+		}
+	}
+	if x.WebsiteLinks != nil {
+		c.WebsiteLinks = make([]PlainText, len(x.WebsiteLinks))
+		for i, v := range x.WebsiteLinks {
+			c.WebsiteLinks[i] = v.clone()
+		}
+	}
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x Website) equal(y Website) bool {
+	if (x.WebsiteRole == nil) != (y.WebsiteRole == nil) || (x.WebsiteRole != nil && !x.WebsiteRole.equal(*y.WebsiteRole)) {
+		return false
+	}
+	if len(x.WebsiteDescriptions) != len(y.WebsiteDescriptions) {
+		return false
+	}
+	for i := range x.WebsiteDescriptions {
+		if !x.WebsiteDescriptions[i].equal(y.WebsiteDescriptions[i]) {
+			return false
+		}
+	}
+	if len(x.WebsiteLinks) != len(y.WebsiteLinks) {
+		return false
+	}
+	for i := range x.WebsiteLinks {
+		if !x.WebsiteLinks[i].equal(y.WebsiteLinks[i]) {
+			return false
+		}
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
+
+func (x WorkIdentifier) clone() WorkIdentifier {
+	c := x
+	c.WorkIDType = x.WorkIDType.clone()
+	if x.IDTypeName != nil {
+		v := x.IDTypeName.clone()
+		c.IDTypeName = &v
+	}
+	c.IDValue = x.IDValue.clone()
+	if x.Datestamp != nil {
+		v := x.Datestamp.clone()
+		c.Datestamp = &v
+	}
+	if x.Sourcetype != nil {
+		v := x.Sourcetype.clone()
+		c.Sourcetype = &v
+	}
+	if x.Sourcename != nil {
+		v := x.Sourcename.clone()
+		c.Sourcename = &v
+	}
+	c.Extensions = cloneExtensions(x.Extensions)
+	return c
+}
+
+func (x WorkIdentifier) equal(y WorkIdentifier) bool {
+	if !x.WorkIDType.equal(y.WorkIDType) {
+		return false
+	}
+	if (x.IDTypeName == nil) != (y.IDTypeName == nil) || (x.IDTypeName != nil && !x.IDTypeName.equal(*y.IDTypeName)) {
+		return false
+	}
+	if !x.IDValue.equal(y.IDValue) {
+		return false
+	}
+	if (x.Sourcetype == nil) != (y.Sourcetype == nil) || (x.Sourcetype != nil && !x.Sourcetype.equal(*y.Sourcetype)) {
+		return false
+	}
+	if (x.Sourcename == nil) != (y.Sourcename == nil) || (x.Sourcename != nil && !x.Sourcename.equal(*y.Sourcename)) {
+		return false
+	}
+	return equalExtensions(x.Extensions, y.Extensions)
+}
@@ -0,0 +1,286 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DatePrecision tells which part of date is significant.
+type DatePrecision uint8
+
+const (
+	// DatePrecisionText is precision of date of free text, which has no time.
+	DatePrecisionText DatePrecision = iota
+	// DatePrecisionYear is precision of date of year such as YYYY.
+	DatePrecisionYear
+	// DatePrecisionSeason is precision of date of season such as YYYYS.
+	DatePrecisionSeason
+	// DatePrecisionQuarter is precision of date of quarter such as YYYYQ.
+	DatePrecisionQuarter
+	// DatePrecisionMonth is precision of date of month such as YYYYMM.
+	DatePrecisionMonth
+	// DatePrecisionWeek is precision of date of week such as YYYYWW.
+	DatePrecisionWeek
+	// DatePrecisionDay is precision of date of day such as YYYYMMDD.
+	DatePrecisionDay
+	// DatePrecisionMinute is precision of date and time such as YYYYMMDDThhmm.
+	DatePrecisionMinute
+	// DatePrecisionSecond is precision of date and time such as YYYYMMDDThhmmss.
+	DatePrecisionSecond
+)
+
+// Date is a date of ONIX, whose Time is the beginning of the period which the date stands for.
+// For example, Time of 2021Q2 is April 1st of 2021.
+type Date struct {
+	Time      time.Time
+	Precision DatePrecision
+	// Text is the date as it is written.
+	Text string
+}
+
+func (d Date) String() string {
+	return d.Text
+}
+
+// dateFormats are patterns and precisions of dates of each code of List 55.
+// Ranges of dates such as YYYYMMDDYYYYMMDD are twice as long as the patterns of their beginning.
+var dateFormats = map[string]struct {
+	pattern   string
+	precision DatePrecision
+	ranged    bool
+}{
+	"00": {"YYYYMMDD", DatePrecisionDay, false},
+	"01": {"YYYYMM", DatePrecisionMonth, false},
+	"02": {"YYYYWW", DatePrecisionWeek, false},
+	"03": {"YYYYQ", DatePrecisionQuarter, false},
+	"04": {"YYYYS", DatePrecisionSeason, false},
+	"05": {"YYYY", DatePrecisionYear, false},
+	"06": {"YYYYMMDD", DatePrecisionDay, true},
+	"07": {"YYYYMM", DatePrecisionMonth, true},
+	"08": {"YYYYWW", DatePrecisionWeek, true},
+	"09": {"YYYYQ", DatePrecisionQuarter, true},
+	"10": {"YYYYS", DatePrecisionSeason, true},
+	"11": {"YYYY", DatePrecisionYear, true},
+	"13": {"YYYYMMDDThhmm", DatePrecisionMinute, false},
+	"14": {"YYYYMMDDThhmmss", DatePrecisionSecond, false},
+}
+
+// ParseDate parses date of format, which is a code of List 55 such as 00 for YYYYMMDD.
+// Format of empty string is inferred from length of date, which is YYYYMMDD, YYYYMM, YYYY or their time.
+// Date of free text of format 12 is not parsed, and the beginning of range of dates is taken.
+func ParseDate(date, format string) (Date, error) {
+	date = strings.TrimSpace(date)
+	d := Date{Text: date}
+	if format == "12" {
+		return d, nil
+	}
+	if format == "" {
+		switch len(date) {
+		case 4:
+			format = "05"
+		case 6:
+			format = "01"
+		case 8:
+			format = "00"
+		case 13:
+			format = "13"
+		case 15:
+			format = "14"
+		default:
+			return d, fmt.Errorf("unexpected date has been passed, got [%s]", date)
+		}
+	}
+	f, ok := dateFormats[format]
+	if !ok {
+		return d, fmt.Errorf("unsupported format of date has been passed, got [%s]", format)
+	}
+	pattern := f.pattern
+	if f.ranged {
+		pattern += f.pattern
+	}
+	if len(date) != len(pattern) {
+		return d, fmt.Errorf("unexpected date of format [%s] has been passed, got [%s]", format, date)
+	}
+	begin := date[:len(f.pattern)]
+
+	var err error
+	switch f.precision {
+	case DatePrecisionYear:
+		d.Time, err = time.Parse("2006", begin)
+	case DatePrecisionMonth:
+		d.Time, err = time.Parse("200601", begin)
+	case DatePrecisionDay:
+		d.Time, err = time.Parse("20060102", begin)
+	case DatePrecisionMinute:
+		d.Time, err = time.Parse("20060102T1504", begin)
+	case DatePrecisionSecond:
+		d.Time, err = time.Parse("20060102T150405", begin)
+	default:
+		d.Time, err = parsePeriod(begin, f.precision)
+	}
+	if err != nil {
+		return Date{Text: date}, fmt.Errorf("unexpected date of format [%s] has been passed, got [%s]", format, date)
+	}
+	d.Precision = f.precision
+	return d, nil
+}
+
+// parsePeriod parses week, quarter or season of year, where seasons begin in March, June, September and December.
+func parsePeriod(date string, precision DatePrecision) (time.Time, error) {
+	year, err := strconv.Atoi(date[:4])
+	if err != nil {
+		return time.Time{}, err
+	}
+	n, err := strconv.Atoi(date[4:])
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch precision {
+	case DatePrecisionWeek:
+		if n < 1 || n > 53 {
+			return time.Time{}, fmt.Errorf("week is out of range")
+		}
+		// ISO week 1 is the week which has January 4th.
+		monday := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		for monday.Weekday() != time.Monday {
+			monday = monday.AddDate(0, 0, -1)
+		}
+		return monday.AddDate(0, 0, (n-1)*7), nil
+	case DatePrecisionQuarter:
+		if n < 1 || n > 4 {
+			return time.Time{}, fmt.Errorf("quarter is out of range")
+		}
+		return time.Date(year, time.Month(n*3-2), 1, 0, 0, 0, 0, time.UTC), nil
+	case DatePrecisionSeason:
+		if n < 1 || n > 4 {
+			return time.Time{}, fmt.Errorf("season is out of range")
+		}
+		return time.Date(year, time.Month(n*3), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+	return time.Time{}, fmt.Errorf("unexpected precision")
+}
+
+// UnmarshalXML unmarshals date element whose format is given by its dateformat attribute.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var v string
+	if err := dec.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	format := ""
+	for _, a := range start.Attr {
+		if a.Name.Local == "dateformat" {
+			format = a.Value
+		}
+	}
+	parsed, err := ParseDate(v, format)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// dateOf parses date of element, whose format is a code of List 55 if DateFormat element is given.
+func dateOf(date *PlainText, format *DateFormat) (Date, bool) {
+	if date == nil {
+		return Date{}, false
+	}
+	f := ""
+	if format != nil {
+		f = format.Code()
+	}
+	d, err := ParseDate(date.Body, f)
+	if err != nil || d.Precision == DatePrecisionText {
+		return Date{}, false
+	}
+	return d, true
+}
+
+// Value returns date of publishing.
+func (d PublishingDate) Value() (Date, bool) {
+	return dateOf(&d.Date, d.DateFormat)
+}
+
+// Value returns date of publishing in market.
+func (d MarketDate) Value() (Date, bool) {
+	return dateOf(&d.Date, d.DateFormat)
+}
+
+// Value returns date of supply.
+func (d SupplyDate) Value() (Date, bool) {
+	return dateOf(&d.Date, d.DateFormat)
+}
+
+// Value returns date of contributor, which is typically a year.
+func (d ContributorDate) Value() (Date, bool) {
+	return dateOf(&d.Date, d.DateFormat)
+}
+
+const (
+	// ContributorDateRoleBirth is code of List 177 of date of birth of contributor.
+	ContributorDateRoleBirth = "50"
+	// ContributorDateRoleDeath is code of List 177 of date of death of contributor.
+	ContributorDateRoleDeath = "51"
+	// ContributorDateRoleFlourished is code of List 177 of date around which contributor has flourished.
+	ContributorDateRoleFlourished = "56"
+	// PublishingDateRolePublication is code of List 163 of publication date.
+	PublishingDateRolePublication = "01"
+	// PublishingDateRoleSalesEmbargo is code of List 163 of date from which product may be sold to public.
+	PublishingDateRoleSalesEmbargo = "02"
+	// SupplyDateRoleSalesEmbargo is code of List 166 of date from which supplier may sell product to public.
+	SupplyDateRoleSalesEmbargo = "02"
+)
+
+// PublicationDate returns the earliest publication date of product among markets, falling back on the one of PublishingDetail.
+func (p Product) PublicationDate() (Date, bool) {
+	if d, ok := p.marketDate(PublishingDateRolePublication); ok {
+		return d, true
+	}
+	return p.publishingDate(PublishingDateRolePublication)
+}
+
+// OnSaleDate returns the earliest date when product may be sold to public among markets.
+// It falls back on embargo date of PublishingDetail, and then of suppliers, unless markets tell it.
+func (p Product) OnSaleDate() (Date, bool) {
+	if d, ok := p.marketDate(PublishingDateRoleSalesEmbargo); ok {
+		return d, true
+	}
+	if d, ok := p.publishingDate(PublishingDateRoleSalesEmbargo); ok {
+		return d, true
+	}
+	var earliest Date
+	found := false
+	for _, s := range p.supplyDetails() {
+		for _, date := range s.SupplyDates {
+			if date.SupplyDateRole.Code() != SupplyDateRoleSalesEmbargo {
+				continue
+			}
+			if d, ok := date.Value(); ok && (!found || d.Time.Before(earliest.Time)) {
+				earliest, found = d, true
+			}
+		}
+	}
+	return earliest, found
+}
+
+func (p Product) marketDate(role string) (Date, bool) {
+	var earliest Date
+	found := false
+	for _, supply := range p.ProductSupplys {
+		if supply.MarketPublishingDetail == nil {
+			continue
+		}
+		for _, date := range supply.MarketPublishingDetail.MarketDates {
+			if date.MarketDateRole.Code() != role {
+				continue
+			}
+			if d, ok := date.Value(); ok && (!found || d.Time.Before(earliest.Time)) {
+				earliest, found = d, true
+			}
+		}
+	}
+	return earliest, found
+}
@@ -0,0 +1,238 @@
+package onix
+
+import "strings"
+
+// EventRoleCode is code of List 20 which tells relation of product to event, such as 02 for proceedings of conference.
+type EventRoleCode string
+
+const (
+	// EventRolePublicationLinked is publication linked to conference.
+	EventRolePublicationLinked EventRoleCode = "01"
+	// EventRoleCompleteProceedings is complete proceedings of conference.
+	EventRoleCompleteProceedings EventRoleCode = "02"
+	// EventRoleSelectedPapers is selected papers from conference.
+	EventRoleSelectedPapers EventRoleCode = "03"
+	// EventRoleSportingEvent is publication linked to sporting event.
+	EventRoleSportingEvent EventRoleCode = "11"
+	// EventRoleSportingEventProgramme is programme or guide for sporting event.
+	EventRoleSportingEventProgramme EventRoleCode = "12"
+	// EventRoleArtisticEvent is publication linked to artistic event.
+	EventRoleArtisticEvent EventRoleCode = "21"
+	// EventRoleArtisticEventProgramme is programme or guide for artistic event.
+	EventRoleArtisticEventProgramme EventRoleCode = "22"
+	// EventRoleExposition is publication linked to exposition.
+	EventRoleExposition EventRoleCode = "31"
+	// EventRoleExpositionProgramme is programme or guide for exposition.
+	EventRoleExpositionProgramme EventRoleCode = "32"
+)
+
+// IsProceedings reports whether code tells product consists of papers from conference.
+func (c EventRoleCode) IsProceedings() bool {
+	return c == EventRoleCompleteProceedings || c == EventRoleSelectedPapers
+}
+
+// Role returns code of List 20 of event.
+func (e Event) Role() EventRoleCode {
+	return EventRoleCode(e.EventRole.Code())
+}
+
+// Names returns EventName of event, which may be repeated in languages.
+func (e Event) Names() []string {
+	return textsOf(e.EventNames)
+}
+
+// Acronyms returns EventAcronym of event, which may be repeated in languages.
+func (e Event) Acronyms() []string {
+	return textsOf(e.EventAcronyms)
+}
+
+// Number returns EventNumber of event in a series of events.
+func (e Event) Number() (int64, bool) {
+	return quantityOf(e.EventNumber)
+}
+
+// Themes returns EventTheme of event, which may be repeated in languages.
+func (e Event) Themes() []string {
+	return textsOf(e.EventThemes)
+}
+
+// Date returns EventDate of event, which is typically a year and is absent unless it can be parsed.
+func (e Event) Date() (Date, bool) {
+	return dateOf(e.EventDate, nil)
+}
+
+// Places returns EventPlace of event, which may be repeated in languages.
+func (e Event) Places() []string {
+	return textsOf(e.EventPlaces)
+}
+
+// Sponsors returns names of sponsors of event, which are either PersonName or CorporateName of each sponsor.
+func (e Event) Sponsors() []string {
+	var sponsors []string
+	for _, s := range e.EventSponsors {
+		if name, ok := nameOf(s.CorporateName); ok {
+			sponsors = append(sponsors, name)
+		} else if name, ok := nameOf(s.PersonName); ok {
+			sponsors = append(sponsors, name)
+		}
+	}
+	return sponsors
+}
+
+// Event converts conference into Event composite which replaces Conference composite deprecated in 3.0 and removed in 3.1.
+// Conference without ConferenceRole is a publication linked to the event, since EventRole is mandatory.
+func (c Conference) Event() (Event, error) {
+	e := Event{EventNames: []PlainText{c.ConferenceName}, EventNumber: c.ConferenceNumber, EventDate: c.ConferenceDate, Websites: c.Websites}
+	role := string(EventRolePublicationLinked)
+	if c.ConferenceRole != nil {
+		role = c.ConferenceRole.Code()
+	}
+	if err := decodeCode(&e.EventRole, role); err != nil {
+		return Event{}, err
+	}
+	if c.ConferenceAcronym != nil {
+		e.EventAcronyms = []PlainText{*c.ConferenceAcronym}
+	}
+	if c.ConferenceTheme != nil {
+		e.EventThemes = append(e.EventThemes, PlainText{Body: string(*c.ConferenceTheme)})
+	}
+	if c.ConferencePlace != nil {
+		e.EventPlaces = []PlainText{*c.ConferencePlace}
+	}
+	for _, s := range c.ConferenceSponsors {
+		sponsor := EventSponsor{PersonName: s.PersonName, CorporateName: s.CorporateName}
+		for _, id := range s.ConferenceSponsorIdentifiers {
+			identifier := EventSponsorIdentifier{IDTypeName: id.IDTypeName, IDValue: id.IDValue}
+			if err := decodeCode(&identifier.EventSponsorIDType, id.ConferenceSponsorIDType.Code()); err != nil {
+				return Event{}, err
+			}
+			sponsor.EventSponsorIdentifiers = append(sponsor.EventSponsorIdentifiers, identifier)
+		}
+		e.EventSponsors = append(e.EventSponsors, sponsor)
+	}
+	return e, nil
+}
+
+// EventsOf returns Event composites of detail whose role is role.
+func (d DescriptiveDetail) EventsOf(role EventRoleCode) []Event {
+	var found []Event
+	for _, e := range d.Events {
+		if e.Role() == role {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+// ReligiousTextFeatureTypeChurchSeason is code of List 89 of feature of church season or activity, which is the only type defined.
+const ReligiousTextFeatureTypeChurchSeason = "01"
+
+// ReligiousFeature is a feature of religious text, whose Type and Code are codes of List 89 and List 90.
+type ReligiousFeature struct {
+	Type         string
+	Code         string
+	Descriptions []string
+}
+
+// Features returns ReligiousTextFeature composites of religious text.
+func (r ReligiousText) Features() []ReligiousFeature {
+	features := make([]ReligiousFeature, 0, len(r.ReligiousTextFeatures))
+	for _, f := range r.ReligiousTextFeatures {
+		var descriptions []string
+		for _, d := range f.ReligiousTextFeatureDescriptions {
+			if text := strings.TrimSpace(string(d)); text != "" {
+				descriptions = append(descriptions, text)
+			}
+		}
+		features = append(features, ReligiousFeature{
+			Type:         f.ReligiousTextFeatureType.Code(),
+			Code:         f.ReligiousTextFeatureCode.Code(),
+			Descriptions: descriptions,
+		})
+	}
+	return features
+}
+
+// Identifier returns code of List 88 of religious text other than Bible.
+func (r ReligiousText) Identifier() (string, bool) {
+	if r.ReligiousTextIdentifier == nil {
+		return "", false
+	}
+	return strings.TrimSpace(r.ReligiousTextIdentifier.Body), true
+}
+
+// IsBible reports whether religious text is, or contains, Bible.
+func (r ReligiousText) IsBible() bool {
+	return r.Bible != nil
+}
+
+// Contents returns codes of List 82 of the parts of Bible which product contains, such as OT for Old Testament.
+func (b Bible) Contents() []string {
+	codes := make([]string, 0, len(b.BibleContentss))
+	for _, c := range b.BibleContentss {
+		codes = append(codes, c.Code())
+	}
+	return codes
+}
+
+// Versions returns codes of List 83 of versions of Bible, such as KJV for King James.
+func (b Bible) Versions() []string {
+	codes := make([]string, 0, len(b.BibleVersions))
+	for _, v := range b.BibleVersions {
+		codes = append(codes, v.Code())
+	}
+	return codes
+}
+
+// Study returns code of List 84 of study Bible, which is absent unless Bible is a study Bible.
+func (b Bible) Study() (string, bool) {
+	if b.StudyBibleType == nil {
+		return "", false
+	}
+	return b.StudyBibleType.Code(), true
+}
+
+// Purposes returns codes of List 85 of purposes of Bible, such as PW for pew Bible.
+func (b Bible) Purposes() []string {
+	codes := make([]string, 0, len(b.BiblePurposes))
+	for _, p := range b.BiblePurposes {
+		codes = append(codes, p.Code())
+	}
+	return codes
+}
+
+// TextOrganization returns code of List 86 of how text of Bible is organized, such as CHR for chronological.
+func (b Bible) TextOrganization() (string, bool) {
+	if b.BibleTextOrganization == nil {
+		return "", false
+	}
+	return b.BibleTextOrganization.Code(), true
+}
+
+// ReferenceLocation returns code of List 87 of where references of Bible are located on page, such as PGE for foot of page.
+func (b Bible) ReferenceLocation() (string, bool) {
+	if b.BibleReferenceLocation == nil {
+		return "", false
+	}
+	return b.BibleReferenceLocation.Code(), true
+}
+
+// TextFeatures returns codes of List 97 of features of text of Bible, such as RL for red letter.
+func (b Bible) TextFeatures() []string {
+	codes := make([]string, 0, len(b.BibleTextFeatures))
+	for _, f := range b.BibleTextFeatures {
+		codes = append(codes, f.Code())
+	}
+	return codes
+}
+
+// textsOf returns trimmed texts which are not empty.
+func textsOf(texts []PlainText) []string {
+	var found []string
+	for _, t := range texts {
+		if text := strings.TrimSpace(t.Body); text != "" {
+			found = append(found, text)
+		}
+	}
+	return found
+}
@@ -0,0 +1,283 @@
+package onix
+
+import "strings"
+
+// DiscountCodeTypeCode is code of List 100 which tells scheme of discount code, such as 01 for BIC discount group code.
+type DiscountCodeTypeCode string
+
+const (
+	// DiscountCodeBIC is BIC discount group code of UK.
+	DiscountCodeBIC DiscountCodeTypeCode = "01"
+	// DiscountCodeProprietary is proprietary discount code of supplier, whose scheme is named by DiscountCodeTypeName.
+	DiscountCodeProprietary DiscountCodeTypeCode = "02"
+	// DiscountCodeBoeksoort is Boeksoort code of the Netherlands.
+	DiscountCodeBoeksoort DiscountCodeTypeCode = "03"
+	// DiscountCodeGermanTerms is terms code of Germany.
+	DiscountCodeGermanTerms DiscountCodeTypeCode = "04"
+	// DiscountCodeProprietaryCommission is proprietary commission code of supplier for agency terms.
+	DiscountCodeProprietaryCommission DiscountCodeTypeCode = "05"
+	// DiscountCodeBICCommission is BIC commission group code of UK for agency terms.
+	DiscountCodeBICCommission DiscountCodeTypeCode = "06"
+	// DiscountCodeISNI is discount group code of supplier identified by its ISNI.
+	DiscountCodeISNI DiscountCodeTypeCode = "07"
+)
+
+// Kind returns scheme of discount code.
+func (d DiscountCoded) Kind() DiscountCodeTypeCode {
+	return DiscountCodeTypeCode(d.DiscountCodeType.Code())
+}
+
+// Code returns discount code itself.
+func (d DiscountCoded) Code() string {
+	return strings.TrimSpace(d.DiscountCode.Body)
+}
+
+// DiscountTypeCode is code of List 170 which tells how discount is given on quantity, such as 01 for rising discount.
+type DiscountTypeCode string
+
+const (
+	// DiscountRising is discount which is given on all copies of order reaching the quantity.
+	DiscountRising DiscountTypeCode = "01"
+	// DiscountRisingCumulative is rising discount whose quantity is of orders through a period.
+	DiscountRisingCumulative DiscountTypeCode = "02"
+	// DiscountProgressive is discount which is given only on copies of order within the range of quantity.
+	DiscountProgressive DiscountTypeCode = "03"
+	// DiscountProgressiveCumulative is progressive discount whose quantity is of orders through a period.
+	DiscountProgressiveCumulative DiscountTypeCode = "04"
+)
+
+// DiscountTier is a discount which trade is given on orders of quantity between From and To inclusive.
+// From and To of zero are unbounded.
+type DiscountTier struct {
+	Kind    DiscountTypeCode
+	From    int64
+	To      int64
+	Percent *Amount
+	Amount  *Amount
+}
+
+// Includes reports whether discount is given on order of quantity n.
+func (t DiscountTier) Includes(n int64) bool {
+	return n >= t.From && (t.To == 0 || n <= t.To)
+}
+
+// Kind returns how discount is given on quantity, which is empty unless DiscountType is given.
+func (d Discount) Kind() DiscountTypeCode {
+	if d.DiscountType == nil {
+		return ""
+	}
+	return DiscountTypeCode(d.DiscountType.Code())
+}
+
+// Percent returns discount in percent.
+func (d Discount) Percent() (Amount, bool) {
+	return amountOfText(d.DiscountPercent)
+}
+
+// Amount returns discount in amount per copy.
+func (d Discount) Amount() (Amount, bool) {
+	return amountOfText(d.DiscountAmount)
+}
+
+// Tier returns discount as a tier of quantity, which is an error for malformed amount.
+func (d Discount) Tier() (DiscountTier, error) {
+	t := DiscountTier{Kind: d.Kind()}
+	t.From, _ = quantityOf(d.Quantity)
+	t.To, _ = quantityOf(d.ToQuantity)
+	for _, to := range []struct {
+		text   *PlainText
+		amount **Amount
+	}{
+		{d.DiscountPercent, &t.Percent},
+		{d.DiscountAmount, &t.Amount},
+	} {
+		if to.text == nil {
+			continue
+		}
+		a, err := ParseAmount(to.text.Body)
+		if err != nil {
+			return DiscountTier{}, err
+		}
+		*to.amount = &a
+	}
+	return t, nil
+}
+
+// DiscountCode returns discount code of price of kind.
+func (p Price) DiscountCode(kind DiscountCodeTypeCode) (string, bool) {
+	for _, d := range p.DiscountCodeds {
+		if d.Kind() == kind && d.Code() != "" {
+			return d.Code(), true
+		}
+	}
+	return "", false
+}
+
+// DiscountTiers returns discounts of price as tiers of quantity.
+func (p Price) DiscountTiers() ([]DiscountTier, error) {
+	var tiers []DiscountTier
+	for _, d := range p.Discounts {
+		t, err := d.Tier()
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, t)
+	}
+	return tiers, nil
+}
+
+// DiscountForQuantity returns discount of price which is given on order of quantity n,
+// which is the tier including n whose lower bound is the highest.
+// Discount of malformed amount is ignored.
+func (p Price) DiscountForQuantity(n int64) (DiscountTier, bool) {
+	var tiers []DiscountTier
+	for _, d := range p.Discounts {
+		if t, err := d.Tier(); err == nil {
+			tiers = append(tiers, t)
+		}
+	}
+	return discountFor(tiers, n)
+}
+
+// PriceConditionTypeCode is code of List 167 which tells condition of price, such as 10 for rental duration.
+type PriceConditionTypeCode string
+
+const (
+	// PriceConditionNone is price without conditions.
+	PriceConditionNone PriceConditionTypeCode = "00"
+	// PriceConditionIncludesUpdates is price which includes updates for a period.
+	PriceConditionIncludesUpdates PriceConditionTypeCode = "01"
+	// PriceConditionMustPurchaseUpdates is price which requires purchase of updates.
+	PriceConditionMustPurchaseUpdates PriceConditionTypeCode = "02"
+	// PriceConditionUpdatesAvailable is price whose updates are available separately.
+	PriceConditionUpdatesAvailable PriceConditionTypeCode = "03"
+	// PriceConditionLinkedSubsequentPurchase is price which is conditional on subsequent purchase of another product.
+	PriceConditionLinkedSubsequentPurchase PriceConditionTypeCode = "04"
+	// PriceConditionLinkedPriorPurchase is price which is conditional on prior purchase of another product.
+	PriceConditionLinkedPriorPurchase PriceConditionTypeCode = "05"
+	// PriceConditionLinked is price which is conditional on purchase of another product at the same time.
+	PriceConditionLinked PriceConditionTypeCode = "06"
+	// PriceConditionAutoRenewing is price which renews automatically at the end of the period.
+	PriceConditionAutoRenewing PriceConditionTypeCode = "07"
+	// PriceConditionRentalDuration is price of rental for a duration.
+	PriceConditionRentalDuration PriceConditionTypeCode = "10"
+	// PriceConditionRentalToPurchase is price which converts rental into purchase.
+	PriceConditionRentalToPurchase PriceConditionTypeCode = "11"
+	// PriceConditionRentalExtension is price which extends rental.
+	PriceConditionRentalExtension PriceConditionTypeCode = "12"
+)
+
+// PriceConditionQuantityTypeCode is code of List 168 which tells what quantity of price condition is, such as 01 for time period.
+type PriceConditionQuantityTypeCode string
+
+const (
+	// PriceConditionQuantityTimePeriod is period which price condition lasts.
+	PriceConditionQuantityTimePeriod PriceConditionQuantityTypeCode = "01"
+	// PriceConditionQuantityUpdates is number of updates included.
+	PriceConditionQuantityUpdates PriceConditionQuantityTypeCode = "02"
+	// PriceConditionQuantityLinkedProducts is number of linked products which must be purchased.
+	PriceConditionQuantityLinkedProducts PriceConditionQuantityTypeCode = "03"
+)
+
+// QuantityUnitCode is code of List 169 which tells unit of quantity of price condition, such as 07 for days.
+type QuantityUnitCode string
+
+const (
+	// QuantityUnits is number of units.
+	QuantityUnits QuantityUnitCode = "00"
+	// QuantityDays is number of days.
+	QuantityDays QuantityUnitCode = "07"
+	// QuantityWeeks is number of weeks.
+	QuantityWeeks QuantityUnitCode = "08"
+	// QuantityMonths is number of months.
+	QuantityMonths QuantityUnitCode = "09"
+	// QuantityYears is number of years.
+	QuantityYears QuantityUnitCode = "10"
+)
+
+// Kind returns condition of price.
+func (c PriceCondition) Kind() PriceConditionTypeCode {
+	return PriceConditionTypeCode(c.PriceConditionType.Code())
+}
+
+// Quantity returns quantity of kind of price condition and its unit.
+func (c PriceCondition) Quantity(kind PriceConditionQuantityTypeCode) (int64, QuantityUnitCode, bool) {
+	for _, q := range c.PriceConditionQuantitys {
+		if q.Kind() != kind {
+			continue
+		}
+		if value, ok := q.Value(); ok {
+			return value, q.Unit(), true
+		}
+	}
+	return 0, "", false
+}
+
+// Kind returns what quantity of price condition is.
+func (q PriceConditionQuantity) Kind() PriceConditionQuantityTypeCode {
+	return PriceConditionQuantityTypeCode(q.PriceConditionQuantityType.Code())
+}
+
+// Unit returns unit of quantity of price condition.
+func (q PriceConditionQuantity) Unit() QuantityUnitCode {
+	return QuantityUnitCode(q.QuantityUnit.Code())
+}
+
+// Value returns quantity of price condition itself.
+func (q PriceConditionQuantity) Value() (int64, bool) {
+	return quantityOf(&q.Quantity)
+}
+
+// Condition returns the first condition of price of kind.
+func (p Price) Condition(kind PriceConditionTypeCode) (PriceCondition, bool) {
+	for _, c := range p.PriceConditions {
+		if c.Kind() == kind {
+			return c, true
+		}
+	}
+	return PriceCondition{}, false
+}
+
+// RentalDuration returns duration of rental which price is of, and its unit.
+func (p Price) RentalDuration() (int64, QuantityUnitCode, bool) {
+	c, ok := p.Condition(PriceConditionRentalDuration)
+	if !ok {
+		return 0, "", false
+	}
+	return c.Quantity(PriceConditionQuantityTimePeriod)
+}
+
+// FreeQuantityFor returns quantity of free copies supplied with order of quantity n,
+// which are given for each batch of the largest batch bonus which n reaches.
+func (p Price) FreeQuantityFor(n int64) int64 {
+	return freeQuantityOf(p.BatchBonuss, n)
+}
+
+// discountFor returns the tier of tiers including n whose lower bound is the highest.
+func discountFor(tiers []DiscountTier, n int64) (DiscountTier, bool) {
+	var found *DiscountTier
+	for i, t := range tiers {
+		if t.Includes(n) && (found == nil || t.From > found.From) {
+			found = &tiers[i]
+		}
+	}
+	if found == nil {
+		return DiscountTier{}, false
+	}
+	return *found, true
+}
+
+func freeQuantityOf(bonuses []BatchBonus, n int64) int64 {
+	var batch, free int64
+	for _, b := range bonuses {
+		q, ok := quantityOf(&b.BatchQuantity)
+		f, fok := quantityOf(&b.FreeQuantity)
+		if ok && fok && q > 0 && q <= n && q > batch {
+			batch, free = q, f
+		}
+	}
+	if batch == 0 {
+		return 0
+	}
+	return n / batch * free
+}
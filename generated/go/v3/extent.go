@@ -0,0 +1,144 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExtentKind is code of List 23 which tells what extent measures, such as 00 for main content page count.
+type ExtentKind string
+
+const (
+	// ExtentMainContentPages is page count of main content.
+	ExtentMainContentPages ExtentKind = "00"
+	// ExtentWords is number of words.
+	ExtentWords ExtentKind = "02"
+	// ExtentFrontMatterPages is page count of front matter.
+	ExtentFrontMatterPages ExtentKind = "03"
+	// ExtentBackMatterPages is page count of back matter.
+	ExtentBackMatterPages ExtentKind = "04"
+	// ExtentTotalNumberedPages is total count of numbered pages.
+	ExtentTotalNumberedPages ExtentKind = "05"
+	// ExtentProductionPages is page count of production.
+	ExtentProductionPages ExtentKind = "06"
+	// ExtentAbsolutePages is absolute page count including every page.
+	ExtentAbsolutePages ExtentKind = "07"
+	// ExtentPrintCounterpartPages is page count of print counterpart of digital product.
+	ExtentPrintCounterpartPages ExtentKind = "08"
+	// ExtentDuration is total duration of time-based product.
+	ExtentDuration ExtentKind = "09"
+	// ExtentNotionalPages is notional page count of digital product.
+	ExtentNotionalPages ExtentKind = "10"
+	// ExtentContentPages is page count of content.
+	ExtentContentPages ExtentKind = "11"
+	// ExtentMainContentDuration is duration of main content.
+	ExtentMainContentDuration ExtentKind = "14"
+	// ExtentFilesize is size of file of digital product.
+	ExtentFilesize ExtentKind = "22"
+)
+
+// ExtentUnitCode is code of List 24 which tells unit of extent, such as 03 for pages or 15 for HHHMM.
+type ExtentUnitCode string
+
+const (
+	// ExtentUnitWords is words.
+	ExtentUnitWords ExtentUnitCode = "02"
+	// ExtentUnitPages is pages.
+	ExtentUnitPages ExtentUnitCode = "03"
+	// ExtentUnitHours is hours in integer and decimals.
+	ExtentUnitHours ExtentUnitCode = "04"
+	// ExtentUnitMinutes is minutes in integer and decimals.
+	ExtentUnitMinutes ExtentUnitCode = "05"
+	// ExtentUnitSeconds is seconds in integer.
+	ExtentUnitSeconds ExtentUnitCode = "06"
+	// ExtentUnitTracks is tracks.
+	ExtentUnitTracks ExtentUnitCode = "11"
+	// ExtentUnitHHH is hours of HHH.
+	ExtentUnitHHH ExtentUnitCode = "14"
+	// ExtentUnitHHHMM is hours and minutes of HHHMM.
+	ExtentUnitHHHMM ExtentUnitCode = "15"
+	// ExtentUnitHHHMMSS is hours, minutes and seconds of HHHMMSS.
+	ExtentUnitHHHMMSS ExtentUnitCode = "16"
+	// ExtentUnitBytes is bytes.
+	ExtentUnitBytes ExtentUnitCode = "17"
+	// ExtentUnitKbytes is kilobytes.
+	ExtentUnitKbytes ExtentUnitCode = "18"
+	// ExtentUnitMbytes is megabytes.
+	ExtentUnitMbytes ExtentUnitCode = "19"
+)
+
+// Kind returns what extent measures.
+func (e Extent) Kind() ExtentKind {
+	return ExtentKind(e.ExtentType.Code())
+}
+
+// Unit returns unit of extent.
+func (e Extent) Unit() ExtentUnitCode {
+	return ExtentUnitCode(e.ExtentUnit.Code())
+}
+
+// Pages returns page count of extent whose unit is pages.
+func (e Extent) Pages() (int64, bool) {
+	if e.Unit() != ExtentUnitPages {
+		return 0, false
+	}
+	return quantityOf(e.ExtentValue)
+}
+
+// Duration returns duration of extent whose unit is either of hours, minutes, seconds, HHH, HHHMM or HHHMMSS.
+// Values of HHHMM and HHHMMSS whose leading zeros are dropped, or which are separated by colons such as 12:30, are accepted as well.
+func (e Extent) Duration() (time.Duration, bool) {
+	if e.ExtentValue == nil {
+		return 0, false
+	}
+	return ParseDuration(e.ExtentValue.Body, e.Unit())
+}
+
+// durationUnits are lengths of units of decimal durations.
+var durationUnits = map[ExtentUnitCode]time.Duration{ExtentUnitHours: time.Hour, ExtentUnitMinutes: time.Minute, ExtentUnitSeconds: time.Second}
+
+// durationDigits are digits of minutes and seconds which follow hours of each unit.
+var durationDigits = map[ExtentUnitCode]string{ExtentUnitHHH: "", ExtentUnitHHHMM: "MM", ExtentUnitHHHMMSS: "MMSS"}
+
+// ParseDuration parses value of extent of unit into duration.
+func ParseDuration(value string, unit ExtentUnitCode) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	switch unit {
+	case ExtentUnitHours, ExtentUnitMinutes, ExtentUnitSeconds:
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil || amount < 0 {
+			return 0, false
+		}
+		return time.Duration(amount * float64(durationUnits[unit])), true
+	case ExtentUnitHHH, ExtentUnitHHHMM, ExtentUnitHHHMMSS:
+		parts := strings.Split(value, ":")
+		if len(parts) == 1 {
+			digits := len(durationDigits[unit])
+			for len(value) <= digits {
+				value = "0" + value
+			}
+			parts = []string{value[:len(value)-digits]}
+			for i := len(value) - digits; i < len(value); i += 2 {
+				parts = append(parts, value[i:i+2])
+			}
+		}
+		if len(parts) > 3 {
+			return 0, false
+		}
+		var d time.Duration
+		scales := []time.Duration{time.Hour, time.Minute, time.Second}
+		for i, part := range parts {
+			n, err := strconv.ParseUint(part, 10, 32)
+			if err != nil || (i > 0 && n >= 60) {
+				return 0, false
+			}
+			d += time.Duration(n) * scales[i]
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,252 @@
+package onix
+
+import "strings"
+
+// ProductFormCode is code of List 150 which tells primary form of product, such as BC for paperback.
+type ProductFormCode string
+
+const (
+	// ProductFormUndefined is undefined form.
+	ProductFormUndefined ProductFormCode = "00"
+	// ProductFormAudio is audio recording whose detail is unspecified.
+	ProductFormAudio ProductFormCode = "AA"
+	// ProductFormAudioCD is audio recording on CD.
+	ProductFormAudioCD ProductFormCode = "AC"
+	// ProductFormDownloadableAudio is audio recording which is downloaded.
+	ProductFormDownloadableAudio ProductFormCode = "AJ"
+	// ProductFormDownloadableAndOnlineAudio is audio recording which is downloaded or streamed.
+	ProductFormDownloadableAndOnlineAudio ProductFormCode = "AN"
+	// ProductFormOnlineAudio is audio recording which is streamed.
+	ProductFormOnlineAudio ProductFormCode = "AO"
+	// ProductFormBook is book whose binding is unspecified.
+	ProductFormBook ProductFormCode = "BA"
+	// ProductFormHardback is hardback book.
+	ProductFormHardback ProductFormCode = "BB"
+	// ProductFormPaperback is paperback or softback book.
+	ProductFormPaperback ProductFormCode = "BC"
+	// ProductFormDigitalOnCarrier is digital content on a physical carrier whose detail is unspecified.
+	ProductFormDigitalOnCarrier ProductFormCode = "DA"
+	// ProductFormDigital is digital content which is delivered electronically.
+	ProductFormDigital ProductFormCode = "EA"
+	// ProductFormDigitalDownloadAndOnline is digital content which is downloaded or accessed online.
+	ProductFormDigitalDownloadAndOnline ProductFormCode = "EB"
+	// ProductFormDigitalOnline is digital content which is accessed online.
+	ProductFormDigitalOnline ProductFormCode = "EC"
+	// ProductFormDigitalDownload is digital content which is downloaded.
+	ProductFormDigitalDownload ProductFormCode = "ED"
+	// ProductFormVideo is video recording whose format is unspecified.
+	ProductFormVideo ProductFormCode = "VA"
+	// ProductFormMultipleComponent is retail product of more than one component.
+	ProductFormMultipleComponent ProductFormCode = "SA"
+)
+
+// IsAudio reports whether c is form of audio recording.
+func (c ProductFormCode) IsAudio() bool {
+	return strings.HasPrefix(string(c), "A")
+}
+
+// IsDigital reports whether c is form of digital content, either on a carrier such as CD-ROM or delivered electronically.
+func (c ProductFormCode) IsDigital() bool {
+	switch c {
+	case ProductFormDownloadableAudio, ProductFormDownloadableAndOnlineAudio, ProductFormOnlineAudio:
+		return true
+	}
+	return strings.HasPrefix(string(c), "D") || strings.HasPrefix(string(c), "E")
+}
+
+// IsPrint reports whether c is form of printed matter, which is book, cartographic item or miscellaneous print such as calendar.
+func (c ProductFormCode) IsPrint() bool {
+	return strings.HasPrefix(string(c), "B") || strings.HasPrefix(string(c), "C") || strings.HasPrefix(string(c), "P")
+}
+
+// ProductFormDetailCode is code of List 175 which tells detail of form of product, such as E101 for EPUB.
+type ProductFormDetailCode string
+
+// IsAudio reports whether c is detail of audio such as its format or channels.
+func (c ProductFormDetailCode) IsAudio() bool {
+	return strings.HasPrefix(string(c), "A")
+}
+
+// IsEBookFormat reports whether c is file format of e-book such as EPUB or PDF.
+func (c ProductFormDetailCode) IsEBookFormat() bool {
+	return strings.HasPrefix(string(c), "E1")
+}
+
+// Form returns primary form of product.
+func (d DescriptiveDetail) Form() ProductFormCode {
+	return ProductFormCode(d.ProductForm.Code())
+}
+
+// FormDetails returns details of form of product.
+func (d DescriptiveDetail) FormDetails() []ProductFormDetailCode {
+	return formDetailsOf(d.ProductFormDetails)
+}
+
+// IsDigital reports whether product is digital content, such as e-book or downloadable audio file.
+func (d DescriptiveDetail) IsDigital() bool {
+	return d.Form().IsDigital()
+}
+
+// IsAudiobook reports whether product is audio recording,
+// or digital content whose details tell audio format such as MP3.
+func (d DescriptiveDetail) IsAudiobook() bool {
+	return isAudiobook(d.Form(), d.FormDetails())
+}
+
+// IsPrint reports whether product is printed matter such as paperback.
+func (d DescriptiveDetail) IsPrint() bool {
+	return d.Form().IsPrint()
+}
+
+// Form returns primary form of product part.
+func (p ProductPart) Form() ProductFormCode {
+	return ProductFormCode(p.ProductForm.Code())
+}
+
+// FormDetails returns details of form of product part.
+func (p ProductPart) FormDetails() []ProductFormDetailCode {
+	return formDetailsOf(p.ProductFormDetails)
+}
+
+// IsDigital reports whether product part is digital content, such as e-book or downloadable audio file.
+func (p ProductPart) IsDigital() bool {
+	return p.Form().IsDigital()
+}
+
+// IsAudiobook reports whether product part is audio recording,
+// or digital content whose details tell audio format such as MP3.
+func (p ProductPart) IsAudiobook() bool {
+	return isAudiobook(p.Form(), p.FormDetails())
+}
+
+// IsPrint reports whether product part is printed matter such as paperback.
+func (p ProductPart) IsPrint() bool {
+	return p.Form().IsPrint()
+}
+
+func formDetailsOf(codes []ProductFormDetail) []ProductFormDetailCode {
+	var details []ProductFormDetailCode
+	for _, d := range codes {
+		details = append(details, ProductFormDetailCode(d.Code()))
+	}
+	return details
+}
+
+func isAudiobook(form ProductFormCode, details []ProductFormDetailCode) bool {
+	if form.IsAudio() {
+		return true
+	}
+	if !form.IsDigital() {
+		return false
+	}
+	for _, d := range details {
+		if d.IsAudio() {
+			return true
+		}
+	}
+	return false
+}
+
+// ProductFormFeatureTypeAccessibility is code of List 79 of feature which tells accessibility of e-publication,
+// whose value is a code of List 196.
+const ProductFormFeatureTypeAccessibility = "09"
+
+// accessibilityLabels are human readable descriptions of codes of List 196 of e-publication accessibility details.
+var accessibilityLabels = map[string]string{
+	"00": "Accessibility summary",
+	"01": "LIA Compliance Scheme",
+	"02": "EPUB Accessibility Specification 1.0 A",
+	"03": "EPUB Accessibility Specification 1.0 AA",
+	"04": "EPUB Accessibility Specification 1.1",
+	"08": "Unknown accessibility",
+	"09": "Inaccessible or known limited accessibility",
+	"10": "No reading system accessibility options actively disabled",
+	"11": "Table of contents navigation",
+	"12": "Index navigation",
+	"13": "Reading order",
+	"14": "Short alternative descriptions",
+	"15": "Full alternative descriptions",
+	"16": "Visualised data also available as non-graphical data",
+	"17": "Accessible math content as MathML",
+	"18": "Accessible chem content as ChemML",
+	"19": "Print-equivalent page numbering",
+	"20": "Synchronised pre-recorded audio",
+	"21": "Text-to-speech hinting provided",
+	"22": "Language tagging provided",
+	"24": "Dyslexia readability",
+	"25": "Use of color is not sole means of conveying information",
+	"26": "Use of high contrast between text and background color",
+	"27": "Use of high contrast between foreground and background audio",
+	"28": "Full alternative audio descriptions",
+	"29": "Next / Previous structural navigation",
+	"30": "ARIA roles provided",
+	"75": "EAA exception 1 – Micro-enterprises",
+	"76": "EAA exception 2 – Disproportionate burden",
+	"77": "EAA exception 3 – Fundamental modification",
+	"80": "WCAG v2.0",
+	"81": "WCAG v2.1",
+	"82": "WCAG v2.2",
+	"84": "WCAG level A",
+	"85": "WCAG level AA",
+	"86": "WCAG level AAA",
+}
+
+// accessibilityConformance are codes of List 196 which claim conformance to specifications of accessibility.
+var accessibilityConformance = map[string]bool{
+	"01": true, "02": true, "03": true, "04": true,
+	"80": true, "81": true, "82": true, "84": true, "85": true, "86": true,
+}
+
+// AccessibilityFeature is a detail of accessibility of e-publication, which retailers display along with product
+// such as under the European Accessibility Act.
+type AccessibilityFeature struct {
+	// Code is a code of List 196.
+	Code string `json:"code"`
+	// Label is human readable description of Code, which is empty for codes which are not known.
+	Label string `json:"label,omitempty"`
+	// Description is ProductFormFeatureDescription, such as text of accessibility summary or detail of claim.
+	Description string `json:"description,omitempty"`
+}
+
+// IsConformance reports whether f claims conformance to a specification of accessibility, such as EPUB Accessibility 1.0 AA or WCAG v2.1.
+func (f AccessibilityFeature) IsConformance() bool {
+	return accessibilityConformance[f.Code]
+}
+
+func accessibilityFeaturesOf(features []ProductFormFeature) []AccessibilityFeature {
+	var details []AccessibilityFeature
+	for _, f := range features {
+		if detail, ok := f.Accessibility(); ok {
+			details = append(details, detail)
+		}
+	}
+	return details
+}
+
+// Accessibility returns detail of accessibility which f tells if it is of type 09,
+// where descriptions in more than one language are joined by new lines.
+func (f ProductFormFeature) Accessibility() (AccessibilityFeature, bool) {
+	if f.ProductFormFeatureType.Code() != ProductFormFeatureTypeAccessibility || f.ProductFormFeatureValue == nil {
+		return AccessibilityFeature{}, false
+	}
+	code := strings.TrimSpace(f.ProductFormFeatureValue.Body)
+	detail := AccessibilityFeature{Code: code, Label: accessibilityLabels[code]}
+	var descriptions []string
+	for _, d := range f.ProductFormFeatureDescriptions {
+		descriptions = append(descriptions, d.Body)
+	}
+	detail.Description = strings.Join(descriptions, "\n")
+	return detail, true
+}
+
+// AccessibilityFeatures returns details of accessibility of e-publication which ProductFormFeature composites of detail tell,
+// in order of the message.
+func (d DescriptiveDetail) AccessibilityFeatures() []AccessibilityFeature {
+	return accessibilityFeaturesOf(d.ProductFormFeatures)
+}
+
+// AccessibilityFeatures returns details of accessibility of e-publication which ProductFormFeature composites of part tell,
+// in order of the message.
+func (p ProductPart) AccessibilityFeatures() []AccessibilityFeature {
+	return accessibilityFeaturesOf(p.ProductFormFeatures)
+}
@@ -0,0 +1,161 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// From returns sender of message.
+func (h Header) From() Party {
+	p := Party{
+		Name:        textOf(h.Sender.SenderName),
+		ContactName: textOf(h.Sender.ContactName),
+		Email:       textOf(h.Sender.EmailAddress),
+		Identifiers: map[string]string{},
+	}
+	for _, id := range h.Sender.SenderIdentifiers {
+		p.Identifiers[id.SenderIDType.Code()] = strings.TrimSpace(id.IDValue.Body)
+	}
+	return p
+}
+
+// To returns addressees of message.
+func (h Header) To() []Party {
+	var parties []Party
+	for _, a := range h.Addressees {
+		p := Party{Name: textOf(a.AddresseeName), ContactName: textOf(a.ContactName), Email: textOf(a.EmailAddress), Identifiers: map[string]string{}}
+		for _, id := range a.AddresseeIdentifiers {
+			p.Identifiers[id.AddresseeIDType.Code()] = strings.TrimSpace(id.IDValue.Body)
+		}
+		parties = append(parties, p)
+	}
+	return parties
+}
+
+// SentTime parses SentDateTime of YYYYMMDD, YYYYMMDDThhmm or YYYYMMDDThhmmss, which may have time zone.
+func (h Header) SentTime() (time.Time, error) {
+	return parseSentTime(h.SentDateTime.Body)
+}
+
+// Normalize backfills products which lack values given as defaults of header.
+// Prices without currency or type become of DefaultCurrencyCode and DefaultPriceType,
+// and descriptive details without language of text become of DefaultLanguageOfText.
+func (m *ONIXMessage) Normalize() {
+	var language, currency, priceType string
+	if m.Header.DefaultLanguageOfText != nil {
+		language = m.Header.DefaultLanguageOfText.Code()
+	}
+	if m.Header.DefaultCurrencyCode != nil {
+		currency = m.Header.DefaultCurrencyCode.Code()
+	}
+	if m.Header.DefaultPriceType != nil {
+		priceType = m.Header.DefaultPriceType.Code()
+	}
+	for i := range m.Products {
+		if d := m.Products[i].DescriptiveDetail; d != nil && language != "" && !d.hasLanguageOfText() {
+			l := Language{}
+			if defaultedCode(&l.LanguageRole, string(LanguageRoleText)) && defaultedCode(&l.LanguageCode, language) {
+				d.Languages = append(d.Languages, l)
+			}
+		}
+		for j := range m.Products[i].ProductSupplys {
+			supply := &m.Products[i].ProductSupplys[j]
+			for k := range supply.SupplyDetails {
+				s := &supply.SupplyDetails[k]
+				normalizePrices(s.Prices, currency, priceType)
+				if s.Reissue != nil {
+					normalizePrices(s.Reissue.Prices, currency, priceType)
+				}
+			}
+		}
+	}
+}
+
+func normalizePrices(prices []Price, currency, priceType string) {
+	for i := range prices {
+		price := &prices[i]
+		if c := (&CurrencyCode{}); price.CurrencyCode == nil && defaultedCode(c, currency) {
+			price.CurrencyCode = c
+		}
+		if t := (&PriceType{}); price.PriceType == nil && defaultedCode(t, priceType) {
+			price.PriceType = t
+		}
+	}
+}
+
+func (d DescriptiveDetail) hasLanguageOfText() bool {
+	for _, l := range d.Languages {
+		if l.Role() == LanguageRoleText {
+			return true
+		}
+	}
+	return false
+}
+
+// Party is sender or addressee of message.
+type Party struct {
+	Name        string
+	ContactName string
+	Email       string
+	// Identifiers are values of identifiers by their type, which is a code of List 44 such as 06 for GLN and 07 for SAN.
+	Identifiers map[string]string
+}
+
+// Is reports whether party is named name, or has identifier of value name.
+func (p Party) Is(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	if strings.EqualFold(p.Name, name) {
+		return true
+	}
+	for _, value := range p.Identifiers {
+		if value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAddressedTo reports whether message is addressed to party of name or identifier,
+// where message without addressees is regarded as addressed to anyone.
+func (h Header) IsAddressedTo(name string) bool {
+	addressees := h.To()
+	if len(addressees) == 0 {
+		return true
+	}
+	for _, a := range addressees {
+		if a.Is(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sentTimeLayouts are layouts of date and time when message is sent, which may have time zone such as Z or +0100.
+var sentTimeLayouts = []string{"20060102", "200601021504", "20060102T1504", "20060102T150405", "20060102T1504Z0700", "20060102T150405Z0700"}
+
+func parseSentTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range sentTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unexpected date and time of sending has been passed, got [%s]", value)
+}
+
+func textOf(t *PlainText) string {
+	if t == nil {
+		return ""
+	}
+	return strings.TrimSpace(t.Body)
+}
+
+// defaultedCode decodes code into c unless code is empty, and reports whether c is decoded.
+func defaultedCode(c xml.Unmarshaler, code string) bool {
+	return code != "" && decodeCode(c, code) == nil
+}
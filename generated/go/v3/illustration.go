@@ -0,0 +1,131 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AncillaryContentTypeCode is code of List 25 which tells type of illustrations and other content, such as 14 for maps.
+type AncillaryContentTypeCode string
+
+const (
+	// AncillaryContentUnspecified is unspecified content, which the description tells.
+	AncillaryContentUnspecified AncillaryContentTypeCode = "00"
+	// AncillaryContentIllustrationsBlackAndWhite is black and white illustrations.
+	AncillaryContentIllustrationsBlackAndWhite AncillaryContentTypeCode = "01"
+	// AncillaryContentIllustrationsColor is color illustrations.
+	AncillaryContentIllustrationsColor AncillaryContentTypeCode = "02"
+	// AncillaryContentHalftonesBlackAndWhite is black and white halftones.
+	AncillaryContentHalftonesBlackAndWhite AncillaryContentTypeCode = "03"
+	// AncillaryContentHalftonesColor is color halftones.
+	AncillaryContentHalftonesColor AncillaryContentTypeCode = "04"
+	// AncillaryContentLineDrawingsBlackAndWhite is black and white line drawings.
+	AncillaryContentLineDrawingsBlackAndWhite AncillaryContentTypeCode = "05"
+	// AncillaryContentLineDrawingsColor is color line drawings.
+	AncillaryContentLineDrawingsColor AncillaryContentTypeCode = "06"
+	// AncillaryContentTablesBlackAndWhite is black and white tables.
+	AncillaryContentTablesBlackAndWhite AncillaryContentTypeCode = "07"
+	// AncillaryContentTablesColor is color tables.
+	AncillaryContentTablesColor AncillaryContentTypeCode = "08"
+	// AncillaryContentIllustrations is illustrations of unspecified color.
+	AncillaryContentIllustrations AncillaryContentTypeCode = "09"
+	// AncillaryContentHalftones is halftones of unspecified color.
+	AncillaryContentHalftones AncillaryContentTypeCode = "10"
+	// AncillaryContentTables is tables of unspecified color.
+	AncillaryContentTables AncillaryContentTypeCode = "11"
+	// AncillaryContentLineDrawings is line drawings of unspecified color.
+	AncillaryContentLineDrawings AncillaryContentTypeCode = "12"
+	// AncillaryContentHalftonesDuotone is duotone halftones.
+	AncillaryContentHalftonesDuotone AncillaryContentTypeCode = "13"
+	// AncillaryContentMaps is maps.
+	AncillaryContentMaps AncillaryContentTypeCode = "14"
+	// AncillaryContentFrontispiece is frontispiece.
+	AncillaryContentFrontispiece AncillaryContentTypeCode = "15"
+	// AncillaryContentDiagrams is diagrams.
+	AncillaryContentDiagrams AncillaryContentTypeCode = "16"
+	// AncillaryContentFigures is figures.
+	AncillaryContentFigures AncillaryContentTypeCode = "17"
+	// AncillaryContentCharts is charts.
+	AncillaryContentCharts AncillaryContentTypeCode = "18"
+	// AncillaryContentRecordedMusic is recorded music items.
+	AncillaryContentRecordedMusic AncillaryContentTypeCode = "19"
+	// AncillaryContentPrintedMusic is printed music items.
+	AncillaryContentPrintedMusic AncillaryContentTypeCode = "20"
+	// AncillaryContentGraphs is graphs.
+	AncillaryContentGraphs AncillaryContentTypeCode = "21"
+	// AncillaryContentPlates is plates of unspecified color.
+	AncillaryContentPlates AncillaryContentTypeCode = "22"
+	// AncillaryContentPlatesBlackAndWhite is black and white plates.
+	AncillaryContentPlatesBlackAndWhite AncillaryContentTypeCode = "23"
+	// AncillaryContentPlatesColor is color plates.
+	AncillaryContentPlatesColor AncillaryContentTypeCode = "24"
+	// AncillaryContentIndex is index.
+	AncillaryContentIndex AncillaryContentTypeCode = "25"
+	// AncillaryContentBibliography is bibliography.
+	AncillaryContentBibliography AncillaryContentTypeCode = "26"
+	// AncillaryContentInsetMaps is inset maps.
+	AncillaryContentInsetMaps AncillaryContentTypeCode = "27"
+	// AncillaryContentGPSGrids is GPS grids.
+	AncillaryContentGPSGrids AncillaryContentTypeCode = "28"
+	// AncillaryContentGlossary is glossary.
+	AncillaryContentGlossary AncillaryContentTypeCode = "29"
+	// AncillaryContentTableOfContents is table of contents.
+	AncillaryContentTableOfContents AncillaryContentTypeCode = "30"
+)
+
+// IsColor reports whether content of code is in color.
+func (c AncillaryContentTypeCode) IsColor() bool {
+	switch c {
+	case AncillaryContentIllustrationsColor, AncillaryContentHalftonesColor, AncillaryContentLineDrawingsColor, AncillaryContentTablesColor, AncillaryContentPlatesColor:
+		return true
+	}
+	return false
+}
+
+// NewAncillaryContent returns AncillaryContent of number of content of t, which fails for codes which List 25 does not define.
+// Number of zero is omitted, and description is omitted if it is empty.
+func NewAncillaryContent(t AncillaryContentTypeCode, number int64, description string) (AncillaryContent, error) {
+	var c AncillaryContent
+	if err := decodeCode(&c.AncillaryContentType, string(t)); err != nil {
+		return AncillaryContent{}, err
+	}
+	if number > 0 {
+		c.Number = &PlainText{Body: strconv.FormatInt(number, 10)}
+	}
+	if description != "" {
+		c.AncillaryContentDescriptions = []Flow{Flow(description)}
+	}
+	return c, nil
+}
+
+// Type returns code of List 25 of content.
+func (c AncillaryContent) Type() AncillaryContentTypeCode {
+	return AncillaryContentTypeCode(c.AncillaryContentType.Code())
+}
+
+// Count returns number of content, which is absent for content which is not counted such as index.
+func (c AncillaryContent) Count() (int64, bool) {
+	return quantityOf(c.Number)
+}
+
+// Descriptions returns AncillaryContentDescription of content, which may be repeated in languages.
+func (c AncillaryContent) Descriptions() []string {
+	var descriptions []string
+	for _, d := range c.AncillaryContentDescriptions {
+		if text := strings.TrimSpace(string(d)); text != "" {
+			descriptions = append(descriptions, text)
+		}
+	}
+	return descriptions
+}
+
+// AncillaryContentsOf returns AncillaryContent composites of detail whose type is t.
+func (d DescriptiveDetail) AncillaryContentsOf(t AncillaryContentTypeCode) []AncillaryContent {
+	var found []AncillaryContent
+	for _, c := range d.AncillaryContents {
+		if c.Type() == t {
+			found = append(found, c)
+		}
+	}
+	return found
+}
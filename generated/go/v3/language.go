@@ -0,0 +1,105 @@
+package onix
+
+import "strings"
+
+// LanguageRoleCode is code of List 22 which tells role of language for product, such as 01 for language of text.
+type LanguageRoleCode string
+
+const (
+	// LanguageRoleText is language of text.
+	LanguageRoleText LanguageRoleCode = "01"
+	// LanguageRoleOriginal is original language of translated text.
+	LanguageRoleOriginal LanguageRoleCode = "02"
+	// LanguageRoleAbstracts is language of abstracts.
+	LanguageRoleAbstracts LanguageRoleCode = "03"
+	// LanguageRoleOriginalMultilingual is original language of multilingual edition.
+	LanguageRoleOriginalMultilingual LanguageRoleCode = "06"
+	// LanguageRoleTranslatedMultilingual is translated language of multilingual edition.
+	LanguageRoleTranslatedMultilingual LanguageRoleCode = "07"
+	// LanguageRoleAudioTrack is language of audio track.
+	LanguageRoleAudioTrack LanguageRoleCode = "08"
+	// LanguageRoleSubtitles is language of subtitles.
+	LanguageRoleSubtitles LanguageRoleCode = "09"
+)
+
+// Role returns role of language.
+func (l Language) Role() LanguageRoleCode {
+	return LanguageRoleCode(l.LanguageRole.Code())
+}
+
+// Code returns code of List 74 of language, which is ISO 639-2/B such as fre for French.
+func (l Language) Code() string {
+	return l.LanguageCode.Code()
+}
+
+// Country returns code of List 91 of country where language is used, which is ISO 3166-1 such as CA for Canada.
+func (l Language) Country() (string, bool) {
+	if l.CountryCode == nil {
+		return "", false
+	}
+	countries := l.CountryCode.Codes()
+	if len(countries) == 0 {
+		return "", false
+	}
+	return countries[0], true
+}
+
+// Script returns code of List 121 of script of language, which is ISO 15924 such as Cyrl for Cyrillic.
+func (l Language) Script() (string, bool) {
+	if l.ScriptCode == nil {
+		return "", false
+	}
+	return l.ScriptCode.Code(), true
+}
+
+// BCP47 returns language tag of BCP 47 of language such as sr-Cyrl-RS.
+func (l Language) BCP47() string {
+	script, _ := l.Script()
+	country, _ := l.Country()
+	return BCP47Tag(l.Code(), script, country)
+}
+
+// iso6391 are codes of ISO 639-1 which codes of ISO 639-2/B, and ISO 639-2/T where they differ, correspond to.
+var iso6391 = map[string]string{
+	"aar": "aa", "abk": "ab", "afr": "af", "aka": "ak", "alb": "sq", "amh": "am", "ara": "ar", "arg": "an", "arm": "hy",
+	"asm": "as", "ava": "av", "ave": "ae", "aym": "ay", "aze": "az", "bak": "ba", "bam": "bm", "baq": "eu", "bel": "be",
+	"ben": "bn", "bis": "bi", "bod": "bo", "bos": "bs", "bre": "br", "bul": "bg", "bur": "my", "cat": "ca", "ces": "cs",
+	"cha": "ch", "che": "ce", "chi": "zh", "chu": "cu", "chv": "cv", "cor": "kw", "cos": "co", "cre": "cr", "cym": "cy",
+	"cze": "cs", "dan": "da", "deu": "de", "div": "dv", "dut": "nl", "dzo": "dz", "ell": "el", "eng": "en", "epo": "eo",
+	"est": "et", "eus": "eu", "ewe": "ee", "fao": "fo", "fas": "fa", "fij": "fj", "fin": "fi", "fra": "fr", "fre": "fr",
+	"fry": "fy", "ful": "ff", "geo": "ka", "ger": "de", "gla": "gd", "gle": "ga", "glg": "gl", "glv": "gv", "gre": "el",
+	"grn": "gn", "guj": "gu", "hat": "ht", "hau": "ha", "heb": "he", "her": "hz", "hin": "hi", "hmo": "ho", "hrv": "hr",
+	"hun": "hu", "hye": "hy", "ibo": "ig", "ice": "is", "ido": "io", "iii": "ii", "iku": "iu", "ile": "ie", "ina": "ia",
+	"ind": "id", "ipk": "ik", "isl": "is", "ita": "it", "jav": "jv", "jpn": "ja", "kal": "kl", "kan": "kn", "kas": "ks",
+	"kat": "ka", "kau": "kr", "kaz": "kk", "khm": "km", "kik": "ki", "kin": "rw", "kir": "ky", "kom": "kv", "kon": "kg",
+	"kor": "ko", "kua": "kj", "kur": "ku", "lao": "lo", "lat": "la", "lav": "lv", "lim": "li", "lin": "ln", "lit": "lt",
+	"ltz": "lb", "lub": "lu", "lug": "lg", "mac": "mk", "mah": "mh", "mal": "ml", "mao": "mi", "mar": "mr", "may": "ms",
+	"mkd": "mk", "mlg": "mg", "mlt": "mt", "mon": "mn", "mri": "mi", "msa": "ms", "mya": "my", "nau": "na", "nav": "nv",
+	"nbl": "nr", "nde": "nd", "ndo": "ng", "nep": "ne", "nld": "nl", "nno": "nn", "nob": "nb", "nor": "no", "nya": "ny",
+	"oci": "oc", "oji": "oj", "ori": "or", "orm": "om", "oss": "os", "pan": "pa", "per": "fa", "pli": "pi", "pol": "pl",
+	"por": "pt", "pus": "ps", "que": "qu", "roh": "rm", "ron": "ro", "rum": "ro", "run": "rn", "rus": "ru", "sag": "sg",
+	"san": "sa", "sin": "si", "slk": "sk", "slo": "sk", "slv": "sl", "sme": "se", "smo": "sm", "sna": "sn", "snd": "sd",
+	"som": "so", "sot": "st", "spa": "es", "sqi": "sq", "srd": "sc", "srp": "sr", "ssw": "ss", "sun": "su", "swa": "sw",
+	"swe": "sv", "tah": "ty", "tam": "ta", "tat": "tt", "tel": "te", "tgk": "tg", "tgl": "tl", "tha": "th", "tib": "bo",
+	"tir": "ti", "ton": "to", "tsn": "tn", "tso": "ts", "tuk": "tk", "tur": "tr", "twi": "tw", "uig": "ug", "ukr": "uk",
+	"urd": "ur", "uzb": "uz", "ven": "ve", "vie": "vi", "vol": "vo", "wel": "cy", "wln": "wa", "wol": "wo", "xho": "xh",
+	"yid": "yi", "yor": "yo", "zha": "za", "zho": "zh", "zul": "zu",
+}
+
+// BCP47Tag returns language tag of BCP 47 of language, which is a code of List 74 such as fre, with script of ISO 15924 and country of ISO 3166-1.
+// Language of ISO 639-2 is shortened into ISO 639-1 such as fr if it has the counterpart, and script and country are omitted if they are empty.
+// For example, BCP47Tag("fre", "", "CA") returns fr-CA.
+func BCP47Tag(language, script, country string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if short, ok := iso6391[language]; ok {
+		language = short
+	}
+	tag := language
+	if script = strings.TrimSpace(script); script != "" {
+		tag += "-" + strings.ToUpper(script[:1]) + strings.ToLower(script[1:])
+	}
+	if country = strings.TrimSpace(country); country != "" {
+		tag += "-" + strings.ToUpper(country)
+	}
+	return tag
+}
@@ -0,0 +1,203 @@
+package onix
+
+import (
+	"strings"
+	"time"
+)
+
+// eurozone are countries whose currency is euro, which ECZ of List 49 stands for.
+var eurozone = []string{"AT", "BE", "CY", "DE", "EE", "ES", "FI", "FR", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MT", "NL", "PT", "SI", "SK"}
+
+// Includes reports whether territory includes country, which is a code of ISO 3166-1 or a subdivision of ISO 3166-2 such as ES-CN.
+// Countries include their subdivisions, WORLD of regions includes every country and ECZ the eurozone, and exclusions take precedence over inclusions.
+// ROW of regions is resolved by Product.MarketsFor, since it depends on the other markets.
+func (t Territory) Includes(country string) bool {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country == "" {
+		return false
+	}
+	if matchesTerritory(textOf(t.CountriesExcluded), country) || matchesTerritory(textOf(t.RegionsExcluded), country) {
+		return false
+	}
+	return matchesTerritory(textOf(t.CountriesIncluded), country) || matchesTerritory(textOf(t.RegionsIncluded), country)
+}
+
+// IsRestOfWorld reports whether territory is the rest of world, which is every country which the other markets do not include.
+func (t Territory) IsRestOfWorld() bool {
+	for _, region := range strings.Fields(textOf(t.RegionsIncluded)) {
+		if strings.EqualFold(region, "ROW") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTerritory reports whether any of codes of countries or regions separated by spaces stands for country or its parent regardless of case.
+func matchesTerritory(codes string, country string) bool {
+	parent := country
+	if i := strings.IndexByte(country, '-'); i >= 0 {
+		parent = country[:i]
+	}
+	for _, code := range strings.Fields(strings.ToUpper(codes)) {
+		switch code {
+		case country, parent, "WORLD":
+			return true
+		case "ECZ":
+			for _, c := range eurozone {
+				if c == parent {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Includes reports whether market of supply includes country, where supply without Market composites is for every country.
+// Markets of the rest of world are not regarded to include any country.
+func (s ProductSupply) Includes(country string) bool {
+	if len(s.Markets) == 0 {
+		return strings.TrimSpace(country) != ""
+	}
+	for _, m := range s.Markets {
+		if m.Territory.Includes(country) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s ProductSupply) isRestOfWorld() bool {
+	for _, m := range s.Markets {
+		if m.Territory.IsRestOfWorld() {
+			return true
+		}
+	}
+	return false
+}
+
+// MarketsFor returns ProductSupply composites whose markets include country, which is a code of ISO 3166-1 or a subdivision of ISO 3166-2.
+// The ones of the rest of world are returned only when no other market includes country.
+func (p Product) MarketsFor(country string) []ProductSupply {
+	var markets, rest []ProductSupply
+	for _, supply := range p.ProductSupplys {
+		switch {
+		case supply.Includes(country):
+			markets = append(markets, supply)
+		case supply.isRestOfWorld() && strings.TrimSpace(country) != "":
+			rest = append(rest, supply)
+		}
+	}
+	if len(markets) == 0 {
+		return rest
+	}
+	return markets
+}
+
+// Status returns status of publishing in market, which is a code of List 68 such as 04 for active.
+func (d MarketPublishingDetail) Status() string {
+	return d.MarketPublishingStatus.Code()
+}
+
+// Date returns date of role in market, which is a code of List 163.
+func (d MarketPublishingDetail) Date(role string) (Date, bool) {
+	for _, date := range d.MarketDates {
+		if date.MarketDateRole.Code() != role {
+			continue
+		}
+		if value, ok := date.Value(); ok {
+			return value, true
+		}
+	}
+	return Date{}, false
+}
+
+// Date returns date of role of publishing, which is a code of List 163.
+func (d PublishingDetail) Date(role string) (Date, bool) {
+	for _, date := range d.PublishingDates {
+		if date.PublishingDateRole.Code() != role {
+			continue
+		}
+		if value, ok := date.Value(); ok {
+			return value, true
+		}
+	}
+	return Date{}, false
+}
+
+// PublishingStatus returns status of publishing in market of supply, which is empty unless supply has MarketPublishingDetail.
+func (s ProductSupply) PublishingStatus() string {
+	if s.MarketPublishingDetail == nil {
+		return ""
+	}
+	return s.MarketPublishingDetail.Status()
+}
+
+// PublicationDate returns date of publication in market of supply.
+func (s ProductSupply) PublicationDate() (Date, bool) {
+	if s.MarketPublishingDetail == nil {
+		return Date{}, false
+	}
+	return s.MarketPublishingDetail.Date(PublishingDateRolePublication)
+}
+
+// OnSaleDate returns date when product may be sold to public in market of supply.
+func (s ProductSupply) OnSaleDate() (Date, bool) {
+	if s.MarketPublishingDetail == nil {
+		return Date{}, false
+	}
+	return s.MarketPublishingDetail.Date(PublishingDateRoleSalesEmbargo)
+}
+
+// IsOnSale reports whether product may be sold to public at at in market of country, which is a code of ISO 3166-1 or a subdivision of ISO 3166-2,
+// so that embargoed product is not sold before the embargo is lifted. Markets whose MarketPublishingDetail tells embargo date
+// take precedence over the one of PublishingDetail, and product is on sale by a supply only if any of its suppliers may sell it as well.
+// Product without embargo is on sale as soon as it is supplied, and product is not on sale in market which no supply includes.
+func (p Product) IsOnSale(at time.Time, country string) bool {
+	embargo, embargoed := p.publishingDate(PublishingDateRoleSalesEmbargo)
+	for _, s := range p.MarketsFor(country) {
+		d, ok := embargo, embargoed
+		if s.MarketPublishingDetail != nil {
+			if date, found := s.MarketPublishingDetail.Date(PublishingDateRoleSalesEmbargo); found {
+				d, ok = date, true
+			}
+		}
+		if ok && at.Before(d.Time) {
+			continue
+		}
+		if s.isSuppliedAt(at) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSuppliedAt reports whether any of suppliers may sell product of supply at at, which is the case of supply without SupplyDetail composites.
+func (s ProductSupply) isSuppliedAt(at time.Time) bool {
+	if len(s.SupplyDetails) == 0 {
+		return true
+	}
+	for _, detail := range s.SupplyDetails {
+		embargoed := false
+		for _, date := range detail.SupplyDates {
+			if date.SupplyDateRole.Code() != SupplyDateRoleSalesEmbargo {
+				continue
+			}
+			if d, ok := date.Value(); ok && at.Before(d.Time) {
+				embargoed = true
+			}
+		}
+		if !embargoed {
+			return true
+		}
+	}
+	return false
+}
+
+// publishingDate returns date of role of PublishingDetail, which is a code of List 163.
+func (p Product) publishingDate(role string) (Date, bool) {
+	if p.PublishingDetail == nil {
+		return Date{}, false
+	}
+	return p.PublishingDetail.Date(role)
+}
@@ -0,0 +1,91 @@
+package onix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MeasureKind is code of List 48 which tells what measure measures, such as 01 for height.
+type MeasureKind string
+
+const (
+	// MeasureHeight is overall height.
+	MeasureHeight MeasureKind = "01"
+	// MeasureWidth is overall width.
+	MeasureWidth MeasureKind = "02"
+	// MeasureThickness is overall thickness.
+	MeasureThickness MeasureKind = "03"
+	// MeasurePageTrimHeight is height of trimmed pages.
+	MeasurePageTrimHeight MeasureKind = "04"
+	// MeasurePageTrimWidth is width of trimmed pages.
+	MeasurePageTrimWidth MeasureKind = "05"
+	// MeasureUnitWeight is weight of single unit of product.
+	MeasureUnitWeight MeasureKind = "08"
+	// MeasureDiameter is diameter of sphere such as globe.
+	MeasureDiameter MeasureKind = "09"
+)
+
+// MeasureUnit is code of List 50 which tells unit of measurement, such as mm for millimeters.
+type MeasureUnit string
+
+const (
+	// MeasureUnitCentimeters is centimeters.
+	MeasureUnitCentimeters MeasureUnit = "cm"
+	// MeasureUnitGrams is grams.
+	MeasureUnitGrams MeasureUnit = "gr"
+	// MeasureUnitInches is inches of US.
+	MeasureUnitInches MeasureUnit = "in"
+	// MeasureUnitKilograms is kilograms.
+	MeasureUnitKilograms MeasureUnit = "kg"
+	// MeasureUnitPounds is pounds of US.
+	MeasureUnitPounds MeasureUnit = "lb"
+	// MeasureUnitMillimeters is millimeters.
+	MeasureUnitMillimeters MeasureUnit = "mm"
+	// MeasureUnitOunces is ounces of US.
+	MeasureUnitOunces MeasureUnit = "oz"
+	// MeasureUnitPixels is pixels.
+	MeasureUnitPixels MeasureUnit = "px"
+)
+
+// millimeters and grams are the amounts of units of length and weight in millimeters and grams.
+var (
+	millimeters = map[MeasureUnit]float64{MeasureUnitCentimeters: 10, MeasureUnitInches: 25.4, MeasureUnitMillimeters: 1}
+	grams       = map[MeasureUnit]float64{MeasureUnitGrams: 1, MeasureUnitKilograms: 1000, MeasureUnitPounds: 453.59237, MeasureUnitOunces: 28.349523125}
+)
+
+// Kind returns what measure measures.
+func (m Measure) Kind() MeasureKind {
+	return MeasureKind(m.MeasureType.Code())
+}
+
+// Unit returns unit of measurement.
+func (m Measure) Unit() MeasureUnit {
+	return MeasureUnit(m.MeasureUnitCode.Code())
+}
+
+// Value returns measurement in its unit.
+func (m Measure) Value() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(m.Measurement.Body), 64)
+}
+
+// Millimeters returns measurement of length in millimeters, whose unit is either of millimeters, centimeters or inches.
+func (m Measure) Millimeters() (float64, bool) {
+	return m.convert(millimeters)
+}
+
+// Grams returns measurement of weight in grams, whose unit is either of grams, kilograms, pounds or ounces.
+func (m Measure) Grams() (float64, bool) {
+	return m.convert(grams)
+}
+
+func (m Measure) convert(units map[MeasureUnit]float64) (float64, bool) {
+	rate, ok := units[m.Unit()]
+	if !ok {
+		return 0, false
+	}
+	value, err := m.Value()
+	if err != nil {
+		return 0, false
+	}
+	return value * rate, true
+}
@@ -0,0 +1,160 @@
+package onix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ResourceContentTypeFrontCover is code of List 158 of front cover.
+	ResourceContentTypeFrontCover = "01"
+	// ResourceContentTypeBackCover is code of List 158 of back cover.
+	ResourceContentTypeBackCover = "02"
+	// ResourceModeImage is code of List 159 of still image.
+	ResourceModeImage = "03"
+	// ResourceFormLinkable is code of List 161 of resource which is linked to.
+	ResourceFormLinkable = "01"
+	// ResourceFormDownloadable is code of List 161 of file which is downloaded.
+	ResourceFormDownloadable = "02"
+	// ResourceFormEmbeddable is code of List 161 of application which is embedded in pages.
+	ResourceFormEmbeddable = "03"
+	// ResourceVersionFeatureFileFormat is code of List 162 of format of file, which is a code of List 178.
+	ResourceVersionFeatureFileFormat = "01"
+	// ResourceVersionFeatureImageHeight is code of List 162 of height of image in pixels.
+	ResourceVersionFeatureImageHeight = "02"
+	// ResourceVersionFeatureImageWidth is code of List 162 of width of image in pixels.
+	ResourceVersionFeatureImageWidth = "03"
+)
+
+// Feature returns value of feature of version, whose type is a code of List 162.
+func (v ResourceVersion) Feature(featureType string) (string, bool) {
+	for _, f := range v.ResourceVersionFeatures {
+		if f.ResourceVersionFeatureType.Code() == featureType && f.FeatureValue != nil {
+			return strings.TrimSpace(f.FeatureValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// ImageWidth returns width of image of version in pixels.
+func (v ResourceVersion) ImageWidth() (int64, bool) {
+	return v.pixels(ResourceVersionFeatureImageWidth)
+}
+
+// ImageHeight returns height of image of version in pixels.
+func (v ResourceVersion) ImageHeight() (int64, bool) {
+	return v.pixels(ResourceVersionFeatureImageHeight)
+}
+
+func (v ResourceVersion) pixels(featureType string) (int64, bool) {
+	value, ok := v.Feature(featureType)
+	if !ok {
+		return 0, false
+	}
+	pixels, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return pixels, true
+}
+
+// URL returns the first link of version which is a valid URL of http or https.
+func (v ResourceVersion) URL() (string, bool) {
+	for _, l := range v.ResourceLinks {
+		if link := strings.TrimSpace(l.Body); ValidURL(link) {
+			return link, true
+		}
+	}
+	return "", false
+}
+
+// Version returns version of resource of form, which is a code of List 161 such as 02 for downloadable file, and which has valid URL.
+// The narrowest version whose ImageWidth is at least width is selected, or the widest one when none is wide enough or width is 0.
+// Version which doesn't tell its width is regarded as the narrowest.
+func (r SupportingResource) Version(form string, width int64) (ResourceVersion, bool) {
+	var selected ResourceVersion
+	var selectedWidth int64
+	found, wide := false, false
+	for _, v := range r.ResourceVersions {
+		if v.ResourceForm.Code() != form {
+			continue
+		}
+		if _, ok := v.URL(); !ok {
+			continue
+		}
+		w, _ := v.ImageWidth()
+		enough := width > 0 && w >= width
+		switch {
+		case !found:
+		case enough && (!wide || w < selectedWidth):
+		case !enough && !wide && w > selectedWidth:
+		default:
+			continue
+		}
+		selected, selectedWidth, found, wide = v, w, true, enough
+	}
+	return selected, found
+}
+
+// SupportingResources returns supporting resources of CollateralDetail of product, followed by the ones of Reissue of supply details.
+func (p Product) SupportingResources() []SupportingResource {
+	var resources []SupportingResource
+	if p.CollateralDetail != nil {
+		resources = append(resources, p.CollateralDetail.SupportingResources...)
+	}
+	for _, s := range p.supplyDetails() {
+		if s.Reissue != nil {
+			resources = append(resources, s.Reissue.SupportingResources...)
+		}
+	}
+	return resources
+}
+
+// CoverImageURL returns URL of the widest version of image of front cover, where linkable resource is preferred over downloadable file.
+func (p Product) CoverImageURL() (string, bool) {
+	for _, form := range []string{ResourceFormLinkable, ResourceFormDownloadable} {
+		for _, r := range p.SupportingResources() {
+			if r.ResourceContentType.Code() != ResourceContentTypeFrontCover || r.ResourceMode.Code() != ResourceModeImage {
+				continue
+			}
+			if v, ok := r.Version(form, 0); ok {
+				return v.URL()
+			}
+		}
+	}
+	return "", false
+}
+
+// ValidURL reports whether link is an absolute URL of http or https which storefronts can link to.
+func ValidURL(link string) bool {
+	u, err := url.Parse(strings.TrimSpace(link))
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// VerifyLink sends HEAD request to link by client, and returns error unless it is responded successfully.
+// http.DefaultClient is used when client is nil.
+func VerifyLink(ctx context.Context, client *http.Client, link string) error {
+	if !ValidURL(link) {
+		return fmt.Errorf("unexpected link has been passed, got [%s]", link)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, strings.TrimSpace(link), nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status of link [%s] has been responded, got [%s]", link, res.Status)
+	}
+	return nil
+}
@@ -1,22 +1,107 @@
 package onix
 
+import (
+	"encoding/xml"
+	"strings"
+)
+
 
 // Flow is html string.
 type Flow string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h Flow) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h Flow) clone() Flow {
+	return h
+}
+
+func (h Flow) equal(o Flow) bool {
+	return h == o
+}
+
 
 // Inline is html string.
 type Inline string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h Inline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h Inline) clone() Inline {
+	return h
+}
+
+func (h Inline) equal(o Inline) bool {
+	return h == o
+}
+
 
 // ADotcontent is html string.
 type ADotcontent string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h ADotcontent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h ADotcontent) clone() ADotcontent {
+	return h
+}
+
+func (h ADotcontent) equal(o ADotcontent) bool {
+	return h == o
+}
+
 
 // PreDotcontent is html string.
 type PreDotcontent string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h PreDotcontent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h PreDotcontent) clone() PreDotcontent {
+	return h
+}
+
+func (h PreDotcontent) equal(o PreDotcontent) bool {
+	return h == o
+}
+
 
 // RubyDotcontent is html string.
 type RubyDotcontent string
 
+// MarshalXML is marshaler which writes no element for blank html, since empty element is not valid ONIX.
+func (h RubyDotcontent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if strings.TrimSpace(string(h)) == "" {
+		return nil
+	}
+	return e.EncodeElement(string(h), start)
+}
+
+func (h RubyDotcontent) clone() RubyDotcontent {
+	return h
+}
+
+func (h RubyDotcontent) equal(o RubyDotcontent) bool {
+	return h == o
+}
+
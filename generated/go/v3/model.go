@@ -3,1514 +3,1710 @@ package onix
 
 // AVItem is not documented.
 type AVItem struct {
-	AVItemType AVItemType `xml:"x540"`
-	AVItemIdentifiers []AVItemIdentifier `xml:"avitemidentifier,omitempty" json:",omitempty"`
-	TimeRuns []TimeRun `xml:"timerun,omitempty" json:",omitempty"`
-	AVDuration *DtDotTimeOrDuration `xml:"x544,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AVItemType AVItemType `xml:"x540" json:"avItemType"`
+	AVItemIdentifiers []AVItemIdentifier `xml:"avitemidentifier,omitempty" json:"avItemIdentifiers,omitempty"`
+	TimeRuns []TimeRun `xml:"timerun,omitempty" json:"timeRuns,omitempty"`
+	AVDuration *PlainText `xml:"x544,omitempty" json:"avDuration,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AVItemIdentifier is not documented.
 type AVItemIdentifier struct {
-	AVItemIDType AVItemIDType `xml:"x541"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AVItemIDType AVItemIDType `xml:"x541" json:"avItemIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Addressee is not documented.
 type Addressee struct {
-	AddresseeName *DtDotNonEmptyString `xml:"x300,omitempty" json:",omitempty"`
-	AddresseeIdentifiers []AddresseeIdentifier `xml:"addresseeidentifier,omitempty" json:",omitempty"`
-	ContactName *DtDotNonEmptyString `xml:"x299,omitempty" json:",omitempty"`
-	EmailAddress *DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AddresseeIdentifiers []AddresseeIdentifier `xml:"addresseeidentifier,omitempty" json:"addresseeIdentifiers,omitempty"`
+	AddresseeName *PlainText `xml:"x300,omitempty" json:"addresseeName,omitempty"`
+	ContactName *PlainText `xml:"x299,omitempty" json:"contactName,omitempty"`
+	EmailAddress *PlainText `xml:"j272,omitempty" json:"emailAddress,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AddresseeIdentifier is not documented.
 type AddresseeIdentifier struct {
-	AddresseeIDType AddresseeIDType `xml:"m380"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AddresseeIDType AddresseeIDType `xml:"m380" json:"addresseeIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AgentIdentifier is not documented.
 type AgentIdentifier struct {
-	AgentIDType AgentIDType `xml:"j400"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AgentIDType AgentIDType `xml:"j400" json:"agentIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AlternativeName is not documented.
 type AlternativeName struct {
-	Gender *Gender `xml:"x524,omitempty" json:",omitempty"`
-	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:",omitempty"`
-	NameType NameType `xml:"x414"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	NameType NameType `xml:"x414" json:"nameType"`
+	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:"nameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	Gender *Gender `xml:"x524,omitempty" json:"gender,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	CorporateNameInverted *PlainText `xml:"x443,omitempty" json:"corporateNameInverted,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AncillaryContent is not documented.
 type AncillaryContent struct {
-	AncillaryContentType AncillaryContentType `xml:"x423"`
-	AncillaryContentDescriptions []Flow `xml:"x424,omitempty" json:",omitempty"`
-	Number *DtDotPositiveInteger `xml:"b257,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AncillaryContentType AncillaryContentType `xml:"x423" json:"ancillaryContentType"`
+	AncillaryContentDescriptions []Flow `xml:"x424,omitempty" json:"ancillaryContentDescriptions,omitempty"`
+	Number *PlainText `xml:"b257,omitempty" json:"number,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Audience is not documented.
 type Audience struct {
-	AudienceCodeType AudienceCodeType `xml:"b204"`
-	AudienceCodeTypeName *DtDotNonEmptyString `xml:"b205,omitempty" json:",omitempty"`
-	AudienceCodeValue DtDotNonEmptyString `xml:"b206"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AudienceCodeType AudienceCodeType `xml:"b204" json:"audienceCodeType"`
+	AudienceCodeTypeName *PlainText `xml:"b205,omitempty" json:"audienceCodeTypeName,omitempty"`
+	AudienceCodeValue PlainText `xml:"b206" json:"audienceCodeValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // AudienceRange is not documented.
 type AudienceRange struct {
-	AudienceRangeQualifier AudienceRangeQualifier `xml:"b074"`
-	AudienceRangePrecision *AudienceRangePrecision `xml:"b075,omitempty" json:",omitempty"`
-	AudienceRangeValue *DtDotNonEmptyString `xml:"b076,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AudienceRangeQualifier AudienceRangeQualifier `xml:"b074" json:"audienceRangeQualifier"`
+	AudienceRangePrecision *AudienceRangePrecision `xml:"b075,omitempty" json:"audienceRangePrecision,omitempty"`
+	AudienceRangeValue *PlainText `xml:"b076,omitempty" json:"audienceRangeValue,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Barcode is not documented.
 type Barcode struct {
-	BarcodeType BarcodeType `xml:"x312"`
-	PositionOnProduct *PositionOnProduct `xml:"x313,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	BarcodeType BarcodeType `xml:"x312" json:"barcodeType"`
+	PositionOnProduct *PositionOnProduct `xml:"x313,omitempty" json:"positionOnProduct,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // BatchBonus is not documented.
 type BatchBonus struct {
-	BatchQuantity DtDotStrictPositiveInteger `xml:"j264"`
-	FreeQuantity DtDotStrictPositiveInteger `xml:"j265"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	BatchQuantity PlainText `xml:"j264" json:"batchQuantity"`
+	FreeQuantity PlainText `xml:"j265" json:"freeQuantity"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Bible is not documented.
 type Bible struct {
-	BibleContentss []BibleContents `xml:"b352"`
-	BibleVersions []BibleVersion `xml:"b353"`
-	StudyBibleType *StudyBibleType `xml:"b389,omitempty" json:",omitempty"`
-	BiblePurposes []BiblePurpose `xml:"b354,omitempty" json:",omitempty"`
-	BibleTextOrganization *BibleTextOrganization `xml:"b355,omitempty" json:",omitempty"`
-	BibleReferenceLocation *BibleReferenceLocation `xml:"b356,omitempty" json:",omitempty"`
-	BibleTextFeatures []BibleTextFeature `xml:"b357,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	BibleContentss []BibleContents `xml:"b352" json:"bibleContentss"`
+	BibleVersions []BibleVersion `xml:"b353" json:"bibleVersions"`
+	StudyBibleType *StudyBibleType `xml:"b389,omitempty" json:"studyBibleType,omitempty"`
+	BiblePurposes []BiblePurpose `xml:"b354,omitempty" json:"biblePurposes,omitempty"`
+	BibleTextOrganization *BibleTextOrganization `xml:"b355,omitempty" json:"bibleTextOrganization,omitempty"`
+	BibleReferenceLocation *BibleReferenceLocation `xml:"b356,omitempty" json:"bibleReferenceLocation,omitempty"`
+	BibleTextFeatures []BibleTextFeature `xml:"b357,omitempty" json:"bibleTextFeatures,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CitedContent is not documented.
 type CitedContent struct {
-	ReviewRating *ReviewRating `xml:"reviewrating,omitempty" json:",omitempty"`
-	SourceTitles []DtDotNonEmptyString `xml:"x428,omitempty" json:",omitempty"`
-	ListNames []DtDotNonEmptyString `xml:"x432,omitempty" json:",omitempty"`
-	PositionOnList *DtDotStrictPositiveInteger `xml:"x433,omitempty" json:",omitempty"`
-	CitedContentType CitedContentType `xml:"x430"`
-	ContentAudiences []ContentAudience `xml:"x427,omitempty" json:",omitempty"`
-	Territory *Territory `xml:"territory,omitempty" json:",omitempty"`
-	SourceType *SourceType `xml:"x431,omitempty" json:",omitempty"`
-	CitationNotes []Flow `xml:"x434,omitempty" json:",omitempty"`
-	ResourceLinks []DtDotNonEmptyURI `xml:"x435,omitempty" json:",omitempty"`
-	ContentDates []ContentDate `xml:"contentdate,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CitedContentType CitedContentType `xml:"x430" json:"citedContentType"`
+	ContentAudiences []ContentAudience `xml:"x427,omitempty" json:"contentAudiences,omitempty"`
+	Territory *Territory `xml:"territory,omitempty" json:"territory,omitempty"`
+	SourceType *SourceType `xml:"x431,omitempty" json:"sourceType,omitempty"`
+	ReviewRating *ReviewRating `xml:"reviewrating,omitempty" json:"reviewRating,omitempty"`
+	SourceTitles []PlainText `xml:"x428,omitempty" json:"sourceTitles,omitempty"`
+	ListNames []PlainText `xml:"x432,omitempty" json:"listNames,omitempty"`
+	PositionOnList *PlainText `xml:"x433,omitempty" json:"positionOnList,omitempty"`
+	CitationNotes []Flow `xml:"x434,omitempty" json:"citationNotes,omitempty"`
+	ResourceLinks []PlainText `xml:"x435,omitempty" json:"resourceLinks,omitempty"`
+	ContentDates []ContentDate `xml:"contentdate,omitempty" json:"contentDates,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CollateralDetail is not documented.
 type CollateralDetail struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextContents []TextContent `xml:"textcontent,omitempty" json:"textContents,omitempty"`
+	CitedContents []CitedContent `xml:"citedcontent,omitempty" json:"citedContents,omitempty"`
+	SupportingResources []SupportingResource `xml:"supportingresource,omitempty" json:"supportingResources,omitempty"`
+	Prizes []Prize `xml:"prize,omitempty" json:"prizes,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Collection is not documented.
 type Collection struct {
-	CollectionType CollectionType `xml:"x329"`
-	SourceName *DtDotNonEmptyString `xml:"x330,omitempty" json:",omitempty"`
-	CollectionIdentifiers []CollectionIdentifier `xml:"collectionidentifier,omitempty" json:",omitempty"`
-	CollectionSequences []CollectionSequence `xml:"collectionsequence,omitempty" json:",omitempty"`
-	TitleDetails []TitleDetail `xml:"titledetail,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CollectionType CollectionType `xml:"x329" json:"collectionType"`
+	SourceName *PlainText `xml:"x330,omitempty" json:"sourceName,omitempty"`
+	CollectionIdentifiers []CollectionIdentifier `xml:"collectionidentifier,omitempty" json:"collectionIdentifiers,omitempty"`
+	CollectionSequences []CollectionSequence `xml:"collectionsequence,omitempty" json:"collectionSequences,omitempty"`
+	TitleDetails []TitleDetail `xml:"titledetail,omitempty" json:"titleDetails,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorStatements []Flow `xml:"b049,omitempty" json:"contributorStatements,omitempty"`
+	NoContributor Flag `xml:"n339,omitempty" json:"noContributor,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CollectionIdentifier is not documented.
 type CollectionIdentifier struct {
-	CollectionIDType CollectionIDType `xml:"x344"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CollectionIDType CollectionIDType `xml:"x344" json:"collectionIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CollectionSequence is not documented.
 type CollectionSequence struct {
-	CollectionSequenceType CollectionSequenceType `xml:"x479"`
-	CollectionSequenceTypeName *DtDotNonEmptyString `xml:"x480,omitempty" json:",omitempty"`
-	CollectionSequenceNumber DtDotMultiLevelNumberOrHyphen `xml:"x481"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CollectionSequenceType CollectionSequenceType `xml:"x479" json:"collectionSequenceType"`
+	CollectionSequenceTypeName *PlainText `xml:"x480,omitempty" json:"collectionSequenceTypeName,omitempty"`
+	CollectionSequenceNumber PlainText `xml:"x481" json:"collectionSequenceNumber"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ComparisonProductPrice is not documented.
 type ComparisonProductPrice struct {
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier"`
-	PriceType *PriceType `xml:"x462,omitempty" json:",omitempty"`
-	PriceAmount DtDotStrictPositiveDecimal `xml:"j151"`
-	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier" json:"productIdentifiers"`
+	PriceType *PriceType `xml:"x462,omitempty" json:"priceType,omitempty"`
+	PriceAmount PlainText `xml:"j151" json:"priceAmount"`
+	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:"currencyCode,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Complexity is not documented.
 type Complexity struct {
-	ComplexitySchemeIdentifier ComplexitySchemeIdentifier `xml:"b077"`
-	ComplexityCode DtDotNonEmptyString `xml:"b078"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ComplexitySchemeIdentifier ComplexitySchemeIdentifier `xml:"b077" json:"complexitySchemeIdentifier"`
+	ComplexityCode PlainText `xml:"b078" json:"complexityCode"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Conference is not documented.
 type Conference struct {
-	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:",omitempty"`
-	ConferenceName DtDotNonEmptyString `xml:"b052"`
-	ConferenceAcronym *DtDotNonEmptyString `xml:"b341,omitempty" json:",omitempty"`
-	ConferenceNumber *DtDotStrictPositiveInteger `xml:"b053,omitempty" json:",omitempty"`
-	ConferenceTheme *Flow `xml:"b342,omitempty" json:",omitempty"`
-	ConferenceDate *DtDotNonEmptyString `xml:"b054,omitempty" json:",omitempty"`
-	ConferencePlace *DtDotNonEmptyString `xml:"b055,omitempty" json:",omitempty"`
-	ConferenceSponsors []ConferenceSponsor `xml:"conferencesponsor,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ConferenceRole *ConferenceRole `xml:"b051,omitempty" json:"conferenceRole,omitempty"`
+	ConferenceName PlainText `xml:"b052" json:"conferenceName"`
+	ConferenceAcronym *PlainText `xml:"b341,omitempty" json:"conferenceAcronym,omitempty"`
+	ConferenceNumber *PlainText `xml:"b053,omitempty" json:"conferenceNumber,omitempty"`
+	ConferenceTheme *Flow `xml:"b342,omitempty" json:"conferenceTheme,omitempty"`
+	ConferenceDate *PlainText `xml:"b054,omitempty" json:"conferenceDate,omitempty"`
+	ConferencePlace *PlainText `xml:"b055,omitempty" json:"conferencePlace,omitempty"`
+	ConferenceSponsors []ConferenceSponsor `xml:"conferencesponsor,omitempty" json:"conferenceSponsors,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ConferenceSponsor is not documented.
 type ConferenceSponsor struct {
-	PersonName *DtDotNonEmptyString `xml:"b036,omitempty" json:",omitempty"`
-	CorporateName *DtDotNonEmptyString `xml:"b047,omitempty" json:",omitempty"`
-	ConferenceSponsorIdentifiers []ConferenceSponsorIdentifier `xml:"conferencesponsoridentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ConferenceSponsorIdentifiers []ConferenceSponsorIdentifier `xml:"conferencesponsoridentifier,omitempty" json:"conferenceSponsorIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ConferenceSponsorIdentifier is not documented.
 type ConferenceSponsorIdentifier struct {
-	ConferenceSponsorIDType ConferenceSponsorIDType `xml:"b391"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ConferenceSponsorIDType ConferenceSponsorIDType `xml:"b391" json:"conferenceSponsorIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContentDate is not documented.
 type ContentDate struct {
-	ContentDateRole ContentDateRole `xml:"x429"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ContentDateRole ContentDateRole `xml:"x429" json:"contentDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContentDetail is not documented.
 type ContentDetail struct {
-	ContentItems []ContentItem `xml:"contentitem,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ContentItems []ContentItem `xml:"contentitem,omitempty" json:"contentItems,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContentItem is not documented.
 type ContentItem struct {
-	TextItem *TextItem `xml:"textitem,omitempty" json:",omitempty"`
-	AVItem *AVItem `xml:"avitem,omitempty" json:",omitempty"`
-	ComponentTypeName *DtDotNonEmptyString `xml:"b288,omitempty" json:",omitempty"`
-	ComponentNumber *DtDotNonEmptyString `xml:"b289,omitempty" json:",omitempty"`
-	TitleDetails []TitleDetail `xml:"titledetail,omitempty" json:",omitempty"`
-	LevelSequenceNumber *DtDotMultiLevelNumber `xml:"b284,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LevelSequenceNumber *PlainText `xml:"b284,omitempty" json:"levelSequenceNumber,omitempty"`
+	TextItem *TextItem `xml:"textitem,omitempty" json:"textItem,omitempty"`
+	AVItem *AVItem `xml:"avitem,omitempty" json:"avItem,omitempty"`
+	ComponentTypeName *PlainText `xml:"b288,omitempty" json:"componentTypeName,omitempty"`
+	ComponentNumber *PlainText `xml:"b289,omitempty" json:"componentNumber,omitempty"`
+	TitleDetails []TitleDetail `xml:"titledetail,omitempty" json:"titleDetails,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorStatements []Flow `xml:"b049,omitempty" json:"contributorStatements,omitempty"`
+	NoContributor Flag `xml:"n339,omitempty" json:"noContributor,omitempty"`
+	Subjects []Subject `xml:"subject,omitempty" json:"subjects,omitempty"`
+	NameAsSubjects []NameAsSubject `xml:"nameassubject,omitempty" json:"nameAsSubjects,omitempty"`
+	TextContents []TextContent `xml:"textcontent,omitempty" json:"textContents,omitempty"`
+	CitedContents []CitedContent `xml:"citedcontent,omitempty" json:"citedContents,omitempty"`
+	SupportingResources []SupportingResource `xml:"supportingresource,omitempty" json:"supportingResources,omitempty"`
+	RelatedWorks []RelatedWork `xml:"relatedwork,omitempty" json:"relatedWorks,omitempty"`
+	RelatedProducts []RelatedProduct `xml:"relatedproduct,omitempty" json:"relatedProducts,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Contributor is not documented.
 type Contributor struct {
-	UnnamedPersons *UnnamedPersons `xml:"b249,omitempty" json:",omitempty"`
-	Gender *Gender `xml:"x524,omitempty" json:",omitempty"`
-	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:",omitempty"`
-	AlternativeNames []AlternativeName `xml:"alternativename,omitempty" json:",omitempty"`
-	ContributorDates []ContributorDate `xml:"contributordate,omitempty" json:",omitempty"`
-	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:",omitempty"`
-	Prizes []Prize `xml:"prize,omitempty" json:",omitempty"`
-	BiographicalNotes []Flow `xml:"b044,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	ContributorDescriptions []Flow `xml:"b048,omitempty" json:",omitempty"`
-	ContributorPlaces []ContributorPlace `xml:"contributorplace,omitempty" json:",omitempty"`
-	SequenceNumber *DtDotStrictPositiveInteger `xml:"b034,omitempty" json:",omitempty"`
-	ContributorRoles []ContributorRole `xml:"b035"`
-	FromLanguages []FromLanguage `xml:"x412,omitempty" json:",omitempty"`
-	ToLanguages []ToLanguage `xml:"x413,omitempty" json:",omitempty"`
-	NameType *NameType `xml:"x414,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SequenceNumber *PlainText `xml:"b034,omitempty" json:"sequenceNumber,omitempty"`
+	ContributorRoles []ContributorRole `xml:"b035" json:"contributorRoles"`
+	FromLanguages []FromLanguage `xml:"x412,omitempty" json:"fromLanguages,omitempty"`
+	ToLanguages []ToLanguage `xml:"x413,omitempty" json:"toLanguages,omitempty"`
+	NameType *NameType `xml:"x414,omitempty" json:"nameType,omitempty"`
+	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:"nameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	Gender *Gender `xml:"x524,omitempty" json:"gender,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	CorporateNameInverted *PlainText `xml:"x443,omitempty" json:"corporateNameInverted,omitempty"`
+	UnnamedPersons *UnnamedPersons `xml:"b249,omitempty" json:"unnamedPersons,omitempty"`
+	AlternativeNames []AlternativeName `xml:"alternativename,omitempty" json:"alternativeNames,omitempty"`
+	ContributorDates []ContributorDate `xml:"contributordate,omitempty" json:"contributorDates,omitempty"`
+	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:"professionalAffiliations,omitempty"`
+	Prizes []Prize `xml:"prize,omitempty" json:"prizes,omitempty"`
+	BiographicalNotes []Flow `xml:"b044,omitempty" json:"biographicalNotes,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	ContributorDescriptions []Flow `xml:"b048,omitempty" json:"contributorDescriptions,omitempty"`
+	ContributorPlaces []ContributorPlace `xml:"contributorplace,omitempty" json:"contributorPlaces,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContributorDate is not documented.
 type ContributorDate struct {
-	ContributorDateRole ContributorDateRole `xml:"x417"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ContributorDateRole ContributorDateRole `xml:"x417" json:"contributorDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContributorPlace is not documented.
 type ContributorPlace struct {
-	RegionCode *RegionCode `xml:"b398,omitempty" json:",omitempty"`
-	CountryCode *CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	ContributorPlaceRelator ContributorPlaceRelator `xml:"x418"`
-	LocationNames []DtDotNonEmptyString `xml:"j349,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ContributorPlaceRelator ContributorPlaceRelator `xml:"x418" json:"contributorPlaceRelator"`
+	CountryCode *CountryCode `xml:"b251,omitempty" json:"countryCode,omitempty"`
+	RegionCode *RegionCode `xml:"b398,omitempty" json:"regionCode,omitempty"`
+	LocationNames []PlainText `xml:"j349,omitempty" json:"locationNames,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ContributorReference is not documented.
 type ContributorReference struct {
-	SequenceNumber *DtDotStrictPositiveInteger `xml:"b034,omitempty" json:",omitempty"`
-	ContributorRoles []ContributorRole `xml:"b035"`
-	NameIdentifiers []NameIdentifier `xml:"nameidentifier"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SequenceNumber *PlainText `xml:"b034,omitempty" json:"sequenceNumber,omitempty"`
+	ContributorRoles []ContributorRole `xml:"b035" json:"contributorRoles"`
+	NameIdentifiers []NameIdentifier `xml:"nameidentifier" json:"nameIdentifiers"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightOwner is not documented.
 type CopyrightOwner struct {
-	PersonName *DtDotNonEmptyString `xml:"b036,omitempty" json:",omitempty"`
-	CorporateName *DtDotNonEmptyString `xml:"b047,omitempty" json:",omitempty"`
-	CopyrightOwnerIdentifiers []CopyrightOwnerIdentifier `xml:"copyrightowneridentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CopyrightOwnerIdentifiers []CopyrightOwnerIdentifier `xml:"copyrightowneridentifier,omitempty" json:"copyrightOwnerIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightOwnerIdentifier is not documented.
 type CopyrightOwnerIdentifier struct {
-	CopyrightOwnerIDType CopyrightOwnerIDType `xml:"b392"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CopyrightOwnerIDType CopyrightOwnerIDType `xml:"b392" json:"copyrightOwnerIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // CopyrightStatement is not documented.
 type CopyrightStatement struct {
-	CopyrightOwners []CopyrightOwner `xml:"copyrightowner,omitempty" json:",omitempty"`
-	CopyrightYears []DtDotNonEmptyString `xml:"b087,omitempty" json:",omitempty"`
-	CopyrightType *CopyrightType `xml:"x512,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CopyrightType *CopyrightType `xml:"x512,omitempty" json:"copyrightType,omitempty"`
+	CopyrightYears []PlainText `xml:"b087,omitempty" json:"copyrightYears,omitempty"`
+	CopyrightOwners []CopyrightOwner `xml:"copyrightowner,omitempty" json:"copyrightOwners,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // DescriptiveDetail is not documented.
 type DescriptiveDetail struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductComposition ProductComposition `xml:"x314" json:"productComposition"`
+	ProductForm ProductForm `xml:"b012" json:"productForm"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:"productFormFeatures,omitempty"`
+	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:"productPackaging,omitempty"`
+	ProductFormDescriptions []PlainText `xml:"b014,omitempty" json:"productFormDescriptions,omitempty"`
+	TradeCategory *TradeCategory `xml:"b384,omitempty" json:"tradeCategory,omitempty"`
+	PrimaryContentType *PrimaryContentType `xml:"x416,omitempty" json:"primaryContentType,omitempty"`
+	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:"productContentTypes,omitempty"`
+	Measures []Measure `xml:"measure,omitempty" json:"measures,omitempty"`
+	CountryOfManufacture *CountryOfManufacture `xml:"x316,omitempty" json:"countryOfManufacture,omitempty"`
+	EpubTechnicalProtections []EpubTechnicalProtection `xml:"x317,omitempty" json:"epubTechnicalProtections,omitempty"`
+	EpubUsageConstraints []EpubUsageConstraint `xml:"epubusageconstraint,omitempty" json:"epubUsageConstraints,omitempty"`
+	EpubLicense *EpubLicense `xml:"epublicense,omitempty" json:"epubLicense,omitempty"`
+	MapScales []PlainText `xml:"b063,omitempty" json:"mapScales,omitempty"`
+	ProductClassifications []ProductClassification `xml:"productclassification,omitempty" json:"productClassifications,omitempty"`
+	ProductParts []ProductPart `xml:"productpart,omitempty" json:"productParts,omitempty"`
+	Collections []Collection `xml:"collection,omitempty" json:"collections,omitempty"`
+	NoCollection Flag `xml:"x411,omitempty" json:"noCollection,omitempty"`
+	TitleDetails []TitleDetail `xml:"titledetail" json:"titleDetails"`
+	ThesisType *ThesisType `xml:"b368,omitempty" json:"thesisType,omitempty"`
+	ThesisPresentedTo *PlainText `xml:"b369,omitempty" json:"thesisPresentedTo,omitempty"`
+	ThesisYear *PlainText `xml:"b370,omitempty" json:"thesisYear,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorStatements []Flow `xml:"b049,omitempty" json:"contributorStatements,omitempty"`
+	NoContributor Flag `xml:"n339,omitempty" json:"noContributor,omitempty"`
+	Events []Event `xml:"event,omitempty" json:"events,omitempty"`
+	Conferences []Conference `xml:"conference,omitempty" json:"conferences,omitempty"`
+	EditionTypes []EditionType `xml:"x419,omitempty" json:"editionTypes,omitempty"`
+	EditionNumber *PlainText `xml:"b057,omitempty" json:"editionNumber,omitempty"`
+	EditionVersionNumber *PlainText `xml:"b217,omitempty" json:"editionVersionNumber,omitempty"`
+	EditionStatements []Flow `xml:"b058,omitempty" json:"editionStatements,omitempty"`
+	NoEdition Flag `xml:"n386,omitempty" json:"noEdition,omitempty"`
+	ReligiousText *ReligiousText `xml:"religioustext,omitempty" json:"religiousText,omitempty"`
+	Languages []Language `xml:"language,omitempty" json:"languages,omitempty"`
+	Extents []Extent `xml:"extent,omitempty" json:"extents,omitempty"`
+	Illustrated *Illustrated `xml:"x422,omitempty" json:"illustrated,omitempty"`
+	NumberOfIllustrations *PlainText `xml:"b125,omitempty" json:"numberOfIllustrations,omitempty"`
+	IllustrationsNotes []Flow `xml:"b062,omitempty" json:"illustrationsNotes,omitempty"`
+	AncillaryContents []AncillaryContent `xml:"ancillarycontent,omitempty" json:"ancillaryContents,omitempty"`
+	Subjects []Subject `xml:"subject,omitempty" json:"subjects,omitempty"`
+	NameAsSubjects []NameAsSubject `xml:"nameassubject,omitempty" json:"nameAsSubjects,omitempty"`
+	AudienceCodes []AudienceCode `xml:"b073,omitempty" json:"audienceCodes,omitempty"`
+	Audiences []Audience `xml:"audience,omitempty" json:"audiences,omitempty"`
+	AudienceRanges []AudienceRange `xml:"audiencerange,omitempty" json:"audienceRanges,omitempty"`
+	AudienceDescriptions []Flow `xml:"x432,omitempty" json:"audienceDescriptions,omitempty"`
+	Complexitys []Complexity `xml:"complexity,omitempty" json:"complexitys,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Discount is not documented.
 type Discount struct {
-	DiscountAmount *DtDotPositiveDecimal `xml:"x469,omitempty" json:",omitempty"`
-	DiscountPercent *DtDotPercentDecimal `xml:"j267,omitempty" json:",omitempty"`
-	DiscountType *DiscountType `xml:"x467,omitempty" json:",omitempty"`
-	Quantity *DtDotPositiveDecimal `xml:"x320,omitempty" json:",omitempty"`
-	ToQuantity *DtDotPositiveDecimal `xml:"x514,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	DiscountType *DiscountType `xml:"x467,omitempty" json:"discountType,omitempty"`
+	Quantity *PlainText `xml:"x320,omitempty" json:"quantity,omitempty"`
+	ToQuantity *PlainText `xml:"x514,omitempty" json:"toQuantity,omitempty"`
+	DiscountPercent *PlainText `xml:"j267,omitempty" json:"discountPercent,omitempty"`
+	DiscountAmount *PlainText `xml:"x469,omitempty" json:"discountAmount,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // DiscountCoded is not documented.
 type DiscountCoded struct {
-	DiscountCodeType DiscountCodeType `xml:"j363"`
-	DiscountCodeTypeName *DtDotNonEmptyString `xml:"j378,omitempty" json:",omitempty"`
-	DiscountCode DtDotNonEmptyString `xml:"j364"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	DiscountCodeType DiscountCodeType `xml:"j363" json:"discountCodeType"`
+	DiscountCodeTypeName *PlainText `xml:"j378,omitempty" json:"discountCodeTypeName,omitempty"`
+	DiscountCode PlainText `xml:"j364" json:"discountCode"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EpubLicense is not documented.
 type EpubLicense struct {
-	EpubLicenseNames []DtDotNonEmptyString `xml:"x511"`
-	EpubLicenseExpressions []EpubLicenseExpression `xml:"epublicenseexpression,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EpubLicenseNames []PlainText `xml:"x511" json:"epubLicenseNames"`
+	EpubLicenseExpressions []EpubLicenseExpression `xml:"epublicenseexpression,omitempty" json:"epubLicenseExpressions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EpubLicenseExpression is not documented.
 type EpubLicenseExpression struct {
-	EpubLicenseExpressionType EpubLicenseExpressionType `xml:"x508"`
-	EpubLicenseExpressionTypeName *DtDotNonEmptyString `xml:"x509,omitempty" json:",omitempty"`
-	EpubLicenseExpressionLink DtDotNonEmptyURI `xml:"x510"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EpubLicenseExpressionType EpubLicenseExpressionType `xml:"x508" json:"epubLicenseExpressionType"`
+	EpubLicenseExpressionTypeName *PlainText `xml:"x509,omitempty" json:"epubLicenseExpressionTypeName,omitempty"`
+	EpubLicenseExpressionLink PlainText `xml:"x510" json:"epubLicenseExpressionLink"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EpubUsageConstraint is not documented.
 type EpubUsageConstraint struct {
-	EpubUsageType EpubUsageType `xml:"x318"`
-	EpubUsageStatus EpubUsageStatus `xml:"x319"`
-	EpubUsageLimits []EpubUsageLimit `xml:"epubusagelimit,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EpubUsageType EpubUsageType `xml:"x318" json:"epubUsageType"`
+	EpubUsageStatus EpubUsageStatus `xml:"x319" json:"epubUsageStatus"`
+	EpubUsageLimits []EpubUsageLimit `xml:"epubusagelimit,omitempty" json:"epubUsageLimits,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EpubUsageLimit is not documented.
 type EpubUsageLimit struct {
-	Quantity DtDotPositiveDecimal `xml:"x320"`
-	EpubUsageUnit EpubUsageUnit `xml:"x321"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Quantity PlainText `xml:"x320" json:"quantity"`
+	EpubUsageUnit EpubUsageUnit `xml:"x321" json:"epubUsageUnit"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Event is not documented.
 type Event struct {
-	EventRole EventRole `xml:"x515"`
-	EventNames []DtDotNonEmptyString `xml:"x516"`
-	EventAcronyms []DtDotNonEmptyString `xml:"x517,omitempty" json:",omitempty"`
-	EventNumber *DtDotStrictPositiveInteger `xml:"x518,omitempty" json:",omitempty"`
-	EventThemes []DtDotNonEmptyString `xml:"x519,omitempty" json:",omitempty"`
-	EventDate *DtDotNonEmptyString `xml:"x520,omitempty" json:",omitempty"`
-	EventPlaces []DtDotNonEmptyString `xml:"x521,omitempty" json:",omitempty"`
-	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventRole EventRole `xml:"x515" json:"eventRole"`
+	EventNames []PlainText `xml:"x516" json:"eventNames"`
+	EventAcronyms []PlainText `xml:"x517,omitempty" json:"eventAcronyms,omitempty"`
+	EventNumber *PlainText `xml:"x518,omitempty" json:"eventNumber,omitempty"`
+	EventThemes []PlainText `xml:"x519,omitempty" json:"eventThemes,omitempty"`
+	EventDate *PlainText `xml:"x520,omitempty" json:"eventDate,omitempty"`
+	EventPlaces []PlainText `xml:"x521,omitempty" json:"eventPlaces,omitempty"`
+	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:"eventSponsors,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EventIdentifier is not documented.
 type EventIdentifier struct {
-	EventIDType EventIDType `xml:"x547"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventIDType EventIDType `xml:"x547" json:"eventIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EventOccurrence is not documented.
 type EventOccurrence struct {
-	EventIdentifiers []EventIdentifier `xml:"eventidentifier,omitempty" json:",omitempty"`
-	OccurrenceDates []OccurrenceDate `xml:"occurrencedate"`
-	EventStatus EventStatus `xml:"x549"`
-	EventDescriptions []Flow `xml:"x550,omitempty" json:",omitempty"`
-	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	RegionCode *RegionCode `xml:"b398,omitempty" json:",omitempty"`
-	CountryCode *CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	LocationNames []DtDotNonEmptyString `xml:"j349,omitempty" json:",omitempty"`
-	VenueName *DtDotNonEmptyString `xml:"x551,omitempty" json:",omitempty"`
-	StreetAddress *DtDotNonEmptyString `xml:"x552,omitempty" json:",omitempty"`
-	VenueNotes []Flow `xml:"x553,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventIdentifiers []EventIdentifier `xml:"eventidentifier,omitempty" json:"eventIdentifiers,omitempty"`
+	OccurrenceDates []OccurrenceDate `xml:"occurrencedate" json:"occurrenceDates"`
+	EventStatus EventStatus `xml:"x549" json:"eventStatus"`
+	CountryCode *CountryCode `xml:"b251,omitempty" json:"countryCode,omitempty"`
+	RegionCode *RegionCode `xml:"b398,omitempty" json:"regionCode,omitempty"`
+	LocationNames []PlainText `xml:"j349,omitempty" json:"locationNames,omitempty"`
+	VenueName *PlainText `xml:"x551,omitempty" json:"venueName,omitempty"`
+	StreetAddress *PlainText `xml:"x552,omitempty" json:"streetAddress,omitempty"`
+	VenueNotes []Flow `xml:"x553,omitempty" json:"venueNotes,omitempty"`
+	EventDescriptions []Flow `xml:"x550,omitempty" json:"eventDescriptions,omitempty"`
+	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:"eventSponsors,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EventSponsor is not documented.
 type EventSponsor struct {
-	PersonName *DtDotNonEmptyString `xml:"b036,omitempty" json:",omitempty"`
-	CorporateName *DtDotNonEmptyString `xml:"b047,omitempty" json:",omitempty"`
-	EventSponsorIdentifiers []EventSponsorIdentifier `xml:"eventsponsoridentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventSponsorIdentifiers []EventSponsorIdentifier `xml:"eventsponsoridentifier,omitempty" json:"eventSponsorIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // EventSponsorIdentifier is not documented.
 type EventSponsorIdentifier struct {
-	EventSponsorIDType EventSponsorIDType `xml:"x522"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventSponsorIDType EventSponsorIDType `xml:"x522" json:"eventSponsorIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Extent is not documented.
 type Extent struct {
-	ExtentValueRoman *DtDotRomanNumeralString `xml:"x421,omitempty" json:",omitempty"`
-	ExtentValue *DtDotStrictPositiveDecimal `xml:"b219,omitempty" json:",omitempty"`
-	ExtentType ExtentType `xml:"b218"`
-	ExtentUnit ExtentUnit `xml:"b220"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ExtentType ExtentType `xml:"b218" json:"extentType"`
+	ExtentValue *PlainText `xml:"b219,omitempty" json:"extentValue,omitempty"`
+	ExtentValueRoman *PlainText `xml:"x421,omitempty" json:"extentValueRoman,omitempty"`
+	ExtentUnit ExtentUnit `xml:"b220" json:"extentUnit"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Funding is not documented.
 type Funding struct {
-	FundingIdentifiers []FundingIdentifier `xml:"fundingidentifier"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	FundingIdentifiers []FundingIdentifier `xml:"fundingidentifier" json:"fundingIdentifiers"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // FundingIdentifier is not documented.
 type FundingIdentifier struct {
-	FundingIDType FundingIDType `xml:"x523"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	FundingIDType FundingIDType `xml:"x523" json:"fundingIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Header is not documented.
 type Header struct {
-	Sender Sender `xml:"sender"`
-	Addressees []Addressee `xml:"addressee,omitempty" json:",omitempty"`
-	MessageNumber *DtDotStrictPositiveInteger `xml:"m180,omitempty" json:",omitempty"`
-	MessageRepeat *DtDotStrictPositiveInteger `xml:"m181,omitempty" json:",omitempty"`
-	SentDateTime DtDotDateOrDateTime `xml:"x307"`
-	MessageNotes []DtDotNonEmptyString `xml:"m183,omitempty" json:",omitempty"`
-	DefaultLanguageOfText *DefaultLanguageOfText `xml:"m184,omitempty" json:",omitempty"`
-	DefaultPriceType *DefaultPriceType `xml:"x310,omitempty" json:",omitempty"`
-	DefaultCurrencyCode *DefaultCurrencyCode `xml:"m186,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Sender Sender `xml:"sender" json:"sender"`
+	Addressees []Addressee `xml:"addressee,omitempty" json:"addressees,omitempty"`
+	MessageNumber *PlainText `xml:"m180,omitempty" json:"messageNumber,omitempty"`
+	MessageRepeat *PlainText `xml:"m181,omitempty" json:"messageRepeat,omitempty"`
+	SentDateTime PlainText `xml:"x307" json:"sentDateTime"`
+	MessageNotes []PlainText `xml:"m183,omitempty" json:"messageNotes,omitempty"`
+	DefaultLanguageOfText *DefaultLanguageOfText `xml:"m184,omitempty" json:"defaultLanguageOfText,omitempty"`
+	DefaultPriceType *DefaultPriceType `xml:"x310,omitempty" json:"defaultPriceType,omitempty"`
+	DefaultCurrencyCode *DefaultCurrencyCode `xml:"m186,omitempty" json:"defaultCurrencyCode,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Imprint is not documented.
 type Imprint struct {
-	ImprintName *DtDotNonEmptyString `xml:"b079,omitempty" json:",omitempty"`
-	ImprintIdentifiers []ImprintIdentifier `xml:"imprintidentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ImprintIdentifiers []ImprintIdentifier `xml:"imprintidentifier,omitempty" json:"imprintIdentifiers,omitempty"`
+	ImprintName *PlainText `xml:"b079,omitempty" json:"imprintName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ImprintIdentifier is not documented.
 type ImprintIdentifier struct {
-	ImprintIDType ImprintIDType `xml:"x445"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ImprintIDType ImprintIDType `xml:"x445" json:"imprintIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Language is not documented.
 type Language struct {
-	LanguageRole LanguageRole `xml:"b253"`
-	LanguageCode LanguageCode `xml:"b252"`
-	CountryCode *CountryCode `xml:"b251,omitempty" json:",omitempty"`
-	RegionCode *RegionCode `xml:"b398,omitempty" json:",omitempty"`
-	ScriptCode *ScriptCode `xml:"x420,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LanguageRole LanguageRole `xml:"b253" json:"languageRole"`
+	LanguageCode LanguageCode `xml:"b252" json:"languageCode"`
+	CountryCode *CountryCode `xml:"b251,omitempty" json:"countryCode,omitempty"`
+	RegionCode *RegionCode `xml:"b398,omitempty" json:"regionCode,omitempty"`
+	ScriptCode *ScriptCode `xml:"x420,omitempty" json:"scriptCode,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // LocationIdentifier is not documented.
 type LocationIdentifier struct {
-	LocationIDType LocationIDType `xml:"j377"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// MainSubject is not documented.
-type MainSubject struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LocationIDType LocationIDType `xml:"j377" json:"locationIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Market is not documented.
 type Market struct {
-	Territory Territory `xml:"territory"`
-	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Territory Territory `xml:"territory" json:"territory"`
+	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:"salesRestrictions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MarketDate is not documented.
 type MarketDate struct {
-	MarketDateRole MarketDateRole `xml:"j408"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	MarketDateRole MarketDateRole `xml:"j408" json:"marketDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // MarketPublishingDetail is not documented.
 type MarketPublishingDetail struct {
-	PublisherRepresentatives []PublisherRepresentative `xml:"publisherrepresentative,omitempty" json:",omitempty"`
-	ProductContacts []ProductContact `xml:"productcontact,omitempty" json:",omitempty"`
-	MarketPublishingStatus MarketPublishingStatus `xml:"j407"`
-	MarketPublishingStatusNotes []Flow `xml:"x406,omitempty" json:",omitempty"`
-	MarketDates []MarketDate `xml:"marketdate,omitempty" json:",omitempty"`
-	PromotionCampaigns []Flow `xml:"k165,omitempty" json:",omitempty"`
-	PromotionContact *Flow `xml:"k166,omitempty" json:",omitempty"`
-	InitialPrintRuns []Flow `xml:"k167,omitempty" json:",omitempty"`
-	ReprintDetails []Flow `xml:"k309,omitempty" json:",omitempty"`
-	CopiesSolds []Flow `xml:"k168,omitempty" json:",omitempty"`
-	BookClubAdoptions []Flow `xml:"k169,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PublisherRepresentatives []PublisherRepresentative `xml:"publisherrepresentative,omitempty" json:"publisherRepresentatives,omitempty"`
+	ProductContacts []ProductContact `xml:"productcontact,omitempty" json:"productContacts,omitempty"`
+	MarketPublishingStatus MarketPublishingStatus `xml:"j407" json:"marketPublishingStatus"`
+	MarketPublishingStatusNotes []Flow `xml:"x406,omitempty" json:"marketPublishingStatusNotes,omitempty"`
+	MarketDates []MarketDate `xml:"marketdate,omitempty" json:"marketDates,omitempty"`
+	PromotionCampaigns []Flow `xml:"k165,omitempty" json:"promotionCampaigns,omitempty"`
+	PromotionContact *Flow `xml:"k166,omitempty" json:"promotionContact,omitempty"`
+	InitialPrintRuns []Flow `xml:"k167,omitempty" json:"initialPrintRuns,omitempty"`
+	ReprintDetails []Flow `xml:"k309,omitempty" json:"reprintDetails,omitempty"`
+	CopiesSolds []Flow `xml:"k168,omitempty" json:"copiesSolds,omitempty"`
+	BookClubAdoptions []Flow `xml:"k169,omitempty" json:"bookClubAdoptions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Measure is not documented.
 type Measure struct {
-	MeasureType MeasureType `xml:"x315"`
-	Measurement DtDotStrictPositiveDecimal `xml:"c094"`
-	MeasureUnitCode MeasureUnitCode `xml:"c095"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	MeasureType MeasureType `xml:"x315" json:"measureType"`
+	Measurement PlainText `xml:"c094" json:"measurement"`
+	MeasureUnitCode MeasureUnitCode `xml:"c095" json:"measureUnitCode"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // NameAsSubject is not documented.
 type NameAsSubject struct {
-	Gender *Gender `xml:"x524,omitempty" json:",omitempty"`
-	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:",omitempty"`
-	NameType *NameType `xml:"x414,omitempty" json:",omitempty"`
-	AlternativeNames []AlternativeName `xml:"alternativename,omitempty" json:",omitempty"`
-	SubjectDates []SubjectDate `xml:"subjectdate,omitempty" json:",omitempty"`
-	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	NameType *NameType `xml:"x414,omitempty" json:"nameType,omitempty"`
+	NameIdentifiers []NameIdentifier `xml:"nameidentifier,omitempty" json:"nameIdentifiers,omitempty"`
+	PersonName *PlainText `xml:"b036,omitempty" json:"personName,omitempty"`
+	PersonNameInverted *PlainText `xml:"b037,omitempty" json:"personNameInverted,omitempty"`
+	TitlesBeforeNames *PlainText `xml:"b038,omitempty" json:"titlesBeforeNames,omitempty"`
+	NamesBeforeKey *PlainText `xml:"b039,omitempty" json:"namesBeforeKey,omitempty"`
+	PrefixToKey *PlainText `xml:"b247,omitempty" json:"prefixToKey,omitempty"`
+	KeyNames *PlainText `xml:"b040,omitempty" json:"keyNames,omitempty"`
+	NamesAfterKey *PlainText `xml:"b041,omitempty" json:"namesAfterKey,omitempty"`
+	SuffixToKey *PlainText `xml:"b248,omitempty" json:"suffixToKey,omitempty"`
+	LettersAfterNames *PlainText `xml:"b042,omitempty" json:"lettersAfterNames,omitempty"`
+	TitlesAfterNames *PlainText `xml:"b043,omitempty" json:"titlesAfterNames,omitempty"`
+	Gender *Gender `xml:"x524,omitempty" json:"gender,omitempty"`
+	CorporateName *PlainText `xml:"b047,omitempty" json:"corporateName,omitempty"`
+	CorporateNameInverted *PlainText `xml:"x443,omitempty" json:"corporateNameInverted,omitempty"`
+	AlternativeNames []AlternativeName `xml:"alternativename,omitempty" json:"alternativeNames,omitempty"`
+	SubjectDates []SubjectDate `xml:"subjectdate,omitempty" json:"subjectDates,omitempty"`
+	ProfessionalAffiliations []ProfessionalAffiliation `xml:"professionalaffiliation,omitempty" json:"professionalAffiliations,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // NameIdentifier is not documented.
 type NameIdentifier struct {
-	NameIDType NameIDType `xml:"x415"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	NameIDType NameIDType `xml:"x415" json:"nameIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // NewSupplier is not documented.
 type NewSupplier struct {
-	SupplierName *DtDotNonEmptyString `xml:"j137,omitempty" json:",omitempty"`
-	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:",omitempty"`
-	TelephoneNumbers []DtDotNonEmptyString `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []DtDotNonEmptyString `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoCollection is not documented.
-type NoCollection struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoContributor is not documented.
-type NoContributor struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoEdition is not documented.
-type NoEdition struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoPrefix is not documented.
-type NoPrefix struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// NoProduct is not documented.
-type NoProduct struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:"supplierIdentifiers,omitempty"`
+	SupplierName *PlainText `xml:"j137,omitempty" json:"supplierName,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ONIXMessage is not documented.
 type ONIXMessage struct {
-	NoProduct *NoProduct `xml:"x507,omitempty" json:",omitempty"`
-	Products []Product `xml:"product,omitempty" json:",omitempty"`
-	Header Header `xml:"header"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-	Release Release `xml:"release,attr"`
+	Header Header `xml:"header" json:"header"`
+	NoProduct Flag `xml:"x507,omitempty" json:"noProduct,omitempty"`
+	Products []Product `xml:"product,omitempty" json:"products,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Release Release `xml:"release,attr" json:"release"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // OccurrenceDate is not documented.
 type OccurrenceDate struct {
-	OccurrenceDateRole OccurrenceDateRole `xml:"x554"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	OccurrenceDateRole OccurrenceDateRole `xml:"x554" json:"occurrenceDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // OnOrderDetail is not documented.
 type OnOrderDetail struct {
-	OnOrder DtDotPositiveInteger `xml:"j351"`
-	Proximity *Proximity `xml:"x502,omitempty" json:",omitempty"`
-	ExpectedDate DtDotNonEmptyString `xml:"j302"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	OnOrder PlainText `xml:"j351" json:"onOrder"`
+	Proximity *Proximity `xml:"x502,omitempty" json:"proximity,omitempty"`
+	ExpectedDate PlainText `xml:"j302" json:"expectedDate"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PageRun is not documented.
 type PageRun struct {
-	FirstPageNumber DtDotNonEmptyString `xml:"b286"`
-	LastPageNumber *DtDotNonEmptyString `xml:"b287,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	FirstPageNumber PlainText `xml:"b286" json:"firstPageNumber"`
+	LastPageNumber *PlainText `xml:"b287,omitempty" json:"lastPageNumber,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Price is not documented.
 type Price struct {
-	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:",omitempty"`
-	PriceAmount *DtDotStrictPositiveDecimal `xml:"j151,omitempty" json:",omitempty"`
-	PriceCoded *PriceCoded `xml:"pricecoded,omitempty" json:",omitempty"`
-	Taxs []Tax `xml:"tax,omitempty" json:",omitempty"`
-	TaxExempt *TaxExempt `xml:"x546,omitempty" json:",omitempty"`
-	PriceIdentifiers []PriceIdentifier `xml:"priceidentifier,omitempty" json:",omitempty"`
-	PriceType *PriceType `xml:"x462,omitempty" json:",omitempty"`
-	PriceQualifier *PriceQualifier `xml:"j261,omitempty" json:",omitempty"`
-	EpubTechnicalProtections []EpubTechnicalProtection `xml:"x317,omitempty" json:",omitempty"`
-	PriceConstraints []PriceConstraint `xml:"priceconstraint,omitempty" json:",omitempty"`
-	EpubLicense *EpubLicense `xml:"epublicense,omitempty" json:",omitempty"`
-	PriceTypeDescriptions []DtDotNonEmptyString `xml:"j262,omitempty" json:",omitempty"`
-	PricePer *PricePer `xml:"j239,omitempty" json:",omitempty"`
-	PriceConditions []PriceCondition `xml:"pricecondition,omitempty" json:",omitempty"`
-	MinimumOrderQuantity *DtDotStrictPositiveInteger `xml:"j263,omitempty" json:",omitempty"`
-	BatchBonuss []BatchBonus `xml:"batchbonus,omitempty" json:",omitempty"`
-	DiscountCodeds []DiscountCoded `xml:"discountcoded,omitempty" json:",omitempty"`
-	Discounts []Discount `xml:"discount,omitempty" json:",omitempty"`
-	PriceStatus *PriceStatus `xml:"j266,omitempty" json:",omitempty"`
-	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:",omitempty"`
-	Territory *Territory `xml:"territory,omitempty" json:",omitempty"`
-	CurrencyZone *CurrencyZone `xml:"x475,omitempty" json:",omitempty"`
-	ComparisonProductPrices []ComparisonProductPrice `xml:"comparisonproductprice,omitempty" json:",omitempty"`
-	PriceDates []PriceDate `xml:"pricedate,omitempty" json:",omitempty"`
-	PrintedOnProduct *PrintedOnProduct `xml:"x301,omitempty" json:",omitempty"`
-	PositionOnProduct *PositionOnProduct `xml:"x313,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceIdentifiers []PriceIdentifier `xml:"priceidentifier,omitempty" json:"priceIdentifiers,omitempty"`
+	PriceType *PriceType `xml:"x462,omitempty" json:"priceType,omitempty"`
+	PriceQualifier *PriceQualifier `xml:"j261,omitempty" json:"priceQualifier,omitempty"`
+	EpubTechnicalProtections []EpubTechnicalProtection `xml:"x317,omitempty" json:"epubTechnicalProtections,omitempty"`
+	PriceConstraints []PriceConstraint `xml:"priceconstraint,omitempty" json:"priceConstraints,omitempty"`
+	EpubLicense *EpubLicense `xml:"epublicense,omitempty" json:"epubLicense,omitempty"`
+	PriceTypeDescriptions []PlainText `xml:"j262,omitempty" json:"priceTypeDescriptions,omitempty"`
+	PricePer *PricePer `xml:"j239,omitempty" json:"pricePer,omitempty"`
+	PriceConditions []PriceCondition `xml:"pricecondition,omitempty" json:"priceConditions,omitempty"`
+	MinimumOrderQuantity *PlainText `xml:"j263,omitempty" json:"minimumOrderQuantity,omitempty"`
+	BatchBonuss []BatchBonus `xml:"batchbonus,omitempty" json:"batchBonuss,omitempty"`
+	DiscountCodeds []DiscountCoded `xml:"discountcoded,omitempty" json:"discountCodeds,omitempty"`
+	Discounts []Discount `xml:"discount,omitempty" json:"discounts,omitempty"`
+	PriceStatus *PriceStatus `xml:"j266,omitempty" json:"priceStatus,omitempty"`
+	PriceAmount *PlainText `xml:"j151,omitempty" json:"priceAmount,omitempty"`
+	PriceCoded *PriceCoded `xml:"pricecoded,omitempty" json:"priceCoded,omitempty"`
+	Taxs []Tax `xml:"tax,omitempty" json:"taxs,omitempty"`
+	TaxExempt Flag `xml:"x546,omitempty" json:"taxExempt,omitempty"`
+	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:"unpricedItemType,omitempty"`
+	CurrencyCode *CurrencyCode `xml:"j152,omitempty" json:"currencyCode,omitempty"`
+	Territory *Territory `xml:"territory,omitempty" json:"territory,omitempty"`
+	CurrencyZone *CurrencyZone `xml:"x475,omitempty" json:"currencyZone,omitempty"`
+	ComparisonProductPrices []ComparisonProductPrice `xml:"comparisonproductprice,omitempty" json:"comparisonProductPrices,omitempty"`
+	PriceDates []PriceDate `xml:"pricedate,omitempty" json:"priceDates,omitempty"`
+	PrintedOnProduct *PrintedOnProduct `xml:"x301,omitempty" json:"printedOnProduct,omitempty"`
+	PositionOnProduct *PositionOnProduct `xml:"x313,omitempty" json:"positionOnProduct,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceCoded is not documented.
 type PriceCoded struct {
-	PriceCodeType PriceCodeType `xml:"x465"`
-	PriceCodeTypeName *DtDotNonEmptyString `xml:"x477,omitempty" json:",omitempty"`
-	PriceCode DtDotNonEmptyString `xml:"x468"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceCodeType PriceCodeType `xml:"x465" json:"priceCodeType"`
+	PriceCodeTypeName *PlainText `xml:"x477,omitempty" json:"priceCodeTypeName,omitempty"`
+	PriceCode PlainText `xml:"x468" json:"priceCode"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceCondition is not documented.
 type PriceCondition struct {
-	PriceConditionType PriceConditionType `xml:"x463"`
-	PriceConditionQuantitys []PriceConditionQuantity `xml:"priceconditionquantity,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceConditionType PriceConditionType `xml:"x463" json:"priceConditionType"`
+	PriceConditionQuantitys []PriceConditionQuantity `xml:"priceconditionquantity,omitempty" json:"priceConditionQuantitys,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceConditionQuantity is not documented.
 type PriceConditionQuantity struct {
-	PriceConditionQuantityType PriceConditionQuantityType `xml:"x464"`
-	Quantity DtDotPositiveDecimal `xml:"x320"`
-	QuantityUnit QuantityUnit `xml:"x466"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceConditionQuantityType PriceConditionQuantityType `xml:"x464" json:"priceConditionQuantityType"`
+	Quantity PlainText `xml:"x320" json:"quantity"`
+	QuantityUnit QuantityUnit `xml:"x466" json:"quantityUnit"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceConstraint is not documented.
 type PriceConstraint struct {
-	PriceConstraintType PriceConstraintType `xml:"x529"`
-	PriceConstraintStatus PriceConstraintStatus `xml:"x530"`
-	PriceConstraintLimits []PriceConstraintLimit `xml:"priceconstraintlimit,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceConstraintType PriceConstraintType `xml:"x529" json:"priceConstraintType"`
+	PriceConstraintStatus PriceConstraintStatus `xml:"x530" json:"priceConstraintStatus"`
+	PriceConstraintLimits []PriceConstraintLimit `xml:"priceconstraintlimit,omitempty" json:"priceConstraintLimits,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceConstraintLimit is not documented.
 type PriceConstraintLimit struct {
-	Quantity DtDotPositiveDecimal `xml:"x320"`
-	PriceConstraintUnit PriceConstraintUnit `xml:"x531"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Quantity PlainText `xml:"x320" json:"quantity"`
+	PriceConstraintUnit PriceConstraintUnit `xml:"x531" json:"priceConstraintUnit"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceDate is not documented.
 type PriceDate struct {
-	PriceDateRole PriceDateRole `xml:"x476"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceDateRole PriceDateRole `xml:"x476" json:"priceDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PriceIdentifier is not documented.
 type PriceIdentifier struct {
-	PriceIDType PriceIDType `xml:"x506"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// PrimaryPart is not documented.
-type PrimaryPart struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PriceIDType PriceIDType `xml:"x506" json:"priceIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Prize is not documented.
 type Prize struct {
-	PrizeNames []DtDotNonEmptyString `xml:"g126"`
-	PrizeYear *DtDotYear `xml:"g127,omitempty" json:",omitempty"`
-	PrizeCountry *PrizeCountry `xml:"g128,omitempty" json:",omitempty"`
-	PrizeRegion *PrizeRegion `xml:"x556,omitempty" json:",omitempty"`
-	PrizeCode *PrizeCode `xml:"g129,omitempty" json:",omitempty"`
-	PrizeStatements []Flow `xml:"x503,omitempty" json:",omitempty"`
-	PrizeJurys []Flow `xml:"g343,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PrizeNames []PlainText `xml:"g126" json:"prizeNames"`
+	PrizeYear *PlainText `xml:"g127,omitempty" json:"prizeYear,omitempty"`
+	PrizeCountry *PrizeCountry `xml:"g128,omitempty" json:"prizeCountry,omitempty"`
+	PrizeRegion *PrizeRegion `xml:"x556,omitempty" json:"prizeRegion,omitempty"`
+	PrizeCode *PrizeCode `xml:"g129,omitempty" json:"prizeCode,omitempty"`
+	PrizeStatements []Flow `xml:"x503,omitempty" json:"prizeStatements,omitempty"`
+	PrizeJurys []Flow `xml:"g343,omitempty" json:"prizeJurys,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Product is not documented.
 type Product struct {
-	DescriptiveDetail *DescriptiveDetail `xml:"descriptivedetail,omitempty" json:",omitempty"`
-	CollateralDetail *CollateralDetail `xml:"collateraldetail,omitempty" json:",omitempty"`
-	PromotionDetail *PromotionDetail `xml:"promotiondetail,omitempty" json:",omitempty"`
-	ContentDetail *ContentDetail `xml:"contentdetail,omitempty" json:",omitempty"`
-	PublishingDetail *PublishingDetail `xml:"publishingdetail,omitempty" json:",omitempty"`
-	RelatedMaterial *RelatedMaterial `xml:"relatedmaterial,omitempty" json:",omitempty"`
-	ProductSupplys []ProductSupply `xml:"productsupply,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RecordReference PlainText `xml:"a001" json:"recordReference"`
+	NotificationType NotificationType `xml:"a002" json:"notificationType"`
+	DeletionTexts []PlainText `xml:"a199,omitempty" json:"deletionTexts,omitempty"`
+	RecordSourceType *RecordSourceType `xml:"a194,omitempty" json:"recordSourceType,omitempty"`
+	RecordSourceIdentifiers []RecordSourceIdentifier `xml:"recordsourceidentifier,omitempty" json:"recordSourceIdentifiers,omitempty"`
+	RecordSourceName *PlainText `xml:"a197,omitempty" json:"recordSourceName,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier" json:"productIdentifiers"`
+	Barcodes []Barcode `xml:"barcode,omitempty" json:"barcodes,omitempty"`
+	DescriptiveDetail *DescriptiveDetail `xml:"descriptivedetail,omitempty" json:"descriptiveDetail,omitempty"`
+	CollateralDetail *CollateralDetail `xml:"collateraldetail,omitempty" json:"collateralDetail,omitempty"`
+	PromotionDetail *PromotionDetail `xml:"promotiondetail,omitempty" json:"promotionDetail,omitempty"`
+	ContentDetail *ContentDetail `xml:"contentdetail,omitempty" json:"contentDetail,omitempty"`
+	PublishingDetail *PublishingDetail `xml:"publishingdetail,omitempty" json:"publishingDetail,omitempty"`
+	RelatedMaterial *RelatedMaterial `xml:"relatedmaterial,omitempty" json:"relatedMaterial,omitempty"`
+	ProductSupplys []ProductSupply `xml:"productsupply,omitempty" json:"productSupplys,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductClassification is not documented.
 type ProductClassification struct {
-	ProductClassificationType ProductClassificationType `xml:"b274"`
-	ProductClassificationTypeName *DtDotNonEmptyString `xml:"x555,omitempty" json:",omitempty"`
-	ProductClassificationCode DtDotNonEmptyString `xml:"b275"`
-	Percent *DtDotPercentDecimal `xml:"b337,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductClassificationType ProductClassificationType `xml:"b274" json:"productClassificationType"`
+	ProductClassificationTypeName *PlainText `xml:"x555,omitempty" json:"productClassificationTypeName,omitempty"`
+	ProductClassificationCode PlainText `xml:"b275" json:"productClassificationCode"`
+	Percent *PlainText `xml:"b337,omitempty" json:"percent,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductContact is not documented.
 type ProductContact struct {
-	ProductContactName *DtDotNonEmptyString `xml:"x484,omitempty" json:",omitempty"`
-	ProductContactIdentifiers []ProductContactIdentifier `xml:"productcontactidentifier,omitempty" json:",omitempty"`
-	ProductContactRole ProductContactRole `xml:"x482"`
-	ContactName *DtDotNonEmptyString `xml:"x299,omitempty" json:",omitempty"`
-	EmailAddress *DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductContactRole ProductContactRole `xml:"x482" json:"productContactRole"`
+	ProductContactIdentifiers []ProductContactIdentifier `xml:"productcontactidentifier,omitempty" json:"productContactIdentifiers,omitempty"`
+	ProductContactName *PlainText `xml:"x484,omitempty" json:"productContactName,omitempty"`
+	ContactName *PlainText `xml:"x299,omitempty" json:"contactName,omitempty"`
+	EmailAddress *PlainText `xml:"j272,omitempty" json:"emailAddress,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductContactIdentifier is not documented.
 type ProductContactIdentifier struct {
-	ProductContactIDType ProductContactIDType `xml:"x483"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductContactIDType ProductContactIDType `xml:"x483" json:"productContactIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductFormFeature is not documented.
 type ProductFormFeature struct {
-	ProductFormFeatureType ProductFormFeatureType `xml:"b334"`
-	ProductFormFeatureValue *DtDotNonEmptyString `xml:"b335,omitempty" json:",omitempty"`
-	ProductFormFeatureDescriptions []DtDotNonEmptyString `xml:"b336,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductFormFeatureType ProductFormFeatureType `xml:"b334" json:"productFormFeatureType"`
+	ProductFormFeatureValue *PlainText `xml:"b335,omitempty" json:"productFormFeatureValue,omitempty"`
+	ProductFormFeatureDescriptions []PlainText `xml:"b336,omitempty" json:"productFormFeatureDescriptions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductIdentifier is not documented.
 type ProductIdentifier struct {
-	ProductIDType ProductIDType `xml:"b221"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductIDType ProductIDType `xml:"b221" json:"productIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductPart is not documented.
 type ProductPart struct {
-	NumberOfCopies *DtDotStrictPositiveInteger `xml:"x323,omitempty" json:",omitempty"`
-	NumberOfItemsOfThisForm *DtDotStrictPositiveInteger `xml:"x322,omitempty" json:",omitempty"`
-	PrimaryPart *PrimaryPart `xml:"x457,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	ProductForm ProductForm `xml:"b012"`
-	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:",omitempty"`
-	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:",omitempty"`
-	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:",omitempty"`
-	ProductFormDescriptions []DtDotNonEmptyString `xml:"b014,omitempty" json:",omitempty"`
-	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:",omitempty"`
-	Measures []Measure `xml:"measure,omitempty" json:",omitempty"`
-	CountryOfManufacture *CountryOfManufacture `xml:"x316,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PrimaryPart Flag `xml:"x457,omitempty" json:"primaryPart,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	ProductForm ProductForm `xml:"b012" json:"productForm"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	ProductFormFeatures []ProductFormFeature `xml:"productformfeature,omitempty" json:"productFormFeatures,omitempty"`
+	ProductPackaging *ProductPackaging `xml:"b225,omitempty" json:"productPackaging,omitempty"`
+	ProductFormDescriptions []PlainText `xml:"b014,omitempty" json:"productFormDescriptions,omitempty"`
+	ProductContentTypes []ProductContentType `xml:"b385,omitempty" json:"productContentTypes,omitempty"`
+	Measures []Measure `xml:"measure,omitempty" json:"measures,omitempty"`
+	NumberOfItemsOfThisForm *PlainText `xml:"x322,omitempty" json:"numberOfItemsOfThisForm,omitempty"`
+	NumberOfCopies *PlainText `xml:"x323,omitempty" json:"numberOfCopies,omitempty"`
+	CountryOfManufacture *CountryOfManufacture `xml:"x316,omitempty" json:"countryOfManufacture,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProductSupply is not documented.
 type ProductSupply struct {
-	Markets []Market `xml:"market,omitempty" json:",omitempty"`
-	MarketPublishingDetail *MarketPublishingDetail `xml:"marketpublishingdetail,omitempty" json:",omitempty"`
-	SupplyDetails []SupplyDetail `xml:"supplydetail"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Markets []Market `xml:"market,omitempty" json:"markets,omitempty"`
+	MarketPublishingDetail *MarketPublishingDetail `xml:"marketpublishingdetail,omitempty" json:"marketPublishingDetail,omitempty"`
+	SupplyDetails []SupplyDetail `xml:"supplydetail" json:"supplyDetails"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ProfessionalAffiliation is not documented.
 type ProfessionalAffiliation struct {
-	Affiliation *DtDotNonEmptyString `xml:"b046,omitempty" json:",omitempty"`
-	ProfessionalPositions []DtDotNonEmptyString `xml:"b045,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProfessionalPositions []PlainText `xml:"b045,omitempty" json:"professionalPositions,omitempty"`
+	Affiliation *PlainText `xml:"b046,omitempty" json:"affiliation,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PromotionDetail is not documented.
 type PromotionDetail struct {
-	PromotionalEvents []PromotionalEvent `xml:"promotionalevent,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PromotionalEvents []PromotionalEvent `xml:"promotionalevent,omitempty" json:"promotionalEvents,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PromotionalEvent is not documented.
 type PromotionalEvent struct {
-	NoContributor *NoContributor `xml:"n339,omitempty" json:",omitempty"`
-	Contributors []Contributor `xml:"contributor,omitempty" json:",omitempty"`
-	ContributorReferences []ContributorReference `xml:"contributorreference,omitempty" json:",omitempty"`
-	ContributorStatements []Flow `xml:"b049,omitempty" json:",omitempty"`
-	EventIdentifiers []EventIdentifier `xml:"eventidentifier,omitempty" json:",omitempty"`
-	EventTypes []EventType `xml:"x548"`
-	EventStatus *EventStatus `xml:"x549,omitempty" json:",omitempty"`
-	ContentAudiences []ContentAudience `xml:"x427"`
-	EventNames []DtDotNonEmptyString `xml:"x516"`
-	EventDescriptions []Flow `xml:"x550,omitempty" json:",omitempty"`
-	EventOccurrences []EventOccurrence `xml:"eventoccurrence"`
-	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	EventIdentifiers []EventIdentifier `xml:"eventidentifier,omitempty" json:"eventIdentifiers,omitempty"`
+	EventTypes []EventType `xml:"x548" json:"eventTypes"`
+	EventStatus *EventStatus `xml:"x549,omitempty" json:"eventStatus,omitempty"`
+	ContentAudiences []ContentAudience `xml:"x427" json:"contentAudiences"`
+	EventNames []PlainText `xml:"x516" json:"eventNames"`
+	EventDescriptions []Flow `xml:"x550,omitempty" json:"eventDescriptions,omitempty"`
+	Contributors []Contributor `xml:"contributor,omitempty" json:"contributors,omitempty"`
+	ContributorReferences []ContributorReference `xml:"contributorreference,omitempty" json:"contributorReferences,omitempty"`
+	ContributorStatements []Flow `xml:"b049,omitempty" json:"contributorStatements,omitempty"`
+	NoContributor Flag `xml:"n339,omitempty" json:"noContributor,omitempty"`
+	EventOccurrences []EventOccurrence `xml:"eventoccurrence" json:"eventOccurrences"`
+	EventSponsors []EventSponsor `xml:"eventsponsor,omitempty" json:"eventSponsors,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Publisher is not documented.
 type Publisher struct {
-	PublisherName *DtDotNonEmptyString `xml:"b081,omitempty" json:",omitempty"`
-	PublisherIdentifiers []PublisherIdentifier `xml:"publisheridentifier,omitempty" json:",omitempty"`
-	PublishingRole PublishingRole `xml:"b291"`
-	Fundings []Funding `xml:"funding,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PublishingRole PublishingRole `xml:"b291" json:"publishingRole"`
+	PublisherIdentifiers []PublisherIdentifier `xml:"publisheridentifier,omitempty" json:"publisherIdentifiers,omitempty"`
+	PublisherName *PlainText `xml:"b081,omitempty" json:"publisherName,omitempty"`
+	Fundings []Funding `xml:"funding,omitempty" json:"fundings,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PublisherIdentifier is not documented.
 type PublisherIdentifier struct {
-	PublisherIDType PublisherIDType `xml:"x447"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PublisherIDType PublisherIDType `xml:"x447" json:"publisherIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PublisherRepresentative is not documented.
 type PublisherRepresentative struct {
-	AgentName *DtDotNonEmptyString `xml:"j401,omitempty" json:",omitempty"`
-	AgentIdentifiers []AgentIdentifier `xml:"agentidentifier,omitempty" json:",omitempty"`
-	AgentRole AgentRole `xml:"j402"`
-	TelephoneNumbers []DtDotNonEmptyString `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []DtDotNonEmptyString `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	AgentRole AgentRole `xml:"j402" json:"agentRole"`
+	AgentIdentifiers []AgentIdentifier `xml:"agentidentifier,omitempty" json:"agentIdentifiers,omitempty"`
+	AgentName *PlainText `xml:"j401,omitempty" json:"agentName,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PublishingDate is not documented.
 type PublishingDate struct {
-	PublishingDateRole PublishingDateRole `xml:"x448"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	PublishingDateRole PublishingDateRole `xml:"x448" json:"publishingDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // PublishingDetail is not documented.
 type PublishingDetail struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Imprints []Imprint `xml:"imprint,omitempty" json:"imprints,omitempty"`
+	Publishers []Publisher `xml:"publisher,omitempty" json:"publishers,omitempty"`
+	CityOfPublications []PlainText `xml:"b209,omitempty" json:"cityOfPublications,omitempty"`
+	CountryOfPublication *CountryOfPublication `xml:"b083,omitempty" json:"countryOfPublication,omitempty"`
+	ProductContacts []ProductContact `xml:"productcontact,omitempty" json:"productContacts,omitempty"`
+	PublishingStatus *PublishingStatus `xml:"b394,omitempty" json:"publishingStatus,omitempty"`
+	PublishingStatusNotes []Flow `xml:"b395,omitempty" json:"publishingStatusNotes,omitempty"`
+	PublishingDates []PublishingDate `xml:"publishingdate,omitempty" json:"publishingDates,omitempty"`
+	LatestReprintNumber *PlainText `xml:"x446,omitempty" json:"latestReprintNumber,omitempty"`
+	CopyrightStatements []CopyrightStatement `xml:"copyrightstatement,omitempty" json:"copyrightStatements,omitempty"`
+	SalesRightss []SalesRights `xml:"salesrights,omitempty" json:"salesRightss,omitempty"`
+	ROWSalesRightsType *ROWSalesRightsType `xml:"x456,omitempty" json:"rowSalesRightsType,omitempty"`
+	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:"salesRestrictions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // RecordSourceIdentifier is not documented.
 type RecordSourceIdentifier struct {
-	RecordSourceIDType RecordSourceIDType `xml:"x311"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RecordSourceIDType RecordSourceIDType `xml:"x311" json:"recordSourceIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Reissue is not documented.
 type Reissue struct {
-	ReissueDate DtDotNonEmptyString `xml:"j365"`
-	ReissueDescription *Flow `xml:"j366,omitempty" json:",omitempty"`
-	Prices []Price `xml:"price,omitempty" json:",omitempty"`
-	SupportingResources []SupportingResource `xml:"supportingresource,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ReissueDate PlainText `xml:"j365" json:"reissueDate"`
+	ReissueDescription *Flow `xml:"j366,omitempty" json:"reissueDescription,omitempty"`
+	Prices []Price `xml:"price,omitempty" json:"prices,omitempty"`
+	SupportingResources []SupportingResource `xml:"supportingresource,omitempty" json:"supportingResources,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // RelatedMaterial is not documented.
 type RelatedMaterial struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	RelatedWorks []RelatedWork `xml:"relatedwork,omitempty" json:"relatedWorks,omitempty"`
+	RelatedProducts []RelatedProduct `xml:"relatedproduct,omitempty" json:"relatedProducts,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // RelatedProduct is not documented.
 type RelatedProduct struct {
-	ProductRelationCodes []ProductRelationCode `xml:"x455"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier"`
-	ProductForm *ProductForm `xml:"b012,omitempty" json:",omitempty"`
-	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductRelationCodes []ProductRelationCode `xml:"x455" json:"productRelationCodes"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier" json:"productIdentifiers"`
+	ProductForm *ProductForm `xml:"b012,omitempty" json:"productForm,omitempty"`
+	ProductFormDetails []ProductFormDetail `xml:"b333,omitempty" json:"productFormDetails,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // RelatedWork is not documented.
 type RelatedWork struct {
-	WorkRelationCode WorkRelationCode `xml:"x454"`
-	WorkIdentifiers []WorkIdentifier `xml:"workidentifier"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WorkRelationCode WorkRelationCode `xml:"x454" json:"workRelationCode"`
+	WorkIdentifiers []WorkIdentifier `xml:"workidentifier" json:"workIdentifiers"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReligiousText is not documented.
 type ReligiousText struct {
-	Bible *Bible `xml:"bible,omitempty" json:",omitempty"`
-	ReligiousTextIdentifier *ReligiousTextIdentifier `xml:"b376,omitempty" json:",omitempty"`
-	ReligiousTextFeatures []ReligiousTextFeature `xml:"religioustextfeature,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Bible *Bible `xml:"bible,omitempty" json:"bible,omitempty"`
+	ReligiousTextIdentifier *ReligiousTextIdentifier `xml:"b376,omitempty" json:"religiousTextIdentifier,omitempty"`
+	ReligiousTextFeatures []ReligiousTextFeature `xml:"religioustextfeature,omitempty" json:"religiousTextFeatures,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReligiousTextFeature is not documented.
 type ReligiousTextFeature struct {
-	ReligiousTextFeatureType ReligiousTextFeatureType `xml:"b358"`
-	ReligiousTextFeatureCode ReligiousTextFeatureCode `xml:"b359"`
-	ReligiousTextFeatureDescriptions []Flow `xml:"b360,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ReligiousTextFeatureType ReligiousTextFeatureType `xml:"b358" json:"religiousTextFeatureType"`
+	ReligiousTextFeatureCode ReligiousTextFeatureCode `xml:"b359" json:"religiousTextFeatureCode"`
+	ReligiousTextFeatureDescriptions []Flow `xml:"b360,omitempty" json:"religiousTextFeatureDescriptions,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ResourceFeature is not documented.
 type ResourceFeature struct {
-	ResourceFeatureType ResourceFeatureType `xml:"x438"`
-	FeatureValue *DtDotNonEmptyString `xml:"x439,omitempty" json:",omitempty"`
-	FeatureNotes []Flow `xml:"x440,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ResourceFeatureType ResourceFeatureType `xml:"x438" json:"resourceFeatureType"`
+	FeatureValue *PlainText `xml:"x439,omitempty" json:"featureValue,omitempty"`
+	FeatureNotes []Flow `xml:"x440,omitempty" json:"featureNotes,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ResourceVersion is not documented.
 type ResourceVersion struct {
-	ResourceForm ResourceForm `xml:"x441"`
-	ResourceVersionFeatures []ResourceVersionFeature `xml:"resourceversionfeature,omitempty" json:",omitempty"`
-	ResourceLinks []DtDotNonEmptyURI `xml:"x435"`
-	ContentDates []ContentDate `xml:"contentdate,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ResourceForm ResourceForm `xml:"x441" json:"resourceForm"`
+	ResourceVersionFeatures []ResourceVersionFeature `xml:"resourceversionfeature,omitempty" json:"resourceVersionFeatures,omitempty"`
+	ResourceLinks []PlainText `xml:"x435" json:"resourceLinks"`
+	ContentDates []ContentDate `xml:"contentdate,omitempty" json:"contentDates,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ResourceVersionFeature is not documented.
 type ResourceVersionFeature struct {
-	ResourceVersionFeatureType ResourceVersionFeatureType `xml:"x442"`
-	FeatureValue *DtDotNonEmptyString `xml:"x439,omitempty" json:",omitempty"`
-	FeatureNotes []Flow `xml:"x440,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ResourceVersionFeatureType ResourceVersionFeatureType `xml:"x442" json:"resourceVersionFeatureType"`
+	FeatureValue *PlainText `xml:"x439,omitempty" json:"featureValue,omitempty"`
+	FeatureNotes []Flow `xml:"x440,omitempty" json:"featureNotes,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReturnsConditions is not documented.
 type ReturnsConditions struct {
-	ReturnsCodeType ReturnsCodeType `xml:"j268"`
-	ReturnsCodeTypeName *DtDotNonEmptyString `xml:"x460,omitempty" json:",omitempty"`
-	ReturnsCode DtDotNonEmptyString `xml:"j269"`
-	ReturnsNotes []DtDotNonEmptyString `xml:"x528,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ReturnsCodeType ReturnsCodeType `xml:"j268" json:"returnsCodeType"`
+	ReturnsCodeTypeName *PlainText `xml:"x460,omitempty" json:"returnsCodeTypeName,omitempty"`
+	ReturnsCode PlainText `xml:"j269" json:"returnsCode"`
+	ReturnsNotes []PlainText `xml:"x528,omitempty" json:"returnsNotes,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // ReviewRating is not documented.
 type ReviewRating struct {
-	Rating DtDotPositiveDecimal `xml:"x525"`
-	RatingLimit *DtDotPositiveInteger `xml:"x526,omitempty" json:",omitempty"`
-	RatingUnitss []DtDotNonEmptyString `xml:"x527,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Rating PlainText `xml:"x525" json:"rating"`
+	RatingLimit *PlainText `xml:"x526,omitempty" json:"ratingLimit,omitempty"`
+	RatingUnitss []PlainText `xml:"x527,omitempty" json:"ratingUnitss,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesOutlet is not documented.
 type SalesOutlet struct {
-	SalesOutletName *DtDotNonEmptyString `xml:"b382,omitempty" json:",omitempty"`
-	SalesOutletIdentifiers []SalesOutletIdentifier `xml:"salesoutletidentifier,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesOutletIdentifiers []SalesOutletIdentifier `xml:"salesoutletidentifier,omitempty" json:"salesOutletIdentifiers,omitempty"`
+	SalesOutletName *PlainText `xml:"b382,omitempty" json:"salesOutletName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesOutletIdentifier is not documented.
 type SalesOutletIdentifier struct {
-	SalesOutletIDType SalesOutletIDType `xml:"b393"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesOutletIDType SalesOutletIDType `xml:"b393" json:"salesOutletIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesRestriction is not documented.
 type SalesRestriction struct {
-	SalesRestrictionType SalesRestrictionType `xml:"b381"`
-	SalesOutlets []SalesOutlet `xml:"salesoutlet,omitempty" json:",omitempty"`
-	SalesRestrictionNotes []Flow `xml:"x453,omitempty" json:",omitempty"`
-	StartDate *DtDotNonEmptyString `xml:"b324,omitempty" json:",omitempty"`
-	EndDate *DtDotNonEmptyString `xml:"b325,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesRestrictionType SalesRestrictionType `xml:"b381" json:"salesRestrictionType"`
+	SalesOutlets []SalesOutlet `xml:"salesoutlet,omitempty" json:"salesOutlets,omitempty"`
+	SalesRestrictionNotes []Flow `xml:"x453,omitempty" json:"salesRestrictionNotes,omitempty"`
+	StartDate *PlainText `xml:"b324,omitempty" json:"startDate,omitempty"`
+	EndDate *PlainText `xml:"b325,omitempty" json:"endDate,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SalesRights is not documented.
 type SalesRights struct {
-	SalesRightsType SalesRightsType `xml:"b089"`
-	Territory Territory `xml:"territory"`
-	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	PublisherName *DtDotNonEmptyString `xml:"b081,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SalesRightsType SalesRightsType `xml:"b089" json:"salesRightsType"`
+	Territory Territory `xml:"territory" json:"territory"`
+	SalesRestrictions []SalesRestriction `xml:"salesrestriction,omitempty" json:"salesRestrictions,omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	PublisherName *PlainText `xml:"b081,omitempty" json:"publisherName,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Sender is not documented.
 type Sender struct {
-	SenderName *DtDotNonEmptyString `xml:"x298,omitempty" json:",omitempty"`
-	SenderIdentifiers []SenderIdentifier `xml:"senderidentifier,omitempty" json:",omitempty"`
-	ContactName *DtDotNonEmptyString `xml:"x299,omitempty" json:",omitempty"`
-	EmailAddress *DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SenderIdentifiers []SenderIdentifier `xml:"senderidentifier,omitempty" json:"senderIdentifiers,omitempty"`
+	SenderName *PlainText `xml:"x298,omitempty" json:"senderName,omitempty"`
+	ContactName *PlainText `xml:"x299,omitempty" json:"contactName,omitempty"`
+	EmailAddress *PlainText `xml:"j272,omitempty" json:"emailAddress,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SenderIdentifier is not documented.
 type SenderIdentifier struct {
-	SenderIDType SenderIDType `xml:"m379"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SenderIDType SenderIDType `xml:"m379" json:"senderIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Stock is not documented.
 type Stock struct {
-	StockQuantityCodeds []StockQuantityCoded `xml:"stockquantitycoded,omitempty" json:",omitempty"`
-	OnHand *DtDotInteger `xml:"j350,omitempty" json:",omitempty"`
-	Proximity *Proximity `xml:"x502,omitempty" json:",omitempty"`
-	Reserved *DtDotPositiveInteger `xml:"x536,omitempty" json:",omitempty"`
-	OnOrder *DtDotPositiveInteger `xml:"j351,omitempty" json:",omitempty"`
-	CBO *DtDotPositiveInteger `xml:"j375,omitempty" json:",omitempty"`
-	LocationIdentifiers []LocationIdentifier `xml:"locationidentifier,omitempty" json:",omitempty"`
-	LocationNames []DtDotNonEmptyString `xml:"j349,omitempty" json:",omitempty"`
-	OnOrderDetails []OnOrderDetail `xml:"onorderdetail,omitempty" json:",omitempty"`
-	Velocitys []Velocity `xml:"velocity,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	LocationIdentifiers []LocationIdentifier `xml:"locationidentifier,omitempty" json:"locationIdentifiers,omitempty"`
+	LocationNames []PlainText `xml:"j349,omitempty" json:"locationNames,omitempty"`
+	StockQuantityCodeds []StockQuantityCoded `xml:"stockquantitycoded,omitempty" json:"stockQuantityCodeds,omitempty"`
+	OnHand *PlainText `xml:"j350,omitempty" json:"onHand,omitempty"`
+	Proximity *Proximity `xml:"x502,omitempty" json:"proximity,omitempty"`
+	Reserved *PlainText `xml:"x536,omitempty" json:"reserved,omitempty"`
+	OnOrder *PlainText `xml:"j351,omitempty" json:"onOrder,omitempty"`
+	CBO *PlainText `xml:"j375,omitempty" json:"cbo,omitempty"`
+	OnOrderDetails []OnOrderDetail `xml:"onorderdetail,omitempty" json:"onOrderDetails,omitempty"`
+	Velocitys []Velocity `xml:"velocity,omitempty" json:"velocitys,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // StockQuantityCoded is not documented.
 type StockQuantityCoded struct {
-	StockQuantityCodeType StockQuantityCodeType `xml:"j293"`
-	StockQuantityCodeTypeName *DtDotNonEmptyString `xml:"j296,omitempty" json:",omitempty"`
-	StockQuantityCode DtDotNonEmptyString `xml:"j297"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	StockQuantityCodeType StockQuantityCodeType `xml:"j293" json:"stockQuantityCodeType"`
+	StockQuantityCodeTypeName *PlainText `xml:"j296,omitempty" json:"stockQuantityCodeTypeName,omitempty"`
+	StockQuantityCode PlainText `xml:"j297" json:"stockQuantityCode"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Subject is not documented.
 type Subject struct {
-	SubjectHeadingTexts []DtDotNonEmptyString `xml:"b070,omitempty" json:",omitempty"`
-	SubjectCode *DtDotNonEmptyString `xml:"b069,omitempty" json:",omitempty"`
-	MainSubject *MainSubject `xml:"x425,omitempty" json:",omitempty"`
-	SubjectSchemeIdentifier SubjectSchemeIdentifier `xml:"b067"`
-	SubjectSchemeName *DtDotNonEmptyString `xml:"b171,omitempty" json:",omitempty"`
-	SubjectSchemeVersion *DtDotNonEmptyString `xml:"b068,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	MainSubject Flag `xml:"x425,omitempty" json:"mainSubject,omitempty"`
+	SubjectSchemeIdentifier SubjectSchemeIdentifier `xml:"b067" json:"subjectSchemeIdentifier"`
+	SubjectSchemeName *PlainText `xml:"b171,omitempty" json:"subjectSchemeName,omitempty"`
+	SubjectSchemeVersion *PlainText `xml:"b068,omitempty" json:"subjectSchemeVersion,omitempty"`
+	SubjectCode *PlainText `xml:"b069,omitempty" json:"subjectCode,omitempty"`
+	SubjectHeadingTexts []PlainText `xml:"b070,omitempty" json:"subjectHeadingTexts,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SubjectDate is not documented.
 type SubjectDate struct {
-	SubjectDateRole SubjectDateRole `xml:"x534"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SubjectDateRole SubjectDateRole `xml:"x534" json:"subjectDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Supplier is not documented.
 type Supplier struct {
-	SupplierName *DtDotNonEmptyString `xml:"j137,omitempty" json:",omitempty"`
-	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:",omitempty"`
-	SupplierRole SupplierRole `xml:"j292"`
-	TelephoneNumbers []DtDotNonEmptyString `xml:"j270,omitempty" json:",omitempty"`
-	FaxNumbers []DtDotNonEmptyString `xml:"j271,omitempty" json:",omitempty"`
-	EmailAddresss []DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Websites []Website `xml:"website,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierRole SupplierRole `xml:"j292" json:"supplierRole"`
+	SupplierIdentifiers []SupplierIdentifier `xml:"supplieridentifier,omitempty" json:"supplierIdentifiers,omitempty"`
+	SupplierName *PlainText `xml:"j137,omitempty" json:"supplierName,omitempty"`
+	TelephoneNumbers []PlainText `xml:"j270,omitempty" json:"telephoneNumbers,omitempty"`
+	FaxNumbers []PlainText `xml:"j271,omitempty" json:"faxNumbers,omitempty"`
+	EmailAddresss []PlainText `xml:"j272,omitempty" json:"emailAddresss,omitempty"`
+	Websites []Website `xml:"website,omitempty" json:"websites,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplierIdentifier is not documented.
 type SupplierIdentifier struct {
-	SupplierIDType SupplierIDType `xml:"j345"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierIDType SupplierIDType `xml:"j345" json:"supplierIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplierOwnCoding is not documented.
 type SupplierOwnCoding struct {
-	SupplierCodeType SupplierCodeType `xml:"x458"`
-	SupplierCodeTypeName *DtDotNonEmptyString `xml:"x513,omitempty" json:",omitempty"`
-	SupplierCodeValue DtDotNonEmptyString `xml:"x459"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplierCodeType SupplierCodeType `xml:"x458" json:"supplierCodeType"`
+	SupplierCodeTypeName *PlainText `xml:"x513,omitempty" json:"supplierCodeTypeName,omitempty"`
+	SupplierCodeValue PlainText `xml:"x459" json:"supplierCodeValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplyContact is not documented.
 type SupplyContact struct {
-	SupplyContactName *DtDotNonEmptyString `xml:"x539,omitempty" json:",omitempty"`
-	SupplyContactIdentifiers []SupplyContactIdentifier `xml:"supplycontactidentifier,omitempty" json:",omitempty"`
-	SupplyContactRole SupplyContactRole `xml:"x537"`
-	ContactName *DtDotNonEmptyString `xml:"x299,omitempty" json:",omitempty"`
-	EmailAddress *DtDotEmailString `xml:"j272,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplyContactRole SupplyContactRole `xml:"x537" json:"supplyContactRole"`
+	SupplyContactIdentifiers []SupplyContactIdentifier `xml:"supplycontactidentifier,omitempty" json:"supplyContactIdentifiers,omitempty"`
+	SupplyContactName *PlainText `xml:"x539,omitempty" json:"supplyContactName,omitempty"`
+	ContactName *PlainText `xml:"x299,omitempty" json:"contactName,omitempty"`
+	EmailAddress *PlainText `xml:"j272,omitempty" json:"emailAddress,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplyContactIdentifier is not documented.
 type SupplyContactIdentifier struct {
-	SupplyContactIDType SupplyContactIDType `xml:"x538"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplyContactIDType SupplyContactIDType `xml:"x538" json:"supplyContactIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplyDate is not documented.
 type SupplyDate struct {
-	SupplyDateRole SupplyDateRole `xml:"x461"`
-	DateFormat *DateFormat `xml:"j260,omitempty" json:",omitempty"`
-	Date DtDotNonEmptyString `xml:"b306"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SupplyDateRole SupplyDateRole `xml:"x461" json:"supplyDateRole"`
+	DateFormat *DateFormat `xml:"j260,omitempty" json:"dateFormat,omitempty"`
+	Date PlainText `xml:"b306" json:"date"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupplyDetail is not documented.
 type SupplyDetail struct {
-	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:",omitempty"`
-	Prices []Price `xml:"price,omitempty" json:",omitempty"`
-	Supplier Supplier `xml:"supplier"`
-	SupplyContacts []SupplyContact `xml:"supplycontact,omitempty" json:",omitempty"`
-	SupplierOwnCodings []SupplierOwnCoding `xml:"supplierowncoding,omitempty" json:",omitempty"`
-	ReturnsConditionss []ReturnsConditions `xml:"returnsconditions,omitempty" json:",omitempty"`
-	ProductAvailability ProductAvailability `xml:"j396"`
-	SupplyDates []SupplyDate `xml:"supplydate,omitempty" json:",omitempty"`
-	OrderTime *DtDotPositiveInteger `xml:"j144,omitempty" json:",omitempty"`
-	NewSupplier *NewSupplier `xml:"newsupplier,omitempty" json:",omitempty"`
-	Stocks []Stock `xml:"stock,omitempty" json:",omitempty"`
-	PackQuantity *DtDotStrictPositiveInteger `xml:"j145,omitempty" json:",omitempty"`
-	PalletQuantity *DtDotStrictPositiveInteger `xml:"x545,omitempty" json:",omitempty"`
-	Reissue *Reissue `xml:"reissue,omitempty" json:",omitempty"`
-	OrderQuantityMinimums []DtDotStrictPositiveInteger `xml:"x532,omitempty" json:",omitempty"`
-	OrderQuantityMultiple *DtDotStrictPositiveInteger `xml:"x533,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	Supplier Supplier `xml:"supplier" json:"supplier"`
+	SupplyContacts []SupplyContact `xml:"supplycontact,omitempty" json:"supplyContacts,omitempty"`
+	SupplierOwnCodings []SupplierOwnCoding `xml:"supplierowncoding,omitempty" json:"supplierOwnCodings,omitempty"`
+	ReturnsConditionss []ReturnsConditions `xml:"returnsconditions,omitempty" json:"returnsConditionss,omitempty"`
+	ProductAvailability ProductAvailability `xml:"j396" json:"productAvailability"`
+	SupplyDates []SupplyDate `xml:"supplydate,omitempty" json:"supplyDates,omitempty"`
+	OrderTime *PlainText `xml:"j144,omitempty" json:"orderTime,omitempty"`
+	NewSupplier *NewSupplier `xml:"newsupplier,omitempty" json:"newSupplier,omitempty"`
+	Stocks []Stock `xml:"stock,omitempty" json:"stocks,omitempty"`
+	PackQuantity *PlainText `xml:"j145,omitempty" json:"packQuantity,omitempty"`
+	PalletQuantity *PlainText `xml:"x545,omitempty" json:"palletQuantity,omitempty"`
+	OrderQuantityMinimums []PlainText `xml:"x532,omitempty" json:"orderQuantityMinimums,omitempty"`
+	OrderQuantityMultiple *PlainText `xml:"x533,omitempty" json:"orderQuantityMultiple,omitempty"`
+	UnpricedItemType *UnpricedItemType `xml:"j192,omitempty" json:"unpricedItemType,omitempty"`
+	Prices []Price `xml:"price,omitempty" json:"prices,omitempty"`
+	Reissue *Reissue `xml:"reissue,omitempty" json:"reissue,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // SupportingResource is not documented.
 type SupportingResource struct {
-	ResourceContentType ResourceContentType `xml:"x436"`
-	ContentAudiences []ContentAudience `xml:"x427"`
-	Territory *Territory `xml:"territory,omitempty" json:",omitempty"`
-	ResourceMode ResourceMode `xml:"x437"`
-	ResourceFeatures []ResourceFeature `xml:"resourcefeature,omitempty" json:",omitempty"`
-	ResourceVersions []ResourceVersion `xml:"resourceversion"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ResourceContentType ResourceContentType `xml:"x436" json:"resourceContentType"`
+	ContentAudiences []ContentAudience `xml:"x427" json:"contentAudiences"`
+	Territory *Territory `xml:"territory,omitempty" json:"territory,omitempty"`
+	ResourceMode ResourceMode `xml:"x437" json:"resourceMode"`
+	ResourceFeatures []ResourceFeature `xml:"resourcefeature,omitempty" json:"resourceFeatures,omitempty"`
+	ResourceVersions []ResourceVersion `xml:"resourceversion" json:"resourceVersions"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Tax is not documented.
 type Tax struct {
-	TaxRatePercent *DtDotPercentDecimal `xml:"x472,omitempty" json:",omitempty"`
-	TaxableAmount *DtDotStrictPositiveDecimal `xml:"x473,omitempty" json:",omitempty"`
-	TaxAmount *DtDotPositiveDecimal `xml:"x474,omitempty" json:",omitempty"`
-	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:",omitempty"`
-	PricePartDescriptions []DtDotNonEmptyString `xml:"x535,omitempty" json:",omitempty"`
-	TaxType *TaxType `xml:"x470,omitempty" json:",omitempty"`
-	TaxRateCode *TaxRateCode `xml:"x471,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
-}
-
-// TaxExempt is not documented.
-type TaxExempt struct {
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	ProductIdentifiers []ProductIdentifier `xml:"productidentifier,omitempty" json:"productIdentifiers,omitempty"`
+	PricePartDescriptions []PlainText `xml:"x535,omitempty" json:"pricePartDescriptions,omitempty"`
+	TaxType *TaxType `xml:"x470,omitempty" json:"taxType,omitempty"`
+	TaxRateCode *TaxRateCode `xml:"x471,omitempty" json:"taxRateCode,omitempty"`
+	TaxRatePercent *PlainText `xml:"x472,omitempty" json:"taxRatePercent,omitempty"`
+	TaxableAmount *PlainText `xml:"x473,omitempty" json:"taxableAmount,omitempty"`
+	TaxAmount *PlainText `xml:"x474,omitempty" json:"taxAmount,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Territory is not documented.
 type Territory struct {
-	CountriesIncluded *DtDotCountryCodeList `xml:"x449,omitempty" json:",omitempty"`
-	RegionsIncluded *DtDotRegionCodeList `xml:"x450,omitempty" json:",omitempty"`
-	RegionsExcluded *DtDotRegionCodeList `xml:"x452,omitempty" json:",omitempty"`
-	CountriesExcluded *DtDotCountryCodeList `xml:"x451,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	CountriesIncluded *PlainText `xml:"x449,omitempty" json:"countriesIncluded,omitempty"`
+	RegionsIncluded *PlainText `xml:"x450,omitempty" json:"regionsIncluded,omitempty"`
+	CountriesExcluded *PlainText `xml:"x451,omitempty" json:"countriesExcluded,omitempty"`
+	RegionsExcluded *PlainText `xml:"x452,omitempty" json:"regionsExcluded,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TextContent is not documented.
 type TextContent struct {
-	TextType TextType `xml:"x426"`
-	ContentAudiences []ContentAudience `xml:"x427"`
-	Territory *Territory `xml:"territory,omitempty" json:",omitempty"`
-	Texts []Flow `xml:"d104"`
-	ReviewRating *ReviewRating `xml:"reviewrating,omitempty" json:",omitempty"`
-	TextAuthors []DtDotNonEmptyString `xml:"d107,omitempty" json:",omitempty"`
-	TextSourceCorporate *DtDotNonEmptyString `xml:"b374,omitempty" json:",omitempty"`
-	TextSourceDescriptions []Flow `xml:"x557,omitempty" json:",omitempty"`
-	SourceTitles []DtDotNonEmptyString `xml:"x428,omitempty" json:",omitempty"`
-	ContentDates []ContentDate `xml:"contentdate,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextType TextType `xml:"x426" json:"textType"`
+	ContentAudiences []ContentAudience `xml:"x427" json:"contentAudiences"`
+	Territory *Territory `xml:"territory,omitempty" json:"territory,omitempty"`
+	Texts []Flow `xml:"d104" json:"texts"`
+	ReviewRating *ReviewRating `xml:"reviewrating,omitempty" json:"reviewRating,omitempty"`
+	TextAuthors []PlainText `xml:"d107,omitempty" json:"textAuthors,omitempty"`
+	TextSourceCorporate *PlainText `xml:"b374,omitempty" json:"textSourceCorporate,omitempty"`
+	TextSourceDescriptions []Flow `xml:"x557,omitempty" json:"textSourceDescriptions,omitempty"`
+	SourceTitles []PlainText `xml:"x428,omitempty" json:"sourceTitles,omitempty"`
+	ContentDates []ContentDate `xml:"contentdate,omitempty" json:"contentDates,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TextItem is not documented.
 type TextItem struct {
-	TextItemType TextItemType `xml:"b290"`
-	TextItemIdentifiers []TextItemIdentifier `xml:"textitemidentifier,omitempty" json:",omitempty"`
-	PageRuns []PageRun `xml:"pagerun,omitempty" json:",omitempty"`
-	NumberOfPages *DtDotStrictPositiveInteger `xml:"b061,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextItemType TextItemType `xml:"b290" json:"textItemType"`
+	TextItemIdentifiers []TextItemIdentifier `xml:"textitemidentifier,omitempty" json:"textItemIdentifiers,omitempty"`
+	PageRuns []PageRun `xml:"pagerun,omitempty" json:"pageRuns,omitempty"`
+	NumberOfPages *PlainText `xml:"b061,omitempty" json:"numberOfPages,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TextItemIdentifier is not documented.
 type TextItemIdentifier struct {
-	TextItemIDType TextItemIDType `xml:"b285"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TextItemIDType TextItemIDType `xml:"b285" json:"textItemIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TimeRun is not documented.
 type TimeRun struct {
-	StartTime DtDotTimeOrDuration `xml:"x542"`
-	EndTime *DtDotTimeOrDuration `xml:"x543,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	StartTime PlainText `xml:"x542" json:"startTime"`
+	EndTime *PlainText `xml:"x543,omitempty" json:"endTime,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TitleDetail is not documented.
 type TitleDetail struct {
-	TitleType TitleType `xml:"b202"`
-	TitleElements []TitleElement `xml:"titleelement"`
-	TitleStatement *Flow `xml:"x478,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	TitleType TitleType `xml:"b202" json:"titleType"`
+	TitleElements []TitleElement `xml:"titleelement" json:"titleElements"`
+	TitleStatement *Flow `xml:"x478,omitempty" json:"titleStatement,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // TitleElement is not documented.
 type TitleElement struct {
-	TitleText *DtDotNonEmptyString `xml:"b203,omitempty" json:",omitempty"`
-	TitlePrefix *DtDotNonEmptyString `xml:"b030,omitempty" json:",omitempty"`
-	NoPrefix *NoPrefix `xml:"x501,omitempty" json:",omitempty"`
-	TitleWithoutPrefix *DtDotNonEmptyString `xml:"b031,omitempty" json:",omitempty"`
-	PartNumber *DtDotNonEmptyString `xml:"x410,omitempty" json:",omitempty"`
-	YearOfAnnual *DtDotYearOrYearRange `xml:"b020,omitempty" json:",omitempty"`
-	SequenceNumber *DtDotStrictPositiveInteger `xml:"b034,omitempty" json:",omitempty"`
-	TitleElementLevel TitleElementLevel `xml:"x409"`
-	Subtitle *DtDotNonEmptyString `xml:"b029,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	SequenceNumber *PlainText `xml:"b034,omitempty" json:"sequenceNumber,omitempty"`
+	TitleElementLevel TitleElementLevel `xml:"x409" json:"titleElementLevel"`
+	PartNumber *PlainText `xml:"x410,omitempty" json:"partNumber,omitempty"`
+	YearOfAnnual *PlainText `xml:"b020,omitempty" json:"yearOfAnnual,omitempty"`
+	TitleText *PlainText `xml:"b203,omitempty" json:"titleText,omitempty"`
+	TitlePrefix *PlainText `xml:"b030,omitempty" json:"titlePrefix,omitempty"`
+	NoPrefix Flag `xml:"x501,omitempty" json:"noPrefix,omitempty"`
+	TitleWithoutPrefix *PlainText `xml:"b031,omitempty" json:"titleWithoutPrefix,omitempty"`
+	Subtitle *PlainText `xml:"b029,omitempty" json:"subtitle,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Velocity is not documented.
 type Velocity struct {
-	VelocityMetric VelocityMetric `xml:"x504"`
-	Rate DtDotInteger `xml:"x505"`
-	Proximity *Proximity `xml:"x502,omitempty" json:",omitempty"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	VelocityMetric VelocityMetric `xml:"x504" json:"velocityMetric"`
+	Rate PlainText `xml:"x505" json:"rate"`
+	Proximity *Proximity `xml:"x502,omitempty" json:"proximity,omitempty"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // Website is not documented.
 type Website struct {
-	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:",omitempty"`
-	WebsiteDescriptions []Flow `xml:"b294,omitempty" json:",omitempty"`
-	WebsiteLinks []DtDotNonEmptyURI `xml:"b295"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WebsiteRole *WebsiteRole `xml:"b367,omitempty" json:"websiteRole,omitempty"`
+	WebsiteDescriptions []Flow `xml:"b294,omitempty" json:"websiteDescriptions,omitempty"`
+	WebsiteLinks []PlainText `xml:"b295" json:"websiteLinks"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
 
 // WorkIdentifier is not documented.
 type WorkIdentifier struct {
-	WorkIDType WorkIDType `xml:"b201"`
-	IDTypeName *DtDotNonEmptyString `xml:"b233,omitempty" json:",omitempty"`
-	IDValue DtDotNonEmptyString `xml:"b244"`
-	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:",omitempty"`
-	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:",omitempty"`
-	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:",omitempty"`
+	WorkIDType WorkIDType `xml:"b201" json:"workIDType"`
+	IDTypeName *PlainText `xml:"b233,omitempty" json:"idTypeName,omitempty"`
+	IDValue PlainText `xml:"b244" json:"idValue"`
+	Datestamp *DtDotDateOrDateTime `xml:"datestamp,omitempty,attr" json:"datestamp,omitempty"`
+	Sourcetype *SourceTypeCode `xml:"sourcetype,omitempty,attr" json:"sourcetype,omitempty"`
+	Sourcename *DtDotNonEmptyString `xml:"sourcename,omitempty,attr" json:"sourcename,omitempty"`
+	Extensions []Extension `xml:",any" json:"extensions,omitempty"`
 }
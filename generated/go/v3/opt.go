@@ -0,0 +1,11054 @@
+package onix
+
+// Optional elements and attributes are held by pointers, whose accessors read them without nil checks
+// even if the composites holding them are nil, as in p.PublishingDetail.PublishingStatusOrElse(def).
+
+// GetAVDuration returns AVDuration and true, or false if it or x is nil.
+func (x *AVItem) GetAVDuration() (PlainText, bool) {
+	if x == nil || x.AVDuration == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AVDuration, true
+}
+
+// AVDurationOrElse returns AVDuration, or def if it or x is nil.
+func (x *AVItem) AVDurationOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAVDuration(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AVItem) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AVItem) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AVItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AVItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AVItem) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AVItem) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *AVItemIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *AVItemIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AVItemIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AVItemIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AVItemIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AVItemIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AVItemIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AVItemIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAddresseeName returns AddresseeName and true, or false if it or x is nil.
+func (x *Addressee) GetAddresseeName() (PlainText, bool) {
+	if x == nil || x.AddresseeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AddresseeName, true
+}
+
+// AddresseeNameOrElse returns AddresseeName, or def if it or x is nil.
+func (x *Addressee) AddresseeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAddresseeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContactName returns ContactName and true, or false if it or x is nil.
+func (x *Addressee) GetContactName() (PlainText, bool) {
+	if x == nil || x.ContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContactName, true
+}
+
+// ContactNameOrElse returns ContactName, or def if it or x is nil.
+func (x *Addressee) ContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEmailAddress returns EmailAddress and true, or false if it or x is nil.
+func (x *Addressee) GetEmailAddress() (PlainText, bool) {
+	if x == nil || x.EmailAddress == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EmailAddress, true
+}
+
+// EmailAddressOrElse returns EmailAddress, or def if it or x is nil.
+func (x *Addressee) EmailAddressOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEmailAddress(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Addressee) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Addressee) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Addressee) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Addressee) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Addressee) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Addressee) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *AddresseeIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AddresseeIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AddresseeIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AddresseeIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AddresseeIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *AgentIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AgentIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AgentIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AgentIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AgentIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *AlternativeName) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *AlternativeName) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *AlternativeName) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *AlternativeName) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *AlternativeName) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *AlternativeName) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *AlternativeName) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *AlternativeName) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *AlternativeName) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *AlternativeName) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *AlternativeName) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *AlternativeName) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *AlternativeName) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *AlternativeName) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *AlternativeName) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *AlternativeName) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *AlternativeName) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *AlternativeName) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *AlternativeName) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *AlternativeName) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetGender returns Gender and true, or false if it or x is nil.
+func (x *AlternativeName) GetGender() (Gender, bool) {
+	if x == nil || x.Gender == nil {
+		var zero Gender
+		return zero, false
+	}
+	return *x.Gender, true
+}
+
+// GenderOrElse returns Gender, or def if it or x is nil.
+func (x *AlternativeName) GenderOrElse(def Gender) Gender {
+	if v, ok := x.GetGender(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *AlternativeName) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *AlternativeName) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateNameInverted returns CorporateNameInverted and true, or false if it or x is nil.
+func (x *AlternativeName) GetCorporateNameInverted() (PlainText, bool) {
+	if x == nil || x.CorporateNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateNameInverted, true
+}
+
+// CorporateNameInvertedOrElse returns CorporateNameInverted, or def if it or x is nil.
+func (x *AlternativeName) CorporateNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AlternativeName) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AlternativeName) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AlternativeName) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AlternativeName) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AlternativeName) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AlternativeName) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumber returns Number and true, or false if it or x is nil.
+func (x *AncillaryContent) GetNumber() (PlainText, bool) {
+	if x == nil || x.Number == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Number, true
+}
+
+// NumberOrElse returns Number, or def if it or x is nil.
+func (x *AncillaryContent) NumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AncillaryContent) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AncillaryContent) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AncillaryContent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AncillaryContent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AncillaryContent) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AncillaryContent) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceCodeTypeName returns AudienceCodeTypeName and true, or false if it or x is nil.
+func (x *Audience) GetAudienceCodeTypeName() (PlainText, bool) {
+	if x == nil || x.AudienceCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceCodeTypeName, true
+}
+
+// AudienceCodeTypeNameOrElse returns AudienceCodeTypeName, or def if it or x is nil.
+func (x *Audience) AudienceCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Audience) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Audience) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Audience) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Audience) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Audience) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Audience) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRangePrecision returns AudienceRangePrecision and true, or false if it or x is nil.
+func (x *AudienceRange) GetAudienceRangePrecision() (AudienceRangePrecision, bool) {
+	if x == nil || x.AudienceRangePrecision == nil {
+		var zero AudienceRangePrecision
+		return zero, false
+	}
+	return *x.AudienceRangePrecision, true
+}
+
+// AudienceRangePrecisionOrElse returns AudienceRangePrecision, or def if it or x is nil.
+func (x *AudienceRange) AudienceRangePrecisionOrElse(def AudienceRangePrecision) AudienceRangePrecision {
+	if v, ok := x.GetAudienceRangePrecision(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAudienceRangeValue returns AudienceRangeValue and true, or false if it or x is nil.
+func (x *AudienceRange) GetAudienceRangeValue() (PlainText, bool) {
+	if x == nil || x.AudienceRangeValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AudienceRangeValue, true
+}
+
+// AudienceRangeValueOrElse returns AudienceRangeValue, or def if it or x is nil.
+func (x *AudienceRange) AudienceRangeValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAudienceRangeValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *AudienceRange) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *AudienceRange) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *AudienceRange) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *AudienceRange) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *AudienceRange) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *AudienceRange) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPositionOnProduct returns PositionOnProduct and true, or false if it or x is nil.
+func (x *Barcode) GetPositionOnProduct() (PositionOnProduct, bool) {
+	if x == nil || x.PositionOnProduct == nil {
+		var zero PositionOnProduct
+		return zero, false
+	}
+	return *x.PositionOnProduct, true
+}
+
+// PositionOnProductOrElse returns PositionOnProduct, or def if it or x is nil.
+func (x *Barcode) PositionOnProductOrElse(def PositionOnProduct) PositionOnProduct {
+	if v, ok := x.GetPositionOnProduct(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Barcode) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Barcode) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Barcode) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Barcode) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Barcode) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Barcode) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *BatchBonus) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *BatchBonus) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *BatchBonus) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *BatchBonus) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *BatchBonus) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *BatchBonus) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStudyBibleType returns StudyBibleType and true, or false if it or x is nil.
+func (x *Bible) GetStudyBibleType() (StudyBibleType, bool) {
+	if x == nil || x.StudyBibleType == nil {
+		var zero StudyBibleType
+		return zero, false
+	}
+	return *x.StudyBibleType, true
+}
+
+// StudyBibleTypeOrElse returns StudyBibleType, or def if it or x is nil.
+func (x *Bible) StudyBibleTypeOrElse(def StudyBibleType) StudyBibleType {
+	if v, ok := x.GetStudyBibleType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBibleTextOrganization returns BibleTextOrganization and true, or false if it or x is nil.
+func (x *Bible) GetBibleTextOrganization() (BibleTextOrganization, bool) {
+	if x == nil || x.BibleTextOrganization == nil {
+		var zero BibleTextOrganization
+		return zero, false
+	}
+	return *x.BibleTextOrganization, true
+}
+
+// BibleTextOrganizationOrElse returns BibleTextOrganization, or def if it or x is nil.
+func (x *Bible) BibleTextOrganizationOrElse(def BibleTextOrganization) BibleTextOrganization {
+	if v, ok := x.GetBibleTextOrganization(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBibleReferenceLocation returns BibleReferenceLocation and true, or false if it or x is nil.
+func (x *Bible) GetBibleReferenceLocation() (BibleReferenceLocation, bool) {
+	if x == nil || x.BibleReferenceLocation == nil {
+		var zero BibleReferenceLocation
+		return zero, false
+	}
+	return *x.BibleReferenceLocation, true
+}
+
+// BibleReferenceLocationOrElse returns BibleReferenceLocation, or def if it or x is nil.
+func (x *Bible) BibleReferenceLocationOrElse(def BibleReferenceLocation) BibleReferenceLocation {
+	if v, ok := x.GetBibleReferenceLocation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Bible) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Bible) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Bible) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Bible) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Bible) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Bible) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritory returns Territory and true, or false if it or x is nil.
+func (x *CitedContent) GetTerritory() (Territory, bool) {
+	if x == nil || x.Territory == nil {
+		var zero Territory
+		return zero, false
+	}
+	return *x.Territory, true
+}
+
+// TerritoryOrElse returns Territory, or def if it or x is nil.
+func (x *CitedContent) TerritoryOrElse(def Territory) Territory {
+	if v, ok := x.GetTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourceType returns SourceType and true, or false if it or x is nil.
+func (x *CitedContent) GetSourceType() (SourceType, bool) {
+	if x == nil || x.SourceType == nil {
+		var zero SourceType
+		return zero, false
+	}
+	return *x.SourceType, true
+}
+
+// SourceTypeOrElse returns SourceType, or def if it or x is nil.
+func (x *CitedContent) SourceTypeOrElse(def SourceType) SourceType {
+	if v, ok := x.GetSourceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReviewRating returns ReviewRating and true, or false if it or x is nil.
+func (x *CitedContent) GetReviewRating() (ReviewRating, bool) {
+	if x == nil || x.ReviewRating == nil {
+		var zero ReviewRating
+		return zero, false
+	}
+	return *x.ReviewRating, true
+}
+
+// ReviewRatingOrElse returns ReviewRating, or def if it or x is nil.
+func (x *CitedContent) ReviewRatingOrElse(def ReviewRating) ReviewRating {
+	if v, ok := x.GetReviewRating(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPositionOnList returns PositionOnList and true, or false if it or x is nil.
+func (x *CitedContent) GetPositionOnList() (PlainText, bool) {
+	if x == nil || x.PositionOnList == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PositionOnList, true
+}
+
+// PositionOnListOrElse returns PositionOnList, or def if it or x is nil.
+func (x *CitedContent) PositionOnListOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPositionOnList(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CitedContent) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CitedContent) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CitedContent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CitedContent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CitedContent) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CitedContent) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CollateralDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CollateralDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CollateralDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CollateralDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CollateralDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CollateralDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourceName returns SourceName and true, or false if it or x is nil.
+func (x *Collection) GetSourceName() (PlainText, bool) {
+	if x == nil || x.SourceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SourceName, true
+}
+
+// SourceNameOrElse returns SourceName, or def if it or x is nil.
+func (x *Collection) SourceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSourceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Collection) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Collection) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Collection) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Collection) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Collection) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Collection) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *CollectionIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *CollectionIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CollectionIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CollectionIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CollectionIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CollectionIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CollectionIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CollectionIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCollectionSequenceTypeName returns CollectionSequenceTypeName and true, or false if it or x is nil.
+func (x *CollectionSequence) GetCollectionSequenceTypeName() (PlainText, bool) {
+	if x == nil || x.CollectionSequenceTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CollectionSequenceTypeName, true
+}
+
+// CollectionSequenceTypeNameOrElse returns CollectionSequenceTypeName, or def if it or x is nil.
+func (x *CollectionSequence) CollectionSequenceTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCollectionSequenceTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CollectionSequence) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CollectionSequence) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CollectionSequence) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CollectionSequence) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CollectionSequence) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CollectionSequence) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceType returns PriceType and true, or false if it or x is nil.
+func (x *ComparisonProductPrice) GetPriceType() (PriceType, bool) {
+	if x == nil || x.PriceType == nil {
+		var zero PriceType
+		return zero, false
+	}
+	return *x.PriceType, true
+}
+
+// PriceTypeOrElse returns PriceType, or def if it or x is nil.
+func (x *ComparisonProductPrice) PriceTypeOrElse(def PriceType) PriceType {
+	if v, ok := x.GetPriceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCurrencyCode returns CurrencyCode and true, or false if it or x is nil.
+func (x *ComparisonProductPrice) GetCurrencyCode() (CurrencyCode, bool) {
+	if x == nil || x.CurrencyCode == nil {
+		var zero CurrencyCode
+		return zero, false
+	}
+	return *x.CurrencyCode, true
+}
+
+// CurrencyCodeOrElse returns CurrencyCode, or def if it or x is nil.
+func (x *ComparisonProductPrice) CurrencyCodeOrElse(def CurrencyCode) CurrencyCode {
+	if v, ok := x.GetCurrencyCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ComparisonProductPrice) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ComparisonProductPrice) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ComparisonProductPrice) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ComparisonProductPrice) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ComparisonProductPrice) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ComparisonProductPrice) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Complexity) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Complexity) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Complexity) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Complexity) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Complexity) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Complexity) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceRole returns ConferenceRole and true, or false if it or x is nil.
+func (x *Conference) GetConferenceRole() (ConferenceRole, bool) {
+	if x == nil || x.ConferenceRole == nil {
+		var zero ConferenceRole
+		return zero, false
+	}
+	return *x.ConferenceRole, true
+}
+
+// ConferenceRoleOrElse returns ConferenceRole, or def if it or x is nil.
+func (x *Conference) ConferenceRoleOrElse(def ConferenceRole) ConferenceRole {
+	if v, ok := x.GetConferenceRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceAcronym returns ConferenceAcronym and true, or false if it or x is nil.
+func (x *Conference) GetConferenceAcronym() (PlainText, bool) {
+	if x == nil || x.ConferenceAcronym == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceAcronym, true
+}
+
+// ConferenceAcronymOrElse returns ConferenceAcronym, or def if it or x is nil.
+func (x *Conference) ConferenceAcronymOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceAcronym(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceNumber returns ConferenceNumber and true, or false if it or x is nil.
+func (x *Conference) GetConferenceNumber() (PlainText, bool) {
+	if x == nil || x.ConferenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceNumber, true
+}
+
+// ConferenceNumberOrElse returns ConferenceNumber, or def if it or x is nil.
+func (x *Conference) ConferenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceTheme returns ConferenceTheme and true, or false if it or x is nil.
+func (x *Conference) GetConferenceTheme() (Flow, bool) {
+	if x == nil || x.ConferenceTheme == nil {
+		var zero Flow
+		return zero, false
+	}
+	return *x.ConferenceTheme, true
+}
+
+// ConferenceThemeOrElse returns ConferenceTheme, or def if it or x is nil.
+func (x *Conference) ConferenceThemeOrElse(def Flow) Flow {
+	if v, ok := x.GetConferenceTheme(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferenceDate returns ConferenceDate and true, or false if it or x is nil.
+func (x *Conference) GetConferenceDate() (PlainText, bool) {
+	if x == nil || x.ConferenceDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferenceDate, true
+}
+
+// ConferenceDateOrElse returns ConferenceDate, or def if it or x is nil.
+func (x *Conference) ConferenceDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferenceDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetConferencePlace returns ConferencePlace and true, or false if it or x is nil.
+func (x *Conference) GetConferencePlace() (PlainText, bool) {
+	if x == nil || x.ConferencePlace == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ConferencePlace, true
+}
+
+// ConferencePlaceOrElse returns ConferencePlace, or def if it or x is nil.
+func (x *Conference) ConferencePlaceOrElse(def PlainText) PlainText {
+	if v, ok := x.GetConferencePlace(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Conference) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Conference) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Conference) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Conference) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Conference) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Conference) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *ConferenceSponsor) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *ConferenceSponsor) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ConferenceSponsor) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ConferenceSponsor) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ConferenceSponsor) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ConferenceSponsor) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ConferenceSponsorIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ConferenceSponsorIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *ContentDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *ContentDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContentDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContentDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContentDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContentDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContentDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContentDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContentDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContentDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContentDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContentDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContentDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContentDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLevelSequenceNumber returns LevelSequenceNumber and true, or false if it or x is nil.
+func (x *ContentItem) GetLevelSequenceNumber() (PlainText, bool) {
+	if x == nil || x.LevelSequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LevelSequenceNumber, true
+}
+
+// LevelSequenceNumberOrElse returns LevelSequenceNumber, or def if it or x is nil.
+func (x *ContentItem) LevelSequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLevelSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextItem returns TextItem and true, or false if it or x is nil.
+func (x *ContentItem) GetTextItem() (TextItem, bool) {
+	if x == nil || x.TextItem == nil {
+		var zero TextItem
+		return zero, false
+	}
+	return *x.TextItem, true
+}
+
+// TextItemOrElse returns TextItem, or def if it or x is nil.
+func (x *ContentItem) TextItemOrElse(def TextItem) TextItem {
+	if v, ok := x.GetTextItem(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAVItem returns AVItem and true, or false if it or x is nil.
+func (x *ContentItem) GetAVItem() (AVItem, bool) {
+	if x == nil || x.AVItem == nil {
+		var zero AVItem
+		return zero, false
+	}
+	return *x.AVItem, true
+}
+
+// AVItemOrElse returns AVItem, or def if it or x is nil.
+func (x *ContentItem) AVItemOrElse(def AVItem) AVItem {
+	if v, ok := x.GetAVItem(); ok {
+		return v
+	}
+	return def
+}
+
+// GetComponentTypeName returns ComponentTypeName and true, or false if it or x is nil.
+func (x *ContentItem) GetComponentTypeName() (PlainText, bool) {
+	if x == nil || x.ComponentTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ComponentTypeName, true
+}
+
+// ComponentTypeNameOrElse returns ComponentTypeName, or def if it or x is nil.
+func (x *ContentItem) ComponentTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetComponentTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetComponentNumber returns ComponentNumber and true, or false if it or x is nil.
+func (x *ContentItem) GetComponentNumber() (PlainText, bool) {
+	if x == nil || x.ComponentNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ComponentNumber, true
+}
+
+// ComponentNumberOrElse returns ComponentNumber, or def if it or x is nil.
+func (x *ContentItem) ComponentNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetComponentNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContentItem) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContentItem) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContentItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContentItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContentItem) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContentItem) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSequenceNumber returns SequenceNumber and true, or false if it or x is nil.
+func (x *Contributor) GetSequenceNumber() (PlainText, bool) {
+	if x == nil || x.SequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SequenceNumber, true
+}
+
+// SequenceNumberOrElse returns SequenceNumber, or def if it or x is nil.
+func (x *Contributor) SequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameType returns NameType and true, or false if it or x is nil.
+func (x *Contributor) GetNameType() (NameType, bool) {
+	if x == nil || x.NameType == nil {
+		var zero NameType
+		return zero, false
+	}
+	return *x.NameType, true
+}
+
+// NameTypeOrElse returns NameType, or def if it or x is nil.
+func (x *Contributor) NameTypeOrElse(def NameType) NameType {
+	if v, ok := x.GetNameType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *Contributor) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *Contributor) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *Contributor) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *Contributor) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *Contributor) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *Contributor) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *Contributor) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *Contributor) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *Contributor) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *Contributor) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *Contributor) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *Contributor) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *Contributor) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *Contributor) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *Contributor) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *Contributor) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *Contributor) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *Contributor) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *Contributor) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *Contributor) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetGender returns Gender and true, or false if it or x is nil.
+func (x *Contributor) GetGender() (Gender, bool) {
+	if x == nil || x.Gender == nil {
+		var zero Gender
+		return zero, false
+	}
+	return *x.Gender, true
+}
+
+// GenderOrElse returns Gender, or def if it or x is nil.
+func (x *Contributor) GenderOrElse(def Gender) Gender {
+	if v, ok := x.GetGender(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *Contributor) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *Contributor) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateNameInverted returns CorporateNameInverted and true, or false if it or x is nil.
+func (x *Contributor) GetCorporateNameInverted() (PlainText, bool) {
+	if x == nil || x.CorporateNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateNameInverted, true
+}
+
+// CorporateNameInvertedOrElse returns CorporateNameInverted, or def if it or x is nil.
+func (x *Contributor) CorporateNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUnnamedPersons returns UnnamedPersons and true, or false if it or x is nil.
+func (x *Contributor) GetUnnamedPersons() (UnnamedPersons, bool) {
+	if x == nil || x.UnnamedPersons == nil {
+		var zero UnnamedPersons
+		return zero, false
+	}
+	return *x.UnnamedPersons, true
+}
+
+// UnnamedPersonsOrElse returns UnnamedPersons, or def if it or x is nil.
+func (x *Contributor) UnnamedPersonsOrElse(def UnnamedPersons) UnnamedPersons {
+	if v, ok := x.GetUnnamedPersons(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Contributor) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Contributor) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Contributor) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Contributor) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Contributor) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Contributor) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *ContributorDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *ContributorDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContributorDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContributorDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContributorDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContributorDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContributorDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContributorDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryCode returns CountryCode and true, or false if it or x is nil.
+func (x *ContributorPlace) GetCountryCode() (CountryCode, bool) {
+	if x == nil || x.CountryCode == nil {
+		var zero CountryCode
+		return zero, false
+	}
+	return *x.CountryCode, true
+}
+
+// CountryCodeOrElse returns CountryCode, or def if it or x is nil.
+func (x *ContributorPlace) CountryCodeOrElse(def CountryCode) CountryCode {
+	if v, ok := x.GetCountryCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRegionCode returns RegionCode and true, or false if it or x is nil.
+func (x *ContributorPlace) GetRegionCode() (RegionCode, bool) {
+	if x == nil || x.RegionCode == nil {
+		var zero RegionCode
+		return zero, false
+	}
+	return *x.RegionCode, true
+}
+
+// RegionCodeOrElse returns RegionCode, or def if it or x is nil.
+func (x *ContributorPlace) RegionCodeOrElse(def RegionCode) RegionCode {
+	if v, ok := x.GetRegionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContributorPlace) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContributorPlace) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContributorPlace) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContributorPlace) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContributorPlace) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContributorPlace) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSequenceNumber returns SequenceNumber and true, or false if it or x is nil.
+func (x *ContributorReference) GetSequenceNumber() (PlainText, bool) {
+	if x == nil || x.SequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SequenceNumber, true
+}
+
+// SequenceNumberOrElse returns SequenceNumber, or def if it or x is nil.
+func (x *ContributorReference) SequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ContributorReference) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ContributorReference) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ContributorReference) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ContributorReference) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ContributorReference) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ContributorReference) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *CopyrightOwner) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *CopyrightOwner) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightOwner) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightOwner) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightOwner) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightOwner) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightOwnerIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightOwnerIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCopyrightType returns CopyrightType and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetCopyrightType() (CopyrightType, bool) {
+	if x == nil || x.CopyrightType == nil {
+		var zero CopyrightType
+		return zero, false
+	}
+	return *x.CopyrightType, true
+}
+
+// CopyrightTypeOrElse returns CopyrightType, or def if it or x is nil.
+func (x *CopyrightStatement) CopyrightTypeOrElse(def CopyrightType) CopyrightType {
+	if v, ok := x.GetCopyrightType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *CopyrightStatement) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *CopyrightStatement) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *CopyrightStatement) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *CopyrightStatement) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductPackaging returns ProductPackaging and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetProductPackaging() (ProductPackaging, bool) {
+	if x == nil || x.ProductPackaging == nil {
+		var zero ProductPackaging
+		return zero, false
+	}
+	return *x.ProductPackaging, true
+}
+
+// ProductPackagingOrElse returns ProductPackaging, or def if it or x is nil.
+func (x *DescriptiveDetail) ProductPackagingOrElse(def ProductPackaging) ProductPackaging {
+	if v, ok := x.GetProductPackaging(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTradeCategory returns TradeCategory and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetTradeCategory() (TradeCategory, bool) {
+	if x == nil || x.TradeCategory == nil {
+		var zero TradeCategory
+		return zero, false
+	}
+	return *x.TradeCategory, true
+}
+
+// TradeCategoryOrElse returns TradeCategory, or def if it or x is nil.
+func (x *DescriptiveDetail) TradeCategoryOrElse(def TradeCategory) TradeCategory {
+	if v, ok := x.GetTradeCategory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrimaryContentType returns PrimaryContentType and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetPrimaryContentType() (PrimaryContentType, bool) {
+	if x == nil || x.PrimaryContentType == nil {
+		var zero PrimaryContentType
+		return zero, false
+	}
+	return *x.PrimaryContentType, true
+}
+
+// PrimaryContentTypeOrElse returns PrimaryContentType, or def if it or x is nil.
+func (x *DescriptiveDetail) PrimaryContentTypeOrElse(def PrimaryContentType) PrimaryContentType {
+	if v, ok := x.GetPrimaryContentType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryOfManufacture returns CountryOfManufacture and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetCountryOfManufacture() (CountryOfManufacture, bool) {
+	if x == nil || x.CountryOfManufacture == nil {
+		var zero CountryOfManufacture
+		return zero, false
+	}
+	return *x.CountryOfManufacture, true
+}
+
+// CountryOfManufactureOrElse returns CountryOfManufacture, or def if it or x is nil.
+func (x *DescriptiveDetail) CountryOfManufactureOrElse(def CountryOfManufacture) CountryOfManufacture {
+	if v, ok := x.GetCountryOfManufacture(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubLicense returns EpubLicense and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetEpubLicense() (EpubLicense, bool) {
+	if x == nil || x.EpubLicense == nil {
+		var zero EpubLicense
+		return zero, false
+	}
+	return *x.EpubLicense, true
+}
+
+// EpubLicenseOrElse returns EpubLicense, or def if it or x is nil.
+func (x *DescriptiveDetail) EpubLicenseOrElse(def EpubLicense) EpubLicense {
+	if v, ok := x.GetEpubLicense(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisType returns ThesisType and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetThesisType() (ThesisType, bool) {
+	if x == nil || x.ThesisType == nil {
+		var zero ThesisType
+		return zero, false
+	}
+	return *x.ThesisType, true
+}
+
+// ThesisTypeOrElse returns ThesisType, or def if it or x is nil.
+func (x *DescriptiveDetail) ThesisTypeOrElse(def ThesisType) ThesisType {
+	if v, ok := x.GetThesisType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisPresentedTo returns ThesisPresentedTo and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetThesisPresentedTo() (PlainText, bool) {
+	if x == nil || x.ThesisPresentedTo == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ThesisPresentedTo, true
+}
+
+// ThesisPresentedToOrElse returns ThesisPresentedTo, or def if it or x is nil.
+func (x *DescriptiveDetail) ThesisPresentedToOrElse(def PlainText) PlainText {
+	if v, ok := x.GetThesisPresentedTo(); ok {
+		return v
+	}
+	return def
+}
+
+// GetThesisYear returns ThesisYear and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetThesisYear() (PlainText, bool) {
+	if x == nil || x.ThesisYear == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ThesisYear, true
+}
+
+// ThesisYearOrElse returns ThesisYear, or def if it or x is nil.
+func (x *DescriptiveDetail) ThesisYearOrElse(def PlainText) PlainText {
+	if v, ok := x.GetThesisYear(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEditionNumber returns EditionNumber and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetEditionNumber() (PlainText, bool) {
+	if x == nil || x.EditionNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EditionNumber, true
+}
+
+// EditionNumberOrElse returns EditionNumber, or def if it or x is nil.
+func (x *DescriptiveDetail) EditionNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEditionNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEditionVersionNumber returns EditionVersionNumber and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetEditionVersionNumber() (PlainText, bool) {
+	if x == nil || x.EditionVersionNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EditionVersionNumber, true
+}
+
+// EditionVersionNumberOrElse returns EditionVersionNumber, or def if it or x is nil.
+func (x *DescriptiveDetail) EditionVersionNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEditionVersionNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReligiousText returns ReligiousText and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetReligiousText() (ReligiousText, bool) {
+	if x == nil || x.ReligiousText == nil {
+		var zero ReligiousText
+		return zero, false
+	}
+	return *x.ReligiousText, true
+}
+
+// ReligiousTextOrElse returns ReligiousText, or def if it or x is nil.
+func (x *DescriptiveDetail) ReligiousTextOrElse(def ReligiousText) ReligiousText {
+	if v, ok := x.GetReligiousText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIllustrated returns Illustrated and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetIllustrated() (Illustrated, bool) {
+	if x == nil || x.Illustrated == nil {
+		var zero Illustrated
+		return zero, false
+	}
+	return *x.Illustrated, true
+}
+
+// IllustratedOrElse returns Illustrated, or def if it or x is nil.
+func (x *DescriptiveDetail) IllustratedOrElse(def Illustrated) Illustrated {
+	if v, ok := x.GetIllustrated(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfIllustrations returns NumberOfIllustrations and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetNumberOfIllustrations() (PlainText, bool) {
+	if x == nil || x.NumberOfIllustrations == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfIllustrations, true
+}
+
+// NumberOfIllustrationsOrElse returns NumberOfIllustrations, or def if it or x is nil.
+func (x *DescriptiveDetail) NumberOfIllustrationsOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfIllustrations(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *DescriptiveDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *DescriptiveDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *DescriptiveDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *DescriptiveDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountType returns DiscountType and true, or false if it or x is nil.
+func (x *Discount) GetDiscountType() (DiscountType, bool) {
+	if x == nil || x.DiscountType == nil {
+		var zero DiscountType
+		return zero, false
+	}
+	return *x.DiscountType, true
+}
+
+// DiscountTypeOrElse returns DiscountType, or def if it or x is nil.
+func (x *Discount) DiscountTypeOrElse(def DiscountType) DiscountType {
+	if v, ok := x.GetDiscountType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetQuantity returns Quantity and true, or false if it or x is nil.
+func (x *Discount) GetQuantity() (PlainText, bool) {
+	if x == nil || x.Quantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Quantity, true
+}
+
+// QuantityOrElse returns Quantity, or def if it or x is nil.
+func (x *Discount) QuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetToQuantity returns ToQuantity and true, or false if it or x is nil.
+func (x *Discount) GetToQuantity() (PlainText, bool) {
+	if x == nil || x.ToQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ToQuantity, true
+}
+
+// ToQuantityOrElse returns ToQuantity, or def if it or x is nil.
+func (x *Discount) ToQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetToQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountPercent returns DiscountPercent and true, or false if it or x is nil.
+func (x *Discount) GetDiscountPercent() (PlainText, bool) {
+	if x == nil || x.DiscountPercent == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DiscountPercent, true
+}
+
+// DiscountPercentOrElse returns DiscountPercent, or def if it or x is nil.
+func (x *Discount) DiscountPercentOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDiscountPercent(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountAmount returns DiscountAmount and true, or false if it or x is nil.
+func (x *Discount) GetDiscountAmount() (PlainText, bool) {
+	if x == nil || x.DiscountAmount == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DiscountAmount, true
+}
+
+// DiscountAmountOrElse returns DiscountAmount, or def if it or x is nil.
+func (x *Discount) DiscountAmountOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDiscountAmount(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Discount) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Discount) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Discount) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Discount) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Discount) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Discount) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDiscountCodeTypeName returns DiscountCodeTypeName and true, or false if it or x is nil.
+func (x *DiscountCoded) GetDiscountCodeTypeName() (PlainText, bool) {
+	if x == nil || x.DiscountCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.DiscountCodeTypeName, true
+}
+
+// DiscountCodeTypeNameOrElse returns DiscountCodeTypeName, or def if it or x is nil.
+func (x *DiscountCoded) DiscountCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetDiscountCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *DiscountCoded) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *DiscountCoded) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *DiscountCoded) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *DiscountCoded) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *DiscountCoded) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *DiscountCoded) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EpubLicense) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EpubLicense) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EpubLicense) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EpubLicense) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EpubLicense) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EpubLicense) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubLicenseExpressionTypeName returns EpubLicenseExpressionTypeName and true, or false if it or x is nil.
+func (x *EpubLicenseExpression) GetEpubLicenseExpressionTypeName() (PlainText, bool) {
+	if x == nil || x.EpubLicenseExpressionTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EpubLicenseExpressionTypeName, true
+}
+
+// EpubLicenseExpressionTypeNameOrElse returns EpubLicenseExpressionTypeName, or def if it or x is nil.
+func (x *EpubLicenseExpression) EpubLicenseExpressionTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEpubLicenseExpressionTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EpubLicenseExpression) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EpubLicenseExpression) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EpubLicenseExpression) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EpubLicenseExpression) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EpubLicenseExpression) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EpubLicenseExpression) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EpubUsageConstraint) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EpubUsageConstraint) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EpubUsageConstraint) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EpubUsageConstraint) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EpubUsageConstraint) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EpubUsageConstraint) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EpubUsageLimit) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EpubUsageLimit) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EpubUsageLimit) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EpubUsageLimit) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EpubUsageLimit) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EpubUsageLimit) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEventNumber returns EventNumber and true, or false if it or x is nil.
+func (x *Event) GetEventNumber() (PlainText, bool) {
+	if x == nil || x.EventNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EventNumber, true
+}
+
+// EventNumberOrElse returns EventNumber, or def if it or x is nil.
+func (x *Event) EventNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEventNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEventDate returns EventDate and true, or false if it or x is nil.
+func (x *Event) GetEventDate() (PlainText, bool) {
+	if x == nil || x.EventDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EventDate, true
+}
+
+// EventDateOrElse returns EventDate, or def if it or x is nil.
+func (x *Event) EventDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEventDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Event) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Event) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Event) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Event) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Event) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Event) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *EventIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *EventIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EventIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EventIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EventIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EventIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EventIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EventIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryCode returns CountryCode and true, or false if it or x is nil.
+func (x *EventOccurrence) GetCountryCode() (CountryCode, bool) {
+	if x == nil || x.CountryCode == nil {
+		var zero CountryCode
+		return zero, false
+	}
+	return *x.CountryCode, true
+}
+
+// CountryCodeOrElse returns CountryCode, or def if it or x is nil.
+func (x *EventOccurrence) CountryCodeOrElse(def CountryCode) CountryCode {
+	if v, ok := x.GetCountryCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRegionCode returns RegionCode and true, or false if it or x is nil.
+func (x *EventOccurrence) GetRegionCode() (RegionCode, bool) {
+	if x == nil || x.RegionCode == nil {
+		var zero RegionCode
+		return zero, false
+	}
+	return *x.RegionCode, true
+}
+
+// RegionCodeOrElse returns RegionCode, or def if it or x is nil.
+func (x *EventOccurrence) RegionCodeOrElse(def RegionCode) RegionCode {
+	if v, ok := x.GetRegionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetVenueName returns VenueName and true, or false if it or x is nil.
+func (x *EventOccurrence) GetVenueName() (PlainText, bool) {
+	if x == nil || x.VenueName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.VenueName, true
+}
+
+// VenueNameOrElse returns VenueName, or def if it or x is nil.
+func (x *EventOccurrence) VenueNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetVenueName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStreetAddress returns StreetAddress and true, or false if it or x is nil.
+func (x *EventOccurrence) GetStreetAddress() (PlainText, bool) {
+	if x == nil || x.StreetAddress == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.StreetAddress, true
+}
+
+// StreetAddressOrElse returns StreetAddress, or def if it or x is nil.
+func (x *EventOccurrence) StreetAddressOrElse(def PlainText) PlainText {
+	if v, ok := x.GetStreetAddress(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EventOccurrence) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EventOccurrence) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EventOccurrence) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EventOccurrence) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EventOccurrence) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EventOccurrence) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *EventSponsor) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *EventSponsor) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *EventSponsor) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *EventSponsor) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EventSponsor) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EventSponsor) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EventSponsor) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EventSponsor) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EventSponsor) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EventSponsor) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *EventSponsorIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *EventSponsorIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *EventSponsorIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *EventSponsorIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *EventSponsorIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *EventSponsorIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *EventSponsorIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *EventSponsorIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetExtentValue returns ExtentValue and true, or false if it or x is nil.
+func (x *Extent) GetExtentValue() (PlainText, bool) {
+	if x == nil || x.ExtentValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ExtentValue, true
+}
+
+// ExtentValueOrElse returns ExtentValue, or def if it or x is nil.
+func (x *Extent) ExtentValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetExtentValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetExtentValueRoman returns ExtentValueRoman and true, or false if it or x is nil.
+func (x *Extent) GetExtentValueRoman() (PlainText, bool) {
+	if x == nil || x.ExtentValueRoman == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ExtentValueRoman, true
+}
+
+// ExtentValueRomanOrElse returns ExtentValueRoman, or def if it or x is nil.
+func (x *Extent) ExtentValueRomanOrElse(def PlainText) PlainText {
+	if v, ok := x.GetExtentValueRoman(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Extent) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Extent) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Extent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Extent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Extent) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Extent) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Funding) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Funding) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Funding) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Funding) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Funding) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Funding) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *FundingIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *FundingIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *FundingIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *FundingIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *FundingIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *FundingIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *FundingIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *FundingIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMessageNumber returns MessageNumber and true, or false if it or x is nil.
+func (x *Header) GetMessageNumber() (PlainText, bool) {
+	if x == nil || x.MessageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MessageNumber, true
+}
+
+// MessageNumberOrElse returns MessageNumber, or def if it or x is nil.
+func (x *Header) MessageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMessageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMessageRepeat returns MessageRepeat and true, or false if it or x is nil.
+func (x *Header) GetMessageRepeat() (PlainText, bool) {
+	if x == nil || x.MessageRepeat == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MessageRepeat, true
+}
+
+// MessageRepeatOrElse returns MessageRepeat, or def if it or x is nil.
+func (x *Header) MessageRepeatOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMessageRepeat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultLanguageOfText returns DefaultLanguageOfText and true, or false if it or x is nil.
+func (x *Header) GetDefaultLanguageOfText() (DefaultLanguageOfText, bool) {
+	if x == nil || x.DefaultLanguageOfText == nil {
+		var zero DefaultLanguageOfText
+		return zero, false
+	}
+	return *x.DefaultLanguageOfText, true
+}
+
+// DefaultLanguageOfTextOrElse returns DefaultLanguageOfText, or def if it or x is nil.
+func (x *Header) DefaultLanguageOfTextOrElse(def DefaultLanguageOfText) DefaultLanguageOfText {
+	if v, ok := x.GetDefaultLanguageOfText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultPriceType returns DefaultPriceType and true, or false if it or x is nil.
+func (x *Header) GetDefaultPriceType() (DefaultPriceType, bool) {
+	if x == nil || x.DefaultPriceType == nil {
+		var zero DefaultPriceType
+		return zero, false
+	}
+	return *x.DefaultPriceType, true
+}
+
+// DefaultPriceTypeOrElse returns DefaultPriceType, or def if it or x is nil.
+func (x *Header) DefaultPriceTypeOrElse(def DefaultPriceType) DefaultPriceType {
+	if v, ok := x.GetDefaultPriceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDefaultCurrencyCode returns DefaultCurrencyCode and true, or false if it or x is nil.
+func (x *Header) GetDefaultCurrencyCode() (DefaultCurrencyCode, bool) {
+	if x == nil || x.DefaultCurrencyCode == nil {
+		var zero DefaultCurrencyCode
+		return zero, false
+	}
+	return *x.DefaultCurrencyCode, true
+}
+
+// DefaultCurrencyCodeOrElse returns DefaultCurrencyCode, or def if it or x is nil.
+func (x *Header) DefaultCurrencyCodeOrElse(def DefaultCurrencyCode) DefaultCurrencyCode {
+	if v, ok := x.GetDefaultCurrencyCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Header) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Header) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Header) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Header) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Header) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Header) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetImprintName returns ImprintName and true, or false if it or x is nil.
+func (x *Imprint) GetImprintName() (PlainText, bool) {
+	if x == nil || x.ImprintName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ImprintName, true
+}
+
+// ImprintNameOrElse returns ImprintName, or def if it or x is nil.
+func (x *Imprint) ImprintNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetImprintName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Imprint) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Imprint) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Imprint) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Imprint) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Imprint) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Imprint) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ImprintIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ImprintIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ImprintIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ImprintIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ImprintIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ImprintIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ImprintIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ImprintIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryCode returns CountryCode and true, or false if it or x is nil.
+func (x *Language) GetCountryCode() (CountryCode, bool) {
+	if x == nil || x.CountryCode == nil {
+		var zero CountryCode
+		return zero, false
+	}
+	return *x.CountryCode, true
+}
+
+// CountryCodeOrElse returns CountryCode, or def if it or x is nil.
+func (x *Language) CountryCodeOrElse(def CountryCode) CountryCode {
+	if v, ok := x.GetCountryCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRegionCode returns RegionCode and true, or false if it or x is nil.
+func (x *Language) GetRegionCode() (RegionCode, bool) {
+	if x == nil || x.RegionCode == nil {
+		var zero RegionCode
+		return zero, false
+	}
+	return *x.RegionCode, true
+}
+
+// RegionCodeOrElse returns RegionCode, or def if it or x is nil.
+func (x *Language) RegionCodeOrElse(def RegionCode) RegionCode {
+	if v, ok := x.GetRegionCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetScriptCode returns ScriptCode and true, or false if it or x is nil.
+func (x *Language) GetScriptCode() (ScriptCode, bool) {
+	if x == nil || x.ScriptCode == nil {
+		var zero ScriptCode
+		return zero, false
+	}
+	return *x.ScriptCode, true
+}
+
+// ScriptCodeOrElse returns ScriptCode, or def if it or x is nil.
+func (x *Language) ScriptCodeOrElse(def ScriptCode) ScriptCode {
+	if v, ok := x.GetScriptCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Language) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Language) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Language) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Language) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Language) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Language) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *LocationIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *LocationIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *LocationIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *LocationIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *LocationIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Market) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Market) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Market) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Market) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Market) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Market) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *MarketDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *MarketDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MarketDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MarketDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MarketDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MarketDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MarketDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MarketDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPromotionContact returns PromotionContact and true, or false if it or x is nil.
+func (x *MarketPublishingDetail) GetPromotionContact() (Flow, bool) {
+	if x == nil || x.PromotionContact == nil {
+		var zero Flow
+		return zero, false
+	}
+	return *x.PromotionContact, true
+}
+
+// PromotionContactOrElse returns PromotionContact, or def if it or x is nil.
+func (x *MarketPublishingDetail) PromotionContactOrElse(def Flow) Flow {
+	if v, ok := x.GetPromotionContact(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *MarketPublishingDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *MarketPublishingDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *MarketPublishingDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *MarketPublishingDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *MarketPublishingDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *MarketPublishingDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Measure) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Measure) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Measure) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Measure) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Measure) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Measure) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNameType returns NameType and true, or false if it or x is nil.
+func (x *NameAsSubject) GetNameType() (NameType, bool) {
+	if x == nil || x.NameType == nil {
+		var zero NameType
+		return zero, false
+	}
+	return *x.NameType, true
+}
+
+// NameTypeOrElse returns NameType, or def if it or x is nil.
+func (x *NameAsSubject) NameTypeOrElse(def NameType) NameType {
+	if v, ok := x.GetNameType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonName returns PersonName and true, or false if it or x is nil.
+func (x *NameAsSubject) GetPersonName() (PlainText, bool) {
+	if x == nil || x.PersonName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonName, true
+}
+
+// PersonNameOrElse returns PersonName, or def if it or x is nil.
+func (x *NameAsSubject) PersonNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPersonNameInverted returns PersonNameInverted and true, or false if it or x is nil.
+func (x *NameAsSubject) GetPersonNameInverted() (PlainText, bool) {
+	if x == nil || x.PersonNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PersonNameInverted, true
+}
+
+// PersonNameInvertedOrElse returns PersonNameInverted, or def if it or x is nil.
+func (x *NameAsSubject) PersonNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPersonNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesBeforeNames returns TitlesBeforeNames and true, or false if it or x is nil.
+func (x *NameAsSubject) GetTitlesBeforeNames() (PlainText, bool) {
+	if x == nil || x.TitlesBeforeNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesBeforeNames, true
+}
+
+// TitlesBeforeNamesOrElse returns TitlesBeforeNames, or def if it or x is nil.
+func (x *NameAsSubject) TitlesBeforeNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesBeforeNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesBeforeKey returns NamesBeforeKey and true, or false if it or x is nil.
+func (x *NameAsSubject) GetNamesBeforeKey() (PlainText, bool) {
+	if x == nil || x.NamesBeforeKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesBeforeKey, true
+}
+
+// NamesBeforeKeyOrElse returns NamesBeforeKey, or def if it or x is nil.
+func (x *NameAsSubject) NamesBeforeKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesBeforeKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrefixToKey returns PrefixToKey and true, or false if it or x is nil.
+func (x *NameAsSubject) GetPrefixToKey() (PlainText, bool) {
+	if x == nil || x.PrefixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrefixToKey, true
+}
+
+// PrefixToKeyOrElse returns PrefixToKey, or def if it or x is nil.
+func (x *NameAsSubject) PrefixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrefixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetKeyNames returns KeyNames and true, or false if it or x is nil.
+func (x *NameAsSubject) GetKeyNames() (PlainText, bool) {
+	if x == nil || x.KeyNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.KeyNames, true
+}
+
+// KeyNamesOrElse returns KeyNames, or def if it or x is nil.
+func (x *NameAsSubject) KeyNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetKeyNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNamesAfterKey returns NamesAfterKey and true, or false if it or x is nil.
+func (x *NameAsSubject) GetNamesAfterKey() (PlainText, bool) {
+	if x == nil || x.NamesAfterKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NamesAfterKey, true
+}
+
+// NamesAfterKeyOrElse returns NamesAfterKey, or def if it or x is nil.
+func (x *NameAsSubject) NamesAfterKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNamesAfterKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSuffixToKey returns SuffixToKey and true, or false if it or x is nil.
+func (x *NameAsSubject) GetSuffixToKey() (PlainText, bool) {
+	if x == nil || x.SuffixToKey == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SuffixToKey, true
+}
+
+// SuffixToKeyOrElse returns SuffixToKey, or def if it or x is nil.
+func (x *NameAsSubject) SuffixToKeyOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSuffixToKey(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLettersAfterNames returns LettersAfterNames and true, or false if it or x is nil.
+func (x *NameAsSubject) GetLettersAfterNames() (PlainText, bool) {
+	if x == nil || x.LettersAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LettersAfterNames, true
+}
+
+// LettersAfterNamesOrElse returns LettersAfterNames, or def if it or x is nil.
+func (x *NameAsSubject) LettersAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLettersAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlesAfterNames returns TitlesAfterNames and true, or false if it or x is nil.
+func (x *NameAsSubject) GetTitlesAfterNames() (PlainText, bool) {
+	if x == nil || x.TitlesAfterNames == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlesAfterNames, true
+}
+
+// TitlesAfterNamesOrElse returns TitlesAfterNames, or def if it or x is nil.
+func (x *NameAsSubject) TitlesAfterNamesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlesAfterNames(); ok {
+		return v
+	}
+	return def
+}
+
+// GetGender returns Gender and true, or false if it or x is nil.
+func (x *NameAsSubject) GetGender() (Gender, bool) {
+	if x == nil || x.Gender == nil {
+		var zero Gender
+		return zero, false
+	}
+	return *x.Gender, true
+}
+
+// GenderOrElse returns Gender, or def if it or x is nil.
+func (x *NameAsSubject) GenderOrElse(def Gender) Gender {
+	if v, ok := x.GetGender(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateName returns CorporateName and true, or false if it or x is nil.
+func (x *NameAsSubject) GetCorporateName() (PlainText, bool) {
+	if x == nil || x.CorporateName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateName, true
+}
+
+// CorporateNameOrElse returns CorporateName, or def if it or x is nil.
+func (x *NameAsSubject) CorporateNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCorporateNameInverted returns CorporateNameInverted and true, or false if it or x is nil.
+func (x *NameAsSubject) GetCorporateNameInverted() (PlainText, bool) {
+	if x == nil || x.CorporateNameInverted == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CorporateNameInverted, true
+}
+
+// CorporateNameInvertedOrElse returns CorporateNameInverted, or def if it or x is nil.
+func (x *NameAsSubject) CorporateNameInvertedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCorporateNameInverted(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *NameAsSubject) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *NameAsSubject) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *NameAsSubject) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *NameAsSubject) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *NameAsSubject) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *NameAsSubject) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *NameIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *NameIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *NameIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *NameIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *NameIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *NameIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *NameIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *NameIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierName returns SupplierName and true, or false if it or x is nil.
+func (x *NewSupplier) GetSupplierName() (PlainText, bool) {
+	if x == nil || x.SupplierName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierName, true
+}
+
+// SupplierNameOrElse returns SupplierName, or def if it or x is nil.
+func (x *NewSupplier) SupplierNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *NewSupplier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *NewSupplier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *NewSupplier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *NewSupplier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *NewSupplier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *NewSupplier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ONIXMessage) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ONIXMessage) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ONIXMessage) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ONIXMessage) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ONIXMessage) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ONIXMessage) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *OccurrenceDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *OccurrenceDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *OccurrenceDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *OccurrenceDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *OccurrenceDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *OccurrenceDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *OccurrenceDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *OccurrenceDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProximity returns Proximity and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetProximity() (Proximity, bool) {
+	if x == nil || x.Proximity == nil {
+		var zero Proximity
+		return zero, false
+	}
+	return *x.Proximity, true
+}
+
+// ProximityOrElse returns Proximity, or def if it or x is nil.
+func (x *OnOrderDetail) ProximityOrElse(def Proximity) Proximity {
+	if v, ok := x.GetProximity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *OnOrderDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *OnOrderDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *OnOrderDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *OnOrderDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLastPageNumber returns LastPageNumber and true, or false if it or x is nil.
+func (x *PageRun) GetLastPageNumber() (PlainText, bool) {
+	if x == nil || x.LastPageNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LastPageNumber, true
+}
+
+// LastPageNumberOrElse returns LastPageNumber, or def if it or x is nil.
+func (x *PageRun) LastPageNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLastPageNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PageRun) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PageRun) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PageRun) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PageRun) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PageRun) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PageRun) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceType returns PriceType and true, or false if it or x is nil.
+func (x *Price) GetPriceType() (PriceType, bool) {
+	if x == nil || x.PriceType == nil {
+		var zero PriceType
+		return zero, false
+	}
+	return *x.PriceType, true
+}
+
+// PriceTypeOrElse returns PriceType, or def if it or x is nil.
+func (x *Price) PriceTypeOrElse(def PriceType) PriceType {
+	if v, ok := x.GetPriceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceQualifier returns PriceQualifier and true, or false if it or x is nil.
+func (x *Price) GetPriceQualifier() (PriceQualifier, bool) {
+	if x == nil || x.PriceQualifier == nil {
+		var zero PriceQualifier
+		return zero, false
+	}
+	return *x.PriceQualifier, true
+}
+
+// PriceQualifierOrElse returns PriceQualifier, or def if it or x is nil.
+func (x *Price) PriceQualifierOrElse(def PriceQualifier) PriceQualifier {
+	if v, ok := x.GetPriceQualifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEpubLicense returns EpubLicense and true, or false if it or x is nil.
+func (x *Price) GetEpubLicense() (EpubLicense, bool) {
+	if x == nil || x.EpubLicense == nil {
+		var zero EpubLicense
+		return zero, false
+	}
+	return *x.EpubLicense, true
+}
+
+// EpubLicenseOrElse returns EpubLicense, or def if it or x is nil.
+func (x *Price) EpubLicenseOrElse(def EpubLicense) EpubLicense {
+	if v, ok := x.GetEpubLicense(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPricePer returns PricePer and true, or false if it or x is nil.
+func (x *Price) GetPricePer() (PricePer, bool) {
+	if x == nil || x.PricePer == nil {
+		var zero PricePer
+		return zero, false
+	}
+	return *x.PricePer, true
+}
+
+// PricePerOrElse returns PricePer, or def if it or x is nil.
+func (x *Price) PricePerOrElse(def PricePer) PricePer {
+	if v, ok := x.GetPricePer(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMinimumOrderQuantity returns MinimumOrderQuantity and true, or false if it or x is nil.
+func (x *Price) GetMinimumOrderQuantity() (PlainText, bool) {
+	if x == nil || x.MinimumOrderQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.MinimumOrderQuantity, true
+}
+
+// MinimumOrderQuantityOrElse returns MinimumOrderQuantity, or def if it or x is nil.
+func (x *Price) MinimumOrderQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetMinimumOrderQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceStatus returns PriceStatus and true, or false if it or x is nil.
+func (x *Price) GetPriceStatus() (PriceStatus, bool) {
+	if x == nil || x.PriceStatus == nil {
+		var zero PriceStatus
+		return zero, false
+	}
+	return *x.PriceStatus, true
+}
+
+// PriceStatusOrElse returns PriceStatus, or def if it or x is nil.
+func (x *Price) PriceStatusOrElse(def PriceStatus) PriceStatus {
+	if v, ok := x.GetPriceStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceAmount returns PriceAmount and true, or false if it or x is nil.
+func (x *Price) GetPriceAmount() (PlainText, bool) {
+	if x == nil || x.PriceAmount == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceAmount, true
+}
+
+// PriceAmountOrElse returns PriceAmount, or def if it or x is nil.
+func (x *Price) PriceAmountOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceAmount(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceCoded returns PriceCoded and true, or false if it or x is nil.
+func (x *Price) GetPriceCoded() (PriceCoded, bool) {
+	if x == nil || x.PriceCoded == nil {
+		var zero PriceCoded
+		return zero, false
+	}
+	return *x.PriceCoded, true
+}
+
+// PriceCodedOrElse returns PriceCoded, or def if it or x is nil.
+func (x *Price) PriceCodedOrElse(def PriceCoded) PriceCoded {
+	if v, ok := x.GetPriceCoded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUnpricedItemType returns UnpricedItemType and true, or false if it or x is nil.
+func (x *Price) GetUnpricedItemType() (UnpricedItemType, bool) {
+	if x == nil || x.UnpricedItemType == nil {
+		var zero UnpricedItemType
+		return zero, false
+	}
+	return *x.UnpricedItemType, true
+}
+
+// UnpricedItemTypeOrElse returns UnpricedItemType, or def if it or x is nil.
+func (x *Price) UnpricedItemTypeOrElse(def UnpricedItemType) UnpricedItemType {
+	if v, ok := x.GetUnpricedItemType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCurrencyCode returns CurrencyCode and true, or false if it or x is nil.
+func (x *Price) GetCurrencyCode() (CurrencyCode, bool) {
+	if x == nil || x.CurrencyCode == nil {
+		var zero CurrencyCode
+		return zero, false
+	}
+	return *x.CurrencyCode, true
+}
+
+// CurrencyCodeOrElse returns CurrencyCode, or def if it or x is nil.
+func (x *Price) CurrencyCodeOrElse(def CurrencyCode) CurrencyCode {
+	if v, ok := x.GetCurrencyCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritory returns Territory and true, or false if it or x is nil.
+func (x *Price) GetTerritory() (Territory, bool) {
+	if x == nil || x.Territory == nil {
+		var zero Territory
+		return zero, false
+	}
+	return *x.Territory, true
+}
+
+// TerritoryOrElse returns Territory, or def if it or x is nil.
+func (x *Price) TerritoryOrElse(def Territory) Territory {
+	if v, ok := x.GetTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCurrencyZone returns CurrencyZone and true, or false if it or x is nil.
+func (x *Price) GetCurrencyZone() (CurrencyZone, bool) {
+	if x == nil || x.CurrencyZone == nil {
+		var zero CurrencyZone
+		return zero, false
+	}
+	return *x.CurrencyZone, true
+}
+
+// CurrencyZoneOrElse returns CurrencyZone, or def if it or x is nil.
+func (x *Price) CurrencyZoneOrElse(def CurrencyZone) CurrencyZone {
+	if v, ok := x.GetCurrencyZone(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrintedOnProduct returns PrintedOnProduct and true, or false if it or x is nil.
+func (x *Price) GetPrintedOnProduct() (PrintedOnProduct, bool) {
+	if x == nil || x.PrintedOnProduct == nil {
+		var zero PrintedOnProduct
+		return zero, false
+	}
+	return *x.PrintedOnProduct, true
+}
+
+// PrintedOnProductOrElse returns PrintedOnProduct, or def if it or x is nil.
+func (x *Price) PrintedOnProductOrElse(def PrintedOnProduct) PrintedOnProduct {
+	if v, ok := x.GetPrintedOnProduct(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPositionOnProduct returns PositionOnProduct and true, or false if it or x is nil.
+func (x *Price) GetPositionOnProduct() (PositionOnProduct, bool) {
+	if x == nil || x.PositionOnProduct == nil {
+		var zero PositionOnProduct
+		return zero, false
+	}
+	return *x.PositionOnProduct, true
+}
+
+// PositionOnProductOrElse returns PositionOnProduct, or def if it or x is nil.
+func (x *Price) PositionOnProductOrElse(def PositionOnProduct) PositionOnProduct {
+	if v, ok := x.GetPositionOnProduct(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Price) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Price) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Price) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Price) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Price) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Price) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPriceCodeTypeName returns PriceCodeTypeName and true, or false if it or x is nil.
+func (x *PriceCoded) GetPriceCodeTypeName() (PlainText, bool) {
+	if x == nil || x.PriceCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PriceCodeTypeName, true
+}
+
+// PriceCodeTypeNameOrElse returns PriceCodeTypeName, or def if it or x is nil.
+func (x *PriceCoded) PriceCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPriceCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceCoded) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceCoded) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceCoded) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceCoded) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceCoded) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceCoded) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceCondition) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceCondition) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceCondition) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceCondition) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceCondition) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceCondition) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceConditionQuantity) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceConditionQuantity) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceConditionQuantity) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceConditionQuantity) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceConditionQuantity) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceConditionQuantity) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceConstraint) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceConstraint) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceConstraint) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceConstraint) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceConstraint) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceConstraint) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceConstraintLimit) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceConstraintLimit) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceConstraintLimit) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceConstraintLimit) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceConstraintLimit) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceConstraintLimit) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *PriceDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *PriceDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *PriceIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *PriceIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PriceIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PriceIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PriceIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PriceIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PriceIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PriceIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeYear returns PrizeYear and true, or false if it or x is nil.
+func (x *Prize) GetPrizeYear() (PlainText, bool) {
+	if x == nil || x.PrizeYear == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PrizeYear, true
+}
+
+// PrizeYearOrElse returns PrizeYear, or def if it or x is nil.
+func (x *Prize) PrizeYearOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPrizeYear(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeCountry returns PrizeCountry and true, or false if it or x is nil.
+func (x *Prize) GetPrizeCountry() (PrizeCountry, bool) {
+	if x == nil || x.PrizeCountry == nil {
+		var zero PrizeCountry
+		return zero, false
+	}
+	return *x.PrizeCountry, true
+}
+
+// PrizeCountryOrElse returns PrizeCountry, or def if it or x is nil.
+func (x *Prize) PrizeCountryOrElse(def PrizeCountry) PrizeCountry {
+	if v, ok := x.GetPrizeCountry(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeRegion returns PrizeRegion and true, or false if it or x is nil.
+func (x *Prize) GetPrizeRegion() (PrizeRegion, bool) {
+	if x == nil || x.PrizeRegion == nil {
+		var zero PrizeRegion
+		return zero, false
+	}
+	return *x.PrizeRegion, true
+}
+
+// PrizeRegionOrElse returns PrizeRegion, or def if it or x is nil.
+func (x *Prize) PrizeRegionOrElse(def PrizeRegion) PrizeRegion {
+	if v, ok := x.GetPrizeRegion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPrizeCode returns PrizeCode and true, or false if it or x is nil.
+func (x *Prize) GetPrizeCode() (PrizeCode, bool) {
+	if x == nil || x.PrizeCode == nil {
+		var zero PrizeCode
+		return zero, false
+	}
+	return *x.PrizeCode, true
+}
+
+// PrizeCodeOrElse returns PrizeCode, or def if it or x is nil.
+func (x *Prize) PrizeCodeOrElse(def PrizeCode) PrizeCode {
+	if v, ok := x.GetPrizeCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Prize) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Prize) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Prize) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Prize) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Prize) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Prize) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceType returns RecordSourceType and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceType() (RecordSourceType, bool) {
+	if x == nil || x.RecordSourceType == nil {
+		var zero RecordSourceType
+		return zero, false
+	}
+	return *x.RecordSourceType, true
+}
+
+// RecordSourceTypeOrElse returns RecordSourceType, or def if it or x is nil.
+func (x *Product) RecordSourceTypeOrElse(def RecordSourceType) RecordSourceType {
+	if v, ok := x.GetRecordSourceType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRecordSourceName returns RecordSourceName and true, or false if it or x is nil.
+func (x *Product) GetRecordSourceName() (PlainText, bool) {
+	if x == nil || x.RecordSourceName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RecordSourceName, true
+}
+
+// RecordSourceNameOrElse returns RecordSourceName, or def if it or x is nil.
+func (x *Product) RecordSourceNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRecordSourceName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDescriptiveDetail returns DescriptiveDetail and true, or false if it or x is nil.
+func (x *Product) GetDescriptiveDetail() (DescriptiveDetail, bool) {
+	if x == nil || x.DescriptiveDetail == nil {
+		var zero DescriptiveDetail
+		return zero, false
+	}
+	return *x.DescriptiveDetail, true
+}
+
+// DescriptiveDetailOrElse returns DescriptiveDetail, or def if it or x is nil.
+func (x *Product) DescriptiveDetailOrElse(def DescriptiveDetail) DescriptiveDetail {
+	if v, ok := x.GetDescriptiveDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCollateralDetail returns CollateralDetail and true, or false if it or x is nil.
+func (x *Product) GetCollateralDetail() (CollateralDetail, bool) {
+	if x == nil || x.CollateralDetail == nil {
+		var zero CollateralDetail
+		return zero, false
+	}
+	return *x.CollateralDetail, true
+}
+
+// CollateralDetailOrElse returns CollateralDetail, or def if it or x is nil.
+func (x *Product) CollateralDetailOrElse(def CollateralDetail) CollateralDetail {
+	if v, ok := x.GetCollateralDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPromotionDetail returns PromotionDetail and true, or false if it or x is nil.
+func (x *Product) GetPromotionDetail() (PromotionDetail, bool) {
+	if x == nil || x.PromotionDetail == nil {
+		var zero PromotionDetail
+		return zero, false
+	}
+	return *x.PromotionDetail, true
+}
+
+// PromotionDetailOrElse returns PromotionDetail, or def if it or x is nil.
+func (x *Product) PromotionDetailOrElse(def PromotionDetail) PromotionDetail {
+	if v, ok := x.GetPromotionDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContentDetail returns ContentDetail and true, or false if it or x is nil.
+func (x *Product) GetContentDetail() (ContentDetail, bool) {
+	if x == nil || x.ContentDetail == nil {
+		var zero ContentDetail
+		return zero, false
+	}
+	return *x.ContentDetail, true
+}
+
+// ContentDetailOrElse returns ContentDetail, or def if it or x is nil.
+func (x *Product) ContentDetailOrElse(def ContentDetail) ContentDetail {
+	if v, ok := x.GetContentDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublishingDetail returns PublishingDetail and true, or false if it or x is nil.
+func (x *Product) GetPublishingDetail() (PublishingDetail, bool) {
+	if x == nil || x.PublishingDetail == nil {
+		var zero PublishingDetail
+		return zero, false
+	}
+	return *x.PublishingDetail, true
+}
+
+// PublishingDetailOrElse returns PublishingDetail, or def if it or x is nil.
+func (x *Product) PublishingDetailOrElse(def PublishingDetail) PublishingDetail {
+	if v, ok := x.GetPublishingDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRelatedMaterial returns RelatedMaterial and true, or false if it or x is nil.
+func (x *Product) GetRelatedMaterial() (RelatedMaterial, bool) {
+	if x == nil || x.RelatedMaterial == nil {
+		var zero RelatedMaterial
+		return zero, false
+	}
+	return *x.RelatedMaterial, true
+}
+
+// RelatedMaterialOrElse returns RelatedMaterial, or def if it or x is nil.
+func (x *Product) RelatedMaterialOrElse(def RelatedMaterial) RelatedMaterial {
+	if v, ok := x.GetRelatedMaterial(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Product) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Product) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Product) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Product) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Product) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Product) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductClassificationTypeName returns ProductClassificationTypeName and true, or false if it or x is nil.
+func (x *ProductClassification) GetProductClassificationTypeName() (PlainText, bool) {
+	if x == nil || x.ProductClassificationTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductClassificationTypeName, true
+}
+
+// ProductClassificationTypeNameOrElse returns ProductClassificationTypeName, or def if it or x is nil.
+func (x *ProductClassification) ProductClassificationTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductClassificationTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPercent returns Percent and true, or false if it or x is nil.
+func (x *ProductClassification) GetPercent() (PlainText, bool) {
+	if x == nil || x.Percent == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Percent, true
+}
+
+// PercentOrElse returns Percent, or def if it or x is nil.
+func (x *ProductClassification) PercentOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPercent(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductClassification) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductClassification) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductClassification) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductClassification) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductClassification) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductClassification) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductContactName returns ProductContactName and true, or false if it or x is nil.
+func (x *ProductContact) GetProductContactName() (PlainText, bool) {
+	if x == nil || x.ProductContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductContactName, true
+}
+
+// ProductContactNameOrElse returns ProductContactName, or def if it or x is nil.
+func (x *ProductContact) ProductContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContactName returns ContactName and true, or false if it or x is nil.
+func (x *ProductContact) GetContactName() (PlainText, bool) {
+	if x == nil || x.ContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContactName, true
+}
+
+// ContactNameOrElse returns ContactName, or def if it or x is nil.
+func (x *ProductContact) ContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEmailAddress returns EmailAddress and true, or false if it or x is nil.
+func (x *ProductContact) GetEmailAddress() (PlainText, bool) {
+	if x == nil || x.EmailAddress == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EmailAddress, true
+}
+
+// EmailAddressOrElse returns EmailAddress, or def if it or x is nil.
+func (x *ProductContact) EmailAddressOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEmailAddress(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductContact) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductContact) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductContact) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductContact) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductContact) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductContact) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ProductContactIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ProductContactIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductContactIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductContactIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductContactIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductContactIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductContactIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductContactIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductFormFeatureValue returns ProductFormFeatureValue and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetProductFormFeatureValue() (PlainText, bool) {
+	if x == nil || x.ProductFormFeatureValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ProductFormFeatureValue, true
+}
+
+// ProductFormFeatureValueOrElse returns ProductFormFeatureValue, or def if it or x is nil.
+func (x *ProductFormFeature) ProductFormFeatureValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetProductFormFeatureValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductFormFeature) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductFormFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductFormFeature) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductFormFeature) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *ProductIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductPackaging returns ProductPackaging and true, or false if it or x is nil.
+func (x *ProductPart) GetProductPackaging() (ProductPackaging, bool) {
+	if x == nil || x.ProductPackaging == nil {
+		var zero ProductPackaging
+		return zero, false
+	}
+	return *x.ProductPackaging, true
+}
+
+// ProductPackagingOrElse returns ProductPackaging, or def if it or x is nil.
+func (x *ProductPart) ProductPackagingOrElse(def ProductPackaging) ProductPackaging {
+	if v, ok := x.GetProductPackaging(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfItemsOfThisForm returns NumberOfItemsOfThisForm and true, or false if it or x is nil.
+func (x *ProductPart) GetNumberOfItemsOfThisForm() (PlainText, bool) {
+	if x == nil || x.NumberOfItemsOfThisForm == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfItemsOfThisForm, true
+}
+
+// NumberOfItemsOfThisFormOrElse returns NumberOfItemsOfThisForm, or def if it or x is nil.
+func (x *ProductPart) NumberOfItemsOfThisFormOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfItemsOfThisForm(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfCopies returns NumberOfCopies and true, or false if it or x is nil.
+func (x *ProductPart) GetNumberOfCopies() (PlainText, bool) {
+	if x == nil || x.NumberOfCopies == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfCopies, true
+}
+
+// NumberOfCopiesOrElse returns NumberOfCopies, or def if it or x is nil.
+func (x *ProductPart) NumberOfCopiesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfCopies(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryOfManufacture returns CountryOfManufacture and true, or false if it or x is nil.
+func (x *ProductPart) GetCountryOfManufacture() (CountryOfManufacture, bool) {
+	if x == nil || x.CountryOfManufacture == nil {
+		var zero CountryOfManufacture
+		return zero, false
+	}
+	return *x.CountryOfManufacture, true
+}
+
+// CountryOfManufactureOrElse returns CountryOfManufacture, or def if it or x is nil.
+func (x *ProductPart) CountryOfManufactureOrElse(def CountryOfManufacture) CountryOfManufacture {
+	if v, ok := x.GetCountryOfManufacture(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductPart) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductPart) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductPart) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductPart) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductPart) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductPart) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetMarketPublishingDetail returns MarketPublishingDetail and true, or false if it or x is nil.
+func (x *ProductSupply) GetMarketPublishingDetail() (MarketPublishingDetail, bool) {
+	if x == nil || x.MarketPublishingDetail == nil {
+		var zero MarketPublishingDetail
+		return zero, false
+	}
+	return *x.MarketPublishingDetail, true
+}
+
+// MarketPublishingDetailOrElse returns MarketPublishingDetail, or def if it or x is nil.
+func (x *ProductSupply) MarketPublishingDetailOrElse(def MarketPublishingDetail) MarketPublishingDetail {
+	if v, ok := x.GetMarketPublishingDetail(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProductSupply) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProductSupply) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProductSupply) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProductSupply) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProductSupply) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProductSupply) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAffiliation returns Affiliation and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetAffiliation() (PlainText, bool) {
+	if x == nil || x.Affiliation == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Affiliation, true
+}
+
+// AffiliationOrElse returns Affiliation, or def if it or x is nil.
+func (x *ProfessionalAffiliation) AffiliationOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAffiliation(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ProfessionalAffiliation) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ProfessionalAffiliation) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ProfessionalAffiliation) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ProfessionalAffiliation) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PromotionDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PromotionDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PromotionDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PromotionDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PromotionDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PromotionDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEventStatus returns EventStatus and true, or false if it or x is nil.
+func (x *PromotionalEvent) GetEventStatus() (EventStatus, bool) {
+	if x == nil || x.EventStatus == nil {
+		var zero EventStatus
+		return zero, false
+	}
+	return *x.EventStatus, true
+}
+
+// EventStatusOrElse returns EventStatus, or def if it or x is nil.
+func (x *PromotionalEvent) EventStatusOrElse(def EventStatus) EventStatus {
+	if v, ok := x.GetEventStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PromotionalEvent) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PromotionalEvent) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PromotionalEvent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PromotionalEvent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PromotionalEvent) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PromotionalEvent) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherName returns PublisherName and true, or false if it or x is nil.
+func (x *Publisher) GetPublisherName() (PlainText, bool) {
+	if x == nil || x.PublisherName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherName, true
+}
+
+// PublisherNameOrElse returns PublisherName, or def if it or x is nil.
+func (x *Publisher) PublisherNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Publisher) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Publisher) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Publisher) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Publisher) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Publisher) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Publisher) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *PublisherIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *PublisherIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PublisherIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PublisherIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PublisherIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PublisherIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PublisherIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PublisherIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetAgentName returns AgentName and true, or false if it or x is nil.
+func (x *PublisherRepresentative) GetAgentName() (PlainText, bool) {
+	if x == nil || x.AgentName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.AgentName, true
+}
+
+// AgentNameOrElse returns AgentName, or def if it or x is nil.
+func (x *PublisherRepresentative) AgentNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetAgentName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PublisherRepresentative) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PublisherRepresentative) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PublisherRepresentative) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PublisherRepresentative) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PublisherRepresentative) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PublisherRepresentative) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *PublishingDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *PublishingDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PublishingDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PublishingDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PublishingDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PublishingDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PublishingDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PublishingDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountryOfPublication returns CountryOfPublication and true, or false if it or x is nil.
+func (x *PublishingDetail) GetCountryOfPublication() (CountryOfPublication, bool) {
+	if x == nil || x.CountryOfPublication == nil {
+		var zero CountryOfPublication
+		return zero, false
+	}
+	return *x.CountryOfPublication, true
+}
+
+// CountryOfPublicationOrElse returns CountryOfPublication, or def if it or x is nil.
+func (x *PublishingDetail) CountryOfPublicationOrElse(def CountryOfPublication) CountryOfPublication {
+	if v, ok := x.GetCountryOfPublication(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublishingStatus returns PublishingStatus and true, or false if it or x is nil.
+func (x *PublishingDetail) GetPublishingStatus() (PublishingStatus, bool) {
+	if x == nil || x.PublishingStatus == nil {
+		var zero PublishingStatus
+		return zero, false
+	}
+	return *x.PublishingStatus, true
+}
+
+// PublishingStatusOrElse returns PublishingStatus, or def if it or x is nil.
+func (x *PublishingDetail) PublishingStatusOrElse(def PublishingStatus) PublishingStatus {
+	if v, ok := x.GetPublishingStatus(); ok {
+		return v
+	}
+	return def
+}
+
+// GetLatestReprintNumber returns LatestReprintNumber and true, or false if it or x is nil.
+func (x *PublishingDetail) GetLatestReprintNumber() (PlainText, bool) {
+	if x == nil || x.LatestReprintNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.LatestReprintNumber, true
+}
+
+// LatestReprintNumberOrElse returns LatestReprintNumber, or def if it or x is nil.
+func (x *PublishingDetail) LatestReprintNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetLatestReprintNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetROWSalesRightsType returns ROWSalesRightsType and true, or false if it or x is nil.
+func (x *PublishingDetail) GetROWSalesRightsType() (ROWSalesRightsType, bool) {
+	if x == nil || x.ROWSalesRightsType == nil {
+		var zero ROWSalesRightsType
+		return zero, false
+	}
+	return *x.ROWSalesRightsType, true
+}
+
+// ROWSalesRightsTypeOrElse returns ROWSalesRightsType, or def if it or x is nil.
+func (x *PublishingDetail) ROWSalesRightsTypeOrElse(def ROWSalesRightsType) ROWSalesRightsType {
+	if v, ok := x.GetROWSalesRightsType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *PublishingDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *PublishingDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *PublishingDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *PublishingDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *PublishingDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *PublishingDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *RecordSourceIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *RecordSourceIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *RecordSourceIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *RecordSourceIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *RecordSourceIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *RecordSourceIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *RecordSourceIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *RecordSourceIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReissueDescription returns ReissueDescription and true, or false if it or x is nil.
+func (x *Reissue) GetReissueDescription() (Flow, bool) {
+	if x == nil || x.ReissueDescription == nil {
+		var zero Flow
+		return zero, false
+	}
+	return *x.ReissueDescription, true
+}
+
+// ReissueDescriptionOrElse returns ReissueDescription, or def if it or x is nil.
+func (x *Reissue) ReissueDescriptionOrElse(def Flow) Flow {
+	if v, ok := x.GetReissueDescription(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Reissue) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Reissue) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Reissue) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Reissue) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Reissue) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Reissue) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *RelatedMaterial) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *RelatedMaterial) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *RelatedMaterial) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *RelatedMaterial) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *RelatedMaterial) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *RelatedMaterial) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProductForm returns ProductForm and true, or false if it or x is nil.
+func (x *RelatedProduct) GetProductForm() (ProductForm, bool) {
+	if x == nil || x.ProductForm == nil {
+		var zero ProductForm
+		return zero, false
+	}
+	return *x.ProductForm, true
+}
+
+// ProductFormOrElse returns ProductForm, or def if it or x is nil.
+func (x *RelatedProduct) ProductFormOrElse(def ProductForm) ProductForm {
+	if v, ok := x.GetProductForm(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *RelatedProduct) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *RelatedProduct) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *RelatedProduct) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *RelatedProduct) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *RelatedProduct) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *RelatedProduct) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *RelatedWork) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *RelatedWork) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *RelatedWork) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *RelatedWork) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *RelatedWork) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *RelatedWork) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetBible returns Bible and true, or false if it or x is nil.
+func (x *ReligiousText) GetBible() (Bible, bool) {
+	if x == nil || x.Bible == nil {
+		var zero Bible
+		return zero, false
+	}
+	return *x.Bible, true
+}
+
+// BibleOrElse returns Bible, or def if it or x is nil.
+func (x *ReligiousText) BibleOrElse(def Bible) Bible {
+	if v, ok := x.GetBible(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReligiousTextIdentifier returns ReligiousTextIdentifier and true, or false if it or x is nil.
+func (x *ReligiousText) GetReligiousTextIdentifier() (ReligiousTextIdentifier, bool) {
+	if x == nil || x.ReligiousTextIdentifier == nil {
+		var zero ReligiousTextIdentifier
+		return zero, false
+	}
+	return *x.ReligiousTextIdentifier, true
+}
+
+// ReligiousTextIdentifierOrElse returns ReligiousTextIdentifier, or def if it or x is nil.
+func (x *ReligiousText) ReligiousTextIdentifierOrElse(def ReligiousTextIdentifier) ReligiousTextIdentifier {
+	if v, ok := x.GetReligiousTextIdentifier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReligiousText) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReligiousText) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReligiousText) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReligiousText) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReligiousText) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReligiousText) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReligiousTextFeature) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReligiousTextFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReligiousTextFeature) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReligiousTextFeature) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFeatureValue returns FeatureValue and true, or false if it or x is nil.
+func (x *ResourceFeature) GetFeatureValue() (PlainText, bool) {
+	if x == nil || x.FeatureValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FeatureValue, true
+}
+
+// FeatureValueOrElse returns FeatureValue, or def if it or x is nil.
+func (x *ResourceFeature) FeatureValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFeatureValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ResourceFeature) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ResourceFeature) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ResourceFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ResourceFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ResourceFeature) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ResourceFeature) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ResourceVersion) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ResourceVersion) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ResourceVersion) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ResourceVersion) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ResourceVersion) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ResourceVersion) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetFeatureValue returns FeatureValue and true, or false if it or x is nil.
+func (x *ResourceVersionFeature) GetFeatureValue() (PlainText, bool) {
+	if x == nil || x.FeatureValue == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.FeatureValue, true
+}
+
+// FeatureValueOrElse returns FeatureValue, or def if it or x is nil.
+func (x *ResourceVersionFeature) FeatureValueOrElse(def PlainText) PlainText {
+	if v, ok := x.GetFeatureValue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ResourceVersionFeature) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ResourceVersionFeature) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ResourceVersionFeature) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ResourceVersionFeature) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ResourceVersionFeature) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ResourceVersionFeature) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReturnsCodeTypeName returns ReturnsCodeTypeName and true, or false if it or x is nil.
+func (x *ReturnsConditions) GetReturnsCodeTypeName() (PlainText, bool) {
+	if x == nil || x.ReturnsCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ReturnsCodeTypeName, true
+}
+
+// ReturnsCodeTypeNameOrElse returns ReturnsCodeTypeName, or def if it or x is nil.
+func (x *ReturnsConditions) ReturnsCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReturnsCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReturnsConditions) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReturnsConditions) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReturnsConditions) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReturnsConditions) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReturnsConditions) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReturnsConditions) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRatingLimit returns RatingLimit and true, or false if it or x is nil.
+func (x *ReviewRating) GetRatingLimit() (PlainText, bool) {
+	if x == nil || x.RatingLimit == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RatingLimit, true
+}
+
+// RatingLimitOrElse returns RatingLimit, or def if it or x is nil.
+func (x *ReviewRating) RatingLimitOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRatingLimit(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *ReviewRating) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *ReviewRating) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *ReviewRating) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *ReviewRating) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *ReviewRating) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *ReviewRating) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSalesOutletName returns SalesOutletName and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSalesOutletName() (PlainText, bool) {
+	if x == nil || x.SalesOutletName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SalesOutletName, true
+}
+
+// SalesOutletNameOrElse returns SalesOutletName, or def if it or x is nil.
+func (x *SalesOutlet) SalesOutletNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSalesOutletName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesOutlet) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesOutlet) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesOutlet) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesOutlet) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesOutlet) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SalesOutletIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesOutletIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesOutletIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesOutletIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesOutletIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStartDate returns StartDate and true, or false if it or x is nil.
+func (x *SalesRestriction) GetStartDate() (PlainText, bool) {
+	if x == nil || x.StartDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.StartDate, true
+}
+
+// StartDateOrElse returns StartDate, or def if it or x is nil.
+func (x *SalesRestriction) StartDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetStartDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEndDate returns EndDate and true, or false if it or x is nil.
+func (x *SalesRestriction) GetEndDate() (PlainText, bool) {
+	if x == nil || x.EndDate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EndDate, true
+}
+
+// EndDateOrElse returns EndDate, or def if it or x is nil.
+func (x *SalesRestriction) EndDateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEndDate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesRestriction) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesRestriction) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesRestriction) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesRestriction) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesRestriction) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesRestriction) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPublisherName returns PublisherName and true, or false if it or x is nil.
+func (x *SalesRights) GetPublisherName() (PlainText, bool) {
+	if x == nil || x.PublisherName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PublisherName, true
+}
+
+// PublisherNameOrElse returns PublisherName, or def if it or x is nil.
+func (x *SalesRights) PublisherNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPublisherName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SalesRights) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SalesRights) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SalesRights) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SalesRights) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SalesRights) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SalesRights) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSenderName returns SenderName and true, or false if it or x is nil.
+func (x *Sender) GetSenderName() (PlainText, bool) {
+	if x == nil || x.SenderName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SenderName, true
+}
+
+// SenderNameOrElse returns SenderName, or def if it or x is nil.
+func (x *Sender) SenderNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSenderName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContactName returns ContactName and true, or false if it or x is nil.
+func (x *Sender) GetContactName() (PlainText, bool) {
+	if x == nil || x.ContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContactName, true
+}
+
+// ContactNameOrElse returns ContactName, or def if it or x is nil.
+func (x *Sender) ContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEmailAddress returns EmailAddress and true, or false if it or x is nil.
+func (x *Sender) GetEmailAddress() (PlainText, bool) {
+	if x == nil || x.EmailAddress == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EmailAddress, true
+}
+
+// EmailAddressOrElse returns EmailAddress, or def if it or x is nil.
+func (x *Sender) EmailAddressOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEmailAddress(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Sender) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Sender) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Sender) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Sender) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Sender) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Sender) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SenderIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SenderIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SenderIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SenderIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SenderIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOnHand returns OnHand and true, or false if it or x is nil.
+func (x *Stock) GetOnHand() (PlainText, bool) {
+	if x == nil || x.OnHand == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OnHand, true
+}
+
+// OnHandOrElse returns OnHand, or def if it or x is nil.
+func (x *Stock) OnHandOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOnHand(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProximity returns Proximity and true, or false if it or x is nil.
+func (x *Stock) GetProximity() (Proximity, bool) {
+	if x == nil || x.Proximity == nil {
+		var zero Proximity
+		return zero, false
+	}
+	return *x.Proximity, true
+}
+
+// ProximityOrElse returns Proximity, or def if it or x is nil.
+func (x *Stock) ProximityOrElse(def Proximity) Proximity {
+	if v, ok := x.GetProximity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReserved returns Reserved and true, or false if it or x is nil.
+func (x *Stock) GetReserved() (PlainText, bool) {
+	if x == nil || x.Reserved == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Reserved, true
+}
+
+// ReservedOrElse returns Reserved, or def if it or x is nil.
+func (x *Stock) ReservedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetReserved(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOnOrder returns OnOrder and true, or false if it or x is nil.
+func (x *Stock) GetOnOrder() (PlainText, bool) {
+	if x == nil || x.OnOrder == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OnOrder, true
+}
+
+// OnOrderOrElse returns OnOrder, or def if it or x is nil.
+func (x *Stock) OnOrderOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOnOrder(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCBO returns CBO and true, or false if it or x is nil.
+func (x *Stock) GetCBO() (PlainText, bool) {
+	if x == nil || x.CBO == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CBO, true
+}
+
+// CBOOrElse returns CBO, or def if it or x is nil.
+func (x *Stock) CBOOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCBO(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Stock) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Stock) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Stock) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Stock) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Stock) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Stock) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetStockQuantityCodeTypeName returns StockQuantityCodeTypeName and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetStockQuantityCodeTypeName() (PlainText, bool) {
+	if x == nil || x.StockQuantityCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.StockQuantityCodeTypeName, true
+}
+
+// StockQuantityCodeTypeNameOrElse returns StockQuantityCodeTypeName, or def if it or x is nil.
+func (x *StockQuantityCoded) StockQuantityCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetStockQuantityCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *StockQuantityCoded) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *StockQuantityCoded) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *StockQuantityCoded) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *StockQuantityCoded) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectSchemeName returns SubjectSchemeName and true, or false if it or x is nil.
+func (x *Subject) GetSubjectSchemeName() (PlainText, bool) {
+	if x == nil || x.SubjectSchemeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectSchemeName, true
+}
+
+// SubjectSchemeNameOrElse returns SubjectSchemeName, or def if it or x is nil.
+func (x *Subject) SubjectSchemeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectSchemeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectSchemeVersion returns SubjectSchemeVersion and true, or false if it or x is nil.
+func (x *Subject) GetSubjectSchemeVersion() (PlainText, bool) {
+	if x == nil || x.SubjectSchemeVersion == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectSchemeVersion, true
+}
+
+// SubjectSchemeVersionOrElse returns SubjectSchemeVersion, or def if it or x is nil.
+func (x *Subject) SubjectSchemeVersionOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectSchemeVersion(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubjectCode returns SubjectCode and true, or false if it or x is nil.
+func (x *Subject) GetSubjectCode() (PlainText, bool) {
+	if x == nil || x.SubjectCode == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SubjectCode, true
+}
+
+// SubjectCodeOrElse returns SubjectCode, or def if it or x is nil.
+func (x *Subject) SubjectCodeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubjectCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Subject) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Subject) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Subject) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Subject) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Subject) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Subject) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *SubjectDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *SubjectDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SubjectDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SubjectDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SubjectDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SubjectDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SubjectDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SubjectDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierName returns SupplierName and true, or false if it or x is nil.
+func (x *Supplier) GetSupplierName() (PlainText, bool) {
+	if x == nil || x.SupplierName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierName, true
+}
+
+// SupplierNameOrElse returns SupplierName, or def if it or x is nil.
+func (x *Supplier) SupplierNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Supplier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Supplier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Supplier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Supplier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Supplier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Supplier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SupplierIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplierIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplierIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplierIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplierIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplierCodeTypeName returns SupplierCodeTypeName and true, or false if it or x is nil.
+func (x *SupplierOwnCoding) GetSupplierCodeTypeName() (PlainText, bool) {
+	if x == nil || x.SupplierCodeTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplierCodeTypeName, true
+}
+
+// SupplierCodeTypeNameOrElse returns SupplierCodeTypeName, or def if it or x is nil.
+func (x *SupplierOwnCoding) SupplierCodeTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplierCodeTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplierOwnCoding) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplierOwnCoding) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplierOwnCoding) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplierOwnCoding) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplierOwnCoding) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplierOwnCoding) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSupplyContactName returns SupplyContactName and true, or false if it or x is nil.
+func (x *SupplyContact) GetSupplyContactName() (PlainText, bool) {
+	if x == nil || x.SupplyContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SupplyContactName, true
+}
+
+// SupplyContactNameOrElse returns SupplyContactName, or def if it or x is nil.
+func (x *SupplyContact) SupplyContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSupplyContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetContactName returns ContactName and true, or false if it or x is nil.
+func (x *SupplyContact) GetContactName() (PlainText, bool) {
+	if x == nil || x.ContactName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.ContactName, true
+}
+
+// ContactNameOrElse returns ContactName, or def if it or x is nil.
+func (x *SupplyContact) ContactNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetContactName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEmailAddress returns EmailAddress and true, or false if it or x is nil.
+func (x *SupplyContact) GetEmailAddress() (PlainText, bool) {
+	if x == nil || x.EmailAddress == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EmailAddress, true
+}
+
+// EmailAddressOrElse returns EmailAddress, or def if it or x is nil.
+func (x *SupplyContact) EmailAddressOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEmailAddress(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplyContact) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplyContact) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplyContact) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplyContact) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplyContact) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplyContact) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *SupplyContactIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *SupplyContactIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplyContactIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplyContactIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplyContactIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplyContactIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplyContactIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplyContactIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDateFormat returns DateFormat and true, or false if it or x is nil.
+func (x *SupplyDate) GetDateFormat() (DateFormat, bool) {
+	if x == nil || x.DateFormat == nil {
+		var zero DateFormat
+		return zero, false
+	}
+	return *x.DateFormat, true
+}
+
+// DateFormatOrElse returns DateFormat, or def if it or x is nil.
+func (x *SupplyDate) DateFormatOrElse(def DateFormat) DateFormat {
+	if v, ok := x.GetDateFormat(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplyDate) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplyDate) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplyDate) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplyDate) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplyDate) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplyDate) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOrderTime returns OrderTime and true, or false if it or x is nil.
+func (x *SupplyDetail) GetOrderTime() (PlainText, bool) {
+	if x == nil || x.OrderTime == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OrderTime, true
+}
+
+// OrderTimeOrElse returns OrderTime, or def if it or x is nil.
+func (x *SupplyDetail) OrderTimeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOrderTime(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNewSupplier returns NewSupplier and true, or false if it or x is nil.
+func (x *SupplyDetail) GetNewSupplier() (NewSupplier, bool) {
+	if x == nil || x.NewSupplier == nil {
+		var zero NewSupplier
+		return zero, false
+	}
+	return *x.NewSupplier, true
+}
+
+// NewSupplierOrElse returns NewSupplier, or def if it or x is nil.
+func (x *SupplyDetail) NewSupplierOrElse(def NewSupplier) NewSupplier {
+	if v, ok := x.GetNewSupplier(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPackQuantity returns PackQuantity and true, or false if it or x is nil.
+func (x *SupplyDetail) GetPackQuantity() (PlainText, bool) {
+	if x == nil || x.PackQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PackQuantity, true
+}
+
+// PackQuantityOrElse returns PackQuantity, or def if it or x is nil.
+func (x *SupplyDetail) PackQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPackQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPalletQuantity returns PalletQuantity and true, or false if it or x is nil.
+func (x *SupplyDetail) GetPalletQuantity() (PlainText, bool) {
+	if x == nil || x.PalletQuantity == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PalletQuantity, true
+}
+
+// PalletQuantityOrElse returns PalletQuantity, or def if it or x is nil.
+func (x *SupplyDetail) PalletQuantityOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPalletQuantity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetOrderQuantityMultiple returns OrderQuantityMultiple and true, or false if it or x is nil.
+func (x *SupplyDetail) GetOrderQuantityMultiple() (PlainText, bool) {
+	if x == nil || x.OrderQuantityMultiple == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.OrderQuantityMultiple, true
+}
+
+// OrderQuantityMultipleOrElse returns OrderQuantityMultiple, or def if it or x is nil.
+func (x *SupplyDetail) OrderQuantityMultipleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetOrderQuantityMultiple(); ok {
+		return v
+	}
+	return def
+}
+
+// GetUnpricedItemType returns UnpricedItemType and true, or false if it or x is nil.
+func (x *SupplyDetail) GetUnpricedItemType() (UnpricedItemType, bool) {
+	if x == nil || x.UnpricedItemType == nil {
+		var zero UnpricedItemType
+		return zero, false
+	}
+	return *x.UnpricedItemType, true
+}
+
+// UnpricedItemTypeOrElse returns UnpricedItemType, or def if it or x is nil.
+func (x *SupplyDetail) UnpricedItemTypeOrElse(def UnpricedItemType) UnpricedItemType {
+	if v, ok := x.GetUnpricedItemType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReissue returns Reissue and true, or false if it or x is nil.
+func (x *SupplyDetail) GetReissue() (Reissue, bool) {
+	if x == nil || x.Reissue == nil {
+		var zero Reissue
+		return zero, false
+	}
+	return *x.Reissue, true
+}
+
+// ReissueOrElse returns Reissue, or def if it or x is nil.
+func (x *SupplyDetail) ReissueOrElse(def Reissue) Reissue {
+	if v, ok := x.GetReissue(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupplyDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupplyDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupplyDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupplyDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupplyDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritory returns Territory and true, or false if it or x is nil.
+func (x *SupportingResource) GetTerritory() (Territory, bool) {
+	if x == nil || x.Territory == nil {
+		var zero Territory
+		return zero, false
+	}
+	return *x.Territory, true
+}
+
+// TerritoryOrElse returns Territory, or def if it or x is nil.
+func (x *SupportingResource) TerritoryOrElse(def Territory) Territory {
+	if v, ok := x.GetTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *SupportingResource) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *SupportingResource) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *SupportingResource) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *SupportingResource) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *SupportingResource) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *SupportingResource) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxType returns TaxType and true, or false if it or x is nil.
+func (x *Tax) GetTaxType() (TaxType, bool) {
+	if x == nil || x.TaxType == nil {
+		var zero TaxType
+		return zero, false
+	}
+	return *x.TaxType, true
+}
+
+// TaxTypeOrElse returns TaxType, or def if it or x is nil.
+func (x *Tax) TaxTypeOrElse(def TaxType) TaxType {
+	if v, ok := x.GetTaxType(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRateCode returns TaxRateCode and true, or false if it or x is nil.
+func (x *Tax) GetTaxRateCode() (TaxRateCode, bool) {
+	if x == nil || x.TaxRateCode == nil {
+		var zero TaxRateCode
+		return zero, false
+	}
+	return *x.TaxRateCode, true
+}
+
+// TaxRateCodeOrElse returns TaxRateCode, or def if it or x is nil.
+func (x *Tax) TaxRateCodeOrElse(def TaxRateCode) TaxRateCode {
+	if v, ok := x.GetTaxRateCode(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxRatePercent returns TaxRatePercent and true, or false if it or x is nil.
+func (x *Tax) GetTaxRatePercent() (PlainText, bool) {
+	if x == nil || x.TaxRatePercent == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxRatePercent, true
+}
+
+// TaxRatePercentOrElse returns TaxRatePercent, or def if it or x is nil.
+func (x *Tax) TaxRatePercentOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxRatePercent(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxableAmount returns TaxableAmount and true, or false if it or x is nil.
+func (x *Tax) GetTaxableAmount() (PlainText, bool) {
+	if x == nil || x.TaxableAmount == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxableAmount, true
+}
+
+// TaxableAmountOrElse returns TaxableAmount, or def if it or x is nil.
+func (x *Tax) TaxableAmountOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxableAmount(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTaxAmount returns TaxAmount and true, or false if it or x is nil.
+func (x *Tax) GetTaxAmount() (PlainText, bool) {
+	if x == nil || x.TaxAmount == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TaxAmount, true
+}
+
+// TaxAmountOrElse returns TaxAmount, or def if it or x is nil.
+func (x *Tax) TaxAmountOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTaxAmount(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Tax) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Tax) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Tax) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Tax) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Tax) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Tax) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountriesIncluded returns CountriesIncluded and true, or false if it or x is nil.
+func (x *Territory) GetCountriesIncluded() (PlainText, bool) {
+	if x == nil || x.CountriesIncluded == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CountriesIncluded, true
+}
+
+// CountriesIncludedOrElse returns CountriesIncluded, or def if it or x is nil.
+func (x *Territory) CountriesIncludedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCountriesIncluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRegionsIncluded returns RegionsIncluded and true, or false if it or x is nil.
+func (x *Territory) GetRegionsIncluded() (PlainText, bool) {
+	if x == nil || x.RegionsIncluded == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RegionsIncluded, true
+}
+
+// RegionsIncludedOrElse returns RegionsIncluded, or def if it or x is nil.
+func (x *Territory) RegionsIncludedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRegionsIncluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetCountriesExcluded returns CountriesExcluded and true, or false if it or x is nil.
+func (x *Territory) GetCountriesExcluded() (PlainText, bool) {
+	if x == nil || x.CountriesExcluded == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.CountriesExcluded, true
+}
+
+// CountriesExcludedOrElse returns CountriesExcluded, or def if it or x is nil.
+func (x *Territory) CountriesExcludedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetCountriesExcluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetRegionsExcluded returns RegionsExcluded and true, or false if it or x is nil.
+func (x *Territory) GetRegionsExcluded() (PlainText, bool) {
+	if x == nil || x.RegionsExcluded == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.RegionsExcluded, true
+}
+
+// RegionsExcludedOrElse returns RegionsExcluded, or def if it or x is nil.
+func (x *Territory) RegionsExcludedOrElse(def PlainText) PlainText {
+	if v, ok := x.GetRegionsExcluded(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Territory) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Territory) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Territory) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Territory) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Territory) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Territory) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTerritory returns Territory and true, or false if it or x is nil.
+func (x *TextContent) GetTerritory() (Territory, bool) {
+	if x == nil || x.Territory == nil {
+		var zero Territory
+		return zero, false
+	}
+	return *x.Territory, true
+}
+
+// TerritoryOrElse returns Territory, or def if it or x is nil.
+func (x *TextContent) TerritoryOrElse(def Territory) Territory {
+	if v, ok := x.GetTerritory(); ok {
+		return v
+	}
+	return def
+}
+
+// GetReviewRating returns ReviewRating and true, or false if it or x is nil.
+func (x *TextContent) GetReviewRating() (ReviewRating, bool) {
+	if x == nil || x.ReviewRating == nil {
+		var zero ReviewRating
+		return zero, false
+	}
+	return *x.ReviewRating, true
+}
+
+// ReviewRatingOrElse returns ReviewRating, or def if it or x is nil.
+func (x *TextContent) ReviewRatingOrElse(def ReviewRating) ReviewRating {
+	if v, ok := x.GetReviewRating(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTextSourceCorporate returns TextSourceCorporate and true, or false if it or x is nil.
+func (x *TextContent) GetTextSourceCorporate() (PlainText, bool) {
+	if x == nil || x.TextSourceCorporate == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TextSourceCorporate, true
+}
+
+// TextSourceCorporateOrElse returns TextSourceCorporate, or def if it or x is nil.
+func (x *TextContent) TextSourceCorporateOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTextSourceCorporate(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TextContent) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TextContent) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TextContent) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TextContent) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TextContent) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TextContent) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetNumberOfPages returns NumberOfPages and true, or false if it or x is nil.
+func (x *TextItem) GetNumberOfPages() (PlainText, bool) {
+	if x == nil || x.NumberOfPages == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.NumberOfPages, true
+}
+
+// NumberOfPagesOrElse returns NumberOfPages, or def if it or x is nil.
+func (x *TextItem) NumberOfPagesOrElse(def PlainText) PlainText {
+	if v, ok := x.GetNumberOfPages(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TextItem) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TextItem) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TextItem) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TextItem) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TextItem) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TextItem) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *TextItemIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TextItemIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TextItemIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TextItemIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TextItemIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetEndTime returns EndTime and true, or false if it or x is nil.
+func (x *TimeRun) GetEndTime() (PlainText, bool) {
+	if x == nil || x.EndTime == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.EndTime, true
+}
+
+// EndTimeOrElse returns EndTime, or def if it or x is nil.
+func (x *TimeRun) EndTimeOrElse(def PlainText) PlainText {
+	if v, ok := x.GetEndTime(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TimeRun) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TimeRun) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TimeRun) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TimeRun) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TimeRun) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TimeRun) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleStatement returns TitleStatement and true, or false if it or x is nil.
+func (x *TitleDetail) GetTitleStatement() (Flow, bool) {
+	if x == nil || x.TitleStatement == nil {
+		var zero Flow
+		return zero, false
+	}
+	return *x.TitleStatement, true
+}
+
+// TitleStatementOrElse returns TitleStatement, or def if it or x is nil.
+func (x *TitleDetail) TitleStatementOrElse(def Flow) Flow {
+	if v, ok := x.GetTitleStatement(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TitleDetail) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TitleDetail) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TitleDetail) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TitleDetail) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TitleDetail) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TitleDetail) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSequenceNumber returns SequenceNumber and true, or false if it or x is nil.
+func (x *TitleElement) GetSequenceNumber() (PlainText, bool) {
+	if x == nil || x.SequenceNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.SequenceNumber, true
+}
+
+// SequenceNumberOrElse returns SequenceNumber, or def if it or x is nil.
+func (x *TitleElement) SequenceNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSequenceNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetPartNumber returns PartNumber and true, or false if it or x is nil.
+func (x *TitleElement) GetPartNumber() (PlainText, bool) {
+	if x == nil || x.PartNumber == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.PartNumber, true
+}
+
+// PartNumberOrElse returns PartNumber, or def if it or x is nil.
+func (x *TitleElement) PartNumberOrElse(def PlainText) PlainText {
+	if v, ok := x.GetPartNumber(); ok {
+		return v
+	}
+	return def
+}
+
+// GetYearOfAnnual returns YearOfAnnual and true, or false if it or x is nil.
+func (x *TitleElement) GetYearOfAnnual() (PlainText, bool) {
+	if x == nil || x.YearOfAnnual == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.YearOfAnnual, true
+}
+
+// YearOfAnnualOrElse returns YearOfAnnual, or def if it or x is nil.
+func (x *TitleElement) YearOfAnnualOrElse(def PlainText) PlainText {
+	if v, ok := x.GetYearOfAnnual(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleText returns TitleText and true, or false if it or x is nil.
+func (x *TitleElement) GetTitleText() (PlainText, bool) {
+	if x == nil || x.TitleText == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleText, true
+}
+
+// TitleTextOrElse returns TitleText, or def if it or x is nil.
+func (x *TitleElement) TitleTextOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleText(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitlePrefix returns TitlePrefix and true, or false if it or x is nil.
+func (x *TitleElement) GetTitlePrefix() (PlainText, bool) {
+	if x == nil || x.TitlePrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitlePrefix, true
+}
+
+// TitlePrefixOrElse returns TitlePrefix, or def if it or x is nil.
+func (x *TitleElement) TitlePrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitlePrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetTitleWithoutPrefix returns TitleWithoutPrefix and true, or false if it or x is nil.
+func (x *TitleElement) GetTitleWithoutPrefix() (PlainText, bool) {
+	if x == nil || x.TitleWithoutPrefix == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.TitleWithoutPrefix, true
+}
+
+// TitleWithoutPrefixOrElse returns TitleWithoutPrefix, or def if it or x is nil.
+func (x *TitleElement) TitleWithoutPrefixOrElse(def PlainText) PlainText {
+	if v, ok := x.GetTitleWithoutPrefix(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSubtitle returns Subtitle and true, or false if it or x is nil.
+func (x *TitleElement) GetSubtitle() (PlainText, bool) {
+	if x == nil || x.Subtitle == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.Subtitle, true
+}
+
+// SubtitleOrElse returns Subtitle, or def if it or x is nil.
+func (x *TitleElement) SubtitleOrElse(def PlainText) PlainText {
+	if v, ok := x.GetSubtitle(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *TitleElement) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *TitleElement) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *TitleElement) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *TitleElement) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *TitleElement) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *TitleElement) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetProximity returns Proximity and true, or false if it or x is nil.
+func (x *Velocity) GetProximity() (Proximity, bool) {
+	if x == nil || x.Proximity == nil {
+		var zero Proximity
+		return zero, false
+	}
+	return *x.Proximity, true
+}
+
+// ProximityOrElse returns Proximity, or def if it or x is nil.
+func (x *Velocity) ProximityOrElse(def Proximity) Proximity {
+	if v, ok := x.GetProximity(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Velocity) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Velocity) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Velocity) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Velocity) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Velocity) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Velocity) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetWebsiteRole returns WebsiteRole and true, or false if it or x is nil.
+func (x *Website) GetWebsiteRole() (WebsiteRole, bool) {
+	if x == nil || x.WebsiteRole == nil {
+		var zero WebsiteRole
+		return zero, false
+	}
+	return *x.WebsiteRole, true
+}
+
+// WebsiteRoleOrElse returns WebsiteRole, or def if it or x is nil.
+func (x *Website) WebsiteRoleOrElse(def WebsiteRole) WebsiteRole {
+	if v, ok := x.GetWebsiteRole(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *Website) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *Website) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *Website) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *Website) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *Website) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *Website) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
+
+// GetIDTypeName returns IDTypeName and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetIDTypeName() (PlainText, bool) {
+	if x == nil || x.IDTypeName == nil {
+		var zero PlainText
+		return zero, false
+	}
+	return *x.IDTypeName, true
+}
+
+// IDTypeNameOrElse returns IDTypeName, or def if it or x is nil.
+func (x *WorkIdentifier) IDTypeNameOrElse(def PlainText) PlainText {
+	if v, ok := x.GetIDTypeName(); ok {
+		return v
+	}
+	return def
+}
+
+// GetDatestamp returns Datestamp and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetDatestamp() (DtDotDateOrDateTime, bool) {
+	if x == nil || x.Datestamp == nil {
+		var zero DtDotDateOrDateTime
+		return zero, false
+	}
+	return *x.Datestamp, true
+}
+
+// DatestampOrElse returns Datestamp, or def if it or x is nil.
+func (x *WorkIdentifier) DatestampOrElse(def DtDotDateOrDateTime) DtDotDateOrDateTime {
+	if v, ok := x.GetDatestamp(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcetype returns Sourcetype and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetSourcetype() (SourceTypeCode, bool) {
+	if x == nil || x.Sourcetype == nil {
+		var zero SourceTypeCode
+		return zero, false
+	}
+	return *x.Sourcetype, true
+}
+
+// SourcetypeOrElse returns Sourcetype, or def if it or x is nil.
+func (x *WorkIdentifier) SourcetypeOrElse(def SourceTypeCode) SourceTypeCode {
+	if v, ok := x.GetSourcetype(); ok {
+		return v
+	}
+	return def
+}
+
+// GetSourcename returns Sourcename and true, or false if it or x is nil.
+func (x *WorkIdentifier) GetSourcename() (DtDotNonEmptyString, bool) {
+	if x == nil || x.Sourcename == nil {
+		var zero DtDotNonEmptyString
+		return zero, false
+	}
+	return *x.Sourcename, true
+}
+
+// SourcenameOrElse returns Sourcename, or def if it or x is nil.
+func (x *WorkIdentifier) SourcenameOrElse(def DtDotNonEmptyString) DtDotNonEmptyString {
+	if v, ok := x.GetSourcename(); ok {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,172 @@
+package onix
+
+import "strings"
+
+// PrizeAchievement is code of List 41 which tells achievement of product for prize, such as 01 for winner.
+type PrizeAchievement string
+
+const (
+	// PrizeWinner is winner of prize.
+	PrizeWinner PrizeAchievement = "01"
+	// PrizeRunnerUp is runner-up of prize.
+	PrizeRunnerUp PrizeAchievement = "02"
+	// PrizeCommended is commended by jury of prize.
+	PrizeCommended PrizeAchievement = "03"
+	// PrizeShortListed is short-listed for prize.
+	PrizeShortListed PrizeAchievement = "04"
+	// PrizeLongListed is long-listed for prize.
+	PrizeLongListed PrizeAchievement = "05"
+	// PrizeJointWinner is one of joint winners of prize.
+	PrizeJointWinner PrizeAchievement = "06"
+	// PrizeNominated is nominated for prize.
+	PrizeNominated PrizeAchievement = "07"
+)
+
+// IsWon reports whether a is of product which won prize, jointly or not.
+func (a PrizeAchievement) IsWon() bool {
+	return a == PrizeWinner || a == PrizeJointWinner
+}
+
+// Award is a prize which product or contributor has been awarded or is listed for.
+type Award struct {
+	Name string
+	// Year is zero unless PrizeYear is given.
+	Year int64
+	// Country is a code of List 91 of country which awards prize, which is empty unless it is given.
+	Country string
+	// Achievement is empty unless PrizeCode is given.
+	Achievement PrizeAchievement
+	Jury        string
+}
+
+// Award returns prize as an Award, whose name and jury are the first of ones in each language.
+func (p Prize) Award() Award {
+	var a Award
+	if len(p.PrizeNames) > 0 {
+		a.Name = strings.TrimSpace(p.PrizeNames[0].Body)
+	}
+	a.Year, _ = quantityOf(p.PrizeYear)
+	if p.PrizeCountry != nil {
+		a.Country = strings.Join(p.PrizeCountry.Codes(), " ")
+	}
+	if p.PrizeCode != nil {
+		a.Achievement = PrizeAchievement(p.PrizeCode.Code())
+	}
+	if len(p.PrizeJurys) > 0 {
+		a.Jury = strings.TrimSpace(string(p.PrizeJurys[0]))
+	}
+	return a
+}
+
+// CitedContentKind is code of List 156 which tells what content is cited, such as 01 for review.
+type CitedContentKind string
+
+const (
+	// CitedContentReview is review of product.
+	CitedContentReview CitedContentKind = "01"
+	// CitedContentBestsellerList is bestseller list which product is on.
+	CitedContentBestsellerList CitedContentKind = "02"
+	// CitedContentMediaMention is mention of product in media other than reviews.
+	CitedContentMediaMention CitedContentKind = "03"
+	// CitedContentOneLocalityOneBook is program of one locality, one book which product is chosen for.
+	CitedContentOneLocalityOneBook CitedContentKind = "04"
+	// CitedContentCuratedList is curated list which product is on.
+	CitedContentCuratedList CitedContentKind = "05"
+)
+
+// ContentDateRolePublication is code of List 155 of publication date of content.
+const ContentDateRolePublication = "01"
+
+// reviewTextTypes are codes of List 153 of texts which quote reviews.
+var reviewTextTypes = []string{"06", "07", "08"}
+
+// descriptionTextTypes are codes of List 153 of texts which describe product, in order of precedence.
+var descriptionTextTypes = []string{"03", "02"}
+
+// Description returns long or short description of product, which may be marked up in HTML,
+// and is the first of ones in each language.
+func (d CollateralDetail) Description() (string, bool) {
+	for _, t := range descriptionTextTypes {
+		for _, c := range d.TextContents {
+			if c.TextType.Code() == t && len(c.Texts) > 0 {
+				return string(c.Texts[0]), true
+			}
+		}
+	}
+	return "", false
+}
+
+// Review is a quote from review of product, or the review itself.
+type Review struct {
+	// Type is a code of List 153 such as 06 for review quote.
+	Type  string
+	Quote string
+	// Author is author of review, which is empty unless it is given.
+	Author string
+	// Source is title of publication or name of organization which review is published by.
+	Source string
+	// Date is publication date of review, whose Precision is DatePrecisionText unless it is given.
+	Date Date
+	// Link is link to review, which is empty unless it is given.
+	Link string
+}
+
+// Review returns text as a Review if it quotes a review, whose quote and author are the first of ones in each language.
+func (c TextContent) Review() (Review, bool) {
+	r := Review{Type: c.TextType.Code()}
+	if !containsCode(reviewTextTypes, r.Type) {
+		return Review{}, false
+	}
+	if len(c.Texts) > 0 {
+		r.Quote = strings.TrimSpace(string(c.Texts[0]))
+	}
+	if len(c.TextAuthors) > 0 {
+		r.Author = strings.TrimSpace(c.TextAuthors[0].Body)
+	}
+	if len(c.SourceTitles) > 0 {
+		r.Source = strings.TrimSpace(c.SourceTitles[0].Body)
+	} else if c.TextSourceCorporate != nil {
+		r.Source = strings.TrimSpace(c.TextSourceCorporate.Body)
+	}
+	r.Date, _ = publicationDateOf(c.ContentDates)
+	return r, true
+}
+
+// Kind returns what content is cited.
+func (c CitedContent) Kind() CitedContentKind {
+	return CitedContentKind(c.CitedContentType.Code())
+}
+
+// Review returns cited content as a Review of type 06 without quote if it is a review.
+func (c CitedContent) Review() (Review, bool) {
+	if c.Kind() != CitedContentReview {
+		return Review{}, false
+	}
+	r := Review{Type: "06"}
+	if len(c.SourceTitles) > 0 {
+		r.Source = strings.TrimSpace(c.SourceTitles[0].Body)
+	}
+	r.Date, _ = publicationDateOf(c.ContentDates)
+	if len(c.ResourceLinks) > 0 {
+		r.Link = strings.TrimSpace(c.ResourceLinks[0].Body)
+	}
+	return r, true
+}
+
+func publicationDateOf(dates []ContentDate) (Date, bool) {
+	for _, d := range dates {
+		if d.ContentDateRole.Code() == ContentDateRolePublication {
+			return dateOf(&d.Date, d.DateFormat)
+		}
+	}
+	return Date{}, false
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
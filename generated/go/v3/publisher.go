@@ -0,0 +1,116 @@
+package onix
+
+import "strings"
+
+// PublishingRoleCode is code of List 45 which tells role of publisher, such as 02 for co-publisher.
+type PublishingRoleCode string
+
+const (
+	// PublishingRolePublisher is publisher of product.
+	PublishingRolePublisher PublishingRoleCode = "01"
+	// PublishingRoleCoPublisher is one of publishers which publish product jointly.
+	PublishingRoleCoPublisher PublishingRoleCode = "02"
+	// PublishingRoleSponsor is sponsor of publication.
+	PublishingRoleSponsor PublishingRoleCode = "03"
+	// PublishingRoleOriginalLanguagePublisher is publisher of version of product in original language.
+	PublishingRoleOriginalLanguagePublisher PublishingRoleCode = "04"
+	// PublishingRoleHost is host or distributor of electronic content.
+	PublishingRoleHost PublishingRoleCode = "05"
+	// PublishingRolePublishedFor is organization which product is published for or on behalf of.
+	PublishingRolePublishedFor PublishingRoleCode = "06"
+	// PublishingRolePublishedInAssociationWith is organization which product is published in association with.
+	PublishingRolePublishedInAssociationWith PublishingRoleCode = "07"
+	// PublishingRoleNewPublisher is new or acquiring publisher of product.
+	PublishingRoleNewPublisher PublishingRoleCode = "09"
+	// PublishingRolePublishingGroup is group which publisher belongs to.
+	PublishingRolePublishingGroup PublishingRoleCode = "10"
+	// PublishingRoleFacsimileOriginalPublisher is publisher of original of facsimile.
+	PublishingRoleFacsimileOriginalPublisher PublishingRoleCode = "11"
+	// PublishingRoleRepackager is repackager of prebound edition.
+	PublishingRoleRepackager PublishingRoleCode = "12"
+	// PublishingRoleFormerPublisher is former publisher of product.
+	PublishingRoleFormerPublisher PublishingRoleCode = "13"
+	// PublishingRolePublicationFunder is funder of publication.
+	PublishingRolePublicationFunder PublishingRoleCode = "14"
+	// PublishingRoleResearchFunder is funder of research which product is based on.
+	PublishingRoleResearchFunder PublishingRoleCode = "15"
+	// PublishingRoleFundingBody is body which funds publication or research.
+	PublishingRoleFundingBody PublishingRoleCode = "16"
+	// PublishingRolePrinter is printer of product.
+	PublishingRolePrinter PublishingRoleCode = "17"
+)
+
+// Role returns role of publisher.
+func (p Publisher) Role() PublishingRoleCode {
+	return PublishingRoleCode(p.PublishingRole.Code())
+}
+
+// Name returns name of publisher.
+func (p Publisher) Name() (string, bool) {
+	return nameOf(p.PublisherName)
+}
+
+// Identifier returns identifier of publisher of idType, which is a code of List 44 such as 06 for GLN.
+func (p Publisher) Identifier(idType string) (string, bool) {
+	for _, id := range p.PublisherIdentifiers {
+		if id.PublisherIDType.Code() == idType {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// ProprietaryID returns proprietary identifier of publisher whose IDTypeName is idTypeName.
+func (p Publisher) ProprietaryID(idTypeName string) (string, bool) {
+	for _, id := range p.PublisherIdentifiers {
+		if id.PublisherIDType.Code() == "01" && id.IDTypeName != nil && id.IDTypeName.Body == idTypeName {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// Name returns name of imprint.
+func (i Imprint) Name() (string, bool) {
+	return nameOf(i.ImprintName)
+}
+
+// Identifier returns identifier of imprint of idType, which is a code of List 44 such as 06 for GLN.
+func (i Imprint) Identifier(idType string) (string, bool) {
+	for _, id := range i.ImprintIdentifiers {
+		if id.ImprintIDType.Code() == idType {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// ProprietaryID returns proprietary identifier of imprint whose IDTypeName is idTypeName.
+func (i Imprint) ProprietaryID(idTypeName string) (string, bool) {
+	for _, id := range i.ImprintIdentifiers {
+		if id.ImprintIDType.Code() == "01" && id.IDTypeName != nil && id.IDTypeName.Body == idTypeName {
+			return strings.TrimSpace(id.IDValue.Body), true
+		}
+	}
+	return "", false
+}
+
+// Publisher returns name of publisher of product, which is the first Publisher composite of role 01 with name.
+func (d PublishingDetail) Publisher() (string, bool) {
+	for _, publisher := range d.Publishers {
+		if publisher.Role() != PublishingRolePublisher {
+			continue
+		}
+		if name, ok := publisher.Name(); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func nameOf(t *PlainText) (string, bool) {
+	if t == nil || strings.TrimSpace(t.Body) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(t.Body), true
+}
@@ -2,13 +2,150 @@ package onix
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kogai/onix-codegen/generated/go/v3/codelists"
+)
+
+// UnknownCodePolicy is how codes which are not defined at codelists are treated on decoding.
+type UnknownCodePolicy uint
+
+const (
+	// UnknownCodeError aborts decoding with an error, which is the default.
+	UnknownCodeError UnknownCodePolicy = iota
+	// UnknownCodeKeep preserves the code as it is in place of human readable description.
+	UnknownCodeKeep
+	// UnknownCodeSkip leaves the value empty.
+	UnknownCodeSkip
 )
 
+// Warning is a code which is not defined at codelists and has been kept or skipped.
+type Warning struct {
+	// Element is tag name of the element which has the code.
+	Element string
+	Type    string
+	Code    string
+}
+
+func (w Warning) Error() string {
+	return fmt.Sprintf("undefined code for %s has been passed at <%s>, got [%s]", w.Type, w.Element, w.Code)
+}
+
+// UndefinedCodeError is a code which is not defined at codelists, which has aborted decoding under UnknownCodeError.
+type UndefinedCodeError struct {
+	Type string
+	Code string
+}
+
+func (e *UndefinedCodeError) Error() string {
+	return fmt.Sprintf("undefined code for %s has been passed, got [%s]", e.Type, e.Code)
+}
+
+type decodeOptions struct {
+	unknownCodePolicy UnknownCodePolicy
+	warnings          *[]Warning
+}
+
+// Option configures decoding of message.
+type Option func(*decodeOptions)
+
+// WithUnknownCodePolicy chooses how codes which are not defined at codelists are treated.
+func WithUnknownCodePolicy(policy UnknownCodePolicy) Option {
+	return func(o *decodeOptions) {
+		o.unknownCodePolicy = policy
+	}
+}
+
+// WithWarnings appends codes which have been kept or skipped by UnknownCodePolicy to warnings.
+func WithWarnings(warnings *[]Warning) Option {
+	return func(o *decodeOptions) {
+		o.warnings = warnings
+	}
+}
+
+// decoders holds options by decoder, since UnmarshalXML of code types can see nothing but its decoder.
+var decoders sync.Map
+
+// ConfigureDecoder applies options to d, so that code types decoded by d follow them.
+// The returned function releases the options, and has to be called once decoding has finished.
+func ConfigureDecoder(d *xml.Decoder, opts ...Option) func() {
+	if len(opts) == 0 {
+		return func() {}
+	}
+	options := decodeOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	decoders.Store(d, &options)
+	return func() {
+		decoders.Delete(d)
+	}
+}
+
+// unknownCode returns value of code of typeName which is not defined at codelists and reports whether it is kept,
+// or returns an error when decoder of it does not tolerate unknown codes.
+// Code which has been registered by codelists.Register is decoded into its label regardless of policy.
+func unknownCode(d *xml.Decoder, start xml.StartElement, typeName, code string) (string, bool, error) {
+	if list, ok := codelists.ListOf(typeName); ok {
+		if label, ok := codelists.Registered(list, code); ok {
+			return label, true, nil
+		}
+	}
+	o, ok := decoders.Load(d)
+	if !ok || o.(*decodeOptions).unknownCodePolicy == UnknownCodeError {
+		return "", false, &UndefinedCodeError{Type: typeName, Code: code}
+	}
+	options := o.(*decodeOptions)
+	if options.warnings != nil {
+		*options.warnings = append(*options.warnings, Warning{Element: start.Name.Local, Type: typeName, Code: code})
+	}
+	return code, options.unknownCodePolicy == UnknownCodeKeep, nil
+}
+
+// registeredCode returns code which label of typeName has been registered for by codelists.Register,
+// or returns an error when description is not defined at codelists either.
+func registeredCode(typeName, description string) (string, error) {
+	if list, ok := codelists.ListOf(typeName); ok {
+		if code, ok := codelists.RegisteredCode(list, description); ok {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("undefined description for %s has been passed, got [%s]", typeName, description)
+}
+
+// decodeText appends text of the element which start has started to buf as DecodeElement decodes it into a string,
+// where children are skipped. Code types look their codes up from the returned bytes,
+// so that decoding allocates nothing for codes which are defined at codelists.
+func decodeText(d *xml.Decoder, buf []byte) ([]byte, error) {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return buf, err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			buf = append(buf, t...)
+		case xml.StartElement:
+			if err := d.Skip(); err != nil {
+				return buf, err
+			}
+		case xml.EndElement:
+			return buf, nil
+		}
+	}
+}
+
 // Read read ONIX for Books 2.1 format file.
-func Read(input string) (*ONIXMessage, error) {
+func Read(input string, opts ...Option) (*ONIXMessage, error) {
 	file, err := ioutil.ReadFile(input)
 	if err != nil {
 		return nil, err
@@ -19,9 +156,281 @@ func Read(input string) (*ONIXMessage, error) {
 	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
 		return input, nil
 	}
+	defer ConfigureDecoder(decoder, opts...)()
 
 	if err := decoder.Decode(&data); err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
+
+// ErrNoHeader is returned by Reader.Header when products appear without preceding header.
+var ErrNoHeader = errors.New("header of message has not been found before products")
+
+// Reader reads ONIX for Books message product by product,
+// so that a huge message can be consumed without loading whole of it into memory.
+type Reader struct {
+	// decoder decodes tokens of input whose tags have been renamed into short tags.
+	decoder *xml.Decoder
+	// input is decoder of the message as it is, which tells byte offsets of it.
+	input   *xml.Decoder
+	root    *xml.StartElement
+	header  *Header
+	pending *xml.StartElement
+	// total is size of input, which is -1 unless it is known.
+	total    int64
+	products int64
+	started  time.Time
+	// rootTag is start tag of root element, which checkpoints carry to resume reading.
+	rootTag string
+	// offset is byte offset of the message where input of decoder begins, less the root tag which it is prefixed with on resuming.
+	offset int64
+	// resumed is byte offset of the checkpoint which reading has resumed from.
+	resumed int64
+	// pendingOffset is byte offset of the message before start of the product which has been found last.
+	pendingOffset int64
+	// release releases options which NewReader has configured decoder with.
+	release func()
+}
+
+// NewReader returns Reader which reads message from r, which is written in either short tags or reference tags.
+// Reader has to be closed by Close once reading has finished.
+func NewReader(r io.Reader, opts ...Option) *Reader {
+	input := xml.NewDecoder(r)
+	input.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	decoder := xml.NewTokenDecoder(shortTagReader{input})
+	return &Reader{decoder: decoder, input: input, total: sizeOf(r), started: time.Now(), release: ConfigureDecoder(decoder, opts...)}
+}
+
+// Close releases options which reader has been configured with, and has to be called once reading has finished.
+// It does not close the underlying reader.
+func (r *Reader) Close() error {
+	r.release()
+	return nil
+}
+
+// Header returns header of message.
+// It has to be called before the first call of Next, since header precedes products.
+func (r *Reader) Header() (Header, error) {
+	for r.header == nil {
+		if r.pending != nil {
+			return Header{}, ErrNoHeader
+		}
+		start, err := r.nextElement()
+		if err == io.EOF {
+			return Header{}, ErrNoHeader
+		}
+		if err != nil {
+			return Header{}, err
+		}
+		if start.Name.Local == ShortTags["Product"] {
+			r.pending = &start
+		}
+	}
+	return *r.header, nil
+}
+
+// Next returns next product of message.
+// It returns io.EOF when there are no more products.
+func (r *Reader) Next() (*Product, error) {
+	var start xml.StartElement
+	if r.pending != nil {
+		start, r.pending = *r.pending, nil
+	} else {
+		s, err := r.nextElement()
+		if err != nil {
+			return nil, err
+		}
+		start = s
+	}
+	var product Product
+	if err := r.decoder.DecodeElement(&product, &start); err != nil {
+		return nil, err
+	}
+	r.products++
+	return &product, nil
+}
+
+// NextContext returns next product of message as Next does, or returns the error of ctx once it is done before the product,
+// so that reading of a huge message stops on cancellation between products.
+func (r *Reader) NextContext(ctx context.Context) (*Product, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.Next()
+}
+
+// Progress returns bytes of input which have been read, size of input and number of products which Next has returned,
+// so that progress of a huge message can be rendered. Size of input is -1 unless it is known,
+// which is known for files, readers which have Size such as bytes.Reader, and by SetTotalBytes.
+func (r *Reader) Progress() (bytesRead, totalBytes int64, productsDecoded int64) {
+	return r.offset + r.input.InputOffset(), r.total, r.products
+}
+
+// SetTotalBytes tells size of input which is not known from the reader, such as Content-Length of a response.
+func (r *Reader) SetTotalBytes(total int64) {
+	r.total = total
+}
+
+// ETA returns estimated time to read the rest of input at the rate since NewReader or SeekToCheckpoint,
+// which is unknown until size of input is known and some bytes have been read.
+func (r *Reader) ETA() (time.Duration, bool) {
+	read, total, _ := r.Progress()
+	if total < 0 || read <= r.resumed {
+		return 0, false
+	}
+	if read >= total {
+		return 0, true
+	}
+	elapsed := time.Since(r.started)
+	return time.Duration(float64(elapsed) * float64(total-read) / float64(read-r.resumed)), true
+}
+
+// Checkpoint is where Reader has read a message up to, from which SeekToCheckpoint resumes reading it,
+// so that a job which has crashed does not process products again. It is marshaled into JSON to be persisted.
+type Checkpoint struct {
+	// Offset is byte offset of the message after the last product which Next has returned.
+	Offset int64 `json:"offset"`
+	// Products is number of products before Offset.
+	Products int64 `json:"products"`
+	// Root is start tag of root element, which declares namespaces of products.
+	// It is empty unless reading has passed beyond it.
+	Root string `json:"root,omitempty"`
+}
+
+// Checkpoint returns where r has read the message up to, which is before the product which Next returns next.
+func (r *Reader) Checkpoint() Checkpoint {
+	offset, _, products := r.Progress()
+	if r.pending != nil {
+		offset = r.pendingOffset
+	}
+	return Checkpoint{Offset: offset, Products: products, Root: r.rootTag}
+}
+
+// SeekToCheckpoint seeks input of the message to checkpoint, and returns Reader which continues reading it from the product after checkpoint.
+// Header is not read again, so that Header of the returned Reader fails with ErrNoHeader.
+func SeekToCheckpoint(input io.ReadSeeker, checkpoint Checkpoint, opts ...Option) (*Reader, error) {
+	total, err := input.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := input.Seek(checkpoint.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var r io.Reader = input
+	if checkpoint.Root != "" {
+		r = io.MultiReader(strings.NewReader(checkpoint.Root), input)
+	}
+	reader := NewReader(r, opts...)
+	reader.total = total
+	reader.products = checkpoint.Products
+	reader.offset = checkpoint.Offset - int64(len(checkpoint.Root))
+	reader.resumed = checkpoint.Offset
+	return reader, nil
+}
+
+// startTag returns start as it is written, where prefixes of the names are the ones which start declares.
+func startTag(start xml.StartElement) string {
+	prefixes := map[string]string{}
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" {
+			prefixes[attr.Value] = attr.Name.Local + ":"
+		}
+	}
+	nameOf := func(name xml.Name) string {
+		if name.Space == "xmlns" || name.Space == "xml" {
+			return name.Space + ":" + name.Local
+		}
+		return prefixes[name.Space] + name.Local
+	}
+	var b strings.Builder
+	b.WriteString("<" + nameOf(start.Name))
+	for _, attr := range start.Attr {
+		b.WriteString(" " + nameOf(attr.Name) + `="`)
+		xml.EscapeText(&b, []byte(attr.Value))
+		b.WriteString(`"`)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// sizeOf returns size of input of r, which is -1 unless r is a file or has Size.
+func sizeOf(r io.Reader) int64 {
+	switch input := r.(type) {
+	case interface{ Size() int64 }:
+		return input.Size()
+	case *os.File:
+		if info, err := input.Stat(); err == nil && info.Mode().IsRegular() {
+			if offset, err := input.Seek(0, io.SeekCurrent); err == nil {
+				return info.Size() - offset
+			}
+			return info.Size()
+		}
+	}
+	return -1
+}
+
+// nextElement returns start of next child of root element.
+// Header is decoded on the way, and children other than header and product are skipped.
+func (r *Reader) nextElement() (xml.StartElement, error) {
+	for {
+		offset := r.input.InputOffset()
+		token, err := r.decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if r.root == nil {
+			root := start.Copy()
+			r.root = &root
+			r.rootTag = startTag(root)
+			continue
+		}
+		switch start.Name.Local {
+		case ShortTags["Header"]:
+			var header Header
+			if err := r.decoder.DecodeElement(&header, &start); err != nil {
+				return xml.StartElement{}, err
+			}
+			r.header = &header
+		case ShortTags["Product"]:
+			r.pendingOffset = r.offset + offset
+			return start.Copy(), nil
+		default:
+			if err := r.decoder.Skip(); err != nil {
+				return xml.StartElement{}, err
+			}
+		}
+	}
+}
+
+// shortTagReader renames reference tags of decoder into short tags as onix.Decode does,
+// so that the structs which are bound to short tags decode messages of both dialects.
+type shortTagReader struct {
+	decoder *xml.Decoder
+}
+
+func (r shortTagReader) Token() (xml.Token, error) {
+	token, err := r.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := token.(type) {
+	case xml.StartElement:
+		if short, ok := ShortTags[t.Name.Local]; ok {
+			t.Name.Local = short
+		}
+		return t, nil
+	case xml.EndElement:
+		if short, ok := ShortTags[t.Name.Local]; ok {
+			t.Name.Local = short
+		}
+		return t, nil
+	}
+	return token, nil
+}
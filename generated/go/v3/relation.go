@@ -0,0 +1,96 @@
+package onix
+
+// ProductRelation is code of List 51 which tells how related product relates to product, such as 06 for alternative format.
+type ProductRelation string
+
+const (
+	// ProductRelationUnspecified is relation which is not specified.
+	ProductRelationUnspecified ProductRelation = "00"
+	// ProductRelationIncludes is related product which product includes.
+	ProductRelationIncludes ProductRelation = "01"
+	// ProductRelationIsPartOf is related product which product is a part of.
+	ProductRelationIsPartOf ProductRelation = "02"
+	// ProductRelationReplaces is related product which product replaces.
+	ProductRelationReplaces ProductRelation = "03"
+	// ProductRelationReplacedBy is related product which replaces product.
+	ProductRelationReplacedBy ProductRelation = "05"
+	// ProductRelationAlternativeFormat is related product of the same work in alternative format.
+	ProductRelationAlternativeFormat ProductRelation = "06"
+	// ProductRelationOtherLanguageVersion is related product which is the same work in other language.
+	ProductRelationOtherLanguageVersion ProductRelation = "11"
+	// ProductRelationEpublicationBasedOn is print product which e-publication is based on.
+	ProductRelationEpublicationBasedOn ProductRelation = "13"
+	// ProductRelationSameAuthor is related product by the same author.
+	ProductRelationSameAuthor ProductRelation = "22"
+	// ProductRelationSimilarProduct is related product which is similar to product.
+	ProductRelationSimilarProduct ProductRelation = "23"
+	// ProductRelationElectronicVersion is e-publication of the same work which is available.
+	ProductRelationElectronicVersion ProductRelation = "27"
+	// ProductRelationSameCollection is related product in the same collection.
+	ProductRelationSameCollection ProductRelation = "30"
+)
+
+// IsOtherFormat reports whether r relates the same work in other format, such as e-book of print product.
+func (r ProductRelation) IsOtherFormat() bool {
+	return r == ProductRelationAlternativeFormat || r == ProductRelationEpublicationBasedOn || r == ProductRelationElectronicVersion
+}
+
+// WorkRelation is code of List 164 which tells how related work relates to product, such as 01 for manifestation of.
+type WorkRelation string
+
+const (
+	// WorkRelationManifestationOf is work which product is a manifestation of.
+	WorkRelationManifestationOf WorkRelation = "01"
+	// WorkRelationDerivedFrom is work which product is derived from.
+	WorkRelationDerivedFrom WorkRelation = "02"
+	// WorkRelationDerivedFromThis is work which is derived from product.
+	WorkRelationDerivedFromThis WorkRelation = "03"
+	// WorkRelationSameCollection is other work in the same collection.
+	WorkRelationSameCollection WorkRelation = "04"
+	// WorkRelationSameContributor is other work by the same contributor.
+	WorkRelationSameContributor WorkRelation = "05"
+)
+
+// Relations returns how related product relates to product, which may be more than one.
+func (r RelatedProduct) Relations() []ProductRelation {
+	var relations []ProductRelation
+	for _, c := range r.ProductRelationCodes {
+		relations = append(relations, ProductRelation(c.Code()))
+	}
+	return relations
+}
+
+// Has reports whether related product relates to product by relation.
+func (r RelatedProduct) Has(relation ProductRelation) bool {
+	for _, c := range r.ProductRelationCodes {
+		if ProductRelation(c.Code()) == relation {
+			return true
+		}
+	}
+	return false
+}
+
+// ISBN13 returns ISBN-13 of related product.
+func (r RelatedProduct) ISBN13() (string, bool) {
+	for _, id := range r.ProductIdentifiers {
+		if id.ProductIDType.Code() == "15" {
+			return id.IDValue.Body, true
+		}
+	}
+	return "", false
+}
+
+// Relation returns how related work relates to product.
+func (w RelatedWork) Relation() WorkRelation {
+	return WorkRelation(w.WorkRelationCode.Code())
+}
+
+// Identifier returns identifier of related work of idType, which is a code of List 16 such as 11 for ISTC.
+func (w RelatedWork) Identifier(idType string) (string, bool) {
+	for _, id := range w.WorkIdentifiers {
+		if id.WorkIDType.Code() == idType {
+			return id.IDValue.Body, true
+		}
+	}
+	return "", false
+}
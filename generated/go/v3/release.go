@@ -0,0 +1,165 @@
+package onix
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+const (
+	// Release30 is release attribute of message of ONIX for Books 3.0, which the model is generated from.
+	Release30 Release = "3.0"
+	// Release31 is release attribute of message of ONIX for Books 3.1.
+	Release31 Release = "3.1"
+	// Namespace31 is namespace of message of 3.1 written in short tags.
+	Namespace31 = "http://ns.editeur.org/onix/3.1/short"
+	// ReferenceNamespace31 is namespace of message of 3.1 written in reference tags.
+	ReferenceNamespace31 = "http://ns.editeur.org/onix/3.1/reference"
+)
+
+// RemovedIn31 are reference names of elements which are deprecated in 3.0 and removed in 3.1, with what replaces them.
+var RemovedIn31 = map[string]string{
+	"Conference": "Event composite replaces Conference composite",
+	"DateFormat": "dateformat attribute of Date replaces DateFormat element",
+	"Reissue":    "SupplyDate and SupportingResource replace Reissue composite",
+}
+
+// Incompatibility is a construct of 3.0 in a message which is invalid under 3.1.
+type Incompatibility struct {
+	// Path is XPath-like location of the element such as /ONIXMessage/Product[1]/ProductSupply[1]/SupplyDetail[1]/Reissue.
+	Path    string
+	Message string
+}
+
+func (i Incompatibility) Error() string {
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// IsRelease31 reports whether release attribute of message is of 3.1.
+// Message of 3.1 is decoded into the model of 3.0, where elements which are new in 3.1 are not in the model yet and are skipped.
+func (m ONIXMessage) IsRelease31() bool {
+	return strings.HasPrefix(string(m.Release), string(Release31))
+}
+
+// CheckRelease31 returns every construct of msg which is invalid under 3.1, or nil when msg is valid under 3.1 as well.
+func CheckRelease31(msg *ONIXMessage) []Incompatibility {
+	var found []Incompatibility
+	walkRelease31(reflect.ValueOf(msg).Elem(), "/ONIXMessage", false, &found)
+	return found
+}
+
+// ToRelease31 returns a copy of msg of release 3.1, where elements removed in 3.1 are dropped, which CheckRelease31 reports beforehand.
+// Conference composites are converted into Event composites instead.
+// DateFormat element other than 00 for YYYYMMDD is an error, since Date of the model has no dateformat attribute to keep it yet.
+func ToRelease31(msg *ONIXMessage) (*ONIXMessage, error) {
+	b, err := xml.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var converted ONIXMessage
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	if err := decoder.Decode(&converted); err != nil {
+		return nil, err
+	}
+	var found []Incompatibility
+	walkRelease31(reflect.ValueOf(&converted).Elem(), "/ONIXMessage", true, &found)
+	if len(found) > 0 {
+		return nil, found[0]
+	}
+	converted.Release = Release31
+	return &converted, nil
+}
+
+// EncodeRelease31 encodes ONIX for Books message as 3.1 with XML declaration, whose root element declares Namespace31.
+func EncodeRelease31(w io.Writer, data *ONIXMessage) error {
+	converted, err := ToRelease31(data)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	type message ONIXMessage
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	start := xml.StartElement{Name: xml.Name{Space: Namespace31, Local: ShortTags["ONIXMessage"]}}
+	if err := encoder.EncodeElement(message(*converted), start); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+// walkRelease31 finds elements removed in 3.1 under v, which are dropped when fix is set unless they cannot be.
+func walkRelease31(v reflect.Value, path string, fix bool, found *[]Incompatibility) {
+	if d, ok := v.Addr().Interface().(*DescriptiveDetail); ok && fix {
+		convertConferences31(d)
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("xml")
+		if field.Anonymous || tag == "" || tag == "-" || strings.HasPrefix(tag, ",") || strings.Contains(tag, ",attr") {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if ref, ok := ReferenceTags[name]; ok {
+			name = ref
+		}
+		f := v.Field(i)
+		if message, ok := RemovedIn31[name]; ok && !f.IsZero() {
+			if fix && removableIn31(f) {
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+			*found = append(*found, Incompatibility{Path: path + "/" + name, Message: message})
+			continue
+		}
+		switch f.Kind() {
+		case reflect.Slice:
+			for j := 0; j < f.Len(); j++ {
+				if f.Index(j).Kind() == reflect.Struct {
+					walkRelease31(f.Index(j), fmt.Sprintf("%s/%s[%d]", path, name, j+1), fix, found)
+				}
+			}
+		case reflect.Ptr:
+			if !f.IsNil() && f.Elem().Kind() == reflect.Struct {
+				walkRelease31(f.Elem(), path+"/"+name, fix, found)
+			}
+		case reflect.Struct:
+			walkRelease31(f, path+"/"+name, fix, found)
+		}
+	}
+}
+
+// convertConferences31 converts Conference composites of detail into Event composites which replace them,
+// where the ones which cannot be converted are left to be reported.
+func convertConferences31(d *DescriptiveDetail) {
+	var rest []Conference
+	for _, c := range d.Conferences {
+		if e, err := c.Event(); err == nil {
+			d.Events = append(d.Events, e)
+		} else {
+			rest = append(rest, c)
+		}
+	}
+	d.Conferences = rest
+}
+
+// removableIn31 reports whether element removed in 3.1 can be dropped,
+// which DateFormat other than YYYYMMDD cannot be since its format would be lost,
+// nor Conference composites which have not been converted into Event composites.
+func removableIn31(v reflect.Value) bool {
+	if format, ok := v.Interface().(*DateFormat); ok {
+		return format.Code() == "00"
+	}
+	if _, ok := v.Interface().([]Conference); ok {
+		return false
+	}
+	return true
+}
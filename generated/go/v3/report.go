@@ -0,0 +1,112 @@
+package onix
+
+import (
+	"io"
+	"strings"
+)
+
+// Report is statistics of products of a message, which aggregators check on incoming feeds for quality of data.
+// Counts are of products, so that a product of two prices in the same currency is counted once.
+type Report struct {
+	Products int64
+	// NotificationTypes counts products by code of List 1, such as 03 for notification confirmed on publication.
+	NotificationTypes map[string]int64
+	ProductForms      map[ProductFormCode]int64
+	// Publishers counts products by name of publisher.
+	Publishers map[string]int64
+	// Currencies counts products which have prices in each currency.
+	Currencies map[string]int64
+	// WithCoverImage, WithDescription and WithBISAC count products which have each of key fields,
+	// where those without them are Products less them.
+	WithCoverImage  int64
+	WithDescription int64
+	WithBISAC       int64
+	// Violations counts codes which are not defined at codelists by their types, and ViolatingProducts counts products which have them.
+	Violations        map[string]int64
+	ViolatingProducts int64
+}
+
+// Analyze reads message from r product by product, and returns Report of its products.
+// Codes which are not defined at codelists are kept as they are and counted as violations, instead of aborting decoding.
+func Analyze(r io.Reader) (Report, error) {
+	report := Report{
+		NotificationTypes: map[string]int64{},
+		ProductForms:      map[ProductFormCode]int64{},
+		Publishers:        map[string]int64{},
+		Currencies:        map[string]int64{},
+		Violations:        map[string]int64{},
+	}
+	var warnings []Warning
+	reader := NewReader(r, WithUnknownCodePolicy(UnknownCodeKeep), WithWarnings(&warnings))
+	for {
+		seen := len(warnings)
+		p, err := reader.Next()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+		report.add(*p)
+		for _, w := range warnings[seen:] {
+			report.Violations[w.Type]++
+		}
+		if len(warnings) > seen {
+			report.ViolatingProducts++
+		}
+	}
+}
+
+func (r *Report) add(p Product) {
+	r.Products++
+	r.NotificationTypes[reportedCode(p.NotificationType.Code(), p.NotificationType.Body)]++
+	if d := p.DescriptiveDetail; d != nil {
+		r.ProductForms[ProductFormCode(reportedCode(d.ProductForm.Code(), d.ProductForm.Body))]++
+	}
+	if d := p.PublishingDetail; d != nil {
+		if name, ok := d.Publisher(); ok {
+			r.Publishers[name]++
+		}
+	}
+	currencies := map[string]bool{}
+	for _, s := range p.supplyDetails() {
+		for _, price := range s.Prices {
+			if price.CurrencyCode == nil {
+				continue
+			}
+			if c := price.CurrencyCode.Code(); c != "" && !currencies[c] {
+				currencies[c] = true
+				r.Currencies[c]++
+			}
+		}
+	}
+	if _, ok := p.CoverImageURL(); ok {
+		r.WithCoverImage++
+	}
+	if d := p.CollateralDetail; d != nil {
+		if _, ok := d.Description(); ok {
+			r.WithDescription++
+		}
+	}
+	if d := p.DescriptiveDetail; d != nil && d.hasBISAC() {
+		r.WithBISAC++
+	}
+}
+
+// hasBISAC reports whether detail has BISAC subject heading, which is scheme 10 of List 26.
+func (d DescriptiveDetail) hasBISAC() bool {
+	for _, s := range d.Subjects {
+		if s.SubjectSchemeIdentifier.Code() == "10" {
+			return true
+		}
+	}
+	return false
+}
+
+// reportedCode returns code, which falls back on body of code which is not defined at codelists and has been kept as it is.
+func reportedCode(code, body string) string {
+	if code == "" {
+		return strings.TrimSpace(body)
+	}
+	return code
+}
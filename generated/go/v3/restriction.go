@@ -0,0 +1,144 @@
+package onix
+
+import (
+	"strings"
+	"time"
+)
+
+// SalesRestrictionCode is code of List 71 which tells how sales of product are restricted, such as 04 for retailer exclusive.
+type SalesRestrictionCode string
+
+const (
+	// SalesRestrictionUnspecified is restriction which is not specified, and is described by SalesRestrictionNote.
+	SalesRestrictionUnspecified SalesRestrictionCode = "00"
+	// SalesRestrictionRetailerExclusiveOrOwnBrand is product which is exclusive to or own brand of the outlets.
+	SalesRestrictionRetailerExclusiveOrOwnBrand SalesRestrictionCode = "01"
+	// SalesRestrictionOfficeSupplies is edition which is sold only through office supplies outlets.
+	SalesRestrictionOfficeSupplies SalesRestrictionCode = "02"
+	// SalesRestrictionInternalUseOnly is product which is for internal use of publisher, and is not for sale.
+	SalesRestrictionInternalUseOnly SalesRestrictionCode = "03"
+	// SalesRestrictionRetailerExclusive is product which is exclusive to the outlets.
+	SalesRestrictionRetailerExclusive SalesRestrictionCode = "04"
+	// SalesRestrictionRetailerOwnBrand is product which is own brand of the outlets.
+	SalesRestrictionRetailerOwnBrand SalesRestrictionCode = "05"
+	// SalesRestrictionLibraryEdition is edition which is sold only to libraries.
+	SalesRestrictionLibraryEdition SalesRestrictionCode = "06"
+	// SalesRestrictionSchoolsOnly is edition which is sold only to schools.
+	SalesRestrictionSchoolsOnly SalesRestrictionCode = "07"
+	// SalesRestrictionIndexed is product which is indexed by BPjM in Germany, and is not sold to minors.
+	SalesRestrictionIndexed SalesRestrictionCode = "08"
+	// SalesRestrictionNotForLibraries is product which is not for sale to libraries.
+	SalesRestrictionNotForLibraries SalesRestrictionCode = "09"
+	// SalesRestrictionNewsOutletEdition is edition which is sold only through news outlets such as airports and stations.
+	SalesRestrictionNewsOutletEdition SalesRestrictionCode = "10"
+	// SalesRestrictionRetailerException is product which is not for sale through the outlets.
+	SalesRestrictionRetailerException SalesRestrictionCode = "11"
+	// SalesRestrictionNotForSubscription is product which is not for sale to subscription services.
+	SalesRestrictionNotForSubscription SalesRestrictionCode = "12"
+)
+
+// IsExclusive reports whether c restricts sales to the outlets, or to the kind of outlets, which restriction tells.
+func (c SalesRestrictionCode) IsExclusive() bool {
+	switch c {
+	case SalesRestrictionRetailerExclusiveOrOwnBrand, SalesRestrictionOfficeSupplies, SalesRestrictionRetailerExclusive,
+		SalesRestrictionRetailerOwnBrand, SalesRestrictionLibraryEdition, SalesRestrictionSchoolsOnly, SalesRestrictionNewsOutletEdition:
+		return true
+	}
+	return false
+}
+
+// IsExclusion reports whether c excludes the outlets which restriction tells from sales.
+func (c SalesRestrictionCode) IsExclusion() bool {
+	return c == SalesRestrictionNotForLibraries || c == SalesRestrictionRetailerException || c == SalesRestrictionNotForSubscription
+}
+
+// Kind returns type of restriction.
+func (r SalesRestriction) Kind() SalesRestrictionCode {
+	return SalesRestrictionCode(r.SalesRestrictionType.Code())
+}
+
+// Has reports whether outlets of restriction include the outlet of id, which is its IDValue or name regardless of case.
+func (r SalesRestriction) Has(id string) bool {
+	for _, o := range r.SalesOutlets {
+		if o.Is(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// InEffectAt reports whether restriction is in effect at t, between StartDate and EndDate which is inclusive if they are given.
+func (r SalesRestriction) InEffectAt(t time.Time) bool {
+	if start, ok := dateOf(r.StartDate, nil); ok && t.Before(start.Time) {
+		return false
+	}
+	if end, ok := dateOf(r.EndDate, nil); ok && !t.Before(end.Time.AddDate(0, 0, 1)) {
+		return false
+	}
+	return true
+}
+
+// Restricts reports whether restriction forbids the outlet of id to sell product, regardless of dates when it is in effect.
+// Exclusive restrictions forbid outlets which are not listed, and a restriction for internal use forbids every outlet.
+// An exclusive restriction which lists no outlets forbids every outlet, since it is unknown who the product is exclusive to.
+func (r SalesRestriction) Restricts(id string) bool {
+	switch kind := r.Kind(); {
+	case kind == SalesRestrictionInternalUseOnly:
+		return true
+	case kind.IsExclusive():
+		return !r.Has(id)
+	case kind.IsExclusion():
+		return r.Has(id)
+	}
+	return false
+}
+
+// Is reports whether outlet is the one of id, which is any of its IDValue or its name regardless of case.
+func (o SalesOutlet) Is(id string) bool {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return false
+	}
+	for _, i := range o.SalesOutletIdentifiers {
+		if strings.EqualFold(strings.TrimSpace(i.IDValue.Body), id) {
+			return true
+		}
+	}
+	return o.SalesOutletName != nil && strings.EqualFold(strings.TrimSpace(o.SalesOutletName.Body), id)
+}
+
+// IsRestrictedFor reports whether any of SalesRestriction composites of product forbids the outlet of salesOutletID to sell product now.
+func (p Product) IsRestrictedFor(salesOutletID string) bool {
+	return p.IsRestrictedAt(salesOutletID, time.Now())
+}
+
+// IsRestrictedAt reports whether any of SalesRestriction composites in effect at t forbids the outlet of salesOutletID to sell product,
+// which are the ones of PublishingDetail, of its SalesRights composites and of markets.
+func (p Product) IsRestrictedAt(salesOutletID string, t time.Time) bool {
+	restricts := func(restrictions []SalesRestriction) bool {
+		for _, r := range restrictions {
+			if r.InEffectAt(t) && r.Restricts(salesOutletID) {
+				return true
+			}
+		}
+		return false
+	}
+	if d := p.PublishingDetail; d != nil {
+		if restricts(d.SalesRestrictions) {
+			return true
+		}
+		for _, rights := range d.SalesRightss {
+			if restricts(rights.SalesRestrictions) {
+				return true
+			}
+		}
+	}
+	for _, supply := range p.ProductSupplys {
+		for _, m := range supply.Markets {
+			if restricts(m.SalesRestrictions) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package onix
+
+import "strings"
+
+// SubjectSchemeKeywords is code of List 27 of keywords, whose SubjectHeadingText is keywords separated by semicolons.
+const SubjectSchemeKeywords = "20"
+
+// SplitKeywords splits text of keywords by semicolons as 3.0 specifies, where keywords are trimmed
+// and duplicates which differ only in case are dropped.
+func SplitKeywords(text string) []string {
+	return appendKeywords(nil, strings.Split(text, ";"))
+}
+
+// appendKeywords appends trimmed keywords to keywords, except empty ones and the ones which keywords already have.
+func appendKeywords(keywords []string, candidates []string) []string {
+	for _, k := range candidates {
+		k = strings.Join(strings.Fields(k), " ")
+		if k == "" {
+			continue
+		}
+		duplicated := false
+		for _, existing := range keywords {
+			if strings.EqualFold(existing, k) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			keywords = append(keywords, k)
+		}
+	}
+	return keywords
+}
+
+// IsKeywords reports whether subject is of keywords.
+func (s Subject) IsKeywords() bool {
+	return s.SubjectSchemeIdentifier.Code() == SubjectSchemeKeywords
+}
+
+// Keywords returns keywords of subject, which is nil unless subject is of keywords.
+// SubjectHeadingText repeated in languages is split one by one.
+func (s Subject) Keywords() []string {
+	if !s.IsKeywords() {
+		return nil
+	}
+	var keywords []string
+	for _, heading := range s.SubjectHeadingTexts {
+		keywords = appendKeywords(keywords, SplitKeywords(heading.Body))
+	}
+	return keywords
+}
+
+// Keywords returns keywords of Subject composites of keywords of detail in order, without duplicates.
+func (d DescriptiveDetail) Keywords() []string {
+	var keywords []string
+	for _, s := range d.Subjects {
+		keywords = appendKeywords(keywords, s.Keywords())
+	}
+	return keywords
+}
+
+// Codes of List 79 of features which warn of hazards of product, whose value is a code of the list which each of them tells.
+const (
+	// ProductFormFeatureTypeCPSIAHazard is hazard warning of US CPSIA or other legislation of List 143.
+	ProductFormFeatureTypeCPSIAHazard = "12"
+	// ProductFormFeatureTypeEUToySafetyHazard is hazard warning of EU Toy Safety Directive of List 184.
+	ProductFormFeatureTypeEUToySafetyHazard = "13"
+	// ProductFormFeatureTypeIATADangerousGoods is warning of IATA Dangerous Goods, whose descriptions tell the details.
+	ProductFormFeatureTypeIATADangerousGoods = "14"
+	// ProductFormFeatureTypeDangerousGoods is warning of dangerous goods such as lithium batteries, whose value is their classification.
+	ProductFormFeatureTypeDangerousGoods = "21"
+)
+
+// HazardWarning is a warning of hazard of product, such as choking hazard of small parts of toys.
+type HazardWarning struct {
+	// Type is one of ProductFormFeatureTypeCPSIAHazard, ProductFormFeatureTypeEUToySafetyHazard, ProductFormFeatureTypeIATADangerousGoods
+	// and ProductFormFeatureTypeDangerousGoods.
+	Type  string
+	Value string
+	// Descriptions are the wording of the warning, which may be repeated in languages.
+	Descriptions []string
+}
+
+// HazardWarning returns warning which feature tells, if it is of a hazard.
+func (f ProductFormFeature) HazardWarning() (HazardWarning, bool) {
+	t := f.ProductFormFeatureType.Code()
+	switch t {
+	case ProductFormFeatureTypeCPSIAHazard, ProductFormFeatureTypeEUToySafetyHazard, ProductFormFeatureTypeIATADangerousGoods, ProductFormFeatureTypeDangerousGoods:
+	default:
+		return HazardWarning{}, false
+	}
+	w := HazardWarning{Type: t}
+	if f.ProductFormFeatureValue != nil {
+		w.Value = strings.TrimSpace(f.ProductFormFeatureValue.Body)
+	}
+	for _, d := range f.ProductFormFeatureDescriptions {
+		if text := strings.TrimSpace(d.Body); text != "" {
+			w.Descriptions = append(w.Descriptions, text)
+		}
+	}
+	return w, true
+}
+
+// HazardWarnings returns warnings of hazards which ProductFormFeature composites of detail tell, in order of the message.
+func (d DescriptiveDetail) HazardWarnings() []HazardWarning {
+	return hazardWarningsOf(d.ProductFormFeatures)
+}
+
+// HazardWarnings returns warnings of hazards which ProductFormFeature composites of part tell, in order of the message.
+func (p ProductPart) HazardWarnings() []HazardWarning {
+	return hazardWarningsOf(p.ProductFormFeatures)
+}
+
+func hazardWarningsOf(features []ProductFormFeature) []HazardWarning {
+	var warnings []HazardWarning
+	for _, f := range features {
+		if w, ok := f.HazardWarning(); ok {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,141 @@
+package onix
+
+import "strings"
+
+// SupplierIDTypeCode is code of List 92 which tells scheme of identifier of supplier, such as 04 for GLN.
+type SupplierIDTypeCode string
+
+const (
+	// SupplierIDTypeProprietary is proprietary identifier whose scheme is named by IDTypeName.
+	SupplierIDTypeProprietary SupplierIDTypeCode = "01"
+	// SupplierIDTypeVerkehrsnummer is Verkehrsnummer of Börsenverein des Deutschen Buchhandels.
+	SupplierIDTypeVerkehrsnummer SupplierIDTypeCode = "02"
+	// SupplierIDTypeGermanISBNAgency is identifier of publisher of German ISBN Agency.
+	SupplierIDTypeGermanISBNAgency SupplierIDTypeCode = "03"
+	// SupplierIDTypeGLN is Global Location Number of GS1.
+	SupplierIDTypeGLN SupplierIDTypeCode = "04"
+	// SupplierIDTypeSAN is Standard Address Number of book trade in North America.
+	SupplierIDTypeSAN SupplierIDTypeCode = "05"
+	// SupplierIDTypeDistributeurscode is Distributeurscode of Centraal Boekhuis in Netherlands.
+	SupplierIDTypeDistributeurscode SupplierIDTypeCode = "06"
+	// SupplierIDTypeFondscode is Fondscode of Boekenbank in Flanders.
+	SupplierIDTypeFondscode SupplierIDTypeCode = "07"
+	// SupplierIDTypeVATIdentity is identity number of value added tax.
+	SupplierIDTypeVATIdentity SupplierIDTypeCode = "23"
+)
+
+// Kind returns scheme of identifier.
+func (id SupplierIdentifier) Kind() SupplierIDTypeCode {
+	return SupplierIDTypeCode(id.SupplierIDType.Code())
+}
+
+// Value returns identifier without surrounding whitespace.
+func (id SupplierIdentifier) Value() string {
+	return strings.TrimSpace(id.IDValue.Body)
+}
+
+// SupplierCodeTypeCode is code of List 165 which tells what code of supplier's own scheme classifies, such as 07 for order routing eligibility.
+type SupplierCodeTypeCode string
+
+const (
+	// SupplierCodeTypeSupplierSalesClassification is sales classification of supplier.
+	SupplierCodeTypeSupplierSalesClassification SupplierCodeTypeCode = "01"
+	// SupplierCodeTypeSupplierBonusEligibility is eligibility of product for bonus of supplier.
+	SupplierCodeTypeSupplierBonusEligibility SupplierCodeTypeCode = "02"
+	// SupplierCodeTypePublisherSalesClassification is sales classification of publisher.
+	SupplierCodeTypePublisherSalesClassification SupplierCodeTypeCode = "03"
+	// SupplierCodeTypeSupplierPricingRestriction is classification of pricing restriction of supplier.
+	SupplierCodeTypeSupplierPricingRestriction SupplierCodeTypeCode = "04"
+	// SupplierCodeTypeSupplierSalesExpectation is expectation of sales of supplier.
+	SupplierCodeTypeSupplierSalesExpectation SupplierCodeTypeCode = "05"
+	// SupplierCodeTypePublisherSalesExpectation is expectation of sales of publisher.
+	SupplierCodeTypePublisherSalesExpectation SupplierCodeTypeCode = "06"
+	// SupplierCodeTypeOrderRoutingEligibility is eligibility of product for routing of orders of supplier.
+	SupplierCodeTypeOrderRoutingEligibility SupplierCodeTypeCode = "07"
+)
+
+// Name returns name of supplier.
+func (s Supplier) Name() (string, bool) {
+	return nameOf(s.SupplierName)
+}
+
+// Identifier returns identifier of supplier of idType.
+func (s Supplier) Identifier(idType SupplierIDTypeCode) (string, bool) {
+	for _, id := range s.SupplierIdentifiers {
+		if id.Kind() == idType && id.Value() != "" {
+			return id.Value(), true
+		}
+	}
+	return "", false
+}
+
+// ProprietaryID returns proprietary identifier of supplier whose IDTypeName is idTypeName.
+func (s Supplier) ProprietaryID(idTypeName string) (string, bool) {
+	for _, id := range s.SupplierIdentifiers {
+		if id.Kind() == SupplierIDTypeProprietary && id.IDTypeName != nil && id.IDTypeName.Body == idTypeName {
+			return id.Value(), true
+		}
+	}
+	return "", false
+}
+
+// GLN returns Global Location Number of supplier.
+func (s Supplier) GLN() (string, bool) {
+	return s.Identifier(SupplierIDTypeGLN)
+}
+
+// SAN returns Standard Address Number of supplier.
+func (s Supplier) SAN() (string, bool) {
+	return s.Identifier(SupplierIDTypeSAN)
+}
+
+// Is reports whether supplier is the one of id, which is any of its identifiers or its name regardless of case.
+func (s Supplier) Is(id string) bool {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return false
+	}
+	for _, i := range s.SupplierIdentifiers {
+		if strings.EqualFold(i.Value(), id) {
+			return true
+		}
+	}
+	return s.SupplierName != nil && strings.EqualFold(strings.TrimSpace(s.SupplierName.Body), id)
+}
+
+// Kind returns what code of supplier's own scheme classifies.
+func (c SupplierOwnCoding) Kind() SupplierCodeTypeCode {
+	return SupplierCodeTypeCode(c.SupplierCodeType.Code())
+}
+
+// OwnCode returns code of supplier's own scheme of codeType.
+func (s SupplyDetail) OwnCode(codeType SupplierCodeTypeCode) (string, bool) {
+	for _, c := range s.SupplierOwnCodings {
+		if c.Kind() == codeType {
+			return nameOf(&c.SupplierCodeValue)
+		}
+	}
+	return "", false
+}
+
+// OwnCodeNamed returns code of supplier's own scheme whose SupplierCodeTypeName is name, which names schemes of the same type apart.
+func (s SupplyDetail) OwnCodeNamed(name string) (string, bool) {
+	for _, c := range s.SupplierOwnCodings {
+		if c.SupplierCodeTypeName != nil && strings.EqualFold(strings.TrimSpace(c.SupplierCodeTypeName.Body), name) {
+			return nameOf(&c.SupplierCodeValue)
+		}
+	}
+	return "", false
+}
+
+// SupplyDetailsFrom returns SupplyDetail composites of product in every market whose supplier is the one of id, such as its GLN, SAN or name,
+// by which orders are routed to the supplying warehouse.
+func (p Product) SupplyDetailsFrom(id string) []SupplyDetail {
+	var details []SupplyDetail
+	for _, s := range p.supplyDetails() {
+		if s.Supplier.Is(id) {
+			details = append(details, s)
+		}
+	}
+	return details
+}
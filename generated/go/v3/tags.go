@@ -0,0 +1,489 @@
+package onix
+
+// ShortTags is mapping from reference tag names to short tag names.
+var ShortTags = map[string]string{
+	"AVDuration": "x544",
+	"AVItem": "avitem",
+	"AVItemIDType": "x541",
+	"AVItemIdentifier": "avitemidentifier",
+	"AVItemType": "x540",
+	"Addressee": "addressee",
+	"AddresseeIDType": "m380",
+	"AddresseeIdentifier": "addresseeidentifier",
+	"AddresseeName": "x300",
+	"Affiliation": "b046",
+	"AgentIDType": "j400",
+	"AgentIdentifier": "agentidentifier",
+	"AgentName": "j401",
+	"AgentRole": "j402",
+	"AlternativeName": "alternativename",
+	"AncillaryContent": "ancillarycontent",
+	"AncillaryContentDescription": "x424",
+	"AncillaryContentType": "x423",
+	"Audience": "audience",
+	"AudienceCode": "b073",
+	"AudienceCodeType": "b204",
+	"AudienceCodeTypeName": "b205",
+	"AudienceCodeValue": "b206",
+	"AudienceDescription": "x432",
+	"AudienceRange": "audiencerange",
+	"AudienceRangePrecision": "b075",
+	"AudienceRangeQualifier": "b074",
+	"AudienceRangeValue": "b076",
+	"Barcode": "barcode",
+	"BarcodeType": "x312",
+	"BatchBonus": "batchbonus",
+	"BatchQuantity": "j264",
+	"Bible": "bible",
+	"BibleContents": "b352",
+	"BiblePurpose": "b354",
+	"BibleReferenceLocation": "b356",
+	"BibleTextFeature": "b357",
+	"BibleTextOrganization": "b355",
+	"BibleVersion": "b353",
+	"BiographicalNote": "b044",
+	"BookClubAdoption": "k169",
+	"CBO": "j375",
+	"CitationNote": "x434",
+	"CitedContent": "citedcontent",
+	"CitedContentType": "x430",
+	"CityOfPublication": "b209",
+	"CollateralDetail": "collateraldetail",
+	"Collection": "collection",
+	"CollectionIDType": "x344",
+	"CollectionIdentifier": "collectionidentifier",
+	"CollectionSequence": "collectionsequence",
+	"CollectionSequenceNumber": "x481",
+	"CollectionSequenceType": "x479",
+	"CollectionSequenceTypeName": "x480",
+	"CollectionType": "x329",
+	"ComparisonProductPrice": "comparisonproductprice",
+	"Complexity": "complexity",
+	"ComplexityCode": "b078",
+	"ComplexitySchemeIdentifier": "b077",
+	"ComponentNumber": "b289",
+	"ComponentTypeName": "b288",
+	"Conference": "conference",
+	"ConferenceAcronym": "b341",
+	"ConferenceDate": "b054",
+	"ConferenceName": "b052",
+	"ConferenceNumber": "b053",
+	"ConferencePlace": "b055",
+	"ConferenceRole": "b051",
+	"ConferenceSponsor": "conferencesponsor",
+	"ConferenceSponsorIDType": "b391",
+	"ConferenceSponsorIdentifier": "conferencesponsoridentifier",
+	"ConferenceTheme": "b342",
+	"ContactName": "x299",
+	"ContentAudience": "x427",
+	"ContentDate": "contentdate",
+	"ContentDateRole": "x429",
+	"ContentDetail": "contentdetail",
+	"ContentItem": "contentitem",
+	"Contributor": "contributor",
+	"ContributorDate": "contributordate",
+	"ContributorDateRole": "x417",
+	"ContributorDescription": "b048",
+	"ContributorPlace": "contributorplace",
+	"ContributorPlaceRelator": "x418",
+	"ContributorReference": "contributorreference",
+	"ContributorRole": "b035",
+	"ContributorStatement": "b049",
+	"CopiesSold": "k168",
+	"CopyrightOwner": "copyrightowner",
+	"CopyrightOwnerIDType": "b392",
+	"CopyrightOwnerIdentifier": "copyrightowneridentifier",
+	"CopyrightStatement": "copyrightstatement",
+	"CopyrightType": "x512",
+	"CopyrightYear": "b087",
+	"CorporateName": "b047",
+	"CorporateNameInverted": "x443",
+	"CountriesExcluded": "x451",
+	"CountriesIncluded": "x449",
+	"CountryCode": "b251",
+	"CountryOfManufacture": "x316",
+	"CountryOfPublication": "b083",
+	"CurrencyCode": "j152",
+	"CurrencyZone": "x475",
+	"Date": "b306",
+	"DateFormat": "j260",
+	"DefaultCurrencyCode": "m186",
+	"DefaultLanguageOfText": "m184",
+	"DefaultPriceType": "x310",
+	"DeletionText": "a199",
+	"DescriptiveDetail": "descriptivedetail",
+	"Discount": "discount",
+	"DiscountAmount": "x469",
+	"DiscountCode": "j364",
+	"DiscountCodeType": "j363",
+	"DiscountCodeTypeName": "j378",
+	"DiscountCoded": "discountcoded",
+	"DiscountPercent": "j267",
+	"DiscountType": "x467",
+	"EditionNumber": "b057",
+	"EditionStatement": "b058",
+	"EditionType": "x419",
+	"EditionVersionNumber": "b217",
+	"EmailAddress": "j272",
+	"EndDate": "b325",
+	"EndTime": "x543",
+	"EpubLicense": "epublicense",
+	"EpubLicenseExpression": "epublicenseexpression",
+	"EpubLicenseExpressionLink": "x510",
+	"EpubLicenseExpressionType": "x508",
+	"EpubLicenseExpressionTypeName": "x509",
+	"EpubLicenseName": "x511",
+	"EpubTechnicalProtection": "x317",
+	"EpubUsageConstraint": "epubusageconstraint",
+	"EpubUsageLimit": "epubusagelimit",
+	"EpubUsageStatus": "x319",
+	"EpubUsageType": "x318",
+	"EpubUsageUnit": "x321",
+	"Event": "event",
+	"EventAcronym": "x517",
+	"EventDate": "x520",
+	"EventDescription": "x550",
+	"EventIDType": "x547",
+	"EventIdentifier": "eventidentifier",
+	"EventName": "x516",
+	"EventNumber": "x518",
+	"EventOccurrence": "eventoccurrence",
+	"EventPlace": "x521",
+	"EventRole": "x515",
+	"EventSponsor": "eventsponsor",
+	"EventSponsorIDType": "x522",
+	"EventSponsorIdentifier": "eventsponsoridentifier",
+	"EventStatus": "x549",
+	"EventTheme": "x519",
+	"EventType": "x548",
+	"ExpectedDate": "j302",
+	"Extent": "extent",
+	"ExtentType": "b218",
+	"ExtentUnit": "b220",
+	"ExtentValue": "b219",
+	"ExtentValueRoman": "x421",
+	"FaxNumber": "j271",
+	"FeatureNote": "x440",
+	"FeatureValue": "x439",
+	"FirstPageNumber": "b286",
+	"FreeQuantity": "j265",
+	"FromLanguage": "x412",
+	"Funding": "funding",
+	"FundingIDType": "x523",
+	"FundingIdentifier": "fundingidentifier",
+	"Gender": "x524",
+	"Header": "header",
+	"IDTypeName": "b233",
+	"IDValue": "b244",
+	"Illustrated": "x422",
+	"IllustrationsNote": "b062",
+	"Imprint": "imprint",
+	"ImprintIDType": "x445",
+	"ImprintIdentifier": "imprintidentifier",
+	"ImprintName": "b079",
+	"InitialPrintRun": "k167",
+	"KeyNames": "b040",
+	"Language": "language",
+	"LanguageCode": "b252",
+	"LanguageRole": "b253",
+	"LastPageNumber": "b287",
+	"LatestReprintNumber": "x446",
+	"LettersAfterNames": "b042",
+	"LevelSequenceNumber": "b284",
+	"ListName": "x432",
+	"LocationIDType": "j377",
+	"LocationIdentifier": "locationidentifier",
+	"LocationName": "j349",
+	"MainSubject": "x425",
+	"MapScale": "b063",
+	"Market": "market",
+	"MarketDate": "marketdate",
+	"MarketDateRole": "j408",
+	"MarketPublishingDetail": "marketpublishingdetail",
+	"MarketPublishingStatus": "j407",
+	"MarketPublishingStatusNote": "x406",
+	"Measure": "measure",
+	"MeasureType": "x315",
+	"MeasureUnitCode": "c095",
+	"Measurement": "c094",
+	"MessageNote": "m183",
+	"MessageNumber": "m180",
+	"MessageRepeat": "m181",
+	"MinimumOrderQuantity": "j263",
+	"NameAsSubject": "nameassubject",
+	"NameIDType": "x415",
+	"NameIdentifier": "nameidentifier",
+	"NameType": "x414",
+	"NamesAfterKey": "b041",
+	"NamesBeforeKey": "b039",
+	"NewSupplier": "newsupplier",
+	"NoCollection": "x411",
+	"NoContributor": "n339",
+	"NoEdition": "n386",
+	"NoPrefix": "x501",
+	"NoProduct": "x507",
+	"NotificationType": "a002",
+	"Number": "b257",
+	"NumberOfCopies": "x323",
+	"NumberOfIllustrations": "b125",
+	"NumberOfItemsOfThisForm": "x322",
+	"NumberOfPages": "b061",
+	"ONIXMessage": "ONIXmessage",
+	"OccurrenceDate": "occurrencedate",
+	"OccurrenceDateRole": "x554",
+	"OnHand": "j350",
+	"OnOrder": "j351",
+	"OnOrderDetail": "onorderdetail",
+	"OrderQuantityMinimum": "x532",
+	"OrderQuantityMultiple": "x533",
+	"OrderTime": "j144",
+	"PackQuantity": "j145",
+	"PageRun": "pagerun",
+	"PalletQuantity": "x545",
+	"PartNumber": "x410",
+	"Percent": "b337",
+	"PersonName": "b036",
+	"PersonNameInverted": "b037",
+	"PositionOnList": "x433",
+	"PositionOnProduct": "x313",
+	"PrefixToKey": "b247",
+	"Price": "price",
+	"PriceAmount": "j151",
+	"PriceCode": "x468",
+	"PriceCodeType": "x465",
+	"PriceCodeTypeName": "x477",
+	"PriceCoded": "pricecoded",
+	"PriceCondition": "pricecondition",
+	"PriceConditionQuantity": "priceconditionquantity",
+	"PriceConditionQuantityType": "x464",
+	"PriceConditionType": "x463",
+	"PriceConstraint": "priceconstraint",
+	"PriceConstraintLimit": "priceconstraintlimit",
+	"PriceConstraintStatus": "x530",
+	"PriceConstraintType": "x529",
+	"PriceConstraintUnit": "x531",
+	"PriceDate": "pricedate",
+	"PriceDateRole": "x476",
+	"PriceIDType": "x506",
+	"PriceIdentifier": "priceidentifier",
+	"PricePartDescription": "x535",
+	"PricePer": "j239",
+	"PriceQualifier": "j261",
+	"PriceStatus": "j266",
+	"PriceType": "x462",
+	"PriceTypeDescription": "j262",
+	"PrimaryContentType": "x416",
+	"PrimaryPart": "x457",
+	"PrintedOnProduct": "x301",
+	"Prize": "prize",
+	"PrizeCode": "g129",
+	"PrizeCountry": "g128",
+	"PrizeJury": "g343",
+	"PrizeName": "g126",
+	"PrizeRegion": "x556",
+	"PrizeStatement": "x503",
+	"PrizeYear": "g127",
+	"Product": "product",
+	"ProductAvailability": "j396",
+	"ProductClassification": "productclassification",
+	"ProductClassificationCode": "b275",
+	"ProductClassificationType": "b274",
+	"ProductClassificationTypeName": "x555",
+	"ProductComposition": "x314",
+	"ProductContact": "productcontact",
+	"ProductContactIDType": "x483",
+	"ProductContactIdentifier": "productcontactidentifier",
+	"ProductContactName": "x484",
+	"ProductContactRole": "x482",
+	"ProductContentType": "b385",
+	"ProductForm": "b012",
+	"ProductFormDescription": "b014",
+	"ProductFormDetail": "b333",
+	"ProductFormFeature": "productformfeature",
+	"ProductFormFeatureDescription": "b336",
+	"ProductFormFeatureType": "b334",
+	"ProductFormFeatureValue": "b335",
+	"ProductIDType": "b221",
+	"ProductIdentifier": "productidentifier",
+	"ProductPackaging": "b225",
+	"ProductPart": "productpart",
+	"ProductRelationCode": "x455",
+	"ProductSupply": "productsupply",
+	"ProfessionalAffiliation": "professionalaffiliation",
+	"ProfessionalPosition": "b045",
+	"PromotionCampaign": "k165",
+	"PromotionContact": "k166",
+	"PromotionDetail": "promotiondetail",
+	"PromotionalEvent": "promotionalevent",
+	"Proximity": "x502",
+	"Publisher": "publisher",
+	"PublisherIDType": "x447",
+	"PublisherIdentifier": "publisheridentifier",
+	"PublisherName": "b081",
+	"PublisherRepresentative": "publisherrepresentative",
+	"PublishingDate": "publishingdate",
+	"PublishingDateRole": "x448",
+	"PublishingDetail": "publishingdetail",
+	"PublishingRole": "b291",
+	"PublishingStatus": "b394",
+	"PublishingStatusNote": "b395",
+	"Quantity": "x320",
+	"QuantityUnit": "x466",
+	"ROWSalesRightsType": "x456",
+	"Rate": "x505",
+	"Rating": "x525",
+	"RatingLimit": "x526",
+	"RatingUnits": "x527",
+	"RecordReference": "a001",
+	"RecordSourceIDType": "x311",
+	"RecordSourceIdentifier": "recordsourceidentifier",
+	"RecordSourceName": "a197",
+	"RecordSourceType": "a194",
+	"RegionCode": "b398",
+	"RegionsExcluded": "x452",
+	"RegionsIncluded": "x450",
+	"Reissue": "reissue",
+	"ReissueDate": "j365",
+	"ReissueDescription": "j366",
+	"RelatedMaterial": "relatedmaterial",
+	"RelatedProduct": "relatedproduct",
+	"RelatedWork": "relatedwork",
+	"ReligiousText": "religioustext",
+	"ReligiousTextFeature": "religioustextfeature",
+	"ReligiousTextFeatureCode": "b359",
+	"ReligiousTextFeatureDescription": "b360",
+	"ReligiousTextFeatureType": "b358",
+	"ReligiousTextIdentifier": "b376",
+	"ReprintDetail": "k309",
+	"Reserved": "x536",
+	"ResourceContentType": "x436",
+	"ResourceFeature": "resourcefeature",
+	"ResourceFeatureType": "x438",
+	"ResourceForm": "x441",
+	"ResourceLink": "x435",
+	"ResourceMode": "x437",
+	"ResourceVersion": "resourceversion",
+	"ResourceVersionFeature": "resourceversionfeature",
+	"ResourceVersionFeatureType": "x442",
+	"ReturnsCode": "j269",
+	"ReturnsCodeType": "j268",
+	"ReturnsCodeTypeName": "x460",
+	"ReturnsConditions": "returnsconditions",
+	"ReturnsNote": "x528",
+	"ReviewRating": "reviewrating",
+	"SalesOutlet": "salesoutlet",
+	"SalesOutletIDType": "b393",
+	"SalesOutletIdentifier": "salesoutletidentifier",
+	"SalesOutletName": "b382",
+	"SalesRestriction": "salesrestriction",
+	"SalesRestrictionNote": "x453",
+	"SalesRestrictionType": "b381",
+	"SalesRights": "salesrights",
+	"SalesRightsType": "b089",
+	"ScriptCode": "x420",
+	"Sender": "sender",
+	"SenderIDType": "m379",
+	"SenderIdentifier": "senderidentifier",
+	"SenderName": "x298",
+	"SentDateTime": "x307",
+	"SequenceNumber": "b034",
+	"SourceName": "x330",
+	"SourceTitle": "x428",
+	"SourceType": "x431",
+	"StartDate": "b324",
+	"StartTime": "x542",
+	"Stock": "stock",
+	"StockQuantityCode": "j297",
+	"StockQuantityCodeType": "j293",
+	"StockQuantityCodeTypeName": "j296",
+	"StockQuantityCoded": "stockquantitycoded",
+	"StreetAddress": "x552",
+	"StudyBibleType": "b389",
+	"Subject": "subject",
+	"SubjectCode": "b069",
+	"SubjectDate": "subjectdate",
+	"SubjectDateRole": "x534",
+	"SubjectHeadingText": "b070",
+	"SubjectSchemeIdentifier": "b067",
+	"SubjectSchemeName": "b171",
+	"SubjectSchemeVersion": "b068",
+	"Subtitle": "b029",
+	"SuffixToKey": "b248",
+	"Supplier": "supplier",
+	"SupplierCodeType": "x458",
+	"SupplierCodeTypeName": "x513",
+	"SupplierCodeValue": "x459",
+	"SupplierIDType": "j345",
+	"SupplierIdentifier": "supplieridentifier",
+	"SupplierName": "j137",
+	"SupplierOwnCoding": "supplierowncoding",
+	"SupplierRole": "j292",
+	"SupplyContact": "supplycontact",
+	"SupplyContactIDType": "x538",
+	"SupplyContactIdentifier": "supplycontactidentifier",
+	"SupplyContactName": "x539",
+	"SupplyContactRole": "x537",
+	"SupplyDate": "supplydate",
+	"SupplyDateRole": "x461",
+	"SupplyDetail": "supplydetail",
+	"SupportingResource": "supportingresource",
+	"Tax": "tax",
+	"TaxAmount": "x474",
+	"TaxExempt": "x546",
+	"TaxRateCode": "x471",
+	"TaxRatePercent": "x472",
+	"TaxType": "x470",
+	"TaxableAmount": "x473",
+	"TelephoneNumber": "j270",
+	"Territory": "territory",
+	"Text": "d104",
+	"TextAuthor": "d107",
+	"TextContent": "textcontent",
+	"TextItem": "textitem",
+	"TextItemIDType": "b285",
+	"TextItemIdentifier": "textitemidentifier",
+	"TextItemType": "b290",
+	"TextSourceCorporate": "b374",
+	"TextSourceDescription": "x557",
+	"TextType": "x426",
+	"ThesisPresentedTo": "b369",
+	"ThesisType": "b368",
+	"ThesisYear": "b370",
+	"TimeRun": "timerun",
+	"TitleDetail": "titledetail",
+	"TitleElement": "titleelement",
+	"TitleElementLevel": "x409",
+	"TitlePrefix": "b030",
+	"TitleStatement": "x478",
+	"TitleText": "b203",
+	"TitleType": "b202",
+	"TitleWithoutPrefix": "b031",
+	"TitlesAfterNames": "b043",
+	"TitlesBeforeNames": "b038",
+	"ToLanguage": "x413",
+	"ToQuantity": "x514",
+	"TradeCategory": "b384",
+	"UnnamedPersons": "b249",
+	"UnpricedItemType": "j192",
+	"Velocity": "velocity",
+	"VelocityMetric": "x504",
+	"VenueName": "x551",
+	"VenueNote": "x553",
+	"Website": "website",
+	"WebsiteDescription": "b294",
+	"WebsiteLink": "b295",
+	"WebsiteRole": "b367",
+	"WorkIDType": "b201",
+	"WorkIdentifier": "workidentifier",
+	"WorkRelationCode": "x454",
+	"YearOfAnnual": "b020",
+}
+
+// ReferenceTags is mapping from short tag names to reference tag names.
+var ReferenceTags = map[string]string{}
+
+func init() {
+	for ref, short := range ShortTags {
+		ReferenceTags[short] = ref
+	}
+}
@@ -0,0 +1,126 @@
+package onix
+
+import "fmt"
+
+// TaxKind is code of List 171 which tells type of tax, such as 01 for VAT.
+type TaxKind string
+
+const (
+	// TaxVAT is value added tax.
+	TaxVAT TaxKind = "01"
+	// TaxGST is goods and services tax.
+	TaxGST TaxKind = "02"
+	// TaxECO is ecotax of France.
+	TaxECO TaxKind = "03"
+)
+
+// TaxRate is code of List 62 which tells rate of tax, which is named so since TaxRateCode is the element itself.
+type TaxRate string
+
+const (
+	// TaxRateHigher is higher rate.
+	TaxRateHigher TaxRate = "H"
+	// TaxRatePaidAtSource is tax paid at source in Italy.
+	TaxRatePaidAtSource TaxRate = "P"
+	// TaxRateLower is lower rate.
+	TaxRateLower TaxRate = "R"
+	// TaxRateStandard is standard rate.
+	TaxRateStandard TaxRate = "S"
+	// TaxRateSuperLow is super-low rate.
+	TaxRateSuperLow TaxRate = "T"
+	// TaxRateZero is zero-rated.
+	TaxRateZero TaxRate = "Z"
+)
+
+// Kind returns type of tax, which is VAT unless TaxType is given.
+func (t Tax) Kind() TaxKind {
+	if t.TaxType == nil {
+		return TaxVAT
+	}
+	return TaxKind(t.TaxType.Code())
+}
+
+// Rate returns rate of tax, which is empty unless TaxRateCode is given.
+func (t Tax) Rate() TaxRate {
+	if t.TaxRateCode == nil {
+		return ""
+	}
+	return TaxRate(t.TaxRateCode.Code())
+}
+
+// Percent returns rate of tax in percent.
+func (t Tax) Percent() (Amount, bool) {
+	return amountOfText(t.TaxRatePercent)
+}
+
+// Taxable returns amount which tax is charged on.
+func (t Tax) Taxable() (Amount, bool) {
+	return amountOfText(t.TaxableAmount)
+}
+
+// Amount returns amount of tax.
+func (t Tax) Amount() (Amount, bool) {
+	return amountOfText(t.TaxAmount)
+}
+
+// Amount returns amount of price, which is an error for unpriced item.
+func (p Price) Amount() (Amount, error) {
+	if p.PriceAmount == nil {
+		return Amount{}, fmt.Errorf("unexpected price without amount has been passed")
+	}
+	return ParseAmount(p.PriceAmount.Body)
+}
+
+// TaxInclusive reports whether price includes tax by its type.
+func (p Price) TaxInclusive() bool {
+	return p.Kind().IncludingTax()
+}
+
+// TaxesByKind returns Tax composites of price by their type, such as VAT and ecotax on the same price.
+func (p Price) TaxesByKind() map[TaxKind][]Tax {
+	taxes := map[TaxKind][]Tax{}
+	for _, t := range p.Taxs {
+		taxes[t.Kind()] = append(taxes[t.Kind()], t)
+	}
+	return taxes
+}
+
+// NetAmount returns amount of price excluding tax, which is computed from its Tax composites when price includes tax.
+// Price which is exempt from tax is of the same amount.
+func (p Price) NetAmount() (Amount, error) {
+	amount, err := p.Amount()
+	if err != nil || !p.TaxInclusive() || bool(p.TaxExempt) {
+		return amount, err
+	}
+	var parts []taxPart
+	for _, t := range p.Taxs {
+		part := taxPart{zeroRated: t.Rate() == TaxRateZero}
+		for _, to := range []struct {
+			text   *PlainText
+			amount **Amount
+		}{
+			{t.TaxRatePercent, &part.percent},
+			{t.TaxableAmount, &part.taxable},
+			{t.TaxAmount, &part.amount},
+		} {
+			if to.text == nil {
+				continue
+			}
+			a, err := ParseAmount(to.text.Body)
+			if err != nil {
+				return Amount{}, err
+			}
+			*to.amount = &a
+		}
+		parts = append(parts, part)
+	}
+	return netAmountOf(amount, parts)
+}
+
+func amountOfText(t *PlainText) (Amount, bool) {
+	if t == nil {
+		return Amount{}, false
+	}
+	a, err := ParseAmount(t.Body)
+	return a, err == nil
+}
@@ -0,0 +1,97 @@
+package onix
+
+import "strings"
+
+const (
+	// TitleTypeDistinctive is code of List 15 of distinctive title of product.
+	TitleTypeDistinctive = "01"
+	// TitleTypeAbbreviated is code of List 15 of abbreviated title of product, such as one of limited length for displays.
+	TitleTypeAbbreviated = "05"
+)
+
+// SortKey returns title which element tells for sorting, which is TitleWithoutPrefix if title is split into TitlePrefix and it,
+// or TitleText which NoPrefix tells to have no prefix as it is, or TitleText without its leading article of language otherwise,
+// which is a code of List 74 such as eng.
+func (e TitleElement) SortKey(language string) (string, bool) {
+	if e.TitleWithoutPrefix != nil {
+		return strings.TrimSpace(e.TitleWithoutPrefix.Body), true
+	}
+	if e.TitleText == nil {
+		return "", false
+	}
+	if e.NoPrefix {
+		return strings.TrimSpace(e.TitleText.Body), true
+	}
+	return withoutArticle(e.TitleText.Body, language), true
+}
+
+// Title returns title which title element of product level tells, or which the first title element tells unless there is.
+func (t TitleDetail) Title() (string, bool) {
+	e, ok := t.element()
+	if !ok {
+		return "", false
+	}
+	return e.Text()
+}
+
+// Subtitle returns subtitle of title element which Title takes.
+func (t TitleDetail) Subtitle() (string, bool) {
+	e, ok := t.element()
+	if !ok || e.Subtitle == nil {
+		return "", false
+	}
+	return e.Subtitle.Body, true
+}
+
+// SortTitle returns title which Title returns for sorting in language as SortKey of the element does.
+func (t TitleDetail) SortTitle(language string) (string, bool) {
+	e, ok := t.element()
+	if !ok {
+		return "", false
+	}
+	return e.SortKey(language)
+}
+
+func (t TitleDetail) element() (TitleElement, bool) {
+	if e, ok := t.Element(TitleElementLevelProduct); ok {
+		return e, true
+	}
+	if len(t.TitleElements) == 0 {
+		return TitleElement{}, false
+	}
+	return t.TitleElements[0], true
+}
+
+// leadingArticles are articles by codes of List 74 of languages, which sorting of titles ignores at the beginning of them.
+// Articles which end with an apostrophe are elided into the next word, such as l'.
+var leadingArticles = map[string][]string{
+	"eng": {"the", "an", "a"},
+	"fre": {"les", "le", "la", "une", "un", "l'", "l’"},
+	"ger": {"der", "die", "das", "eine", "ein"},
+	"spa": {"los", "las", "el", "la", "una", "un"},
+	"ita": {"gli", "il", "lo", "la", "le", "i", "una", "uno", "un", "l'", "l’"},
+	"por": {"os", "as", "o", "a", "uma", "um"},
+	"dut": {"het", "de", "een"},
+}
+
+// withoutArticle returns title without its leading article of language, which is a code of List 74 such as eng.
+// Titles which consist of nothing but the article are returned as they are.
+func withoutArticle(title, language string) string {
+	title = strings.TrimSpace(title)
+	lower := strings.ToLower(title)
+	for _, article := range leadingArticles[strings.ToLower(language)] {
+		if !strings.HasPrefix(lower, article) {
+			continue
+		}
+		rest := title[len(article):]
+		if !strings.HasSuffix(article, "'") && !strings.HasSuffix(article, "’") {
+			if rest == "" || rest[0] != ' ' {
+				continue
+			}
+		}
+		if rest = strings.TrimSpace(rest); rest != "" {
+			return rest
+		}
+	}
+	return title
+}
@@ -0,0 +1,200 @@
+package onix
+
+// EpubTechnicalProtectionCode is code of List 144 which tells technical protection of digital product, such as 01 for DRM.
+type EpubTechnicalProtectionCode string
+
+const (
+	// EpubTechnicalProtectionNone is product which has no technical protection.
+	EpubTechnicalProtectionNone EpubTechnicalProtectionCode = "00"
+	// EpubTechnicalProtectionDRM is product which is protected by DRM of unspecified kind.
+	EpubTechnicalProtectionDRM EpubTechnicalProtectionCode = "01"
+	// EpubTechnicalProtectionWatermarking is product which is watermarked, but is not otherwise protected.
+	EpubTechnicalProtectionWatermarking EpubTechnicalProtectionCode = "02"
+	// EpubTechnicalProtectionAdobeDRM is product which is protected by Adobe DRM.
+	EpubTechnicalProtectionAdobeDRM EpubTechnicalProtectionCode = "03"
+	// EpubTechnicalProtectionAppleDRM is product which is protected by Apple DRM.
+	EpubTechnicalProtectionAppleDRM EpubTechnicalProtectionCode = "04"
+	// EpubTechnicalProtectionOMADRM is product which is protected by OMA DRM.
+	EpubTechnicalProtectionOMADRM EpubTechnicalProtectionCode = "05"
+	// EpubTechnicalProtectionReadiumLCP is product which is protected by Readium LCP DRM.
+	EpubTechnicalProtectionReadiumLCP EpubTechnicalProtectionCode = "06"
+	// EpubTechnicalProtectionSonyDRM is product which is protected by Sony DRM.
+	EpubTechnicalProtectionSonyDRM EpubTechnicalProtectionCode = "07"
+)
+
+// IsDRM reports whether c is of DRM which restricts usage of product, where watermarking is not.
+func (c EpubTechnicalProtectionCode) IsDRM() bool {
+	return c != "" && c != EpubTechnicalProtectionNone && c != EpubTechnicalProtectionWatermarking
+}
+
+// EpubUsageTypeCode is code of List 145 which tells usage constrained, such as 02 for print.
+type EpubUsageTypeCode string
+
+const (
+	// EpubUsageNoConstraints is usage of product which has no constraints.
+	EpubUsageNoConstraints EpubUsageTypeCode = "00"
+	// EpubUsagePreview is preview of product before purchase.
+	EpubUsagePreview EpubUsageTypeCode = "01"
+	// EpubUsagePrint is printing of product.
+	EpubUsagePrint EpubUsageTypeCode = "02"
+	// EpubUsageCopyPaste is copying and pasting of content.
+	EpubUsageCopyPaste EpubUsageTypeCode = "03"
+	// EpubUsageShare is sharing of product among devices.
+	EpubUsageShare EpubUsageTypeCode = "04"
+	// EpubUsageTextToSpeech is reading aloud of content by text to speech.
+	EpubUsageTextToSpeech EpubUsageTypeCode = "05"
+	// EpubUsageLend is lending of product to others.
+	EpubUsageLend EpubUsageTypeCode = "06"
+	// EpubUsageTimeLimitedLicense is license of product which expires.
+	EpubUsageTimeLimitedLicense EpubUsageTypeCode = "07"
+	// EpubUsageLoanRenewal is renewal of loan of product.
+	EpubUsageLoanRenewal EpubUsageTypeCode = "08"
+	// EpubUsageMultiUserLicense is license of product for multiple users.
+	EpubUsageMultiUserLicense EpubUsageTypeCode = "09"
+	// EpubUsagePreviewOnPremises is preview of product on premises of retailer or library.
+	EpubUsagePreviewOnPremises EpubUsageTypeCode = "10"
+)
+
+// EpubUsageStatusCode is code of List 146 which tells whether usage is permitted, such as 03 for prohibited.
+type EpubUsageStatusCode string
+
+const (
+	// EpubUsagePermittedUnlimited is usage which is permitted without limit.
+	EpubUsagePermittedUnlimited EpubUsageStatusCode = "01"
+	// EpubUsagePermittedSubjectToLimit is usage which is permitted within EpubUsageLimit composites.
+	EpubUsagePermittedSubjectToLimit EpubUsageStatusCode = "02"
+	// EpubUsageProhibited is usage which is prohibited.
+	EpubUsageProhibited EpubUsageStatusCode = "03"
+)
+
+// EpubUsageUnitCode is code of List 147 which tells unit of limit of usage, such as 04 for pages.
+type EpubUsageUnitCode string
+
+const (
+	// EpubUsageUnitCopies is limit in copies.
+	EpubUsageUnitCopies EpubUsageUnitCode = "01"
+	// EpubUsageUnitCharacters is limit in characters.
+	EpubUsageUnitCharacters EpubUsageUnitCode = "02"
+	// EpubUsageUnitWords is limit in words.
+	EpubUsageUnitWords EpubUsageUnitCode = "03"
+	// EpubUsageUnitPages is limit in pages.
+	EpubUsageUnitPages EpubUsageUnitCode = "04"
+	// EpubUsageUnitPercentage is limit in percentage of content.
+	EpubUsageUnitPercentage EpubUsageUnitCode = "05"
+	// EpubUsageUnitDevices is limit in devices.
+	EpubUsageUnitDevices EpubUsageUnitCode = "06"
+	// EpubUsageUnitConcurrentUsers is limit in users at the same time.
+	EpubUsageUnitConcurrentUsers EpubUsageUnitCode = "07"
+	// EpubUsageUnitDays is limit in days.
+	EpubUsageUnitDays EpubUsageUnitCode = "09"
+	// EpubUsageUnitTimes is limit in times of usage.
+	EpubUsageUnitTimes EpubUsageUnitCode = "10"
+	// EpubUsageUnitWeeks is limit in weeks.
+	EpubUsageUnitWeeks EpubUsageUnitCode = "13"
+	// EpubUsageUnitMonths is limit in months.
+	EpubUsageUnitMonths EpubUsageUnitCode = "14"
+	// EpubUsageUnitUsers is limit in users.
+	EpubUsageUnitUsers EpubUsageUnitCode = "15"
+)
+
+// Usage returns usage which constraint applies to.
+func (c EpubUsageConstraint) Usage() EpubUsageTypeCode {
+	return EpubUsageTypeCode(c.EpubUsageType.Code())
+}
+
+// Status returns whether usage is permitted.
+func (c EpubUsageConstraint) Status() EpubUsageStatusCode {
+	return EpubUsageStatusCode(c.EpubUsageStatus.Code())
+}
+
+// IsPermitted reports whether usage is permitted, with or without limit.
+func (c EpubUsageConstraint) IsPermitted() bool {
+	status := c.Status()
+	return status == EpubUsagePermittedUnlimited || status == EpubUsagePermittedSubjectToLimit
+}
+
+// Limit returns quantity of EpubUsageLimit in unit.
+func (c EpubUsageConstraint) Limit(unit EpubUsageUnitCode) (int64, bool) {
+	for _, l := range c.EpubUsageLimits {
+		if l.Unit() == unit {
+			return quantityOf(&l.Quantity)
+		}
+	}
+	return 0, false
+}
+
+// Unit returns unit of limit.
+func (l EpubUsageLimit) Unit() EpubUsageUnitCode {
+	return EpubUsageUnitCode(l.EpubUsageUnit.Code())
+}
+
+// UsageConstraint returns EpubUsageConstraint composite of detail of usage.
+func (d DescriptiveDetail) UsageConstraint(usage EpubUsageTypeCode) (EpubUsageConstraint, bool) {
+	for _, c := range d.EpubUsageConstraints {
+		if c.Usage() == usage {
+			return c, true
+		}
+	}
+	return EpubUsageConstraint{}, false
+}
+
+// PrintLimit returns how many pages are permitted to be printed, which is 0 when printing is prohibited.
+// It is false when printing is unlimited or unspecified, or is limited in unit other than pages.
+func (d DescriptiveDetail) PrintLimit() (int64, bool) {
+	c, ok := d.UsageConstraint(EpubUsagePrint)
+	if !ok {
+		return 0, false
+	}
+	if c.Status() == EpubUsageProhibited {
+		return 0, true
+	}
+	return c.Limit(EpubUsageUnitPages)
+}
+
+// LendingAllowed reports whether lending is permitted by constraints of detail, which is false when it is unspecified.
+func (d DescriptiveDetail) LendingAllowed() bool {
+	c, ok := d.UsageConstraint(EpubUsageLend)
+	return ok && c.IsPermitted()
+}
+
+// TechnicalProtections returns technical protections of digital product of price.
+func (p Price) TechnicalProtections() []EpubTechnicalProtectionCode {
+	var protections []EpubTechnicalProtectionCode
+	for _, t := range p.EpubTechnicalProtections {
+		protections = append(protections, EpubTechnicalProtectionCode(t.Code()))
+	}
+	return protections
+}
+
+// DRM returns technical protections of DescriptiveDetail and of prices of suppliers, which exclude ones of no protection or of watermarking.
+func (p Product) DRM() []EpubTechnicalProtectionCode {
+	var drm []EpubTechnicalProtectionCode
+	if p.DescriptiveDetail != nil {
+		for _, c := range p.DescriptiveDetail.EpubTechnicalProtections {
+			if t := EpubTechnicalProtectionCode(c.Code()); t.IsDRM() && !containsProtection(drm, t) {
+				drm = append(drm, t)
+			}
+		}
+	}
+	for _, supply := range p.ProductSupplys {
+		for _, s := range supply.SupplyDetails {
+			for _, price := range s.Prices {
+				for _, t := range price.TechnicalProtections() {
+					if t.IsDRM() && !containsProtection(drm, t) {
+						drm = append(drm, t)
+					}
+				}
+			}
+		}
+	}
+	return drm
+}
+
+func containsProtection(protections []EpubTechnicalProtectionCode, protection EpubTechnicalProtectionCode) bool {
+	for _, p := range protections {
+		if p == protection {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+// Package accessibility reports accessibility of digital products of ONIX for Books 2.1 and 3.0 from their details of List 196,
+// which the European Accessibility Act requires retailers to display to consumers, and flags products which miss declarations of it.
+package accessibility
+
+import (
+	"io"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Section is a group of statements which consumer-facing summaries of accessibility display together.
+type Section string
+
+const (
+	// Conformance is conformance to specifications of accessibility such as EPUB Accessibility and WCAG.
+	Conformance Section = "Conformance"
+	// WaysOfReading is how product can be read, such as by text-to-speech or with modified appearance of text.
+	WaysOfReading Section = "Ways of reading"
+	// Navigation is how product can be navigated, such as by table of contents.
+	Navigation Section = "Navigation"
+	// RichContent is accessibility of rich content such as images, charts and math.
+	RichContent Section = "Rich content"
+	// Legal is exemptions from requirements of accessibility.
+	Legal Section = "Legal considerations"
+	// Additional is the other information such as accessibility summary.
+	Additional Section = "Additional accessibility information"
+)
+
+// sections are sections in order of display.
+var sections = []Section{Conformance, WaysOfReading, Navigation, RichContent, Legal, Additional}
+
+// sectionOf are sections of codes of List 196, where the other codes fall into Additional.
+var sectionOf = map[string]Section{
+	"01": Conformance, "02": Conformance, "03": Conformance, "04": Conformance, "08": Conformance, "09": Conformance,
+	"80": Conformance, "81": Conformance, "82": Conformance, "84": Conformance, "85": Conformance, "86": Conformance,
+	"10": WaysOfReading, "13": WaysOfReading, "20": WaysOfReading, "21": WaysOfReading, "22": WaysOfReading,
+	"24": WaysOfReading, "25": WaysOfReading, "26": WaysOfReading, "27": WaysOfReading,
+	"11": Navigation, "12": Navigation, "19": Navigation, "29": Navigation, "30": Navigation,
+	"14": RichContent, "15": RichContent, "16": RichContent, "17": RichContent, "18": RichContent, "28": RichContent,
+	"75": Legal, "76": Legal, "77": Legal,
+}
+
+const (
+	summaryCode = "00"
+	// unknownCode and inaccessibleCode declare that accessibility is unknown or limited in place of conformance.
+	unknownCode      = "08"
+	inaccessibleCode = "09"
+)
+
+// Issue is a declaration which a digital product misses.
+type Issue string
+
+const (
+	// MissingDetails is a digital product which has no details of accessibility at all.
+	MissingDetails Issue = "MissingDetails"
+	// MissingConformance is a digital product which neither claims conformance, declares that accessibility is unknown or limited,
+	// nor claims exemption.
+	MissingConformance Issue = "MissingConformance"
+	// MissingSummary is a digital product which has no accessibility summary.
+	MissingSummary Issue = "MissingSummary"
+)
+
+// Statement is a detail of accessibility which a summary displays.
+type Statement struct {
+	Section Section
+	// Code is a code of List 196.
+	Code string
+	// Text is human readable description of Code, or Code itself for codes which are not known.
+	Text string
+	// Description is detail which the sender has given, such as text of accessibility summary.
+	Description string
+}
+
+// Report is accessibility of a product.
+type Report struct {
+	// RecordReference identifies the product in its feed.
+	RecordReference string
+	// Digital is whether the product is digital content, which the act applies to.
+	// Products which are not digital have neither statements nor issues.
+	Digital bool
+	// Summary is accessibility summary of the product, which is empty unless it has one.
+	Summary string
+	// Statements are details of accessibility other than summary, in order of sections and of the message.
+	Statements []Statement
+	// Issues are declarations which the product misses.
+	Issues []Issue
+}
+
+// Compliant reports whether the product has every declaration which the act requires, which products other than digital ones do.
+func (r Report) Compliant() bool {
+	return len(r.Issues) == 0
+}
+
+// ConformsTo returns conformance which the product claims, such as EPUB Accessibility Specification 1.0 AA.
+func (r Report) ConformsTo() []string {
+	var claims []string
+	for _, s := range r.Statements {
+		if s.Section == Conformance && s.Code != unknownCode && s.Code != inaccessibleCode {
+			claims = append(claims, s.Text)
+		}
+	}
+	return claims
+}
+
+// Evaluate returns accessibility of p.
+func Evaluate(p v2.Product) Report {
+	return evaluate(p.RecordReference.Body, p.IsDigital(), p.AccessibilityFeatures())
+}
+
+// EvaluateV3 returns accessibility of p of 3.0, which ProductFormFeature composites of type 09 of DescriptiveDetail tell.
+func EvaluateV3(p v3.Product) Report {
+	d := p.DescriptiveDetail
+	if d == nil {
+		return evaluate(p.RecordReference.Body, false, nil)
+	}
+	var features []v2.AccessibilityFeature
+	for _, f := range d.AccessibilityFeatures() {
+		features = append(features, v2.AccessibilityFeature(f))
+	}
+	return evaluate(p.RecordReference.Body, d.IsDigital(), features)
+}
+
+// evaluate returns accessibility of the product of reference from features, which both releases tell in the same way.
+func evaluate(reference string, digital bool, features []v2.AccessibilityFeature) Report {
+	r := Report{RecordReference: strings.TrimSpace(reference), Digital: digital}
+	if !r.Digital {
+		return r
+	}
+	bySection := map[Section][]Statement{}
+	declared := false
+	for _, f := range features {
+		if f.Code == summaryCode {
+			if r.Summary == "" {
+				r.Summary = strings.TrimSpace(f.Description)
+			}
+			continue
+		}
+		section, ok := sectionOf[f.Code]
+		if !ok {
+			section = Additional
+		}
+		if section == Conformance || section == Legal {
+			declared = true
+		}
+		text := f.Label
+		if text == "" {
+			text = f.Code
+		}
+		bySection[section] = append(bySection[section], Statement{Section: section, Code: f.Code, Text: text, Description: strings.TrimSpace(f.Description)})
+	}
+	for _, section := range sections {
+		r.Statements = append(r.Statements, bySection[section]...)
+	}
+	switch {
+	case len(features) == 0:
+		r.Issues = append(r.Issues, MissingDetails, MissingConformance, MissingSummary)
+	default:
+		if !declared {
+			r.Issues = append(r.Issues, MissingConformance)
+		}
+		if r.Summary == "" {
+			r.Issues = append(r.Issues, MissingSummary)
+		}
+	}
+	return r
+}
+
+// EvaluateMessage returns accessibility of every product of msg in order of products.
+func EvaluateMessage(msg *v2.ONIXMessage) []Report {
+	var reports []Report
+	for _, p := range msg.Products {
+		reports = append(reports, Evaluate(p))
+	}
+	return reports
+}
+
+// EvaluateMessageV3 returns accessibility of every product of msg of 3.0 in order of products.
+func EvaluateMessageV3(msg *v3.ONIXMessage) []Report {
+	var reports []Report
+	for _, p := range msg.Products {
+		reports = append(reports, EvaluateV3(p))
+	}
+	return reports
+}
+
+// EvaluateReader reads message from r product by product, and returns accessibility of every product in order of products.
+func EvaluateReader(r io.Reader, opts ...v2.Option) ([]Report, error) {
+	reader := v2.NewReader(r, opts...)
+	defer reader.Close()
+	var reports []Report
+	for {
+		p, err := reader.Next()
+		if err == io.EOF {
+			return reports, nil
+		}
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, Evaluate(*p))
+	}
+}
+
+// EvaluateReaderV3 reads message of 3.0 from r product by product, and returns accessibility of every product in order of products.
+func EvaluateReaderV3(r io.Reader, opts ...v3.Option) ([]Report, error) {
+	reader := v3.NewReader(r, opts...)
+	defer reader.Close()
+	var reports []Report
+	for {
+		p, err := reader.Next()
+		if err == io.EOF {
+			return reports, nil
+		}
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, EvaluateV3(*p))
+	}
+}
+
+// Text returns the summary which is displayed to consumers in plain text, of a line by section which the product has.
+// Products without details tell that no information is available.
+func (r Report) Text() string {
+	if !r.Digital {
+		return ""
+	}
+	var lines []string
+	if r.Summary != "" {
+		lines = append(lines, r.Summary)
+	}
+	for _, section := range sections {
+		var texts []string
+		for _, s := range r.Statements {
+			if s.Section != section {
+				continue
+			}
+			text := s.Text
+			if s.Description != "" {
+				text += " (" + s.Description + ")"
+			}
+			texts = append(texts, text)
+		}
+		if len(texts) > 0 {
+			lines = append(lines, string(section)+": "+strings.Join(texts, ", "))
+		}
+	}
+	if len(lines) == 0 {
+		return "No information about accessibility is available."
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,204 @@
+// Package ack builds acknowledgement which recipient of message of ONIX for Books sends back to its sender,
+// telling which products have been accepted and which have been rejected with reasons.
+package ack
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// Status is result of processing a product.
+type Status string
+
+const (
+	// Accepted is product which recipient has taken in.
+	Accepted Status = "accepted"
+	// Rejected is product which recipient has refused.
+	Rejected Status = "rejected"
+)
+
+// Record is result of processing a product of message.
+type Record struct {
+	// Index is position of the product in message from 1.
+	Index int `xml:"index,attr" json:"index"`
+	// RecordReference is RecordReference of the product, which is empty for 3.0 whose model has no RecordReference yet.
+	RecordReference string   `xml:"RecordReference,omitempty" json:"recordReference,omitempty"`
+	Status          Status   `xml:"Status" json:"status"`
+	Reasons         []string `xml:"Reason,omitempty" json:"reasons,omitempty"`
+}
+
+// Acknowledgement is response to a message, which is written in XML by Encode or in JSON by encoding/json.
+// Its elements are modeled on header of ONIX for Books, since EDItEUR defines no schema of acknowledgement of ONIX for Books.
+type Acknowledgement struct {
+	XMLName xml.Name `xml:"ONIXAcknowledgement" json:"-"`
+	// Sender is recipient of the message, who sends acknowledgement.
+	Sender string `xml:"Sender" json:"sender"`
+	// Addressee is sender of the message, which is FromCompany or SenderName of its header.
+	Addressee     string `xml:"Addressee,omitempty" json:"addressee,omitempty"`
+	MessageNumber string `xml:"MessageNumber,omitempty" json:"messageNumber,omitempty"`
+	// MessageSentDate is SentDate or SentDateTime of the message as it is.
+	MessageSentDate string    `xml:"MessageSentDate,omitempty" json:"messageSentDate,omitempty"`
+	SentTime        time.Time `xml:"SentTime" json:"sentTime"`
+	// Errors are errors of the message outside products, such as of its header.
+	Errors   []string `xml:"Error,omitempty" json:"errors,omitempty"`
+	Accepted int      `xml:"Accepted" json:"accepted"`
+	Rejected int      `xml:"Rejected" json:"rejected"`
+	Records  []Record `xml:"Record,omitempty" json:"records,omitempty"`
+}
+
+// productPath matches path of validation error in a product, such as /ONIXMessage/Product[3]/NotificationType.
+var productPath = regexp.MustCompile(`^/ONIXMessage/Product\[(\d+)\](/.*)?$`)
+
+// FromMessage returns acknowledgement of msg which sender sends at sent, where products which are invalid are rejected
+// with their validation errors as reasons and the others are accepted.
+// Reject rejects products by rules of recipient on top of it.
+func FromMessage(msg *onix.Message, sender string, sent time.Time) (*Acknowledgement, error) {
+	a := &Acknowledgement{Sender: sender, SentTime: sent}
+	var errs []string
+	switch msg.Version {
+	case onix.V2:
+		if msg.V2 == nil {
+			return nil, fmt.Errorf("unexpected message without 2.1 model has been passed")
+		}
+		if h := msg.V2.Header; h != nil {
+			a.Addressee = h.From().Name
+			if h.MessageNumber != nil {
+				a.MessageNumber = strings.TrimSpace(h.MessageNumber.Body)
+			}
+			a.MessageSentDate = strings.TrimSpace(h.SentDate.Body)
+		}
+		for i, p := range msg.V2.Products {
+			a.Records = append(a.Records, Record{Index: i + 1, RecordReference: strings.TrimSpace(p.RecordReference.Body), Status: Accepted})
+		}
+		for _, err := range v2.Validate(msg.V2) {
+			errs = a.assign(err.Path, err.Message, errs)
+		}
+	case onix.V3:
+		if msg.V3 == nil {
+			return nil, fmt.Errorf("unexpected message without 3.0 model has been passed")
+		}
+		h := msg.V3.Header
+		a.Addressee = h.From().Name
+		if h.MessageNumber != nil {
+			a.MessageNumber = strings.TrimSpace(h.MessageNumber.Body)
+		}
+		a.MessageSentDate = strings.TrimSpace(h.SentDateTime.Body)
+		for i := range msg.V3.Products {
+			a.Records = append(a.Records, Record{Index: i + 1, Status: Accepted})
+		}
+		for _, err := range v3.Validate(msg.V3) {
+			errs = a.assign(err.Path, err.Message, errs)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected version of message has been passed, got [%s]", msg.Version)
+	}
+	a.Errors = errs
+	a.count()
+	return a, nil
+}
+
+// FromError returns acknowledgement of message which has failed to be decoded with err, which tells the error to its sender.
+// Product which *onix.DecodeError has occurred in is rejected, while the others are unknown and not recorded.
+func FromError(err error, sender string, sent time.Time) *Acknowledgement {
+	a := &Acknowledgement{Sender: sender, SentTime: sent, Errors: []string{err.Error()}}
+	var decodeErr *onix.DecodeError
+	if errors.As(err, &decodeErr) {
+		if m := productPath.FindStringSubmatch(decodeErr.Path); m != nil {
+			index, _ := strconv.Atoi(m[1])
+			a.Records = []Record{{Index: index, RecordReference: decodeErr.RecordReference, Status: Rejected, Reasons: []string{decodeErr.Err.Error()}}}
+			a.Errors = nil
+		}
+	}
+	a.count()
+	return a
+}
+
+// Reject rejects every product whose RecordReference is ref with reasons, and reports whether there is such a product.
+func (a *Acknowledgement) Reject(ref string, reasons ...string) bool {
+	found := false
+	for i := range a.Records {
+		if a.Records[i].RecordReference == ref {
+			a.reject(i, reasons)
+			found = true
+		}
+	}
+	return found
+}
+
+// RejectAt rejects product at index from 1 with reasons, which is for products without RecordReference such as of 3.0.
+func (a *Acknowledgement) RejectAt(index int, reasons ...string) bool {
+	for i := range a.Records {
+		if a.Records[i].Index == index {
+			a.reject(i, reasons)
+			return true
+		}
+	}
+	return false
+}
+
+// Record returns result of product whose RecordReference is ref.
+func (a *Acknowledgement) Record(ref string) (Record, bool) {
+	for _, r := range a.Records {
+		if r.RecordReference == ref {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+// Encode writes acknowledgement as XML with XML declaration.
+func (a *Acknowledgement) Encode(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(a); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+// assign adds validation error at path to the product which it has occurred in, or to errs when it is outside products.
+func (a *Acknowledgement) assign(path, message string, errs []string) []string {
+	m := productPath.FindStringSubmatch(path)
+	if m == nil {
+		return append(errs, fmt.Sprintf("%s: %s", path, message))
+	}
+	index, _ := strconv.Atoi(m[1])
+	reason := message
+	if m[2] != "" {
+		reason = fmt.Sprintf("%s: %s", strings.TrimPrefix(m[2], "/"), message)
+	}
+	if !a.RejectAt(index, reason) {
+		return append(errs, fmt.Sprintf("%s: %s", path, message))
+	}
+	return errs
+}
+
+func (a *Acknowledgement) reject(i int, reasons []string) {
+	a.Records[i].Status = Rejected
+	a.Records[i].Reasons = append(a.Records[i].Reasons, reasons...)
+	a.count()
+}
+
+func (a *Acknowledgement) count() {
+	a.Accepted, a.Rejected = 0, 0
+	for _, r := range a.Records {
+		if r.Status == Rejected {
+			a.Rejected++
+		} else {
+			a.Accepted++
+		}
+	}
+}
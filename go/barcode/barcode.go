@@ -0,0 +1,256 @@
+// Package barcode encodes GTIN-13 of products of ONIX for Books into EAN-13 barcodes for print workflows,
+// with 5-digit add-on which encodes price in US or Canadian dollar, and renders them into SVG or PNG.
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+var (
+	// ErrLength is returned when GTIN-13 or add-on has unexpected number of digits.
+	ErrLength = errors.New("barcode: unexpected length")
+	// ErrCharacter is returned when GTIN-13 or add-on has a character which is not a digit.
+	ErrCharacter = errors.New("barcode: unexpected character")
+	// ErrChecksum is returned when check digit of GTIN-13 does not match.
+	ErrChecksum = errors.New("barcode: check digit mismatch")
+	// ErrNoGTIN is returned when product has neither GTIN-13 nor ISBN-13.
+	ErrNoGTIN = errors.New("barcode: product has no GTIN-13")
+)
+
+// NoPrice is add-on which tells that no price is encoded.
+const NoPrice = "90000"
+
+// currencies are the first digits of add-on which encodes price in each currency.
+var currencies = map[string]byte{"USD": '5', "CAD": '6'}
+
+// Barcode is an EAN-13 barcode of GTIN-13, which is followed by 5-digit add-on unless AddOn is empty.
+type Barcode struct {
+	GTIN  string
+	AddOn string
+}
+
+// New returns a Barcode of gtin and addOn, which are validated, ignoring hyphens and spaces of gtin.
+func New(gtin, addOn string) (Barcode, error) {
+	b := Barcode{GTIN: normalize(gtin), AddOn: strings.TrimSpace(addOn)}
+	if err := Validate(b.GTIN); err != nil {
+		return Barcode{}, err
+	}
+	if b.AddOn == "" {
+		return b, nil
+	}
+	if len(b.AddOn) != 5 {
+		return Barcode{}, ErrLength
+	}
+	if !digits(b.AddOn) {
+		return Barcode{}, ErrCharacter
+	}
+	return b, nil
+}
+
+// Validate reports whether gtin is a valid GTIN-13, ignoring hyphens and spaces.
+func Validate(gtin string) error {
+	gtin = normalize(gtin)
+	if len(gtin) != 13 {
+		return ErrLength
+	}
+	if !digits(gtin) {
+		return ErrCharacter
+	}
+	if checkDigit(gtin[:12]) != gtin[12] {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// PriceAddOn returns add-on which encodes amount in currency, which is USD or CAD.
+// Amount is encoded in cents after the first digit of currency, where amount of 100 or more is encoded as 9999.
+func PriceAddOn(currency string, amount v2.Amount) (string, error) {
+	first, ok := currencies[currency]
+	if !ok {
+		return "", fmt.Errorf("unsupported currency of add-on has been passed, got [%s]", currency)
+	}
+	cents := math.Round(amount.Float64() * 100)
+	if cents < 0 {
+		return "", fmt.Errorf("unexpected amount of add-on has been passed, got [%s]", amount)
+	}
+	if cents > 9999 {
+		cents = 9999
+	}
+	return fmt.Sprintf("%c%04d", first, int(cents)), nil
+}
+
+// FromProduct returns a Barcode of GTIN-13 of p, which falls back on its ISBN-13.
+// Add-on follows the first Barcode element of p whose scheme has add-on, which encodes retail price in its currency,
+// or NoPrice when it encodes no price or p has no retail price in the currency.
+// PriceAddOn encodes price of products without Barcode elements.
+func FromProduct(p v2.Product) (Barcode, error) {
+	gtin, ok := p.GTIN13()
+	if !ok {
+		gtin, ok = p.ISBN13()
+	}
+	if !ok {
+		return Barcode{}, ErrNoGTIN
+	}
+	addOn := ""
+	for _, b := range p.Barcodes {
+		kind := b.Kind()
+		if !kind.HasAddOn() {
+			continue
+		}
+		addOn = NoPrice
+		if currency := kind.AddOnCurrency(); currency != "" {
+			if price, ok := p.RetailPriceIn(currency); ok {
+				if amount, err := price.Amount(); err == nil {
+					if encoded, err := PriceAddOn(currency, amount); err == nil {
+						addOn = encoded
+					}
+				}
+			}
+		}
+		break
+	}
+	return New(gtin, addOn)
+}
+
+const (
+	// quietZone is width of margin at the left of barcode in modules, which is 7 at the right.
+	quietZone = 11
+	// addOnGap is width of gap between barcode and add-on in modules.
+	addOnGap = 9
+)
+
+// leftPatterns are patterns of odd parity of digits, whose complement is of the right half,
+// and whose reverse of complement is of even parity.
+var leftPatterns = []string{
+	"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// parities are parities of the left half of EAN-13 which the first digit is encoded into, where G is even.
+var parities = []string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG", "LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+// addOnParities are parities of 5-digit add-on of each checksum.
+var addOnParities = []string{
+	"GGLLL", "GLGLL", "GLLGL", "GLLLG", "LGGLL", "LLGGL", "LLLGG", "LGLGL", "LGLLG", "LLGLG",
+}
+
+// Modules returns modules of barcode from the left quiet zone to the right one, where true is a bar.
+func (b Barcode) Modules() ([]bool, error) {
+	bars, _, err := b.encode()
+	return bars, err
+}
+
+// encode returns modules of barcode, and whether each of them is of guard bars which are taller than bars of digits.
+// Modules after the right quiet zone of the barcode itself are of add-on.
+func (b Barcode) encode() ([]bool, []bool, error) {
+	b, err := New(b.GTIN, b.AddOn)
+	if err != nil {
+		return nil, nil, err
+	}
+	var bars, guards []bool
+	put := func(pattern string, guard bool) {
+		for _, m := range pattern {
+			bars = append(bars, m == '1')
+			guards = append(guards, guard)
+		}
+	}
+	put(strings.Repeat("0", quietZone), false)
+	put("101", true)
+	parity := parities[b.GTIN[0]-'0']
+	for i := 1; i <= 6; i++ {
+		put(patternOf(b.GTIN[i], parity[i-1]), false)
+	}
+	put("01010", true)
+	for i := 7; i <= 12; i++ {
+		put(complement(leftPatterns[b.GTIN[i]-'0']), false)
+	}
+	put("101", true)
+	if b.AddOn == "" {
+		put(strings.Repeat("0", 7), false)
+		return bars, guards, nil
+	}
+	put(strings.Repeat("0", addOnGap), false)
+	put("01011", false)
+	parity = addOnParities[addOnChecksum(b.AddOn)]
+	for i := 0; i < 5; i++ {
+		if i > 0 {
+			put("01", false)
+		}
+		put(patternOf(b.AddOn[i], parity[i]), false)
+	}
+	put(strings.Repeat("0", 5), false)
+	return bars, guards, nil
+}
+
+func patternOf(digit, parity byte) string {
+	pattern := leftPatterns[digit-'0']
+	if parity == 'L' {
+		return pattern
+	}
+	return reverse(complement(pattern))
+}
+
+func complement(pattern string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '0' {
+			return '1'
+		}
+		return '0'
+	}, pattern)
+}
+
+func reverse(pattern string) string {
+	b := []byte(pattern)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+func addOnChecksum(addOn string) int {
+	sum := 0
+	for i := 0; i < 5; i++ {
+		weight := 3
+		if i%2 == 1 {
+			weight = 9
+		}
+		sum += weight * int(addOn[i]-'0')
+	}
+	return sum % 10
+}
+
+func checkDigit(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(body[i]-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func normalize(gtin string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, gtin)
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package barcode
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+)
+
+const (
+	// moduleMM is nominal width of module in millimeters, which is magnification of 100%.
+	moduleMM = 0.33
+	// top is margin above bars in modules, where add-on is lower by addOnTop to leave room for its digits.
+	top      = 2
+	addOnTop = 9
+	// barHeight is height of bars of digits in modules, which guard bars exceed by guardExtension.
+	barHeight      = 69
+	guardExtension = 5
+	// height is height of the whole barcode in modules including digits below bars.
+	height = top + barHeight + 11
+	// fontSize is size of digits in modules.
+	fontSize = 9
+)
+
+// rect is a bar of barcode whose position and size are in modules.
+type rect struct {
+	x, y, w, h int
+}
+
+// rects returns bars of barcode, where adjacent modules of the same height are joined into a bar.
+func (b Barcode) rects() ([]rect, int, error) {
+	bars, guards, err := b.encode()
+	if err != nil {
+		return nil, 0, err
+	}
+	var rects []rect
+	for i := 0; i < len(bars); i++ {
+		if !bars[i] {
+			continue
+		}
+		r := b.rectOf(i, guards[i])
+		for i+1 < len(bars) && bars[i+1] && b.rectOf(i+1, guards[i+1]) == (rect{i + 1, r.y, 1, r.h}) {
+			r.w++
+			i++
+		}
+		rects = append(rects, r)
+	}
+	return rects, len(bars), nil
+}
+
+func (b Barcode) rectOf(i int, guard bool) rect {
+	switch {
+	case i >= quietZone+95:
+		return rect{i, top + addOnTop, 1, barHeight + guardExtension - addOnTop}
+	case guard:
+		return rect{i, top, 1, barHeight + guardExtension}
+	}
+	return rect{i, top, 1, barHeight}
+}
+
+// WriteSVG writes barcode as SVG of nominal size, with digits below bars and those of add-on above its bars.
+func (b Barcode) WriteSVG(w io.Writer) error {
+	rects, width, err := b.rects()
+	if err != nil {
+		return err
+	}
+	b, _ = New(b.GTIN, b.AddOn)
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%.2fmm" height="%.2fmm">`,
+		width, height, float64(width)*moduleMM, float64(height)*moduleMM)
+	fmt.Fprintf(&s, `<rect width="%d" height="%d" fill="#fff"/><g fill="#000">`, width, height)
+	for _, r := range rects {
+		fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d"/>`, r.x, r.y, r.w, r.h)
+	}
+	fmt.Fprintf(&s, `</g><g font-family="OCR-B, monospace" font-size="%d" text-anchor="middle">`, fontSize)
+	baseline := top + barHeight + fontSize
+	digit := func(x float64, y int, d byte) {
+		fmt.Fprintf(&s, `<text x="%.1f" y="%d">%c</text>`, x, y, d)
+	}
+	digit(quietZone-4, baseline, b.GTIN[0])
+	for i := 0; i < 6; i++ {
+		digit(float64(quietZone+3+7*i)+3.5, baseline, b.GTIN[1+i])
+		digit(float64(quietZone+50+7*i)+3.5, baseline, b.GTIN[7+i])
+	}
+	for i := 0; i < len(b.AddOn); i++ {
+		digit(float64(quietZone+95+addOnGap+5+9*i)+3.5, top+addOnTop-1, b.AddOn[i])
+	}
+	s.WriteString(`</g></svg>`)
+	_, err = io.WriteString(w, s.String())
+	return err
+}
+
+// WritePNG writes bars of barcode as PNG whose module is scale pixels wide, without digits.
+func (b Barcode) WritePNG(w io.Writer, scale int) error {
+	if scale < 1 {
+		return fmt.Errorf("unexpected scale of barcode has been passed, got [%d]", scale)
+	}
+	rects, width, err := b.rects()
+	if err != nil {
+		return err
+	}
+	img := image.NewGray(image.Rect(0, 0, width*scale, height*scale))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for _, r := range rects {
+		bar := image.Rect(r.x*scale, r.y*scale, (r.x+r.w)*scale, (r.y+r.h)*scale)
+		draw.Draw(img, bar, image.NewUniform(color.Black), image.Point{}, draw.Src)
+	}
+	return png.Encode(w, img)
+}
@@ -0,0 +1,249 @@
+// Command onix validates, converts and inspects messages of ONIX for Books.
+//
+//	onix validate <file>
+//	onix convert --to 3.0 <file>
+//	onix to-json <file>
+//	onix stats <file>
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/convert"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+const usage = `usage: onix <command> [arguments]
+
+commands:
+  validate <file>           report parts of message which do not conform to the generated models
+  convert --to 3.0 <file>   convert message of 2.1 into 3.0
+  to-json <file>            print message as JSON
+  stats <file>              count products by notification type, publisher and product form
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "validate":
+		err = validate(os.Args[2:])
+	case "convert":
+		err = convertMessage(os.Args[2:])
+	case "to-json":
+		err = toJSON(os.Args[2:])
+	case "stats":
+		err = stats(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command has been passed, got [%s]\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "onix %s: %s\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+// file parses flags of the command and returns the file which is passed as the only argument.
+func file(flags *flag.FlagSet, args []string) (string, error) {
+	if err := flags.Parse(args); err != nil {
+		return "", err
+	}
+	if flags.NArg() != 1 {
+		return "", fmt.Errorf("a file is required")
+	}
+	return flags.Arg(0), nil
+}
+
+func validate(args []string) error {
+	input, err := file(flag.NewFlagSet("validate", flag.ExitOnError), args)
+	if err != nil {
+		return err
+	}
+	src, err := ioutil.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	version, err := detectVersion(bytes.NewReader(src))
+	if err != nil {
+		return err
+	}
+	errs := onix.ValidateStructure(bytes.NewReader(src), version)
+	for _, e := range errs {
+		fmt.Fprintf(os.Stdout, "%s:%s\n", input, e)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d errors have been found", len(errs))
+	}
+	return nil
+}
+
+// detectVersion reads release of message from its root element.
+func detectVersion(r io.Reader) (onix.Version, error) {
+	decoder := xml.NewDecoder(onix.UTF8Reader(r))
+	decoder.CharsetReader = onix.CharsetReader
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if root, ok := token.(xml.StartElement); ok {
+			return onix.DetectVersion(root)
+		}
+	}
+}
+
+func convertMessage(args []string) error {
+	flags := flag.NewFlagSet("convert", flag.ExitOnError)
+	to := flags.String("to", "3.0", "release which message is converted into")
+	input, err := file(flags, args)
+	if err != nil {
+		return err
+	}
+	if onix.Version(*to) != onix.V3 {
+		return fmt.Errorf("only conversion into %s is supported, got [%s]", onix.V3, *to)
+	}
+	msg, err := onix.Read(input)
+	if err != nil {
+		return err
+	}
+	if msg.Version != onix.V2 {
+		return fmt.Errorf("message of %s is required, got [%s]", onix.V2, msg.Version)
+	}
+
+	var warnings []convert.Warning
+	converted := v3.ONIXMessage{Release: v3.Release(onix.V3)}
+	if msg.V2.Header != nil {
+		header, ws := convert.UpgradeHeader(*msg.V2.Header)
+		converted.Header = header
+		warnings = append(warnings, ws...)
+	}
+	for _, p := range msg.V2.Products {
+		product, ws := convert.Upgrade21To30(p)
+		converted.Products = append(converted.Products, product)
+		warnings = append(warnings, ws...)
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+	if err := onix.Encode(os.Stdout, &onix.Message{Version: onix.V3, V3: &converted}); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout)
+	return err
+}
+
+func toJSON(args []string) error {
+	input, err := file(flag.NewFlagSet("to-json", flag.ExitOnError), args)
+	if err != nil {
+		return err
+	}
+	msg, err := onix.Read(input)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(msg, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(b))
+	return err
+}
+
+func stats(args []string) error {
+	input, err := file(flag.NewFlagSet("stats", flag.ExitOnError), args)
+	if err != nil {
+		return err
+	}
+	msg, err := onix.Read(input)
+	if err != nil {
+		return err
+	}
+
+	notificationTypes, publishers, forms := map[string]int{}, map[string]int{}, map[string]int{}
+	products := 0
+	switch msg.Version {
+	case onix.V2:
+		products = len(msg.V2.Products)
+		for _, p := range msg.V2.Products {
+			notificationTypes[p.NotificationType.Body]++
+			publisher, ok := p.Publisher()
+			if !ok {
+				publisher = "(unknown)"
+			}
+			publishers[publisher]++
+			form := "(unknown)"
+			if p.ProductForm != nil {
+				form = p.ProductForm.Body
+			}
+			forms[form]++
+		}
+	case onix.V3:
+		products = len(msg.V3.Products)
+		for _, p := range msg.V3.Products {
+			notificationTypes[p.NotificationType.Body]++
+			publisher, ok := "", false
+			if p.PublishingDetail != nil {
+				publisher, ok = p.PublishingDetail.Publisher()
+			}
+			if !ok {
+				publisher = "(unknown)"
+			}
+			publishers[publisher]++
+			form := "(unknown)"
+			if p.DescriptiveDetail != nil {
+				form = p.DescriptiveDetail.ProductForm.Body
+			}
+			forms[form]++
+		}
+	}
+
+	fmt.Fprintf(os.Stdout, "release: %s\nproducts: %d\n", msg.Version, products)
+	for _, count := range []struct {
+		title  string
+		counts map[string]int
+	}{
+		{"notification types", notificationTypes},
+		{"publishers", publishers},
+		{"product forms", forms},
+	} {
+		if len(count.counts) == 0 {
+			continue
+		}
+		fmt.Fprintf(os.Stdout, "%s:\n", count.title)
+		for _, key := range sortedKeys(count.counts) {
+			fmt.Fprintf(os.Stdout, "  %s\t%d\n", key, count.counts[key])
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns keys of counts in descending order of the counts.
+func sortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
@@ -0,0 +1,43 @@
+// Command onixd serves parsing and validation of messages of ONIX for Books over HTTP, for systems which are not written in Go.
+//
+//	onixd --addr :8080
+//
+// Endpoints are:
+//
+//	POST   /feeds                 upload a message, which is kept until it is deleted or evicted
+//	GET    /feeds/{id}/products   stream products of the message as JSON lines
+//	GET    /feeds/{id}/report     fetch the validation report of the message
+//	DELETE /feeds/{id}            delete the message
+//	POST   /parse                 stream products of the message of the body as JSON lines
+//	POST   /validate              fetch the validation report of the message of the body
+//	GET    /metrics               expose measurements of decoding in the text format of Prometheus
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"runtime"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address which the server listens on")
+	maxSize := flag.Int64("max-size", 64<<20, "size of message which is accepted at most in bytes, which uploaded messages are kept in memory up to")
+	maxFeeds := flag.Int("max-feeds", 16, "number of uploaded messages which are kept at most, where the oldest one is evicted")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of workers which decode products of a message")
+	readTimeout := flag.Duration("read-timeout", 5*time.Minute, "duration of reading a request including its body at most")
+	writeTimeout := flag.Duration("write-timeout", 15*time.Minute, "duration of a request from the end of its headers to the end of its response at most, such as a stream of products")
+	flag.Parse()
+
+	s := newServer(*maxSize, *maxFeeds, *workers)
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       *readTimeout,
+		WriteTimeout:      *writeTimeout,
+	}
+	log.Printf("onixd is listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
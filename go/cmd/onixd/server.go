@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// feed is an uploaded message as it has been sent.
+type feed struct {
+	ID       string       `json:"id"`
+	Version  onix.Version `json:"version"`
+	Size     int          `json:"size"`
+	Uploaded time.Time    `json:"uploaded"`
+	src      []byte
+}
+
+// server keeps uploaded messages in memory, and decodes them on each request.
+// Messages of POST /parse and POST /validate are decoded as their bodies are read, and are not kept.
+type server struct {
+	maxSize  int64
+	maxFeeds int
+	workers  int
+	// metrics are measurements of decoding of all requests, which GET /metrics exposes.
+	metrics *onix.Counters
+
+	mu    sync.Mutex
+	feeds map[string]*feed
+	// order are IDs of feeds in order of their uploads, whose first one is evicted first.
+	order []string
+}
+
+func newServer(maxSize int64, maxFeeds, workers int) *server {
+	return &server{maxSize: maxSize, maxFeeds: maxFeeds, workers: workers, feeds: map[string]*feed{}, metrics: onix.NewCounters()}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	parts := strings.Split(path, "/")
+	switch {
+	case path == "healthz" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	case path == "metrics" && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		s.metrics.WritePrometheus(w)
+	case path == "feeds" && r.Method == http.MethodPost:
+		s.upload(w, r)
+	case path == "parse" && r.Method == http.MethodPost:
+		s.withBody(w, r, s.streamProducts)
+	case path == "validate" && r.Method == http.MethodPost:
+		s.withBody(w, r, s.report)
+	case len(parts) == 2 && parts[0] == "feeds" && r.Method == http.MethodDelete:
+		s.delete(w, parts[1])
+	case len(parts) == 3 && parts[0] == "feeds" && parts[2] == "products" && r.Method == http.MethodGet:
+		s.withFeed(w, r, parts[1], s.streamProducts)
+	case len(parts) == 3 && parts[0] == "feeds" && parts[2] == "report" && r.Method == http.MethodGet:
+		s.withFeed(w, r, parts[1], s.report)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unexpected endpoint has been requested, got [%s %s]", r.Method, r.URL.Path))
+	}
+}
+
+// tooLargeError is the error of reading a body larger than maxSize.
+type tooLargeError int64
+
+func (e tooLargeError) Error() string {
+	return fmt.Sprintf("message larger than %d bytes has been passed", int64(e))
+}
+
+// limitedReader reads at most n bytes of r, and fails with tooLargeError of max once r has more.
+type limitedReader struct {
+	r   io.Reader
+	n   int64
+	max int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, tooLargeError(l.max)
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.n {
+		n, l.n = int(l.n), -1
+		return n, tooLargeError(l.max)
+	}
+	l.n -= int64(n)
+	return n, err
+}
+
+// body returns body of r which fails with tooLargeError beyond maxSize, and the release of the message which the root element declares,
+// where the body is read from its start again. It fails for documents of no message of ONIX for Books.
+func (s *server) body(r *http.Request) (io.Reader, onix.Version, int, error) {
+	src := &limitedReader{r: r.Body, n: s.maxSize, max: s.maxSize}
+	var prolog bytes.Buffer
+	version, err := detectVersion(io.TeeReader(src, &prolog))
+	if err != nil {
+		return nil, "", statusOf(err, http.StatusUnprocessableEntity), err
+	}
+	return io.MultiReader(&prolog, src), version, 0, nil
+}
+
+// statusOf returns status of err, which is status unless the body has been too large.
+func statusOf(err error, status int) int {
+	var tooLarge tooLargeError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return status
+}
+
+func (s *server) upload(w http.ResponseWriter, r *http.Request) {
+	body, version, status, err := s.body(r)
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	src, err := ioutil.ReadAll(body)
+	if err != nil {
+		writeError(w, statusOf(err, http.StatusBadRequest), err)
+		return
+	}
+	f := &feed{Version: version, Size: len(src), Uploaded: time.Now().UTC(), src: src}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	f.ID = hex.EncodeToString(id)
+
+	s.mu.Lock()
+	s.feeds[f.ID] = f
+	s.order = append(s.order, f.ID)
+	for len(s.order) > s.maxFeeds {
+		delete(s.feeds, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Location", "/feeds/"+f.ID)
+	writeJSON(w, http.StatusCreated, f)
+}
+
+func (s *server) delete(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	_, ok := s.feeds[id]
+	delete(s.feeds, id)
+	for i, uploaded := range s.order {
+		if uploaded == id {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unexpected feed has been requested, got [%s]", id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// source is a message which is being read, either of an uploaded feed or of the body of a request.
+type source struct {
+	// id is ID of the uploaded feed, which is empty for the body.
+	id      string
+	version onix.Version
+	r       io.Reader
+}
+
+func (s *server) withFeed(w http.ResponseWriter, r *http.Request, id string, handle func(http.ResponseWriter, *http.Request, source)) {
+	s.mu.Lock()
+	f, ok := s.feeds[id]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unexpected feed has been requested, got [%s]", id))
+		return
+	}
+	handle(w, r, source{id: f.ID, version: f.Version, r: bytes.NewReader(f.src)})
+}
+
+func (s *server) withBody(w http.ResponseWriter, r *http.Request, handle func(http.ResponseWriter, *http.Request, source)) {
+	body, version, status, err := s.body(r)
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	handle(w, r, source{version: version, r: body})
+}
+
+// line is a line of stream of products, which is either a product or the error which has stopped decoding.
+type line struct {
+	Product  *onix.Product `json:"product,omitempty"`
+	Warnings []string      `json:"warnings,omitempty"`
+	Error    *decodeError  `json:"error,omitempty"`
+}
+
+type decodeError struct {
+	Message         string `json:"message"`
+	Path            string `json:"path,omitempty"`
+	RecordReference string `json:"recordReference,omitempty"`
+	Offset          int64  `json:"offset,omitempty"`
+}
+
+func errorOf(err error) *decodeError {
+	var decodeErr *onix.DecodeError
+	if errors.As(err, &decodeErr) {
+		return &decodeError{Message: decodeErr.Err.Error(), Path: decodeErr.Path, RecordReference: decodeErr.RecordReference, Offset: decodeErr.Offset}
+	}
+	return &decodeError{Message: err.Error()}
+}
+
+// streamProducts writes products of src as JSON lines as soon as they are decoded, where codes undefined at codelists are kept as warnings.
+// The stream ends with a line of error if decoding has stopped.
+func (s *server) streamProducts(w http.ResponseWriter, r *http.Request, src source) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	failed := false
+	for result := range onix.DecodeParallelContext(r.Context(), src.r, s.workers, onix.WithUnknownCodePolicy(onix.UnknownCodeKeep), onix.WithMetrics(s.metrics)) {
+		// The channel is drained even after the client has gone, so that workers are not left blocked.
+		if failed {
+			continue
+		}
+		l := line{}
+		if result.Err != nil {
+			l.Error = errorOf(result.Err)
+		} else {
+			product := result.Product
+			l.Product = &product
+			for _, warning := range result.Warnings {
+				l.Warnings = append(l.Warnings, warning.Error())
+			}
+		}
+		if err := encoder.Encode(l); err != nil {
+			log.Printf("stream of feed [%s] has been interrupted: %s", src.id, err)
+			failed = true
+			continue
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// validationReport is the validation report of a message.
+type validationReport struct {
+	ID       string       `json:"id,omitempty"`
+	Version  onix.Version `json:"version"`
+	Valid    bool         `json:"valid"`
+	Products int          `json:"products"`
+	// StructureErrors are parts of message which the generated models do not allow.
+	StructureErrors []structureError `json:"structureErrors"`
+	// Warnings are codes which codelists do not define.
+	Warnings []string `json:"warnings"`
+	// Deprecations are elements which the release deprecates.
+	Deprecations []string `json:"deprecations"`
+	// Error is the first error of decoding message, if any.
+	Error *decodeError `json:"error,omitempty"`
+}
+
+type structureError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// report validates structure of src while its products are decoded one by one from the same read of it,
+// so that products are not held in memory, nor the body of POST /validate.
+func (s *server) report(w http.ResponseWriter, r *http.Request, src source) {
+	report := validationReport{ID: src.id, Version: src.version, StructureErrors: []structureError{}, Warnings: []string{}, Deprecations: []string{}}
+	validated, structure := io.Pipe()
+	done := make(chan []onix.StructureError)
+	go func() {
+		errs := onix.ValidateStructure(validated, src.version)
+		// The rest is drained once validation has stopped, so that decoding is not blocked.
+		io.Copy(ioutil.Discard, validated)
+		done <- errs
+	}()
+
+	input := io.TeeReader(src.r, structure)
+	var deprecations []onix.Deprecation
+	for result := range onix.DecodeParallelContext(r.Context(), input, s.workers, onix.WithUnknownCodePolicy(onix.UnknownCodeKeep), onix.WithDeprecations(&deprecations)) {
+		if result.Err != nil {
+			if report.Error == nil {
+				report.Error = errorOf(result.Err)
+			}
+			continue
+		}
+		report.Products++
+		for _, warning := range result.Warnings {
+			report.Warnings = append(report.Warnings, warning.Error())
+		}
+	}
+	// The rest after the root element is validated as well, such as trailing elements.
+	_, err := io.Copy(ioutil.Discard, input)
+	structure.CloseWithError(err)
+	for _, e := range <-done {
+		report.StructureErrors = append(report.StructureErrors, structureError{Line: e.Line, Column: e.Column, Path: e.Path, Message: e.Message})
+	}
+	if err != nil {
+		writeError(w, statusOf(err, http.StatusBadRequest), err)
+		return
+	}
+	for _, deprecation := range deprecations {
+		report.Deprecations = append(report.Deprecations, deprecation.Error())
+	}
+	report.Valid = report.Error == nil && len(report.StructureErrors) == 0 && len(report.Warnings) == 0
+	writeJSON(w, http.StatusOK, report)
+}
+
+// detectVersion reads release of message from its root element.
+func detectVersion(r io.Reader) (onix.Version, error) {
+	decoder := xml.NewDecoder(onix.UTF8Reader(r))
+	decoder.CharsetReader = onix.CharsetReader
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return "", fmt.Errorf("document without root element has been passed")
+		}
+		if err != nil {
+			return "", err
+		}
+		if root, ok := token.(xml.StartElement); ok {
+			return onix.DetectVersion(root)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("response has not been written: %s", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
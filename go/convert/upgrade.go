@@ -0,0 +1,521 @@
+// Package convert converts products between releases of ONIX for Books.
+package convert
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Warning is a part of product which cannot be converted losslessly.
+type Warning struct {
+	// Path is XPath-like location of the element in the source product such as /Product/Series[1]/Contributor.
+	Path    string
+	Message string
+}
+
+func (w Warning) Error() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// placeless are elements of 2.1 product which can be converted into composites of 3.0,
+// but 3.0 holds them in composites of publisher, contributor or supporting resource that 2.1 product does not tell which of.
+var placeless = map[string]string{
+	"Websites": "UpgradeWebsite",
+}
+
+// Upgrade21To30 converts product of 2.1 into product of 3.0.
+// Record reference, notification and identifiers are carried into the head of product, titles, series and subjects into its descriptive detail,
+// supply details into a product supply, and other elements are reported as warnings.
+func Upgrade21To30(p21 v2.Product) (v3.Product, []Warning) {
+	u := upgrader{}
+	p30 := v3.Product{RecordReference: text(p21.RecordReference)}
+	u.recode("/Product/NotificationType", p21.NotificationType, &p30.NotificationType)
+	for i, id := range p21.ProductIdentifiers {
+		p30.ProductIdentifiers = append(p30.ProductIdentifiers, u.productIdentifier(fmt.Sprintf("/Product/ProductIdentifier[%d]", i+1), id))
+	}
+	p30.DescriptiveDetail = u.descriptiveDetail("/Product", p21)
+	if len(p21.SupplyDetails) > 0 {
+		supply := v3.ProductSupply{}
+		for i, d := range p21.SupplyDetails {
+			supply.SupplyDetails = append(supply.SupplyDetails, u.supplyDetail(fmt.Sprintf("/Product/SupplyDetail[%d]", i+1), d))
+		}
+		p30.ProductSupplys = []v3.ProductSupply{supply}
+	}
+
+	v := reflect.ValueOf(p21)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		if contains(upgraded, name) || v.Field(i).IsZero() {
+			continue
+		}
+		path := "/Product/" + elementName(t.Field(i))
+		if upgrade, ok := placeless[name]; ok {
+			u.warn(path, fmt.Sprintf("it can be converted by %s, but the composite of 3.0 to hold it is not known", upgrade))
+			continue
+		}
+		u.warn(path, "the generated product of 3.0 has no field to hold it")
+	}
+	return p30, u.warnings
+}
+
+// upgraded are fields of 2.1 product which Upgrade21To30 converts.
+var upgraded = []string{"RecordReference", "NotificationType", "ProductIdentifiers", "ProductForm", "Titles", "Seriess", "MainSubjects", "Subjects", "SupplyDetails"}
+
+// UpgradeHeader converts header of 2.1 into header of 3.0.
+// Sender and addressee are carried into their composites, and defaults which 3.0 has dropped are reported as warnings.
+func UpgradeHeader(h v2.Header) (v3.Header, []Warning) {
+	u := upgrader{}
+	return u.header("/Header", h), u.warnings
+}
+
+// UpgradeSeries converts series of 2.1 into collection of publisher.
+func UpgradeSeries(s v2.Series) (v3.Collection, []Warning) {
+	u := upgrader{}
+	return u.series("/Series", s), u.warnings
+}
+
+// UpgradeTitle converts title of 2.1 into title detail of product level.
+func UpgradeTitle(t v2.Title) (v3.TitleDetail, []Warning) {
+	u := upgrader{}
+	return u.title("/Title", t, "01"), u.warnings
+}
+
+// UpgradeMainSubject converts main subject of 2.1 into subject flagged as main subject.
+func UpgradeMainSubject(s v2.MainSubject) (v3.Subject, []Warning) {
+	u := upgrader{}
+	return u.mainSubject("/MainSubject", s), u.warnings
+}
+
+// UpgradeSubject converts subject of 2.1 into subject of 3.0.
+func UpgradeSubject(s v2.Subject) (v3.Subject, []Warning) {
+	u := upgrader{}
+	return u.subject("/Subject", s), u.warnings
+}
+
+// UpgradeWebsite converts website of 2.1 into website of 3.0.
+func UpgradeWebsite(w v2.Website) (v3.Website, []Warning) {
+	u := upgrader{}
+	return u.website("/Website", w), u.warnings
+}
+
+// UpgradeProductIdentifier converts product identifier of 2.1 into product identifier of 3.0.
+func UpgradeProductIdentifier(id v2.ProductIdentifier) (v3.ProductIdentifier, []Warning) {
+	u := upgrader{}
+	return u.productIdentifier("/ProductIdentifier", id), u.warnings
+}
+
+type upgrader struct {
+	warnings []Warning
+}
+
+func (u *upgrader) warn(path, message string) {
+	u.warnings = append(u.warnings, Warning{Path: path, Message: message})
+}
+
+// recode converts code of 2.1 into code type of 3.0 through its code value,
+// since description of the same code may differ between issues of codelists.
+func (u *upgrader) recode(path string, from xml.Marshaler, to xml.Unmarshaler) {
+	data, err := xml.Marshal(from)
+	if err == nil {
+		err = xml.Unmarshal(data, to)
+	}
+	if err != nil {
+		u.warn(path, err.Error())
+	}
+}
+
+// code sets code value of 3.0 which has no counterpart in 2.1.
+func (u *upgrader) code(path string, value string, to xml.Unmarshaler) {
+	if err := xml.Unmarshal([]byte("<code>"+value+"</code>"), to); err != nil {
+		u.warn(path, err.Error())
+	}
+}
+
+// unmapped warns fields of composite which have values though they are not converted.
+func (u *upgrader) unmapped(path string, composite interface{}, mapped ...string) {
+	v := reflect.ValueOf(composite)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if v.Field(i).IsZero() || contains(mapped, t.Field(i).Name) {
+			continue
+		}
+		u.warn(path+"/"+elementName(t.Field(i)), "it is not converted into 3.0")
+	}
+}
+
+func (u *upgrader) header(path string, h v2.Header) v3.Header {
+	header := v3.Header{
+		Sender: v3.Sender{
+			SenderName:   optionalText(h.FromCompany),
+			ContactName:  optionalText(h.FromPerson),
+			EmailAddress: optionalText(h.FromEmail),
+		},
+		SentDateTime: text(h.SentDate),
+	}
+	if h.MessageNote != nil {
+		header.MessageNotes = []v3.PlainText{text(*h.MessageNote)}
+	}
+	for i, s := range h.SenderIdentifiers {
+		p := fmt.Sprintf("%s/SenderIdentifier[%d]", path, i+1)
+		id := v3.SenderIdentifier{IDTypeName: optionalText(s.IDTypeName), IDValue: text(s.IDValue)}
+		u.recode(p+"/SenderIDType", s.SenderIDType, &id.SenderIDType)
+		u.unmapped(p, s, "SenderIDType", "IDTypeName", "IDValue")
+		header.Sender.SenderIdentifiers = append(header.Sender.SenderIdentifiers, id)
+	}
+	if h.FromEANNumber != nil {
+		id := v3.SenderIdentifier{IDValue: text(*h.FromEANNumber)}
+		u.code(path+"/FromEANNumber", "06", &id.SenderIDType)
+		header.Sender.SenderIdentifiers = append(header.Sender.SenderIdentifiers, id)
+	}
+	if h.FromSAN != nil {
+		id := v3.SenderIdentifier{IDValue: text(*h.FromSAN)}
+		u.code(path+"/FromSAN", "07", &id.SenderIDType)
+		header.Sender.SenderIdentifiers = append(header.Sender.SenderIdentifiers, id)
+	}
+
+	addressee := v3.Addressee{AddresseeName: optionalText(h.ToCompany), ContactName: optionalText(h.ToPerson)}
+	for i, a := range h.AddresseeIdentifiers {
+		p := fmt.Sprintf("%s/AddresseeIdentifier[%d]", path, i+1)
+		id := v3.AddresseeIdentifier{IDTypeName: optionalText(a.IDTypeName), IDValue: text(a.IDValue)}
+		u.recode(p+"/AddresseeIDType", a.AddresseeIDType, &id.AddresseeIDType)
+		u.unmapped(p, a, "AddresseeIDType", "IDTypeName", "IDValue")
+		addressee.AddresseeIdentifiers = append(addressee.AddresseeIdentifiers, id)
+	}
+	if h.ToEANNumber != nil {
+		id := v3.AddresseeIdentifier{IDValue: text(*h.ToEANNumber)}
+		u.code(path+"/ToEANNumber", "06", &id.AddresseeIDType)
+		addressee.AddresseeIdentifiers = append(addressee.AddresseeIdentifiers, id)
+	}
+	if h.ToSAN != nil {
+		id := v3.AddresseeIdentifier{IDValue: text(*h.ToSAN)}
+		u.code(path+"/ToSAN", "07", &id.AddresseeIDType)
+		addressee.AddresseeIdentifiers = append(addressee.AddresseeIdentifiers, id)
+	}
+	if !reflect.ValueOf(addressee).IsZero() {
+		header.Addressees = []v3.Addressee{addressee}
+	}
+
+	header.MessageNumber = optionalText(h.MessageNumber)
+	header.MessageRepeat = optionalText(h.MessageRepeat)
+	if h.DefaultLanguageOfText != nil {
+		header.DefaultLanguageOfText = &v3.DefaultLanguageOfText{}
+		u.recode(path+"/DefaultLanguageOfText", h.DefaultLanguageOfText, header.DefaultLanguageOfText)
+	}
+	if h.DefaultPriceTypeCode != nil {
+		header.DefaultPriceType = &v3.DefaultPriceType{}
+		u.recode(path+"/DefaultPriceTypeCode", h.DefaultPriceTypeCode, header.DefaultPriceType)
+	}
+	if h.DefaultCurrencyCode != nil {
+		header.DefaultCurrencyCode = &v3.DefaultCurrencyCode{}
+		u.recode(path+"/DefaultCurrencyCode", h.DefaultCurrencyCode, header.DefaultCurrencyCode)
+	}
+	for _, d := range []struct {
+		name  string
+		value interface{}
+	}{
+		{"DefaultLinearUnit", h.DefaultLinearUnit},
+		{"DefaultWeightUnit", h.DefaultWeightUnit},
+		{"DefaultClassOfTrade", h.DefaultClassOfTrade},
+	} {
+		if !reflect.ValueOf(d.value).IsNil() {
+			u.warn(path+"/"+d.name, "3.0 has no default of it, so that it has to be set on each element")
+		}
+	}
+	u.unmapped(path, h, "FromCompany", "FromEANNumber", "FromSAN", "SenderIdentifiers", "FromPerson", "FromEmail",
+		"ToEANNumber", "ToSAN", "AddresseeIdentifiers", "ToCompany", "ToPerson", "MessageNumber", "MessageRepeat", "SentDate", "MessageNote",
+		"DefaultLanguageOfText", "DefaultPriceTypeCode", "DefaultCurrencyCode", "DefaultLinearUnit", "DefaultWeightUnit", "DefaultClassOfTrade")
+	return header
+}
+
+// descriptiveDetail converts elements of 2.1 product which 3.0 holds in DescriptiveDetail, which is nil if product has none of them.
+// Product of 2.1 is regarded as of a single component, since 2.1 tells composition only by its form.
+func (u *upgrader) descriptiveDetail(path string, p v2.Product) *v3.DescriptiveDetail {
+	if p.ProductForm == nil && len(p.Titles) == 0 && len(p.Seriess) == 0 && len(p.MainSubjects) == 0 && len(p.Subjects) == 0 {
+		return nil
+	}
+	d := &v3.DescriptiveDetail{}
+	u.code(path, "00", &d.ProductComposition)
+	if p.ProductForm != nil {
+		u.recode(path+"/ProductForm", p.ProductForm, &d.ProductForm)
+	} else {
+		u.warn(path, "descriptive detail of 3.0 requires product form, but product has no form")
+	}
+	for i, s := range p.Seriess {
+		d.Collections = append(d.Collections, u.series(fmt.Sprintf("%s/Series[%d]", path, i+1), s))
+	}
+	for i, t := range p.Titles {
+		d.TitleDetails = append(d.TitleDetails, u.title(fmt.Sprintf("%s/Title[%d]", path, i+1), t, "01"))
+	}
+	for i, s := range p.MainSubjects {
+		d.Subjects = append(d.Subjects, u.mainSubject(fmt.Sprintf("%s/MainSubject[%d]", path, i+1), s))
+	}
+	for i, s := range p.Subjects {
+		d.Subjects = append(d.Subjects, u.subject(fmt.Sprintf("%s/Subject[%d]", path, i+1), s))
+	}
+	return d
+}
+
+func (u *upgrader) series(path string, s v2.Series) v3.Collection {
+	c := v3.Collection{}
+	u.code(path, "10", &c.CollectionType)
+	if s.TitleOfSeries != nil {
+		c.TitleDetails = append(c.TitleDetails, v3.TitleDetail{
+			TitleElements: []v3.TitleElement{{TitleText: optionalText(s.TitleOfSeries)}},
+		})
+		u.code(path+"/TitleOfSeries", "01", &c.TitleDetails[0].TitleType)
+		u.code(path+"/TitleOfSeries", "02", &c.TitleDetails[0].TitleElements[0].TitleElementLevel)
+	}
+	for i, t := range s.Titles {
+		c.TitleDetails = append(c.TitleDetails, u.title(fmt.Sprintf("%s/Title[%d]", path, i+1), t, "02"))
+	}
+	if s.NumberWithinSeries != nil || s.YearOfAnnual != nil {
+		if len(c.TitleDetails) == 0 {
+			u.warn(path, "collection of 3.0 holds number within series and year of annual in title element, but series has no title")
+		} else {
+			element := &c.TitleDetails[0].TitleElements[0]
+			element.PartNumber = optionalText(s.NumberWithinSeries)
+			element.YearOfAnnual = optionalText(s.YearOfAnnual)
+		}
+	}
+
+	if s.SeriesISSN != nil {
+		id := v3.CollectionIdentifier{IDValue: text(*s.SeriesISSN)}
+		u.code(path+"/SeriesISSN", "02", &id.CollectionIDType)
+		c.CollectionIdentifiers = append(c.CollectionIdentifiers, id)
+	}
+	if s.PublisherSeriesCode != nil {
+		name := v3.PlainText{Body: "Publisher series code"}
+		id := v3.CollectionIdentifier{IDTypeName: &name, IDValue: text(*s.PublisherSeriesCode)}
+		u.code(path+"/PublisherSeriesCode", "01", &id.CollectionIDType)
+		c.CollectionIdentifiers = append(c.CollectionIdentifiers, id)
+	}
+	for i, si := range s.SeriesIdentifiers {
+		p := fmt.Sprintf("%s/SeriesIdentifier[%d]", path, i+1)
+		id := v3.CollectionIdentifier{IDTypeName: optionalText(si.IDTypeName), IDValue: text(si.IDValue)}
+		u.recode(p+"/SeriesIDType", si.SeriesIDType, &id.CollectionIDType)
+		u.unmapped(p, si, "SeriesIDType", "IDTypeName", "IDValue")
+		c.CollectionIdentifiers = append(c.CollectionIdentifiers, id)
+	}
+	u.unmapped(path, s, "TitleOfSeries", "Titles", "NumberWithinSeries", "YearOfAnnual", "SeriesISSN", "PublisherSeriesCode", "SeriesIdentifiers")
+	return c
+}
+
+func (u *upgrader) title(path string, t v2.Title, level string) v3.TitleDetail {
+	d := v3.TitleDetail{
+		TitleElements: []v3.TitleElement{{
+			TitleText:          optionalText(t.TitleText),
+			TitlePrefix:        optionalText(t.TitlePrefix),
+			TitleWithoutPrefix: optionalText(t.TitleWithoutPrefix),
+			Subtitle:           optionalText(t.Subtitle),
+		}},
+	}
+	u.recode(path+"/TitleType", t.TitleType, &d.TitleType)
+	u.code(path, level, &d.TitleElements[0].TitleElementLevel)
+	u.unmapped(path, t, "TitleText", "TitlePrefix", "TitleWithoutPrefix", "Subtitle", "TitleType")
+	return d
+}
+
+func (u *upgrader) mainSubject(path string, s v2.MainSubject) v3.Subject {
+	subject := v3.Subject{
+		MainSubject:          true,
+		SubjectSchemeVersion: optionalText(s.SubjectSchemeVersion),
+		SubjectCode:          optionalText(s.SubjectCode),
+	}
+	if s.SubjectHeadingText != nil {
+		subject.SubjectHeadingTexts = []v3.PlainText{text(*s.SubjectHeadingText)}
+	}
+	u.recode(path+"/MainSubjectSchemeIdentifier", s.MainSubjectSchemeIdentifier, &subject.SubjectSchemeIdentifier)
+	u.unmapped(path, s, "SubjectSchemeVersion", "SubjectCode", "SubjectHeadingText", "MainSubjectSchemeIdentifier")
+	return subject
+}
+
+func (u *upgrader) subject(path string, s v2.Subject) v3.Subject {
+	subject := v3.Subject{
+		SubjectSchemeName:    optionalText(s.SubjectSchemeName),
+		SubjectSchemeVersion: optionalText(s.SubjectSchemeVersion),
+		SubjectCode:          optionalText(s.SubjectCode),
+	}
+	if s.SubjectHeadingText != nil {
+		subject.SubjectHeadingTexts = []v3.PlainText{text(*s.SubjectHeadingText)}
+	}
+	u.recode(path+"/SubjectSchemeIdentifier", s.SubjectSchemeIdentifier, &subject.SubjectSchemeIdentifier)
+	u.unmapped(path, s, "SubjectSchemeName", "SubjectSchemeVersion", "SubjectCode", "SubjectHeadingText", "SubjectSchemeIdentifier")
+	return subject
+}
+
+func (u *upgrader) website(path string, w v2.Website) v3.Website {
+	website := v3.Website{WebsiteLinks: []v3.PlainText{text(w.WebsiteLink)}}
+	if w.WebsiteRole != nil {
+		website.WebsiteRole = &v3.WebsiteRole{}
+		u.recode(path+"/WebsiteRole", w.WebsiteRole, website.WebsiteRole)
+	}
+	if w.WebsiteDescription != nil {
+		website.WebsiteDescriptions = []v3.Flow{v3.Flow(*w.WebsiteDescription)}
+	}
+	u.unmapped(path, w, "WebsiteLink", "WebsiteRole", "WebsiteDescription")
+	return website
+}
+
+func (u *upgrader) productIdentifier(path string, id v2.ProductIdentifier) v3.ProductIdentifier {
+	identifier := v3.ProductIdentifier{IDTypeName: optionalText(id.IDTypeName), IDValue: text(id.IDValue)}
+	u.recode(path+"/ProductIDType", id.ProductIDType, &identifier.ProductIDType)
+	u.unmapped(path, id, "ProductIDType", "IDTypeName", "IDValue")
+	return identifier
+}
+
+func (u *upgrader) supplyDetail(path string, d v2.SupplyDetail) v3.SupplyDetail {
+	detail := v3.SupplyDetail{
+		Supplier: v3.Supplier{
+			SupplierName:     optionalText(d.SupplierName),
+			TelephoneNumbers: texts(d.TelephoneNumbers),
+			FaxNumbers:       texts(d.FaxNumbers),
+		},
+	}
+	for _, email := range d.EmailAddresss {
+		detail.Supplier.EmailAddresss = append(detail.Supplier.EmailAddresss, text(email))
+	}
+	for i, w := range d.Websites {
+		detail.Supplier.Websites = append(detail.Supplier.Websites, u.website(fmt.Sprintf("%s/Website[%d]", path, i+1), w))
+	}
+	for i, s := range d.SupplierIdentifiers {
+		p := fmt.Sprintf("%s/SupplierIdentifier[%d]", path, i+1)
+		id := v3.SupplierIdentifier{IDTypeName: optionalText(s.IDTypeName), IDValue: text(s.IDValue)}
+		u.recode(p+"/SupplierIDType", s.SupplierIDType, &id.SupplierIDType)
+		u.unmapped(p, s, "SupplierIDType", "IDTypeName", "IDValue")
+		detail.Supplier.SupplierIdentifiers = append(detail.Supplier.SupplierIdentifiers, id)
+	}
+	if d.SupplierSAN != nil {
+		id := v3.SupplierIdentifier{IDValue: text(*d.SupplierSAN)}
+		u.code(path+"/SupplierSAN", "07", &id.SupplierIDType)
+		detail.Supplier.SupplierIdentifiers = append(detail.Supplier.SupplierIdentifiers, id)
+	}
+	if d.SupplierEANLocationNumber != nil {
+		id := v3.SupplierIdentifier{IDValue: text(*d.SupplierEANLocationNumber)}
+		u.code(path+"/SupplierEANLocationNumber", "06", &id.SupplierIDType)
+		detail.Supplier.SupplierIdentifiers = append(detail.Supplier.SupplierIdentifiers, id)
+	}
+	if d.SupplierRole != nil {
+		u.recode(path+"/SupplierRole", d.SupplierRole, &detail.Supplier.SupplierRole)
+	} else {
+		u.warn(path+"/SupplierRole", "it is required in 3.0, but has not been set")
+	}
+	if d.ProductAvailability != nil {
+		u.recode(path+"/ProductAvailability", d.ProductAvailability, &detail.ProductAvailability)
+	} else {
+		u.warn(path+"/ProductAvailability", "it is required in 3.0, and AvailabilityCode cannot be converted into it losslessly")
+	}
+	detail.OrderTime = optionalText(d.OrderTime)
+	detail.PackQuantity = optionalText(d.PackQuantity)
+	for i, p := range d.Prices {
+		detail.Prices = append(detail.Prices, u.price(fmt.Sprintf("%s/Price[%d]", path, i+1), p))
+	}
+	u.unmapped(path, d, "SupplierName", "TelephoneNumbers", "FaxNumbers", "EmailAddresss", "Websites", "SupplierIdentifiers",
+		"SupplierSAN", "SupplierEANLocationNumber", "SupplierRole", "ProductAvailability", "OrderTime", "PackQuantity", "Prices")
+	return detail
+}
+
+func (u *upgrader) price(path string, p v2.Price) v3.Price {
+	amount := text(p.PriceAmount)
+	price := v3.Price{PriceAmount: &amount}
+	if p.PriceTypeCode != nil {
+		price.PriceType = &v3.PriceType{}
+		u.recode(path+"/PriceTypeCode", p.PriceTypeCode, price.PriceType)
+	}
+	if p.PriceQualifier != nil {
+		price.PriceQualifier = &v3.PriceQualifier{}
+		u.recode(path+"/PriceQualifier", p.PriceQualifier, price.PriceQualifier)
+	}
+	if p.PriceStatus != nil {
+		price.PriceStatus = &v3.PriceStatus{}
+		u.recode(path+"/PriceStatus", p.PriceStatus, price.PriceStatus)
+	}
+	if p.CurrencyCode != nil {
+		price.CurrencyCode = &v3.CurrencyCode{}
+		u.recode(path+"/CurrencyCode", p.CurrencyCode, price.CurrencyCode)
+	}
+	if p.PriceTypeDescription != nil {
+		price.PriceTypeDescriptions = []v3.PlainText{text(*p.PriceTypeDescription)}
+	}
+	price.MinimumOrderQuantity = optionalText(p.MinimumOrderQuantity)
+	if p.PriceEffectiveFrom != nil {
+		date := v3.PriceDate{Date: text(*p.PriceEffectiveFrom)}
+		u.code(path+"/PriceEffectiveFrom", "14", &date.PriceDateRole)
+		price.PriceDates = append(price.PriceDates, date)
+	}
+	if p.PriceEffectiveUntil != nil {
+		date := v3.PriceDate{Date: text(*p.PriceEffectiveUntil)}
+		u.code(path+"/PriceEffectiveUntil", "15", &date.PriceDateRole)
+		price.PriceDates = append(price.PriceDates, date)
+	}
+	u.unmapped(path, p, "PriceAmount", "PriceTypeCode", "PriceQualifier", "PriceStatus", "CurrencyCode",
+		"PriceTypeDescription", "MinimumOrderQuantity", "PriceEffectiveFrom", "PriceEffectiveUntil")
+	return price
+}
+
+// text converts text of 2.1 into 3.0 along with attributes of its provenance.
+func text(t v2.PlainText) v3.PlainText {
+	v := v3.PlainText{Body: t.Body}
+	if t.Datestamp != nil {
+		datestamp := v3.DtDotDateOrDateTime(*t.Datestamp)
+		v.Datestamp = &datestamp
+	}
+	if t.Sourcetype != nil {
+		sourcetype := v3.SourceTypeCode(*t.Sourcetype)
+		v.Sourcetype = &sourcetype
+	}
+	if t.Sourcename != nil {
+		sourcename := v3.DtDotNonEmptyString(*t.Sourcename)
+		v.Sourcename = &sourcename
+	}
+	return v
+}
+
+func optionalText(t *v2.PlainText) *v3.PlainText {
+	if t == nil {
+		return nil
+	}
+	v := text(*t)
+	return &v
+}
+
+func texts(ts []v2.PlainText) []v3.PlainText {
+	var vs []v3.PlainText
+	for _, t := range ts {
+		vs = append(vs, text(t))
+	}
+	return vs
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// elementName returns reference name of the element which field is bound to.
+func elementName(field reflect.StructField) string {
+	opts := strings.Split(field.Tag.Get("xml"), ",")
+	name := opts[0]
+	if ref, ok := v2.ReferenceTags[name]; ok {
+		name = ref
+	}
+	if name == "" {
+		// Extensions are elements which the model does not define.
+		return field.Name
+	}
+	for _, opt := range opts[1:] {
+		if opt == "attr" {
+			return "@" + name
+		}
+	}
+	return name
+}
@@ -0,0 +1,293 @@
+// Package csv flattens products of ONIX for Books into rows of CSV or TSV, whose columns are dotted paths of elements
+// such as DescriptiveDetail.TitleDetail[0].TitleElement.TitleText, for review of products in spreadsheets.
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// DefaultSeparator joins values of a column which has several elements, such as Contributor.PersonName.
+const DefaultSeparator = "; "
+
+// Column is a column of rows, whose cells are values of elements at its path in products.
+// A step of path is either reference name of element or name of field of the model, and the index of [n] picks n-th of repeated elements,
+// where leading Product is optional. Steps without index collect all of repeated elements.
+type Column struct {
+	// Name is the header of the column, which is the path unless it has been named.
+	Name  string
+	path  string
+	steps []step
+}
+
+type step struct {
+	name string
+	// index is the repetition which the step picks, which is -1 for all of them.
+	index int
+}
+
+// ParseColumn parses path of elements relative to product, such as Title[0].TitleText or RecordReference.
+func ParseColumn(path string) (Column, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(path), "Product.")
+	if trimmed == "" {
+		return Column{}, fmt.Errorf("empty path has been passed")
+	}
+	column := Column{Name: path, path: path}
+	for _, s := range strings.Split(trimmed, ".") {
+		st := step{name: s, index: -1}
+		if i := strings.IndexByte(s, '['); i >= 0 {
+			index, err := strconv.Atoi(strings.TrimSuffix(s[i+1:], "]"))
+			if !strings.HasSuffix(s, "]") || err != nil || index < 0 {
+				return Column{}, fmt.Errorf("malformed index of path [%s] has been passed, got [%s]", path, s)
+			}
+			st = step{name: s[:i], index: index}
+		}
+		if st.name == "" {
+			return Column{}, fmt.Errorf("malformed path has been passed, got [%s]", path)
+		}
+		column.steps = append(column.steps, st)
+	}
+	return column, nil
+}
+
+// Named returns the column whose header is name.
+func (c Column) Named(name string) Column {
+	c.Name = name
+	return c
+}
+
+func (c Column) String() string {
+	return c.path
+}
+
+// Values returns values of elements at the column in product, which is either a product of 2.1 or 3.0,
+// where codes are written as they are and composites as their texts joined by spaces.
+// It fails for steps which the model of product does not have, even if product lacks the elements.
+func (c Column) Values(product interface{}) ([]string, error) {
+	current := []reflect.Value{reflect.Indirect(reflect.ValueOf(product))}
+	t := current[0].Type()
+	for _, st := range c.steps {
+		f, ok := fieldsOf(t)[st.name]
+		if !ok {
+			return nil, fmt.Errorf("unexpected element of path [%s] has been passed, got [%s]", c.path, st.name)
+		}
+		var next []reflect.Value
+		for _, v := range current {
+			children := childrenOf(v.Field(f))
+			if st.index < 0 {
+				next = append(next, children...)
+			} else if st.index < len(children) {
+				next = append(next, children[st.index])
+			}
+		}
+		current, t = next, elemType(t.Field(f).Type)
+	}
+	values := make([]string, 0, len(current))
+	for _, v := range current {
+		if text := textOf(v); text != "" {
+			values = append(values, text)
+		}
+	}
+	return values, nil
+}
+
+// Option configures how Writer writes rows.
+type Option func(*Writer)
+
+// WithComma delimits cells by comma, such as '\t' for TSV, which is ',' by default.
+func WithComma(comma rune) Option {
+	return func(w *Writer) {
+		w.w.Comma = comma
+	}
+}
+
+// WithSeparator joins values of a cell by sep, which is DefaultSeparator by default.
+func WithSeparator(sep string) Option {
+	return func(w *Writer) {
+		w.separator = sep
+	}
+}
+
+// Writer writes products as rows of columns, following the header of their names.
+type Writer struct {
+	w         *stdcsv.Writer
+	columns   []Column
+	separator string
+	header    bool
+}
+
+// NewWriter returns Writer which writes rows of columns at paths to w, which fails for malformed paths.
+func NewWriter(w io.Writer, paths []string, opts ...Option) (*Writer, error) {
+	columns := make([]Column, len(paths))
+	for i, path := range paths {
+		column, err := ParseColumn(path)
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = column
+	}
+	return NewColumnWriter(w, columns, opts...), nil
+}
+
+// NewColumnWriter returns Writer which writes rows of columns to w.
+func NewColumnWriter(w io.Writer, columns []Column, opts ...Option) *Writer {
+	writer := &Writer{w: stdcsv.NewWriter(w), columns: columns, separator: DefaultSeparator}
+	for _, opt := range opts {
+		opt(writer)
+	}
+	return writer
+}
+
+// WriteHeader writes names of columns, which Write does before the first row unless it has been written.
+func (w *Writer) WriteHeader() error {
+	w.header = true
+	names := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		names[i] = column.Name
+	}
+	return w.w.Write(names)
+}
+
+// Write writes a row of product, which is either v2.Product or v3.Product.
+func (w *Writer) Write(product interface{}) error {
+	if !w.header {
+		if err := w.WriteHeader(); err != nil {
+			return err
+		}
+	}
+	row := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		values, err := column.Values(product)
+		if err != nil {
+			return err
+		}
+		row[i] = strings.Join(values, w.separator)
+	}
+	return w.w.Write(row)
+}
+
+// Flush writes buffered rows to the output, and returns error which has occurred on writing, if any.
+func (w *Writer) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// WriteV2 writes products of message of 2.1 as rows of columns at paths to w.
+func WriteV2(w io.Writer, msg *v2.ONIXMessage, paths []string, opts ...Option) error {
+	writer, err := NewWriter(w, paths, opts...)
+	if err != nil {
+		return err
+	}
+	if err := writer.WriteHeader(); err != nil {
+		return err
+	}
+	for _, p := range msg.Products {
+		if err := writer.Write(p); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// WriteV3 writes products of message of 3.0 as rows of columns at paths to w.
+func WriteV3(w io.Writer, msg *v3.ONIXMessage, paths []string, opts ...Option) error {
+	writer, err := NewWriter(w, paths, opts...)
+	if err != nil {
+		return err
+	}
+	if err := writer.WriteHeader(); err != nil {
+		return err
+	}
+	for _, p := range msg.Products {
+		if err := writer.Write(p); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// fieldCache are indexes of fields of struct types by reference names of their elements and names of the fields.
+var fieldCache sync.Map
+
+func fieldsOf(t reflect.Type) map[string]int {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.(map[string]int)
+	}
+	tags := v2.ReferenceTags
+	if t.PkgPath() == reflect.TypeOf(v3.Product{}).PkgPath() {
+		tags = v3.ReferenceTags
+	}
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if ref, ok := tags[name]; ok {
+			name = ref
+		}
+		fields[name] = i
+		fields[t.Field(i).Name] = i
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
+
+// childrenOf returns present elements of field.
+func childrenOf(field reflect.Value) []reflect.Value {
+	switch field.Kind() {
+	case reflect.Ptr:
+		if field.IsNil() {
+			return nil
+		}
+		return []reflect.Value{field.Elem()}
+	case reflect.Slice:
+		children := make([]reflect.Value, field.Len())
+		for i := range children {
+			children[i] = field.Index(i)
+		}
+		return children
+	}
+	return []reflect.Value{field}
+}
+
+// textOf returns texts of element of v joined by spaces, which is the code for code types since they marshal into their codes.
+func textOf(v reflect.Value) string {
+	b, err := xml.Marshal(v.Interface())
+	if err != nil {
+		return ""
+	}
+	decoder := xml.NewDecoder(strings.NewReader(string(b)))
+	var texts []string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		if text, ok := token.(xml.CharData); ok {
+			if s := strings.TrimSpace(string(text)); s != "" {
+				texts = append(texts, s)
+			}
+		}
+	}
+	return strings.Join(texts, " ")
+}
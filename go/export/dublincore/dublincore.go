@@ -0,0 +1,107 @@
+// Package dublincore converts products of ONIX for Books into simple Dublin Core records,
+// which are marshaled into XML of oai_dc as OAI-PMH harvests.
+package dublincore
+
+import (
+	"encoding/xml"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/subjects"
+	"github.com/kogai/onix-codegen/go/texts"
+)
+
+const (
+	// Namespace is namespace of elements of Dublin Core.
+	Namespace = "http://purl.org/dc/elements/1.1/"
+	// OAINamespace is namespace of container of Dublin Core in OAI-PMH.
+	OAINamespace = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+)
+
+// Record is a record of the fifteen elements of Dublin Core, which is marshaled into XML by encoding/xml.
+type Record struct {
+	XMLName      xml.Name `xml:"oai_dc:dc"`
+	XmlnsOAIDC   string   `xml:"xmlns:oai_dc,attr"`
+	XmlnsDC      string   `xml:"xmlns:dc,attr"`
+	Titles       []string `xml:"dc:title"`
+	Creators     []string `xml:"dc:creator"`
+	Subjects     []string `xml:"dc:subject"`
+	Descriptions []string `xml:"dc:description"`
+	Publishers   []string `xml:"dc:publisher"`
+	Contributors []string `xml:"dc:contributor"`
+	Dates        []string `xml:"dc:date"`
+	Types        []string `xml:"dc:type"`
+	Formats      []string `xml:"dc:format"`
+	Identifiers  []string `xml:"dc:identifier"`
+	Languages    []string `xml:"dc:language"`
+}
+
+// FromProduct converts p into a record, where authors are creators and the other contributors are contributors.
+// Names are inverted such as Schreier, Jason, and ISBN is written as URN.
+func FromProduct(p v2.Product) Record {
+	r := Record{XmlnsOAIDC: OAINamespace, XmlnsDC: Namespace}
+	if title, ok := p.Title(); ok {
+		r.Titles = append(r.Titles, title)
+	}
+	for _, c := range p.Credits() {
+		if c.Has(v2.ContributorRoleAuthor) {
+			r.Creators = append(r.Creators, c.SortName)
+		} else {
+			r.Contributors = append(r.Contributors, c.SortName)
+		}
+	}
+	for _, s := range subjects.FromProduct(p) {
+		subject := s.Heading
+		if subject == "" {
+			subject = s.Code
+		}
+		if subject != "" && !contains(r.Subjects, subject) {
+			r.Subjects = append(r.Subjects, subject)
+		}
+	}
+	if description, ok := p.Description(); ok {
+		r.Descriptions = append(r.Descriptions, texts.PlainText(description))
+	}
+	if publisher, ok := p.Publisher(); ok {
+		r.Publishers = append(r.Publishers, publisher)
+	}
+	if p.PublicationDate != nil {
+		r.Dates = append(r.Dates, isoDate(p.PublicationDate.Body))
+	}
+	if p.IsAudiobook() {
+		r.Types = append(r.Types, "Sound")
+	} else {
+		r.Types = append(r.Types, "Text")
+	}
+	if p.ProductForm != nil {
+		r.Formats = append(r.Formats, strings.TrimSpace(p.ProductForm.Body))
+	}
+	if isbn, ok := p.ISBN13(); ok {
+		r.Identifiers = append(r.Identifiers, "urn:isbn:"+isbn)
+	}
+	for _, l := range p.TextLanguages() {
+		r.Languages = append(r.Languages, l.Code())
+	}
+	return r
+}
+
+// isoDate formats date of YYYYMMDD, YYYYMM or YYYY in ISO 8601.
+func isoDate(date string) string {
+	date = strings.TrimSpace(date)
+	switch len(date) {
+	case 8:
+		return date[:4] + "-" + date[4:6] + "-" + date[6:]
+	case 6:
+		return date[:4] + "-" + date[4:]
+	}
+	return date
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
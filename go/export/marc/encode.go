@@ -0,0 +1,103 @@
+package marc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+const (
+	subfieldDelimiter = 0x1f
+	fieldTerminator   = 0x1e
+	recordTerminator  = 0x1d
+)
+
+// WriteMARCXML writes records to w as a collection of MARCXML.
+func WriteMARCXML(w io.Writer, records ...Record) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	collection := struct {
+		XMLName xml.Name `xml:"collection"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Records []Record `xml:"record"`
+	}{Xmlns: Namespace, Records: records}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(collection); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteISO2709 writes records to w in exchange format of ISO 2709, where data are encoded in UTF-8.
+func WriteISO2709(w io.Writer, records ...Record) error {
+	for _, r := range records {
+		b, err := r.ISO2709()
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ISO2709 encodes r in exchange format of ISO 2709.
+// A record longer than 99999 bytes is not encodable since its length does not fit in leader.
+func (r Record) ISO2709() ([]byte, error) {
+	if len(r.Leader) != 24 {
+		return nil, fmt.Errorf("leader must be 24 characters, got [%s]", r.Leader)
+	}
+	var directory, data bytes.Buffer
+	entry := func(tag string, field []byte) error {
+		if len(tag) != 3 {
+			return fmt.Errorf("tag must be 3 characters, got [%s]", tag)
+		}
+		if len(field) > 9999 {
+			return fmt.Errorf("field [%s] is longer than 9999 bytes", tag)
+		}
+		fmt.Fprintf(&directory, "%s%04d%05d", tag, len(field), data.Len())
+		data.Write(field)
+		return nil
+	}
+	for _, f := range r.ControlFields {
+		if err := entry(f.Tag, append([]byte(f.Value), fieldTerminator)); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range r.DataFields {
+		if len(f.Ind1) != 1 || len(f.Ind2) != 1 {
+			return nil, fmt.Errorf("indicators of field [%s] must be a character each, got [%s] and [%s]", f.Tag, f.Ind1, f.Ind2)
+		}
+		field := []byte(f.Ind1 + f.Ind2)
+		for _, s := range f.Subfields {
+			field = append(field, subfieldDelimiter)
+			field = append(field, s.Code...)
+			field = append(field, s.Value...)
+		}
+		if err := entry(f.Tag, append(field, fieldTerminator)); err != nil {
+			return nil, err
+		}
+	}
+	directory.WriteByte(fieldTerminator)
+
+	base := 24 + directory.Len()
+	length := base + data.Len() + 1
+	if length > 99999 {
+		return nil, fmt.Errorf("record is longer than 99999 bytes, got [%d]", length)
+	}
+	leader := []byte(r.Leader)
+	copy(leader[0:5], fmt.Sprintf("%05d", length))
+	copy(leader[12:17], fmt.Sprintf("%05d", base))
+
+	var b bytes.Buffer
+	b.Write(leader)
+	b.Write(directory.Bytes())
+	b.Write(data.Bytes())
+	b.WriteByte(recordTerminator)
+	return b.Bytes(), nil
+}
@@ -0,0 +1,185 @@
+// Package marc converts products of ONIX for Books into MARC 21 bibliographic records,
+// which are emitted as MARCXML or ISO 2709.
+package marc
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/texts"
+)
+
+// Namespace is namespace of MARCXML.
+const Namespace = "http://www.loc.gov/MARC21/slim"
+
+// leader is leader of new record of language material, which is a monograph of abbreviated level encoded in UTF-8 without ISBD punctuation.
+// Length of record and base address of data are filled on encoding.
+const leader = "00000nam a22000003c 4500"
+
+// Record is a MARC 21 bibliographic record.
+type Record struct {
+	XMLName       xml.Name       `xml:"record"`
+	Leader        string         `xml:"leader"`
+	ControlFields []ControlField `xml:"controlfield"`
+	DataFields    []DataField    `xml:"datafield"`
+}
+
+// ControlField is a variable control field such as 001.
+type ControlField struct {
+	Tag   string `xml:"tag,attr"`
+	Value string `xml:",chardata"`
+}
+
+// DataField is a variable data field such as 245.
+type DataField struct {
+	Tag       string     `xml:"tag,attr"`
+	Ind1      string     `xml:"ind1,attr"`
+	Ind2      string     `xml:"ind2,attr"`
+	Subfields []Subfield `xml:"subfield"`
+}
+
+// Subfield is a subfield of data field.
+type Subfield struct {
+	Code  string `xml:"code,attr"`
+	Value string `xml:",chardata"`
+}
+
+// relators are terms and codes of MARC Code List for Relators which roles of contributors correspond to.
+var relators = map[v2.ContributorRoleCode][2]string{
+	v2.ContributorRoleAuthor:      {"author", "aut"},
+	v2.ContributorRoleWith:        {"contributor", "ctb"},
+	v2.ContributorRoleIllustrator: {"illustrator", "ill"},
+	v2.ContributorRoleEditor:      {"editor", "edt"},
+	v2.ContributorRoleTranslator:  {"translator", "trl"},
+	v2.ContributorRoleReader:      {"narrator", "nrt"},
+}
+
+// FromProduct converts p into a record, whose fields are
+// 001 record reference, 008 date of publication and language, 020 ISBN, 100 or 110 main author,
+// 245 title, 264 publication, 520 description and 700 or 710 the other contributors.
+// A product without title is not convertible since 245 is mandatory.
+func FromProduct(p v2.Product) (Record, error) {
+	title, ok := p.Title()
+	if !ok {
+		return Record{}, fmt.Errorf("product [%s] has no title", p.RecordReference.Body)
+	}
+	r := Record{Leader: leader}
+	r.control("001", p.RecordReference.Body)
+	r.control("008", fixedLength(p))
+
+	if isbn, ok := p.ISBN13(); ok {
+		r.data("020", " ", " ", "a", isbn)
+	}
+
+	credits := p.Credits()
+	main := -1
+	for i, c := range credits {
+		if c.Has(v2.ContributorRoleAuthor) {
+			main = i
+			break
+		}
+	}
+	if main >= 0 {
+		r.DataFields = append(r.DataFields, name("100", credits[main]))
+	}
+
+	ind1 := "0"
+	if main >= 0 {
+		ind1 = "1"
+	}
+	statement := []string{"a", title}
+	if subtitle, ok := p.SubtitleText(); ok {
+		statement = append(statement, "b", subtitle)
+	}
+	r.data("245", ind1, "0", statement...)
+
+	var publication []string
+	if len(p.CityOfPublications) > 0 {
+		publication = append(publication, "a", p.CityOfPublications[0].Body)
+	}
+	if publisher, ok := p.Publisher(); ok {
+		publication = append(publication, "b", publisher)
+	}
+	if year, ok := yearOf(p); ok {
+		publication = append(publication, "c", year)
+	}
+	if len(publication) > 0 {
+		r.data("264", " ", "1", publication...)
+	}
+
+	if description, ok := p.Description(); ok {
+		r.data("520", " ", " ", "a", strings.Join(strings.Fields(texts.PlainText(description)), " "))
+	}
+
+	for i, c := range credits {
+		if i == main {
+			continue
+		}
+		r.DataFields = append(r.DataFields, name("700", c))
+	}
+	return r, nil
+}
+
+func (r *Record) control(tag, value string) {
+	r.ControlFields = append(r.ControlFields, ControlField{Tag: tag, Value: value})
+}
+
+// data adds data field whose subfields are pairs of code and value.
+func (r *Record) data(tag, ind1, ind2 string, subfields ...string) {
+	f := DataField{Tag: tag, Ind1: ind1, Ind2: ind2}
+	for i := 0; i+1 < len(subfields); i += 2 {
+		f.Subfields = append(f.Subfields, Subfield{Code: subfields[i], Value: subfields[i+1]})
+	}
+	r.DataFields = append(r.DataFields, f)
+}
+
+// name returns field of personal name such as 100, or field of corporate name such as 110 for corporate body.
+func name(tag string, c v2.Credit) DataField {
+	f := DataField{Tag: tag, Ind1: "1", Ind2: " ", Subfields: []Subfield{{Code: "a", Value: c.SortName}}}
+	if c.Corporate {
+		f.Tag, f.Ind1 = tag[:1]+"10", "2"
+	}
+	for _, role := range c.Roles {
+		if relator, ok := relators[role]; ok {
+			f.Subfields = append(f.Subfields, Subfield{Code: "e", Value: relator[0]})
+		}
+	}
+	for _, role := range c.Roles {
+		if relator, ok := relators[role]; ok {
+			f.Subfields = append(f.Subfields, Subfield{Code: "4", Value: relator[1]})
+		}
+	}
+	return f
+}
+
+// fixedLength returns 008 field of book whose elements other than date and language are blank.
+func fixedLength(p v2.Product) string {
+	field := []byte(strings.Repeat(" ", 40))
+	copy(field[15:], "xx ")
+	copy(field[35:], "und")
+	field[39] = 'd'
+	if year, ok := yearOf(p); ok {
+		field[6] = 's'
+		copy(field[7:], year)
+	}
+	for _, l := range p.TextLanguages() {
+		if code := l.Code(); len(code) == 3 {
+			copy(field[35:], code)
+		}
+		break
+	}
+	return string(field)
+}
+
+func yearOf(p v2.Product) (string, bool) {
+	if p.PublicationDate == nil {
+		return "", false
+	}
+	date := strings.TrimSpace(p.PublicationDate.Body)
+	if len(date) < 4 {
+		return "", false
+	}
+	return date[:4], true
+}
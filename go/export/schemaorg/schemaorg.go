@@ -0,0 +1,249 @@
+// Package schemaorg converts products of ONIX for Books into Book and Audiobook of schema.org,
+// which are embedded in product pages as JSON-LD.
+package schemaorg
+
+import (
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/texts"
+)
+
+// Context is context of JSON-LD of schema.org.
+const Context = "https://schema.org"
+
+// Book is a Book or an Audiobook of schema.org, which is marshaled into JSON-LD by encoding/json.
+type Book struct {
+	Context       string  `json:"@context,omitempty"`
+	Type          string  `json:"@type"`
+	Name          string  `json:"name,omitempty"`
+	ISBN          string  `json:"isbn,omitempty"`
+	GTIN13        string  `json:"gtin13,omitempty"`
+	BookFormat    string  `json:"bookFormat,omitempty"`
+	Authors       []Agent `json:"author,omitempty"`
+	Editors       []Agent `json:"editor,omitempty"`
+	Illustrators  []Agent `json:"illustrator,omitempty"`
+	Translators   []Agent `json:"translator,omitempty"`
+	ReadBy        []Agent `json:"readBy,omitempty"`
+	Contributors  []Agent `json:"contributor,omitempty"`
+	Publisher     *Agent  `json:"publisher,omitempty"`
+	DatePublished string  `json:"datePublished,omitempty"`
+	NumberOfPages int     `json:"numberOfPages,omitempty"`
+	Description   string  `json:"description,omitempty"`
+	WorkExamples  []Book  `json:"workExample,omitempty"`
+	Offers        []Offer `json:"offers,omitempty"`
+}
+
+// Agent is a Person or an Organization of schema.org.
+type Agent struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// Offer is an Offer of schema.org which tells price of product.
+type Offer struct {
+	Type          string `json:"@type"`
+	Price         string `json:"price"`
+	PriceCurrency string `json:"priceCurrency"`
+	Availability  string `json:"availability,omitempty"`
+	Seller        *Agent `json:"seller,omitempty"`
+}
+
+// bookFormats are BookFormatType of schema.org which codes of List 7 correspond to.
+var bookFormats = map[v2.ProductFormCode]string{
+	v2.ProductFormHardback:  "https://schema.org/Hardcover",
+	v2.ProductFormPaperback: "https://schema.org/Paperback",
+}
+
+// FromProduct converts p into a Book, or an Audiobook when p is an audiobook.
+// Alternative formats among related products become workExample, and retail prices of each supplier become offers.
+func FromProduct(p v2.Product) Book {
+	b := Book{Context: Context, Type: "Book"}
+	if p.IsAudiobook() {
+		b.Type = "Audiobook"
+	}
+	b.Name, _ = p.Title()
+	b.ISBN, _ = p.ISBN13()
+	b.GTIN13, _ = p.GTIN13()
+	if form, ok := p.Form(); ok {
+		b.BookFormat = formatOf(form, p.IsAudiobook())
+	}
+
+	for _, c := range p.Credits() {
+		agent := Agent{Type: "Person", Name: c.Name}
+		if c.Corporate {
+			agent.Type = "Organization"
+		}
+		known := false
+		for _, role := range c.Roles {
+			var to *[]Agent
+			switch role {
+			case v2.ContributorRoleAuthor:
+				to = &b.Authors
+			case v2.ContributorRoleEditor:
+				to = &b.Editors
+			case v2.ContributorRoleIllustrator:
+				to = &b.Illustrators
+			case v2.ContributorRoleTranslator:
+				to = &b.Translators
+			case v2.ContributorRoleReader:
+				if b.Type == "Audiobook" {
+					to = &b.ReadBy
+				}
+			}
+			if to != nil {
+				*to = append(*to, agent)
+				known = true
+			}
+		}
+		if !known {
+			b.Contributors = append(b.Contributors, agent)
+		}
+	}
+
+	if publisher, ok := p.Publisher(); ok {
+		b.Publisher = &Agent{Type: "Organization", Name: publisher}
+	}
+	if p.PublicationDate != nil {
+		b.DatePublished = isoDate(p.PublicationDate.Body)
+	}
+	if pages, ok := p.PageCount(); ok {
+		b.NumberOfPages = int(pages)
+	}
+	if description, ok := p.Description(); ok {
+		b.Description = texts.PlainText(description)
+	}
+
+	for _, r := range p.RelatedProducts {
+		if !r.Relation().IsOtherFormat() {
+			continue
+		}
+		edition := Book{Type: "Book", ISBN: relatedISBN(r)}
+		if edition.ISBN == "" {
+			continue
+		}
+		if r.ProductForm != nil {
+			form := v2.ProductFormCode(r.ProductForm.Code())
+			if form.IsAudio() {
+				edition.Type = "Audiobook"
+			}
+			edition.BookFormat = formatOf(form, form.IsAudio())
+		}
+		b.WorkExamples = append(b.WorkExamples, edition)
+	}
+
+	for _, s := range p.SupplyDetails {
+		b.Offers = append(b.Offers, offersOf(s)...)
+	}
+	return b
+}
+
+func formatOf(form v2.ProductFormCode, audiobook bool) string {
+	if format, ok := bookFormats[form]; ok {
+		return format
+	}
+	switch {
+	case audiobook:
+		return "https://schema.org/AudiobookFormat"
+	case form.IsDigital():
+		return "https://schema.org/EBook"
+	}
+	return ""
+}
+
+func relatedISBN(r v2.RelatedProduct) string {
+	for _, id := range r.ProductIdentifiers {
+		if t := id.ProductIDType.Code(); t == "15" || t == "03" {
+			return id.IDValue.Body
+		}
+	}
+	if r.EAN13 != nil {
+		return r.EAN13.Body
+	}
+	if r.ISBN != nil {
+		return r.ISBN.Body
+	}
+	return ""
+}
+
+// offersOf returns retail price of supplier in each currency.
+func offersOf(s v2.SupplyDetail) []Offer {
+	supplier := v2.Product{SupplyDetails: []v2.SupplyDetail{s}}
+	availability := availabilityOf(s)
+	var offers []Offer
+	var currencies []string
+	for _, price := range s.Prices {
+		if c, ok := price.Currency(); ok && !contains(currencies, string(c)) {
+			currencies = append(currencies, string(c))
+		}
+	}
+	for _, currency := range currencies {
+		price, ok := supplier.RetailPriceIn(currency)
+		if !ok {
+			continue
+		}
+		amount, err := price.Amount()
+		if err != nil {
+			continue
+		}
+		offer := Offer{Type: "Offer", Price: amount.String(), PriceCurrency: currency, Availability: availability}
+		if s.SupplierName != nil {
+			offer.Seller = &Agent{Type: "Organization", Name: s.SupplierName.Body}
+		}
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// availabilityOf returns ItemAvailability of schema.org which ProductAvailability of List 65,
+// or AvailabilityCode of List 54 which it replaces, corresponds to.
+func availabilityOf(s v2.SupplyDetail) string {
+	if s.ProductAvailability != nil {
+		switch code := s.ProductAvailability.Code(); {
+		case strings.HasPrefix(code, "1"):
+			return "https://schema.org/PreOrder"
+		case code == "22" || code == "32":
+			return "https://schema.org/BackOrder"
+		case strings.HasPrefix(code, "2"):
+			return "https://schema.org/InStock"
+		case code == "41" || code == "51":
+			return "https://schema.org/Discontinued"
+		case strings.HasPrefix(code, "3") || strings.HasPrefix(code, "4"):
+			return "https://schema.org/OutOfStock"
+		}
+	}
+	if s.AvailabilityCode != nil {
+		switch s.AvailabilityCode.Code() {
+		case "IP":
+			return "https://schema.org/InStock"
+		case "NP":
+			return "https://schema.org/PreOrder"
+		case "OP":
+			return "https://schema.org/Discontinued"
+		case "TU", "OI", "RP":
+			return "https://schema.org/OutOfStock"
+		}
+	}
+	return ""
+}
+
+// isoDate formats date of YYYYMMDD, YYYYMM or YYYY in ISO 8601.
+func isoDate(date string) string {
+	date = strings.TrimSpace(date)
+	switch len(date) {
+	case 8:
+		return date[:4] + "-" + date[4:6] + "-" + date[6:]
+	case 6:
+		return date[:4] + "-" + date[4:]
+	}
+	return date
+}
+
+func contains(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
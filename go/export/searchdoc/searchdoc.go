@@ -0,0 +1,171 @@
+// Package searchdoc flattens products of ONIX for Books into documents of full-text search engines such as Elasticsearch and Bleve.
+package searchdoc
+
+import (
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/subjects"
+	"github.com/kogai/onix-codegen/go/texts"
+)
+
+// Field is a field of Document, which is named by its key in JSON.
+type Field string
+
+const (
+	// FieldID is RecordReference, which identifies the document.
+	FieldID Field = "id"
+	// FieldISBN is ISBN-13.
+	FieldISBN Field = "isbn"
+	// FieldTitle is distinctive title.
+	FieldTitle Field = "title"
+	// FieldSubtitle is subtitle of distinctive title.
+	FieldSubtitle Field = "subtitle"
+	// FieldContributors are names of contributors.
+	FieldContributors Field = "contributors"
+	// FieldSubjects are headings of subjects, or their codes if they have no heading.
+	FieldSubjects Field = "subjects"
+	// FieldSubjectCodes are codes of subjects such as BISAC code.
+	FieldSubjectCodes Field = "subjectCodes"
+	// FieldDescription is main description in plain text.
+	FieldDescription Field = "description"
+	// FieldPublisher is name of publisher.
+	FieldPublisher Field = "publisher"
+	// FieldPrices are prices of suppliers.
+	FieldPrices Field = "prices"
+)
+
+// Fields are all of fields of Document.
+var Fields = []Field{
+	FieldID, FieldISBN, FieldTitle, FieldSubtitle, FieldContributors, FieldSubjects, FieldSubjectCodes, FieldDescription, FieldPublisher, FieldPrices,
+}
+
+// Document is a flattened product, which encoding/json marshals into a document to index.
+type Document struct {
+	ID           string   `json:"id"`
+	ISBN         string   `json:"isbn,omitempty"`
+	Title        string   `json:"title,omitempty"`
+	Subtitle     string   `json:"subtitle,omitempty"`
+	Contributors []string `json:"contributors,omitempty"`
+	Subjects     []string `json:"subjects,omitempty"`
+	SubjectCodes []string `json:"subjectCodes,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Publisher    string   `json:"publisher,omitempty"`
+	Prices       []Price  `json:"prices,omitempty"`
+}
+
+// Price is a price of supplier.
+type Price struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency,omitempty"`
+	// Type is code of List 58 such as 01 for RRP excluding tax.
+	Type string `json:"type,omitempty"`
+}
+
+// FromProduct flattens p into Document.
+func FromProduct(p v2.Product) Document {
+	d := Document{ID: strings.TrimSpace(p.RecordReference.Body)}
+	d.ISBN, _ = p.ISBN13()
+	d.Title, _ = p.Title()
+	d.Subtitle, _ = p.SubtitleText()
+	for _, c := range p.Credits() {
+		d.Contributors = append(d.Contributors, c.Name)
+	}
+	for _, s := range subjects.FromProduct(p) {
+		if s.Heading != "" {
+			d.Subjects = append(d.Subjects, s.Heading)
+		} else if s.Code != "" {
+			d.Subjects = append(d.Subjects, s.Code)
+		}
+		if s.Code != "" {
+			d.SubjectCodes = append(d.SubjectCodes, s.Code)
+		}
+	}
+	if description, ok := p.Description(); ok {
+		d.Description = texts.PlainText(description)
+	}
+	d.Publisher, _ = p.Publisher()
+	for _, s := range p.SupplyDetails {
+		for _, price := range s.Prices {
+			amount, err := price.Amount()
+			if err != nil {
+				continue
+			}
+			flat := Price{Amount: amount.Float64()}
+			if currency, ok := price.Currency(); ok {
+				flat.Currency = string(currency)
+			}
+			if t, ok := price.Type(); ok {
+				flat.Type = string(t)
+			}
+			d.Prices = append(d.Prices, flat)
+		}
+	}
+	return d
+}
+
+// Map returns fields of d, or only the ones among fields if they are given, where empty fields are omitted.
+// Prices are maps of amount, currency and type, so that the map is indexed as it is.
+func (d Document) Map(fields ...Field) map[string]interface{} {
+	if len(fields) == 0 {
+		fields = Fields
+	}
+	m := map[string]interface{}{}
+	for _, f := range fields {
+		var value interface{}
+		switch f {
+		case FieldID:
+			value = d.ID
+		case FieldISBN:
+			value = d.ISBN
+		case FieldTitle:
+			value = d.Title
+		case FieldSubtitle:
+			value = d.Subtitle
+		case FieldContributors:
+			value = d.Contributors
+		case FieldSubjects:
+			value = d.Subjects
+		case FieldSubjectCodes:
+			value = d.SubjectCodes
+		case FieldDescription:
+			value = d.Description
+		case FieldPublisher:
+			value = d.Publisher
+		case FieldPrices:
+			var prices []map[string]interface{}
+			for _, p := range d.Prices {
+				price := map[string]interface{}{"amount": p.Amount}
+				if p.Currency != "" {
+					price["currency"] = p.Currency
+				}
+				if p.Type != "" {
+					price["type"] = p.Type
+				}
+				prices = append(prices, price)
+			}
+			value = prices
+		}
+		if !empty(value) {
+			m[string(f)] = value
+		}
+	}
+	return m
+}
+
+// Flatten flattens p into map of fields, or of all fields if none is given.
+func Flatten(p v2.Product, fields ...Field) map[string]interface{} {
+	return FromProduct(p).Map(fields...)
+}
+
+func empty(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case []map[string]interface{}:
+		return len(v) == 0
+	}
+	return value == nil
+}
@@ -0,0 +1,123 @@
+package xlsx
+
+import (
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/subjects"
+	"github.com/kogai/onix-codegen/go/texts"
+)
+
+// Column is a column of the sheet, whose cells are Value of each product below Header.
+type Column struct {
+	Header string
+	// Width is width of the column in characters, which is the default width of the sheet if it is zero.
+	Width float64
+	Value func(p v2.Product) Cell
+}
+
+var (
+	// ColumnISBN is ISBN-13 of product.
+	ColumnISBN = Column{Header: "ISBN", Width: 16, Value: func(p v2.Product) Cell {
+		isbn, _ := p.ISBN13()
+		return Text(isbn)
+	}}
+	// ColumnTitle is distinctive title of product.
+	ColumnTitle = Column{Header: "Title", Width: 40, Value: func(p v2.Product) Cell {
+		title, _ := p.Title()
+		return Text(title)
+	}}
+	// ColumnAuthor are names of authors of product joined by semicolons.
+	ColumnAuthor = Column{Header: "Author", Width: 30, Value: func(p v2.Product) Cell {
+		var names []string
+		for _, c := range p.Credits() {
+			if c.Has(v2.ContributorRoleAuthor) {
+				names = append(names, c.Name)
+			}
+		}
+		return Text(strings.Join(names, "; "))
+	}}
+	// ColumnPublisher is name of publisher of product.
+	ColumnPublisher = Column{Header: "Publisher", Width: 24, Value: func(p v2.Product) Cell {
+		publisher, _ := p.Publisher()
+		return Text(publisher)
+	}}
+	// ColumnPublicationDate is publication date of product as a cell of date, or text of date which is not a period such as "Spring 2021".
+	ColumnPublicationDate = Column{Header: "Pub Date", Width: 12, Value: func(p v2.Product) Cell {
+		if d, ok := p.PublishedOn(); ok {
+			return DateCell(d.Time)
+		}
+		if p.PublicationDate != nil {
+			return Text(strings.TrimSpace(p.PublicationDate.Body))
+		}
+		return Cell{}
+	}}
+	// ColumnPrice is the first price of suppliers of product, whose currency is ColumnCurrency.
+	ColumnPrice = Column{Header: "Price", Width: 10, Value: func(p v2.Product) Cell {
+		if price, ok := firstPrice(p); ok {
+			if amount, err := price.Amount(); err == nil {
+				return Money(amount.Float64())
+			}
+		}
+		return Cell{}
+	}}
+	// ColumnCurrency is currency of ColumnPrice.
+	ColumnCurrency = Column{Header: "Currency", Width: 9, Value: func(p v2.Product) Cell {
+		if price, ok := firstPrice(p); ok {
+			if currency, ok := price.Currency(); ok {
+				return Text(string(currency))
+			}
+		}
+		return Cell{}
+	}}
+	// ColumnBISAC are BISAC codes of subjects of product joined by semicolons, where the main subject precedes the others and duplicates are dropped.
+	ColumnBISAC = Column{Header: "BISAC", Width: 24, Value: func(p v2.Product) Cell {
+		var codes []string
+		for _, s := range subjects.Of(subjects.FromProduct(p), subjects.BISAC) {
+			if s.Code != "" && !contains(codes, s.Code) {
+				codes = append(codes, s.Code)
+			}
+		}
+		return Text(strings.Join(codes, "; "))
+	}}
+	// ColumnDescription is main description of product in plain text.
+	ColumnDescription = Column{Header: "Description", Width: 80, Value: func(p v2.Product) Cell {
+		description, _ := p.Description()
+		return Text(texts.PlainText(description))
+	}}
+)
+
+// AdvanceTitleInformation are columns of a sheet of advance title information, which is written unless columns are given.
+var AdvanceTitleInformation = []Column{
+	ColumnISBN, ColumnTitle, ColumnAuthor, ColumnPublicationDate, ColumnPrice, ColumnCurrency, ColumnBISAC, ColumnDescription,
+}
+
+// PriceIn returns a column of retail price of product in currency such as USD.
+func PriceIn(currency string) Column {
+	return Column{Header: "Price (" + currency + ")", Width: 12, Value: func(p v2.Product) Cell {
+		if price, ok := p.RetailPriceIn(currency); ok {
+			if amount, err := price.Amount(); err == nil {
+				return Money(amount.Float64())
+			}
+		}
+		return Cell{}
+	}}
+}
+
+func firstPrice(p v2.Product) (v2.Price, bool) {
+	for _, s := range p.SupplyDetails {
+		for _, price := range s.Prices {
+			return price, true
+		}
+	}
+	return v2.Price{}, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+// Package xlsx writes products of ONIX for Books into a workbook of Office Open XML spreadsheet,
+// such as a sheet of advance title information for sales teams, whose cells of dates and prices are typed.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+// CellKind is the type of value of Cell.
+type CellKind int
+
+const (
+	// KindEmpty is a cell without value.
+	KindEmpty CellKind = iota
+	// KindText is a cell of string.
+	KindText
+	// KindNumber is a cell of number.
+	KindNumber
+	// KindMoney is a cell of number formatted in two decimal places.
+	KindMoney
+	// KindDate is a cell of date, which spreadsheets sort and filter as dates.
+	KindDate
+)
+
+// Cell is a typed value of a cell.
+type Cell struct {
+	Kind   CellKind
+	Text   string
+	Number float64
+	Date   time.Time
+}
+
+// Text returns a cell of s, which is empty if s is empty.
+func Text(s string) Cell {
+	if s == "" {
+		return Cell{}
+	}
+	return Cell{Kind: KindText, Text: s}
+}
+
+// Number returns a cell of n.
+func Number(n float64) Cell {
+	return Cell{Kind: KindNumber, Number: n}
+}
+
+// Money returns a cell of amount, which is formatted in two decimal places.
+func Money(amount float64) Cell {
+	return Cell{Kind: KindMoney, Number: amount}
+}
+
+// DateCell returns a cell of the date of t.
+func DateCell(t time.Time) Cell {
+	return Cell{Kind: KindDate, Date: t}
+}
+
+// maxTextLength is the number of characters which a cell of spreadsheets holds at most.
+const maxTextLength = 32767
+
+// epoch is the day which serial numbers of dates of spreadsheets count from.
+var epoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// Styles of cells, which are indexes of cellXfs of styles.xml.
+const (
+	styleDefault = iota
+	styleHeader
+	styleDate
+	styleMoney
+)
+
+// DefaultSheetName is name of the sheet which Write writes.
+const DefaultSheetName = "Titles"
+
+// Write writes workbook to w, whose sheet has a row of each product in columns below their headers,
+// or in AdvanceTitleInformation if no column is given.
+func Write(w io.Writer, products []v2.Product, columns ...Column) error {
+	return WriteSheet(w, DefaultSheetName, products, columns...)
+}
+
+// WriteMessage writes workbook of products of msg.
+func WriteMessage(w io.Writer, msg *v2.ONIXMessage, columns ...Column) error {
+	return Write(w, msg.Products, columns...)
+}
+
+// WriteSheet writes workbook whose sheet is named name, which is at most 31 characters.
+func WriteSheet(w io.Writer, name string, products []v2.Product, columns ...Column) error {
+	if name == "" || utf8.RuneCountInString(name) > 31 || strings.ContainsAny(name, `\/?*[]:`) {
+		return fmt.Errorf("unexpected name of sheet has been passed, got [%s]", name)
+	}
+	if len(columns) == 0 {
+		columns = AdvanceTitleInformation
+	}
+	z := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRelationships},
+		{"xl/workbook.xml", fmt.Sprintf(workbook, escape(name))},
+		{"xl/_rels/workbook.xml.rels", workbookRelationships},
+		{"xl/styles.xml", styles},
+	}
+	for _, part := range parts {
+		f, err := z.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	f, err := z.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if err := writeSheet(f, products, columns); err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+func writeSheet(w io.Writer, products []v2.Product, columns []Column) error {
+	b := bufio.NewWriter(w)
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	// The row of headers is frozen, so that it stays while scrolling rows.
+	b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	widths := ""
+	for i, c := range columns {
+		if c.Width > 0 {
+			widths += fmt.Sprintf(`<col min="%d" max="%d" width="%s" customWidth="1"/>`, i+1, i+1, strconv.FormatFloat(c.Width, 'f', -1, 64))
+		}
+	}
+	if widths != "" {
+		b.WriteString("<cols>" + widths + "</cols>")
+	}
+	b.WriteString("<sheetData>")
+	headers := make([]Cell, len(columns))
+	for i, c := range columns {
+		headers[i] = Text(c.Header)
+	}
+	writeRow(b, 1, headers, styleHeader)
+	for i, p := range products {
+		cells := make([]Cell, len(columns))
+		for j, c := range columns {
+			cells[j] = c.Value(p)
+		}
+		writeRow(b, i+2, cells, styleDefault)
+	}
+	b.WriteString("</sheetData></worksheet>")
+	return b.Flush()
+}
+
+func writeRow(b *bufio.Writer, row int, cells []Cell, style int) {
+	fmt.Fprintf(b, `<row r="%d">`, row)
+	for i, c := range cells {
+		ref := columnName(i) + strconv.Itoa(row)
+		switch c.Kind {
+		case KindText:
+			text := c.Text
+			if utf8.RuneCountInString(text) > maxTextLength {
+				text = string([]rune(text)[:maxTextLength])
+			}
+			fmt.Fprintf(b, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, styleOf(style), escape(text))
+		case KindNumber:
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleOf(style), strconv.FormatFloat(c.Number, 'f', -1, 64))
+		case KindMoney:
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleOf(styleMoney), strconv.FormatFloat(c.Number, 'f', -1, 64))
+		case KindDate:
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleOf(styleDate), strconv.FormatFloat(serial(c.Date), 'f', -1, 64))
+		}
+	}
+	b.WriteString("</row>")
+}
+
+func styleOf(style int) string {
+	if style == styleDefault {
+		return ""
+	}
+	return fmt.Sprintf(` s="%d"`, style)
+}
+
+// serial returns serial number of date of t, which counts days since the epoch of spreadsheets.
+func serial(t time.Time) float64 {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return float64(day.Sub(epoch) / (24 * time.Hour))
+}
+
+// columnName returns name of column of index i, such as A for 0 and AA for 26.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rootRelationships = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const workbookRelationships = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// styles are the default style, bold headers, dates of yyyy-mm-dd and numbers of two decimal places in order of cellXfs.
+const styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="2" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
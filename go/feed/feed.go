@@ -0,0 +1,138 @@
+// Package feed picks up ONIX for Books files which suppliers deliver to their drops such as FTP and SFTP,
+// and decodes files which have arrived since the last pickup.
+package feed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// File is a file of a drop.
+type File struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// Source is a drop which files are delivered to, such as FTP and SFTP.
+// The other transports plug in by implementing it.
+type Source interface {
+	// List lists files of the drop, where directories are excluded.
+	List(ctx context.Context) ([]File, error)
+	// Open opens the file of the drop which is named name.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// Checkpoint is where the last pickup has ended, which is persisted between pickups such as in JSON.
+type Checkpoint struct {
+	// ModTime is modification time of the latest file which has been picked up.
+	ModTime time.Time `json:"modTime"`
+	// Names are names of files which have been picked up at ModTime,
+	// since files which are modified at the same time are picked up one by one.
+	Names []string `json:"names,omitempty"`
+}
+
+// IsNew reports whether file has not been picked up by the checkpoint.
+func (c Checkpoint) IsNew(file File) bool {
+	if file.ModTime.After(c.ModTime) {
+		return true
+	}
+	if file.ModTime.Before(c.ModTime) {
+		return false
+	}
+	for _, name := range c.Names {
+		if name == file.Name {
+			return false
+		}
+	}
+	return true
+}
+
+// advance returns the checkpoint which file has been picked up after.
+func (c Checkpoint) advance(file File) Checkpoint {
+	if file.ModTime.Equal(c.ModTime) {
+		return Checkpoint{ModTime: c.ModTime, Names: append(append([]string{}, c.Names...), file.Name)}
+	}
+	return Checkpoint{ModTime: file.ModTime, Names: []string{file.Name}}
+}
+
+// NewFiles lists files of src which have not been picked up by checkpoint, in order of modification time and name.
+func NewFiles(ctx context.Context, src Source, checkpoint Checkpoint) ([]File, error) {
+	files, err := src.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var news []File
+	for _, file := range files {
+		if checkpoint.IsNew(file) {
+			news = append(news, file)
+		}
+	}
+	sort.Slice(news, func(i, j int) bool {
+		if !news[i].ModTime.Equal(news[j].ModTime) {
+			return news[i].ModTime.Before(news[j].ModTime)
+		}
+		return news[i].Name < news[j].Name
+	})
+	return news, nil
+}
+
+// Handler handles a message of file which has been picked up.
+type Handler func(file File, msg *onix.Message) error
+
+// Fetch downloads files of src which have not been picked up by checkpoint, and decodes them by opts,
+// where gzip and zip are unwrapped as onix.DecodeArchive does.
+// Messages are passed to handle in order of modification time of files, and the checkpoint advances once handle has accepted
+// every message of a file, so that the returned checkpoint resumes the next pickup even if an error stops it.
+func Fetch(ctx context.Context, src Source, checkpoint Checkpoint, handle Handler, opts ...onix.DecodeOption) (Checkpoint, error) {
+	files, err := NewFiles(ctx, src, checkpoint)
+	if err != nil {
+		return checkpoint, err
+	}
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return checkpoint, err
+		}
+		messages, err := download(ctx, src, file, opts)
+		if err != nil {
+			return checkpoint, fmt.Errorf("%s: %w", file.Name, err)
+		}
+		for _, msg := range messages {
+			if err := handle(file, msg); err != nil {
+				return checkpoint, err
+			}
+		}
+		checkpoint = checkpoint.advance(file)
+	}
+	return checkpoint, nil
+}
+
+// download downloads file into a temporary file, since entries of zip are read at their offsets.
+func download(ctx context.Context, src Source, file File, opts []onix.DecodeOption) ([]*onix.Message, error) {
+	r, err := src.Open(ctx, file.Name)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempFile("", "onix-feed-")
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	size, err := io.Copy(tmp, r)
+	if closeErr := r.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return onix.DecodeArchive(tmp, size, opts...)
+}
@@ -0,0 +1,293 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FTP is a session of FTP which lists and downloads files of a directory of the drop.
+// Files are transferred in binary and passive mode, and a session transfers one file at a time.
+type FTP struct {
+	conn *textproto.Conn
+	raw  net.Conn
+	host string
+	dir  string
+}
+
+// DialFTP logs into the FTP server at addr such as ftp.example.com:21 as user, and lists files of dir
+// which is relative to the home directory of user unless it is absolute.
+func DialFTP(ctx context.Context, addr, user, password, dir string) (*FTP, error) {
+	var dialer net.Dialer
+	raw, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		raw.Close()
+		return nil, err
+	}
+	c := &FTP{conn: textproto.NewConn(raw), raw: raw, host: host, dir: dir}
+	if err := c.login(ctx, user, password); err != nil {
+		c.conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *FTP) login(ctx context.Context, user, password string) error {
+	c.deadline(ctx)
+	if _, _, err := c.conn.ReadResponse(220); err != nil {
+		return err
+	}
+	code, _, err := c.cmd(0, "USER %s", user)
+	if err != nil {
+		return err
+	}
+	if code == 331 {
+		if _, _, err := c.cmd(230, "PASS %s", password); err != nil {
+			return err
+		}
+	} else if code != 230 {
+		return fmt.Errorf("login as %s has been refused, got [%d]", user, code)
+	}
+	_, _, err = c.cmd(200, "TYPE I")
+	return err
+}
+
+// List lists files of the directory, by MLSD if the server supports it, or by NLST, SIZE and MDTM otherwise.
+func (c *FTP) List(ctx context.Context) ([]File, error) {
+	c.deadline(ctx)
+	lines, err := c.transferLines("%s", c.listing("MLSD"))
+	if err == nil {
+		return parseMLSD(lines), nil
+	}
+	if e, ok := err.(*textproto.Error); !ok || (e.Code != 500 && e.Code != 501 && e.Code != 502) {
+		return nil, err
+	}
+	names, err := c.transferLines("%s", c.listing("NLST"))
+	if err != nil {
+		return nil, err
+	}
+	var files []File
+	for _, name := range names {
+		name = path.Base(name)
+		code, msg, err := c.cmd(0, "MDTM %s", c.path(name))
+		if err != nil {
+			return nil, err
+		}
+		if code != 213 {
+			// Directories have no modification time of files.
+			continue
+		}
+		file := File{Name: name, ModTime: parseFTPTime(msg)}
+		if code, msg, err := c.cmd(0, "SIZE %s", c.path(name)); err != nil {
+			return nil, err
+		} else if code == 213 {
+			file.Size, _ = strconv.ParseInt(strings.TrimSpace(msg), 10, 64)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// Open downloads the file of the directory which is named name, which has to be closed before the next command of the session.
+func (c *FTP) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	c.deadline(ctx)
+	data, err := c.transfer("RETR %s", c.path(name))
+	if err != nil {
+		return nil, err
+	}
+	return &ftpReader{c: c, data: data}, nil
+}
+
+// Close logs out of the server.
+func (c *FTP) Close() error {
+	c.cmd(221, "QUIT")
+	return c.conn.Close()
+}
+
+func (c *FTP) path(name string) string {
+	if c.dir == "" {
+		return name
+	}
+	return path.Join(c.dir, name)
+}
+
+// listing returns command which lists the directory, where the working directory is listed without argument.
+func (c *FTP) listing(command string) string {
+	if c.dir == "" {
+		return command
+	}
+	return command + " " + c.dir
+}
+
+// deadline limits commands of the session to the deadline of ctx, if any.
+func (c *FTP) deadline(ctx context.Context) {
+	deadline, _ := ctx.Deadline()
+	c.raw.SetDeadline(deadline)
+}
+
+// cmd sends a command and reads its reply, which has to be of code expectCode unless it is 0.
+func (c *FTP) cmd(expectCode int, format string, args ...interface{}) (int, string, error) {
+	if _, err := c.conn.Cmd(format, args...); err != nil {
+		return 0, "", err
+	}
+	return c.conn.ReadResponse(expectCode)
+}
+
+// transfer opens a connection of data in passive mode, and sends a command which transfers over it.
+func (c *FTP) transfer(format string, args ...interface{}) (net.Conn, error) {
+	addr, err := c.passive()
+	if err != nil {
+		return nil, err
+	}
+	data, err := net.DialTimeout("tcp", addr, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	code, msg, err := c.cmd(0, format, args...)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	if code != 125 && code != 150 {
+		data.Close()
+		return nil, &textproto.Error{Code: code, Msg: msg}
+	}
+	return data, nil
+}
+
+// transferLines reads lines which a command transfers, such as listings.
+func (c *FTP) transferLines(format string, args ...interface{}) ([]string, error) {
+	data, err := c.transfer(format, args...)
+	if err != nil {
+		return nil, err
+	}
+	r := &ftpReader{c: c, data: data}
+	body, err := ioutil.ReadAll(r)
+	if closeErr := r.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, line := range strings.Split(string(body), "\n") {
+		if line = strings.TrimRight(line, "\r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// passive returns address of the connection of data, by EPSV or by PASV for servers which do not support it.
+func (c *FTP) passive() (string, error) {
+	code, msg, err := c.cmd(0, "EPSV")
+	if err != nil {
+		return "", err
+	}
+	if code == 229 {
+		// 229 Entering Extended Passive Mode (|||port|)
+		start, end := strings.Index(msg, "(|||"), strings.LastIndex(msg, "|)")
+		if start < 0 || end < start+4 {
+			return "", fmt.Errorf("malformed reply of EPSV, got [%s]", msg)
+		}
+		return net.JoinHostPort(c.host, msg[start+4:end]), nil
+	}
+	_, msg, err = c.cmd(227, "PASV")
+	if err != nil {
+		return "", err
+	}
+	// 227 Entering Passive Mode (h1,h2,h3,h4,p1,p2), where the host is ignored in favor of the one of the session like EPSV.
+	start, end := strings.Index(msg, "("), strings.LastIndex(msg, ")")
+	if start < 0 || end < start {
+		return "", fmt.Errorf("malformed reply of PASV, got [%s]", msg)
+	}
+	fields := strings.Split(msg[start+1:end], ",")
+	if len(fields) != 6 {
+		return "", fmt.Errorf("malformed reply of PASV, got [%s]", msg)
+	}
+	high, err := strconv.Atoi(strings.TrimSpace(fields[4]))
+	if err != nil {
+		return "", err
+	}
+	low, err := strconv.Atoi(strings.TrimSpace(fields[5]))
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(c.host, strconv.Itoa(high<<8|low)), nil
+}
+
+// ftpReader reads a connection of data, and reads the reply of completion of the transfer on Close.
+type ftpReader struct {
+	c    *FTP
+	data net.Conn
+}
+
+func (r *ftpReader) Read(p []byte) (int, error) {
+	return r.data.Read(p)
+}
+
+func (r *ftpReader) Close() error {
+	if err := r.data.Close(); err != nil {
+		return err
+	}
+	code, msg, err := r.c.conn.ReadResponse(0)
+	if err != nil {
+		return err
+	}
+	if code != 226 && code != 250 {
+		return &textproto.Error{Code: code, Msg: msg}
+	}
+	return nil
+}
+
+// parseMLSD parses facts of files of MLSD such as "type=file;size=1024;modify=20201212093000; name.xml", where directories are excluded.
+func parseMLSD(lines []string) []File {
+	var files []File
+	for _, line := range lines {
+		i := strings.Index(line, " ")
+		if i < 0 {
+			continue
+		}
+		file := File{Name: line[i+1:]}
+		isFile := false
+		for _, fact := range strings.Split(line[:i], ";") {
+			kv := strings.SplitN(fact, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			switch strings.ToLower(kv[0]) {
+			case "type":
+				isFile = strings.ToLower(kv[1]) == "file"
+			case "size":
+				file.Size, _ = strconv.ParseInt(kv[1], 10, 64)
+			case "modify":
+				file.ModTime = parseFTPTime(kv[1])
+			}
+		}
+		if isFile {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// parseFTPTime parses time of MDTM and MLSD such as 20201212093000 or 20201212093000.123 in UTC.
+func parseFTPTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	if i := strings.Index(value, "."); i >= 0 {
+		value = value[:i]
+	}
+	t, _ := time.Parse("20060102150405", value)
+	return t
+}
@@ -0,0 +1,527 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+)
+
+// Types of packets of version 3 of SFTP.
+const (
+	sftpInit    = 1
+	sftpVersion = 2
+	sftpOpen    = 3
+	sftpClose   = 4
+	sftpRead    = 5
+	sftpOpendir = 11
+	sftpReaddir = 12
+	sftpStatus  = 101
+	sftpHandle  = 102
+	sftpData    = 103
+	sftpName    = 104
+)
+
+// sftpReadMode is flag of opening files for read.
+const sftpReadMode = 1
+
+// Flags of attributes of files of SFTP.
+const (
+	sftpAttrSize        = 0x1
+	sftpAttrUIDGID      = 0x2
+	sftpAttrPermissions = 0x4
+	sftpAttrACModTime   = 0x8
+	sftpAttrExtended    = 0x80000000
+)
+
+// Codes of status of SFTP.
+const (
+	sftpOK  = 0
+	sftpEOF = 1
+)
+
+// sftpChunk is bytes which a request of read asks for, which servers such as OpenSSH serve at once.
+const sftpChunk = 32 * 1024
+
+// SFTPError is a status of failure which the server of SFTP has replied, such as 2 of no such file.
+type SFTPError struct {
+	Code    uint32
+	Message string
+}
+
+func (e *SFTPError) Error() string {
+	return fmt.Sprintf("sftp: %s, got [%d]", e.Message, e.Code)
+}
+
+// SFTP is a session of version 3 of SFTP which lists and downloads files of a directory of the drop.
+// It speaks over the sftp subsystem of a connection of SSH, and a session transfers one file at a time.
+type SFTP struct {
+	r   *bufio.Reader
+	w   io.Writer
+	c   io.Closer
+	cmd *exec.Cmd
+	// deadlines are ends of the connection which support deadlines.
+	deadlines []deadliner
+	// stderr is what ssh has written, which tells why the session has failed.
+	stderr bytes.Buffer
+	dir    string
+	id     uint32
+}
+
+// DialSFTP runs ssh on destination such as feeds@sftp.example.com with the sftp subsystem, and lists files of dir
+// which is relative to the home directory of the user unless it is absolute.
+// Authentication and host keys are the ones of ssh such as keys of the agent and known_hosts, which args such as -i or -p configure,
+// and prompts of passwords are disabled since there is no terminal.
+func DialSFTP(ctx context.Context, destination, dir string, args ...string) (*SFTP, error) {
+	// Pipes of os are used rather than the ones of exec, which have no deadline.
+	stdin, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	r, stdout, err := os.Pipe()
+	if err != nil {
+		stdin.Close()
+		w.Close()
+		return nil, err
+	}
+	args = append(append([]string{}, args...), "-o", "BatchMode=yes", "-s", destination, "sftp")
+	c := &SFTP{r: bufio.NewReader(r), w: w, c: multiCloser{w, r}, deadlines: []deadliner{w, r}, dir: dir}
+	c.cmd = exec.Command("ssh", args...)
+	c.cmd.Stdin, c.cmd.Stdout, c.cmd.Stderr = stdin, stdout, &c.stderr
+	err = c.cmd.Start()
+	stdin.Close()
+	stdout.Close()
+	if err != nil {
+		w.Close()
+		r.Close()
+		return nil, err
+	}
+	if err := c.init(ctx); err != nil {
+		c.Close()
+		if stderr := strings.TrimSpace(c.stderr.String()); stderr != "" {
+			return nil, fmt.Errorf("%w: %s", err, stderr)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewSFTP starts a session over conn, which is the sftp subsystem of a connection of SSH such as a session of golang.org/x/crypto/ssh,
+// and lists files of dir as DialSFTP does. Deadlines of ctx apply if conn has SetDeadline.
+func NewSFTP(ctx context.Context, conn io.ReadWriteCloser, dir string) (*SFTP, error) {
+	c := &SFTP{r: bufio.NewReader(conn), w: conn, c: conn, dir: dir}
+	if d, ok := conn.(deadliner); ok {
+		c.deadlines = append(c.deadlines, d)
+	}
+	if err := c.init(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *SFTP) init(ctx context.Context) error {
+	c.deadline(ctx)
+	var b sftpBuffer
+	b.uint32(3)
+	if err := c.send(sftpInit, b.bytes()); err != nil {
+		return err
+	}
+	typ, payload, err := c.receive()
+	if err != nil {
+		return err
+	}
+	if typ != sftpVersion {
+		return fmt.Errorf("sftp: unexpected packet of type %d in reply to init", typ)
+	}
+	if version, _ := (&sftpReader{b: payload}).uint32(); version < 3 {
+		return fmt.Errorf("sftp: version 3 is required, got [%d]", version)
+	}
+	return nil
+}
+
+// List lists regular files of the directory, where directories and links are excluded.
+func (c *SFTP) List(ctx context.Context) ([]File, error) {
+	c.deadline(ctx)
+	dir := c.dir
+	if dir == "" {
+		dir = "."
+	}
+	handle, err := c.open(sftpOpendir, func(b *sftpBuffer) { b.string(dir) })
+	if err != nil {
+		return nil, err
+	}
+	var files []File
+	for {
+		var b sftpBuffer
+		b.string(handle)
+		typ, r, err := c.request(sftpReaddir, &b)
+		if err != nil {
+			c.close(handle)
+			return nil, err
+		}
+		if typ == sftpStatus {
+			if err := statusOf(r); err != io.EOF {
+				c.close(handle)
+				return nil, err
+			}
+			break
+		}
+		if typ != sftpName {
+			c.close(handle)
+			return nil, fmt.Errorf("sftp: unexpected packet of type %d in reply to readdir", typ)
+		}
+		count, err := r.uint32()
+		if err != nil {
+			c.close(handle)
+			return nil, err
+		}
+		for i := uint32(0); i < count; i++ {
+			name, err := r.string()
+			if err != nil {
+				c.close(handle)
+				return nil, err
+			}
+			// The long name is what ls -l shows, which is not parsed.
+			if _, err := r.string(); err != nil {
+				c.close(handle)
+				return nil, err
+			}
+			file, regular, err := r.attrs(name)
+			if err != nil {
+				c.close(handle)
+				return nil, err
+			}
+			if regular {
+				files = append(files, file)
+			}
+		}
+	}
+	return files, c.close(handle)
+}
+
+// Open downloads the file of the directory which is named name, which has to be closed before the next request of the session.
+func (c *SFTP) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	c.deadline(ctx)
+	handle, err := c.open(sftpOpen, func(b *sftpBuffer) {
+		b.string(c.path(name))
+		b.uint32(sftpReadMode)
+		// Attributes of files which are created are not set.
+		b.uint32(0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sftpFile{c: c, handle: handle}, nil
+}
+
+// Close ends the session, and waits for ssh to exit if the session has run it.
+func (c *SFTP) Close() error {
+	err := c.c.Close()
+	if c.cmd != nil {
+		// ssh exits with an error once stdin is closed, which is not reported.
+		c.cmd.Wait()
+	}
+	return err
+}
+
+func (c *SFTP) path(name string) string {
+	if c.dir == "" {
+		return name
+	}
+	return path.Join(c.dir, name)
+}
+
+// deadline limits requests of the session to the deadline of ctx, if any, as far as the connection supports deadlines.
+func (c *SFTP) deadline(ctx context.Context) {
+	deadline, _ := ctx.Deadline()
+	for _, d := range c.deadlines {
+		d.SetDeadline(deadline)
+	}
+}
+
+type deadliner interface {
+	SetDeadline(t time.Time) error
+}
+
+// open sends a request of typ which opens a file or a directory, and returns its handle.
+func (c *SFTP) open(typ byte, args func(b *sftpBuffer)) (string, error) {
+	var b sftpBuffer
+	args(&b)
+	reply, r, err := c.request(typ, &b)
+	if err != nil {
+		return "", err
+	}
+	switch reply {
+	case sftpHandle:
+		return r.string()
+	case sftpStatus:
+		if err := statusOf(r); err != nil {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("sftp: unexpected packet of type %d in reply to open", reply)
+}
+
+func (c *SFTP) close(handle string) error {
+	var b sftpBuffer
+	b.string(handle)
+	typ, r, err := c.request(sftpClose, &b)
+	if err != nil {
+		return err
+	}
+	if typ != sftpStatus {
+		return fmt.Errorf("sftp: unexpected packet of type %d in reply to close", typ)
+	}
+	return statusOf(r)
+}
+
+// request sends a request of typ with a new id, and receives its reply whose id has been consumed,
+// since requests are not pipelined.
+func (c *SFTP) request(typ byte, args *sftpBuffer) (byte, *sftpReader, error) {
+	c.id++
+	var b sftpBuffer
+	b.uint32(c.id)
+	b.buf.Write(args.bytes())
+	if err := c.send(typ, b.bytes()); err != nil {
+		return 0, nil, err
+	}
+	reply, payload, err := c.receive()
+	if err != nil {
+		return 0, nil, err
+	}
+	r := &sftpReader{b: payload}
+	if id, err := r.uint32(); err != nil {
+		return 0, nil, err
+	} else if id != c.id {
+		return 0, nil, fmt.Errorf("sftp: reply to request %d has been received, got [%d]", c.id, id)
+	}
+	return reply, r, nil
+}
+
+func (c *SFTP) send(typ byte, payload []byte) error {
+	packet := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(packet, uint32(1+len(payload)))
+	packet[4] = typ
+	_, err := c.w.Write(append(packet, payload...))
+	return err
+}
+
+// receive reads a packet, whose length is limited to reject replies which are not of SFTP such as banners of shells.
+func (c *SFTP) receive() (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(header[:4])
+	if length < 1 || length > 256*1024 {
+		return 0, nil, fmt.Errorf("sftp: malformed packet of %d bytes", length)
+	}
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[4], payload, nil
+}
+
+// sftpFile reads a file which has been opened by requests of read, and closes its handle on Close.
+type sftpFile struct {
+	c      *SFTP
+	handle string
+	offset uint64
+	// buf is what the last reply of read has left.
+	buf []byte
+	eof bool
+}
+
+func (f *sftpFile) Read(p []byte) (int, error) {
+	if len(f.buf) == 0 {
+		if f.eof {
+			return 0, io.EOF
+		}
+		var b sftpBuffer
+		b.string(f.handle)
+		b.uint64(f.offset)
+		b.uint32(sftpChunk)
+		typ, r, err := f.c.request(sftpRead, &b)
+		if err != nil {
+			return 0, err
+		}
+		switch typ {
+		case sftpData:
+			data, err := r.string()
+			if err != nil {
+				return 0, err
+			}
+			f.buf = []byte(data)
+			f.offset += uint64(len(data))
+		case sftpStatus:
+			if err := statusOf(r); err != io.EOF {
+				return 0, err
+			}
+			f.eof = true
+			return 0, io.EOF
+		default:
+			return 0, fmt.Errorf("sftp: unexpected packet of type %d in reply to read", typ)
+		}
+	}
+	n := copy(p, f.buf)
+	f.buf = f.buf[n:]
+	return n, nil
+}
+
+func (f *sftpFile) Close() error {
+	return f.c.close(f.handle)
+}
+
+// statusOf returns nil for status of OK, io.EOF for the one of end of file, and *SFTPError otherwise.
+func statusOf(r *sftpReader) error {
+	code, err := r.uint32()
+	if err != nil {
+		return err
+	}
+	switch code {
+	case sftpOK:
+		return nil
+	case sftpEOF:
+		return io.EOF
+	}
+	// Message is missing in replies of servers of the older versions.
+	message, _ := r.string()
+	return &SFTPError{Code: code, Message: message}
+}
+
+// sftpBuffer writes fields of a packet.
+type sftpBuffer struct {
+	buf bytes.Buffer
+}
+
+func (b *sftpBuffer) uint32(v uint32) {
+	var n [4]byte
+	binary.BigEndian.PutUint32(n[:], v)
+	b.buf.Write(n[:])
+}
+
+func (b *sftpBuffer) uint64(v uint64) {
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], v)
+	b.buf.Write(n[:])
+}
+
+func (b *sftpBuffer) string(s string) {
+	b.uint32(uint32(len(s)))
+	b.buf.WriteString(s)
+}
+
+func (b *sftpBuffer) bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// sftpReader reads fields of a packet.
+type sftpReader struct {
+	b []byte
+}
+
+func (r *sftpReader) uint32() (uint32, error) {
+	if len(r.b) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	v := binary.BigEndian.Uint32(r.b)
+	r.b = r.b[4:]
+	return v, nil
+}
+
+func (r *sftpReader) uint64() (uint64, error) {
+	if len(r.b) < 8 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	v := binary.BigEndian.Uint64(r.b)
+	r.b = r.b[8:]
+	return v, nil
+}
+
+func (r *sftpReader) string() (string, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return "", err
+	}
+	if uint32(len(r.b)) < n {
+		return "", io.ErrUnexpectedEOF
+	}
+	s := string(r.b[:n])
+	r.b = r.b[n:]
+	return s, nil
+}
+
+// attrs reads attributes of file named name, and reports whether it is a regular file by its permissions.
+// Files without permissions are regarded as regular unless they are . or ..
+func (r *sftpReader) attrs(name string) (File, bool, error) {
+	file := File{Name: name}
+	regular := name != "." && name != ".."
+	flags, err := r.uint32()
+	if err != nil {
+		return file, false, err
+	}
+	if flags&sftpAttrSize != 0 {
+		size, err := r.uint64()
+		if err != nil {
+			return file, false, err
+		}
+		file.Size = int64(size)
+	}
+	if flags&sftpAttrUIDGID != 0 {
+		if _, err := r.uint64(); err != nil {
+			return file, false, err
+		}
+	}
+	if flags&sftpAttrPermissions != 0 {
+		permissions, err := r.uint32()
+		if err != nil {
+			return file, false, err
+		}
+		// S_IFMT and S_IFREG of the type of file.
+		regular = regular && permissions&0170000 == 0100000
+	}
+	if flags&sftpAttrACModTime != 0 {
+		if _, err := r.uint32(); err != nil {
+			return file, false, err
+		}
+		mtime, err := r.uint32()
+		if err != nil {
+			return file, false, err
+		}
+		file.ModTime = time.Unix(int64(mtime), 0).UTC()
+	}
+	if flags&sftpAttrExtended != 0 {
+		count, err := r.uint32()
+		if err != nil {
+			return file, false, err
+		}
+		for i := uint32(0); i < count*2; i++ {
+			if _, err := r.string(); err != nil {
+				return file, false, err
+			}
+		}
+	}
+	return file, regular, nil
+}
+
+// multiCloser closes both ends of pipes of ssh.
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var err error
+	for _, c := range m {
+		if closeErr := c.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
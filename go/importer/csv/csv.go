@@ -0,0 +1,312 @@
+// Package csv reads rows of CSV spreadsheets into products of ONIX for Books 3.0, whose columns are mapped onto dotted paths of elements
+// such as ProductSupply.SupplyDetail.Price[1].PriceAmount, so that small publishers produce feeds from their spreadsheets.
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Mapping maps headers of columns onto paths of elements relative to product, which encoding/json unmarshals from an object.
+// A step of path is either reference name of element or name of field of the model, and the index of [n] picks n-th of repeated elements,
+// which is the first one for steps without index. Leading Product is optional.
+type Mapping map[string]string
+
+// compiled is a column which is set into its path of product.
+type compiled struct {
+	header string
+	// index is the column of rows, which is -1 for default values.
+	index int
+	path  string
+	steps []step
+	value string
+}
+
+type step struct {
+	field int
+	index int
+}
+
+// RowError is an error of a cell of a row, which is numbered from 1 at the header.
+type RowError struct {
+	Line   int
+	Column string
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d: column [%s]: %s", e.Line, e.Column, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Option configures how Reader reads rows.
+type Option func(*Reader)
+
+// WithComma reads rows whose cells are delimited by comma, such as '\t' for TSV, which is ',' by default.
+func WithComma(comma rune) Option {
+	return func(r *Reader) {
+		r.r.Comma = comma
+	}
+}
+
+// WithDefault sets value into path of each product whose row leaves it empty, such as ProductSupply.SupplyDetail.Supplier.SupplierRole of 01.
+func WithDefault(path, value string) Option {
+	return func(r *Reader) {
+		r.defaults = append(r.defaults, [2]string{path, value})
+	}
+}
+
+// Reader reads products of rows of spreadsheet following the row of headers.
+type Reader struct {
+	r        *stdcsv.Reader
+	mapping  Mapping
+	defaults [][2]string
+	columns  []compiled
+	line     int
+}
+
+// NewReader returns Reader which reads header of r, where columns which mapping does not have are ignored.
+// It fails if mapping has columns which the header lacks, or paths which the model does not have.
+func NewReader(r io.Reader, mapping Mapping, opts ...Option) (*Reader, error) {
+	reader := &Reader{r: stdcsv.NewReader(r), mapping: mapping}
+	reader.r.FieldsPerRecord = -1
+	for _, opt := range opts {
+		opt(reader)
+	}
+	header, err := reader.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	reader.line = 1
+	indexes := map[string]int{}
+	for i, h := range header {
+		indexes[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	headers := make([]string, 0, len(mapping))
+	for h := range mapping {
+		headers = append(headers, h)
+	}
+	sort.Strings(headers)
+	for _, h := range headers {
+		i, ok := indexes[h]
+		if !ok {
+			return nil, fmt.Errorf("unexpected column of mapping has been passed, got [%s]", h)
+		}
+		column, err := compile(mapping[h])
+		if err != nil {
+			return nil, err
+		}
+		column.header, column.index = h, i
+		reader.columns = append(reader.columns, column)
+	}
+	for _, d := range reader.defaults {
+		column, err := compile(d[0])
+		if err != nil {
+			return nil, err
+		}
+		column.index, column.value = -1, d[1]
+		reader.columns = append(reader.columns, column)
+	}
+	return reader, nil
+}
+
+// Read returns product of the next row, which has been validated against the schema except elements which the model of 3.0 lacks.
+// Errors of cells and violations are RowError, and it returns io.EOF after the last row.
+func (r *Reader) Read() (v3.Product, error) {
+	for {
+		row, err := r.r.Read()
+		if err != nil {
+			return v3.Product{}, err
+		}
+		r.line++
+		if isBlank(row) {
+			continue
+		}
+		var p v3.Product
+		set := map[string]bool{}
+		for _, column := range r.columns {
+			value := column.value
+			if column.index >= 0 {
+				if column.index >= len(row) {
+					continue
+				}
+				value = row[column.index]
+			}
+			value = strings.TrimSpace(value)
+			if value == "" || set[column.path] {
+				continue
+			}
+			if err := column.set(reflect.ValueOf(&p).Elem(), value); err != nil {
+				return v3.Product{}, &RowError{Line: r.line, Column: column.header, Err: err}
+			}
+			set[column.path] = true
+		}
+		if errs := validate(p); len(errs) > 0 {
+			return v3.Product{}, &RowError{Line: r.line, Err: errs}
+		}
+		return p, nil
+	}
+}
+
+// ReadAll returns products of all rows of r.
+func ReadAll(r io.Reader, mapping Mapping, opts ...Option) ([]v3.Product, error) {
+	reader, err := NewReader(r, mapping, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var products []v3.Product
+	for {
+		p, err := reader.Read()
+		if err == io.EOF {
+			return products, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+}
+
+// ReadMessage returns message of header whose products are rows of r.
+func ReadMessage(r io.Reader, header v3.Header, mapping Mapping, opts ...Option) (*v3.ONIXMessage, error) {
+	products, err := ReadAll(r, mapping, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &v3.ONIXMessage{Header: header, Products: products}, nil
+}
+
+// validate returns violations of p, whose paths are relative to the product.
+func validate(p v3.Product) v3.ValidationErrors {
+	const prefix = "/ONIXMessage/Product[1]"
+	var errs v3.ValidationErrors
+	for _, err := range v3.Validate(&v3.ONIXMessage{Products: []v3.Product{p}}) {
+		if strings.HasPrefix(err.Path, prefix) {
+			err.Path = "/Product" + strings.TrimPrefix(err.Path, prefix)
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func isBlank(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// compile resolves steps of path against the model of product.
+func compile(path string) (compiled, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(path), "Product.")
+	if trimmed == "" {
+		return compiled{}, fmt.Errorf("empty path has been passed")
+	}
+	column := compiled{path: path}
+	t := reflect.TypeOf(v3.Product{})
+	for _, s := range strings.Split(trimmed, ".") {
+		name, index := s, 0
+		if i := strings.IndexByte(s, '['); i >= 0 {
+			n, err := strconv.Atoi(strings.TrimSuffix(s[i+1:], "]"))
+			if !strings.HasSuffix(s, "]") || err != nil || n < 0 {
+				return compiled{}, fmt.Errorf("malformed index of path [%s] has been passed, got [%s]", path, s)
+			}
+			name, index = s[:i], n
+		}
+		f, ok := fieldsOf(t)[name]
+		if !ok {
+			return compiled{}, fmt.Errorf("unexpected element of path [%s] has been passed, got [%s]", path, name)
+		}
+		column.steps = append(column.steps, step{field: f, index: index})
+		t = elemType(t.Field(f).Type)
+	}
+	return column, nil
+}
+
+// set sets value into the element at the column of v, which allocates composites up to the element.
+func (c compiled) set(v reflect.Value, value string) error {
+	for _, st := range c.steps {
+		field := v.Field(st.field)
+		switch field.Kind() {
+		case reflect.Ptr:
+			if field.IsNil() {
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			field = field.Elem()
+		case reflect.Slice:
+			if field.Len() <= st.index {
+				grown := reflect.MakeSlice(field.Type(), st.index+1, st.index+1)
+				reflect.Copy(grown, field)
+				field.Set(grown)
+			}
+			field = field.Index(st.index)
+		}
+		v = field
+	}
+	if v.Kind() == reflect.Struct && v.NumField() > 0 && !hasText(v.Type()) {
+		return fmt.Errorf("unexpected composite of path [%s] has been passed, which has no value", c.path)
+	}
+	// Values are unmarshaled as content of the element, so that codes are checked against codelists as they are decoded from message.
+	var element strings.Builder
+	element.WriteString("<v>")
+	xml.EscapeText(&element, []byte(value))
+	element.WriteString("</v>")
+	return xml.Unmarshal([]byte(element.String()), v.Addr().Interface())
+}
+
+var unmarshalerType = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+
+// hasText reports whether element of t has text, such as codes and PlainText.
+func hasText(t reflect.Type) bool {
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Contains(t.Field(i).Tag.Get("xml"), ",chardata") {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldsOf(t reflect.Type) map[string]int {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if ref, ok := v3.ReferenceTags[name]; ok {
+			name = ref
+		}
+		fields[name] = i
+		fields[t.Field(i).Name] = i
+	}
+	return fields
+}
+
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
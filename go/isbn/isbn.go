@@ -0,0 +1,123 @@
+// Package isbn validates and canonicalizes ISBNs which are held by ProductIdentifier composites.
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrLength is returned when ISBN has unexpected number of digits.
+	ErrLength = errors.New("isbn: unexpected length")
+	// ErrCharacter is returned when ISBN has a character which is neither digit nor check digit X.
+	ErrCharacter = errors.New("isbn: unexpected character")
+	// ErrChecksum is returned when check digit of ISBN does not match.
+	ErrChecksum = errors.New("isbn: check digit mismatch")
+	// ErrPrefix is returned when ISBN-13 does not start with 978 or 979.
+	ErrPrefix = errors.New("isbn: unexpected prefix")
+)
+
+// Normalize strips hyphens and spaces from isbn, and capitalizes check digit X.
+func Normalize(isbn string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ':
+			return -1
+		case 'x':
+			return 'X'
+		}
+		return r
+	}, isbn)
+}
+
+// Validate10 reports whether isbn is a valid ISBN-10, ignoring hyphens and spaces.
+func Validate10(isbn string) error {
+	isbn = Normalize(isbn)
+	if len(isbn) != 10 {
+		return ErrLength
+	}
+	for i := 0; i < 9; i++ {
+		if !isDigit(isbn[i]) {
+			return ErrCharacter
+		}
+	}
+	if !isDigit(isbn[9]) && isbn[9] != 'X' {
+		return ErrCharacter
+	}
+	if checkDigit10(isbn[:9]) != isbn[9] {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// Validate13 reports whether isbn is a valid ISBN-13, ignoring hyphens and spaces.
+func Validate13(isbn string) error {
+	isbn = Normalize(isbn)
+	if len(isbn) != 13 {
+		return ErrLength
+	}
+	for i := 0; i < 13; i++ {
+		if !isDigit(isbn[i]) {
+			return ErrCharacter
+		}
+	}
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return ErrPrefix
+	}
+	if checkDigit13(isbn[:12]) != isbn[12] {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// Convert10To13 converts isbn of ISBN-10 into ISBN-13 without hyphens.
+func Convert10To13(isbn string) (string, error) {
+	if err := Validate10(isbn); err != nil {
+		return "", err
+	}
+	body := "978" + Normalize(isbn)[:9]
+	return body + string(checkDigit13(body)), nil
+}
+
+// Convert13To10 converts isbn of ISBN-13 into ISBN-10 without hyphens.
+// Only ISBN-13 starting with 978 has its counterpart of ISBN-10.
+func Convert13To10(isbn string) (string, error) {
+	if err := Validate13(isbn); err != nil {
+		return "", err
+	}
+	isbn = Normalize(isbn)
+	if !strings.HasPrefix(isbn, "978") {
+		return "", ErrPrefix
+	}
+	body := isbn[3:12]
+	return body + string(checkDigit10(body)), nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func checkDigit10(body string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(body[i]-'0')
+	}
+	switch d := (11 - sum%11) % 11; d {
+	case 10:
+		return 'X'
+	default:
+		return byte('0' + d)
+	}
+}
+
+func checkDigit13(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(body[i]-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,222 @@
+package isbn
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrRange is returned when ISBN is out of ranges which are known to Ranges.
+var ErrRange = errors.New("isbn: undefined range")
+
+type rule struct {
+	low, high string
+	length    int
+}
+
+// Ranges are lengths of registration groups and registrants which ISBN message of International ISBN Agency defines.
+type Ranges struct {
+	// prefixes are rules of registration groups keyed by GS1 prefix such as 978.
+	prefixes map[string][]rule
+	// groups are rules of registrants keyed by GS1 prefix and registration group such as 978-0.
+	groups map[string][]rule
+}
+
+// DefaultRanges holds ranges of major registration groups, which is a subset of ISBN range message of International ISBN Agency.
+// Use ParseRangeMessage with RangeMessage.xml from https://www.isbn-international.org/range_file_generation
+// to hyphenate ISBN of the other registration groups.
+var DefaultRanges = &Ranges{
+	prefixes: map[string][]rule{
+		"978": {
+			{"0000000", "5999999", 1},
+			{"6000000", "6499999", 3},
+			{"6500000", "6599999", 2},
+			{"6600000", "6999999", 0},
+			{"7000000", "7999999", 1},
+			{"8000000", "9499999", 2},
+			{"9500000", "9899999", 3},
+			{"9900000", "9989999", 4},
+			{"9990000", "9999999", 5},
+		},
+		"979": {
+			{"0000000", "0999999", 0},
+			{"1000000", "1299999", 2},
+			{"1300000", "7999999", 0},
+			{"8000000", "8999999", 1},
+			{"9000000", "9999999", 0},
+		},
+	},
+	groups: map[string][]rule{
+		// English language
+		"978-0": {
+			{"0000000", "1999999", 2},
+			{"2000000", "2279999", 3},
+			{"2280000", "2289999", 4},
+			{"2290000", "6479999", 3},
+			{"6480000", "6489999", 7},
+			{"6490000", "6999999", 3},
+			{"7000000", "8499999", 4},
+			{"8500000", "8999999", 5},
+			{"9000000", "9499999", 6},
+			{"9500000", "9999999", 7},
+		},
+		// English language
+		"978-1": {
+			{"0000000", "0999999", 2},
+			{"1000000", "3999999", 3},
+			{"4000000", "5499999", 4},
+			{"5500000", "8697999", 5},
+			{"8698000", "9729999", 6},
+			{"9730000", "9877999", 4},
+			{"9878000", "9989999", 6},
+			{"9990000", "9999999", 7},
+		},
+		// French language
+		"978-2": {
+			{"0000000", "1999999", 2},
+			{"2000000", "3499999", 3},
+			{"3500000", "3999999", 5},
+			{"4000000", "6999999", 3},
+			{"7000000", "8399999", 4},
+			{"8400000", "8999999", 5},
+			{"9000000", "9499999", 6},
+			{"9500000", "9999999", 7},
+		},
+		// German language
+		"978-3": {
+			{"0000000", "0299999", 2},
+			{"0300000", "0339999", 3},
+			{"0340000", "0369999", 4},
+			{"0370000", "0399999", 5},
+			{"0400000", "1999999", 2},
+			{"2000000", "6999999", 3},
+			{"7000000", "8499999", 4},
+			{"8500000", "8999999", 5},
+			{"9000000", "9499999", 6},
+			{"9500000", "9539999", 7},
+			{"9540000", "9699999", 5},
+			{"9700000", "9849999", 7},
+			{"9850000", "9999999", 5},
+		},
+		// Japan
+		"978-4": {
+			{"0000000", "1999999", 2},
+			{"2000000", "6999999", 3},
+			{"7000000", "8499999", 4},
+			{"8500000", "8999999", 5},
+			{"9000000", "9499999", 6},
+			{"9500000", "9999999", 7},
+		},
+		// France
+		"979-10": {
+			{"0000000", "1999999", 2},
+			{"2000000", "6999999", 3},
+			{"7000000", "8999999", 4},
+			{"9000000", "9759999", 5},
+			{"9760000", "9999999", 6},
+		},
+	},
+}
+
+type rangeMessage struct {
+	Prefixes []rangeGroup `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []rangeGroup `xml:"RegistrationGroups>Group"`
+}
+
+type rangeGroup struct {
+	Prefix string      `xml:"Prefix"`
+	Rules  []rangeRule `xml:"Rules>Rule"`
+}
+
+type rangeRule struct {
+	Range  string `xml:"Range"`
+	Length int    `xml:"Length"`
+}
+
+// ParseRangeMessage reads Ranges from RangeMessage.xml of International ISBN Agency.
+func ParseRangeMessage(r io.Reader) (*Ranges, error) {
+	var message rangeMessage
+	if err := xml.NewDecoder(r).Decode(&message); err != nil {
+		return nil, err
+	}
+	ranges := &Ranges{prefixes: map[string][]rule{}, groups: map[string][]rule{}}
+	for _, p := range message.Prefixes {
+		rules, err := rulesOf(p)
+		if err != nil {
+			return nil, err
+		}
+		ranges.prefixes[p.Prefix] = rules
+	}
+	for _, g := range message.Groups {
+		rules, err := rulesOf(g)
+		if err != nil {
+			return nil, err
+		}
+		ranges.groups[g.Prefix] = rules
+	}
+	return ranges, nil
+}
+
+func rulesOf(g rangeGroup) ([]rule, error) {
+	rules := make([]rule, 0, len(g.Rules))
+	for _, r := range g.Rules {
+		bounds := strings.Split(r.Range, "-")
+		if len(bounds) != 2 || len(bounds[0]) != 7 || len(bounds[1]) != 7 {
+			return nil, fmt.Errorf("isbn: unexpected range [%s] of %s", r.Range, g.Prefix)
+		}
+		rules = append(rules, rule{bounds[0], bounds[1], r.Length})
+	}
+	return rules, nil
+}
+
+// Hyphenate hyphenates ISBN-10 or ISBN-13 by DefaultRanges.
+func Hyphenate(isbn string) (string, error) {
+	return DefaultRanges.Hyphenate(isbn)
+}
+
+// Hyphenate hyphenates ISBN-10 or ISBN-13 into GS1 prefix, registration group, registrant, publication and check digit,
+// where ISBN-10 has no GS1 prefix.
+func (r *Ranges) Hyphenate(isbn string) (string, error) {
+	isbn = Normalize(isbn)
+	if len(isbn) == 10 {
+		isbn13, err := Convert10To13(isbn)
+		if err != nil {
+			return "", err
+		}
+		hyphenated, err := r.Hyphenate(isbn13)
+		if err != nil {
+			return "", err
+		}
+		return hyphenated[len("978-"):len(hyphenated)-1] + isbn[9:], nil
+	}
+	if err := Validate13(isbn); err != nil {
+		return "", err
+	}
+	prefix, rest := isbn[:3], isbn[3:12]
+	group := length(r.prefixes[prefix], rest)
+	if group == 0 {
+		return "", ErrRange
+	}
+	registrant := length(r.groups[prefix+"-"+rest[:group]], rest[group:])
+	if registrant == 0 {
+		return "", ErrRange
+	}
+	parts := []string{prefix, rest[:group], rest[group : group+registrant], rest[group+registrant:], isbn[12:]}
+	return strings.Join(parts, "-"), nil
+}
+
+// length returns length of digits which rules define for the leading digits, or 0 when they are undefined.
+func length(rules []rule, digits string) int {
+	key := (digits + "0000000")[:7]
+	for _, r := range rules {
+		if r.low <= key && key <= r.high {
+			if r.length >= len(digits) {
+				return 0
+			}
+			return r.length
+		}
+	}
+	return 0
+}
@@ -0,0 +1,194 @@
+// Package merge deduplicates products of ONIX for Books which multiple senders have sent,
+// and merges their records into one product by priorities of senders.
+package merge
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/isbn"
+)
+
+// Record is a product which sender has sent.
+type Record struct {
+	Sender  string
+	Product v2.Product
+}
+
+// FromMessage returns records of products of msg, whose sender is FromCompany of its header.
+func FromMessage(msg *v2.ONIXMessage) []Record {
+	sender := ""
+	if msg.Header != nil {
+		sender = msg.Header.From().Name
+	}
+	records := make([]Record, len(msg.Products))
+	for i, p := range msg.Products {
+		records[i] = Record{Sender: sender, Product: p}
+	}
+	return records
+}
+
+// Block is a group of elements of product which are merged by the same priorities of senders.
+type Block string
+
+const (
+	// Descriptive is block of elements which describe product, such as titles, contributors and subjects.
+	Descriptive Block = "descriptive"
+	// Supply is block of elements of supply and market representation, such as prices and availability.
+	Supply Block = "supply"
+)
+
+// supplyElements are elements of product which belong to Supply block, where the others belong to Descriptive block.
+var supplyElements = map[string]bool{"SupplyDetail": true, "MarketRepresentation": true}
+
+// Rules are priorities of senders of each block, where senders earlier are preferred.
+// Senders which are not listed follow them in order of records.
+type Rules map[Block][]string
+
+func (r Rules) rank(block Block, sender string) int {
+	for i, s := range r[block] {
+		if s == sender {
+			return i
+		}
+	}
+	return len(r[block])
+}
+
+// Merged is a product which records of the same product are merged into.
+type Merged struct {
+	// Key is GTIN-13 which identifies product, which is ISBN-13 for books.
+	Key     string
+	Product v2.Product
+	// Senders are senders of records which are merged in order of records.
+	Senders []string
+	// Provenance are senders which elements of Product are taken from, by reference names such as Title and SupplyDetail.
+	Provenance map[string]string
+}
+
+// Key returns GTIN-13 which identifies p across senders.
+// It prefers ISBN-13, and falls back on GTIN-13 and ISBN-10 which is converted into ISBN-13.
+func Key(p v2.Product) (string, bool) {
+	if id, ok := p.ISBN13(); ok && isbn.Validate13(id) == nil {
+		return isbn.Normalize(id), true
+	}
+	if id, ok := p.GTIN13(); ok {
+		if id = isbn.Normalize(id); len(id) == 13 {
+			return id, true
+		}
+	}
+	for _, id := range isbn10Of(p) {
+		if converted, err := isbn.Convert10To13(id); err == nil {
+			return converted, true
+		}
+	}
+	return "", false
+}
+
+func isbn10Of(p v2.Product) []string {
+	var ids []string
+	for _, id := range p.ProductIdentifiers {
+		if id.ProductIDType.Code() == "02" {
+			ids = append(ids, id.IDValue.Body)
+		}
+	}
+	if p.ISBN != nil {
+		ids = append(ids, p.ISBN.Body)
+	}
+	return ids
+}
+
+// Cluster groups records of the same Key in order of their first appearance.
+// Records without Key are left out since they cannot be told apart.
+func Cluster(records []Record) [][]Record {
+	var clusters [][]Record
+	indices := map[string]int{}
+	for _, r := range records {
+		key, ok := Key(r.Product)
+		if !ok {
+			continue
+		}
+		i, ok := indices[key]
+		if !ok {
+			i = len(clusters)
+			indices[key] = i
+			clusters = append(clusters, nil)
+		}
+		clusters[i] = append(clusters[i], r)
+	}
+	return clusters
+}
+
+// Merge clusters records by Key, and merges each cluster into a product by rules.
+// Each element of product is taken from the most preferred sender of its block among the ones which have the element.
+func Merge(records []Record, rules Rules) []Merged {
+	var merged []Merged
+	for _, cluster := range Cluster(records) {
+		merged = append(merged, mergeCluster(cluster, rules))
+	}
+	return merged
+}
+
+func mergeCluster(cluster []Record, rules Rules) Merged {
+	key, _ := Key(cluster[0].Product)
+	m := Merged{Key: key, Provenance: map[string]string{}}
+	for _, r := range cluster {
+		if !contains(m.Senders, r.Sender) {
+			m.Senders = append(m.Senders, r.Sender)
+		}
+	}
+	ordered := map[Block][]Record{}
+	for _, block := range []Block{Descriptive, Supply} {
+		records := append([]Record(nil), cluster...)
+		sort.SliceStable(records, func(i, j int) bool {
+			return rules.rank(block, records[i].Sender) < rules.rank(block, records[j].Sender)
+		})
+		ordered[block] = records
+	}
+
+	product := reflect.ValueOf(&m.Product).Elem()
+	t := product.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := elementName(t.Field(i))
+		block := Descriptive
+		if supplyElements[name] {
+			block = Supply
+		}
+		for _, r := range ordered[block] {
+			field := reflect.ValueOf(r.Product).Field(i)
+			if field.IsZero() {
+				continue
+			}
+			product.Field(i).Set(field)
+			m.Provenance[name] = r.Sender
+			break
+		}
+	}
+	return m
+}
+
+// elementName returns reference name of element or attribute of field.
+func elementName(field reflect.StructField) string {
+	opts := strings.Split(field.Tag.Get("xml"), ",")
+	name := opts[0]
+	if ref, ok := v2.ReferenceTags[name]; ok {
+		name = ref
+	}
+	if name == "" {
+		return field.Name
+	}
+	if contains(opts[1:], "attr") {
+		return "@" + name
+	}
+	return name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package onix
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	zipMagic  = []byte{'P', 'K', 0x03, 0x04}
+	gzipMagic = []byte{0x1F, 0x8B}
+)
+
+// Open reads messages of the feed file at path, which is either a message itself, a gzip of it,
+// or a zip of one or more messages.
+func Open(path string, opts ...DecodeOption) ([]*Message, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return DecodeArchive(file, info.Size(), opts...)
+}
+
+// DecodeArchive decodes messages of r of size bytes, where gzip and zip are detected by their magic numbers and unwrapped,
+// and other input is decoded as a message as it is.
+// Entries of zip are decoded in order of the archive, where directories, empty entries and entries which are not XML such as readme
+// are skipped, and entries which are themselves gzip are unwrapped as well.
+// An error of an entry tells its name, and stops decoding the rest.
+func DecodeArchive(r io.ReaderAt, size int64, opts ...DecodeOption) ([]*Message, error) {
+	head := make([]byte, len(zipMagic))
+	n, err := r.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	head = head[:n]
+	if !bytes.HasPrefix(head, zipMagic) {
+		msg, err := decodeCompressed(io.NewSectionReader(r, 0, size), opts)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	var messages []*Message
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || entry.UncompressedSize64 == 0 || !isFeedEntry(entry.Name) {
+			continue
+		}
+		msg, err := decodeEntry(entry, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		messages = append(messages, msg)
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("zip has no ONIX message")
+	}
+	return messages, nil
+}
+
+func decodeEntry(entry *zip.File, opts []DecodeOption) (*Message, error) {
+	r, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return decodeCompressed(r, opts)
+}
+
+// decodeCompressed decodes a message of r, which is unwrapped beforehand if it is gzip.
+func decodeCompressed(r io.Reader, opts []DecodeOption) (*Message, error) {
+	head := make([]byte, len(gzipMagic))
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	r = io.MultiReader(bytes.NewReader(head[:n]), r)
+	if !bytes.Equal(head[:n], gzipMagic) {
+		return Decode(r, opts...)
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	return Decode(gz, opts...)
+}
+
+// isFeedEntry reports whether an entry of zip which is named name may be ONIX message,
+// which is the one of extensions such as .xml and .onx, or the one without extension.
+func isFeedEntry(name string) bool {
+	base := name[strings.LastIndex(name, "/")+1:]
+	if strings.HasPrefix(base, ".") || strings.HasPrefix(name, "__MACOSX/") {
+		return false
+	}
+	i := strings.LastIndex(base, ".")
+	if i < 0 {
+		return true
+	}
+	switch strings.ToLower(base[i:]) {
+	case ".xml", ".onx", ".onix", ".gz":
+		return true
+	}
+	return false
+}
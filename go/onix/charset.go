@@ -0,0 +1,222 @@
+package onix
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// windows1252 are characters which bytes from 0x80 to 0x9F of windows-1252 stand for, where undefined ones are U+FFFD.
+var windows1252 = [32]rune{
+	'€', '�', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', '�', 'Ž', '�',
+	'�', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', '�', 'ž', 'Ÿ',
+}
+
+// CharsetReader converts input of charset which XML declaration declares into UTF-8, which decoding uses by default.
+// ISO-8859-1 and windows-1252 are converted, and input of UTF-8, US-ASCII and UTF-16 is read as it is,
+// since UTF-16 has been converted by UTF8Reader before XML declaration is read.
+// Input of the other charsets is read as it is as well.
+func CharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "iso8859-1", "iso_8859-1", "latin1", "l1":
+		return &singleByteReader{r: input}, nil
+	case "windows-1252", "cp1252":
+		return &singleByteReader{r: input, windows: true}, nil
+	}
+	return input, nil
+}
+
+// UTF8Reader returns reader of r which drops byte order mark of UTF-8,
+// and converts UTF-16 which is detected by byte order mark or by the beginning of XML declaration into UTF-8.
+func UTF8Reader(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(4)
+	switch {
+	case bytes.HasPrefix(head, []byte{0xEF, 0xBB, 0xBF}):
+		br.Discard(3)
+	case bytes.HasPrefix(head, []byte{0xFE, 0xFF}):
+		br.Discard(2)
+		return &utf16Reader{r: br, bigEndian: true}
+	case bytes.HasPrefix(head, []byte{0xFF, 0xFE}):
+		br.Discard(2)
+		return &utf16Reader{r: br}
+	case bytes.Equal(head, []byte{0x00, '<', 0x00, '?'}):
+		return &utf16Reader{r: br, bigEndian: true}
+	case bytes.Equal(head, []byte{'<', 0x00, '?', 0x00}):
+		return &utf16Reader{r: br}
+	}
+	return br
+}
+
+// singleByteReader converts ISO-8859-1, or windows-1252 if windows is set, into UTF-8.
+type singleByteReader struct {
+	r       io.Reader
+	windows bool
+	buf     []byte
+	pending []byte
+	// out is storage of pending, which is reused once pending has been read.
+	out []byte
+}
+
+func (r *singleByteReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if len(r.buf) < len(p) {
+			r.buf = make([]byte, len(p))
+		}
+		n, err := r.r.Read(r.buf[:len(p)])
+		out := r.out[:0]
+		for _, b := range r.buf[:n] {
+			if b < utf8.RuneSelf {
+				out = append(out, b)
+				continue
+			}
+			c := rune(b)
+			if r.windows && b < 0xA0 {
+				c = windows1252[b-0x80]
+			}
+			out = appendRune(out, c)
+		}
+		r.out, r.pending = out, out
+		if n == 0 && err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// utf16Reader converts UTF-16 of either byte order into UTF-8.
+type utf16Reader struct {
+	r         *bufio.Reader
+	bigEndian bool
+	pending   []byte
+}
+
+func (r *utf16Reader) Read(p []byte) (int, error) {
+	for len(r.pending) < len(p) {
+		unit, err := r.unit()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		c := rune(unit)
+		if utf16.IsSurrogate(c) {
+			next, err := r.unit()
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+			c = utf16.DecodeRune(c, rune(next))
+		}
+		r.pending = appendRune(r.pending, c)
+	}
+	if len(r.pending) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *utf16Reader) unit() (uint16, error) {
+	var b [2]byte
+	if _, err := io.ReadFull(r.r, b[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, fmt.Errorf("odd number of bytes of UTF-16 has been passed")
+		}
+		return 0, err
+	}
+	if r.bigEndian {
+		return uint16(b[0])<<8 | uint16(b[1]), nil
+	}
+	return uint16(b[1])<<8 | uint16(b[0]), nil
+}
+
+func appendRune(b []byte, c rune) []byte {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], c)
+	return append(b, buf[:n]...)
+}
+
+// charsetWriter returns writer of w which converts UTF-8 into charset, which is either UTF-8, US-ASCII, ISO-8859-1 or windows-1252.
+// Characters which charset lacks are written as character references such as &#8364;,
+// which stand for them in texts and values of attributes.
+func charsetWriter(charset string, w io.Writer) (io.Writer, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8":
+		return w, nil
+	case "us-ascii", "ascii":
+		return &singleByteWriter{w: w, max: utf8.RuneSelf}, nil
+	case "iso-8859-1", "iso8859-1", "iso_8859-1", "latin1", "l1":
+		return &singleByteWriter{w: w, max: 0x100}, nil
+	case "windows-1252", "cp1252":
+		return &singleByteWriter{w: w, max: 0x100, windows: true}, nil
+	}
+	return nil, fmt.Errorf("unsupported charset has been passed, got [%s]", charset)
+}
+
+// singleByteWriter converts UTF-8 into a charset of single bytes, whose characters are below max
+// or the ones of windows-1252 if windows is set.
+type singleByteWriter struct {
+	w       io.Writer
+	max     rune
+	windows bool
+	// pending is the beginning of a character which the last write has split.
+	pending []byte
+	buf     []byte
+}
+
+func (w *singleByteWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(w.pending) > 0 {
+		p, w.pending = append(w.pending, p...), nil
+	}
+	out := w.buf[:0]
+	for len(p) > 0 {
+		if p[0] < utf8.RuneSelf {
+			out = append(out, p[0])
+			p = p[1:]
+			continue
+		}
+		if !utf8.FullRune(p) {
+			w.pending = append([]byte(nil), p...)
+			break
+		}
+		c, size := utf8.DecodeRune(p)
+		p = p[size:]
+		if b, ok := w.byteOf(c); ok {
+			out = append(out, b)
+			continue
+		}
+		out = append(strconv.AppendInt(append(out, "&#"...), int64(c), 10), ';')
+	}
+	w.buf = out
+	if _, err := w.w.Write(out); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (w *singleByteWriter) byteOf(c rune) (byte, bool) {
+	if w.windows {
+		for i, r := range windows1252 {
+			if r == c && r != utf8.RuneError {
+				return byte(0x80 + i), true
+			}
+		}
+		if c >= 0x80 && c < 0xA0 {
+			return 0, false
+		}
+	}
+	if c < w.max {
+		return byte(c), true
+	}
+	return 0, false
+}
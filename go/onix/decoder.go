@@ -0,0 +1,715 @@
+package onix
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Message is ONIX for Books message decoded by the release it declares.
+type Message struct {
+	Version Version         `json:"version"`
+	V2      *v2.ONIXMessage `json:"v2,omitempty"`
+	V3      *v3.ONIXMessage `json:"v3,omitempty"`
+}
+
+// UnknownCodePolicy is how codes which are not defined at codelists are treated on decoding.
+type UnknownCodePolicy uint
+
+const (
+	// UnknownCodeError aborts decoding with an error, which is the default.
+	UnknownCodeError UnknownCodePolicy = iota
+	// UnknownCodeKeep preserves the code as it is in place of human readable description.
+	UnknownCodeKeep
+	// UnknownCodeSkip leaves the value empty.
+	UnknownCodeSkip
+)
+
+// Warning is a code which is not defined at codelists and has been kept or skipped.
+type Warning struct {
+	// Element is tag name of the element which has the code.
+	Element string
+	Type    string
+	Code    string
+}
+
+func (w Warning) Error() string {
+	return fmt.Sprintf("undefined code for %s has been passed at <%s>, got [%s]", w.Type, w.Element, w.Code)
+}
+
+// DecodeError is an error which has stopped decoding, along with where it has occurred in message.
+type DecodeError struct {
+	// RecordReference is RecordReference of the product which error has occurred in, which is empty outside products.
+	RecordReference string
+	// Path is XPath-like location of the element such as /ONIXMessage/Product[3]/ProductIdentifier[2]/ProductIDType,
+	// where products and repeated elements are numbered.
+	Path string
+	// Offset is byte offset of input where decoding has stopped.
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	if e.RecordReference == "" {
+		return fmt.Sprintf("%s at %s (offset %d)", e.Err, e.Path, e.Offset)
+	}
+	return fmt.Sprintf("%s at %s of record [%s] (offset %d)", e.Err, e.Path, e.RecordReference, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+type decodeConfig struct {
+	unknownCodePolicy UnknownCodePolicy
+	warnings          *[]Warning
+	deprecations      *[]Deprecation
+	charsetReader     func(charset string, input io.Reader) (io.Reader, error)
+	lenient           bool
+	maxProductSize    int64
+	dialect           *Dialect
+	codeListIssue     int
+	dtdEntities       bool
+	extensions        bool
+	maxDepth          int
+	internPool        *InternPool
+	eventHandler      EventHandler
+	progressInterval  int
+	metrics           Metrics
+}
+
+// DecodeOption configures how message is decoded.
+type DecodeOption func(*decodeConfig)
+
+// WithUnknownCodePolicy chooses how codes which are not defined at codelists are treated.
+func WithUnknownCodePolicy(policy UnknownCodePolicy) DecodeOption {
+	return func(c *decodeConfig) {
+		c.unknownCodePolicy = policy
+	}
+}
+
+// WithWarnings appends codes which have been kept or skipped by UnknownCodePolicy to warnings.
+func WithWarnings(warnings *[]Warning) DecodeOption {
+	return func(c *decodeConfig) {
+		c.warnings = warnings
+	}
+}
+
+// WithDeprecations appends elements which are deprecated in the release of message and have been decoded to deprecations in order of message,
+// which Decoder.Deprecations returns as well, so that DecodeParallel collects them along with the ones of header.
+func WithDeprecations(deprecations *[]Deprecation) DecodeOption {
+	return func(c *decodeConfig) {
+		c.deprecations = deprecations
+	}
+}
+
+// WithCharsetReader converts input of the charset which XML declaration declares into UTF-8, as CharsetReader of xml.Decoder does,
+// in place of CharsetReader which is used by default.
+func WithCharsetReader(reader func(charset string, input io.Reader) (io.Reader, error)) DecodeOption {
+	return func(c *decodeConfig) {
+		c.charsetReader = reader
+	}
+}
+
+// WithStrict chooses whether XML has to be well-formed, which it has to by default.
+// Non-strict decoding closes elements which are left open, and accepts entities of HTML such as &nbsp;.
+func WithStrict(strict bool) DecodeOption {
+	return func(c *decodeConfig) {
+		c.lenient = !strict
+	}
+}
+
+// WithMaxProductSize aborts decoding with ErrProductTooLarge once a product exceeds size bytes of input.
+// Size of products is not limited by default.
+func WithMaxProductSize(size int64) DecodeOption {
+	return func(c *decodeConfig) {
+		c.maxProductSize = size
+	}
+}
+
+// WithMaxDepth aborts decoding with ErrTooDeep once elements are nested deeper than depth levels,
+// which is DefaultMaxDepth by default, so that maliciously deep input does not exhaust memory.
+func WithMaxDepth(depth int) DecodeOption {
+	return func(c *decodeConfig) {
+		c.maxDepth = depth
+	}
+}
+
+// WithTagDialect accepts only tags of dialect, while both of them are accepted by default.
+func WithTagDialect(dialect Dialect) DecodeOption {
+	return func(c *decodeConfig) {
+		c.dialect = &dialect
+	}
+}
+
+// WithCodeListIssue pins codelists to issue, so that decoding fails
+// unless the codes of the release which message declares are generated from the issue.
+func WithCodeListIssue(issue int) DecodeOption {
+	return func(c *decodeConfig) {
+		c.codeListIssue = issue
+	}
+}
+
+// WithDTDEntities resolves character entities which the official DTD of ONIX for Books declares, such as &eacute; of 2.1,
+// from the entity sets of XHTML which it includes, without fetching the DTD.
+// Entities are not resolved by default, and non-strict decoding resolves them as well.
+func WithDTDEntities() DecodeOption {
+	return func(c *decodeConfig) {
+		c.dtdEntities = true
+	}
+}
+
+// WithExtensions keeps elements of the other namespaces than ONIX for Books, such as proprietary extensions of senders,
+// as Extensions of composites which they are in, while they are skipped by default.
+// Elements of ONIX for Books which the model does not define are kept as Extensions regardless.
+func WithExtensions() DecodeOption {
+	return func(c *decodeConfig) {
+		c.extensions = true
+	}
+}
+
+// WithInternPool replaces strings of decoded products by identical ones of pool, so that they share backing memory,
+// which reduces heap of products which are kept in memory such as ones of whole feeds. Strings are not interned by default.
+func WithInternPool(pool *InternPool) DecodeOption {
+	return func(c *decodeConfig) {
+		c.internPool = pool
+	}
+}
+
+// ErrProductTooLarge is an error of a product which exceeds the size which WithMaxProductSize has limited.
+var ErrProductTooLarge = errors.New("product exceeds max size")
+
+// DefaultMaxDepth is levels of elements which are allowed to be nested unless WithMaxDepth is passed,
+// which is far deeper than ONIX for Books and XHTML of its texts are nested.
+const DefaultMaxDepth = 256
+
+// ErrTooDeep is an error of elements which are nested deeper than WithMaxDepth has limited.
+var ErrTooDeep = errors.New("elements are nested too deep")
+
+func newDecodeConfig(opts []DecodeOption) decodeConfig {
+	config := decodeConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// xmlDecoder returns xml.Decoder of r which is configured by config, where UTF-16 is converted into UTF-8 beforehand.
+func (c decodeConfig) xmlDecoder(r io.Reader) *xml.Decoder {
+	decoder := xml.NewDecoder(UTF8Reader(r))
+	decoder.CharsetReader = c.charsetReader
+	if decoder.CharsetReader == nil {
+		decoder.CharsetReader = CharsetReader
+	}
+	if c.lenient {
+		decoder.Strict = false
+		decoder.AutoClose = xml.HTMLAutoClose
+	}
+	if c.lenient || c.dtdEntities {
+		// Entities of XHTML are single characters, so that they never expand beyond a few bytes.
+		decoder.Entity = xml.HTMLEntity
+	}
+	return decoder
+}
+
+// tagReader returns tagReader which renames tags of decoder into short tags of version, and stops once ctx is done.
+func (c decodeConfig) tagReader(ctx context.Context, decoder *xml.Decoder, root xml.StartElement, version Version) *tagReader {
+	tags, others := v2.ShortTags, v2.ReferenceTags
+	if version == V3 {
+		tags, others = v3.ShortTags, v3.ReferenceTags
+	}
+	r := newTagReader(decoder, root, tags, "")
+	r.maxProductSize = c.maxProductSize
+	if c.maxDepth > 0 {
+		r.maxDepth = c.maxDepth
+	}
+	r.ctx = ctx
+	r.extensions = c.extensions
+	if c.dialect != nil {
+		switch *c.dialect {
+		case ShortTag:
+			// Reference tags are the keys of the mapping into short tags.
+			r.tags, r.rejected = nil, tags
+		case ReferenceTag:
+			r.rejected = others
+		}
+	}
+	return r
+}
+
+// checkCodeListIssue returns an error unless codes of version are generated from codelists of the issue which has been pinned.
+func (c decodeConfig) checkCodeListIssue(version Version) error {
+	if c.codeListIssue == 0 {
+		return nil
+	}
+	issue := v2.CodeListIssue
+	if version == V3 {
+		issue = v3.CodeListIssue
+	}
+	if issue != c.codeListIssue {
+		return fmt.Errorf("codelists of Issue %d has been pinned, but codes of %s are generated from Issue %d", c.codeListIssue, version, issue)
+	}
+	return nil
+}
+
+// Read reads ONIX for Books file of either 2.1 or 3.0.
+func Read(input string, opts ...DecodeOption) (*Message, error) {
+	file, err := os.Open(input)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Decode(file, opts...)
+}
+
+// Decode decodes ONIX for Books message of either 2.1 or 3.0.
+// The release is detected from the root element,
+// and both of reference tags and short tags are accepted with or without namespaces of them.
+// An error which stops decoding is a *DecodeError which tells where it has occurred.
+func Decode(r io.Reader, opts ...DecodeOption) (*Message, error) {
+	return NewDecoder(r, opts...).Decode()
+}
+
+// DecodeContext decodes message as Decode does, and aborts with the error of ctx once ctx is done,
+// which is checked between products so that huge uploads stop at disconnection of clients or timeout.
+func DecodeContext(ctx context.Context, r io.Reader, opts ...DecodeOption) (*Message, error) {
+	return NewDecoder(r, opts...).DecodeContext(ctx)
+}
+
+// Decoder decodes ONIX for Books message of either 2.1 or 3.0 from its input as options have configured.
+type Decoder struct {
+	r            io.Reader
+	config       decodeConfig
+	deprecations []Deprecation
+}
+
+// NewDecoder returns Decoder which reads message from r.
+func NewDecoder(r io.Reader, opts ...DecodeOption) *Decoder {
+	return &Decoder{r: r, config: newDecodeConfig(opts)}
+}
+
+// Decode decodes message as Decode does.
+func (dec *Decoder) Decode() (*Message, error) {
+	return dec.DecodeContext(context.Background())
+}
+
+// Deprecations returns elements which are deprecated in the release of message and have been decoded by the last Decode, in order of message,
+// so that senders of feeds are told what replaces them.
+func (dec *Decoder) Deprecations() []Deprecation {
+	return dec.deprecations
+}
+
+// DecodeContext decodes message as DecodeContext does.
+func (dec *Decoder) DecodeContext(ctx context.Context) (*Message, error) {
+	config := dec.config
+	decoder := config.xmlDecoder(dec.r)
+	dec.deprecations = nil
+
+	root, err := rootElement(decoder)
+	if err != nil {
+		config.failed(err)
+		return nil, err
+	}
+	version, err := DetectVersion(root)
+	if err != nil {
+		config.failed(err)
+		return nil, err
+	}
+	if err := config.checkCodeListIssue(version); err != nil {
+		config.failed(err)
+		return nil, err
+	}
+
+	msg := Message{Version: version}
+	var warnings []Warning
+	switch version {
+	case V2:
+		var ws []v2.Warning
+		tags := config.tagReader(ctx, decoder, root, V2)
+		l := newLocator(tags, v2.ReferenceTags)
+		l.deprecated = deprecatedElements(V2)
+		events := config.emitter(l, decoder, func(from int) []Warning {
+			var warnings []Warning
+			for _, w := range ws[from:] {
+				warnings = append(warnings, Warning(w))
+			}
+			return warnings
+		})
+		if events != nil {
+			tags.skipped = events.skipped
+		}
+		d := xml.NewTokenDecoder(l)
+		release := v2.ConfigureDecoder(d, v2.WithUnknownCodePolicy(v2.UnknownCodePolicy(config.unknownCodePolicy)), v2.WithWarnings(&ws))
+		msg.V2 = &v2.ONIXMessage{}
+		err = l.wrap(d.Decode(msg.V2), decoder.InputOffset())
+		release()
+		dec.deprecations = l.deprecations
+		if events != nil {
+			events.done(err)
+		}
+		for _, w := range ws {
+			warnings = append(warnings, Warning(w))
+		}
+	case V3:
+		var ws []v3.Warning
+		tags := config.tagReader(ctx, decoder, root, V3)
+		l := newLocator(tags, v3.ReferenceTags)
+		l.deprecated = deprecatedElements(V3)
+		events := config.emitter(l, decoder, func(from int) []Warning {
+			var warnings []Warning
+			for _, w := range ws[from:] {
+				warnings = append(warnings, Warning(w))
+			}
+			return warnings
+		})
+		if events != nil {
+			tags.skipped = events.skipped
+		}
+		d := xml.NewTokenDecoder(l)
+		release := v3.ConfigureDecoder(d, v3.WithUnknownCodePolicy(v3.UnknownCodePolicy(config.unknownCodePolicy)), v3.WithWarnings(&ws))
+		msg.V3 = &v3.ONIXMessage{}
+		err = l.wrap(d.Decode(msg.V3), decoder.InputOffset())
+		release()
+		dec.deprecations = l.deprecations
+		if events != nil {
+			events.done(err)
+		}
+		for _, w := range ws {
+			warnings = append(warnings, Warning(w))
+		}
+	}
+	if config.warnings != nil {
+		*config.warnings = append(*config.warnings, warnings...)
+	}
+	if config.deprecations != nil {
+		*config.deprecations = append(*config.deprecations, dec.deprecations...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.internPool != nil {
+		config.internPool.internValue(&msg)
+	}
+	return &msg, nil
+}
+
+// rootElement reads prolog of message up to the root element.
+// DOCTYPE is ignored, since encoding/xml neither fetches DTD nor expands entities which it declares,
+// and DOCTYPE which declares entities is rejected rather than failing at their references.
+func rootElement(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return xml.StartElement{}, fmt.Errorf("root element of ONIX message has not been found")
+		}
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := token.(type) {
+		case xml.Directive:
+			if bytes.HasPrefix(t, []byte("DOCTYPE")) && bytes.Contains(t, []byte("<!ENTITY")) {
+				return xml.StartElement{}, fmt.Errorf("declarations of entities in DOCTYPE are not allowed")
+			}
+		case xml.StartElement:
+			return t.Copy(), nil
+		}
+	}
+}
+
+// tagReader renames tags by the mapping which it has been passed.
+// Renaming reference tags to short tags lets the generated structs which bound to short tags decode both of them,
+// and the reverse lets Encode emit reference tags.
+// Elements lose namespaces of ONIX for Books, where the root element is put in the namespace which it has been passed instead,
+// and elements of the other namespaces such as extensions of senders are skipped unless extensions is set,
+// which passes them through as they are along with their contents.
+type tagReader struct {
+	decoder *xml.Decoder
+	root    *xml.StartElement
+	tags    map[string]string
+	space   string
+	depth   int
+	// maxDepth limits levels of elements which are nested.
+	maxDepth int
+	// rejected are tags which are not accepted, whose values are ignored.
+	rejected map[string]string
+	// maxProductSize limits bytes of input of each product unless it is 0.
+	maxProductSize int64
+	productOffset  int64
+	inProduct      bool
+	// ctx aborts reading at the beginning of a product once it is done unless it is nil.
+	ctx        context.Context
+	extensions bool
+	// foreign is depth of the element of the other namespace which is being passed through, which is 0 outside it.
+	foreign int
+	// skipped is called by elements of the other namespace which are skipped unless it is nil.
+	skipped func(name xml.Name)
+}
+
+func newTagReader(d *xml.Decoder, root xml.StartElement, tags map[string]string, space string) *tagReader {
+	return &tagReader{decoder: d, root: &root, tags: tags, space: space, maxDepth: DefaultMaxDepth}
+}
+
+func (r *tagReader) Token() (xml.Token, error) {
+	for {
+		var token xml.Token
+		offset := r.decoder.InputOffset()
+		root := r.root != nil
+		if root {
+			token, r.root = *r.root, nil
+		} else {
+			t, err := r.decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			token = t
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if r.foreign == 0 && !root && !isNamespace(t.Name.Space) {
+				if !r.extensions {
+					if r.skipped != nil {
+						r.skipped(t.Name)
+					}
+					if err := r.decoder.Skip(); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				r.foreign = r.depth + 1
+			}
+			if r.foreign > 0 {
+				r.depth++
+				if err := r.checkDepth(); err != nil {
+					return nil, err
+				}
+				if err := r.checkProductSize(); err != nil {
+					return nil, err
+				}
+				// Namespaces are declared again by names of elements and attributes on encoding.
+				t.Attr = withoutNamespaces(t.Attr)
+				return t, nil
+			}
+			if _, ok := r.rejected[t.Name.Local]; ok {
+				return nil, fmt.Errorf("unexpected tag of the other dialect has been passed, got [%s]", t.Name.Local)
+			}
+			r.depth++
+			if err := r.checkDepth(); err != nil {
+				return nil, err
+			}
+			t.Name = r.rename(t.Name)
+			if r.depth == 2 && t.Name.Local == "product" {
+				if r.ctx != nil {
+					if err := r.ctx.Err(); err != nil {
+						return nil, err
+					}
+				}
+				r.productOffset, r.inProduct = offset, true
+			}
+			if err := r.checkProductSize(); err != nil {
+				return nil, err
+			}
+			if root {
+				t.Name.Space = r.space
+				t.Attr = withoutNamespaces(t.Attr)
+			}
+			return t, nil
+		case xml.EndElement:
+			if err := r.checkProductSize(); err != nil {
+				return nil, err
+			}
+			if r.foreign > 0 {
+				if r.depth == r.foreign {
+					r.foreign = 0
+				}
+				r.depth--
+				return t, nil
+			}
+			r.depth--
+			t.Name = r.rename(t.Name)
+			if r.depth == 0 {
+				t.Name.Space = r.space
+			}
+			if r.depth == 1 {
+				r.inProduct = false
+			}
+			return t, nil
+		default:
+			if err := r.checkProductSize(); err != nil {
+				return nil, err
+			}
+			return token, nil
+		}
+	}
+}
+
+func (r *tagReader) checkDepth() error {
+	if r.depth > r.maxDepth {
+		return fmt.Errorf("%w, over %d levels", ErrTooDeep, r.maxDepth)
+	}
+	return nil
+}
+
+func (r *tagReader) checkProductSize() error {
+	if r.maxProductSize <= 0 || !r.inProduct {
+		return nil
+	}
+	if size := r.decoder.InputOffset() - r.productOffset; size > r.maxProductSize {
+		return fmt.Errorf("%w, got %d bytes over %d bytes", ErrProductTooLarge, size, r.maxProductSize)
+	}
+	return nil
+}
+
+func (r *tagReader) rename(name xml.Name) xml.Name {
+	if short, ok := r.tags[name.Local]; ok {
+		name.Local = short
+	}
+	name.Space = ""
+	return name
+}
+
+// isNamespace reports whether space is a namespace of ONIX for Books, such as the ones of short tags and reference tags,
+// where elements without namespace are regarded as of ONIX for Books.
+func isNamespace(space string) bool {
+	return space == "" || strings.Contains(space, "editeur.org/onix/")
+}
+
+// withoutNamespaces returns attrs except declarations of namespaces.
+func withoutNamespaces(attrs []xml.Attr) []xml.Attr {
+	var rest []xml.Attr
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		rest = append(rest, attr)
+	}
+	return rest
+}
+
+// locator follows elements which tokens of r open and close, so that errors of decoding tell where they have occurred.
+// Paths are built only once an error is wrapped, since following elements is on the hot path of decoding.
+type locator struct {
+	r xml.TokenReader
+	// tags is mapping from short tags to reference tags which paths are written in.
+	tags  map[string]string
+	stack []locatorFrame
+	// closed is depth of the element which has just been closed, which is 0 unless a token follows it.
+	// Its frame is left beyond the end of stack until the next element is opened.
+	closed int
+	record string
+	text   *strings.Builder
+	// deprecated are elements whose deprecations are collected, by reference names of them or of their parents and them.
+	deprecated   map[string]string
+	deprecations []Deprecation
+	// events are emitted as children of root element are closed unless it is nil.
+	events *emitter
+}
+
+type locatorFrame struct {
+	name string
+	// index is position of the element among its siblings of the same name, which the path tells unless it is 0.
+	index    int
+	children map[string]int
+}
+
+func newLocator(r xml.TokenReader, tags map[string]string) *locator {
+	return &locator{r: r, tags: tags, stack: []locatorFrame{{}}}
+}
+
+func (l *locator) Token() (xml.Token, error) {
+	token, err := l.r.Token()
+	if err != nil {
+		return token, err
+	}
+	switch t := token.(type) {
+	case xml.StartElement:
+		name := t.Name.Local
+		if ref, ok := l.tags[name]; ok {
+			name = ref
+		}
+		parent := &l.stack[len(l.stack)-1]
+		if parent.children == nil {
+			parent.children = map[string]int{}
+		}
+		parent.children[name]++
+		frame := locatorFrame{name: name}
+		if n := parent.children[name]; name == "Product" || n > 1 {
+			frame.index = n
+		}
+		if len(l.stack) == 2 {
+			// Children of root such as products and header have records of their own.
+			l.record = ""
+		}
+		if name == "RecordReference" && parent.name == "Product" {
+			l.text = &strings.Builder{}
+		}
+		l.stack = append(l.stack, frame)
+		l.closed = 0
+		if l.deprecated != nil {
+			l.deprecate(parent.name, name)
+		}
+	case xml.CharData:
+		if l.text != nil {
+			l.text.Write(t)
+		}
+		l.closed = 0
+	case xml.EndElement:
+		if l.text != nil {
+			l.record = strings.TrimSpace(l.text.String())
+			l.text = nil
+		}
+		if l.events != nil && len(l.stack) == 3 {
+			l.events.closed(l.stack[2].name, l.record, pathOfFrames(l.stack))
+		}
+		if len(l.stack) > 1 {
+			l.closed = len(l.stack)
+			l.stack = l.stack[:len(l.stack)-1]
+		}
+	}
+	return token, nil
+}
+
+// deprecate collects the element of name which has just been opened in parent, if it is deprecated.
+func (l *locator) deprecate(parent, name string) {
+	replacement, ok := l.deprecated[parent+"/"+name]
+	if !ok {
+		replacement, ok = l.deprecated[name]
+	}
+	if !ok {
+		return
+	}
+	l.deprecations = append(l.deprecations, Deprecation{Element: name, RecordReference: l.record, Path: pathOfFrames(l.stack), Replacement: replacement})
+}
+
+// wrap returns err as DecodeError at the element which has just been closed, or which is open,
+// since UnmarshalXML of codes fails once it has read the whole element.
+func (l *locator) wrap(err error, offset int64) error {
+	if err == nil {
+		return nil
+	}
+	frames := l.stack
+	if l.closed > 0 {
+		frames = l.stack[:l.closed]
+	}
+	return &DecodeError{RecordReference: l.record, Path: pathOfFrames(frames), Offset: offset, Err: err}
+}
+
+// pathOfFrames returns path of the innermost element of frames, whose first frame is the document.
+func pathOfFrames(frames []locatorFrame) string {
+	var b strings.Builder
+	for _, frame := range frames[1:] {
+		b.WriteString("/")
+		b.WriteString(frame.name)
+		if frame.index > 0 {
+			fmt.Fprintf(&b, "[%d]", frame.index)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package onix
+
+import (
+	"fmt"
+
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Deprecation is an element which is deprecated in the release of message, and has been decoded nonetheless.
+type Deprecation struct {
+	// Element is reference name of the element.
+	Element string
+	// RecordReference and Path tell where the element is, as the ones of DecodeError do.
+	RecordReference string
+	Path            string
+	// Replacement tells what replaces the element.
+	Replacement string
+}
+
+func (d Deprecation) Error() string {
+	if d.RecordReference == "" {
+		return fmt.Sprintf("deprecated <%s> has been passed at %s, %s", d.Element, d.Path, d.Replacement)
+	}
+	return fmt.Sprintf("deprecated <%s> has been passed at %s of record [%s], %s", d.Element, d.Path, d.RecordReference, d.Replacement)
+}
+
+// deprecatedV2 are elements which are deprecated in 2.1 with what replaces them, by reference names of them,
+// or of their parents and them for the ones which are deprecated only in their parents.
+var deprecatedV2 = map[string]string{
+	"FromEANNumber":                  "SenderIdentifier replaces FromEANNumber",
+	"FromSAN":                        "SenderIdentifier replaces FromSAN",
+	"ToEANNumber":                    "AddresseeIdentifier replaces ToEANNumber",
+	"ToSAN":                          "AddresseeIdentifier replaces ToSAN",
+	"Product/ISBN":                   "ProductIdentifier of ProductIDType 02 replaces ISBN",
+	"Product/EAN13":                  "ProductIdentifier of ProductIDType 03 replaces EAN13",
+	"Product/UPC":                    "ProductIdentifier of ProductIDType 04 replaces UPC",
+	"Product/PublisherProductNo":     "ProductIdentifier of ProductIDType 01 replaces PublisherProductNo",
+	"Product/ISMN":                   "ProductIdentifier of ProductIDType 05 replaces ISMN",
+	"Product/DOI":                    "ProductIdentifier of ProductIDType 06 replaces DOI",
+	"ReplacesISBN":                   "RelatedProduct of RelationCode 05 replaces ReplacesISBN",
+	"ReplacesEAN13":                  "RelatedProduct of RelationCode 05 replaces ReplacesEAN13",
+	"Product/DistinctiveTitle":       "Title composite replaces DistinctiveTitle",
+	"Product/TitlePrefix":            "Title composite replaces TitlePrefix",
+	"Product/TitleWithoutPrefix":     "Title composite replaces TitleWithoutPrefix",
+	"Product/ImprintName":            "Imprint composite replaces ImprintName",
+	"Product/PublisherName":          "Publisher composite replaces PublisherName",
+	"Product/LanguageOfText":         "Language composite of LanguageRole 01 replaces LanguageOfText",
+	"Product/OriginalLanguage":       "Language composite of LanguageRole 02 replaces OriginalLanguage",
+	"Product/Height":                 "Measure composite replaces Height",
+	"Product/Width":                  "Measure composite replaces Width",
+	"Product/Thickness":              "Measure composite replaces Thickness",
+	"Product/Weight":                 "Measure composite replaces Weight",
+	"Product/Dimensions":             "Measure composite replaces Dimensions",
+	"Product/Annotation":             "OtherText composite of TextTypeCode 02 replaces Annotation",
+	"Product/MainDescription":        "OtherText composite of TextTypeCode 01 replaces MainDescription",
+	"Product/ReviewQuote":            "OtherText composite of TextTypeCode 08 replaces ReviewQuote",
+	"Product/CoverImageFormatCode":   "MediaFile composite replaces CoverImageFormatCode",
+	"Product/CoverImageLinkTypeCode": "MediaFile composite replaces CoverImageLinkTypeCode",
+	"Product/CoverImageLink":         "MediaFile composite replaces CoverImageLink",
+	"ProductWebsite":                 "Website composite replaces ProductWebsite",
+	"SeriesISSN":                     "SeriesIdentifier of SeriesIDType 02 replaces SeriesISSN",
+	"SupplierSAN":                    "SupplierIdentifier of SupplierIDType 05 replaces SupplierSAN",
+	"SupplierEANLocationNumber":      "SupplierIdentifier of SupplierIDType 04 replaces SupplierEANLocationNumber",
+	"AvailabilityCode":               "ProductAvailability replaces AvailabilityCode",
+}
+
+// deprecatedElements returns elements which are deprecated in version.
+func deprecatedElements(version Version) map[string]string {
+	if version == V3 {
+		return v3.RemovedIn31
+	}
+	return deprecatedV2
+}
@@ -0,0 +1,274 @@
+package onix
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Dialect is a set of tag names which ONIX for Books message is written in.
+type Dialect int
+
+const (
+	// ShortTag is a dialect of short tags such as <b004>.
+	ShortTag Dialect = iota
+	// ReferenceTag is a dialect of reference tags such as <ISBN>.
+	ReferenceTag
+)
+
+// Namespace returns namespace of message of version which is written in dialect.
+func Namespace(version Version, dialect Dialect) (string, bool) {
+	switch {
+	case version == V2 && dialect == ShortTag:
+		return v2.Namespace, true
+	case version == V2 && dialect == ReferenceTag:
+		return v2.ReferenceNamespace, true
+	case version == V3 && dialect == ShortTag:
+		return v3.Namespace, true
+	case version == V3 && dialect == ReferenceTag:
+		return v3.ReferenceNamespace, true
+	}
+	return "", false
+}
+
+// Doctypes of 2.1 refer to the DTD of the dialect, which is the schema of 2.1 that recipients validate against.
+const (
+	// ShortTagDoctype is DOCTYPE of 2.1 in short tags.
+	ShortTagDoctype = `<!DOCTYPE ONIXmessage SYSTEM "http://www.editeur.org/onix/2.1/short/onix-international.dtd">`
+	// ReferenceTagDoctype is DOCTYPE of 2.1 in reference tags.
+	ReferenceTagDoctype = `<!DOCTYPE ONIXMessage SYSTEM "http://www.editeur.org/onix/2.1/reference/onix-international.dtd">`
+)
+
+type marshalConfig struct {
+	dialect   Dialect
+	release31 bool
+	prefix    string
+	indent    string
+	charset   string
+	doctype   bool
+}
+
+// MarshalOption configures how message is marshaled.
+type MarshalOption func(*marshalConfig)
+
+// WithDialect chooses the dialect of tags to emit, which is ShortTag by default.
+func WithDialect(dialect Dialect) MarshalOption {
+	return func(c *marshalConfig) {
+		c.dialect = dialect
+	}
+}
+
+// WithRelease31 marshals message of 3.0 as 3.1 in namespaces of 3.1, where elements removed in 3.1 are dropped.
+// It fails on message which cannot be converted, such as one of DateFormat other than YYYYMMDD, and messages of 2.1 are not affected.
+func WithRelease31() MarshalOption {
+	return func(c *marshalConfig) {
+		c.release31 = true
+	}
+}
+
+// WithIndent writes each element on a new line which begins with prefix and copies of indent by its depth,
+// which is no prefix and two spaces by default. Empty prefix and indent write the message on a line.
+func WithIndent(prefix, indent string) MarshalOption {
+	return func(c *marshalConfig) {
+		c.prefix, c.indent = prefix, indent
+	}
+}
+
+// WithEncoding writes message in charset which XML declaration declares, which is UTF-8 by default.
+// US-ASCII, ISO-8859-1 and windows-1252 are supported as well, where characters which charset lacks are written as character references.
+func WithEncoding(charset string) MarshalOption {
+	return func(c *marshalConfig) {
+		c.charset = charset
+	}
+}
+
+// WithDoctype writes DOCTYPE of the dialect after XML declaration of message of 2.1, whose root element declares no namespace
+// since the DTD does not declare it. Messages of 3.0 are not affected, which are validated against their XML schema.
+func WithDoctype() MarshalOption {
+	return func(c *marshalConfig) {
+		c.doctype = true
+	}
+}
+
+// Marshal returns ONIX for Books message as XML document.
+func Marshal(msg *Message, opts ...MarshalOption) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, msg, opts...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes ONIX for Books message as XML document to w, whose root element declares namespace of the dialect.
+func Encode(w io.Writer, msg *Message, opts ...MarshalOption) error {
+	return NewEncoder(w, opts...).Encode(msg)
+}
+
+// Encoder writes ONIX for Books messages to its output as options have configured.
+type Encoder struct {
+	w      io.Writer
+	config marshalConfig
+}
+
+// NewEncoder returns Encoder which writes messages to w.
+func NewEncoder(w io.Writer, opts ...MarshalOption) *Encoder {
+	config := marshalConfig{dialect: ShortTag, indent: "  ", charset: "UTF-8"}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return &Encoder{w: w, config: config}
+}
+
+// Encode writes msg as XML document, whose root element declares namespace of the dialect unless WithDoctype is passed.
+func (enc *Encoder) Encode(msg *Message) error {
+	config := enc.config
+	w, err := charsetWriter(config.charset, enc.w)
+	if err != nil {
+		return err
+	}
+
+	var (
+		body []byte
+		tags map[string]string
+	)
+	switch msg.Version {
+	case V2:
+		body, err = xml.Marshal(msg.V2)
+		tags = v2.ReferenceTags
+	case V3:
+		if config.release31 {
+			body, err = release31(msg.V3)
+		} else {
+			body, err = xml.Marshal(msg.V3)
+		}
+		tags = v3.ReferenceTags
+	}
+	space, ok := Namespace(msg.Version, config.dialect)
+	if !ok {
+		return fmt.Errorf("unsupported release of ONIX for Books has been passed, got [%s]", msg.Version)
+	}
+	if err != nil {
+		return err
+	}
+	if msg.Version == V3 && config.release31 {
+		space = v3.Namespace31
+		if config.dialect == ReferenceTag {
+			space = v3.ReferenceNamespace31
+		}
+	}
+	if config.dialect == ShortTag {
+		tags = map[string]string{}
+	}
+
+	header := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"%s\"?>\n", config.charset)
+	if config.doctype && msg.Version == V2 {
+		doctype := ShortTagDoctype
+		if config.dialect == ReferenceTag {
+			doctype = ReferenceTagDoctype
+		}
+		header += doctype + "\n"
+		space = ""
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+	out := &emptyElementWriter{w: w}
+	encoder := xml.NewEncoder(out)
+	encoder.Indent(config.prefix, config.indent)
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	root, err := rootElement(decoder)
+	if err != nil {
+		return err
+	}
+	tokens := newTagReader(decoder, root, tags, space)
+	// Extensions of composites are written as they have been decoded.
+	tokens.extensions = true
+	// Start element is held until the next token, so that an element without content is written as self-closing tag.
+	var held *xml.StartElement
+	for {
+		token, err := tokens.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if end, ok := token.(xml.EndElement); ok && held != nil {
+			if err := out.empty(encoder, *held, end); err != nil {
+				return err
+			}
+			held = nil
+			continue
+		}
+		if held != nil {
+			if err := encoder.EncodeToken(*held); err != nil {
+				return err
+			}
+			held = nil
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			held = &start
+			continue
+		}
+		if err := encoder.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+	return encoder.Flush()
+}
+
+// emptyElementWriter writes output of encoder to w, except elements without content
+// which encoding/xml writes as pairs of tags, such as flags, and are rewritten into self-closing tags such as <n386/>.
+type emptyElementWriter struct {
+	w io.Writer
+	// element is output of an element without content while it is encoded.
+	element    []byte
+	collecting bool
+}
+
+func (w *emptyElementWriter) Write(p []byte) (int, error) {
+	if w.collecting {
+		w.element = append(w.element, p...)
+		return len(p), nil
+	}
+	return w.w.Write(p)
+}
+
+// empty writes start which is followed by end directly as self-closing tag along with indentation of encoder.
+func (w *emptyElementWriter) empty(encoder *xml.Encoder, start xml.StartElement, end xml.EndElement) error {
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+	w.element, w.collecting = w.element[:0], true
+	err := encoder.EncodeToken(start)
+	if err == nil {
+		err = encoder.EncodeToken(end)
+	}
+	if err == nil {
+		err = encoder.Flush()
+	}
+	w.collecting = false
+	if err != nil {
+		return err
+	}
+	// Attributes have escaped their brackets, so the last "></" is where the start tag closes.
+	element := w.element
+	if i := bytes.LastIndex(element, []byte("></")); i >= 0 {
+		element = append(element[:i], "/>"...)
+	}
+	_, err = w.w.Write(element)
+	return err
+}
+
+// release31 marshals message of 3.0 converted into 3.1.
+func release31(msg *v3.ONIXMessage) ([]byte, error) {
+	converted, err := v3.ToRelease31(msg)
+	if err != nil {
+		return nil, err
+	}
+	return xml.Marshal(converted)
+}
@@ -0,0 +1,175 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// EventKind is kind of Event which decoding emits.
+type EventKind int
+
+const (
+	// ProductDecoded is a product which has been decoded.
+	ProductDecoded EventKind = iota
+	// CodeWarning is a code which is not defined at codelists and has been kept or skipped by UnknownCodePolicy.
+	CodeWarning
+	// SkippedElement is an element of the other namespace than ONIX for Books which has been skipped, since WithExtensions is not passed.
+	SkippedElement
+	// Progress is emitted by every interval of products which WithProgressInterval sets.
+	Progress
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case ProductDecoded:
+		return "product decoded"
+	case CodeWarning:
+		return "warning"
+	case SkippedElement:
+		return "skipped element"
+	case Progress:
+		return "progress"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event is what has happened during decoding, which the handler of WithEventHandler receives.
+type Event struct {
+	Kind EventKind
+	// Products is number of products which have been decoded so far.
+	Products int
+	// Offset is byte offset of input which has been read so far.
+	Offset int64
+	// RecordReference and Path tell where the event has happened, as the ones of DecodeError do.
+	// Warnings in products are emitted once the product has been read, at path of the product.
+	RecordReference string
+	Path            string
+	// Warning is the code of CodeWarning.
+	Warning *Warning
+	// Element is name of the element of SkippedElement.
+	Element xml.Name
+}
+
+// String returns event as a line of log, such as "progress: 1000 products (offset 5242880)".
+func (e Event) String() string {
+	switch e.Kind {
+	case CodeWarning:
+		return fmt.Sprintf("%s: %s at %s", e.Kind, e.Warning, e.Path)
+	case SkippedElement:
+		return fmt.Sprintf("%s: <%s> of namespace [%s] at %s", e.Kind, e.Element.Local, e.Element.Space, e.Path)
+	case Progress:
+		return fmt.Sprintf("%s: %d products (offset %d)", e.Kind, e.Products, e.Offset)
+	}
+	return fmt.Sprintf("%s: record [%s] at %s", e.Kind, e.RecordReference, e.Path)
+}
+
+// EventHandler receives events of decoding synchronously, so that long-running ingestion jobs surface progress
+// and issues to their own logging system, such as by log.Println(event).
+type EventHandler func(Event)
+
+// DefaultProgressInterval is number of products between Progress events unless WithProgressInterval is passed.
+const DefaultProgressInterval = 1000
+
+// WithEventHandler passes events of Decode and Decoder to handler, which are not emitted by default.
+// DecodeParallel does not emit events, since products are decoded out of order.
+func WithEventHandler(handler EventHandler) DecodeOption {
+	return func(c *decodeConfig) {
+		c.eventHandler = handler
+	}
+}
+
+// WithProgressInterval emits Progress event by every products, which is DefaultProgressInterval by default.
+func WithProgressInterval(products int) DecodeOption {
+	return func(c *decodeConfig) {
+		c.progressInterval = products
+	}
+}
+
+// emitter emits events of decoding and reports them to metrics, which follows locator for where they happen.
+type emitter struct {
+	handler  EventHandler
+	metrics  Metrics
+	interval int
+	l        *locator
+	offset   func() int64
+	// warnings returns warnings which have been collected since the one at from.
+	warnings func(from int) []Warning
+	emitted  int
+	products int
+	// read is byte offset of input which has been reported to metrics so far.
+	read int64
+}
+
+// emitter returns emitter of events of decoding which l follows, or nil unless either of handler or metrics is passed.
+func (c decodeConfig) emitter(l *locator, d *xml.Decoder, warnings func(from int) []Warning) *emitter {
+	if c.eventHandler == nil && c.metrics == nil {
+		return nil
+	}
+	e := &emitter{handler: c.eventHandler, metrics: c.metrics, interval: c.progressInterval, l: l, offset: d.InputOffset, warnings: warnings}
+	if e.interval <= 0 {
+		e.interval = DefaultProgressInterval
+	}
+	l.events = e
+	return e
+}
+
+func (e *emitter) emit(event Event) {
+	if e.handler != nil {
+		e.handler(event)
+	}
+}
+
+// reportRead reports bytes which have been read since the last report to metrics.
+func (e *emitter) reportRead() {
+	offset := e.offset()
+	e.metrics.BytesRead(offset - e.read)
+	e.read = offset
+}
+
+// flushWarnings emits warnings which have been collected since the last flush.
+func (e *emitter) flushWarnings(record, path string) {
+	for _, w := range e.warnings(e.emitted) {
+		w := w
+		e.emitted++
+		if e.metrics != nil {
+			e.metrics.CodeViolation(w.Type)
+		}
+		e.emit(Event{Kind: CodeWarning, Products: e.products, Offset: e.offset(), RecordReference: record, Path: path, Warning: &w})
+	}
+}
+
+// closed emits events of the child of root element at path which has just been read, such as a product.
+func (e *emitter) closed(name, record, path string) {
+	e.flushWarnings(record, path)
+	if name != "Product" {
+		return
+	}
+	e.products++
+	if e.metrics != nil {
+		e.metrics.ProductsDecoded(1)
+		e.reportRead()
+	}
+	e.emit(Event{Kind: ProductDecoded, Products: e.products, Offset: e.offset(), RecordReference: record, Path: path})
+	if e.products%e.interval == 0 {
+		e.emit(Event{Kind: Progress, Products: e.products, Offset: e.offset()})
+	}
+}
+
+// skipped emits SkippedElement of name which is being skipped in the element which is open.
+func (e *emitter) skipped(name xml.Name) {
+	e.emit(Event{Kind: SkippedElement, Products: e.products, Offset: e.offset(), RecordReference: e.l.record, Path: pathOfFrames(e.l.stack) + "/" + name.Local, Element: name})
+}
+
+// done emits warnings which have been collected since the last child of root element, such as the ones of attributes of root element,
+// unless err has stopped decoding, which is reported to metrics along with the rest of input which has been read.
+func (e *emitter) done(err error) {
+	if err == nil {
+		e.flushWarnings("", "")
+	}
+	if e.metrics != nil {
+		e.reportRead()
+		if err != nil {
+			e.metrics.DecodeFailed(ErrorKind(err))
+		}
+	}
+}
@@ -0,0 +1,23 @@
+//go:build gofuzz
+// +build gofuzz
+
+package onix
+
+import "bytes"
+
+// Fuzz is the target of go-fuzz, which decodes data strictly and leniently, in parallel and against the generated models,
+// and marshals what has been decoded again. Malformed input has to be reported as errors rather than panics.
+// Inputs which decode are prioritized by returning 1.
+func Fuzz(data []byte) int {
+	for p := range DecodeParallel(bytes.NewReader(data), 2) {
+		_ = p.Err
+	}
+	Decode(bytes.NewReader(data), WithStrict(false), WithExtensions(), WithUnknownCodePolicy(UnknownCodeKeep))
+	msg, err := Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0
+	}
+	ValidateStructure(bytes.NewReader(data), msg.Version)
+	Marshal(msg)
+	return 1
+}
@@ -0,0 +1,80 @@
+package onix
+
+import (
+	"reflect"
+	"sync"
+)
+
+// internMaxLen is length of the longest strings which are interned, since longer ones such as texts rarely repeat.
+const internMaxLen = 64
+
+// InternPool shares backing memory among identical strings of decoded products, such as names of publishers and imprints,
+// currency codes and subject codes, so that products which are kept in memory hold one copy of each of them.
+// It is safe for concurrent use, and can be shared by decoding of every message of a feed.
+// Strings which have been interned are kept as long as the pool is.
+type InternPool struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+// NewInternPool returns an empty InternPool.
+func NewInternPool() *InternPool {
+	return &InternPool{strings: map[string]string{}}
+}
+
+// Intern returns the string of the pool which is identical to s, which s is added to the pool as unless there is.
+func (p *InternPool) Intern(s string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.intern(s)
+}
+
+// Len returns number of strings of the pool.
+func (p *InternPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.strings)
+}
+
+func (p *InternPool) intern(s string) string {
+	if len(s) > internMaxLen {
+		return s
+	}
+	if interned, ok := p.strings[s]; ok {
+		return interned
+	}
+	p.strings[s] = s
+	return s
+}
+
+// internValue replaces strings of v which is pointer to a decoded model by ones of the pool.
+func (p *InternPool) internValue(v interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.walk(reflect.ValueOf(v))
+}
+
+func (p *InternPool) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(p.intern(v.String()))
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			p.walk(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			p.walk(v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		// Bytes and tokens such as ones of Extensions are left as they are.
+		switch v.Type().Elem().Kind() {
+		case reflect.String, reflect.Ptr, reflect.Struct, reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				p.walk(v.Index(i))
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package onix
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Metrics receives measurements of decoding, which operators export to their monitoring systems such as collectors of Prometheus.
+// Methods are called from workers of DecodeParallel as well, so that implementations have to be safe for concurrent use.
+type Metrics interface {
+	// ProductsDecoded adds n products which have been decoded, whose rate is products decoded per second.
+	ProductsDecoded(n int)
+	// DecodeFailed counts an error which has stopped decoding by its kind, which is one of ErrorKind.
+	DecodeFailed(kind string)
+	// CodeViolation counts a code which codelists do not define by its type, such as ProductForm of List 7.
+	CodeViolation(codeType string)
+	// BytesRead adds n bytes of input which have been read.
+	BytesRead(n int64)
+}
+
+// Kinds of errors which DecodeFailed counts.
+const (
+	// ErrorKindSyntax is malformed XML.
+	ErrorKindSyntax = "syntax"
+	// ErrorKindCode is a code which codelists do not define, which aborts decoding under UnknownCodeError policy.
+	ErrorKindCode = "code"
+	// ErrorKindLimit is a product or nesting beyond WithMaxProductSize or WithMaxDepth.
+	ErrorKindLimit = "limit"
+	// ErrorKindCanceled is context which has been done before the end of message.
+	ErrorKindCanceled = "canceled"
+	// ErrorKindOther is any other error, such as a message of unsupported release.
+	ErrorKindOther = "other"
+)
+
+// ErrorKind returns kind of err which has stopped decoding.
+func ErrorKind(err error) string {
+	var syntaxErr *xml.SyntaxError
+	var codeErr2 *v2.UndefinedCodeError
+	var codeErr3 *v3.UndefinedCodeError
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return ErrorKindCanceled
+	case errors.Is(err, ErrProductTooLarge) || errors.Is(err, ErrTooDeep):
+		return ErrorKindLimit
+	case errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrorKindSyntax
+	case errors.As(err, &codeErr2) || errors.As(err, &codeErr3):
+		return ErrorKindCode
+	}
+	return ErrorKindOther
+}
+
+// WithMetrics reports measurements of Decode, Decoder and DecodeParallel to metrics, which are not measured by default.
+func WithMetrics(metrics Metrics) DecodeOption {
+	return func(c *decodeConfig) {
+		c.metrics = metrics
+	}
+}
+
+// failed reports err which has stopped decoding before any product, if metrics have been passed.
+func (c decodeConfig) failed(err error) {
+	if c.metrics != nil && err != nil {
+		c.metrics.DecodeFailed(ErrorKind(err))
+	}
+}
+
+// Counters is Metrics which counts measurements in memory, and writes them in the text format of Prometheus by WritePrometheus
+// for a handler of /metrics, without depending on the client library.
+type Counters struct {
+	products int64
+	bytes    int64
+
+	mu         sync.Mutex
+	errors     map[string]int64
+	violations map[string]int64
+}
+
+// NewCounters returns Counters of nothing counted.
+func NewCounters() *Counters {
+	return &Counters{errors: map[string]int64{}, violations: map[string]int64{}}
+}
+
+// ProductsDecoded adds n to products which have been decoded.
+func (c *Counters) ProductsDecoded(n int) {
+	atomic.AddInt64(&c.products, int64(n))
+}
+
+// DecodeFailed counts an error of kind.
+func (c *Counters) DecodeFailed(kind string) {
+	c.mu.Lock()
+	c.errors[kind]++
+	c.mu.Unlock()
+}
+
+// CodeViolation counts a code of codeType which codelists do not define.
+func (c *Counters) CodeViolation(codeType string) {
+	c.mu.Lock()
+	c.violations[codeType]++
+	c.mu.Unlock()
+}
+
+// BytesRead adds n to bytes which have been read.
+func (c *Counters) BytesRead(n int64) {
+	atomic.AddInt64(&c.bytes, n)
+}
+
+// Products returns number of products which have been decoded.
+func (c *Counters) Products() int64 {
+	return atomic.LoadInt64(&c.products)
+}
+
+// Bytes returns number of bytes which have been read.
+func (c *Counters) Bytes() int64 {
+	return atomic.LoadInt64(&c.bytes)
+}
+
+// Errors returns numbers of errors by their kinds.
+func (c *Counters) Errors() map[string]int64 {
+	return c.copy(c.errors)
+}
+
+// Violations returns numbers of codes which codelists do not define by their types.
+func (c *Counters) Violations() map[string]int64 {
+	return c.copy(c.violations)
+}
+
+func (c *Counters) copy(counts map[string]int64) map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	copied := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		copied[key] = count
+	}
+	return copied
+}
+
+// WritePrometheus writes the counters to w in the text format of Prometheus, whose names begin with onix_.
+func (c *Counters) WritePrometheus(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# HELP onix_products_decoded_total Products which have been decoded.\n# TYPE onix_products_decoded_total counter\nonix_products_decoded_total %d\n", c.Products())
+	fmt.Fprintf(&b, "# HELP onix_bytes_read_total Bytes of messages which have been read.\n# TYPE onix_bytes_read_total counter\nonix_bytes_read_total %d\n", c.Bytes())
+	b.WriteString("# HELP onix_decode_errors_total Errors which have stopped decoding by their kinds.\n# TYPE onix_decode_errors_total counter\n")
+	writeLabeled(&b, "onix_decode_errors_total", "kind", c.Errors())
+	b.WriteString("# HELP onix_code_violations_total Codes which codelists do not define by their types.\n# TYPE onix_code_violations_total counter\n")
+	writeLabeled(&b, "onix_code_violations_total", "type", c.Violations())
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeLabeled(b *strings.Builder, name, label string, counts map[string]int64) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	for _, key := range keys {
+		fmt.Fprintf(b, "%s{%s=\"%s\"} %d\n", name, label, replacer.Replace(key), counts[key])
+	}
+}
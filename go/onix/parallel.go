@@ -0,0 +1,323 @@
+package onix
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Product is a product of ONIX for Books decoded by the release which message declares.
+type Product struct {
+	Version Version     `json:"version"`
+	V2      *v2.Product `json:"v2,omitempty"`
+	V3      *v3.Product `json:"v3,omitempty"`
+}
+
+// ProductOrError is either a product decoded by DecodeParallel or an error which has stopped decoding the product.
+type ProductOrError struct {
+	Product Product
+	// Warnings are codes of the product which have been kept or skipped by UnknownCodePolicy.
+	Warnings []Warning
+	Err      error
+	// deprecations are elements of the product which are deprecated, which are collected by WithDeprecations.
+	deprecations []Deprecation
+	// header is whether it has been decoded of the header rather than a product, which is sent for deprecations of it only.
+	header bool
+}
+
+type productJob struct {
+	// index is number of the product in message, which begins with 1.
+	index   int
+	tokens  []xml.Token
+	offsets []int64
+	result  chan ProductOrError
+	// header is whether tokens are of the header, which is copied for deprecations of it only.
+	header bool
+}
+
+// DecodeParallel decodes products of message on workers,
+// and sends them to the channel in order of the message.
+// Elements other than products such as header are skipped.
+// The channel is closed when the message ends or a malformed XML is encountered, which is sent as an error.
+// Errors of decoding products are *DecodeError which tell where they have occurred.
+// Warnings which WithWarnings collects and deprecations which WithDeprecations collects are available after the channel is closed.
+// The channel has to be drained, otherwise the workers are left blocked.
+func DecodeParallel(r io.Reader, workers int, opts ...DecodeOption) <-chan ProductOrError {
+	return DecodeParallelContext(context.Background(), r, workers, opts...)
+}
+
+// DecodeParallelContext decodes products as DecodeParallel does, and stops reading message once ctx is done,
+// which is checked between products and sent as the last error of the channel.
+func DecodeParallelContext(ctx context.Context, r io.Reader, workers int, opts ...DecodeOption) <-chan ProductOrError {
+	config := newDecodeConfig(opts)
+	if workers < 1 {
+		workers = 1
+	}
+
+	out := make(chan ProductOrError)
+	jobs := make(chan productJob)
+	queue := make(chan chan ProductOrError, workers*2)
+
+	decoder := config.xmlDecoder(r)
+	root, err := rootElement(decoder)
+	var version Version
+	if err == nil {
+		version, err = DetectVersion(root)
+	}
+	if err == nil {
+		err = config.checkCodeListIssue(version)
+	}
+	if err != nil {
+		config.failed(err)
+		go func() {
+			out <- ProductOrError{Err: err}
+			close(out)
+		}()
+		return out
+	}
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				if job.header {
+					job.result <- deprecationsOf(job, version)
+					continue
+				}
+				job.result <- decodeProduct(job, version, config)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+		tokens := config.tagReader(ctx, decoder, root, version)
+		// Bytes are reported to metrics as products are copied, and the rest of input once it ends.
+		var read int64
+		reportRead := func() {
+			if config.metrics != nil {
+				offset := decoder.InputOffset()
+				config.metrics.BytesRead(offset - read)
+				read = offset
+			}
+		}
+		defer reportRead()
+		// The root element is passed through tagReader once again.
+		if _, err := tokens.Token(); err != nil {
+			failed(queue, err)
+			return
+		}
+		index := 0
+		for {
+			token, err := tokens.Token()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				failed(queue, err)
+				return
+			}
+			start, ok := token.(xml.StartElement)
+			if !ok {
+				continue
+			}
+			if start.Name.Local != "product" {
+				if config.deprecations == nil || start.Name.Local != "header" {
+					if err := skipElement(tokens); err != nil {
+						failed(queue, err)
+						return
+					}
+					continue
+				}
+				element, offsets, err := copyElement(tokens, start)
+				if err != nil {
+					failed(queue, err)
+					return
+				}
+				job := productJob{tokens: element, offsets: offsets, result: make(chan ProductOrError, 1), header: true}
+				queue <- job.result
+				jobs <- job
+				continue
+			}
+			index++
+			element, offsets, err := copyElement(tokens, start)
+			if err != nil {
+				failed(queue, err)
+				return
+			}
+			reportRead()
+			job := productJob{index: index, tokens: element, offsets: offsets, result: make(chan ProductOrError, 1)}
+			queue <- job.result
+			jobs <- job
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for result := range queue {
+			p := <-result
+			if config.deprecations != nil {
+				*config.deprecations = append(*config.deprecations, p.deprecations...)
+			}
+			if p.header {
+				continue
+			}
+			if config.warnings != nil {
+				*config.warnings = append(*config.warnings, p.Warnings...)
+			}
+			if config.metrics != nil {
+				config.report(p)
+			}
+			out <- p
+		}
+	}()
+	return out
+}
+
+// report reports product which has been decoded, or the error which has stopped decoding, to metrics.
+func (c decodeConfig) report(p ProductOrError) {
+	if p.Err != nil {
+		c.metrics.DecodeFailed(ErrorKind(p.Err))
+		return
+	}
+	c.metrics.ProductsDecoded(1)
+	for _, w := range p.Warnings {
+		c.metrics.CodeViolation(w.Type)
+	}
+}
+
+func failed(queue chan chan ProductOrError, err error) {
+	result := make(chan ProductOrError, 1)
+	result <- ProductOrError{Err: err}
+	queue <- result
+}
+
+func decodeProduct(job productJob, version Version, config decodeConfig) ProductOrError {
+	r := &sliceReader{tokens: job.tokens, offsets: job.offsets}
+	var l *locator
+	switch version {
+	case V2:
+		l = newLocator(r, v2.ReferenceTags)
+	case V3:
+		l = newLocator(r, v3.ReferenceTags)
+	}
+	// The product is located in message by the number of products which precede it.
+	l.stack = append(l.stack, locatorFrame{name: "ONIXMessage", children: map[string]int{"Product": job.index - 1}})
+	if config.deprecations != nil {
+		l.deprecated = deprecatedElements(version)
+	}
+	d := xml.NewTokenDecoder(l)
+	p := ProductOrError{Product: Product{Version: version}}
+	switch version {
+	case V2:
+		var ws []v2.Warning
+		release := v2.ConfigureDecoder(d, v2.WithUnknownCodePolicy(v2.UnknownCodePolicy(config.unknownCodePolicy)), v2.WithWarnings(&ws))
+		p.Product.V2 = &v2.Product{}
+		p.Err = d.Decode(p.Product.V2)
+		release()
+		for _, w := range ws {
+			p.Warnings = append(p.Warnings, Warning(w))
+		}
+	case V3:
+		var ws []v3.Warning
+		release := v3.ConfigureDecoder(d, v3.WithUnknownCodePolicy(v3.UnknownCodePolicy(config.unknownCodePolicy)), v3.WithWarnings(&ws))
+		p.Product.V3 = &v3.Product{}
+		p.Err = d.Decode(p.Product.V3)
+		release()
+		for _, w := range ws {
+			p.Warnings = append(p.Warnings, Warning(w))
+		}
+	}
+	p.deprecations = l.deprecations
+	if p.Err != nil {
+		p.Err = l.wrap(p.Err, r.offset)
+		p.Product = Product{Version: version}
+	} else if config.internPool != nil {
+		config.internPool.internValue(&p.Product)
+	}
+	return p
+}
+
+// deprecationsOf collects deprecated elements of the header which job has copied.
+func deprecationsOf(job productJob, version Version) ProductOrError {
+	var l *locator
+	switch version {
+	case V2:
+		l = newLocator(&sliceReader{tokens: job.tokens, offsets: job.offsets}, v2.ReferenceTags)
+	case V3:
+		l = newLocator(&sliceReader{tokens: job.tokens, offsets: job.offsets}, v3.ReferenceTags)
+	}
+	l.stack = append(l.stack, locatorFrame{name: "ONIXMessage"})
+	l.deprecated = deprecatedElements(version)
+	for {
+		if _, err := l.Token(); err != nil {
+			return ProductOrError{deprecations: l.deprecations, header: true}
+		}
+	}
+}
+
+// copyElement copies tokens of the element which start has started, since tokens of xml.Decoder are valid only until the next call.
+// Offsets of input where each token ends are copied along with them.
+func copyElement(r *tagReader, start xml.StartElement) ([]xml.Token, []int64, error) {
+	tokens := []xml.Token{start.Copy()}
+	offsets := []int64{r.decoder.InputOffset()}
+	depth := 1
+	for depth > 0 {
+		token, err := r.Token()
+		if err == io.EOF {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		tokens = append(tokens, xml.CopyToken(token))
+		offsets = append(offsets, r.decoder.InputOffset())
+	}
+	return tokens, offsets, nil
+}
+
+func skipElement(r xml.TokenReader) error {
+	depth := 1
+	for depth > 0 {
+		token, err := r.Token()
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// sliceReader reads tokens which copyElement has copied, and keeps offset of input where the last token ends.
+type sliceReader struct {
+	tokens  []xml.Token
+	offsets []int64
+	offset  int64
+}
+
+func (r *sliceReader) Token() (xml.Token, error) {
+	if len(r.tokens) == 0 {
+		return nil, io.EOF
+	}
+	token := r.tokens[0]
+	r.tokens = r.tokens[1:]
+	r.offset, r.offsets = r.offsets[0], r.offsets[1:]
+	return token, nil
+}
@@ -0,0 +1,356 @@
+package onix
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// StructureError is a part of message which does not conform to the structure of the generated models.
+type StructureError struct {
+	Line   int
+	Column int
+	// Path is XPath-like location of the element such as /ONIXMessage/Product[1]/ProductIdentifier[2].
+	Path    string
+	Message string
+}
+
+func (e StructureError) Error() string {
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+// ValidateStructure reports elements, attributes and codes of message which the generated models of the release do not allow,
+// which is checking of the shape of the Go structs rather than validation against the XSD, so that both of reference tags and short tags are accepted.
+// Fields are required or repeatable as the generator derives them from minOccurs and maxOccurs,
+// and each alternative of a choice is an optional field, so that elements of two alternatives are not reported.
+// Elements out of order are reported for 3.0 only, whose models have been generated in order of the schema.
+// Message is read as it is validated, so that a huge message is not held in memory.
+func ValidateStructure(r io.Reader, version Version) []StructureError {
+	v := structureValidator{input: &positionReader{r: bufio.NewReader(UTF8Reader(r)), last: -1, previous: -1}, models: map[reflect.Type]structureModel{}}
+	switch version {
+	case V2:
+		v.root, v.shortTags, v.referenceTags = reflect.TypeOf(v2.ONIXMessage{}), v2.ShortTags, v2.ReferenceTags
+	case V3:
+		v.root, v.shortTags, v.referenceTags = reflect.TypeOf(v3.ONIXMessage{}), v3.ShortTags, v3.ReferenceTags
+		v.ordered = true
+	default:
+		return []StructureError{{Message: fmt.Sprintf("unsupported release of ONIX for Books has been passed, got [%s]", version)}}
+	}
+	v.decoder = xml.NewDecoder(v.input)
+	// Offsets of the decoder are the ones of input which has been converted on XML declaration, whose lines are counted from then on.
+	v.decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		converted, err := CharsetReader(charset, input)
+		if err != nil {
+			return nil, err
+		}
+		v.input = v.input.switchTo(converted)
+		return v.input, nil
+	}
+	v.validate()
+	sort.SliceStable(v.errs, func(i, j int) bool {
+		if v.errs[i].Line != v.errs[j].Line {
+			return v.errs[i].Line < v.errs[j].Line
+		}
+		return v.errs[i].Column < v.errs[j].Column
+	})
+	return v.errs
+}
+
+type structureValidator struct {
+	decoder       *xml.Decoder
+	input         *positionReader
+	root          reflect.Type
+	shortTags     map[string]string
+	referenceTags map[string]string
+	models        map[reflect.Type]structureModel
+	// ordered is whether fields of models are in order of the schema.
+	ordered bool
+	errs    []StructureError
+}
+
+// structureFrame is a composite which is being validated.
+type structureFrame struct {
+	model    structureModel
+	path     string
+	position position
+	seen     map[string]int
+	// last is short name of the element of frame which has been the latest in order of the model.
+	last string
+}
+
+// structureModel is what the generated struct allows as elements and attributes.
+type structureModel struct {
+	name     string
+	elements map[string]structureField
+	attrs    map[string]structureField
+	// order holds short names of elements and attributes to report them in order of the model.
+	order []string
+	// positions are indexes of elements in order of the model by their short names.
+	positions map[string]int
+	// text is whether the model holds text such as PlainText.
+	text bool
+}
+
+type structureField struct {
+	name     string
+	typ      reflect.Type
+	iterable bool
+	required bool
+}
+
+var unmarshaler = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+
+func (v *structureValidator) validate() {
+	var stack []*structureFrame
+	for {
+		at := v.input.position(v.decoder.InputOffset())
+		token, err := v.decoder.Token()
+		if err == io.EOF {
+			if len(stack) > 0 {
+				v.report(at, stack[len(stack)-1].path, "document ends before the element is closed")
+			}
+			return
+		}
+		if err != nil {
+			v.report(at, pathOf(stack), err.Error())
+			return
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := v.short(t.Name.Local)
+			if len(stack) == 0 {
+				root := v.model(v.root, "ONIXMessage")
+				if name != v.short(root.name) {
+					v.report(at, "/"+t.Name.Local, fmt.Sprintf("root element must be %s", root.name))
+					return
+				}
+				frame := &structureFrame{model: root, path: "/" + root.name, position: at, seen: map[string]int{}}
+				v.attrs(frame, t.Attr)
+				stack = append(stack, frame)
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if len(stack) >= DefaultMaxDepth {
+				v.report(at, parent.path, fmt.Sprintf("%s, over %d levels", ErrTooDeep, DefaultMaxDepth))
+				return
+			}
+			field, ok := parent.model.elements[name]
+			if !ok {
+				v.report(at, parent.path+"/"+t.Name.Local, fmt.Sprintf("element is not allowed in %s", parent.model.name))
+				if err := v.decoder.Skip(); err != nil {
+					v.report(v.input.position(v.decoder.InputOffset()), parent.path, err.Error())
+					return
+				}
+				continue
+			}
+			parent.seen[name]++
+			path := parent.path + "/" + field.name
+			if v.ordered && parent.last != "" && parent.model.positions[name] < parent.model.positions[parent.last] {
+				v.report(at, path, fmt.Sprintf("element must precede %s in %s", parent.model.elements[parent.last].name, parent.model.name))
+			} else {
+				parent.last = name
+			}
+			if field.iterable {
+				path = fmt.Sprintf("%s[%d]", path, parent.seen[name])
+			} else if parent.seen[name] > 1 {
+				v.report(at, path, "element occurs more than once")
+			}
+			if field.typ.Kind() == reflect.Struct && !reflect.PtrTo(field.typ).Implements(unmarshaler) {
+				frame := &structureFrame{model: v.model(field.typ, field.name), path: path, position: at, seen: map[string]int{}}
+				v.attrs(frame, t.Attr)
+				stack = append(stack, frame)
+				continue
+			}
+			if err := v.decoder.DecodeElement(reflect.New(field.typ).Interface(), &t); err != nil {
+				if _, ok := err.(*xml.SyntaxError); ok {
+					v.report(v.input.position(v.decoder.InputOffset()), path, err.Error())
+					return
+				}
+				v.report(at, path, err.Error())
+			}
+		case xml.EndElement:
+			frame := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, short := range frame.model.order {
+				if field, ok := frame.model.elements[short]; ok && field.required && frame.seen[short] == 0 {
+					v.report(frame.position, frame.path, fmt.Sprintf("element %s is required", field.name))
+				}
+			}
+		case xml.CharData:
+			if len(stack) > 0 && !stack[len(stack)-1].model.text && len(bytes.TrimSpace(t)) > 0 {
+				frame := stack[len(stack)-1]
+				v.report(at, frame.path, fmt.Sprintf("text is not allowed in %s", frame.model.name))
+			}
+		}
+	}
+}
+
+func (v *structureValidator) attrs(frame *structureFrame, attrs []xml.Attr) {
+	seen := map[string]bool{}
+	for _, attr := range attrs {
+		if attr.Name.Space != "" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		field, ok := frame.model.attrs[attr.Name.Local]
+		if !ok {
+			v.report(frame.position, frame.path+"/@"+attr.Name.Local, fmt.Sprintf("attribute is not allowed in %s", frame.model.name))
+			continue
+		}
+		seen[attr.Name.Local] = true
+		if !reflect.PtrTo(field.typ).Implements(unmarshaler) {
+			continue
+		}
+		var escaped bytes.Buffer
+		if err := xml.EscapeText(&escaped, []byte(attr.Value)); err != nil {
+			v.report(frame.position, frame.path+"/@"+attr.Name.Local, err.Error())
+			continue
+		}
+		src := "<" + attr.Name.Local + ">" + escaped.String() + "</" + attr.Name.Local + ">"
+		if err := xml.Unmarshal([]byte(src), reflect.New(field.typ).Interface()); err != nil {
+			v.report(frame.position, frame.path+"/@"+attr.Name.Local, err.Error())
+		}
+	}
+	for _, short := range frame.model.order {
+		if field, ok := frame.model.attrs[short]; ok && field.required && !seen[short] {
+			v.report(frame.position, frame.path, fmt.Sprintf("attribute %s is required", field.name))
+		}
+	}
+}
+
+// model reads elements and attributes which t allows from tags of its fields.
+func (v *structureValidator) model(t reflect.Type, name string) structureModel {
+	if m, ok := v.models[t]; ok {
+		m.name = name
+		return m
+	}
+	m := structureModel{name: name, elements: map[string]structureField{}, attrs: map[string]structureField{}, positions: map[string]int{}}
+	v.fields(&m, t)
+	v.models[t] = m
+	return m
+}
+
+// fields reads fields of t into m, where fields of embedded struct such as Attributes are of t itself.
+func (v *structureValidator) fields(m *structureModel, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			v.fields(m, f.Type)
+			continue
+		}
+		tag := f.Tag.Get("xml")
+		if strings.HasPrefix(tag, ",chardata") {
+			m.text = true
+			continue
+		}
+		if tag == "" || tag == "-" || strings.HasPrefix(tag, ",") {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		field := structureField{name: opts[0], typ: f.Type, required: true}
+		if ref, ok := v.referenceTags[opts[0]]; ok {
+			field.name = ref
+		}
+		attr := false
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "omitempty":
+				field.required = false
+			case "attr":
+				attr = true
+			}
+		}
+		switch field.typ.Kind() {
+		case reflect.Ptr:
+			field.typ = field.typ.Elem()
+		case reflect.Slice:
+			field.typ, field.iterable = field.typ.Elem(), true
+		}
+		if attr {
+			m.attrs[opts[0]] = field
+		} else {
+			m.positions[opts[0]] = len(m.elements)
+			m.elements[opts[0]] = field
+		}
+		m.order = append(m.order, opts[0])
+	}
+}
+
+func (v *structureValidator) short(name string) string {
+	if short, ok := v.shortTags[name]; ok {
+		return short
+	}
+	return name
+}
+
+func (v *structureValidator) report(at position, path, message string) {
+	v.errs = append(v.errs, StructureError{Line: at.line, Column: at.column, Path: path, Message: message})
+}
+
+// position is line and column of message, which begin with 1.
+type position struct {
+	line, column int
+}
+
+// positionReader counts line feeds of message as xml.Decoder reads it byte by byte,
+// so that offsets of the decoder are located in lines without holding message.
+type positionReader struct {
+	r      *bufio.Reader
+	offset int64
+	lines  int
+	// last and previous are offsets of the last two line feeds, since the decoder reads a byte ahead at most.
+	last, previous int64
+}
+
+func (r *positionReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	for _, c := range p[:n] {
+		r.count(c)
+	}
+	return n, err
+}
+
+func (r *positionReader) ReadByte() (byte, error) {
+	c, err := r.r.ReadByte()
+	if err == nil {
+		r.count(c)
+	}
+	return c, err
+}
+
+func (r *positionReader) count(c byte) {
+	if c == '\n' {
+		r.lines++
+		r.last, r.previous = r.offset, r.last
+	}
+	r.offset++
+}
+
+// switchTo returns positionReader of converted which continues counting of r, where converted reads the rest of message from r.
+func (r *positionReader) switchTo(converted io.Reader) *positionReader {
+	next := *r
+	next.r = bufio.NewReader(converted)
+	return &next
+}
+
+// position returns line and column of offset, which is either the one which has been read last or the one before it.
+func (r *positionReader) position(offset int64) position {
+	if offset > r.last {
+		return position{line: r.lines + 1, column: int(offset - r.last)}
+	}
+	return position{line: r.lines, column: int(offset - r.previous)}
+}
+
+func pathOf(stack []*structureFrame) string {
+	if len(stack) == 0 {
+		return ""
+	}
+	return stack[len(stack)-1].path
+}
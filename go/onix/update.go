@@ -0,0 +1,68 @@
+package onix
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// blocks are fields of product of 3.0 which are replaced as a whole by block update.
+var blocks = []string{
+	"DescriptiveDetail",
+	"CollateralDetail",
+	"PromotionDetail",
+	"ContentDetail",
+	"PublishingDetail",
+	"RelatedMaterial",
+	"ProductSupplys",
+}
+
+// ApplyUpdate applies update to existing, which is a block update of NotificationType 04 in release 3.0.
+// A block which update has replaces the one of existing as a whole, and the others are retained.
+// An empty block deletes the one of existing, and every ProductSupply is replaced together.
+// Neither existing nor update is modified, though the result shares blocks with them.
+func ApplyUpdate(existing, update *Product) (*Product, error) {
+	if existing == nil || update == nil {
+		return nil, fmt.Errorf("both of existing and update products are required")
+	}
+	if existing.Version != update.Version {
+		return nil, fmt.Errorf("release of update must be the one of existing product, got [%s] and [%s]", update.Version, existing.Version)
+	}
+	if existing.Version != V3 {
+		return nil, fmt.Errorf("block update is not defined in release [%s]", existing.Version)
+	}
+	if existing.V3 == nil || update.V3 == nil {
+		return nil, fmt.Errorf("both of existing and update products of 3.0 are required")
+	}
+
+	p := *existing.V3
+	current := reflect.ValueOf(&p).Elem()
+	next := reflect.ValueOf(update.V3).Elem()
+	for _, name := range blocks {
+		block := next.FieldByName(name)
+		switch block.Kind() {
+		case reflect.Ptr:
+			if block.IsNil() {
+				continue
+			}
+			if block.Elem().IsZero() {
+				current.FieldByName(name).Set(reflect.Zero(block.Type()))
+				continue
+			}
+		case reflect.Slice:
+			if block.Len() == 0 {
+				continue
+			}
+		}
+		current.FieldByName(name).Set(block)
+	}
+	if update.V3.Datestamp != nil {
+		p.Datestamp = update.V3.Datestamp
+	}
+	if update.V3.Sourcetype != nil {
+		p.Sourcetype = update.V3.Sourcetype
+	}
+	if update.V3.Sourcename != nil {
+		p.Sourcename = update.V3.Sourcename
+	}
+	return &Product{Version: V3, V3: &p}, nil
+}
@@ -0,0 +1,44 @@
+package onix
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Version is a release of ONIX for Books.
+type Version string
+
+const (
+	// V2 is ONIX for Books Release 2.1.
+	V2 Version = "2.1"
+	// V3 is ONIX for Books Release 3.0.
+	V3 Version = "3.0"
+)
+
+// DetectVersion detects release of ONIX for Books from the root element of message.
+// The release attribute takes precedence over namespace,
+// and a message without both of them is regarded as 2.1 since its release attribute is optional.
+// Message of 3.1 is regarded as 3.0, whose model it is decoded into.
+func DetectVersion(root xml.StartElement) (Version, error) {
+	for _, attr := range root.Attr {
+		if attr.Name.Local != "release" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(attr.Value, "2."):
+			return V2, nil
+		case strings.HasPrefix(attr.Value, "3."):
+			return V3, nil
+		default:
+			return "", fmt.Errorf("unsupported release of ONIX for Books has been passed, got [%s]", attr.Value)
+		}
+	}
+	switch {
+	case strings.Contains(root.Name.Space, "/onix/3.0/"), strings.Contains(root.Name.Space, "/onix/3.1/"):
+		return V3, nil
+	case strings.Contains(root.Name.Space, "/onix/2.1/"):
+		return V2, nil
+	}
+	return V2, nil
+}
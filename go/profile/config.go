@@ -0,0 +1,166 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+// Config is a profile which is defined in JSON, so that requirements of trading partners are loaded at runtime.
+//
+//	{
+//	  "name": "Acme",
+//	  "extends": "BIC Basic",
+//	  "rules": [
+//	    {"name": "Binding", "path": "ProductForm", "required": true, "codes": ["BB", "BC"]},
+//	    {"name": "AcmeID", "path": "ProductIdentifier[ProductIDType=01]/IDValue", "pattern": "^AC[0-9]{6}$", "severity": "warning"}
+//	  ]
+//	}
+type Config struct {
+	Name string `json:"name"`
+	// Extends is name of a shipped profile whose rules precede the rules, which replace its rules of the same names.
+	Extends string       `json:"extends,omitempty"`
+	Rules   []RuleConfig `json:"rules"`
+}
+
+// RuleConfig is a rule of Config on elements at Path, which are checked by every constraint which it has.
+type RuleConfig struct {
+	Name string `json:"name"`
+	// Severity is either error, which is the default, or warning.
+	Severity string `json:"severity,omitempty"`
+	// Message replaces the message which is built from constraints.
+	Message string `json:"message,omitempty"`
+	Path    string `json:"path"`
+	// Required requires at least one element at path whose value is not empty.
+	Required bool `json:"required,omitempty"`
+	// Codes are codes which elements at path are allowed to have, where lists of codes separated by spaces are allowed by each code.
+	Codes []string `json:"codes,omitempty"`
+	// Pattern is regular expression which values of elements at path have to match.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Shipped are shipped profiles by their names, which configs extend.
+var Shipped = map[string]Profile{
+	BICBasic.Name:         BICBasic,
+	BISGBestPractice.Name: BISGBestPractice,
+}
+
+// LoadConfig reads Config in JSON from r, and returns the profile which it defines.
+func LoadConfig(r io.Reader) (Profile, error) {
+	var c Config
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&c); err != nil {
+		return Profile{}, err
+	}
+	return c.Profile()
+}
+
+// LoadConfigFile reads Config in JSON from file at path.
+func LoadConfigFile(path string) (Profile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Profile{}, err
+	}
+	defer file.Close()
+	pr, err := LoadConfig(file)
+	if err != nil {
+		return Profile{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return pr, nil
+}
+
+// Profile returns the profile which c defines, which fails for unknown profiles to extend and malformed rules.
+func (c Config) Profile() (Profile, error) {
+	base := Profile{Name: c.Name}
+	if c.Extends != "" {
+		shipped, ok := Shipped[c.Extends]
+		if !ok {
+			return Profile{}, fmt.Errorf("unexpected profile to extend has been passed, got [%s]", c.Extends)
+		}
+		base = shipped
+		base.Name = c.Name
+	}
+	var rules []Rule
+	for i, rc := range c.Rules {
+		r, err := rc.Rule()
+		if err != nil {
+			return Profile{}, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rules = append(rules, r)
+	}
+	return base.With(rules...), nil
+}
+
+// Rule returns the rule which rc defines.
+func (rc RuleConfig) Rule() (Rule, error) {
+	if rc.Name == "" {
+		return Rule{}, fmt.Errorf("name of rule is missing")
+	}
+	path, err := ParsePath(rc.Path)
+	if err != nil {
+		return Rule{}, err
+	}
+	r := Rule{Name: rc.Name, Message: rc.Message}
+	switch rc.Severity {
+	case "", "error":
+		r.Severity = Error
+	case "warning":
+		r.Severity = Warning
+	default:
+		return Rule{}, fmt.Errorf("unexpected severity has been passed, got [%s]", rc.Severity)
+	}
+	var pattern *regexp.Regexp
+	if rc.Pattern != "" {
+		if pattern, err = regexp.Compile(rc.Pattern); err != nil {
+			return Rule{}, err
+		}
+	}
+	if !rc.Required && rc.Codes == nil && pattern == nil {
+		return Rule{}, fmt.Errorf("rule [%s] has no constraint", rc.Name)
+	}
+	var constraints []string
+	if rc.Required {
+		constraints = append(constraints, "is required")
+	}
+	if rc.Codes != nil {
+		constraints = append(constraints, "has to be any of "+strings.Join(rc.Codes, ", "))
+	}
+	if pattern != nil {
+		constraints = append(constraints, "has to match "+rc.Pattern)
+	}
+	if r.Message == "" {
+		r.Message = fmt.Sprintf("%s %s", path, strings.Join(constraints, " and "))
+	}
+	allowed := map[string]bool{}
+	for _, code := range rc.Codes {
+		allowed[code] = true
+	}
+	r.Check = func(p v2.Product) bool {
+		values := path.Values(p)
+		present := false
+		for _, v := range values {
+			if v == "" {
+				continue
+			}
+			present = true
+			if rc.Codes != nil {
+				for _, code := range strings.Fields(v) {
+					if !allowed[code] {
+						return false
+					}
+				}
+			}
+			if pattern != nil && !pattern.MatchString(v) {
+				return false
+			}
+		}
+		return present || !rc.Required
+	}
+	return r, nil
+}
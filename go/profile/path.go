@@ -0,0 +1,163 @@
+package profile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+// Path is location of elements in a product by reference names, such as ProductIdentifier[ProductIDType=01]/IDValue,
+// where a step can pick elements whose child has a value, and leading /Product is optional.
+type Path struct {
+	source string
+	steps  []step
+}
+
+type step struct {
+	name string
+	// key and value are the child and its value which elements are picked by, which are empty unless the step has a predicate.
+	key, value string
+}
+
+func (p Path) String() string {
+	return p.source
+}
+
+// ParsePath parses path of elements of product, which fails for elements which the model does not have.
+func ParsePath(path string) (Path, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "/"), "Product/")
+	if trimmed == "" {
+		return Path{}, fmt.Errorf("empty path has been passed")
+	}
+	parsed := Path{source: path}
+	t := reflect.TypeOf(v2.Product{})
+	for _, s := range strings.Split(trimmed, "/") {
+		st := step{name: s}
+		if i := strings.IndexByte(s, '['); i >= 0 {
+			predicate := strings.TrimSuffix(s[i+1:], "]")
+			j := strings.IndexByte(predicate, '=')
+			if !strings.HasSuffix(s, "]") || j < 0 {
+				return Path{}, fmt.Errorf("malformed predicate of path [%s] has been passed, got [%s]", path, s)
+			}
+			st = step{name: s[:i], key: strings.TrimSpace(predicate[:j]), value: strings.TrimSpace(predicate[j+1:])}
+		}
+		f, ok := fieldsOf(t)[st.name]
+		if !ok {
+			return Path{}, fmt.Errorf("unexpected element of path [%s] has been passed, got [%s]", path, st.name)
+		}
+		child := elemType(t.Field(f).Type)
+		if st.key != "" {
+			if _, ok := fieldsOf(child)[st.key]; child.Kind() != reflect.Struct || !ok {
+				return Path{}, fmt.Errorf("unexpected child of predicate of path [%s] has been passed, got [%s]", path, st.key)
+			}
+		}
+		parsed.steps = append(parsed.steps, st)
+		t = child
+	}
+	return parsed, nil
+}
+
+// Values returns values of elements at path in p, which are codes for code types and text for the others.
+func (p Path) Values(product v2.Product) []string {
+	current := []reflect.Value{reflect.ValueOf(product)}
+	for _, st := range p.steps {
+		var next []reflect.Value
+		for _, v := range current {
+			for _, child := range childrenOf(v, st.name) {
+				if st.key != "" && !hasValue(childrenOf(child, st.key), st.value) {
+					continue
+				}
+				next = append(next, child)
+			}
+		}
+		current = next
+	}
+	values := make([]string, 0, len(current))
+	for _, v := range current {
+		values = append(values, textOf(v))
+	}
+	return values
+}
+
+// fieldCache are indexes of fields of struct types by reference names of their elements.
+var fieldCache sync.Map
+
+func fieldsOf(t reflect.Type) map[string]int {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.(map[string]int)
+	}
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if ref, ok := v2.ReferenceTags[name]; ok {
+			name = ref
+		}
+		fields[name] = i
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
+
+// childrenOf returns present elements of name in v.
+func childrenOf(v reflect.Value, name string) []reflect.Value {
+	f, ok := fieldsOf(v.Type())[name]
+	if !ok {
+		return nil
+	}
+	field := v.Field(f)
+	switch field.Kind() {
+	case reflect.Ptr:
+		if field.IsNil() {
+			return nil
+		}
+		return []reflect.Value{field.Elem()}
+	case reflect.Slice:
+		children := make([]reflect.Value, field.Len())
+		for i := range children {
+			children[i] = field.Index(i)
+		}
+		return children
+	}
+	return []reflect.Value{field}
+}
+
+func hasValue(values []reflect.Value, value string) bool {
+	for _, v := range values {
+		if textOf(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// textOf returns content of element of v, which is the code for code types since they marshal into their codes.
+func textOf(v reflect.Value) string {
+	b, err := xml.Marshal(v.Interface())
+	if err != nil {
+		return ""
+	}
+	var text struct {
+		Value string `xml:",chardata"`
+	}
+	if err := xml.Unmarshal(b, &text); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(text.Value)
+}
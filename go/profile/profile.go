@@ -0,0 +1,152 @@
+// Package profile validates products of ONIX for Books 2.1 against profiles of industry bodies, such as BIC Basic,
+// which require a minimum viable product record stricter than the schema.
+// Profiles are sets of rules, so that trading partners can extend shipped profiles or compose their own.
+package profile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+// Severity is how seriously a product breaks a rule.
+type Severity int
+
+const (
+	// Error is a rule which a product has to satisfy to be accepted.
+	Error Severity = iota
+	// Warning is a rule which a product should satisfy.
+	Warning
+)
+
+func (s Severity) String() string {
+	if s == Warning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Rule is a requirement of a profile on a product.
+type Rule struct {
+	// Name identifies the rule, such as Description.
+	Name     string
+	Severity Severity
+	// Message tells what the product misses when it breaks the rule.
+	Message string
+	// Check reports whether the product satisfies the rule, where rule without Check is always broken.
+	Check func(v2.Product) bool
+}
+
+// Profile is a set of rules which products are validated against.
+type Profile struct {
+	Name  string
+	Rules []Rule
+}
+
+// With returns a copy of the profile with rules, which replace the rules of the same names.
+func (pr Profile) With(rules ...Rule) Profile {
+	extended := Profile{Name: pr.Name}
+	replaced := map[string]Rule{}
+	for _, r := range rules {
+		replaced[r.Name] = r
+	}
+	for _, r := range pr.Rules {
+		if replacement, ok := replaced[r.Name]; ok {
+			r = replacement
+			delete(replaced, r.Name)
+		}
+		extended.Rules = append(extended.Rules, r)
+	}
+	for _, r := range rules {
+		if _, ok := replaced[r.Name]; ok {
+			extended.Rules = append(extended.Rules, r)
+		}
+	}
+	return extended
+}
+
+// Without returns a copy of the profile without rules of names.
+func (pr Profile) Without(names ...string) Profile {
+	without := Profile{Name: pr.Name}
+	for _, r := range pr.Rules {
+		omitted := false
+		for _, name := range names {
+			omitted = omitted || r.Name == name
+		}
+		if !omitted {
+			without.Rules = append(without.Rules, r)
+		}
+	}
+	return without
+}
+
+// Violation is a rule which a product breaks.
+type Violation struct {
+	// RecordReference identifies the product in its feed.
+	RecordReference string
+	Rule            string
+	Severity        Severity
+	Message         string
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("%s: %s in record [%s] (%s)", v.Severity, v.Message, v.RecordReference, v.Rule)
+}
+
+// Check returns rules of the profile which p breaks, in order of rules.
+func (pr Profile) Check(p v2.Product) []Violation {
+	var violations []Violation
+	ref := strings.TrimSpace(p.RecordReference.Body)
+	for _, r := range pr.Rules {
+		if r.Check != nil && r.Check(p) {
+			continue
+		}
+		violations = append(violations, Violation{RecordReference: ref, Rule: r.Name, Severity: r.Severity, Message: r.Message})
+	}
+	return violations
+}
+
+// Validate returns rules of profile which products of msg break, in order of products.
+// Products which are deleted are not validated, since notifications of deletion carry only their identifiers.
+func Validate(msg *v2.ONIXMessage, profile Profile) []Violation {
+	var violations []Violation
+	for _, p := range msg.Products {
+		if p.IsDelete() {
+			continue
+		}
+		violations = append(violations, profile.Check(p)...)
+	}
+	return violations
+}
+
+// ValidateReader reads message from r product by product, and returns rules of profile which products break as Validate does.
+func ValidateReader(r io.Reader, profile Profile, opts ...v2.Option) ([]Violation, error) {
+	reader := v2.NewReader(r, opts...)
+	defer reader.Close()
+	var violations []Violation
+	for {
+		p, err := reader.Next()
+		if err == io.EOF {
+			return violations, nil
+		}
+		if err != nil {
+			return violations, err
+		}
+		if !p.IsDelete() {
+			violations = append(violations, profile.Check(*p)...)
+		}
+	}
+}
+
+// Errors returns violations of Error severity among violations.
+func Errors(violations []Violation) []Violation {
+	var errs []Violation
+	for _, v := range violations {
+		if v.Severity == Error {
+			errs = append(errs, v)
+		}
+	}
+	return errs
+}
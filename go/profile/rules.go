@@ -0,0 +1,95 @@
+package profile
+
+import (
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/subjects"
+)
+
+// Rules which shipped profiles are composed of.
+var (
+	// ISBN requires ISBN-13 of product.
+	ISBN = Rule{Name: "ISBN", Message: "ISBN-13 is missing", Check: func(p v2.Product) bool { _, ok := p.ISBN13(); return ok }}
+	// Title requires distinctive title of product.
+	Title = Rule{Name: "Title", Message: "distinctive title is missing", Check: func(p v2.Product) bool { _, ok := p.Title(); return ok }}
+	// Contributor requires a contributor of product, or NoContributor which tells that it has none.
+	Contributor = Rule{Name: "Contributor", Message: "contributor or NoContributor is missing", Check: func(p v2.Product) bool {
+		return len(p.Contributors) > 0 || bool(p.NoContributor)
+	}}
+	// Form requires primary form of product.
+	Form = Rule{Name: "Form", Message: "product form is missing", Check: func(p v2.Product) bool { _, ok := p.Form(); return ok }}
+	// Publisher requires name of publisher of product.
+	Publisher = Rule{Name: "Publisher", Message: "publisher is missing", Check: func(p v2.Product) bool { _, ok := p.Publisher(); return ok }}
+	// PublishingStatus requires status of publishing of product.
+	PublishingStatus = Rule{Name: "PublishingStatus", Message: "publishing status is missing", Check: func(p v2.Product) bool { return p.PublishingStatus != nil }}
+	// PublicationDate requires date of publication of product.
+	PublicationDate = Rule{Name: "PublicationDate", Message: "publication date is missing", Check: func(p v2.Product) bool { _, ok := p.PublishedOn(); return ok }}
+	// SalesRights requires sales rights of product, or NotForSale composites which tell where it is not for sale.
+	SalesRights = Rule{Name: "SalesRights", Message: "sales rights are missing", Check: func(p v2.Product) bool {
+		return len(p.SalesRightss) > 0 || len(p.NotForSales) > 0
+	}}
+	// Description requires main, long or short description of product.
+	Description = Rule{Name: "Description", Message: "description is missing", Check: func(p v2.Product) bool { _, ok := p.Description(); return ok }}
+	// CoverImage requires image of front cover of product.
+	CoverImage = Rule{Name: "CoverImage", Message: "cover image is missing", Check: func(p v2.Product) bool { _, ok := p.CoverImageURL(); return ok }}
+	// BICSubject requires a well-formed subject code of BIC.
+	BICSubject = Rule{Name: "BICSubject", Message: "BIC subject code is missing", Check: hasSubject(subjects.BIC)}
+	// BISACSubject requires a well-formed subject code of BISAC.
+	BISACSubject = Rule{Name: "BISACSubject", Message: "BISAC subject code is missing", Check: hasSubject(subjects.BISAC)}
+	// Audience requires audience of product.
+	Audience = Rule{Name: "Audience", Message: "audience is missing", Check: func(p v2.Product) bool { return len(p.AudienceTypes()) > 0 }}
+	// Supplier requires a supplier of product who tells its availability.
+	Supplier = Rule{Name: "Supplier", Message: "supplier with availability is missing", Check: func(p v2.Product) bool {
+		for _, s := range p.SupplyDetails {
+			if _, ok := s.Availability(); ok {
+				return true
+			}
+		}
+		return false
+	}}
+	// Price requires a price of product whose amount is well-formed, or an unpriced item type which tells why it has none.
+	Price = Rule{Name: "Price", Message: "price is missing", Check: hasPrice}
+)
+
+// BICBasic is BIC Basic of Book Industry Communication, which UK retailers require of records.
+var BICBasic = Profile{
+	Name:  "BIC Basic",
+	Rules: []Rule{ISBN, Title, Contributor, Form, Publisher, PublishingStatus, PublicationDate, SalesRights, BICSubject, Supplier, Price, warning(Description), warning(CoverImage)},
+}
+
+// BISGBestPractice is the core of best practices for product metadata of Book Industry Study Group, which US retailers require of records.
+var BISGBestPractice = Profile{
+	Name:  "BISG best practice",
+	Rules: []Rule{ISBN, Title, Contributor, Form, Publisher, PublicationDate, BISACSubject, Description, CoverImage, Price, Supplier, warning(Audience)},
+}
+
+// warning returns rule of Warning severity.
+func warning(r Rule) Rule {
+	r.Severity = Warning
+	return r
+}
+
+// hasSubject returns check of subject of scheme whose code is well-formed.
+func hasSubject(scheme subjects.Scheme) func(v2.Product) bool {
+	return func(p v2.Product) bool {
+		for _, s := range subjects.Of(subjects.FromProduct(p), scheme) {
+			if s.Code != "" && s.Validate() == nil {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func hasPrice(p v2.Product) bool {
+	for _, s := range p.SupplyDetails {
+		if s.UnpricedItemType != nil {
+			return true
+		}
+		for _, price := range s.Prices {
+			if _, err := price.Amount(); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+// Package quality scores completeness of products of ONIX for Books against a profile of fields,
+// such as the minimum which retailers require, so that suppliers of feeds can be graded.
+package quality
+
+import (
+	"io"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/subjects"
+)
+
+// Field is a field of product which a profile requires.
+type Field string
+
+const (
+	// ISBN is ISBN-13 of product.
+	ISBN Field = "ISBN"
+	// Title is distinctive title of product.
+	Title Field = "Title"
+	// Contributor is at least one contributor of product.
+	Contributor Field = "Contributor"
+	// Price is at least one price of product whose amount is well-formed.
+	Price Field = "Price"
+	// PublicationDate is date of publication of product.
+	PublicationDate Field = "PublicationDate"
+	// CoverImage is image of front cover of product.
+	CoverImage Field = "CoverImage"
+	// Description is main, long or short description of product.
+	Description Field = "Description"
+	// Publisher is name of publisher of product.
+	Publisher Field = "Publisher"
+	// Subject is at least one subject of product of any scheme.
+	Subject Field = "Subject"
+	// Form is primary form of product.
+	Form Field = "Form"
+)
+
+// checks are whether product has each of built-in fields.
+var checks = map[Field]func(v2.Product) bool{
+	ISBN:            func(p v2.Product) bool { _, ok := p.ISBN13(); return ok },
+	Title:           func(p v2.Product) bool { _, ok := p.Title(); return ok },
+	Contributor:     func(p v2.Product) bool { return len(p.Contributors) > 0 },
+	Price:           hasPrice,
+	PublicationDate: func(p v2.Product) bool { _, ok := p.PublishedOn(); return ok },
+	CoverImage:      func(p v2.Product) bool { _, ok := p.CoverImageURL(); return ok },
+	Description:     func(p v2.Product) bool { _, ok := p.Description(); return ok },
+	Publisher:       func(p v2.Product) bool { _, ok := p.Publisher(); return ok },
+	Subject:         func(p v2.Product) bool { return len(subjects.FromProduct(p)) > 0 },
+	Form:            func(p v2.Product) bool { _, ok := p.Form(); return ok },
+}
+
+// Profile is a set of fields which products are scored against.
+type Profile struct {
+	Name   string
+	Fields []Field
+	// Weights are weights of fields in score, where fields without weight weigh 1.
+	Weights map[Field]float64
+	// Checks tell whether product has fields which are not built in, or replace checks of built-in ones.
+	// Field which has no check is always missing.
+	Checks map[Field]func(v2.Product) bool
+}
+
+// RetailerMinimum is the minimum which retailers require to list product.
+var RetailerMinimum = Profile{
+	Name:   "retailer minimum",
+	Fields: []Field{ISBN, Title, Contributor, Price, PublicationDate, CoverImage},
+}
+
+// Enhanced is every built-in field, where fields of RetailerMinimum weigh twice as much as the others.
+var Enhanced = Profile{
+	Name:   "enhanced",
+	Fields: []Field{ISBN, Title, Contributor, Price, PublicationDate, CoverImage, Description, Publisher, Subject, Form},
+	Weights: map[Field]float64{
+		ISBN: 2, Title: 2, Contributor: 2, Price: 2, PublicationDate: 2, CoverImage: 2,
+	},
+}
+
+// Score is completeness of a product against a profile.
+type Score struct {
+	// RecordReference identifies the product in its feed.
+	RecordReference string
+	// Value is the sum of weights of present fields divided by the one of all fields, between 0 and 1.
+	Value float64
+	// Missing are fields which the product does not have, in order of fields of the profile.
+	Missing []Field
+}
+
+// Complete reports whether the product has every field of the profile.
+func (s Score) Complete() bool {
+	return len(s.Missing) == 0
+}
+
+// Score returns completeness of p against the profile, which is 1 for profile of no fields.
+func (pr Profile) Score(p v2.Product) Score {
+	s := Score{RecordReference: strings.TrimSpace(p.RecordReference.Body)}
+	var total, present float64
+	for _, f := range pr.Fields {
+		weight := pr.weightOf(f)
+		total += weight
+		if pr.has(p, f) {
+			present += weight
+		} else {
+			s.Missing = append(s.Missing, f)
+		}
+	}
+	s.Value = 1
+	if total > 0 {
+		s.Value = present / total
+	}
+	return s
+}
+
+// ScoreMessage returns completeness of every product of msg in order of products.
+func (pr Profile) ScoreMessage(msg *v2.ONIXMessage) []Score {
+	var scores []Score
+	for _, p := range msg.Products {
+		scores = append(scores, pr.Score(p))
+	}
+	return scores
+}
+
+// ScoreReader reads message from r product by product, and returns completeness of every product in order of products.
+func (pr Profile) ScoreReader(r io.Reader, opts ...v2.Option) ([]Score, error) {
+	reader := v2.NewReader(r, opts...)
+	defer reader.Close()
+	var scores []Score
+	for {
+		p, err := reader.Next()
+		if err == io.EOF {
+			return scores, nil
+		}
+		if err != nil {
+			return scores, err
+		}
+		scores = append(scores, pr.Score(*p))
+	}
+}
+
+// Average returns average of values of scores, by which a supplier of the products is graded.
+func Average(scores []Score) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, s := range scores {
+		sum += s.Value
+	}
+	return sum / float64(len(scores))
+}
+
+// MissingCounts returns how many products of scores miss each field, which tells suppliers what to improve first.
+func MissingCounts(scores []Score) map[Field]int {
+	counts := map[Field]int{}
+	for _, s := range scores {
+		for _, f := range s.Missing {
+			counts[f]++
+		}
+	}
+	return counts
+}
+
+func (pr Profile) weightOf(f Field) float64 {
+	if w, ok := pr.Weights[f]; ok {
+		return w
+	}
+	return 1
+}
+
+func (pr Profile) has(p v2.Product, f Field) bool {
+	check, ok := pr.Checks[f]
+	if !ok {
+		check, ok = checks[f]
+	}
+	return ok && check(p)
+}
+
+func hasPrice(p v2.Product) bool {
+	for _, s := range p.SupplyDetails {
+		for _, price := range s.Prices {
+			if _, err := price.Amount(); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
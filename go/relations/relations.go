@@ -0,0 +1,147 @@
+// Package relations links products of ONIX for Books by their related products and work identifiers,
+// so that editions of the same work such as print, e-book and audiobook are found from each other.
+package relations
+
+import (
+	"sort"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	"github.com/kogai/onix-codegen/go/isbn"
+	"github.com/kogai/onix-codegen/go/merge"
+)
+
+// Edge is a related product of product, both of which are identified by ISBN-13.
+type Edge struct {
+	From     string
+	To       string
+	Relation v2.ProductRelation
+}
+
+// Graph is products and their related products, which are keyed by ISBN-13 or GTIN-13 as merge.Key does.
+type Graph struct {
+	products map[string]v2.Product
+	edges    map[string][]Edge
+	// works is disjoint sets of editions of the same work, which are linked by relations of other format or by work identifiers.
+	works map[string]string
+}
+
+// BuildGraph links products by their related products, where products without identifier are ignored.
+// Related products which are not among products are linked as well, though Product does not find them.
+func BuildGraph(products []v2.Product) *Graph {
+	g := &Graph{products: map[string]v2.Product{}, edges: map[string][]Edge{}, works: map[string]string{}}
+	identified := map[string]string{}
+	for _, p := range products {
+		from, ok := merge.Key(p)
+		if !ok {
+			continue
+		}
+		g.products[from] = p
+		g.find(from)
+		for _, r := range p.RelatedProducts {
+			to, ok := keyOf(r)
+			if !ok {
+				continue
+			}
+			g.edges[from] = append(g.edges[from], Edge{From: from, To: to, Relation: r.Relation()})
+			if r.Relation().IsOtherFormat() {
+				g.union(from, to)
+			}
+		}
+		for _, id := range p.WorkIdentifiers {
+			work := id.WorkIDType.Code() + ":" + strings.TrimSpace(id.IDValue.Body)
+			if other, ok := identified[work]; ok {
+				g.union(from, other)
+			} else {
+				identified[work] = from
+			}
+		}
+	}
+	return g
+}
+
+// Product returns product of id, which is ISBN-13, GTIN-13 or ISBN-10 with or without hyphens.
+func (g *Graph) Product(id string) (v2.Product, bool) {
+	p, ok := g.products[normalize(id)]
+	return p, ok
+}
+
+// Relations returns related products of product of id in order of the message.
+func (g *Graph) Relations(id string) []Edge {
+	return g.edges[normalize(id)]
+}
+
+// Editions returns ISBN-13 of editions of the same work as product of id including itself in ascending order.
+func (g *Graph) Editions(id string) []string {
+	id = normalize(id)
+	if _, ok := g.works[id]; !ok {
+		return nil
+	}
+	root := g.find(id)
+	var editions []string
+	for edition := range g.works {
+		if g.find(edition) == root {
+			editions = append(editions, edition)
+		}
+	}
+	sort.Strings(editions)
+	return editions
+}
+
+// OtherFormatsOf returns ISBN-13 of editions of the same work as product of id in other formats, such as e-book of print product.
+func (g *Graph) OtherFormatsOf(id string) []string {
+	id = normalize(id)
+	var others []string
+	for _, edition := range g.Editions(id) {
+		if edition != id {
+			others = append(others, edition)
+		}
+	}
+	return others
+}
+
+func (g *Graph) find(id string) string {
+	parent, ok := g.works[id]
+	if !ok {
+		g.works[id] = id
+		return id
+	}
+	if parent == id {
+		return id
+	}
+	root := g.find(parent)
+	g.works[id] = root
+	return root
+}
+
+func (g *Graph) union(a, b string) {
+	if ra, rb := g.find(a), g.find(b); ra != rb {
+		g.works[rb] = ra
+	}
+}
+
+// keyOf returns ISBN-13 or GTIN-13 of related product, where ISBN-10 is converted into ISBN-13.
+func keyOf(r v2.RelatedProduct) (string, bool) {
+	if id, ok := r.ISBN13(); ok && isbn.Validate13(id) == nil {
+		return isbn.Normalize(id), true
+	}
+	if id, ok := r.GTIN13(); ok {
+		if id = isbn.Normalize(id); len(id) == 13 {
+			return id, true
+		}
+	}
+	if id, ok := r.ISBN10(); ok {
+		if converted, err := isbn.Convert10To13(id); err == nil {
+			return converted, true
+		}
+	}
+	return "", false
+}
+
+func normalize(id string) string {
+	id = isbn.Normalize(id)
+	if converted, err := isbn.Convert10To13(id); err == nil && len(id) == 10 {
+		return converted
+	}
+	return id
+}
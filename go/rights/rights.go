@@ -0,0 +1,210 @@
+// Package rights evaluates sales rights of products of ONIX for Books in each market.
+package rights
+
+import (
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// RightsType is code of List 46 which tells type of sales rights.
+type RightsType string
+
+const (
+	// Unknown is sales rights unknown or unstated for any reason.
+	Unknown RightsType = "00"
+	// ForSaleExclusive is for sale with exclusive rights.
+	ForSaleExclusive RightsType = "01"
+	// ForSaleNonExclusive is for sale with non-exclusive rights.
+	ForSaleNonExclusive RightsType = "02"
+	// NotForSale is not for sale for unspecified reason.
+	NotForSale RightsType = "03"
+	// NotForSalePublisherExclusive is not for sale, but publisher holds exclusive rights.
+	NotForSalePublisherExclusive RightsType = "04"
+	// NotForSalePublisherNonExclusive is not for sale, but publisher holds non-exclusive rights.
+	NotForSalePublisherNonExclusive RightsType = "05"
+	// NotForSaleWithoutRights is not for sale because publisher does not hold rights.
+	NotForSaleWithoutRights RightsType = "06"
+	// ForSaleExclusiveRestricted is for sale with exclusive rights where sales restriction applies.
+	ForSaleExclusiveRestricted RightsType = "07"
+	// ForSaleNonExclusiveRestricted is for sale with non-exclusive rights where sales restriction applies.
+	ForSaleNonExclusiveRestricted RightsType = "08"
+)
+
+// ForSale reports whether t allows product to be sold.
+func (t RightsType) ForSale() bool {
+	switch t {
+	case ForSaleExclusive, ForSaleNonExclusive, ForSaleExclusiveRestricted, ForSaleNonExclusiveRestricted:
+		return true
+	}
+	return false
+}
+
+// eurozone are countries whose currency is euro, which ECZ of List 49 stands for.
+var eurozone = []string{"AT", "BE", "CY", "DE", "EE", "ES", "FI", "FR", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MT", "NL", "PT", "SI", "SK"}
+
+// Statement is a statement of sales rights in markets.
+type Statement struct {
+	Type RightsType
+	// Countries are codes of countries of ISO 3166-1 and subdivisions of ISO 3166-2 such as GB-EWS.
+	Countries []string
+	// World is whether the statement applies to the whole world.
+	World bool
+	// RestOfWorld is whether the statement applies to countries which any other statements do not mention.
+	RestOfWorld bool
+	// Excluded are countries and subdivisions to which the statement does not apply, such as CountriesExcluded of 3.0.
+	Excluded []string
+}
+
+// Rights are statements of sales rights of a product.
+type Rights struct {
+	Statements []Statement
+}
+
+// FromProduct reads SalesRights and NotForSale composites of p.
+// NotForSale composite is regarded as sales rights of NotForSale.
+func FromProduct(p v2.Product) Rights {
+	var r Rights
+	for _, s := range p.SalesRightss {
+		statement := Statement{Type: RightsType(s.SalesRightsType.Code())}
+		statement.add(s.RightsCountrys, s.RightsTerritory)
+		for _, region := range s.RightsRegions {
+			switch region.Code() {
+			case "000":
+				statement.World = true
+			case "001":
+				statement.RestOfWorld = true
+			}
+		}
+		r.Statements = append(r.Statements, statement)
+	}
+	for _, n := range p.NotForSales {
+		statement := Statement{Type: NotForSale}
+		statement.add(n.RightsCountrys, n.RightsTerritory)
+		r.Statements = append(r.Statements, statement)
+	}
+	return r
+}
+
+// FromProductV3 reads SalesRights composites of PublishingDetail of p, whose Territory includes and excludes
+// countries and regions of 3.0 instead of RightsCountry and RightsTerritory of 2.1.
+func FromProductV3(p v3.Product) Rights {
+	var r Rights
+	if p.PublishingDetail == nil {
+		return r
+	}
+	for _, s := range p.PublishingDetail.SalesRightss {
+		statement := Statement{Type: RightsType(s.SalesRightsType.Code())}
+		t := s.Territory
+		if t.CountriesIncluded != nil {
+			statement.Countries = append(statement.Countries, strings.Fields(t.CountriesIncluded.Body)...)
+		}
+		if t.RegionsIncluded != nil {
+			statement.territory(strings.Fields(t.RegionsIncluded.Body))
+		}
+		for _, excluded := range []*v3.PlainText{t.CountriesExcluded, t.RegionsExcluded} {
+			if excluded != nil {
+				statement.Excluded = append(statement.Excluded, strings.Fields(excluded.Body)...)
+			}
+		}
+		r.Statements = append(r.Statements, statement)
+	}
+	return r
+}
+
+func (s *Statement) add(countries []v2.CountryCodeList, territory *v2.TerritoryCodeList) {
+	for _, c := range countries {
+		s.Countries = append(s.Countries, c.Codes()...)
+	}
+	if territory == nil {
+		return
+	}
+	s.territory(territory.Codes())
+}
+
+// territory adds codes of territories, which are the ones of countries, subdivisions and regions such as WORLD, ROW and ECZ.
+func (s *Statement) territory(territories []string) {
+	for _, t := range territories {
+		switch t {
+		case "WORLD":
+			s.World = true
+		case "ROW":
+			s.RestOfWorld = true
+		case "ECZ":
+			s.Countries = append(s.Countries, eurozone...)
+		default:
+			s.Countries = append(s.Countries, t)
+		}
+	}
+}
+
+// CanSellIn reports whether product can be sold in country, which is a code of ISO 3166-1 or a subdivision of ISO 3166-2.
+// Statements which mention the country take precedence over the one of the whole world,
+// and the one of the rest of world applies only to countries which no statements mention.
+// Statements do not apply to countries which they exclude. When statements conflict, not for sale takes precedence.
+func (r Rights) CanSellIn(country string) (bool, RightsType) {
+	country = strings.ToUpper(country)
+	parent := country
+	if i := strings.IndexByte(country, '-'); i >= 0 {
+		parent = country[:i]
+	}
+
+	var mentioned, world, rest []RightsType
+	for _, s := range r.Statements {
+		if s.excludes(country, parent) {
+			continue
+		}
+		for _, c := range s.Countries {
+			if c == country || c == parent {
+				mentioned = append(mentioned, s.Type)
+				break
+			}
+		}
+		if s.World {
+			world = append(world, s.Type)
+		}
+		if s.RestOfWorld {
+			rest = append(rest, s.Type)
+		}
+	}
+	for _, types := range [][]RightsType{mentioned, world} {
+		if t, ok := decide(types); ok {
+			return t.ForSale(), t
+		}
+	}
+	if len(mentioned) == 0 {
+		if t, ok := decide(rest); ok {
+			return t.ForSale(), t
+		}
+	}
+	return false, Unknown
+}
+
+// excludes reports whether s excludes country or parent, which is the country of the subdivision.
+func (s Statement) excludes(country, parent string) bool {
+	for _, c := range s.Excluded {
+		if c == country || c == parent {
+			return true
+		}
+	}
+	return false
+}
+
+// decide chooses a type of sales rights from the ones which apply to the same market.
+func decide(types []RightsType) (RightsType, bool) {
+	if len(types) == 0 {
+		return Unknown, false
+	}
+	for _, t := range types {
+		if !t.ForSale() && t != Unknown {
+			return t, true
+		}
+	}
+	for _, t := range types {
+		if t.ForSale() {
+			return t, true
+		}
+	}
+	return types[0], true
+}
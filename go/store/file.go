@@ -0,0 +1,428 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// errClosed is an error of reading products of FileStore which has been closed.
+var errClosed = errors.New("store has been closed")
+
+// FileStore is an embedded ProductStore and Tombstoner which persists changes to a log of JSON lines which is replayed by Open.
+// Only an index of the log is kept in memory, which is RecordReference, ISBN and offset of the latest record of each product,
+// and products are read from the log on demand, so that a catalog larger than memory can be stored.
+type FileStore struct {
+	mu sync.RWMutex
+	// records are where the latest records of products are by their RecordReferences.
+	records map[string]record
+	// isbns are RecordReferences of products by their ISBN-13.
+	isbns map[string]map[string]bool
+	// tombstones are when products have been deleted by their RecordReferences.
+	tombstones map[string]time.Time
+	path       string
+	log        *os.File
+	w          *bufio.Writer
+	// size is bytes of the log, which is where the next entry is appended.
+	size int64
+}
+
+// record is a line of the log which holds a product, or the product itself for the store which persists nothing.
+type record struct {
+	offset  int64
+	length  int
+	isbn    string
+	product *onix.Product
+}
+
+// entry is a line of the log, which is either a product which has been put, RecordReference which has been deleted
+// or RecordReference which has been tombstoned at At.
+type entry struct {
+	// Release is release of the product which has been put, which is 2.1 unless it is set.
+	Release   onix.Version    `json:"release,omitempty"`
+	Put       json.RawMessage `json:"put,omitempty"`
+	Delete    string          `json:"delete,omitempty"`
+	Tombstone string          `json:"tombstone,omitempty"`
+	At        *time.Time      `json:"at,omitempty"`
+}
+
+// product decodes the product which has been put.
+func (e entry) product() (onix.Product, error) {
+	switch e.Release {
+	case "", onix.V2:
+		var p v2.Product
+		err := json.Unmarshal(e.Put, &p)
+		return onix.Product{Version: onix.V2, V2: &p}, err
+	case onix.V3:
+		var p v3.Product
+		err := json.Unmarshal(e.Put, &p)
+		return onix.Product{Version: onix.V3, V3: &p}, err
+	}
+	return onix.Product{}, fmt.Errorf("unsupported release of ONIX for Books has been logged, got [%s]", e.Release)
+}
+
+// NewMemoryStore returns FileStore which persists nothing and keeps products in memory.
+func NewMemoryStore() *FileStore {
+	return &FileStore{records: map[string]record{}, isbns: map[string]map[string]bool{}, tombstones: map[string]time.Time{}}
+}
+
+// Open returns FileStore of the log at path, which is created unless it exists.
+func Open(path string) (*FileStore, error) {
+	s := NewMemoryStore()
+	s.path = path
+	log, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	s.log, s.w = log, bufio.NewWriter(log)
+	if err := s.replay(); err != nil {
+		log.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// replay indexes lines of the log, which are decoded once to tell RecordReferences and ISBNs of products.
+func (s *FileStore) replay() error {
+	r := bufio.NewReader(io.NewSectionReader(s.log, 0, 1<<62))
+	line := 0
+	for {
+		b, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		offset, length := s.size, len(b)
+		s.size += int64(len(b))
+		if len(b) > 0 && b[len(b)-1] == '\n' {
+			length--
+		} else if len(b) > 0 {
+			// The last line which a crash has left without newline is terminated, so that entries are appended on their own lines.
+			if _, err := s.w.WriteString("\n"); err != nil {
+				return err
+			}
+			if err := s.w.Flush(); err != nil {
+				return err
+			}
+			s.size++
+		}
+		if length > 0 {
+			line++
+			var e entry
+			if err := json.Unmarshal(b[:length], &e); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			if err := s.apply(e, offset, length); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func (s *FileStore) apply(e entry, offset int64, length int) error {
+	switch {
+	case e.Put != nil:
+		p, err := e.product()
+		if err != nil {
+			return err
+		}
+		ref := RecordReference(p)
+		if ref == "" {
+			return ErrNoRecordReference
+		}
+		id, _ := isbnOf(p)
+		s.put(ref, record{offset: offset, length: length, isbn: id})
+	case e.Tombstone != "" && e.At != nil:
+		s.tombstone(e.Tombstone, *e.At)
+	default:
+		s.remove(e.Delete)
+	}
+	return nil
+}
+
+// Put stores p in place of the product of the same RecordReference, if any, which revives its tombstone as well.
+func (s *FileStore) Put(p onix.Product) error {
+	ref := RecordReference(p)
+	if ref == "" {
+		return ErrNoRecordReference
+	}
+	var e entry
+	var err error
+	switch {
+	case p.V2 != nil:
+		e.Release = onix.V2
+		e.Put, err = json.Marshal(p.V2)
+	case p.V3 != nil:
+		e.Release = onix.V3
+		e.Put, err = json.Marshal(p.V3)
+	}
+	if err != nil {
+		return err
+	}
+	id, _ := isbnOf(p)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	offset, length, err := s.append(e)
+	if err != nil {
+		return err
+	}
+	r := record{offset: offset, length: length, isbn: id}
+	if s.log == nil {
+		r.product = &p
+	}
+	s.put(ref, r)
+	return nil
+}
+
+// Get returns the product whose RecordReference is ref, which is read from the log.
+func (s *FileStore) Get(ref string) (onix.Product, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.records[ref]
+	if !ok {
+		return onix.Product{}, false, nil
+	}
+	p, err := s.read(r)
+	if err != nil {
+		return onix.Product{}, false, err
+	}
+	return p, true, nil
+}
+
+// FindByISBN returns products whose ISBN-13 or GTIN-13 is id in order of RecordReference.
+func (s *FileStore) FindByISBN(id string) ([]onix.Product, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var refs []string
+	for ref := range s.isbns[normalizeISBN(id)] {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	products := make([]onix.Product, len(refs))
+	for i, ref := range refs {
+		p, err := s.read(s.records[ref])
+		if err != nil {
+			return nil, err
+		}
+		products[i] = p
+	}
+	return products, nil
+}
+
+// ApplyDelete removes the product whose RecordReference is ref.
+func (s *FileStore) ApplyDelete(ref string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[ref]; !ok {
+		return nil
+	}
+	if _, _, err := s.append(entry{Delete: ref}); err != nil {
+		return err
+	}
+	s.remove(ref)
+	return nil
+}
+
+// Tombstone removes the product whose RecordReference is ref, and keeps that it has been deleted at at.
+func (s *FileStore) Tombstone(ref string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, _, err := s.append(entry{Tombstone: ref, At: &at}); err != nil {
+		return err
+	}
+	s.tombstone(ref, at)
+	return nil
+}
+
+// Tombstoned returns when the product whose RecordReference is ref has been deleted.
+func (s *FileStore) Tombstoned(ref string) (time.Time, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	at, ok := s.tombstones[ref]
+	return at, ok, nil
+}
+
+// Len returns number of products which are stored.
+func (s *FileStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.records)
+}
+
+// Compact rewrites the log into the current state of products and tombstones, which drops records replaced or deleted so far.
+// Lines of products are copied as they are without being decoded.
+func (s *FileStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.log == nil {
+		return nil
+	}
+	tmp, err := os.Create(filepath.Join(filepath.Dir(s.path), "."+filepath.Base(s.path)+".compact"))
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	refs := make([]string, 0, len(s.records))
+	for ref := range s.records {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	// offsets are where lines of products are in the compacted log, which replace the current ones once it has been renamed.
+	offsets := make(map[string]int64, len(refs))
+	var size int64
+	for _, ref := range refs {
+		r := s.records[ref]
+		line := make([]byte, r.length, r.length+1)
+		if _, err = s.log.ReadAt(line, r.offset); err != nil {
+			break
+		}
+		if _, err = w.Write(append(line, '\n')); err != nil {
+			break
+		}
+		offsets[ref] = size
+		size += int64(len(line) + 1)
+	}
+	tombstones := make([]string, 0, len(s.tombstones))
+	for ref := range s.tombstones {
+		tombstones = append(tombstones, ref)
+	}
+	sort.Strings(tombstones)
+	for _, ref := range tombstones {
+		if err != nil {
+			break
+		}
+		at := s.tombstones[ref]
+		var b []byte
+		if b, err = json.Marshal(entry{Tombstone: ref, At: &at}); err == nil {
+			_, err = w.Write(append(b, '\n'))
+			size += int64(len(b) + 1)
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	log, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	s.log.Close()
+	s.log, s.w, s.size = log, bufio.NewWriter(log), size
+	for ref, offset := range offsets {
+		r := s.records[ref]
+		r.offset = offset
+		s.records[ref] = r
+	}
+	return nil
+}
+
+// Close flushes the log and closes it.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.log == nil {
+		return nil
+	}
+	err := s.w.Flush()
+	if closeErr := s.log.Close(); err == nil {
+		err = closeErr
+	}
+	s.log, s.w = nil, nil
+	return err
+}
+
+// append writes e to the log, which is flushed by each change so that the log survives crashes of the process,
+// and returns offset and length of the line.
+func (s *FileStore) append(e entry) (int64, int, error) {
+	if s.w == nil {
+		return 0, 0, nil
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := s.w.Write(append(b, '\n')); err != nil {
+		return 0, 0, err
+	}
+	if err := s.w.Flush(); err != nil {
+		return 0, 0, err
+	}
+	offset := s.size
+	s.size += int64(len(b) + 1)
+	return offset, len(b), nil
+}
+
+// read returns the product of r, which is decoded from its line of the log unless r holds the product.
+func (s *FileStore) read(r record) (onix.Product, error) {
+	if r.product != nil {
+		return *r.product, nil
+	}
+	if s.log == nil {
+		return onix.Product{}, errClosed
+	}
+	line := make([]byte, r.length)
+	if _, err := s.log.ReadAt(line, r.offset); err != nil {
+		return onix.Product{}, err
+	}
+	var e entry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return onix.Product{}, fmt.Errorf("%s: offset %d: %w", s.path, r.offset, err)
+	}
+	return e.product()
+}
+
+func (s *FileStore) put(ref string, r record) {
+	s.remove(ref)
+	delete(s.tombstones, ref)
+	s.records[ref] = r
+	if r.isbn != "" {
+		if s.isbns[r.isbn] == nil {
+			s.isbns[r.isbn] = map[string]bool{}
+		}
+		s.isbns[r.isbn][ref] = true
+	}
+}
+
+func (s *FileStore) remove(ref string) {
+	r, ok := s.records[ref]
+	if !ok {
+		return
+	}
+	delete(s.records, ref)
+	if r.isbn != "" {
+		delete(s.isbns[r.isbn], ref)
+		if len(s.isbns[r.isbn]) == 0 {
+			delete(s.isbns, r.isbn)
+		}
+	}
+}
+
+func (s *FileStore) tombstone(ref string, at time.Time) {
+	s.remove(ref)
+	s.tombstones[ref] = at
+}
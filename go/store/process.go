@@ -0,0 +1,119 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// Tombstoner is ProductStore which keeps records of deleted products as tombstones,
+// so that recipients tell products which have been deleted from ones which have never been sent.
+type Tombstoner interface {
+	ProductStore
+	// Tombstone removes the product whose RecordReference is ref, and keeps that it has been deleted at at.
+	Tombstone(ref string, at time.Time) error
+	// Tombstoned returns when the product whose RecordReference is ref has been deleted.
+	Tombstoned(ref string) (time.Time, bool, error)
+}
+
+// Processor applies products of messages to Store, where delete notifications of NotificationType 05 remove records
+// and the other notifications put them.
+type Processor struct {
+	Store ProductStore
+	// Tombstones keeps deleted records as tombstones instead of removing them, which Store has to implement Tombstoner for.
+	Tombstones bool
+	// Now returns time of tombstones, which is time.Now unless it is set.
+	Now func() time.Time
+}
+
+// Report is what Processor has done to products of a message, by RecordReference in order of the message.
+type Report struct {
+	Put     []string `json:"put,omitempty"`
+	Deleted []string `json:"deleted,omitempty"`
+	// UnknownDeletes are delete notifications of records which Store does not have, such as ones which have never been sent
+	// or have already been deleted, which are reported back to senders rather than failing.
+	UnknownDeletes []string `json:"unknownDeletes,omitempty"`
+	// Tests are records of NotificationType 88 and 89 which are sent as tests, and are not applied.
+	Tests []string `json:"tests,omitempty"`
+}
+
+// Process applies products of msg of either release to Store in order of the message.
+// An error stops processing the rest, where Report tells products which have been applied so far.
+func (pr Processor) Process(msg *onix.Message) (Report, error) {
+	var report Report
+	var tombstoner Tombstoner
+	if pr.Tombstones {
+		t, ok := pr.Store.(Tombstoner)
+		if !ok {
+			return report, fmt.Errorf("store %T does not keep tombstones", pr.Store)
+		}
+		tombstoner = t
+	}
+	now := pr.Now
+	if now == nil {
+		now = time.Now
+	}
+	for _, p := range products(msg) {
+		ref := RecordReference(p)
+		if ref == "" {
+			return report, ErrNoRecordReference
+		}
+		test, del := notification(p)
+		switch {
+		case test:
+			report.Tests = append(report.Tests, ref)
+		case del:
+			_, ok, err := pr.Store.Get(ref)
+			if err != nil {
+				return report, err
+			}
+			if !ok {
+				report.UnknownDeletes = append(report.UnknownDeletes, ref)
+				continue
+			}
+			if tombstoner != nil {
+				err = tombstoner.Tombstone(ref, now())
+			} else {
+				err = pr.Store.ApplyDelete(ref)
+			}
+			if err != nil {
+				return report, err
+			}
+			report.Deleted = append(report.Deleted, ref)
+		default:
+			if err := pr.Store.Put(p); err != nil {
+				return report, err
+			}
+			report.Put = append(report.Put, ref)
+		}
+	}
+	return report, nil
+}
+
+// products returns products of msg in order of the message.
+func products(msg *onix.Message) []onix.Product {
+	var products []onix.Product
+	switch {
+	case msg.V2 != nil:
+		for i := range msg.V2.Products {
+			products = append(products, onix.Product{Version: onix.V2, V2: &msg.V2.Products[i]})
+		}
+	case msg.V3 != nil:
+		for i := range msg.V3.Products {
+			products = append(products, onix.Product{Version: onix.V3, V3: &msg.V3.Products[i]})
+		}
+	}
+	return products
+}
+
+// notification reports whether p is sent as a test, and whether p is a delete notification, by List 1 of either release.
+func notification(p onix.Product) (test, del bool) {
+	switch {
+	case p.V2 != nil:
+		return p.V2.Notification().IsTest(), p.V2.IsDelete()
+	case p.V3 != nil:
+		return p.V3.Notification().IsTest(), p.V3.IsDelete()
+	}
+	return false, false
+}
@@ -0,0 +1,90 @@
+// Package store maintains the current state of products of ONIX for Books 2.1 and 3.0 from successive deliveries,
+// where products replace their former records by RecordReference and deletions remove them.
+package store
+
+import (
+	"errors"
+	"strings"
+
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+	"github.com/kogai/onix-codegen/go/isbn"
+	"github.com/kogai/onix-codegen/go/merge"
+	"github.com/kogai/onix-codegen/go/onix"
+)
+
+// ErrNoRecordReference is an error of a product which has no RecordReference to be stored by.
+var ErrNoRecordReference = errors.New("product has no RecordReference")
+
+// ProductStore is current state of products of either release by RecordReference.
+type ProductStore interface {
+	// Put stores p in place of the product of the same RecordReference, if any.
+	Put(p onix.Product) error
+	// Get returns the product whose RecordReference is ref.
+	Get(ref string) (onix.Product, bool, error)
+	// FindByISBN returns products whose ISBN-13 or GTIN-13 is id, which may be ISBN-10 or hyphenated.
+	FindByISBN(id string) ([]onix.Product, error)
+	// ApplyDelete removes the product whose RecordReference is ref, which is not an error if there is no such product.
+	ApplyDelete(ref string) error
+}
+
+// Apply applies products of msg to s in order of the message, as Processor does without tombstones.
+func Apply(s ProductStore, msg *onix.Message) error {
+	_, err := Processor{Store: s}.Process(msg)
+	return err
+}
+
+// RecordReference returns RecordReference of p which products are stored by.
+func RecordReference(p onix.Product) string {
+	switch {
+	case p.V2 != nil:
+		return strings.TrimSpace(p.V2.RecordReference.Body)
+	case p.V3 != nil:
+		return strings.TrimSpace(p.V3.RecordReference.Body)
+	}
+	return ""
+}
+
+// isbnOf returns ISBN-13 of p which products are found by, where products of 3.0 are identified as merge.Key identifies 2.1 ones.
+func isbnOf(p onix.Product) (string, bool) {
+	switch {
+	case p.V2 != nil:
+		return merge.Key(*p.V2)
+	case p.V3 != nil:
+		return isbnOfV3(*p.V3)
+	}
+	return "", false
+}
+
+func isbnOfV3(p v3.Product) (string, bool) {
+	ids := map[string][]string{}
+	for _, id := range p.ProductIdentifiers {
+		ids[id.ProductIDType.Code()] = append(ids[id.ProductIDType.Code()], isbn.Normalize(id.IDValue.Body))
+	}
+	for _, id := range ids["15"] {
+		if isbn.Validate13(id) == nil {
+			return id, true
+		}
+	}
+	for _, id := range ids["03"] {
+		if len(id) == 13 {
+			return id, true
+		}
+	}
+	for _, id := range ids["02"] {
+		if converted, err := isbn.Convert10To13(id); err == nil {
+			return converted, true
+		}
+	}
+	return "", false
+}
+
+// normalizeISBN returns ISBN-13 of id without hyphens, where ISBN-10 is converted.
+func normalizeISBN(id string) string {
+	id = isbn.Normalize(id)
+	if len(id) == 10 {
+		if converted, err := isbn.Convert10To13(id); err == nil {
+			return converted
+		}
+	}
+	return id
+}
@@ -0,0 +1,80 @@
+package subjects
+
+// bisacToThema maps BISAC Subject Headings to Thema Subject Categories.
+// Headings of "general" such as COM000000 stand for whole sections, and are used for codes which the table lacks.
+var bisacToThema = map[string][]string{
+	"ANT000000": {"WC"},
+	"ARC000000": {"AM"},
+	"ART000000": {"A"},
+	"BIB000000": {"QRMP"},
+	"BIO000000": {"DNB"},
+	"BOD000000": {"VX"},
+	"BUS000000": {"K"},
+	"BUS070030": {"KNTX"},
+	"CGN000000": {"X"},
+	"CKB000000": {"WB"},
+	"COM000000": {"U"},
+	"COM041000": {"UYF"},
+	"COM051000": {"UM"},
+	"COM051010": {"UMX"},
+	"COM060160": {"UMW"},
+	"CRA000000": {"WF"},
+	"DES000000": {"AK"},
+	"DRA000000": {"DD"},
+	"EDU000000": {"JN"},
+	"FAM000000": {"VFV"},
+	"FIC000000": {"FB"},
+	"FOR000000": {"CJ"},
+	"GAM000000": {"WD"},
+	"GAM013000": {"WDMG"},
+	"GAR000000": {"WM"},
+	"HEA000000": {"VFD"},
+	"HIS000000": {"NH"},
+	"HOM000000": {"WK"},
+	"HUM000000": {"WH"},
+	"JNF000000": {"YN"},
+	"JUV000000": {"YF"},
+	"LAN000000": {"C"},
+	"LAW000000": {"L"},
+	"LIT000000": {"DS"},
+	"MAT000000": {"PB"},
+	"MED000000": {"M"},
+	"MUS000000": {"AV"},
+	"NAT000000": {"WN"},
+	"PER000000": {"AT"},
+	"PET000000": {"WNG"},
+	"PHI000000": {"QD"},
+	"PHO000000": {"AJ"},
+	"POE000000": {"DC"},
+	"POL000000": {"JP"},
+	"PSY000000": {"JM"},
+	"REF000000": {"GB"},
+	"REL000000": {"QR"},
+	"SCI000000": {"PD"},
+	"SEL000000": {"VS"},
+	"SOC000000": {"JB"},
+	"SPO000000": {"S"},
+	"TEC000000": {"T"},
+	"TEC008000": {"TJF"},
+	"TRA000000": {"WG"},
+	"TRU000000": {"DNXC"},
+	"TRV000000": {"WT"},
+	"YAF000000": {"YF"},
+	"YAN000000": {"YN"},
+}
+
+// MapBISACToThema returns Thema Subject Categories which correspond to BISAC Subject Heading code.
+// A code which the table lacks falls back on the categories of its section, such as U for COM051210,
+// and a malformed code or a code of unknown section has no categories.
+func MapBISACToThema(code string) []string {
+	if Validate(BISAC, code) != nil {
+		return nil
+	}
+	if categories, ok := bisacToThema[code]; ok {
+		return append([]string(nil), categories...)
+	}
+	if categories, ok := bisacToThema[code[:3]+"000000"]; ok {
+		return append([]string(nil), categories...)
+	}
+	return nil
+}
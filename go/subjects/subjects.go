@@ -0,0 +1,152 @@
+// Package subjects reads subjects of products of ONIX for Books and validates their codes by scheme.
+package subjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+)
+
+// Scheme is code of List 27 which tells scheme of subject code.
+type Scheme string
+
+const (
+	// Dewey is Dewey Decimal Classification.
+	Dewey Scheme = "01"
+	// AbridgedDewey is abridged Dewey Decimal Classification.
+	AbridgedDewey Scheme = "02"
+	// LCClassification is Library of Congress Classification.
+	LCClassification Scheme = "03"
+	// LCSubjectHeading is Library of Congress Subject Heading.
+	LCSubjectHeading Scheme = "04"
+	// BISAC is BISAC Subject Heading of Book Industry Study Group.
+	BISAC Scheme = "10"
+	// BISACRegionalTheme is BISAC Regional Theme.
+	BISACRegionalTheme Scheme = "11"
+	// BIC is BIC Subject Category.
+	BIC Scheme = "12"
+	// BICGeographicalQualifier is BIC qualifier of geography.
+	BICGeographicalQualifier Scheme = "13"
+	// BICLanguageQualifier is BIC qualifier of language.
+	BICLanguageQualifier Scheme = "14"
+	// BICTimePeriodQualifier is BIC qualifier of time period.
+	BICTimePeriodQualifier Scheme = "15"
+	// BICEducationalPurposeQualifier is BIC qualifier of educational purpose.
+	BICEducationalPurposeQualifier Scheme = "16"
+	// BICReadingLevelQualifier is BIC qualifier of reading level and special interest.
+	BICReadingLevelQualifier Scheme = "17"
+	// Keywords are keywords which are not taken from any scheme.
+	Keywords Scheme = "20"
+	// Thema is Thema Subject Category.
+	Thema Scheme = "93"
+	// ThemaPlaceQualifier is Thema qualifier of place.
+	ThemaPlaceQualifier Scheme = "94"
+	// ThemaLanguageQualifier is Thema qualifier of language.
+	ThemaLanguageQualifier Scheme = "95"
+	// ThemaTimePeriodQualifier is Thema qualifier of time period.
+	ThemaTimePeriodQualifier Scheme = "96"
+	// ThemaEducationalPurposeQualifier is Thema qualifier of educational purpose.
+	ThemaEducationalPurposeQualifier Scheme = "97"
+	// ThemaInterestAgeQualifier is Thema qualifier of interest age and special interest.
+	ThemaInterestAgeQualifier Scheme = "98"
+	// ThemaStyleQualifier is Thema qualifier of style.
+	ThemaStyleQualifier Scheme = "99"
+)
+
+// syntaxes are patterns of codes of schemes whose syntax is known.
+var syntaxes = map[Scheme]*regexp.Regexp{
+	Dewey:                            regexp.MustCompile(`^[0-9]{3}(\.[0-9]+)?$`),
+	AbridgedDewey:                    regexp.MustCompile(`^[0-9]{3}(\.[0-9]+)?$`),
+	BISAC:                            regexp.MustCompile(`^[A-Z]{3}[0-9]{6}$`),
+	BISACRegionalTheme:               regexp.MustCompile(`^[0-9]\.[0-9]\.[0-9]\.[0-9A-Z]+$`),
+	BIC:                              regexp.MustCompile(`^[A-Z]{1,5}$`),
+	BICGeographicalQualifier:         regexp.MustCompile(`^1[A-Z0-9]+$`),
+	BICLanguageQualifier:             regexp.MustCompile(`^2[A-Z0-9]+$`),
+	BICTimePeriodQualifier:           regexp.MustCompile(`^3[A-Z0-9]+$`),
+	BICEducationalPurposeQualifier:   regexp.MustCompile(`^4[A-Z0-9]+$`),
+	BICReadingLevelQualifier:         regexp.MustCompile(`^5[A-Z0-9]+$`),
+	Thema:                            regexp.MustCompile(`^[A-Z]{1,6}$`),
+	ThemaPlaceQualifier:              regexp.MustCompile(`^1[A-Z0-9-]+$`),
+	ThemaLanguageQualifier:           regexp.MustCompile(`^2[A-Z0-9-]+$`),
+	ThemaTimePeriodQualifier:         regexp.MustCompile(`^3[A-Z0-9-]+$`),
+	ThemaEducationalPurposeQualifier: regexp.MustCompile(`^4[A-Z0-9-]+$`),
+	ThemaInterestAgeQualifier:        regexp.MustCompile(`^5[A-Z0-9-]+$`),
+	ThemaStyleQualifier:              regexp.MustCompile(`^6[A-Z0-9-]+$`),
+}
+
+// Validate checks syntax of code of scheme, such as three letters and six digits of BISAC.
+// Codes of schemes whose syntax is unknown are only required to be non-empty.
+func Validate(scheme Scheme, code string) error {
+	if code == "" {
+		return fmt.Errorf("code of scheme [%s] must not be empty", scheme)
+	}
+	syntax, ok := syntaxes[scheme]
+	if !ok {
+		return nil
+	}
+	if !syntax.MatchString(code) {
+		return fmt.Errorf("unexpected code of scheme [%s] has been passed, got [%s]", scheme, code)
+	}
+	return nil
+}
+
+// Subject is a subject of product.
+type Subject struct {
+	Scheme  Scheme
+	Code    string
+	Heading string
+	// Main is whether the subject is main subject of the product in the scheme.
+	Main bool
+}
+
+// Validate checks syntax of code of subject.
+// Subject which has only heading, such as keywords, is valid.
+func (s Subject) Validate() error {
+	if s.Code == "" && s.Heading != "" {
+		return nil
+	}
+	return Validate(s.Scheme, s.Code)
+}
+
+// FromProduct reads BASICMainSubject, BICMainSubject, MainSubject and Subject of p, where main subjects precede the others.
+// Codes of List 26 which MainSubject has stand for the same schemes as the ones of List 27.
+func FromProduct(p v2.Product) []Subject {
+	var subjects []Subject
+	if p.BASICMainSubject != nil {
+		subjects = append(subjects, Subject{Scheme: BISAC, Code: strings.TrimSpace(p.BASICMainSubject.Body), Main: true})
+	}
+	if p.BICMainSubject != nil {
+		subjects = append(subjects, Subject{Scheme: BIC, Code: strings.TrimSpace(p.BICMainSubject.Body), Main: true})
+	}
+	for _, s := range p.MainSubjects {
+		subjects = append(subjects, subjectOf(Scheme(s.MainSubjectSchemeIdentifier.Code()), s.SubjectCode, s.SubjectHeadingText, true))
+	}
+	for _, s := range p.Subjects {
+		subjects = append(subjects, subjectOf(Scheme(s.SubjectSchemeIdentifier.Code()), s.SubjectCode, s.SubjectHeadingText, false))
+	}
+	return subjects
+}
+
+// Of returns subjects of scheme among subjects.
+func Of(subjects []Subject, scheme Scheme) []Subject {
+	var found []Subject
+	for _, s := range subjects {
+		if s.Scheme == scheme {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+func subjectOf(scheme Scheme, code, heading *v2.PlainText, main bool) Subject {
+	s := Subject{Scheme: scheme, Main: main}
+	if code != nil {
+		s.Code = strings.TrimSpace(code.Body)
+	}
+	if heading != nil {
+		s.Heading = strings.TrimSpace(heading.Body)
+	}
+	return s
+}
@@ -0,0 +1,313 @@
+// Package texts extracts texts such as descriptions of products of ONIX for Books,
+// and sanitizes their HTML or turns them into plain text for storefronts.
+package texts
+
+import (
+	"encoding/xml"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	v2 "github.com/kogai/onix-codegen/generated/go/v2"
+	v3 "github.com/kogai/onix-codegen/generated/go/v3"
+)
+
+// Text is a text of product such as description or biographical note.
+type Text struct {
+	// Type is a code of List 33 in 2.1 or List 153 in 3.0, such as 03 for long description.
+	Type string
+	// Format is a code of List 34 such as 02 for HTML, which is empty unless it is given.
+	Format  string
+	Content string
+}
+
+// FromProduct reads OtherText composites of p.
+// MainDescription element which is replaced by OtherText composite is read as main description of type 01.
+func FromProduct(p v2.Product) []Text {
+	var texts []Text
+	for _, o := range p.OtherTexts {
+		if o.Text == nil {
+			continue
+		}
+		t := Text{Type: o.TextTypeCode.Code(), Content: string(*o.Text)}
+		if o.TextFormat != nil {
+			t.Format = o.TextFormat.Code()
+		}
+		texts = append(texts, t)
+	}
+	if p.MainDescription != nil {
+		texts = append(texts, Text{Type: "01", Content: string(*p.MainDescription)})
+	}
+	return texts
+}
+
+// FromTextContent reads texts of TextContent composite of 3.0, which are XHTML or plain text.
+func FromTextContent(c v3.TextContent) []Text {
+	var texts []Text
+	for _, content := range c.Texts {
+		texts = append(texts, Text{Type: c.TextType.Code(), Content: string(content)})
+	}
+	return texts
+}
+
+// BiographicalNote is a code of List 33 and List 153 of biographical note.
+const BiographicalNote = "13"
+
+// FromContributor reads BiographicalNote of contributor of 2.1 as a text of type 13.
+func FromContributor(c v2.Contributor) []Text {
+	bio, ok := c.Bio()
+	if !ok {
+		return nil
+	}
+	return []Text{{Type: BiographicalNote, Content: bio}}
+}
+
+// FromBiographicalNotes reads BiographicalNote elements of contributor of 3.0 as texts of type 13, which are repeated in languages.
+func FromBiographicalNotes(c v3.Contributor) []Text {
+	var texts []Text
+	for _, note := range c.BiographicalNotes {
+		if content := strings.TrimSpace(string(note)); content != "" {
+			texts = append(texts, Text{Type: BiographicalNote, Content: content})
+		}
+	}
+	return texts
+}
+
+// ByType returns the first text of type among texts.
+func ByType(texts []Text, textType string) (Text, bool) {
+	for _, t := range texts {
+		if t.Type == textType {
+			return t, true
+		}
+	}
+	return Text{}, false
+}
+
+// plainFormats are codes of List 34 of texts which are not marked up.
+var plainFormats = []string{"00", "06", "07"}
+
+func (t Text) marked() bool {
+	for _, f := range plainFormats {
+		if t.Format == f {
+			return false
+		}
+	}
+	return true
+}
+
+// HTML returns content sanitized by Sanitize, where plain text is escaped.
+func (t Text) HTML() string {
+	if !t.marked() {
+		return html.EscapeString(t.Content)
+	}
+	return Sanitize(t.Content)
+}
+
+// PlainText returns content whose markups are stripped by PlainText.
+func (t Text) PlainText() string {
+	if !t.marked() {
+		return strings.TrimSpace(t.Content)
+	}
+	return PlainText(t.Content)
+}
+
+// Snippet returns plain text of content truncated to n runes by Truncate.
+func (t Text) Snippet(n int) string {
+	return Truncate(t.PlainText(), n)
+}
+
+// allowed are elements which sanitized HTML consists of, and their attributes.
+var allowed = map[string][]string{
+	"a": {"href"}, "b": nil, "blockquote": nil, "br": nil, "dd": nil, "dl": nil, "dt": nil, "em": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil, "i": nil, "li": nil, "ol": nil,
+	"p": nil, "strong": nil, "sub": nil, "sup": nil, "u": nil, "ul": nil,
+}
+
+// dropped are elements whose contents are dropped as well as themselves.
+var dropped = map[string]bool{"head": true, "object": true, "script": true, "style": true, "title": true}
+
+// blocks are elements which break lines of plain text.
+var blocks = map[string]bool{
+	"blockquote": true, "br": true, "dd": true, "div": true, "dl": true, "dt": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"li": true, "ol": true, "p": true, "table": true, "tr": true, "ul": true,
+}
+
+// implied are elements which close the open element of the same kind, as li does in HTML.
+var implied = map[string][]string{"dd": {"dd", "dt"}, "dt": {"dd", "dt"}, "li": {"li"}, "p": {"p"}}
+
+// safeSchemes are schemes of URL which links of sanitized HTML may have.
+var safeSchemes = []string{"http://", "https://", "mailto:"}
+
+// Sanitize returns s whose elements and attributes are restricted to allowlist of basic formatting such as p, em and a with href.
+// Other elements are stripped while their texts are retained, and the ones such as script are dropped with their contents.
+// Links other than http, https and mailto are removed. Unbalanced elements are closed.
+func Sanitize(s string) string {
+	var b strings.Builder
+	var open []string
+	skip := 0
+	err := walk(s, func(t xml.Token) {
+		switch t := t.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if skip > 0 || dropped[name] {
+				skip++
+				return
+			}
+			attrs, ok := allowed[name]
+			if !ok {
+				return
+			}
+			if last := len(open) - 1; last >= 0 && contains(implied[name], open[last]) {
+				b.WriteString("</" + open[last] + ">")
+				open = open[:last]
+			}
+			b.WriteString("<" + name)
+			for _, a := range t.Attr {
+				if !contains(attrs, strings.ToLower(a.Name.Local)) || !safe(a.Value) {
+					continue
+				}
+				b.WriteString(" " + strings.ToLower(a.Name.Local) + `="` + html.EscapeString(a.Value) + `"`)
+			}
+			b.WriteString(">")
+			if name != "br" {
+				open = append(open, name)
+			}
+		case xml.EndElement:
+			name := t.Name.Local
+			if skip > 0 {
+				skip--
+				return
+			}
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != name {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		case xml.CharData:
+			if skip == 0 {
+				b.WriteString(html.EscapeString(string(t)))
+			}
+		}
+	})
+	if err != nil {
+		return html.EscapeString(PlainText(s))
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+var (
+	markup = regexp.MustCompile(`<[^>]*>`)
+	tag    = regexp.MustCompile(`</?[A-Za-z][A-Za-z0-9]*`)
+)
+
+// PlainText returns text of HTML s, where block elements such as p and br break lines,
+// white spaces are collapsed, and contents of elements such as script are dropped.
+func PlainText(s string) string {
+	var b strings.Builder
+	skip := 0
+	err := walk(s, func(t xml.Token) {
+		switch t := t.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if skip > 0 || dropped[name] {
+				skip++
+			} else if blocks[name] {
+				b.WriteString("\n")
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+			} else if blocks[t.Name.Local] {
+				b.WriteString("\n")
+			}
+		case xml.CharData:
+			if skip == 0 {
+				b.Write(t)
+			}
+		}
+	})
+	text := b.String()
+	if err != nil {
+		text = html.UnescapeString(markup.ReplaceAllString(s, " "))
+	}
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Truncate returns s truncated to at most n runes including ellipsis, which is cut at the last space if any.
+func Truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	if n <= 0 {
+		return ""
+	}
+	runes := []rune(s)[:n-1]
+	for i := len(runes) - 1; i > 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			runes = runes[:i]
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+// walk passes tokens of HTML s to f, whose names of elements are lowercased,
+// where the end of s closes elements which are left open.
+func walk(s string, f func(xml.Token)) error {
+	d := xml.NewDecoder(strings.NewReader(tag.ReplaceAllStringFunc(s, strings.ToLower)))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if e, ok := err.(*xml.SyntaxError); ok && e.Msg == "unexpected EOF" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(t)
+	}
+}
+
+func safe(url string) bool {
+	url = strings.ToLower(strings.TrimSpace(url))
+	for _, scheme := range safeSchemes {
+		if strings.HasPrefix(url, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -16,15 +16,21 @@ module Code
     collectTypes,
     collectAttributes,
     topLevelAttributeCode,
+    codeListNumber,
+    CodeLists (..),
+    codeLists,
+    Issued (..),
+    codeListIssue,
   )
 where
 
 import qualified Data.List as L
 import qualified Data.Map as M
-import Data.Maybe (isJust)
+import Data.Char (isDigit)
+import Data.Maybe (fromMaybe, isJust)
 import Data.Text (Text, pack)
 import qualified Data.Text as T
-import Data.Vector (Vector, fromList)
+import Data.Vector (Vector, fromList, toList)
 import GHC.Generics (Generic)
 import Model (Model, content, contentAttributes, fieldsOfAttribute, findFixedOf, topLevelAttribute, typeToText)
 import Text.Mustache (ToMustache (..), object, (~>))
@@ -51,12 +57,13 @@ data CodeType = CodeType
     description :: Text,
     codes :: Vector Code,
     spaceSeparatable :: Bool,
-    elements :: [Model]
+    elements :: [Model],
+    listNumber :: Maybe Text
   }
   deriving (Generic, Show, Eq, Ord)
 
 instance ToMustache CodeType where
-  toMustache CodeType {xmlReferenceName, description, codes, spaceSeparatable, elements} =
+  toMustache CodeType {xmlReferenceName, description, codes, spaceSeparatable, elements, listNumber} =
     object
       [ "xmlReferenceName" ~> xmlReferenceName,
         "description" ~> description,
@@ -64,14 +71,60 @@ instance ToMustache CodeType where
         "spaceSeparatable" ~> spaceSeparatable,
         "elements" ~> elements,
         "hasCodes" ~> not (null codes),
-        "hasElements" ~> not (null elements)
+        "hasElements" ~> not (null elements),
+        "listNumber" ~> fromMaybe "" listNumber,
+        "hasListNumber" ~> isJust listNumber
       ]
 
+-- | Number of codelist which is named as `List<number>` in codelists schema.
+codeListNumber :: Text -> Maybe Text
+codeListNumber name =
+  case T.stripPrefix "List" name of
+    Just n | not (T.null n) && T.all isDigit n -> Just n
+    _ -> Nothing
+
 type CodeTypes = Vector CodeType
 
+-- | Codelists which code types are defined as, deduplicated and ordered by the number of List.
+newtype CodeLists = CodeLists [CodeType]
+  deriving (Show, Eq)
+
+instance ToMustache CodeLists where
+  toMustache (CodeLists ts) = toMustache ts
+
+codeLists :: [CodeType] -> CodeLists
+codeLists =
+  CodeLists
+    . L.sortOn (\t -> (read . T.unpack . unwrap . listNumber) t :: Int)
+    . L.nubBy (\x y -> listNumber x == listNumber y)
+    . filter (isJust . listNumber)
+
 codeTypes :: [CodeType] -> CodeTypes
 codeTypes = fromList
 
+-- | Code types or codelists together with issue of codelists which they are generated from.
+data Issued a = Issued
+  { issue :: Text,
+    issued :: a
+  }
+  deriving (Show, Eq)
+
+instance ToMustache a => ToMustache (Issued a) where
+  toMustache Issued {issue, issued} =
+    object
+      [ "issue" ~> issue,
+        "types" ~> issued
+      ]
+
+-- | Issue of codelists which comments or documentation of codelists schema tell, such as `Issue 52`.
+codeListIssue :: X.Schema -> Text
+codeListIssue xsd =
+  case concatMap issuesOf (X.schemaComments xsd) of
+    n : _ -> n
+    [] -> unreachable ["Issue of codelists is told by none of comments of schema"]
+  where
+    issuesOf = filter (not . T.null) . map (T.takeWhile isDigit . T.stripStart . T.drop 5 . snd) . T.breakOnAll "Issue"
+
 typeAnnotations :: X.Type -> [X.Annotation]
 typeAnnotations ty =
   case ty of
@@ -96,7 +149,8 @@ topLevelTypeToCode _scm (ref, X.TypeSimple (X.AtomicType restriction annotations
       description = description,
       codes = fromList codes,
       spaceSeparatable = False,
-      elements = []
+      elements = [],
+      listNumber = Nothing
     }
   where
     description = T.intercalate ". " . map (\(X.Documentation x) -> x) $ annotations
@@ -135,7 +189,8 @@ topLevelTypeToCode scm (ref, X.TypeSimple (X.ListType ty _)) =
               description = desc,
               codes = fromList codes_,
               spaceSeparatable = spaceSeparatable_,
-              elements = []
+              elements = [],
+              listNumber = codeListNumber (X.qnName key_)
             }
     X.Inline _ -> throw Unreachable
 topLevelTypeToCode _scm (_, X.TypeSimple (X.UnionType _ _)) = throw Unreachable
@@ -190,7 +245,8 @@ topLevelElementToCode scm elm =
           description = desc,
           codes = fromList codes_,
           spaceSeparatable = spaceSeparatable_,
-          elements = elements
+          elements = elements,
+          listNumber = keyOfType >>= codeListNumber . X.qnName
         }
 
 constraintToCode :: X.Constraint -> Code
@@ -231,7 +287,8 @@ topLevelAttributeCode scm (X.InlineAttribute X.AttributeInline {X.attributeInlin
             description = description,
             codes = fromList codes_,
             elements = [],
-            spaceSeparatable = spaceSeparatable
+            spaceSeparatable = spaceSeparatable,
+            listNumber = codeListNumber typeName
           }
       ]
 
@@ -293,3 +350,9 @@ instance GenSchema CodeTypes where
       codeTypesFromElements = (map (topLevelElementToCode xsd) . collectCodes) xsd
       codeTypesFromAttributes = (uniq . concatMap (topLevelAttributeCode xsd) . collectAttributes) xsd
       ts = codeTypesFromTypes ++ codeTypesFromElements ++ codeTypesFromAttributes
+
+instance GenSchema CodeLists where
+  readSchema xsd = codeLists . toList $ (readSchema xsd :: CodeTypes)
+
+instance GenSchema a => GenSchema (Issued a) where
+  readSchema xsd = Issued (codeListIssue xsd) (readSchema xsd)
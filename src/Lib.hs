@@ -4,6 +4,7 @@
 module Lib
   ( render,
     compile,
+    defaultSchema,
     Language (..),
     SchemaVersion (..),
   )
@@ -13,6 +14,10 @@ import qualified Code as C
 import Data.Text (unpack)
 import qualified Mixed as Mi
 import qualified Model as M
+import qualified Opt as O
+import System.Directory (createDirectoryIfMissing)
+import System.FilePath (takeDirectory)
+import qualified Tag as T
 import Text.Mustache (Template, automaticCompile, substitute)
 import Text.Parsec.Error (ParseError)
 import Util
@@ -38,14 +43,108 @@ data Renderer
   = Model
   | Mixed
   | Code
+  | Codelists
+  | CodelistTypes
+  | Accessor
+  | Amount
+  | Attributes
+  | Audience
+  | Barcode
+  | Builder
+  | Collection
+  | Comparison
+  | Composition
+  | Contact
+  | Contributor
+  | Copy
+  | Date
+  | Descriptive
+  | Diff
+  | Discount
+  | Edition
+  | Extension
+  | Extent
+  | Flag
+  | Form
+  | Header
+  | Illustration
+  | Languages
+  | Lifecycle
+  | Market
+  | Measure
+  | Media
+  | Opt
+  | Relation
+  | Release31
+  | Report
+  | Restriction
+  | Price
+  | Promotion
+  | Publisher
   | Reader
+  | Subject
+  | Supplier
+  | Supply
+  | Tags
+  | Tax
+  | Title
+  | Usage
+  | Validator
+  | Writer
   deriving (Show)
 
 file :: Renderer -> String
 file Model = "model"
 file Mixed = "mixed"
 file Code = "code"
+file Codelists = "codelists/codelists"
+file CodelistTypes = "codelists/types"
+file Accessor = "accessor"
+file Amount = "amount"
+file Attributes = "attributes"
+file Audience = "audience"
+file Barcode = "barcode"
+file Builder = "builder"
+file Collection = "collection"
+file Comparison = "comparison"
+file Composition = "composition"
+file Contact = "contact"
+file Contributor = "contributor"
+file Copy = "copy"
+file Date = "date"
+file Descriptive = "descriptive"
+file Diff = "diff"
+file Discount = "discount"
+file Edition = "edition"
+file Extension = "extension"
+file Extent = "extent"
+file Flag = "flag"
+file Form = "form"
+file Header = "header"
+file Illustration = "illustration"
+file Languages = "language"
+file Lifecycle = "lifecycle"
+file Market = "market"
+file Measure = "measure"
+file Media = "media"
+file Opt = "opt"
+file Relation = "relation"
+file Release31 = "release"
+file Report = "report"
+file Restriction = "restriction"
+file Price = "price"
+file Promotion = "promotion"
+file Publisher = "publisher"
 file Reader = "reader"
+file Subject = "subject"
+file Supplier = "supplier"
+file Supply = "supply"
+file Tags = "tags"
+file Tax = "tax"
+file Title = "title"
+file Usage = "usage"
+file Validator = "validator"
+file Writer = "writer"
 
 template :: Language -> SchemaVersion -> [FilePath]
 template TypeScript version = [".", "template/typescript/" ++ show version]
@@ -53,9 +152,56 @@ template Go version = [".", "template/go/" ++ show version]
 
 compiledTemplate :: Renderer -> Language -> SchemaVersion -> IO (Either ParseError Template)
 compiledTemplate Code l version = automaticCompile (template l version) "code.mustache"
+compiledTemplate Accessor l version = automaticCompile (template l version) "accessor.mustache"
+compiledTemplate Amount l version = automaticCompile (template l version) "amount.mustache"
+compiledTemplate Attributes l version = automaticCompile (template l version) "attributes.mustache"
+compiledTemplate Codelists l version = automaticCompile (template l version) "codelists.mustache"
+compiledTemplate CodelistTypes l version = automaticCompile (template l version) "codelists_types.mustache"
+compiledTemplate Audience l version = automaticCompile (template l version) "audience.mustache"
+compiledTemplate Barcode l version = automaticCompile (template l version) "barcode.mustache"
+compiledTemplate Builder l version = automaticCompile (template l version) "builder.mustache"
+compiledTemplate Collection l version = automaticCompile (template l version) "collection.mustache"
+compiledTemplate Comparison l version = automaticCompile (template l version) "comparison.mustache"
+compiledTemplate Composition l version = automaticCompile (template l version) "composition.mustache"
+compiledTemplate Contact l version = automaticCompile (template l version) "contact.mustache"
+compiledTemplate Contributor l version = automaticCompile (template l version) "contributor.mustache"
+compiledTemplate Copy l version = automaticCompile (template l version) "copy.mustache"
+compiledTemplate Date l version = automaticCompile (template l version) "date.mustache"
+compiledTemplate Descriptive l version = automaticCompile (template l version) "descriptive.mustache"
+compiledTemplate Diff l version = automaticCompile (template l version) "diff.mustache"
+compiledTemplate Discount l version = automaticCompile (template l version) "discount.mustache"
+compiledTemplate Edition l version = automaticCompile (template l version) "edition.mustache"
+compiledTemplate Extension l version = automaticCompile (template l version) "extension.mustache"
+compiledTemplate Extent l version = automaticCompile (template l version) "extent.mustache"
+compiledTemplate Flag l version = automaticCompile (template l version) "flag.mustache"
+compiledTemplate Form l version = automaticCompile (template l version) "form.mustache"
+compiledTemplate Header l version = automaticCompile (template l version) "header.mustache"
+compiledTemplate Illustration l version = automaticCompile (template l version) "illustration.mustache"
+compiledTemplate Languages l version = automaticCompile (template l version) "language.mustache"
+compiledTemplate Lifecycle l version = automaticCompile (template l version) "lifecycle.mustache"
+compiledTemplate Market l version = automaticCompile (template l version) "market.mustache"
+compiledTemplate Measure l version = automaticCompile (template l version) "measure.mustache"
+compiledTemplate Media l version = automaticCompile (template l version) "media.mustache"
+compiledTemplate Opt l version = automaticCompile (template l version) "opt.mustache"
+compiledTemplate Relation l version = automaticCompile (template l version) "relation.mustache"
+compiledTemplate Release31 l version = automaticCompile (template l version) "release.mustache"
+compiledTemplate Report l version = automaticCompile (template l version) "report.mustache"
+compiledTemplate Restriction l version = automaticCompile (template l version) "restriction.mustache"
+compiledTemplate Price l version = automaticCompile (template l version) "price.mustache"
+compiledTemplate Promotion l version = automaticCompile (template l version) "promotion.mustache"
+compiledTemplate Publisher l version = automaticCompile (template l version) "publisher.mustache"
 compiledTemplate Model l version = automaticCompile (template l version) "model.mustache"
 compiledTemplate Mixed l version = automaticCompile (template l version) "mixed.mustache"
 compiledTemplate Reader l version = automaticCompile (template l version) "reader.mustache"
+compiledTemplate Subject l version = automaticCompile (template l version) "subject.mustache"
+compiledTemplate Supplier l version = automaticCompile (template l version) "supplier.mustache"
+compiledTemplate Supply l version = automaticCompile (template l version) "supply.mustache"
+compiledTemplate Tags l version = automaticCompile (template l version) "tags.mustache"
+compiledTemplate Tax l version = automaticCompile (template l version) "tax.mustache"
+compiledTemplate Title l version = automaticCompile (template l version) "title.mustache"
+compiledTemplate Usage l version = automaticCompile (template l version) "usage.mustache"
+compiledTemplate Validator l version = automaticCompile (template l version) "validator.mustache"
+compiledTemplate Writer l version = automaticCompile (template l version) "writer.mustache"
 
 generateTo :: Language -> SchemaVersion -> String
 generateTo Go V2 = "generated/go/v2"
@@ -66,28 +212,85 @@ generateTo TypeScript V3 = "generated/typescript/v3"
 fileName :: Renderer -> Language -> String
 fileName r l = file r ++ ext l
 
-compile :: Renderer -> Language -> SchemaVersion -> IO String
-compile r l version = do
+-- | Reference schema which is fetched by `make schema`.
+defaultSchema :: SchemaVersion -> String
+defaultSchema V2 = "./schema/v2/ONIX_BookProduct_Release2.1_reference.xsd"
+defaultSchema V3 = "./schema/v3/ONIX_BookProduct_3.0_reference.xsd"
+
+-- | Compile template with reference schema, which is a path of local file or URL.
+compile :: Renderer -> Language -> SchemaVersion -> String -> IO String
+compile r l version source = do
   compiled <- compiledTemplate r l version
-  xsd <- X.getSchema schemaRoot
+  xsd <- X.getSchema source
   return $
     case (compiled, r) of
       (Left err, _) -> throw $ ParseErr err
-      (Right t, Code) -> unpack $ substitute t (readSchema xsd :: C.CodeTypes)
+      (Right t, Code) -> unpack $ substitute t (readSchema xsd :: C.Issued C.CodeTypes)
+      (Right t, Codelists) -> unpack $ substitute t (readSchema xsd :: C.Issued C.CodeLists)
+      (Right t, CodelistTypes) -> unpack $ substitute t (readSchema xsd :: C.CodeTypes)
       (Right t, Mixed) -> unpack $ substitute t (readSchema xsd :: [Mi.Mixed])
       (Right t, Model) -> unpack $ substitute t (readSchema xsd :: M.Models)
+      (Right t, Copy) -> unpack $ substitute t (readSchema xsd :: M.Models)
+      (Right t, Accessor) -> unpack $ substitute t ()
+      (Right t, Amount) -> unpack $ substitute t ()
+      (Right t, Attributes) -> unpack $ substitute t ()
+      (Right t, Audience) -> unpack $ substitute t ()
+      (Right t, Barcode) -> unpack $ substitute t ()
+      (Right t, Builder) -> unpack $ substitute t ()
+      (Right t, Collection) -> unpack $ substitute t ()
+      (Right t, Comparison) -> unpack $ substitute t ()
+      (Right t, Composition) -> unpack $ substitute t ()
+      (Right t, Contact) -> unpack $ substitute t ()
+      (Right t, Contributor) -> unpack $ substitute t ()
+      (Right t, Date) -> unpack $ substitute t ()
+      (Right t, Descriptive) -> unpack $ substitute t ()
+      (Right t, Diff) -> unpack $ substitute t ()
+      (Right t, Discount) -> unpack $ substitute t ()
+      (Right t, Edition) -> unpack $ substitute t ()
+      (Right t, Extension) -> unpack $ substitute t ()
+      (Right t, Extent) -> unpack $ substitute t ()
+      (Right t, Flag) -> unpack $ substitute t ()
+      (Right t, Form) -> unpack $ substitute t ()
+      (Right t, Header) -> unpack $ substitute t ()
+      (Right t, Illustration) -> unpack $ substitute t ()
+      (Right t, Languages) -> unpack $ substitute t ()
+      (Right t, Lifecycle) -> unpack $ substitute t ()
+      (Right t, Market) -> unpack $ substitute t ()
+      (Right t, Measure) -> unpack $ substitute t ()
+      (Right t, Media) -> unpack $ substitute t ()
+      (Right t, Opt) -> unpack $ substitute t (readSchema xsd :: O.Opts)
+      (Right t, Relation) -> unpack $ substitute t ()
+      (Right t, Release31) -> unpack $ substitute t ()
+      (Right t, Report) -> unpack $ substitute t ()
+      (Right t, Restriction) -> unpack $ substitute t ()
+      (Right t, Price) -> unpack $ substitute t ()
+      (Right t, Promotion) -> unpack $ substitute t ()
+      (Right t, Publisher) -> unpack $ substitute t ()
       (Right t, Reader) -> unpack $ substitute t ()
-  where
-    schemaRoot =
-      "./schema"
-        ++ ( case version of
-               V2 -> "/v2/ONIX_BookProduct_Release2.1_reference.xsd"
-               V3 -> "/v3/ONIX_BookProduct_3.0_reference.xsd"
-           )
-
-render :: Language -> SchemaVersion -> IO ()
-render l version = do
-  compile Mixed l version >>= writeFile (generateTo l version ++ "/" ++ fileName Mixed l)
-  compile Code l version >>= writeFile (generateTo l version ++ "/" ++ fileName Code l)
-  compile Model l version >>= writeFile (generateTo l version ++ "/" ++ fileName Model l)
-  compile Reader l version >>= writeFile (generateTo l version ++ "/" ++ fileName Reader l)
+      (Right t, Subject) -> unpack $ substitute t ()
+      (Right t, Supplier) -> unpack $ substitute t ()
+      (Right t, Supply) -> unpack $ substitute t ()
+      (Right t, Tags) -> unpack $ substitute t (readSchema xsd :: T.Tags)
+      (Right t, Tax) -> unpack $ substitute t ()
+      (Right t, Title) -> unpack $ substitute t ()
+      (Right t, Usage) -> unpack $ substitute t ()
+      (Right t, Validator) -> unpack $ substitute t ()
+      (Right t, Writer) -> unpack $ substitute t ()
+
+-- | Renderers of each language.
+-- Accessors, editions and prices are only for 2.1, whose product holds such values in flat elements which 3.0 has moved into composites.
+-- Tax composites, comparison prices, usage constraints, markets, product contacts and compatibility with 3.1 are only for 3.0 which defines them.
+renderers :: Language -> SchemaVersion -> [Renderer]
+renderers Go V2 = [Mixed, Code, Codelists, CodelistTypes, Model, Amount, Attributes, Accessor, Audience, Barcode, Builder, Collection, Composition, Contributor, Copy, Date, Descriptive, Diff, Discount, Edition, Extension, Extent, Flag, Form, Header, Illustration, Languages, Lifecycle, Measure, Media, Opt, Price, Promotion, Publisher, Reader, Relation, Report, Restriction, Subject, Supplier, Supply, Tags, Title, Validator, Writer]
+renderers Go V3 = [Mixed, Code, Codelists, CodelistTypes, Model, Amount, Attributes, Audience, Barcode, Builder, Collection, Comparison, Composition, Contact, Contributor, Copy, Date, Descriptive, Diff, Discount, Extension, Extent, Flag, Form, Header, Illustration, Languages, Lifecycle, Market, Measure, Media, Opt, Promotion, Publisher, Reader, Relation, Release31, Report, Restriction, Subject, Supplier, Supply, Tags, Tax, Title, Usage, Validator, Writer]
+renderers TypeScript _ = [Mixed, Code, Model, Reader]
+
+render :: Language -> SchemaVersion -> String -> IO ()
+render l version source =
+  mapM_
+    ( \r -> do
+        let path = generateTo l version ++ "/" ++ fileName r l
+        createDirectoryIfMissing True (takeDirectory path)
+        compile r l version source >>= writeFile path
+    )
+    (renderers l version)
@@ -70,9 +70,12 @@ instance ToMustache Model where
      in object $
           [ "shortname" ~> shortname,
             "xmlReferenceName" ~> xmlReferenceName,
+            "jsonName" ~> T.append (camelCase xmlReferenceName) (if iterable then "s" else ""),
             "is_tag" ~> (kind == Tag),
             "optional" ~> optional,
             "iterable" ~> iterable,
+            "is_flag" ~> (typeName == Just flagType),
+            "is_datestamp" ~> (xmlReferenceName == "Datestamp"),
             "elements" ~> elements
           ]
             ++ typeName_
@@ -101,15 +104,29 @@ collectElements =
     . M.filter
       ( \case
           X.ElementInline
-            { X.elementType = X.Inline (X.TypeComplex X.ComplexType {X.complexContent = X.ContentPlain _, X.complexMixed = False}),
+            { X.elementType = X.Inline (X.TypeComplex X.ComplexType {X.complexContent = X.ContentPlain c, X.complexMixed = False}),
               X.elementName = X.QName {X.qnNamespace = Just _}
-            } -> True
+            } -> not $ isFlag c
           _ -> False
       )
     . X.schemaElements
 
+-- | Type of element of text, which is PlainText of the generated package to keep attributes such as datestamp along with the text.
 configurableType :: Text
-configurableType = pack "string"
+configurableType = pack "PlainText"
+
+-- | Type of empty element whose presence is what it tells, which is Flag of the generated package instead of a composite of attributes.
+flagType :: Text
+flagType = pack "Flag"
+
+-- | Reference names of empty elements which are defined as flags, since some composites are empty only because their content is not modeled yet.
+flags :: [Text]
+flags = ["NoCollection", "NoContributor", "NoEdition", "NoPrefix", "NoProduct", "NoSeries", "MainSubject", "PrimaryPart", "TaxExempt"]
+
+isFlag :: X.PlainContent -> Bool
+isFlag X.PlainContent {X.plainContentModel = Nothing, X.plainContentAttributes} =
+  maybe False (`elem` flags) $ findFixedOf "refname" plainContentAttributes
+isFlag _ = False
 
 findFixedOf :: String -> [X.Attribute] -> Maybe Text
 findFixedOf s =
@@ -165,10 +182,14 @@ typeToText (X.TypeComplex X.ComplexType {X.complexContent}) = case complexConten
       then unwrap $ findFixedOf "refname" simpleExtensionAttributes
       else case qnName of
         "NonEmptyString" -> configurableType
-        _ -> sanitizeName qnName
+        _
+          | T.isPrefixOf "dt." qnName -> configurableType
+          | otherwise -> sanitizeName qnName
     where
       qnName = X.qnName simpleExtensionBase
-  X.ContentPlain (X.PlainContent _mdg annotations) -> fromMaybe configurableType $ findFixedOf "refname" annotations
+  X.ContentPlain c@(X.PlainContent _mdg annotations)
+    | isFlag c -> flagType
+    | otherwise -> fromMaybe configurableType $ findFixedOf "refname" annotations
   X.ContentComplex (X.ComplexContentExtension X.ComplexExtension {X.complexExtensionBase = X.QName {X.qnName}}) -> qnName
   X.ContentComplex (X.ComplexContentRestriction x) ->
     unimplemented ["ComplexContentRestriction", show x]
@@ -247,6 +268,8 @@ fieldsOfElementOfChoiceInChild docOfRef =
               map (makeOptional . extendOccurs occurs)
                 . fieldsOfElementOfChoiceInChild docOfRef
                 $ ss
+            (X.GroupOfChoice occurs key) ->
+              map (extendOccurs occurs) . fieldsOfGroup docOfRef $ key
         )
     )
 
@@ -280,11 +303,20 @@ fieldsOfElement docOfRef (X.Sequence xs) =
               . fieldsOfElement docOfRef
               . X.Sequence
               $ ys
+          (X.Inline (X.GroupOfSequence occurs key)) ->
+            map (extendOccurs occurs) . fieldsOfGroup docOfRef $ key
       )
     $ xs
 fieldsOfElement docOfRef (X.Choice xs) = (dropDuplicate . map makeOptional . fieldsOfElementOfChoiceInChild docOfRef) xs
 fieldsOfElement _docOfRef (X.All _xs) = []
 
+-- | Fields of a named model group, which are spliced into the composite referring to it.
+fieldsOfGroup :: X.Schema -> X.QName -> [Model]
+fieldsOfGroup docOfRef key =
+  case (M.lookup key . X.schemaGroups) docOfRef of
+    Just mdgrp -> fieldsOfElement docOfRef mdgrp
+    Nothing -> []
+
 content :: X.ElementInline -> Maybe X.Content
 content
   X.ElementInline
@@ -0,0 +1,51 @@
+{-# LANGUAGE DeriveGeneric #-}
+{-# LANGUAGE FlexibleInstances #-}
+{-# LANGUAGE NamedFieldPuns #-}
+{-# LANGUAGE OverloadedStrings #-}
+
+module Opt (Opt (..), Opts, opts, modelToOpts) where
+
+import Data.Text (Text)
+import Data.Vector (Vector, fromList, toList)
+import GHC.Generics (Generic)
+import qualified Model as Md
+import Text.Mustache (ToMustache (..), object, (~>))
+import Util
+
+-- | Optional element or attribute which is held by pointer, with name of the composite which has it.
+data Opt = Opt
+  { owner :: Text,
+    xmlReferenceName :: Text,
+    typeName :: Text
+  }
+  deriving (Generic, Show, Eq, Ord)
+
+instance ToMustache Opt where
+  toMustache Opt {owner, xmlReferenceName, typeName} =
+    object
+      [ "owner" ~> owner,
+        "xmlReferenceName" ~> xmlReferenceName,
+        "typeName" ~> typeName
+      ]
+
+type Opts = Vector Opt
+
+opts :: [Opt] -> Opts
+opts = fromList
+
+modelToOpts :: Md.Model -> [Opt]
+modelToOpts m =
+  [ Opt (Md.xmlReferenceName m) (Md.xmlReferenceName e) t
+    | e <- Md.elements m,
+      Md.optional e,
+      not (Md.iterable e),
+      Just t <- [Md.typeName e],
+      t /= "Flag"
+  ]
+
+instance GenSchema Opts where
+  readSchema xsd =
+    opts
+      . concatMap modelToOpts
+      . toList
+      $ (readSchema xsd :: Md.Models)
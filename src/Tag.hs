@@ -0,0 +1,46 @@
+{-# LANGUAGE DeriveGeneric #-}
+{-# LANGUAGE FlexibleInstances #-}
+{-# LANGUAGE NamedFieldPuns #-}
+{-# LANGUAGE OverloadedStrings #-}
+
+module Tag (Tag (..), Tags, tags, modelToTags) where
+
+import Data.Text (Text)
+import Data.Vector (Vector, fromList, toList)
+import GHC.Generics (Generic)
+import qualified Model as Md
+import Text.Mustache (ToMustache (..), object, (~>))
+import Util
+
+-- | Pair of reference tag name and short tag name of an element.
+data Tag = Tag
+  { xmlReferenceName :: Text,
+    shortname :: Text
+  }
+  deriving (Generic, Show, Eq, Ord)
+
+instance ToMustache Tag where
+  toMustache Tag {xmlReferenceName, shortname} =
+    object
+      [ "xmlReferenceName" ~> xmlReferenceName,
+        "shortname" ~> shortname
+      ]
+
+type Tags = Vector Tag
+
+tags :: [Tag] -> Tags
+tags = fromList
+
+modelToTags :: Md.Model -> [Tag]
+modelToTags m =
+  case Md.kind m of
+    Md.Tag -> Tag (Md.xmlReferenceName m) (Md.shortname m) : concatMap modelToTags (Md.elements m)
+    Md.Attribute -> []
+
+instance GenSchema Tags where
+  readSchema xsd =
+    tags
+      . uniq
+      . concatMap modelToTags
+      . toList
+      $ (readSchema xsd :: Md.Models)
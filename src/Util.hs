@@ -13,10 +13,12 @@ module Util
     sanitizeName,
     uniqBy,
     unwords',
+    camelCase,
   )
 where
 
 import Control.Exception (Exception, throw)
+import Data.Char (isLower, isUpper)
 import Data.List (intercalate)
 import qualified Data.Set as S
 import qualified Data.Text as T
@@ -65,6 +67,8 @@ typeNameToReferenceName :: T.Text -> T.Text -> T.Text
 typeNameToReferenceName name "string" = T.toTitle name
 typeNameToReferenceName name "token" = T.toTitle name
 typeNameToReferenceName name "anySimpleType" = T.toTitle name
+-- Built-in types of XSD such as int are titled, so that they do not shadow predeclared identifiers of Go.
+typeNameToReferenceName _ "int" = "Int"
 typeNameToReferenceName name x =
   if T.isPrefixOf "List" x
     then T.concat [T.toTitle name, x]
@@ -77,5 +81,13 @@ sanitizeName x =
     [y] -> y
     y : ys -> T.concat $ [T.toTitle y, "Dot"] ++ ys
 
+-- | Lower leading acronym of name as a whole, so that `IDValue` becomes `idValue`.
+camelCase :: T.Text -> T.Text
+camelCase x =
+  case T.span isUpper x of
+    (upper, rest)
+      | T.length upper > 1 && maybe False (isLower . fst) (T.uncons rest) -> T.concat [T.toLower (T.init upper), T.singleton (T.last upper), rest]
+      | otherwise -> T.append (T.toLower upper) rest
+
 class (ToMustache a) => GenSchema a where
   readSchema :: Schema -> a
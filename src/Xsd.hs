@@ -27,7 +27,9 @@ import Xsd.Types
 data Schema = Schema
   { schemaTypes :: Map QName Type,
     schemaElements :: Map QName ElementInline,
-    schemaAttributes :: Map QName Attribute
+    schemaAttributes :: Map QName Attribute,
+    schemaGroups :: Map QName ModelGroup,
+    schemaComments :: [Text.Text]
   }
   deriving (Show)
 
@@ -36,7 +38,9 @@ emptySchema =
   Schema
     { schemaTypes = Map.empty,
       schemaElements = Map.empty,
-      schemaAttributes = Map.empty
+      schemaAttributes = Map.empty,
+      schemaGroups = Map.empty,
+      schemaComments = []
     }
 
 mergeSchemata :: Schema -> Schema -> Schema
@@ -44,7 +48,9 @@ mergeSchemata s1 s2 =
   Schema
     { schemaTypes = schemaTypes s1 <> schemaTypes s2,
       schemaElements = schemaElements s1 <> schemaElements s2,
-      schemaAttributes = schemaAttributes s1 <> schemaAttributes s2
+      schemaAttributes = schemaAttributes s1 <> schemaAttributes s2,
+      schemaGroups = schemaGroups s1 <> schemaGroups s2,
+      schemaComments = schemaComments s1 <> schemaComments s2
     }
 
 instance Semigroup Schema where
@@ -117,16 +123,19 @@ xsdToSchema xsd =
   Schema
     { schemaTypes = Map.fromList types,
       schemaElements = Map.fromList elements,
-      schemaAttributes = Map.fromList attributes
+      schemaAttributes = Map.fromList attributes,
+      schemaGroups = Map.fromList groups,
+      schemaComments = comments xsd
     }
   where
-    (types, elements, attributes) = go ([], [], []) (children xsd)
+    (types, elements, attributes, groups) = go ([], [], [], []) (children xsd)
     go res [] = res
-    go (ts, es, as) (c : cs) = case c of
-      ChildType n t -> go ((n, t) : ts, es, as) cs
-      ChildElement (InlineElement e) -> go (ts, (elementName e, e) : es, as) cs
-      ChildAttribute (AttributeGroupInline n as') -> go (ts, es, (n, AttributeGroupInline n as') : as) cs
-      _ -> go (ts, es, as) cs
+    go (ts, es, as, gs) (c : cs) = case c of
+      ChildType n t -> go ((n, t) : ts, es, as, gs) cs
+      ChildElement (InlineElement e) -> go (ts, (elementName e, e) : es, as, gs) cs
+      ChildAttribute (AttributeGroupInline n as') -> go (ts, es, (n, AttributeGroupInline n as') : as, gs) cs
+      ChildGroup n g -> go (ts, es, as, (n, g) : gs) cs
+      _ -> go (ts, es, as, gs) cs
 
 fetchXsd :: URI -> IO Xsd
 fetchXsd uri = do
@@ -16,6 +16,7 @@ import Control.Monad.Trans.Except
 import Control.Monad.Trans.Reader
 import qualified Data.ByteString.Lazy as Lazy (ByteString)
 import qualified Data.Map as Map
+import Data.List (groupBy)
 import Data.Maybe
 import Data.Text (Text)
 import qualified Data.Text as Text
@@ -32,9 +33,11 @@ parseSchema c = do
   children <-
     local (\env -> env {envTargetNamespace = targetNamespace}) $
       parseChildren c
+  annotations <- parseAnnotations c
   return
     Xsd
       { Xsd.targetNamespace = targetNamespace,
+        Xsd.comments = [t | NodeComment t <- node <$> child c] ++ [t | Xsd.Documentation t <- annotations],
         Xsd.children = children
       }
 
@@ -55,8 +58,17 @@ parseChild c = do
     "include" -> Just . Xsd.ChildInclude <$> parseInclude c
     "import" -> Just . Xsd.ChildImport <$> parseImport c
     "attributeGroup" -> Just . Xsd.ChildAttribute <$> parseAttributeGroup c
+    "group" -> Just <$> parseTopGroup c
     _ -> return Nothing
 
+parseTopGroup :: Cursor -> P Xsd.Child
+parseTopGroup c = do
+  name <- theAttribute "name" c >>= makeTargetQName
+  model <- parseModelGroup c
+  case model of
+    Just m -> return $ Xsd.ChildGroup name m
+    Nothing -> parseError c "Expected model group of group"
+
 parseInclude :: Cursor -> P Xsd.Include
 parseInclude c = do
   location <- theAttribute "schemaLocation" c
@@ -343,60 +355,75 @@ parseModelGroup c = do
   sequenceAxis <- makeElemAxis "sequence"
   choiceAxis <- makeElemAxis "choice"
   allAxis <- makeElemAxis "all"
-  case (c $/ sequenceAxis, c $/ choiceAxis, c $/ allAxis) of
-    ([], [], []) -> return Nothing
-    ([g], [], []) -> Just <$> (Xsd.Sequence <$> parseSequence g)
-    ([], [g], []) -> Just <$> (Xsd.Choice <$> parseChoice g)
-    ([], [], [g]) -> Just <$> parseAll g
+  groupAxis <- makeElemAxis "group"
+  case (c $/ sequenceAxis, c $/ choiceAxis, c $/ allAxis, c $/ groupAxis) of
+    ([], [], [], []) -> return Nothing
+    ([g], [], [], []) -> Just <$> (Xsd.Sequence <$> parseSequence g)
+    ([], [g], [], []) -> Just <$> (Xsd.Choice <$> parseChoice g)
+    ([], [], [g], []) -> Just <$> parseAll g
+    ([], [], [], [g]) -> Just . Xsd.Sequence . (: []) . Xsd.Inline . uncurry Xsd.GroupOfSequence <$> parseGroupRef g
     _ -> parseError c "Multiple model groups"
 
-flt :: [P [a]] -> P [a]
-flt [] = return []
-flt [x] = x
-flt (x : xs) = do
-  y <- x
-  (y ++) <$> flt xs
+-- | Child of sequence or choice, which keeps the order of the children in the document.
+data Particle
+  = ParticleElement Xsd.Element
+  | ParticleChoice [Xsd.RefOr Xsd.ChoiceInChild]
+  | ParticleSequence [Xsd.RefOr Xsd.SequenceInChild]
+  | ParticleGroup Xsd.Occurs Xsd.QName
+
+parseParticles :: Cursor -> P [Particle]
+parseParticles c = catMaybes <$> mapM parseParticle (c $/ anyElement)
+
+parseParticle :: Cursor -> P (Maybe Particle)
+parseParticle c = do
+  name <- getElementName c
+  case name of
+    "element" -> Just . ParticleElement <$> parseElementOrRef c
+    "choice" -> Just . ParticleChoice <$> parseChoice c
+    "sequence" -> Just . ParticleSequence <$> parseSequence c
+    "group" -> Just . uncurry ParticleGroup <$> parseGroupRef c
+    _ -> return Nothing
+
+parseGroupRef :: Cursor -> P (Xsd.Occurs, Xsd.QName)
+parseGroupRef c = do
+  occurs <- parseOccurs c
+  ref <- theAttribute "ref" c >>= makeQName c
+  return (occurs, ref)
+
+-- | Put consecutive particles of the same kind together, whereas each reference to a group stands alone.
+runsOf :: [Particle] -> [[Particle]]
+runsOf = groupBy sameKind
+  where
+    sameKind (ParticleElement _) (ParticleElement _) = True
+    sameKind (ParticleChoice _) (ParticleChoice _) = True
+    sameKind (ParticleSequence _) (ParticleSequence _) = True
+    sameKind _ _ = False
 
 parseSequence :: Cursor -> P [Xsd.RefOr Xsd.SequenceInChild]
 parseSequence c = do
-  choiceAxis <- makeElemAxis "choice"
-  sequenceAxis <- makeElemAxis "sequence"
-
-  choices' <- (flt . map parseChoice) (c $/ choiceAxis)
-  elements' <- parseElements c
-  sequences' <- (flt . map parseSequence) (c $/ sequenceAxis)
+  particles <- parseParticles c
   occurs <- parseOccurs c
 
-  return $ case (choices', elements', sequences') of
-    ([], [], []) -> []
-    (xs, [], []) -> [Xsd.Inline $ Xsd.ChoiceOfSequence occurs xs]
-    ([], xs, []) -> [Xsd.Inline $ Xsd.ElementOfSequence occurs xs]
-    ([], [], xs) -> [Xsd.Inline $ Xsd.SequenceInSequence occurs xs]
-    ([], xs, ys) -> [Xsd.Inline $ Xsd.ElementOfSequence occurs xs, Xsd.Inline $ Xsd.SequenceInSequence occurs ys]
-    (xs, [], ys) -> [Xsd.Inline $ Xsd.ChoiceOfSequence occurs xs, Xsd.Inline $ Xsd.SequenceInSequence occurs ys]
-    (xs, ys, []) -> [Xsd.Inline $ Xsd.ChoiceOfSequence occurs xs, Xsd.Inline $ Xsd.ElementOfSequence occurs ys]
-    (xs, ys, zs) -> [Xsd.Inline $ Xsd.ChoiceOfSequence occurs xs, Xsd.Inline $ Xsd.ElementOfSequence occurs ys, Xsd.Inline $ Xsd.SequenceInSequence occurs zs]
+  let inSequence run = case run of
+        (ParticleGroup o n : _) -> Xsd.GroupOfSequence o n
+        (ParticleChoice _ : _) -> Xsd.ChoiceOfSequence occurs [x | ParticleChoice xs <- run, x <- xs]
+        (ParticleSequence _ : _) -> Xsd.SequenceInSequence occurs [x | ParticleSequence xs <- run, x <- xs]
+        _ -> Xsd.ElementOfSequence occurs [x | ParticleElement x <- run]
+
+  return . map (Xsd.Inline . inSequence) . runsOf $ particles
 
 parseChoice :: Cursor -> P [Xsd.RefOr Xsd.ChoiceInChild]
 parseChoice c = do
-  choiceAxis <- makeElemAxis "choice"
-  sequenceAxis <- makeElemAxis "sequence"
-  elements' <- parseElements c
-  sequences' <- (flt . map parseSequence) (c $/ sequenceAxis)
-  choices' <- (flt . map parseChoice) (c $/ choiceAxis)
+  particles <- parseParticles c
   occurs <- parseOccurs c
 
-  let choices = case (elements', sequences', choices') of
-        ([], [], []) -> []
-        (xs, [], []) -> [Xsd.ElementOfChoice occurs xs]
-        ([], xs, []) -> [Xsd.SequenceOfChoice occurs xs]
-        ([], [], xs) -> [Xsd.ChoiceInChoice occurs xs]
-        (xs, ys, []) -> [Xsd.ElementOfChoice occurs xs, Xsd.SequenceOfChoice occurs ys]
-        (xs, [], ys) -> [Xsd.ElementOfChoice occurs xs, Xsd.ChoiceInChoice occurs ys]
-        ([], xs, ys) -> [Xsd.SequenceOfChoice occurs xs, Xsd.ChoiceInChoice occurs ys]
-        (xs, ys, zs) -> [Xsd.ElementOfChoice occurs xs, Xsd.SequenceOfChoice occurs ys, Xsd.ChoiceInChoice occurs zs]
+  let inChoice run = case run of
+        (ParticleGroup o n : _) -> Xsd.GroupOfChoice o n
+        (ParticleChoice _ : _) -> Xsd.ChoiceInChoice occurs [x | ParticleChoice xs <- run, x <- xs]
+        (ParticleSequence _ : _) -> Xsd.SequenceOfChoice occurs [x | ParticleSequence xs <- run, x <- xs]
+        _ -> Xsd.ElementOfChoice occurs [x | ParticleElement x <- run]
 
-  return $ map Xsd.Inline choices
+  return . map (Xsd.Inline . inChoice) . runsOf $ particles
 
 parseAll :: Cursor -> P Xsd.ModelGroup
 parseAll c = Xsd.All <$> parseElements c
@@ -521,13 +548,14 @@ parse conf doc = do
             envPrefixes = []
           }
       c = fromDocument doc
+      prologue = [t | MiscComment t <- prologueBefore (documentPrologue doc)]
   runExcept $
     flip runReaderT env $
       handleNamespaces c $ do
         schemaAxis <- makeElemAxis "schema"
         case c $.// schemaAxis of
           [] -> parseError c "Not schema found"
-          [s] -> parseSchema s
+          [s] -> (\xsd -> xsd {Xsd.comments = prologue ++ Xsd.comments xsd}) <$> parseSchema s
           _ -> parseError c "Multiple schemata found"
 
 -- | Parse file
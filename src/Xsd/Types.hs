@@ -42,6 +42,8 @@ import Data.Text (Text)
 
 data Xsd = Xsd
   { targetNamespace :: Maybe Namespace,
+    -- | Comments and documentation of the document at the top level, such as the issue of codelists.
+    comments :: [Text],
     children :: [Child]
     -- XXX: Investigate whether there could be any dependency on the order
     -- on children. E.g whether an element can reference type from other
@@ -63,6 +65,7 @@ data Child
   | ChildImport Import
   | ChildInclude Include
   | ChildAttribute Attribute
+  | ChildGroup QName ModelGroup
   deriving (Show, Eq)
 
 newtype Annotation = Documentation Text
@@ -168,12 +171,14 @@ data ChoiceInChild
   = ElementOfChoice Occurs [Element]
   | SequenceOfChoice Occurs [RefOr SequenceInChild]
   | ChoiceInChoice Occurs [RefOr ChoiceInChild]
+  | GroupOfChoice Occurs QName
   deriving (Show, Eq)
 
 data SequenceInChild
   = ElementOfSequence Occurs [Element]
   | ChoiceOfSequence Occurs [RefOr ChoiceInChild]
   | SequenceInSequence Occurs [RefOr SequenceInChild]
+  | GroupOfSequence Occurs QName
   deriving (Show, Eq)
 
 data ModelGroup
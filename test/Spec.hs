@@ -11,11 +11,12 @@ import qualified TestCode as C
 import qualified TestMixed as Mi
 import qualified TestModel as M
 import qualified TestParser as P
+import qualified TestTag as T
 
 main :: IO ()
 main = do
   -- counts2 <- runTestTT (test Mi.tests)
-  counts2 <- runTestTT (test $ M.tests ++ P.tests ++ C.tests ++ Mi.tests)
+  counts2 <- runTestTT (test $ M.tests ++ P.tests ++ C.tests ++ Mi.tests ++ T.tests)
   -- counts2 <- runTestTT (test $ last Mi.tests)
 
   if errors counts2 + failures counts2 == 0
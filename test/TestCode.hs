@@ -35,6 +35,7 @@ tests =
                   )
                   False
                   []
+                  (Just "44")
           assertEqual "can derive description from type" expected actual
       ),
     TestCase
@@ -52,6 +53,7 @@ tests =
                   )
                   False
                   []
+                  (Just "1")
           assertEqual "can parse territory code list" expected actual
       ),
     TestCase
@@ -69,6 +71,7 @@ tests =
                   )
                   True
                   []
+                  (Just "91")
 
           assertEqual "can parse territory code list" expected actual
       ),
@@ -77,7 +80,7 @@ tests =
           scm <- getSchema "./fixtures/test_code_attributes.xsd"
           let actual = (head . topLevelAttributeCode scm . head . collectAttributes) scm
               expected =
-                CodeType "TextFormatCode" "has not document" (V.fromList []) False []
+                CodeType "TextFormatCode" "has not document" (V.fromList []) False [] Nothing
           assertEqual "can parse attributes" expected actual
       ),
     TestCase
@@ -93,7 +96,8 @@ tests =
                     elements =
                       [ Md.Model {Md.shortname = "textformat", Md.xmlReferenceName = "Textformat", Md.typeName = Just "TextFormatCode", Md.kind = Md.Attribute, Md.optional = True, Md.iterable = False, Md.elements = []},
                         Md.Model {Md.shortname = "sourcename", Md.xmlReferenceName = "Sourcename", Md.typeName = Just "Sourcename", Md.kind = Md.Attribute, Md.optional = True, Md.iterable = False, Md.elements = []}
-                      ]
+                      ],
+                    listNumber = Just "91"
                   }
           assertEqual "can parse general attributes" expected actual
       ),
@@ -117,7 +121,8 @@ tests =
                             Md.iterable = False,
                             Md.elements = []
                           }
-                      ]
+                      ],
+                    listNumber = Just "91"
                   }
           assertEqual "can parse enumrationed code refname" expected actual
       ),
@@ -126,7 +131,7 @@ tests =
           scm <- getSchema "./fixtures/test_code_attribute_group_ref.xsd"
           let actual = (uniq . concatMap (topLevelAttributeCode scm) . collectAttributes) scm
               expected =
-                [ CodeType {xmlReferenceName = "Class", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []},
+                [ CodeType {xmlReferenceName = "Class", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing},
                   CodeType
                     { xmlReferenceName = "Dir",
                       description = "has not document",
@@ -136,12 +141,13 @@ tests =
                             Code {value = "rtl", codeDescription = "", notes = ""}
                           ],
                       spaceSeparatable = False,
-                      elements = []
+                      elements = [],
+                      listNumber = Nothing
                     },
-                  CodeType {xmlReferenceName = "ID", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []},
-                  CodeType {xmlReferenceName = "StyleSheet", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []},
-                  CodeType {xmlReferenceName = "XHTMLLanguageCode", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []},
-                  CodeType {xmlReferenceName = "XHTMLText", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []}
+                  CodeType {xmlReferenceName = "ID", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing},
+                  CodeType {xmlReferenceName = "StyleSheet", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing},
+                  CodeType {xmlReferenceName = "XHTMLLanguageCode", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing},
+                  CodeType {xmlReferenceName = "XHTMLText", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing}
                 ]
           assertEqual "can parse enumrationed code refname" expected actual
       ),
@@ -150,7 +156,7 @@ tests =
           scm <- getSchema "./fixtures/test_code_attribute_group_release.xsd"
           let actual = (uniq . concatMap (topLevelAttributeCode scm) . collectAttributes) scm
               expected =
-                [ CodeType {xmlReferenceName = "Release", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []}
+                [ CodeType {xmlReferenceName = "Release", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing}
                 ]
           assertEqual "can parse enumrationed code refname" expected actual
       ),
@@ -159,7 +165,7 @@ tests =
           scm <- getSchema "./fixtures/test_code_attribute_group_dot.xsd"
           let actual = (uniq . concatMap (topLevelAttributeCode scm) . collectAttributes) scm
               expected =
-                [ CodeType {xmlReferenceName = "DtDotNonEmptyString", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = []}
+                [ CodeType {xmlReferenceName = "DtDotNonEmptyString", description = "has not document", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = Nothing}
                 ]
           assertEqual "can parse enumrationed code refname" expected actual
       ),
@@ -173,9 +179,29 @@ tests =
                       description = "Datatype for plausible e-mail address",
                       codes = V.fromList [],
                       spaceSeparatable = False,
-                      elements = []
+                      elements = [],
+                      listNumber = Nothing
                     }
                 ]
           assertEqual "can parse enumrationed code refname" expected actual
+      ),
+    TestCase
+      ( do
+          let actual = map codeListNumber ["List5", "List91", "ListType", "List", "TextFormatCode"]
+              expected = [Just "5", Just "91", Nothing, Nothing, Nothing]
+          assertEqual "can derive number of codelist" expected actual
+      ),
+    TestCase
+      ( do
+          let codeType name number = CodeType {xmlReferenceName = name, description = "", codes = V.fromList [], spaceSeparatable = False, elements = [], listNumber = number}
+              actual = codeLists [codeType "ProductForm" (Just "7"), codeType "TextFormatCode" Nothing, codeType "ProductIDType" (Just "5"), codeType "EpublicationFormat" (Just "7")]
+              expected = CodeLists [codeType "ProductIDType" (Just "5"), codeType "ProductForm" (Just "7")]
+          assertEqual "can collect codelists by number" expected actual
+      ),
+    TestCase
+      ( do
+          scm <- getSchema "./fixtures/test_code_issue.xsd"
+          let actual = codeListIssue scm
+          assertEqual "can derive issue of codelists from comments" "52" actual
       )
   ]
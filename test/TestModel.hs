@@ -89,6 +89,25 @@ expected4 =
         }
     )
 
+simpleContentOf :: Text -> Type
+simpleContentOf base =
+  TypeComplex
+    ( ComplexType
+        { complexAnnotations = [],
+          complexName = Nothing,
+          complexContent =
+            ContentSimple
+              ( SimpleContentExtension
+                  ( SimpleExtension
+                      { simpleExtensionBase = makeTargetQName base,
+                        simpleExtensionAttributes = []
+                      }
+                  )
+              ),
+          complexMixed = False
+        }
+    )
+
 tests =
   [ TestCase
       ( do
@@ -124,6 +143,12 @@ tests =
           let actual = typeToText expected1
           assertEqual "can derive string type" "string" actual
       ),
+    TestCase
+      ( assertEqual
+          "can derive text type of element"
+          ["PlainText", "PlainText"]
+          (map (typeToText . simpleContentOf) ["NonEmptyString", "dt.NonEmptyString"])
+      ),
     TestCase
       ( do
           scm <- getSchema "./fixtures/test_model_list.xsd"
@@ -173,8 +198,8 @@ tests =
                   Tag
                   False
                   False
-                  [ model "j351" "OnOrder" (Just "string") Tag False False [],
-                    model "j302" "ExpectedDate" (Just "string") Tag False False []
+                  [ model "j351" "OnOrder" (Just "PlainText") Tag False False [],
+                    model "j302" "ExpectedDate" (Just "PlainText") Tag False False []
                   ]
           assertEqual "can derive field of type" expected actual
       ),
@@ -191,12 +216,32 @@ tests =
                   Tag
                   False
                   False
-                  [ model "b036" "PersonName" (Just "string") Tag True False [],
-                    model "b047" "CorporateName" (Just "string") Tag True False [],
-                    model "conferencesponsoridentifier" "ConferenceSponsorIdentifier" (Just "string") Tag True False []
+                  [ model "conferencesponsoridentifier" "ConferenceSponsorIdentifier" (Just "PlainText") Tag True False [],
+                    model "b036" "PersonName" (Just "PlainText") Tag True False [],
+                    model "b047" "CorporateName" (Just "PlainText") Tag True False []
                   ]
           assertEqual "can parse sum type" expected actual
       ),
+    TestCase
+      ( do
+          scm <- getSchema "./fixtures/test_model_group.xsd"
+          let key = makeTargetQName "Supplier"
+              actual = (topLevelModels scm . unwrap . M.lookup key . schemaElements) scm
+              expected =
+                model
+                  "supplier"
+                  "Supplier"
+                  Nothing
+                  Tag
+                  False
+                  False
+                  [ model "j292" "SupplierRole" (Just "PlainText") Tag False False [],
+                    model "supplieridentifier" "SupplierIdentifier" (Just "PlainText") Tag True True [],
+                    model "j137" "SupplierName" (Just "PlainText") Tag True False [],
+                    model "j270" "TelephoneNumber" (Just "PlainText") Tag True True []
+                  ]
+          assertEqual "can parse group in order of sequence" expected actual
+      ),
     TestCase
       ( do
           scm <- getSchema "./fixtures/test_mixed_html.xsd"
@@ -243,12 +288,12 @@ tests =
                     optional = False,
                     iterable = False,
                     elements =
-                      [ Model {shortname = "a100", xmlReferenceName = "RightsTerritory", typeName = Just "string", kind = Tag, optional = True, iterable = False, elements = []},
-                        Model {shortname = "a000", xmlReferenceName = "RightsCountry", typeName = Just "string", kind = Tag, optional = True, iterable = True, elements = []},
-                        Model {shortname = "a200", xmlReferenceName = "ISBN", typeName = Just "string", kind = Tag, optional = False, iterable = False, elements = []},
-                        Model {shortname = "a300", xmlReferenceName = "EAN13", typeName = Just "string", kind = Tag, optional = False, iterable = False, elements = []},
-                        Model {shortname = "a400", xmlReferenceName = "ProductIdentifier", typeName = Just "string", kind = Tag, optional = False, iterable = True, elements = []},
-                        Model {shortname = "a500", xmlReferenceName = "PublisherName", typeName = Just "string", kind = Tag, optional = False, iterable = False, elements = []}
+                      [ Model {shortname = "a000", xmlReferenceName = "RightsCountry", typeName = Just "PlainText", kind = Tag, optional = True, iterable = True, elements = []},
+                        Model {shortname = "a100", xmlReferenceName = "RightsTerritory", typeName = Just "PlainText", kind = Tag, optional = True, iterable = False, elements = []},
+                        Model {shortname = "a200", xmlReferenceName = "ISBN", typeName = Just "PlainText", kind = Tag, optional = False, iterable = False, elements = []},
+                        Model {shortname = "a300", xmlReferenceName = "EAN13", typeName = Just "PlainText", kind = Tag, optional = False, iterable = False, elements = []},
+                        Model {shortname = "a400", xmlReferenceName = "ProductIdentifier", typeName = Just "PlainText", kind = Tag, optional = False, iterable = True, elements = []},
+                        Model {shortname = "a500", xmlReferenceName = "PublisherName", typeName = Just "PlainText", kind = Tag, optional = False, iterable = False, elements = []}
                       ]
                   }
 
@@ -268,7 +313,7 @@ tests =
                     optional = False,
                     iterable = False,
                     elements =
-                      [ Model {shortname = "a100", xmlReferenceName = "PublicationDate", typeName = Just "string", kind = Tag, optional = True, iterable = False, elements = []}
+                      [ Model {shortname = "a100", xmlReferenceName = "PublicationDate", typeName = Just "PlainText", kind = Tag, optional = True, iterable = False, elements = []}
                       ]
                   }
 
@@ -346,5 +391,11 @@ tests =
                     }
                 ]
           assertEqual "can parse generalAttributes" expected actual
+      ),
+    TestCase
+      ( do
+          let actual = map camelCase ["Product", "IDValue", "ISBN", "EAN13", "ONIXMessage", "Textformat"]
+              expected = ["product", "idValue", "isbn", "ean13", "onixMessage", "textformat"]
+          assertEqual "can derive camelCase name of json" expected actual
       )
   ]
@@ -0,0 +1,43 @@
+{-# LANGUAGE OverloadedStrings #-}
+
+module TestTag (tests) where
+
+import qualified Data.Vector as V
+import qualified Model as Md
+import Tag
+import Test.HUnit (Test (TestCase), assertEqual)
+import Util
+import Xsd (getSchema)
+
+tests :: [Test]
+tests =
+  [ TestCase
+      ( do
+          let actual =
+                modelToTags $
+                  Md.model
+                    "onorderdetail"
+                    "OnOrderDetail"
+                    Nothing
+                    Md.Tag
+                    False
+                    False
+                    [ Md.model "j351" "OnOrder" (Just "string") Md.Tag False False [],
+                      Md.model "textcase" "Textcase" (Just "TextCaseCode") Md.Attribute True False []
+                    ]
+              expected = [Tag "OnOrderDetail" "onorderdetail", Tag "OnOrder" "j351"]
+          assertEqual "can derive tags except attributes" expected actual
+      ),
+    TestCase
+      ( do
+          scm <- getSchema "./fixtures/test_model_element.xsd"
+          let actual = readSchema scm :: Tags
+              expected =
+                V.fromList
+                  [ Tag "ExpectedDate" "j302",
+                    Tag "OnOrder" "j351",
+                    Tag "OnOrderDetail" "onorderdetail"
+                  ]
+          assertEqual "can collect unique tags of schema" expected actual
+      )
+  ]